@@ -14,6 +14,7 @@ type Generator interface {
 	Sub(w io.Writer, n *Sub) error
 	Link(w io.Writer, n *Link) error
 	Image(w io.Writer, n *Image) error
+	Mention(w io.Writer, n *Mention) error
 	Code(w io.Writer, n *Code) error
 	CodeIn(w io.Writer, n *CodeIn) error
 	Quote(w io.Writer, n *Quote) error
@@ -21,6 +22,11 @@ type Generator interface {
 	Spoiler(w io.Writer, n *Spoiler) error
 	Indent(w io.Writer, n *Indent) error
 	HR(w io.Writer, n *HR) error
+	List(w io.Writer, n *List) error
+	ListItem(w io.Writer, n *ListItem) error
+	Table(w io.Writer, n *Table) error
+	TableRow(w io.Writer, n *TableRow) error
+	TableCell(w io.Writer, n *TableCell) error
 	Custom(w io.Writer, n *Custom) error
 }
 
@@ -48,6 +54,8 @@ func Generate(w io.Writer, n Node, g Generator) error {
 		return g.Link(w, t)
 	case *Image:
 		return g.Image(w, t)
+	case *Mention:
+		return g.Mention(w, t)
 	case *Code:
 		return g.Code(w, t)
 	case *CodeIn:
@@ -62,6 +70,16 @@ func Generate(w io.Writer, n Node, g Generator) error {
 		return g.Indent(w, t)
 	case *HR:
 		return g.HR(w, t)
+	case *List:
+		return g.List(w, t)
+	case *ListItem:
+		return g.ListItem(w, t)
+	case *Table:
+		return g.Table(w, t)
+	case *TableRow:
+		return g.TableRow(w, t)
+	case *TableCell:
+		return g.TableCell(w, t)
 	case *Custom:
 		return g.Custom(w, t)
 	}
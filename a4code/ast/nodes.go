@@ -258,6 +258,23 @@ func (i *Image) String() string {
 	return "[img=" + i.Src + "]"
 }
 
+// Mention refers to a user with @username.
+type Mention struct {
+	BaseNode
+	Username string
+}
+
+func (*Mention) isNode()       {}
+func (*Mention) isInlineType() {}
+
+func (m *Mention) Transform(op func(Node) (Node, error)) (Node, error) {
+	return transformChildren(m, op)
+}
+
+func (m *Mention) String() string {
+	return "@" + m.Username
+}
+
 // Code block.
 type Code struct {
 	BaseNode
@@ -413,6 +430,121 @@ func (h *HR) String() string {
 	return "[hr]"
 }
 
+// List holds ListItem children, numbered when Ordered is set.
+type List struct {
+	BaseNode
+	Ordered  bool
+	Children []Node
+}
+
+func (*List) isNode()                {}
+func (*List) isBlockType()           {}
+func (l *List) childrenPtr() *[]Node { return &l.Children }
+func (l *List) AddChild(n Node)      { n.SetParent(l); l.Children = append(l.Children, n) }
+func (l *List) GetChildren() []Node  { return l.Children }
+
+func (l *List) Transform(op func(Node) (Node, error)) (Node, error) {
+	return transformChildren(l, op)
+}
+
+func (l *List) String() string {
+	if l.Ordered {
+		return "[olist" + joinChildren(l.Children) + "]"
+	}
+	return "[list" + joinChildren(l.Children) + "]"
+}
+
+// ListItem is a single entry of a List.
+type ListItem struct {
+	BaseNode
+	Children []Node
+}
+
+func (*ListItem) isNode()                {}
+func (*ListItem) isBlockType()           {}
+func (i *ListItem) childrenPtr() *[]Node { return &i.Children }
+func (i *ListItem) AddChild(n Node)      { n.SetParent(i); i.Children = append(i.Children, n) }
+func (i *ListItem) GetChildren() []Node  { return i.Children }
+
+func (i *ListItem) Transform(op func(Node) (Node, error)) (Node, error) {
+	return transformChildren(i, op)
+}
+
+func (i *ListItem) String() string {
+	return "[li" + joinChildren(i.Children) + "]"
+}
+
+// Table holds TableRow children.
+type Table struct {
+	BaseNode
+	Children []Node
+}
+
+func (*Table) isNode()                {}
+func (*Table) isBlockType()           {}
+func (t *Table) childrenPtr() *[]Node { return &t.Children }
+func (t *Table) AddChild(n Node)      { n.SetParent(t); t.Children = append(t.Children, n) }
+func (t *Table) GetChildren() []Node  { return t.Children }
+
+func (t *Table) Transform(op func(Node) (Node, error)) (Node, error) {
+	return transformChildren(t, op)
+}
+
+func (t *Table) String() string {
+	return "[table" + joinChildren(t.Children) + "]"
+}
+
+// TableRow holds the TableCell children of one table row.
+type TableRow struct {
+	BaseNode
+	Children []Node
+}
+
+func (*TableRow) isNode()                {}
+func (*TableRow) isBlockType()           {}
+func (r *TableRow) childrenPtr() *[]Node { return &r.Children }
+func (r *TableRow) AddChild(n Node)      { n.SetParent(r); r.Children = append(r.Children, n) }
+func (r *TableRow) GetChildren() []Node  { return r.Children }
+
+func (r *TableRow) Transform(op func(Node) (Node, error)) (Node, error) {
+	return transformChildren(r, op)
+}
+
+func (r *TableRow) String() string {
+	return "[row" + joinChildren(r.Children) + "]"
+}
+
+// TableCell is a table cell; Header marks a heading cell.
+type TableCell struct {
+	BaseNode
+	Header   bool
+	Children []Node
+}
+
+func (*TableCell) isNode()                {}
+func (*TableCell) isBlockType()           {}
+func (c *TableCell) childrenPtr() *[]Node { return &c.Children }
+func (c *TableCell) AddChild(n Node)      { n.SetParent(c); c.Children = append(c.Children, n) }
+func (c *TableCell) GetChildren() []Node  { return c.Children }
+
+func (c *TableCell) Transform(op func(Node) (Node, error)) (Node, error) {
+	return transformChildren(c, op)
+}
+
+func (c *TableCell) String() string {
+	if c.Header {
+		return "[th" + joinChildren(c.Children) + "]"
+	}
+	return "[cell" + joinChildren(c.Children) + "]"
+}
+
+// IsBlankText reports whether n is a Text node holding only whitespace, such
+// as the line breaks between the items of a List or the cells of a TableRow.
+func IsBlankText(n Node) bool {
+	t, ok := n.(*Text)
+	return ok && strings.TrimSpace(t.Value) == ""
+}
+
 // Custom element for unrecognised tags.
 type Custom struct {
 	BaseNode
@@ -506,48 +638,96 @@ func IsBlockNode(n Node) bool {
 	// A line is bounded by start of siblings, or a newline text, or a hard block.
 	lineStartIdx := 0
 	for i := idx - 1; i >= 0; i-- {
-		s := siblings[i]
-		if txt, ok := s.(*Text); ok {
-			if strings.Contains(txt.Value, "\n") || strings.Contains(txt.Value, "\r") {
-				lineStartIdx = i + 1
-				break
-			}
-		} else if _, ok := s.(Block); ok {
-			if _, inlinable := s.(BlockWithInlinable); !inlinable {
-				// strict block breaks the line
-				lineStartIdx = i + 1
-				break
-			}
+		if breaksLine(siblings[i]) {
+			lineStartIdx = i + 1
+			break
 		}
 	}
 
 	// Also find the line end index
 	lineEndIdx := len(siblings)
 	for i := idx + 1; i < len(siblings); i++ {
-		s := siblings[i]
-		if txt, ok := s.(*Text); ok {
-			if strings.Contains(txt.Value, "\n") || strings.Contains(txt.Value, "\r") {
-				lineEndIdx = i
-				break
-			}
-		} else if _, ok := s.(Block); ok {
-			if _, inlinable := s.(BlockWithInlinable); !inlinable {
-				lineEndIdx = i
-				break
+		if breaksLine(siblings[i]) {
+			lineEndIdx = i
+			break
+		}
+	}
+
+	return resolveBlock(n, lineHasStrictInline(siblings[lineStartIdx:lineEndIdx]), isContextBlock)
+}
+
+// BlockNodes resolves IsBlockNode for n and every node beneath it. Each
+// line of siblings is scanned once, so the cost is linear in the size of the
+// tree where calling IsBlockNode for every node is quadratic in the number of
+// nodes sharing a line.
+func BlockNodes(n Node) map[Node]bool {
+	out := map[Node]bool{n: IsBlockNode(n)}
+	resolveChildBlocks(n, out)
+	return out
+}
+
+func resolveChildBlocks(p Node, out map[Node]bool) {
+	container, ok := p.(Container)
+	if !ok {
+		return
+	}
+	siblings := container.GetChildren()
+	isContextBlock := out[p]
+
+	// next[i] is the index of the first line break after sibling i.
+	next := make([]int, len(siblings))
+	end := len(siblings)
+	for i := len(siblings) - 1; i >= 0; i-- {
+		next[i] = end
+		if breaksLine(siblings[i]) {
+			end = i
+		}
+	}
+
+	strict := map[[2]int]bool{}
+	start := 0
+	for i, c := range siblings {
+		if c.GetParent() != p {
+			out[c] = IsBlockNode(c)
+		} else {
+			line := [2]int{start, next[i]}
+			hasStrictInline, ok := strict[line]
+			if !ok {
+				hasStrictInline = lineHasStrictInline(siblings[line[0]:line[1]])
+				strict[line] = hasStrictInline
 			}
+			out[c] = resolveBlock(c, hasStrictInline, isContextBlock)
 		}
+		if breaksLine(c) {
+			start = i + 1
+		}
+		resolveChildBlocks(c, out)
 	}
+}
 
-	// Scan the line for strict inline content
-	hasStrictInline := false
-	for i := lineStartIdx; i < lineEndIdx; i++ {
-		s := siblings[i]
+// breaksLine reports whether s ends a line of siblings: text containing a
+// newline or a block that cannot be inlined.
+func breaksLine(s Node) bool {
+	if txt, ok := s.(*Text); ok {
+		return strings.Contains(txt.Value, "\n") || strings.Contains(txt.Value, "\r")
+	}
+	if _, ok := s.(Block); ok {
+		_, inlinable := s.(BlockWithInlinable)
+		return !inlinable
+	}
+	return false
+}
+
+// lineHasStrictInline reports whether a line of siblings holds content that
+// can only be rendered inline.
+func lineHasStrictInline(line []Node) bool {
+	for i, s := range line {
 		if txt, ok := s.(*Text); ok {
 			val := txt.Value
-			if i == lineStartIdx {
+			if i == 0 {
 				val = strings.TrimLeft(val, " \t\n\r")
 			}
-			if i == lineEndIdx-1 {
+			if i == len(line)-1 {
 				val = strings.TrimRight(val, " \t\n\r")
 			} else {
 				if strings.TrimSpace(val) == "" {
@@ -555,20 +735,22 @@ func IsBlockNode(n Node) bool {
 				}
 			}
 			if len(val) > 0 {
-				hasStrictInline = true
-				break
+				return true
 			}
 		} else if _, ok := s.(InlineWithBlockable); ok {
 			// can be either
 		} else if _, ok := s.(BlockWithInlinable); ok {
 			// can be either
 		} else if _, ok := s.(Inline); ok {
-			hasStrictInline = true
-			break
+			return true
 		}
 	}
+	return false
+}
 
-	// Now apply the resolution
+// resolveBlock decides whether n is a block given whether its line holds
+// strict inline content and whether its parent is a block.
+func resolveBlock(n Node, hasStrictInline, isContextBlock bool) bool {
 	if ib, ok := n.(InlineWithBlockable); ok {
 		if hasStrictInline {
 			return false
@@ -37,6 +37,11 @@ func TestIsBlockNode(t *testing.T) {
 		assert.True(t, IsBlockNode(indent))
 	})
 
+	t.Run("List and Table are block", func(t *testing.T) {
+		assert.True(t, IsBlockNode(&List{}))
+		assert.True(t, IsBlockNode(&Table{}))
+	})
+
 	t.Run("Inlinable Code on same line as strict inline text", func(t *testing.T) {
 		root := &Root{}
 		txt := &Text{Value: "Prefix "}
@@ -102,6 +107,33 @@ func TestNodeGettersSettersAndPos(t *testing.T) {
 	assert.Equal(t, parentRoot, base.GetParent())
 }
 
+func TestBlockNodesMatchesIsBlockNode(t *testing.T) {
+	root := &Root{}
+	root.AddChild(&Text{Value: "intro "})
+	root.AddChild(&Code{Value: "inline"})
+	root.AddChild(&Text{Value: "\n"})
+	root.AddChild(&Code{Value: "alone"})
+	root.AddChild(&Text{Value: "\n  "})
+	link := &Link{Href: "https://example.com"}
+	link.AddChild(&Text{Value: "link"})
+	root.AddChild(link)
+	root.AddChild(&HR{})
+	quote := &Quote{}
+	quote.AddChild(&Code{Value: "quoted"})
+	quote.AddChild(&Text{Value: " tail"})
+	root.AddChild(quote)
+	bold := &Bold{}
+	bold.AddChild(&Code{Value: "in bold"})
+	root.AddChild(bold)
+	root.AddChild(&Code{Value: "after bold"})
+
+	blocks := BlockNodes(root)
+	_ = Walk(root, func(n Node) error {
+		assert.Equal(t, IsBlockNode(n), blocks[n], "%T %s", n, n)
+		return nil
+	})
+}
+
 func TestNodeStringMethods(t *testing.T) {
 	root := &Root{}
 	bold := &Bold{}
@@ -7,13 +7,17 @@ import (
 	"github.com/arran4/goa4web/a4code/ast"
 )
 
-type Generator struct{}
+type Generator struct {
+	// blocks caches ast.IsBlockNode for the tree being generated.
+	blocks map[ast.Node]bool
+}
 
 func NewGenerator() *Generator {
 	return &Generator{}
 }
 
 func (g *Generator) Root(w io.Writer, n *ast.Root) error {
+	g.blocks = ast.BlockNodes(n)
 	for _, c := range n.Children {
 		if err := ast.Generate(w, c, g); err != nil {
 			return err
@@ -28,6 +32,12 @@ func (g *Generator) Text(w io.Writer, t *ast.Text) error {
 		case '[', ']', '=', '\\', '*', '/', '_':
 			writeByte(w, '\\')
 			writeByte(w, t.Value[i])
+		case '@':
+			// Keep a literal "@name" from reading back as a mention.
+			if i+1 < len(t.Value) && isMentionNameByte(t.Value[i+1]) && (i == 0 || !isMentionWordByte(t.Value[i-1])) {
+				writeByte(w, '\\')
+			}
+			writeByte(w, '@')
 		default:
 			writeByte(w, t.Value[i])
 		}
@@ -101,9 +111,14 @@ func (g *Generator) Image(w io.Writer, n *ast.Image) error {
 	return nil
 }
 
+func (g *Generator) Mention(w io.Writer, n *ast.Mention) error {
+	_, _ = io.WriteString(w, "@"+n.Username)
+	return nil
+}
+
 func (g *Generator) Code(w io.Writer, n *ast.Code) error {
 	_, _ = io.WriteString(w, "[code")
-	if ast.IsBlockNode(n) {
+	if g.isBlock(n) {
 		_, _ = io.WriteString(w, "\n")
 	} else if len(n.Value) > 0 {
 		first := n.Value[0]
@@ -167,6 +182,54 @@ func (g *Generator) HR(w io.Writer, n *ast.HR) error {
 	return nil
 }
 
+func (g *Generator) List(w io.Writer, n *ast.List) error {
+	if n.Ordered {
+		_, _ = io.WriteString(w, "[olist")
+	} else {
+		_, _ = io.WriteString(w, "[list")
+	}
+	if len(n.Children) > 0 {
+		writeByte(w, ' ')
+	}
+	return g.generateChildren(w, n.Children)
+}
+
+func (g *Generator) ListItem(w io.Writer, n *ast.ListItem) error {
+	_, _ = io.WriteString(w, "[li")
+	if len(n.Children) > 0 {
+		writeByte(w, ' ')
+	}
+	return g.generateChildren(w, n.Children)
+}
+
+func (g *Generator) Table(w io.Writer, n *ast.Table) error {
+	_, _ = io.WriteString(w, "[table")
+	if len(n.Children) > 0 {
+		writeByte(w, ' ')
+	}
+	return g.generateChildren(w, n.Children)
+}
+
+func (g *Generator) TableRow(w io.Writer, n *ast.TableRow) error {
+	_, _ = io.WriteString(w, "[row")
+	if len(n.Children) > 0 {
+		writeByte(w, ' ')
+	}
+	return g.generateChildren(w, n.Children)
+}
+
+func (g *Generator) TableCell(w io.Writer, n *ast.TableCell) error {
+	if n.Header {
+		_, _ = io.WriteString(w, "[th")
+	} else {
+		_, _ = io.WriteString(w, "[cell")
+	}
+	if len(n.Children) > 0 {
+		writeByte(w, ' ')
+	}
+	return g.generateChildren(w, n.Children)
+}
+
 func (g *Generator) Custom(w io.Writer, n *ast.Custom) error {
 	writeByte(w, '[')
 	_, _ = io.WriteString(w, n.Tag)
@@ -176,6 +239,13 @@ func (g *Generator) Custom(w io.Writer, n *ast.Custom) error {
 	return g.generateChildren(w, n.Children)
 }
 
+func (g *Generator) isBlock(n ast.Node) bool {
+	if b, ok := g.blocks[n]; ok {
+		return b
+	}
+	return ast.IsBlockNode(n)
+}
+
 func writeByte(w io.Writer, b byte) {
 	if bw, ok := w.(io.ByteWriter); ok {
 		_ = bw.WriteByte(b)
@@ -209,3 +279,11 @@ func escapeQuotedArg(w io.Writer, s string) {
 	}
 	writeByte(w, '"')
 }
+
+func isMentionWordByte(b byte) bool {
+	return b == '_' || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+func isMentionNameByte(b byte) bool {
+	return isMentionWordByte(b) || b == '.' || b == '-'
+}
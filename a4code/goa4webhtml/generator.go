@@ -130,6 +130,17 @@ func (g *Generator) Image(w io.Writer, n *ast.Image) error {
 	return nil
 }
 
+func (g *Generator) Mention(w io.Writer, n *ast.Mention) error {
+	class := "a4code-mention"
+	if g.UserColorMapper != nil {
+		class += " " + g.UserColorMapper(n.Username)
+	}
+	_, _ = fmt.Fprintf(w, `<a class="%s" href="%s"%s>@`, htmlstd.EscapeString(class), htmlstd.EscapeString(html.MentionHref(n.Username)), g.SourceAttrs(n.Start, n.End))
+	_, _ = io.WriteString(w, htmlstd.EscapeString(n.Username))
+	_, _ = io.WriteString(w, "</a>")
+	return nil
+}
+
 func (g *Generator) QuoteOf(w io.Writer, n *ast.QuoteOf) error {
 	colorClass := fmt.Sprintf("quote-color-%d", g.Depth%6)
 	if g.UserColorMapper != nil {
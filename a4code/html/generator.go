@@ -170,6 +170,20 @@ func (g *Generator) Image(w io.Writer, n *ast.Image) error {
 	return nil
 }
 
+// MentionHref returns the profile URL an @username mention links to.
+func MentionHref(username string) string {
+	return "/user/profile/" + url.PathEscape(username)
+}
+
+func (g *Generator) Mention(w io.Writer, n *ast.Mention) error {
+	_, _ = io.WriteString(w, `<a class="a4code-mention" href="`)
+	_, _ = io.WriteString(w, html.EscapeString(MentionHref(n.Username)))
+	_, _ = fmt.Fprintf(w, `"%s>@`, g.SourceAttrs(n.Start, n.End))
+	_, _ = io.WriteString(w, htmlEscape(n.Username))
+	_, _ = io.WriteString(w, "</a>")
+	return nil
+}
+
 func (g *Generator) Code(w io.Writer, n *ast.Code) error {
 	if !ast.IsBlockNode(n) {
 		_, _ = fmt.Fprintf(w, `<code class="a4code-inline a4code-code"%s>`, g.SourceAttrs(n.Start, n.End))
@@ -273,6 +287,77 @@ func (g *Generator) HR(w io.Writer, n *ast.HR) error {
 	return nil
 }
 
+// structuralChildren renders the children of a list, table or row, dropping
+// the whitespace between items which is not valid content there.
+func (g *Generator) structuralChildren(w io.Writer, children []ast.Node) error {
+	for _, c := range children {
+		if ast.IsBlankText(c) {
+			continue
+		}
+		if err := ast.Generate(w, c, g.self()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) List(w io.Writer, n *ast.List) error {
+	tag := "ul"
+	if n.Ordered {
+		tag = "ol"
+	}
+	_, _ = fmt.Fprintf(w, `<%s class="a4code-block a4code-list"%s>`, tag, g.SourceAttrs(n.Start, n.End))
+	if err := g.structuralChildren(w, n.Children); err != nil {
+		return err
+	}
+	_, _ = fmt.Fprintf(w, "</%s>", tag)
+	return nil
+}
+
+func (g *Generator) ListItem(w io.Writer, n *ast.ListItem) error {
+	_, _ = fmt.Fprintf(w, `<li%s>`, g.SourceAttrs(n.Start, n.End))
+	for _, c := range n.Children {
+		if err := ast.Generate(w, c, g.self()); err != nil {
+			return err
+		}
+	}
+	_, _ = io.WriteString(w, "</li>")
+	return nil
+}
+
+func (g *Generator) Table(w io.Writer, n *ast.Table) error {
+	_, _ = fmt.Fprintf(w, `<table class="a4code-block a4code-table"%s><tbody>`, g.SourceAttrs(n.Start, n.End))
+	if err := g.structuralChildren(w, n.Children); err != nil {
+		return err
+	}
+	_, _ = io.WriteString(w, "</tbody></table>")
+	return nil
+}
+
+func (g *Generator) TableRow(w io.Writer, n *ast.TableRow) error {
+	_, _ = fmt.Fprintf(w, `<tr%s>`, g.SourceAttrs(n.Start, n.End))
+	if err := g.structuralChildren(w, n.Children); err != nil {
+		return err
+	}
+	_, _ = io.WriteString(w, "</tr>")
+	return nil
+}
+
+func (g *Generator) TableCell(w io.Writer, n *ast.TableCell) error {
+	tag := "td"
+	if n.Header {
+		tag = "th"
+	}
+	_, _ = fmt.Fprintf(w, `<%s%s>`, tag, g.SourceAttrs(n.Start, n.End))
+	for _, c := range n.Children {
+		if err := ast.Generate(w, c, g.self()); err != nil {
+			return err
+		}
+	}
+	_, _ = fmt.Fprintf(w, "</%s>", tag)
+	return nil
+}
+
 func (g *Generator) Custom(w io.Writer, n *ast.Custom) error {
 	_, _ = fmt.Fprintf(w, `<span%s>`, g.SourceAttrs(n.Start, n.End))
 	_, _ = io.WriteString(w, "[")
@@ -0,0 +1,68 @@
+package a4code
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/txtar"
+)
+
+// listTableHelpers maps a txtar file suffix to the helper producing it.
+var listTableHelpers = map[string]func(string) string{
+	"clean": func(in string) string {
+		root, err := ParseString(in)
+		if err != nil {
+			return err.Error()
+		}
+		return ToCleanText(root)
+	},
+	"snip":  func(in string) string { return SnipTextWords(in, 5) },
+	"quote": func(in string) string { return QuoteText("bob", in, WithParagraphQuote()) },
+	"reduce": func(in string) string {
+		out, err := QuoteReduce(in)
+		if err != nil {
+			return err.Error()
+		}
+		return out
+	},
+	"substring": func(in string) string {
+		out, err := Substring(in, 0, 8)
+		if err != nil {
+			return err.Error()
+		}
+		return out
+	},
+}
+
+func TestListTableTxtar(t *testing.T) {
+	ar, err := txtar.ParseFile("testdata/list_table.txtar")
+	if err != nil {
+		t.Fatalf("failed to read testdata/list_table.txtar: %v", err)
+	}
+	inputs := map[string]string{}
+	for _, f := range ar.Files {
+		if name, ok := strings.CutSuffix(f.Name, ".in"); ok {
+			inputs[name] = strings.TrimSuffix(string(f.Data), "\n")
+		}
+	}
+	for _, f := range ar.Files {
+		name, kind, ok := strings.Cut(f.Name, ".")
+		if !ok || kind == "in" {
+			continue
+		}
+		helper, ok := listTableHelpers[kind]
+		if !ok {
+			t.Fatalf("%s: unknown helper %q", f.Name, kind)
+		}
+		in, ok := inputs[name]
+		if !ok {
+			t.Fatalf("%s: no input", f.Name)
+		}
+		t.Run(f.Name, func(t *testing.T) {
+			want := strings.TrimSuffix(string(f.Data), "\n")
+			if got := strings.TrimSuffix(helper(in), "\n"); got != want {
+				t.Errorf("got:\n%q\nwant:\n%q", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package a4code
+
+import "github.com/arran4/goa4web/a4code/markdown"
+
+// FromMarkdown converts Markdown source into A4Code markup.
+func FromMarkdown(s string) (string, error) {
+	root, err := markdown.ParseString(s)
+	if err != nil {
+		return "", err
+	}
+	return ToCode(root), nil
+}
@@ -1,8 +1,11 @@
 package markdown
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/arran4/goa4web/a4code/ast"
 )
@@ -126,6 +129,10 @@ func (g *Generator) Image(w io.Writer, n *ast.Image) error {
 	return err
 }
 
+func (g *Generator) Mention(w io.Writer, n *ast.Mention) error {
+	return writeString(w, "@"+n.Username)
+}
+
 func (g *Generator) Code(w io.Writer, n *ast.Code) error {
 	if !ast.IsBlockNode(n) {
 		if err := writeString(w, "`"); err != nil {
@@ -229,6 +236,149 @@ func (g *Generator) HR(w io.Writer, n *ast.HR) error {
 	return writeString(w, "---\n")
 }
 
+// startLine moves to the start of a new line unless already there.
+func startLine(w io.Writer) error {
+	if sw, ok := w.(*SmartWriter); ok && sw.lastByte != '\n' {
+		return writeString(w, "\n")
+	}
+	return nil
+}
+
+// renderInline renders children on their own, for content that must be
+// reshaped before it is written such as list items and table cells.
+func (g *Generator) renderInline(children []ast.Node) (string, error) {
+	var buf bytes.Buffer
+	sw := &SmartWriter{w: &buf, lastByte: '\n'}
+	for _, c := range children {
+		if err := ast.Generate(sw, c, g); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+func (g *Generator) List(w io.Writer, n *ast.List) error {
+	if err := startLine(w); err != nil {
+		return err
+	}
+	num := 0
+	for _, c := range n.Children {
+		if ast.IsBlankText(c) {
+			continue
+		}
+		item, ok := c.(*ast.ListItem)
+		if !ok {
+			if err := ast.Generate(w, c, g); err != nil {
+				return err
+			}
+			continue
+		}
+		num++
+		marker := "- "
+		if n.Ordered {
+			marker = strconv.Itoa(num) + ". "
+		}
+		body, err := g.renderInline(item.Children)
+		if err != nil {
+			return err
+		}
+		body = strings.Trim(body, "\n")
+		body = strings.ReplaceAll(body, "\n", "\n"+strings.Repeat(" ", len(marker)))
+		if err := writeString(w, marker+body+"\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) ListItem(w io.Writer, n *ast.ListItem) error {
+	for _, c := range n.Children {
+		if err := ast.Generate(w, c, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Table renders a pipe table. Markdown tables need a heading row so the
+// first row is always used as one.
+func (g *Generator) Table(w io.Writer, n *ast.Table) error {
+	if err := startLine(w); err != nil {
+		return err
+	}
+	var rows [][]string
+	cols := 0
+	for _, c := range n.Children {
+		row, ok := c.(*ast.TableRow)
+		if !ok {
+			continue
+		}
+		var cells []string
+		for _, rc := range row.Children {
+			cell, ok := rc.(*ast.TableCell)
+			if !ok {
+				continue
+			}
+			v, err := g.renderInline(cell.Children)
+			if err != nil {
+				return err
+			}
+			v = strings.TrimSpace(v)
+			v = strings.ReplaceAll(v, "|", "\\|")
+			v = strings.ReplaceAll(v, "\n", "<br>")
+			cells = append(cells, v)
+		}
+		cols = max(cols, len(cells))
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 || cols == 0 {
+		return nil
+	}
+	writeRow := func(cells []string) error {
+		var b strings.Builder
+		b.WriteString("|")
+		for i := 0; i < cols; i++ {
+			v := ""
+			if i < len(cells) {
+				v = cells[i]
+			}
+			b.WriteString(" " + v + " |")
+		}
+		b.WriteString("\n")
+		return writeString(w, b.String())
+	}
+	if err := writeRow(rows[0]); err != nil {
+		return err
+	}
+	if err := writeString(w, "|"+strings.Repeat(" --- |", cols)+"\n"); err != nil {
+		return err
+	}
+	for _, r := range rows[1:] {
+		if err := writeRow(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) TableRow(w io.Writer, n *ast.TableRow) error {
+	for _, c := range n.Children {
+		if err := ast.Generate(w, c, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) TableCell(w io.Writer, n *ast.TableCell) error {
+	for _, c := range n.Children {
+		if err := ast.Generate(w, c, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (g *Generator) Custom(w io.Writer, n *ast.Custom) error {
 	for _, c := range n.Children {
 		if err := ast.Generate(w, c, g); err != nil {
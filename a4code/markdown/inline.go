@@ -0,0 +1,484 @@
+package markdown
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/arran4/goa4web/a4code/ast"
+)
+
+// inlineParser reads the inline content of a single block. Emphasis, links
+// and HTML elements are parsed by recursive descent: an opener tries to parse
+// up to its closer and falls back to literal text when none is found.
+type inlineParser struct {
+	src    string
+	inLink bool
+	// failedFrom records, per closer, the earliest offset from which a
+	// search for it ran to the end of the source without a match. Later
+	// searches are abandoned so unmatched openers stay linear.
+	failedFrom map[string]int
+	// lastClose caches the last offset at which each closer could match.
+	lastClose map[string]int
+	// brackets maps each '[' to its matching ']', built on first use.
+	brackets map[int]int
+}
+
+func parseInline(s string, depth int) []ast.Node {
+	return newInlineParser(s, false).parseAll(depth)
+}
+
+func newInlineParser(s string, inLink bool) *inlineParser {
+	return &inlineParser{
+		src:        s,
+		inLink:     inLink,
+		failedFrom: map[string]int{},
+		lastClose:  map[string]int{},
+	}
+}
+
+func (p *inlineParser) parseAll(depth int) []ast.Node {
+	nodes, _, _ := p.parse(0, "", depth)
+	return nodes
+}
+
+// inlineTag maps an HTML element written by Generator to its node.
+type inlineTag struct {
+	open, close string
+	node        func() ast.Container
+}
+
+var inlineTags = []inlineTag{
+	{"<u>", "</u>", func() ast.Container { return &ast.Underline{} }},
+	{"<sup>", "</sup>", func() ast.Container { return &ast.Sup{} }},
+	{"<sub>", "</sub>", func() ast.Container { return &ast.Sub{} }},
+	{"<b>", "</b>", func() ast.Container { return &ast.Bold{} }},
+	{"<strong>", "</strong>", func() ast.Container { return &ast.Bold{} }},
+	{"<i>", "</i>", func() ast.Container { return &ast.Italic{} }},
+	{"<em>", "</em>", func() ast.Container { return &ast.Italic{} }},
+	{"<blockquote>", "</blockquote>", nil},
+	{"<details>", "</details>", nil},
+}
+
+var (
+	entityRe    = regexp.MustCompile(`^&(?:#[0-9]{1,7}|#[xX][0-9a-fA-F]{1,6}|[A-Za-z][A-Za-z0-9]{1,31});`)
+	autolinkRe  = regexp.MustCompile(`^<([A-Za-z][A-Za-z0-9+.-]{1,31}:[^\s<>]*)>`)
+	lineBreakRe = regexp.MustCompile(`(?i)^<br\s*/?>`)
+)
+
+// parse reads from pos until closer, or the end of the source when closer
+// is empty. closed reports whether the closer was found and end is the
+// offset after it.
+func (p *inlineParser) parse(pos int, closer string, depth int) (nodes []ast.Node, end int, closed bool) {
+	s := p.src
+	var buf []byte
+	flush := func() {
+		if len(buf) > 0 {
+			nodes = append(nodes, &ast.Text{Value: string(buf)})
+			buf = buf[:0]
+		}
+	}
+	add := func(n ast.Node) {
+		flush()
+		nodes = append(nodes, n)
+	}
+	for i := pos; i < len(s); {
+		if closer != "" && i > pos && p.closes(i, closer) {
+			flush()
+			return nodes, i + len(closer), true
+		}
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) && s[i+1] == '\n' {
+				buf = append(buf, '\n')
+				i += 2
+				continue
+			}
+			if i+1 < len(s) && isASCIIPunct(s[i+1]) {
+				buf = append(buf, s[i+1])
+				i += 2
+				continue
+			}
+		case '`':
+			if n, next, ok := p.codeSpan(i); ok {
+				add(n)
+				i = next
+				continue
+			}
+			run := runLength(s, i, '`')
+			buf = append(buf, s[i:i+run]...)
+			i += run
+			continue
+		case '*', '_':
+			run := runLength(s, i, c)
+			if depth < maxNesting && p.canOpen(i, run) {
+				if n, next, ok := p.emphasis(i, run, depth); ok {
+					add(n)
+					i = next
+					continue
+				}
+			}
+			buf = append(buf, s[i:i+run]...)
+			i += run
+			continue
+		case '!':
+			if i+1 < len(s) && s[i+1] == '[' {
+				if _, dest, next, ok := p.link(i + 1); ok {
+					add(&ast.Image{Src: dest})
+					i = next
+					continue
+				}
+			}
+		case '[':
+			if !p.inLink && depth < maxNesting {
+				if text, dest, next, ok := p.link(i); ok {
+					l := &ast.Link{Href: dest}
+					addChildren(l, newInlineParser(text, true).parseAll(depth+1))
+					add(l)
+					i = next
+					continue
+				}
+			}
+		case '<':
+			if m := lineBreakRe.FindString(s[i:]); m != "" {
+				buf = append(buf, '\n')
+				i += len(m)
+				continue
+			}
+			if m := autolinkRe.FindStringSubmatch(s[i:]); m != nil && !p.inLink {
+				add(&ast.Link{Href: m[1], Children: []ast.Node{&ast.Text{Value: m[1]}}})
+				i += len(m[0])
+				continue
+			}
+			if depth < maxNesting {
+				if n, next, ok := p.htmlElement(i, depth); ok {
+					add(n)
+					i = next
+					continue
+				}
+			}
+		case '@':
+			if name := p.mentionName(i); name != "" {
+				add(&ast.Mention{Username: name})
+				i += len(name) + 1
+				continue
+			}
+		case '&':
+			if m := entityRe.FindString(s[i:]); m != "" {
+				buf = append(buf, html.UnescapeString(m)...)
+				i += len(m)
+				continue
+			}
+		case '\n':
+			// Trailing spaces only mark a hard break, which every newline
+			// already is in A4Code.
+			for len(buf) > 0 && (buf[len(buf)-1] == ' ' || buf[len(buf)-1] == '\t') {
+				buf = buf[:len(buf)-1]
+			}
+		}
+		buf = append(buf, s[i])
+		i++
+	}
+	flush()
+	return nodes, len(s), false
+}
+
+// attempt parses from pos up to closer, remembering failures so the rest
+// of the source is only scanned once for each closer.
+func (p *inlineParser) attempt(pos int, closer string, depth int) ([]ast.Node, int, bool) {
+	if from, ok := p.failedFrom[closer]; (ok && pos >= from) || pos >= p.lastCloseOf(closer) {
+		return nil, pos, false
+	}
+	nodes, end, ok := p.parse(pos, closer, depth)
+	if !ok {
+		if from, seen := p.failedFrom[closer]; !seen || pos < from {
+			p.failedFrom[closer] = pos
+		}
+	}
+	return nodes, end, ok
+}
+
+// lastCloseOf returns the last offset at which closer could match, or -1.
+func (p *inlineParser) lastCloseOf(closer string) int {
+	if v, ok := p.lastClose[closer]; ok {
+		return v
+	}
+	last := -1
+	for i := len(p.src) - len(closer); i > 0; i-- {
+		if p.closes(i, closer) {
+			last = i
+			break
+		}
+	}
+	p.lastClose[closer] = last
+	return last
+}
+
+func (p *inlineParser) closes(i int, closer string) bool {
+	s := p.src
+	if closer[0] == '<' {
+		return hasPrefixFold(s[i:], closer)
+	}
+	if !strings.HasPrefix(s[i:], closer) || i == 0 || isSpace(s[i-1]) {
+		return false
+	}
+	if closer[0] == '_' {
+		after := i + len(closer)
+		if after < len(s) && isAlnum(s[after]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *inlineParser) canOpen(i, run int) bool {
+	s := p.src
+	after := i + run
+	if after >= len(s) || isSpace(s[after]) {
+		return false
+	}
+	if s[i] == '_' && i > 0 && isAlnum(s[i-1]) {
+		return false
+	}
+	return true
+}
+
+// emphasis parses strong or regular emphasis opened by the delimiter run at
+// i, preferring strong when the run allows it.
+func (p *inlineParser) emphasis(i, run, depth int) (ast.Node, int, bool) {
+	ch := p.src[i : i+1]
+	if run >= 2 {
+		if nodes, end, ok := p.attempt(i+2, ch+ch, depth+1); ok {
+			b := &ast.Bold{}
+			addChildren(b, nodes)
+			return b, end, true
+		}
+	}
+	if nodes, end, ok := p.attempt(i+1, ch, depth+1); ok {
+		it := &ast.Italic{}
+		addChildren(it, nodes)
+		return it, end, true
+	}
+	return nil, i, false
+}
+
+// codeSpan parses the code span opened by the backtick run at i.
+func (p *inlineParser) codeSpan(i int) (ast.Node, int, bool) {
+	s := p.src
+	run := runLength(s, i, '`')
+	for j := i + run; j < len(s); {
+		if s[j] != '`' {
+			j++
+			continue
+		}
+		n := runLength(s, j, '`')
+		if n == run {
+			value := strings.ReplaceAll(s[i+run:j], "\n", " ")
+			if len(value) >= 2 && value[0] == ' ' && value[len(value)-1] == ' ' && strings.Trim(value, " ") != "" {
+				value = value[1 : len(value)-1]
+			}
+			return &ast.Code{Value: value}, j + n, true
+		}
+		j += n
+	}
+	return nil, i, false
+}
+
+// link parses an inline link whose text opens with the bracket at i,
+// returning the raw text and destination.
+func (p *inlineParser) link(i int) (text, dest string, end int, ok bool) {
+	s := p.src
+	j, ok := p.matchingBracket(i)
+	if !ok || j+1 >= len(s) || s[j+1] != '(' {
+		return "", "", i, false
+	}
+	text = s[i+1 : j]
+	k := skipSpace(s, j+2)
+	var raw []byte
+	if k < len(s) && s[k] == '<' {
+		k++
+		for ; k < len(s) && s[k] != '>'; k++ {
+			if s[k] == '\n' || s[k] == '<' {
+				return "", "", i, false
+			}
+			raw = append(raw, s[k])
+		}
+		if k >= len(s) {
+			return "", "", i, false
+		}
+		k++
+	} else {
+		parens := 0
+	dest:
+		for ; k < len(s); k++ {
+			c := s[k]
+			switch {
+			case c == '\\' && k+1 < len(s) && isASCIIPunct(s[k+1]):
+				raw = append(raw, c, s[k+1])
+				k++
+				continue
+			case c == '(':
+				parens++
+				if parens > maxLinkParens {
+					return "", "", i, false
+				}
+			case c == ')':
+				if parens == 0 {
+					break dest
+				}
+				parens--
+			case isSpace(c):
+				break dest
+			}
+			raw = append(raw, c)
+		}
+	}
+	k = skipSpace(s, k)
+	if k < len(s) && (s[k] == '"' || s[k] == '\'' || s[k] == '(') {
+		closeTitle := s[k]
+		if closeTitle == '(' {
+			closeTitle = ')'
+		}
+		k++
+		for k < len(s) && s[k] != closeTitle {
+			if s[k] == '\\' {
+				k++
+			}
+			k++
+		}
+		k = skipSpace(s, k+1)
+	}
+	if k >= len(s) || s[k] != ')' {
+		return "", "", i, false
+	}
+	return text, unescapeDestination(string(raw)), k + 1, true
+}
+
+// matchingBracket returns the offset of the ']' closing the '[' at i,
+// ignoring escaped brackets and those inside code spans.
+func (p *inlineParser) matchingBracket(i int) (int, bool) {
+	if p.brackets == nil {
+		p.brackets = map[int]int{}
+		var open []int
+		s := p.src
+		for j := 0; j < len(s); j++ {
+			switch s[j] {
+			case '\\':
+				j++
+			case '`':
+				if _, next, ok := p.codeSpan(j); ok {
+					j = next - 1
+				} else {
+					j += runLength(s, j, '`') - 1
+				}
+			case '[':
+				open = append(open, j)
+			case ']':
+				if len(open) > 0 {
+					p.brackets[open[len(open)-1]] = j
+					open = open[:len(open)-1]
+				}
+			}
+		}
+	}
+	j, ok := p.brackets[i]
+	return j, ok
+}
+
+// htmlElement parses one of inlineTags starting at i.
+func (p *inlineParser) htmlElement(i, depth int) (ast.Node, int, bool) {
+	s := p.src
+	for _, t := range inlineTags {
+		if !hasPrefixFold(s[i:], t.open) {
+			continue
+		}
+		start := i + len(t.open)
+		var c ast.Container
+		switch {
+		case t.node != nil:
+			c = t.node()
+		default:
+			body := s[start:]
+			c = newHTMLContainerNode(htmlContainer{t.open, t.close}, &body)
+			start = len(s) - len(body)
+		}
+		nodes, end, ok := p.attempt(start, t.close, depth+1)
+		if !ok {
+			return nil, i, false
+		}
+		addChildren(c, nodes)
+		return c, end, true
+	}
+	return nil, i, false
+}
+
+// mentionName returns the username of an @mention at i using the rules of
+// the A4Code parser: it must not follow a word character, may not appear in
+// a link and drops trailing dots and dashes.
+func (p *inlineParser) mentionName(i int) string {
+	s := p.src
+	if p.inLink || (i > 0 && isMentionWordByte(s[i-1])) {
+		return ""
+	}
+	n := 0
+	for n < maxMentionLength && i+1+n < len(s) && isMentionNameByte(s[i+1+n]) {
+		n++
+	}
+	name := strings.TrimRight(s[i+1:i+1+n], ".-")
+	return name
+}
+
+// maxLinkParens bounds nested parentheses in a link destination as
+// CommonMark does.
+const maxLinkParens = 32
+
+// maxMentionLength matches the width of users.username.
+const maxMentionLength = 255
+
+func isMentionWordByte(b byte) bool {
+	return b == '_' || isAlnum(b)
+}
+
+func isMentionNameByte(b byte) bool {
+	return isMentionWordByte(b) || b == '.' || b == '-'
+}
+
+func isAlnum(b byte) bool {
+	return ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n'
+}
+
+func isASCIIPunct(b byte) bool {
+	return strings.IndexByte("!\"#$%&'()*+,-./:;<=>?@[\\]^_`{|}~", b) >= 0
+}
+
+func runLength(s string, i int, c byte) int {
+	n := 0
+	for i+n < len(s) && s[i+n] == c {
+		n++
+	}
+	return n
+}
+
+func skipSpace(s string, i int) int {
+	for i < len(s) && isSpace(s[i]) {
+		i++
+	}
+	return i
+}
+
+// unescapeDestination removes backslash escapes and decodes entities in a
+// link destination.
+func unescapeDestination(raw string) string {
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '\\' && i+1 < len(raw) && isASCIIPunct(raw[i+1]) {
+			i++
+		}
+		b.WriteByte(raw[i])
+	}
+	return html.UnescapeString(b.String())
+}
@@ -0,0 +1,513 @@
+package markdown
+
+import (
+	"html"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/arran4/goa4web/a4code/ast"
+)
+
+// maxNesting bounds how deeply inline spans and HTML containers may nest so
+// hostile input cannot exhaust the stack.
+const maxNesting = 64
+
+// Parse reads Markdown from r and builds an A4Code syntax tree which any
+// ast.Generator can render, including format for A4Code source.
+//
+// The CommonMark block and inline syntax in common use is supported along
+// with GitHub pipe tables. Line breaks inside a paragraph are kept since
+// A4Code treats every newline as a break. Headings become bold lines as
+// A4Code has no headings. The HTML written by Generator for underline,
+// superscript, subscript, quotes and spoilers is read back into those nodes;
+// any other HTML is kept as text.
+func Parse(r io.Reader) (*ast.Root, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseString(string(b))
+}
+
+// ParseString parses Markdown held in s. See Parse.
+func ParseString(s string) (*ast.Root, error) {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = strings.ReplaceAll(s, "\r", "\n")
+	root := &ast.Root{}
+	addChildren(root, parseBlocks(strings.Split(s, "\n"), 0))
+	return root, nil
+}
+
+// addChildren appends nodes to c, merging neighbouring text.
+func addChildren(c ast.Container, nodes []ast.Node) {
+	for _, n := range nodes {
+		if t, ok := n.(*ast.Text); ok {
+			children := c.GetChildren()
+			if len(children) > 0 {
+				if last, ok := children[len(children)-1].(*ast.Text); ok {
+					last.Value += t.Value
+					continue
+				}
+			}
+		}
+		c.AddChild(n)
+	}
+}
+
+var (
+	atxHeadingRe    = regexp.MustCompile(`^ {0,3}#{1,6}(?:[ \t]+|$)`)
+	atxClosingRe    = regexp.MustCompile(`(?:^|[ \t]+)#+[ \t]*$`)
+	thematicBreakRe = regexp.MustCompile(`^ {0,3}(?:(?:-[ \t]*){3,}|(?:\*[ \t]*){3,}|(?:_[ \t]*){3,})$`)
+	listItemRe      = regexp.MustCompile(`^( {0,3})([-*+]|[0-9]{1,9}[.)])([ \t]+|$)`)
+	fenceRe         = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*([^`]*)$")
+	delimiterRowRe  = regexp.MustCompile(`^[ \t]*\|?[ \t]*:?-+:?[ \t]*(?:\|[ \t]*:?-+:?[ \t]*)*\|?[ \t]*$`)
+)
+
+func isBlank(line string) bool { return strings.TrimSpace(line) == "" }
+
+// indentOf returns the width of the leading whitespace of line, counting a
+// tab as four columns.
+func indentOf(line string) int {
+	n := 0
+	for _, c := range line {
+		switch c {
+		case ' ':
+			n++
+		case '\t':
+			n += 4 - n%4
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// stripIndent removes up to width columns of leading whitespace.
+func stripIndent(line string, width int) string {
+	col := 0
+	for i, c := range line {
+		if col >= width {
+			return line[i:]
+		}
+		switch c {
+		case ' ':
+			col++
+		case '\t':
+			col += 4 - col%4
+			if col > width {
+				return strings.Repeat(" ", col-width) + line[i+1:]
+			}
+		default:
+			return line[i:]
+		}
+	}
+	return ""
+}
+
+type listMarker struct {
+	ordered bool
+	// kind is the bullet character or the ordered delimiter.
+	kind  byte
+	start string
+	// width is the indent of the item content.
+	width int
+	empty bool
+}
+
+func parseListMarker(line string) (listMarker, bool) {
+	m := listItemRe.FindStringSubmatch(line)
+	if m == nil {
+		return listMarker{}, false
+	}
+	lm := listMarker{width: len(m[1]) + len(m[2]) + len(m[3])}
+	marker := m[2]
+	if c := marker[len(marker)-1]; c == '.' || c == ')' {
+		lm.ordered = true
+		lm.kind = c
+		lm.start = marker[:len(marker)-1]
+	} else {
+		lm.kind = marker[0]
+	}
+	rest := line[len(m[0]):]
+	lm.empty = isBlank(rest)
+	switch {
+	case lm.empty:
+		lm.width = len(m[1]) + len(m[2]) + 1
+	case len(m[3]) > 4:
+		// Content indented this far is code inside the item.
+		lm.width = len(m[1]) + len(m[2]) + 1
+	}
+	return lm, true
+}
+
+func isTableStart(lines []string, i int) bool {
+	return i+1 < len(lines) && strings.Contains(lines[i], "|") && delimiterRowRe.MatchString(lines[i+1]) &&
+		strings.Contains(lines[i+1], "-") && len(splitRow(lines[i])) == len(splitRow(lines[i+1]))
+}
+
+// interruptsParagraph reports whether lines[i] starts a block that ends an
+// open paragraph.
+func interruptsParagraph(lines []string, i int) bool {
+	line := lines[i]
+	if isBlank(line) || indentOf(line) >= 4 {
+		return isBlank(line)
+	}
+	if fenceRe.MatchString(line) || atxHeadingRe.MatchString(line) || thematicBreakRe.MatchString(line) {
+		return true
+	}
+	if strings.HasPrefix(strings.TrimLeft(line, " "), ">") {
+		return true
+	}
+	if lm, ok := parseListMarker(line); ok && !lm.empty && (!lm.ordered || lm.start == "1") {
+		return true
+	}
+	return isTableStart(lines, i)
+}
+
+// parseBlocks turns lines into block nodes separated by newline text that
+// mirrors the line breaks of the source.
+func parseBlocks(lines []string, depth int) []ast.Node {
+	var out []ast.Node
+	blank := 0
+	started := false
+	emit := func(nodes ...ast.Node) {
+		if started {
+			out = append(out, &ast.Text{Value: strings.Repeat("\n", blank+1)})
+		}
+		out = append(out, nodes...)
+		started = true
+		blank = 0
+	}
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		if isBlank(line) {
+			blank++
+			i++
+			continue
+		}
+		indent := indentOf(line)
+
+		if indent >= 4 {
+			var code []string
+			j := i
+			for j < len(lines) && (isBlank(lines[j]) || indentOf(lines[j]) >= 4) {
+				code = append(code, stripIndent(lines[j], 4))
+				j++
+			}
+			for len(code) > 0 && isBlank(code[len(code)-1]) {
+				code = code[:len(code)-1]
+				j--
+			}
+			emit(&ast.Code{Value: strings.Join(code, "\n")})
+			i = j
+			continue
+		}
+
+		if m := fenceRe.FindStringSubmatch(line); m != nil {
+			fence := m[2]
+			lang := strings.Fields(m[3])
+			var code []string
+			j := i + 1
+			for ; j < len(lines); j++ {
+				trimmed := strings.TrimSpace(lines[j])
+				if indentOf(lines[j]) < 4 && strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+					j++
+					break
+				}
+				code = append(code, stripIndent(lines[j], len(m[1])))
+			}
+			value := strings.Join(code, "\n")
+			if len(lang) > 0 {
+				emit(&ast.CodeIn{Language: lang[0], Value: value})
+			} else {
+				emit(&ast.Code{Value: value})
+			}
+			i = j
+			continue
+		}
+
+		if loc := atxHeadingRe.FindStringIndex(line); loc != nil {
+			text := atxClosingRe.ReplaceAllString(strings.TrimSpace(line[loc[1]:]), "")
+			b := &ast.Bold{}
+			addChildren(b, parseInline(text, depth))
+			emit(b)
+			i++
+			continue
+		}
+
+		if thematicBreakRe.MatchString(line) {
+			emit(&ast.HR{})
+			i++
+			continue
+		}
+
+		if strings.HasPrefix(strings.TrimLeft(line, " "), ">") {
+			var inner []string
+			j := i
+			for j < len(lines) {
+				l := strings.TrimLeft(lines[j], " ")
+				if !strings.HasPrefix(l, ">") || indentOf(lines[j]) >= 4 {
+					break
+				}
+				l = strings.TrimPrefix(l[1:], " ")
+				inner = append(inner, l)
+				j++
+			}
+			q := &ast.Quote{}
+			if depth < maxNesting {
+				addChildren(q, parseBlocks(inner, depth+1))
+			} else {
+				addChildren(q, []ast.Node{&ast.Text{Value: strings.Join(inner, "\n")}})
+			}
+			emit(q)
+			i = j
+			continue
+		}
+
+		if lm, ok := parseListMarker(line); ok {
+			list, j := parseList(lines, i, lm, depth)
+			emit(list)
+			i = j
+			continue
+		}
+
+		if isTableStart(lines, i) {
+			table, j := parseTable(lines, i, depth)
+			emit(table)
+			i = j
+			continue
+		}
+
+		if node, j, ok := parseHTMLContainer(lines, i, depth); ok {
+			emit(node)
+			i = j
+			continue
+		}
+
+		var para []string
+		j := i
+		for j < len(lines) && (j == i || !interruptsParagraph(lines, j)) {
+			para = append(para, strings.TrimLeft(lines[j], " \t"))
+			j++
+		}
+		text := strings.Join(para, "\n")
+		text = strings.TrimRight(text, " \t")
+		emit(parseInline(text, depth)...)
+		i = j
+	}
+	return out
+}
+
+// parseList reads the list starting at lines[i] and returns it with the
+// index of the first line after it.
+func parseList(lines []string, i int, first listMarker, depth int) (*ast.List, int) {
+	list := &ast.List{Ordered: first.ordered}
+	lm := first
+	for i < len(lines) {
+		item := []string{""}
+		if !lm.empty {
+			item[0] = lines[i][lm.width:]
+		}
+		j := i + 1
+		for j < len(lines) {
+			l := lines[j]
+			if isBlank(l) {
+				// A blank line only continues the item when indented content
+				// follows.
+				k := j
+				for k < len(lines) && isBlank(lines[k]) {
+					k++
+				}
+				if k < len(lines) && indentOf(lines[k]) >= lm.width {
+					for ; j < k; j++ {
+						item = append(item, "")
+					}
+					continue
+				}
+				break
+			}
+			if indentOf(l) >= lm.width {
+				item = append(item, stripIndent(l, lm.width))
+				j++
+				continue
+			}
+			if _, ok := parseListMarker(l); ok || interruptsParagraph(lines, j) || isBlank(item[len(item)-1]) {
+				break
+			}
+			// Lazy continuation of the item's paragraph.
+			item = append(item, strings.TrimLeft(l, " \t"))
+			j++
+		}
+		li := &ast.ListItem{}
+		if depth < maxNesting {
+			addChildren(li, parseBlocks(item, depth+1))
+		} else {
+			addChildren(li, []ast.Node{&ast.Text{Value: strings.Join(item, "\n")}})
+		}
+		list.AddChild(li)
+
+		// Continue with the next item of the same list, allowing blank lines
+		// between items.
+		k := j
+		for k < len(lines) && isBlank(lines[k]) {
+			k++
+		}
+		next, ok := listMarker{}, false
+		if k < len(lines) && indentOf(lines[k]) < 4 {
+			next, ok = parseListMarker(lines[k])
+		}
+		if !ok || next.ordered != first.ordered || next.kind != first.kind || thematicBreakRe.MatchString(lines[k]) {
+			return list, j
+		}
+		lm = next
+		i = k
+	}
+	return list, i
+}
+
+// splitRow splits a pipe table row into its raw cells.
+func splitRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+	var cells []string
+	var b strings.Builder
+	inCode := false
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '\\' && i+1 < len(line):
+			b.WriteByte(c)
+			b.WriteByte(line[i+1])
+			i++
+		case c == '`':
+			inCode = !inCode
+			b.WriteByte(c)
+		case c == '|' && !inCode:
+			cells = append(cells, strings.TrimSpace(b.String()))
+			b.Reset()
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return append(cells, strings.TrimSpace(b.String()))
+}
+
+func parseTable(lines []string, i int, depth int) (*ast.Table, int) {
+	table := &ast.Table{}
+	addRow := func(line string, header bool) {
+		row := &ast.TableRow{}
+		for _, raw := range splitRow(line) {
+			cell := &ast.TableCell{Header: header}
+			addChildren(cell, parseInline(raw, depth))
+			row.AddChild(cell)
+		}
+		table.AddChild(row)
+	}
+	addRow(lines[i], true)
+	j := i + 2
+	for j < len(lines) && !isBlank(lines[j]) && strings.Contains(lines[j], "|") {
+		addRow(lines[j], false)
+		j++
+	}
+	return table, j
+}
+
+// htmlContainer describes an HTML element Generator writes for a container.
+type htmlContainer struct {
+	open, close string
+}
+
+var htmlContainers = []htmlContainer{
+	{"<blockquote>", "</blockquote>"},
+	{"<details>", "</details>"},
+}
+
+const quoteOfPrefix, quoteOfSuffix = "<p>Quote of ", ":</p>"
+
+// parseHTMLContainer reads a quote or spoiler written as HTML that starts a
+// line and may span several, parsing its body as Markdown blocks. ok is false
+// when lines[i] is not such an element or other text follows its end.
+func parseHTMLContainer(lines []string, i int, depth int) (node ast.Node, next int, ok bool) {
+	if depth >= maxNesting {
+		return nil, i, false
+	}
+	line := strings.TrimLeft(lines[i], " ")
+	for _, hc := range htmlContainers {
+		if !hasPrefixFold(line, hc.open) {
+			continue
+		}
+		rest := strings.Join(append([]string{line}, lines[i+1:]...), "\n")
+		end, ok := matchClose(rest, len(hc.open), hc)
+		if !ok {
+			return nil, i, false
+		}
+		after := rest[end+len(hc.close):]
+		tail, _, _ := strings.Cut(after, "\n")
+		if !isBlank(tail) {
+			return nil, i, false
+		}
+		body := rest[len(hc.open):end]
+		c := newHTMLContainerNode(hc, &body)
+		addChildren(c, parseBlocks(strings.Split(body, "\n"), depth+1))
+		used := strings.Count(rest[:end+len(hc.close)], "\n")
+		return c, i + used + 1, true
+	}
+	return nil, i, false
+}
+
+// newHTMLContainerNode returns the node for hc, consuming a quote or spoiler
+// header from the start of body.
+func newHTMLContainerNode(hc htmlContainer, body *string) ast.Container {
+	if hc.open == "<details>" {
+		if hasPrefixFold(*body, "<summary>") {
+			if end := indexFold(*body, "</summary>"); end >= 0 {
+				*body = (*body)[end+len("</summary>"):]
+			}
+		}
+		return &ast.Spoiler{}
+	}
+	if hasPrefixFold(*body, quoteOfPrefix) {
+		if end := indexFold(*body, quoteOfSuffix); end >= 0 && !strings.Contains((*body)[:end], "\n") {
+			name := html.UnescapeString((*body)[len(quoteOfPrefix):end])
+			*body = (*body)[end+len(quoteOfSuffix):]
+			return &ast.QuoteOf{Name: name}
+		}
+	}
+	return &ast.Quote{}
+}
+
+// matchClose finds the close tag balancing hc.open in s from offset from.
+func matchClose(s string, from int, hc htmlContainer) (int, bool) {
+	level := 1
+	for i := from; i < len(s); i++ {
+		if s[i] != '<' {
+			continue
+		}
+		switch {
+		case hasPrefixFold(s[i:], hc.close):
+			level--
+			if level == 0 {
+				return i, true
+			}
+		case hasPrefixFold(s[i:], hc.open):
+			level++
+		}
+	}
+	return 0, false
+}
+
+func hasPrefixFold(s, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
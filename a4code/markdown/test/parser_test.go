@@ -0,0 +1,86 @@
+package markdown_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/arran4/goa4web/a4code"
+	"github.com/arran4/goa4web/a4code/ast"
+	"github.com/arran4/goa4web/a4code/markdown"
+	"golang.org/x/tools/txtar"
+)
+
+func generateMarkdown(t *testing.T, root *ast.Root) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := ast.Generate(&buf, root, markdown.NewGenerator()); err != nil {
+		t.Fatalf("Generate error: %v", err)
+	}
+	return buf.String()
+}
+
+// TestParserRoundTrip parses the Markdown produced for each generator
+// fixture, converts it to A4Code and back and expects the same Markdown.
+func TestParserRoundTrip(t *testing.T) {
+	data, err := testData.ReadFile("tests.txtar")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	for _, file := range txtar.Parse(data).Files {
+		name, ok := strings.CutSuffix(file.Name, ".expect.txt")
+		if !ok {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			md := strings.TrimSuffix(string(file.Data), "\n")
+			code, err := a4code.FromMarkdown(md)
+			if err != nil {
+				t.Fatalf("FromMarkdown error: %v", err)
+			}
+			root, err := a4code.ParseString(code)
+			if err != nil {
+				t.Fatalf("ParseString(%q) error: %v", code, err)
+			}
+			if got := generateMarkdown(t, root); got != md {
+				t.Errorf("round trip via %q:\ngot:  %q\nwant: %q", code, got, md)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		md   string
+		want string
+	}{
+		{"heading", "# Title\n\nBody", "[b Title]\n\nBody"},
+		{"emphasis", "*a* _b_ __c__ snake_case_name", `[i a] [i b] [b c] snake\_case\_name`},
+		{"hard break", "one  \ntwo\\\nthree", "one\ntwo\nthree"},
+		{"escapes", `\*not\* 5 \[x\] &amp; &copy;`, `\*not\* 5 \[x\] & ©`},
+		{"link", `[the *site*](http://example.com/a_(b) "Title")`, "[a=http://example.com/a_(b) the [i site]]"},
+		{"autolink", "<https://example.com>", `[a=https://example.com https:\/\/example.com]`},
+		{"unmatched", "**open [x](", `\*\*open \[x\](`},
+		{"mention", "hi @bob. mail a@b.c [@x](/u)", `hi @bob. mail a@b.c [a=/u \@x]`},
+		{"blockquote", "> quoted\n> text\n\nafter", "[quote quoted\ntext]\n\nafter"},
+		{"indented code", "    x := 1\n    y := 2", "[code\nx := 1\ny := 2]"},
+		{"tilde fence", "~~~\nraw *x*\n~~~", "[code\nraw *x*]"},
+		{"loose list", "- a\n\n- b\n\n  more\n* c", "[list [li a][li b\n\nmore]]\n[list [li c]]"},
+		{"lazy list", "1. wrapped\ncontinued\n2) other", "[olist [li wrapped\ncontinued]]\n[olist [li other]]"},
+		{"table", "a | b\n--|--\n1 | `x|y`\n\nafter", "[table [row [th a][th b]][row [cell 1][cell [code\nx|y]]]]\n\nafter"},
+		{"html", "<sup>2</sup> <span>x</span> line<br>break", "[sup 2] <span>x<\\/span> line\nbreak"},
+		{"html quote", "<blockquote><p>Quote of bob:</p>- a\n- b\n</blockquote>", "[quoteof \"bob\" [list [li a][li b]]]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a4code.FromMarkdown(tt.md)
+			if err != nil {
+				t.Fatalf("FromMarkdown error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("FromMarkdown(%q)\ngot:  %q\nwant: %q", tt.md, got, tt.want)
+			}
+		})
+	}
+}
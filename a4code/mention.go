@@ -0,0 +1,27 @@
+package a4code
+
+import (
+	"strings"
+
+	"github.com/arran4/goa4web/a4code/ast"
+)
+
+// Mentions returns the usernames mentioned with @username in s, in the order
+// they first appear. Repeats differing only in case are dropped.
+func Mentions(s string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for n := range Stream(strings.NewReader(s)) {
+		m, ok := n.(*ast.Mention)
+		if !ok {
+			continue
+		}
+		key := strings.ToLower(m.Username)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		names = append(names, m.Username)
+	}
+	return names
+}
@@ -0,0 +1,53 @@
+package a4code
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseMention(t *testing.T) {
+	tests := []struct {
+		input string
+		html  string
+	}{
+		{"hi @bob.", `<span data-start-pos="0" data-end-pos="3">hi </span><a class="a4code-mention" href="/user/profile/bob" data-start-pos="3" data-end-pos="7">@bob</a><span data-start-pos="7" data-end-pos="8">.</span>`},
+		{"mail a@b.com", `<span data-start-pos="0" data-end-pos="12">mail a@b.com</span>`},
+		{`\@bob`, `<span data-start-pos="0" data-end-pos="4">@bob</span>`},
+		{"[a=http://example.com @bob]", `<a href="http://example.com" target="_BLANK" data-start-pos="0" data-end-pos="4"><span data-start-pos="0" data-end-pos="4">@bob</span></a>`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			root, err := ParseString(tt.input)
+			if err != nil {
+				t.Fatalf("parse error: %v", err)
+			}
+			if got := ToHTML(root); got != tt.html {
+				t.Errorf("got %q want %q", got, tt.html)
+			}
+		})
+	}
+}
+
+func TestMentionRoundTrip(t *testing.T) {
+	for _, input := range []string{"hi @bob", `\@bob`, "[b @alice-x]", "[a=http://example.com \\@bob]"} {
+		root, err := ParseString(input)
+		if err != nil {
+			t.Fatalf("parse error: %v", err)
+		}
+		if got := ToA4Code(root); got != input {
+			t.Errorf("round trip %q got %q", input, got)
+		}
+	}
+}
+
+func TestMentions(t *testing.T) {
+	got := Mentions("@bob and [quote @Alice said hi to @BOB] e@mail.com [a=http://x @carol]")
+	want := []string{"bob", "Alice"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v want %v", got, want)
+	}
+	if got := Mentions(strings.Repeat("x", 10)); got != nil {
+		t.Errorf("got %v want nil", got)
+	}
+}
@@ -196,13 +196,39 @@ func streamImpl(r io.Reader, yield func(ast.Node, int) bool) {
 				return
 			}
 			switch next {
-			case ' ', '[', ']', '=', '\\', '*', '/', '_':
+			case ' ', '[', ']', '=', '\\', '*', '/', '_', '@':
 				buf.WriteByte(next)
 			default:
 				buf.WriteByte('\\')
 				buf.WriteByte(next)
 			}
 			lastChar = next
+		case '@':
+			if name := peekMentionName(s, lastChar, stack); name != "" {
+				if !flush(1) {
+					return
+				}
+				for range name {
+					_, _ = s.ReadByte()
+				}
+				n := &ast.Mention{Username: name}
+				n.SetPos(visiblePos, visiblePos+len(name)+1)
+				visiblePos += len(name) + 1
+				if len(stack) > 0 {
+					p := stack[len(stack)-1]
+					p.AddChild(n)
+				}
+				if !yield(n, len(stack)+1) {
+					return
+				}
+				lastChar = name[len(name)-1]
+				continue
+			}
+			if textStart == -1 {
+				textStart = s.pos - 1
+			}
+			buf.WriteByte(ch)
+			lastChar = ch
 		default:
 			if textStart == -1 {
 				textStart = s.pos - 1
@@ -213,6 +239,45 @@ func streamImpl(r io.Reader, yield func(ast.Node, int) bool) {
 	}
 }
 
+// maxMentionLength matches the width of users.username.
+const maxMentionLength = 255
+
+func isMentionWordByte(b byte) bool {
+	return b == '_' || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+func isMentionNameByte(b byte) bool {
+	return isMentionWordByte(b) || b == '.' || b == '-'
+}
+
+// peekMentionName returns the username following an '@' without consuming it.
+// Mentions only start after a non-word character, so e-mail addresses stay
+// text, and are not recognised inside links. Trailing dots and dashes are
+// treated as punctuation.
+func peekMentionName(s *scanner, lastChar byte, stack []ast.Container) string {
+	if isMentionWordByte(lastChar) {
+		return ""
+	}
+	for _, c := range stack {
+		if _, ok := c.(*ast.Link); ok {
+			return ""
+		}
+	}
+	n := 0
+	for n < maxMentionLength {
+		b, _ := s.r.Peek(n + 1)
+		if len(b) < n+1 || !isMentionNameByte(b[n]) {
+			break
+		}
+		n++
+	}
+	if n == 0 {
+		return ""
+	}
+	b, _ := s.r.Peek(n)
+	return strings.TrimRight(string(b), ".-")
+}
+
 // Parse reads markup from r and returns the root node.
 func Parse(r io.Reader) (*ast.Root, error) {
 	var nodes []ast.Node
@@ -394,6 +459,20 @@ func parseCommand(s *scanner, stack []ast.Container, depth int, yield func(ast.N
 		createNode(n)
 	case "indent":
 		createNode(&ast.Indent{})
+	case "list", "ul":
+		createNode(&ast.List{})
+	case "olist", "ol":
+		createNode(&ast.List{Ordered: true})
+	case "li", "item":
+		createNode(&ast.ListItem{})
+	case "table":
+		createNode(&ast.Table{})
+	case "row", "tr":
+		createNode(&ast.TableRow{})
+	case "cell", "td":
+		createNode(&ast.TableCell{})
+	case "th":
+		createNode(&ast.TableCell{Header: true})
 	case "hr":
 		n := &ast.HR{}
 		if ch, err := s.ReadByte(); err == nil {
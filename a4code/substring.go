@@ -80,6 +80,14 @@ func filterNodes(nodes []ast.Node, start, end int, pos *int) []ast.Node {
 				kept = append(kept, t)
 			}
 
+		case *ast.Mention:
+			// Mentions are kept whole when any part of them is in range.
+			l := len(t.Username) + 1
+			if *pos+l > start {
+				kept = append(kept, t)
+			}
+			*pos += l
+
 		case *ast.Code:
 			// Code block treated as text for length purposes?
 			// Usually code blocks have visible content.
@@ -134,6 +142,16 @@ func setChildren(c ast.Container, children []ast.Node) {
 		t.Children = children
 	case *ast.Indent:
 		t.Children = children
+	case *ast.List:
+		t.Children = children
+	case *ast.ListItem:
+		t.Children = children
+	case *ast.Table:
+		t.Children = children
+	case *ast.TableRow:
+		t.Children = children
+	case *ast.TableCell:
+		t.Children = children
 	case *ast.Custom:
 		t.Children = children
 	}
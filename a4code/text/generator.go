@@ -3,6 +3,7 @@ package text
 import (
 	"bytes"
 	"io"
+	"strconv"
 
 	"github.com/arran4/goa4web/a4code/ast"
 )
@@ -79,6 +80,11 @@ type Generator struct {
 	QuotePrefix     string
 	QuoteHeaderFunc func(name string) string
 	HRString        string
+	// ListMarkers prefixes list items with "- " or their number and indents
+	// their continuation lines.
+	ListMarkers bool
+	// CellSeparator is written between the cells of a table row.
+	CellSeparator string
 }
 
 func NewGenerator() *Generator {
@@ -87,12 +93,14 @@ func NewGenerator() *Generator {
 		QuoteHeaderFunc: func(name string) string {
 			return "> " + name + " wrote:\n"
 		},
-		HRString: "---\n",
+		HRString:      "---\n",
+		ListMarkers:   true,
+		CellSeparator: " | ",
 	}
 }
 
 func NewCleanGenerator() *Generator {
-	return &Generator{}
+	return &Generator{CellSeparator: " "}
 }
 
 func (g *Generator) Root(w io.Writer, n *ast.Root) error {
@@ -147,6 +155,11 @@ func (g *Generator) Image(w io.Writer, n *ast.Image) error {
 	return nil
 }
 
+func (g *Generator) Mention(w io.Writer, n *ast.Mention) error {
+	_, err := io.WriteString(w, "@"+n.Username)
+	return err
+}
+
 func (g *Generator) Code(w io.Writer, n *ast.Code) error {
 	_, err := io.WriteString(w, n.Value)
 	return err
@@ -206,6 +219,89 @@ func (g *Generator) HR(w io.Writer, n *ast.HR) error {
 	return nil
 }
 
+// startLine moves to the start of a new line unless already there.
+func startLine(w io.Writer) {
+	if lt, ok := w.(lineTracker); ok && !lt.isStartOfLine() {
+		_, _ = io.WriteString(w, "\n")
+	}
+}
+
+func (g *Generator) List(w io.Writer, n *ast.List) error {
+	startLine(w)
+	num := 0
+	for _, c := range n.Children {
+		if ast.IsBlankText(c) {
+			continue
+		}
+		item, ok := c.(*ast.ListItem)
+		if !ok {
+			if err := ast.Generate(w, c, g); err != nil {
+				return err
+			}
+			startLine(w)
+			continue
+		}
+		num++
+		if !g.ListMarkers {
+			if err := g.ListItem(w, item); err != nil {
+				return err
+			}
+			startLine(w)
+			continue
+		}
+		marker := "- "
+		if n.Ordered {
+			marker = strconv.Itoa(num) + ". "
+		}
+		_, _ = io.WriteString(w, marker)
+		pw := &PrefixWriter{w: w, prefix: bytes.Repeat([]byte{' '}, len(marker))}
+		if err := g.ListItem(pw, item); err != nil {
+			return err
+		}
+		startLine(pw)
+	}
+	return nil
+}
+
+func (g *Generator) ListItem(w io.Writer, n *ast.ListItem) error {
+	return g.visitChildren(w, n.Children)
+}
+
+func (g *Generator) Table(w io.Writer, n *ast.Table) error {
+	startLine(w)
+	for _, c := range n.Children {
+		if ast.IsBlankText(c) {
+			continue
+		}
+		if err := ast.Generate(w, c, g); err != nil {
+			return err
+		}
+		startLine(w)
+	}
+	return nil
+}
+
+func (g *Generator) TableRow(w io.Writer, n *ast.TableRow) error {
+	first := true
+	for _, c := range n.Children {
+		if ast.IsBlankText(c) {
+			continue
+		}
+		if !first {
+			_, _ = io.WriteString(w, g.CellSeparator)
+		}
+		first = false
+		if err := ast.Generate(w, c, g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *Generator) TableCell(w io.Writer, n *ast.TableCell) error {
+	return g.visitChildren(w, n.Children)
+}
+
 func (g *Generator) Custom(w io.Writer, n *ast.Custom) error {
 	return g.visitChildren(w, n.Children)
 }
@@ -0,0 +1,734 @@
+// Command gen-postgres-adapter writes internal/db/postgres_adapter_gen.go, an
+// implementation of db.Querier backed by the sqlc generated
+// internal/dbpostgres package.
+//
+// Unlike the SQLite adapter every parameter and result field must have a
+// counterpart in the other package; a missing field is reported as an error
+// rather than silently left at its zero value.
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type FieldInfo struct {
+	Name string
+	Type string
+}
+
+type StructInfo struct {
+	Name   string
+	Fields []FieldInfo
+}
+
+type ParamInfo struct {
+	Name string
+	Type string
+}
+
+type MethodInfo struct {
+	Name       string
+	Params     []ParamInfo
+	ReturnList []string
+}
+
+// pkg describes one side of the conversion.
+type pkg struct {
+	structs map[string]StructInfo
+	methods map[string]MethodInfo
+	aliases map[string]string
+	// kinds maps a query name to its sqlc command, such as "one" or
+	// "execlastid", read from the "-- name:" line of the query constant.
+	kinds map[string]string
+}
+
+func main() {
+	dbDir := "internal/db"
+	pgDir := "internal/dbpostgres"
+
+	dbPkg, err := parsePackage(dbDir)
+	if err != nil {
+		log.Fatalf("failed to parse db package: %v", err)
+	}
+	pgPkg, err := parsePackage(pgDir)
+	if err != nil {
+		log.Fatalf("failed to parse dbpostgres package: %v", err)
+	}
+
+	code, err := generateAdapter(dbPkg, pgPkg)
+	if err != nil {
+		log.Fatalf("failed to generate adapter: %v", err)
+	}
+
+	outPath := filepath.Join(dbDir, "postgres_adapter_gen.go")
+	formatted, err := format.Source(code)
+	if err != nil {
+		_ = os.WriteFile(outPath+".err", code, 0644)
+		log.Fatalf("failed to format generated code: %v\nSee %s.err", err, outPath)
+	}
+	if err := os.WriteFile(outPath, formatted, 0644); err != nil {
+		log.Fatalf("failed to write output: %v", err)
+	}
+	fmt.Printf("Successfully generated %s (%d bytes, %d methods)\n", outPath, len(formatted), len(dbPkg.methods))
+}
+
+func skipFile(name string) bool {
+	if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_gen.go") {
+		return true
+	}
+	return strings.HasPrefix(name, "factory")
+}
+
+func parsePackage(dir string) (*pkg, error) {
+	fset := token.NewFileSet()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	p := &pkg{
+		structs: map[string]StructInfo{},
+		methods: map[string]MethodInfo{},
+		aliases: map[string]string{},
+		kinds:   map[string]string{},
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || skipFile(entry.Name()) {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, entry.Name()), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if name, kind, ok := queryKind(lit.Value); ok {
+					p.kinds[name] = kind
+				}
+				return true
+			}
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			switch t := ts.Type.(type) {
+			case *ast.StructType:
+				var fields []FieldInfo
+				for _, f := range t.Fields.List {
+					typeStr := exprToString(f.Type)
+					if len(f.Names) == 0 {
+						fields = append(fields, FieldInfo{Name: typeStr, Type: typeStr})
+						continue
+					}
+					for _, name := range f.Names {
+						fields = append(fields, FieldInfo{Name: name.Name, Type: typeStr})
+					}
+				}
+				p.structs[ts.Name.Name] = StructInfo{Name: ts.Name.Name, Fields: fields}
+			case *ast.InterfaceType:
+				if ts.Name.Name != "Querier" {
+					return true
+				}
+				for _, m := range t.Methods.List {
+					ft, ok := m.Type.(*ast.FuncType)
+					if len(m.Names) == 0 || !ok {
+						continue
+					}
+					var params []ParamInfo
+					for _, prm := range ft.Params.List {
+						typeStr := exprToString(prm.Type)
+						if len(prm.Names) == 0 {
+							params = append(params, ParamInfo{Type: typeStr})
+							continue
+						}
+						for _, name := range prm.Names {
+							params = append(params, ParamInfo{Name: name.Name, Type: typeStr})
+						}
+					}
+					var rets []string
+					if ft.Results != nil {
+						for _, r := range ft.Results.List {
+							rets = append(rets, exprToString(r.Type))
+						}
+					}
+					p.methods[m.Names[0].Name] = MethodInfo{Name: m.Names[0].Name, Params: params, ReturnList: rets}
+				}
+			default:
+				p.aliases[ts.Name.Name] = exprToString(ts.Type)
+			}
+			return true
+		})
+	}
+	return p, nil
+}
+
+// queryKind reads the query name and sqlc command from a generated query
+// constant such as "-- name: GetUser :one".
+func queryKind(lit string) (name, kind string, ok bool) {
+	line, _, _ := strings.Cut(strings.Trim(lit, "`\""), "\n")
+	rest, found := strings.CutPrefix(line, "-- name: ")
+	if !found {
+		return "", "", false
+	}
+	name, kind, found = strings.Cut(strings.TrimSpace(rest), " :")
+	if !found {
+		return "", "", false
+	}
+	return name, kind, true
+}
+
+func exprToString(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.SelectorExpr:
+		return exprToString(t.X) + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + exprToString(t.X)
+	case *ast.ArrayType:
+		return "[]" + exprToString(t.Elt)
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.Ellipsis:
+		return "..." + exprToString(t.Elt)
+	default:
+		return fmt.Sprintf("%T", e)
+	}
+}
+
+const header = `// Code generated by cmd/gen-postgres-adapter. DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/arran4/goa4web/internal/dbpostgres"
+)
+
+// postgresInsertResult reports the key returned by an INSERT ... RETURNING
+// query through the sql.Result interface used by the MySQL queries. rows is
+// the number of rows the RETURNING clause produced, which for these single
+// row inserts matches the count in the command tag: 0 when ON CONFLICT DO
+// NOTHING skipped the row.
+type postgresInsertResult struct {
+	id   int64
+	rows int64
+}
+
+func (r postgresInsertResult) LastInsertId() (int64, error) { return r.id, nil }
+
+func (r postgresInsertResult) RowsAffected() (int64, error) { return r.rows, nil }
+
+// pgConv converts the loosely typed values used for untyped query parameters
+// and results. Conversions that fail yield the zero value and record the
+// first error so the caller can return it.
+type pgConv struct {
+	err error
+}
+
+func (c *pgConv) fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+// value unwraps driver.Valuer implementations such as sql.NullString so the
+// conversions below see the underlying value.
+func (c *pgConv) value(v any) any {
+	if vr, ok := v.(driver.Valuer); ok {
+		val, err := vr.Value()
+		if err != nil {
+			c.fail(fmt.Errorf("postgres adapter: %T: %w", v, err))
+			return nil
+		}
+		return val
+	}
+	return v
+}
+
+func (c *pgConv) toInt64(v any) int64 {
+	switch val := c.value(v).(type) {
+	case nil:
+		return 0
+	case int64:
+		return val
+	case int32:
+		return int64(val)
+	case int16:
+		return int64(val)
+	case int:
+		return int64(val)
+	case float64:
+		return int64(val)
+	case bool:
+		if val {
+			return 1
+		}
+		return 0
+	case []byte:
+		return c.toInt64(string(val))
+	case string:
+		if val == "" {
+			return 0
+		}
+		n, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			c.fail(fmt.Errorf("postgres adapter: cannot parse %q as int64: %w", val, err))
+		}
+		return n
+	default:
+		c.fail(fmt.Errorf("postgres adapter: unexpected type %T for int64", val))
+		return 0
+	}
+}
+
+func (c *pgConv) toString(v any) string {
+	switch val := c.value(v).(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func (c *pgConv) toNullString(v any) sql.NullString {
+	if v = c.value(v); v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: c.toString(v), Valid: true}
+}
+
+func (c *pgConv) toNullInt32(v any) sql.NullInt32 {
+	if v = c.value(v); v == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(c.toInt64(v)), Valid: true}
+}
+
+func (c *pgConv) toNullInt64(v any) sql.NullInt64 {
+	if v = c.value(v); v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: c.toInt64(v), Valid: true}
+}
+
+func (c *pgConv) toNullTime(v any) sql.NullTime {
+	switch val := c.value(v).(type) {
+	case nil:
+		return sql.NullTime{}
+	case time.Time:
+		return sql.NullTime{Time: val, Valid: true}
+	default:
+		c.fail(fmt.Errorf("postgres adapter: unexpected type %T for time", val))
+		return sql.NullTime{}
+	}
+}
+
+type postgresQuerier struct {
+	q  *dbpostgres.Queries
+	db DBTX
+}
+
+// NewPostgresQuerier constructs a db.Querier backed by the PostgreSQL query implementation.
+func NewPostgresQuerier(db DBTX) Querier {
+	return &postgresQuerier{q: dbpostgres.New(db), db: db}
+}
+
+`
+
+func generateAdapter(dbPkg, pgPkg *pkg) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+
+	var names []string
+	for name := range dbPkg.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := &generator{db: dbPkg, pg: pgPkg}
+	var errs []error
+	for _, name := range names {
+		pgM, ok := pgPkg.methods[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("method %s not found in dbpostgres.Querier", name))
+			continue
+		}
+		if err := g.method(&buf, dbPkg.methods[name], pgM); err != nil {
+			errs = append(errs, fmt.Errorf("method %s: %w", name, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type generator struct {
+	db *pkg
+	pg *pkg
+}
+
+func (g *generator) method(buf *bytes.Buffer, dbM, pgM MethodInfo) error {
+	if len(dbM.Params) != len(pgM.Params) {
+		return fmt.Errorf("parameter count mismatch: db has %d, postgres has %d", len(dbM.Params), len(pgM.Params))
+	}
+	var params, args []string
+	for i, p := range dbM.Params {
+		name := p.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		params = append(params, name+" "+p.Type)
+		if p.Type == "context.Context" {
+			args = append(args, name)
+			continue
+		}
+		expr, err := g.toPG(name, p.Type, pgM.Params[i].Type)
+		if err != nil {
+			return fmt.Errorf("param %s: %w", name, err)
+		}
+		args = append(args, expr)
+	}
+	// Conversions of untyped values go through a pgConv whose error is
+	// checked before the call and again after converting the result.
+	var pre []string
+	for i, a := range args {
+		if strings.Contains(a, "conv.") {
+			pre = append(pre, fmt.Sprintf("pgArg%d := %s", i, a))
+			args[i] = fmt.Sprintf("pgArg%d", i)
+		}
+	}
+	fmt.Fprintf(buf, "func (s *postgresQuerier) %s(%s) ", dbM.Name, strings.Join(params, ", "))
+	switch len(dbM.ReturnList) {
+	case 0:
+		buf.WriteString("{\n")
+	case 1:
+		fmt.Fprintf(buf, "%s {\n", dbM.ReturnList[0])
+	default:
+		fmt.Fprintf(buf, "(%s) {\n", strings.Join(dbM.ReturnList, ", "))
+	}
+	zero := ""
+	if len(dbM.ReturnList) > 1 {
+		zero = zeroValueOf(dbM.ReturnList[0]) + ", "
+	}
+	var ret string
+	if len(dbM.ReturnList) > 1 {
+		if len(pgM.ReturnList) != len(dbM.ReturnList) {
+			return fmt.Errorf("result count mismatch")
+		}
+		var err error
+		if ret, err = g.fromPG("res", dbM.ReturnList[0], pgM.ReturnList[0]); err != nil {
+			return fmt.Errorf("result: %w", err)
+		}
+	} else if len(pgM.ReturnList) > 1 {
+		return fmt.Errorf("postgres returns a value where db does not")
+	}
+	usesConv := len(pre) > 0 || strings.Contains(ret, "conv.")
+	if usesConv && len(dbM.ReturnList) == 0 {
+		return fmt.Errorf("conversion needs an error result")
+	}
+	if usesConv {
+		buf.WriteString("\tvar conv pgConv\n")
+	}
+	if len(pre) > 0 {
+		fmt.Fprintf(buf, "\t%s\n\tif conv.err != nil {\n\t\treturn %sconv.err\n\t}\n", strings.Join(pre, "\n\t"), zero)
+	}
+	call := fmt.Sprintf("s.q.%s(%s)", dbM.Name, strings.Join(args, ", "))
+	if len(dbM.ReturnList) <= 1 {
+		fmt.Fprintf(buf, "\treturn %s\n}\n\n", call)
+		return nil
+	}
+	fmt.Fprintf(buf, "\tres, err := %s\n", call)
+	if g.insertSkipped(dbM.Name) {
+		// MySQL reports an INSERT IGNORE that skipped the row as id 0; the
+		// RETURNING query used by PostgreSQL returns no row instead.
+		fmt.Fprintf(buf, "\tif errors.Is(err, sql.ErrNoRows) {\n\t\treturn %snil\n\t}\n", skippedInsertValue(dbM.ReturnList[0]))
+	}
+	fmt.Fprintf(buf, "\tif err != nil {\n\t\treturn %serr\n\t}\n", zero)
+	if strings.Contains(ret, "conv.") {
+		fmt.Fprintf(buf, "\tout := %s\n\tif conv.err != nil {\n\t\treturn %sconv.err\n\t}\n\treturn out, nil\n}\n\n", ret, zero)
+		return nil
+	}
+	fmt.Fprintf(buf, "\treturn %s, nil\n}\n\n", ret)
+	return nil
+}
+
+// insertSkipped reports whether name is a MySQL insert returning its new id
+// that PostgreSQL runs as an INSERT ... RETURNING query, where a row skipped
+// by ON CONFLICT DO NOTHING surfaces as sql.ErrNoRows.
+func (g *generator) insertSkipped(name string) bool {
+	switch g.db.kinds[name] {
+	case "execlastid", "execresult":
+		return g.pg.kinds[name] == "one"
+	}
+	return false
+}
+
+// skippedInsertValue is the result reported for an insert that added no row.
+func skippedInsertValue(typ string) string {
+	if typ == "sql.Result" {
+		return "postgresInsertResult{}, "
+	}
+	return zeroValueOf(typ) + ", "
+}
+
+func zeroValueOf(typ string) string {
+	if strings.HasPrefix(typ, "*") || strings.HasPrefix(typ, "[]") || strings.HasPrefix(typ, "interface") {
+		return "nil"
+	}
+	switch typ {
+	case "int", "int16", "int32", "int64", "float32", "float64":
+		return "0"
+	case "bool":
+		return "false"
+	case "string":
+		return `""`
+	case "sql.Result":
+		return "nil"
+	default:
+		return typ + "{}"
+	}
+}
+
+// scalar conversions from db (key[0]) to postgres (key[1]) types.
+var toPGConversions = map[[2]string]string{
+	{"interface{}", "string"}:            "conv.toString(%s)",
+	{"interface{}", "sql.NullString"}:    "conv.toNullString(%s)",
+	{"interface{}", "sql.NullInt32"}:     "conv.toNullInt32(%s)",
+	{"interface{}", "sql.NullTime"}:      "conv.toNullTime(%s)",
+	{"int32", "int64"}:                   "int64(%s)",
+	{"int64", "int32"}:                   "int32(%s)",
+	{"int32", "int16"}:                   "int16(%s)",
+	{"int64", "float64"}:                 "float64(%s)",
+	{"sql.NullInt32", "sql.NullInt64"}:   "sql.NullInt64{Int64: int64(%[1]s.Int32), Valid: %[1]s.Valid}",
+	{"sql.NullInt64", "sql.NullInt32"}:   "sql.NullInt32{Int32: int32(%[1]s.Int64), Valid: %[1]s.Valid}",
+	{"sql.NullInt32", "int32"}:           "%s.Int32",
+	{"sql.NullInt64", "int64"}:           "%s.Int64",
+	{"sql.NullInt32", "int64"}:           "int64(%s.Int32)",
+	{"sql.NullInt64", "int32"}:           "int32(%s.Int64)",
+	{"int32", "sql.NullInt32"}:           "sql.NullInt32{Int32: %s, Valid: true}",
+	{"int32", "sql.NullInt64"}:           "sql.NullInt64{Int64: int64(%s), Valid: true}",
+	{"int64", "sql.NullInt64"}:           "sql.NullInt64{Int64: %s, Valid: true}",
+	{"int64", "sql.NullInt32"}:           "sql.NullInt32{Int32: int32(%s), Valid: true}",
+	{"bool", "int32"}:                    "func(b bool) int32 { if b { return 1 }; return 0 }(%s)",
+	{"sql.NullBool", "sql.NullInt32"}:    "sql.NullInt32{Int32: func(b bool) int32 { if b { return 1 }; return 0 }(%[1]s.Bool), Valid: %[1]s.Valid}",
+	{"int32", "bool"}:                    "(%s != 0)",
+	{"sql.NullString", "string"}:         "%s.String",
+	{"string", "sql.NullString"}:         "sql.NullString{String: %s, Valid: true}",
+	{"sql.NullString", "[]byte"}:         "func(s sql.NullString) []byte { if s.Valid { return []byte(s.String) }; return nil }(%s)",
+	{"[]byte", "sql.NullString"}:         "sql.NullString{String: string(%[1]s), Valid: %[1]s != nil}",
+	{"sql.NullTime", "time.Time"}:        "%s.Time",
+	{"time.Time", "sql.NullTime"}:        "sql.NullTime{Time: %s, Valid: true}",
+	{"[]int32", "[]int64"}:               "func(s []int32) []int64 { if s == nil { return nil }; out := make([]int64, len(s)); for i, v := range s { out[i] = int64(v) }; return out }(%s)",
+	{"[]sql.NullString", "[]string"}:     "func(s []sql.NullString) []string { if s == nil { return nil }; out := make([]string, len(s)); for i, v := range s { out[i] = v.String }; return out }(%s)",
+	{"[]sql.NullInt32", "[]int32"}:       "func(s []sql.NullInt32) []int32 { if s == nil { return nil }; out := make([]int32, len(s)); for i, v := range s { out[i] = v.Int32 }; return out }(%s)",
+	{"[]string", "[]sql.NullString"}:     "func(s []string) []sql.NullString { if s == nil { return nil }; out := make([]sql.NullString, len(s)); for i, v := range s { out[i] = sql.NullString{String: v, Valid: true} }; return out }(%s)",
+	{"sql.NullInt32", "sql.NullBool"}:    "sql.NullBool{Bool: %[1]s.Int32 != 0, Valid: %[1]s.Valid}",
+	{"sql.NullBool", "bool"}:             "%s.Bool",
+	{"bool", "sql.NullBool"}:             "sql.NullBool{Bool: %s, Valid: true}",
+	{"sql.NullFloat64", "float64"}:       "%s.Float64",
+	{"float64", "sql.NullFloat64"}:       "sql.NullFloat64{Float64: %s, Valid: true}",
+	{"sql.NullInt64", "sql.NullFloat64"}: "sql.NullFloat64{Float64: float64(%[1]s.Int64), Valid: %[1]s.Valid}",
+}
+
+// scalar conversions from postgres (key[1]) to db (key[0]) types.
+var fromPGConversions = map[[2]string]string{
+	{"int32", "int64"}:                   "int32(%s)",
+	{"int64", "int32"}:                   "int64(%s)",
+	{"int32", "int16"}:                   "int32(%s)",
+	{"int64", "float64"}:                 "int64(%s)",
+	{"float64", "int64"}:                 "float64(%s)",
+	{"float64", "int32"}:                 "float64(%s)",
+	{"sql.NullInt32", "sql.NullInt64"}:   "sql.NullInt32{Int32: int32(%[1]s.Int64), Valid: %[1]s.Valid}",
+	{"sql.NullInt64", "sql.NullInt32"}:   "sql.NullInt64{Int64: int64(%[1]s.Int32), Valid: %[1]s.Valid}",
+	{"sql.NullInt32", "int32"}:           "sql.NullInt32{Int32: %s, Valid: true}",
+	{"sql.NullInt32", "int64"}:           "sql.NullInt32{Int32: int32(%s), Valid: true}",
+	{"sql.NullInt64", "int64"}:           "sql.NullInt64{Int64: %s, Valid: true}",
+	{"sql.NullInt64", "int32"}:           "sql.NullInt64{Int64: int64(%s), Valid: true}",
+	{"int32", "sql.NullInt32"}:           "%s.Int32",
+	{"int32", "sql.NullInt64"}:           "int32(%s.Int64)",
+	{"int64", "sql.NullInt64"}:           "%s.Int64",
+	{"int64", "sql.NullInt32"}:           "int64(%s.Int32)",
+	{"bool", "int32"}:                    "(%s != 0)",
+	{"bool", "int64"}:                    "(%s != 0)",
+	{"sql.NullBool", "sql.NullInt32"}:    "sql.NullBool{Bool: %[1]s.Int32 != 0, Valid: %[1]s.Valid}",
+	{"int32", "bool"}:                    "func(b bool) int32 { if b { return 1 }; return 0 }(%s)",
+	{"int64", "bool"}:                    "func(b bool) int64 { if b { return 1 }; return 0 }(%s)",
+	{"sql.NullString", "string"}:         "sql.NullString{String: %s, Valid: true}",
+	{"string", "sql.NullString"}:         "%s.String",
+	{"sql.NullString", "[]byte"}:         "sql.NullString{String: string(%[1]s), Valid: %[1]s != nil}",
+	{"[]byte", "sql.NullString"}:         "func(s sql.NullString) []byte { if s.Valid { return []byte(s.String) }; return nil }(%s)",
+	{"string", "[]byte"}:                 "string(%s)",
+	{"time.Time", "sql.NullTime"}:        "%s.Time",
+	{"sql.NullTime", "time.Time"}:        "sql.NullTime{Time: %s, Valid: true}",
+	{"[]int32", "[]int64"}:               "func(s []int64) []int32 { if s == nil { return nil }; out := make([]int32, len(s)); for i, v := range s { out[i] = int32(v) }; return out }(%s)",
+	{"sql.Result", "int32"}:              "postgresInsertResult{id: int64(%s), rows: 1}",
+	{"sql.Result", "int64"}:              "postgresInsertResult{id: int64(%s), rows: 1}",
+	{"int32", "interface{}"}:             "int32(conv.toInt64(%s))",
+	{"int64", "interface{}"}:             "conv.toInt64(%s)",
+	{"bool", "interface{}"}:              "(conv.toInt64(%s) != 0)",
+	{"string", "interface{}"}:            "conv.toString(%s)",
+	{"sql.NullTime", "interface{}"}:      "conv.toNullTime(%s)",
+	{"sql.NullInt32", "interface{}"}:     "conv.toNullInt32(%s)",
+	{"sql.NullInt64", "interface{}"}:     "conv.toNullInt64(%s)",
+	{"sql.NullString", "interface{}"}:    "conv.toNullString(%s)",
+	{"sql.NullBool", "sql.NullInt64"}:    "sql.NullBool{Bool: %[1]s.Int64 != 0, Valid: %[1]s.Valid}",
+	{"sql.NullBool", "bool"}:             "sql.NullBool{Bool: %s, Valid: true}",
+	{"bool", "sql.NullBool"}:             "%s.Bool",
+	{"float64", "sql.NullFloat64"}:       "%s.Float64",
+	{"sql.NullFloat64", "float64"}:       "sql.NullFloat64{Float64: %s, Valid: true}",
+	{"sql.NullInt64", "sql.NullFloat64"}: "sql.NullInt64{Int64: int64(%[1]s.Float64), Valid: %[1]s.Valid}",
+}
+
+func (g *generator) toPG(val, dbType, pgType string) (string, error) {
+	if dbType == pgType && !g.isStruct(g.db, dbType) {
+		if _, ok := g.db.aliases[dbType]; ok {
+			return fmt.Sprintf("dbpostgres.%s(%s)", pgType, val), nil
+		}
+		return val, nil
+	}
+	if pgType == "interface{}" {
+		return val, nil
+	}
+	if f, ok := toPGConversions[[2]string{dbType, pgType}]; ok {
+		return fmt.Sprintf(f, val), nil
+	}
+	if _, ok := g.db.aliases[dbType]; ok {
+		if _, ok := g.pg.aliases[pgType]; ok {
+			return fmt.Sprintf("dbpostgres.%s(%s)", pgType, val), nil
+		}
+	}
+	dbSt, dbOK := g.db.structs[dbType]
+	pgSt, pgOK := g.pg.structs[pgType]
+	if dbOK && pgOK {
+		var fields []string
+		for _, pf := range pgSt.Fields {
+			df, ok := fieldByName(dbSt, pf.Name)
+			if !ok {
+				return "", fmt.Errorf("postgres field %s.%s has no db counterpart", pgType, pf.Name)
+			}
+			expr, err := g.toPG(val+"."+df.Name, df.Type, pf.Type)
+			if err != nil {
+				return "", fmt.Errorf("field %s: %w", pf.Name, err)
+			}
+			fields = append(fields, fmt.Sprintf("%s: %s,", pf.Name, expr))
+		}
+		for _, df := range dbSt.Fields {
+			if _, ok := fieldByName(pgSt, df.Name); !ok {
+				return "", fmt.Errorf("db field %s.%s has no postgres counterpart", dbType, df.Name)
+			}
+		}
+		return fmt.Sprintf("dbpostgres.%s{\n\t\t%s\n\t}", pgType, strings.Join(fields, "\n\t\t")), nil
+	}
+	if dbOK && len(dbSt.Fields) == 1 {
+		return g.toPG(val+"."+dbSt.Fields[0].Name, dbSt.Fields[0].Type, pgType)
+	}
+	return "", fmt.Errorf("unsupported conversion from %s to %s for %s", dbType, pgType, val)
+}
+
+func (g *generator) fromPG(val, dbType, pgType string) (string, error) {
+	if dbType == pgType && !g.isStruct(g.db, strings.TrimLeft(dbType, "[]*")) {
+		if _, ok := g.db.aliases[dbType]; ok {
+			return fmt.Sprintf("%s(%s)", dbType, val), nil
+		}
+		return val, nil
+	}
+	if dbType == "interface{}" {
+		return val, nil
+	}
+	if f, ok := fromPGConversions[[2]string{dbType, pgType}]; ok {
+		return fmt.Sprintf(f, val), nil
+	}
+	if _, ok := g.db.aliases[dbType]; ok {
+		if _, ok := g.pg.aliases[pgType]; ok {
+			return fmt.Sprintf("%s(%s)", dbType, val), nil
+		}
+	}
+	for _, shape := range []struct{ prefix, open, elem string }{{"[]*", "[]*", "&"}, {"[]", "[]", ""}, {"*", "*", "&"}} {
+		if !strings.HasPrefix(dbType, shape.prefix) || !strings.HasPrefix(pgType, shape.prefix) {
+			continue
+		}
+		rawDB := strings.TrimPrefix(dbType, shape.prefix)
+		rawPG := strings.TrimPrefix(pgType, shape.prefix)
+		item := "v"
+		if strings.HasPrefix(shape.prefix, "[]") {
+			item = "item"
+		}
+		body, err := g.structFromPG(item, rawDB, rawPG)
+		if err != nil {
+			return "", err
+		}
+		switch shape.prefix {
+		case "*":
+			return fmt.Sprintf("func(v *dbpostgres.%s) *%s {\n\t\tif v == nil { return nil }\n\t\treturn &%s\n\t}(%s)", rawPG, rawDB, body, val), nil
+		case "[]*":
+			return fmt.Sprintf("func(items []*dbpostgres.%s) []*%s {\n\t\tif items == nil { return nil }\n\t\tout := make([]*%s, len(items))\n\t\tfor i, item := range items {\n\t\t\tif item == nil { continue }\n\t\t\tout[i] = &%s\n\t\t}\n\t\treturn out\n\t}(%s)", rawPG, rawDB, rawDB, body, val), nil
+		default:
+			return fmt.Sprintf("func(items []dbpostgres.%s) []%s {\n\t\tif items == nil { return nil }\n\t\tout := make([]%s, len(items))\n\t\tfor i, item := range items {\n\t\t\tout[i] = %s\n\t\t}\n\t\treturn out\n\t}(%s)", rawPG, rawDB, rawDB, body, val), nil
+		}
+	}
+	return "", fmt.Errorf("unsupported conversion from %s to %s for %s", pgType, dbType, val)
+}
+
+func (g *generator) structFromPG(item, dbType, pgType string) (string, error) {
+	dbSt, ok := g.db.structs[dbType]
+	if !ok {
+		return "", fmt.Errorf("unknown db struct %s", dbType)
+	}
+	pgSt, ok := g.pg.structs[pgType]
+	if !ok {
+		return "", fmt.Errorf("unknown postgres struct %s", pgType)
+	}
+	if len(dbSt.Fields) != len(pgSt.Fields) {
+		return "", fmt.Errorf("struct %s has %d fields, postgres %s has %d", dbType, len(dbSt.Fields), pgType, len(pgSt.Fields))
+	}
+	var fields []string
+	for _, df := range dbSt.Fields {
+		pf, ok := fieldByName(pgSt, df.Name)
+		if !ok {
+			return "", fmt.Errorf("db field %s.%s has no postgres counterpart", dbType, df.Name)
+		}
+		expr, err := g.fromPG(item+"."+pf.Name, df.Type, pf.Type)
+		if err != nil {
+			return "", fmt.Errorf("struct %s field %s: %w", dbType, df.Name, err)
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s,", df.Name, expr))
+	}
+	return fmt.Sprintf("%s{\n\t\t\t%s\n\t\t}", dbType, strings.Join(fields, "\n\t\t\t")), nil
+}
+
+func (g *generator) isStruct(p *pkg, typ string) bool {
+	_, ok := p.structs[typ]
+	return ok
+}
+
+func fieldByName(st StructInfo, name string) (FieldInfo, bool) {
+	for _, f := range st.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FieldInfo{}, false
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func testGenerator() *generator {
+	return &generator{
+		db: &pkg{
+			structs: map[string]StructInfo{
+				"UserParam":  {Name: "UserParam", Fields: []FieldInfo{{Name: "ID", Type: "int32"}, {Name: "Active", Type: "bool"}}},
+				"LooseParam": {Name: "LooseParam", Fields: []FieldInfo{{Name: "ID", Type: "int32"}, {Name: "Extra", Type: "string"}}},
+			},
+			aliases: map[string]string{"Status": "string"},
+		},
+		pg: &pkg{
+			structs: map[string]StructInfo{
+				"UserParam":  {Name: "UserParam", Fields: []FieldInfo{{Name: "ID", Type: "int32"}, {Name: "Active", Type: "int32"}}},
+				"LooseParam": {Name: "LooseParam", Fields: []FieldInfo{{Name: "ID", Type: "int32"}}},
+			},
+			aliases: map[string]string{"Status": "string"},
+		},
+	}
+}
+
+func TestToPG(t *testing.T) {
+	g := testGenerator()
+	tests := []struct {
+		name, val, dbType, pgType, want string
+	}{
+		{"identical", "id", "int32", "int32", "id"},
+		{"alias", "s", "Status", "Status", "dbpostgres.Status(s)"},
+		{"bool to int32", "b", "bool", "int32", "func(b bool) int32 { if b { return 1 }; return 0 }(b)"},
+		{"interface to null string", "v", "interface{}", "sql.NullString", "conv.toNullString(v)"},
+		{"interface passthrough", "v", "sql.NullTime", "interface{}", "v"},
+		{"struct", "arg", "UserParam", "UserParam", "dbpostgres.UserParam{\n\t\tID: arg.ID,\n\t\tActive: func(b bool) int32 { if b { return 1 }; return 0 }(arg.Active),\n\t}"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := g.toPG(tc.val, tc.dbType, tc.pgType)
+			if err != nil {
+				t.Fatalf("toPG: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("toPG(%s, %s, %s) = %q, want %q", tc.val, tc.dbType, tc.pgType, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestToPGMissingField(t *testing.T) {
+	g := testGenerator()
+	_, err := g.toPG("arg", "LooseParam", "LooseParam")
+	if err == nil || !strings.Contains(err.Error(), "Extra") {
+		t.Fatalf("expected missing field error, got %v", err)
+	}
+}
+
+func TestFromPG(t *testing.T) {
+	g := testGenerator()
+	tests := []struct {
+		name, val, dbType, pgType, want string
+	}{
+		{"int64 to int32", "n", "int32", "int64", "int32(n)"},
+		{"int32 to bool", "n", "bool", "int32", "(n != 0)"},
+		{"insert result", "id", "sql.Result", "int32", "postgresInsertResult{id: int64(id), rows: 1}"},
+		{"interface to int64", "v", "int64", "interface{}", "conv.toInt64(v)"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := g.fromPG(tc.val, tc.dbType, tc.pgType)
+			if err != nil {
+				t.Fatalf("fromPG: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("fromPG(%s, %s, %s) = %q, want %q", tc.val, tc.dbType, tc.pgType, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnsupportedConversion(t *testing.T) {
+	g := testGenerator()
+	if _, err := g.toPG("c", "complex128", "chan int"); err == nil {
+		t.Fatal("expected error for unsupported conversion")
+	}
+}
+
+func TestMethodSkippedInsert(t *testing.T) {
+	g := testGenerator()
+	g.db.kinds = map[string]string{"CreateWord": "execlastid"}
+	g.pg.kinds = map[string]string{"CreateWord": "one"}
+	var buf bytes.Buffer
+	m := MethodInfo{Name: "CreateWord", Params: []ParamInfo{{Name: "ctx", Type: "context.Context"}, {Name: "word", Type: "string"}}, ReturnList: []string{"int64", "error"}}
+	pg := m
+	pg.ReturnList = []string{"int32", "error"}
+	if err := g.method(&buf, m, pg); err != nil {
+		t.Fatalf("method: %v", err)
+	}
+	if !strings.Contains(buf.String(), "if errors.Is(err, sql.ErrNoRows) {\n\t\treturn 0, nil\n\t}") {
+		t.Fatalf("skipped insert not mapped to 0:\n%s", buf.String())
+	}
+}
+
+func TestMethodConversionError(t *testing.T) {
+	g := testGenerator()
+	var buf bytes.Buffer
+	m := MethodInfo{Name: "Count", Params: []ParamInfo{{Name: "ctx", Type: "context.Context"}, {Name: "prefix", Type: "interface{}"}}, ReturnList: []string{"int64", "error"}}
+	pg := m
+	pg.Params = []ParamInfo{m.Params[0], {Name: "prefix", Type: "string"}}
+	if err := g.method(&buf, m, pg); err != nil {
+		t.Fatalf("method: %v", err)
+	}
+	got := buf.String()
+	for _, want := range []string{"var conv pgConv", "pgArg1 := conv.toString(prefix)", "return 0, conv.err", "s.q.Count(ctx, pgArg1)"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestQueryKind(t *testing.T) {
+	name, kind, ok := queryKind("`-- name: SystemCreateSearchWord :execlastid\nINSERT IGNORE INTO searchwordlist`")
+	if !ok || name != "SystemCreateSearchWord" || kind != "execlastid" {
+		t.Fatalf("queryKind = %q, %q, %v", name, kind, ok)
+	}
+	if _, _, ok := queryKind(`"plain"`); ok {
+		t.Fatal("plain string reported as a query")
+	}
+}
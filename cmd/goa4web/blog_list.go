@@ -40,10 +40,11 @@ func (c *blogListCmd) Run() error {
 	queries := db.New(conn)
 	uid := int32(c.UserID)
 	rows, err := queries.ListBlogEntriesForLister(ctx, db.ListBlogEntriesForListerParams{
-		ListerID: uid,
-		UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-		Limit:    int32(c.Limit),
-		Offset:   int32(c.Offset),
+		ListerID:          uid,
+		UserID:            sql.NullInt32{Int32: uid, Valid: uid != 0},
+		ScheduledViewerID: uid,
+		Limit:             int32(c.Limit),
+		Offset:            int32(c.Offset),
 	})
 	if err != nil {
 		return fmt.Errorf("list blogs: %w", err)
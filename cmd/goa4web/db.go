@@ -67,6 +67,18 @@ func (c *dbCmd) Run() error {
 			return fmt.Errorf("restore: %w", err)
 		}
 		return cmd.Run()
+	case "export":
+		cmd, err := parseDbExportCmd(c, args[1:])
+		if err != nil {
+			return fmt.Errorf("export: %w", err)
+		}
+		return cmd.Run()
+	case "import":
+		cmd, err := parseDbImportCmd(c, args[1:])
+		if err != nil {
+			return fmt.Errorf("import: %w", err)
+		}
+		return cmd.Run()
 	case "show":
 		cmd, err := parseDbShowCmd(c, args[1:])
 		if err != nil {
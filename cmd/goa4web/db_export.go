@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	adminhandlers "github.com/arran4/goa4web/handlers/admin"
+	"github.com/arran4/goa4web/internal/app/dbstart"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/dbops"
+	"github.com/arran4/goa4web/internal/upload"
+)
+
+// dbExportCmd implements "db export".
+type dbExportCmd struct {
+	*dbCmd
+	fs        *flag.FlagSet
+	File      string
+	NoUploads bool
+}
+
+func parseDbExportCmd(parent *dbCmd, args []string) (*dbExportCmd, error) {
+	c := &dbExportCmd{dbCmd: parent}
+	c.fs = newFlagSet("export")
+	c.fs.StringVar(&c.File, "file", "", "output archive file")
+	c.fs.BoolVar(&c.NoUploads, "no-uploads", false, "leave uploaded files out of the archive")
+	if err := c.fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *dbExportCmd) Usage() {
+	_ = executeUsage(c.fs.Output(), "db_export_usage.txt", c)
+}
+
+func (c *dbExportCmd) FlagGroups() []flagGroup {
+	return []flagGroup{{Title: c.fs.Name() + " flags", Flags: flagInfos(c.fs)}}
+}
+
+var _ usageData = (*dbExportCmd)(nil)
+
+func (c *dbExportCmd) Run() error {
+	if c.File == "" {
+		return fmt.Errorf("file required")
+	}
+	sdb, err := openDB(c.cfg, c.dbReg)
+	if err != nil {
+		return fmt.Errorf("open db: %w", err)
+	}
+	defer closeDB(sdb)
+	ctx := c.Context()
+	version, err := dbstart.SchemaVersionWithDriver(ctx, sdb, c.cfg.DBDriver)
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	// Read every table in one transaction so the archive is consistent.
+	tx, err := sdb.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+	q := db.NewForDriver(tx, c.cfg.DBDriver)
+	opts := dbops.ExportOptions{
+		Driver:        c.cfg.DBDriver,
+		SchemaVersion: int64(version),
+		Progress: func(name string, n int64) {
+			c.Verbosef("exported %s (%d)", name, n)
+		},
+	}
+	if !c.NoUploads {
+		p := upload.ProviderFromConfig(c.cfg)
+		if p == nil {
+			return fmt.Errorf("upload provider %q unavailable", c.cfg.ImageUploadProvider)
+		}
+		refs, err := adminhandlers.ReferencedUploads(ctx, q)
+		if err != nil {
+			return err
+		}
+		opts.Uploads = p
+		opts.UploadNames = slices.Sorted(maps.Keys(refs))
+	}
+	f, err := os.Create(c.File)
+	if err != nil {
+		return fmt.Errorf("create file: %w", err)
+	}
+	m, err := dbops.ExportSite(ctx, q, f, opts)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(c.File)
+		return err
+	}
+	c.Infof("exported %d tables and %d uploads at schema version %d to %s", len(m.Tables), len(m.Uploads), m.SchemaVersion, c.File)
+	return nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"archive/zip"
+	"flag"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/app/dbstart"
+	"github.com/arran4/goa4web/internal/dbops"
+	"github.com/arran4/goa4web/internal/upload"
+)
+
+// dbImportCmd implements "db import".
+type dbImportCmd struct {
+	*dbCmd
+	fs        *flag.FlagSet
+	File      string
+	NoUploads bool
+}
+
+func parseDbImportCmd(parent *dbCmd, args []string) (*dbImportCmd, error) {
+	c := &dbImportCmd{dbCmd: parent}
+	c.fs = newFlagSet("import")
+	c.fs.StringVar(&c.File, "file", "", "archive file to import")
+	c.fs.BoolVar(&c.NoUploads, "no-uploads", false, "do not write the archived uploaded files")
+	if err := c.fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *dbImportCmd) Usage() {
+	_ = executeUsage(c.fs.Output(), "db_import_usage.txt", c)
+}
+
+func (c *dbImportCmd) FlagGroups() []flagGroup {
+	return []flagGroup{{Title: c.fs.Name() + " flags", Flags: flagInfos(c.fs)}}
+}
+
+var _ usageData = (*dbImportCmd)(nil)
+
+func (c *dbImportCmd) Run() error {
+	if c.File == "" {
+		return fmt.Errorf("file required")
+	}
+	zr, err := zip.OpenReader(c.File)
+	if err != nil {
+		return fmt.Errorf("open archive: %w", err)
+	}
+	defer func() { _ = zr.Close() }()
+	sdb, err := openDB(c.cfg, c.dbReg)
+	if err != nil {
+		return fmt.Errorf("open db: %w", err)
+	}
+	defer closeDB(sdb)
+	ctx := c.Context()
+	version, err := dbstart.SchemaVersionWithDriver(ctx, sdb, c.cfg.DBDriver)
+	if err != nil {
+		return fmt.Errorf("read schema version: %w", err)
+	}
+	opts := dbops.ImportOptions{
+		Driver:        c.cfg.DBDriver,
+		SchemaVersion: int64(version),
+		Progress: func(name string, n int64) {
+			c.Verbosef("imported %s (%d)", name, n)
+		},
+	}
+	if !c.NoUploads {
+		if opts.Uploads = upload.ProviderFromConfig(c.cfg); opts.Uploads == nil {
+			return fmt.Errorf("upload provider %q unavailable", c.cfg.ImageUploadProvider)
+		}
+	}
+	m, err := dbops.ImportSite(ctx, sdb, &zr.Reader, opts)
+	if err != nil {
+		return err
+	}
+	c.Infof("imported %d tables and %d uploads from %s (exported from %s)", len(m.Tables), len(m.Uploads), c.File, m.Driver)
+	return nil
+}
@@ -57,7 +57,7 @@ func (c *dbSetupCmd) Run() error {
 	}
 
 	log.Println("Applying schema...")
-	if err := sqlutil.RunStatements(context.Background(), sdb, strings.NewReader(string(database.SchemaMySQL))); err != nil {
+	if err := sqlutil.RunStatements(context.Background(), sdb, strings.NewReader(string(database.SchemaForDriver(cfg.DBDriver)))); err != nil {
 		return fmt.Errorf("failed to apply schema: %w", err)
 	}
 
@@ -48,13 +48,16 @@ func (c *dbShowCmd) Run() error {
 	case "schema", "schema.mysql.sql":
 		_, err := fmt.Fprintln(os.Stdout, string(database.SchemaMySQL))
 		return err
+	case "schema.postgres.sql":
+		_, err := fmt.Fprintln(os.Stdout, string(database.SchemaPostgres))
+		return err
 	case "schema-version":
 		db, err := openDB(c.cfg, c.dbReg)
 		if err != nil {
 			return fmt.Errorf("open db: %w", err)
 		}
 		defer func() { _ = db.Close() }()
-		version, err := dbstart.SchemaVersion(context.Background(), db)
+		version, err := dbstart.SchemaVersionWithDriver(context.Background(), db, c.cfg.DBDriver)
 		if err != nil {
 			return fmt.Errorf("read schema version: %w", err)
 		}
@@ -31,6 +31,12 @@ func (c *emailCmd) Run() error {
 		return err
 	}
 	switch args[0] {
+	case "dkim":
+		cmd, err := parseEmailDKIMCmd(c, args[1:])
+		if err != nil {
+			return fmt.Errorf("dkim: %w", err)
+		}
+		return cmd.Run()
 	case "failed":
 		cmd, err := parseEmailFailedCmd(c, args[1:])
 		if err != nil {
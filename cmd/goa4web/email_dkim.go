@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/email/dkim"
+)
+
+// emailDKIMCmd handles the `email dkim` subcommand.
+type emailDKIMCmd struct {
+	*emailCmd
+	fs        *flag.FlagSet
+	algorithm string
+	bits      int
+	domain    string
+	selector  string
+	out       string
+}
+
+func parseEmailDKIMCmd(parent *emailCmd, args []string) (*emailDKIMCmd, error) {
+	c := &emailDKIMCmd{emailCmd: parent}
+	c.fs = newFlagSet("dkim")
+	c.fs.StringVar(&c.algorithm, "algorithm", dkim.AlgorithmRSA, "Key algorithm: rsa or ed25519.")
+	c.fs.IntVar(&c.bits, "bits", 2048, "RSA key size in bits.")
+	c.fs.StringVar(&c.domain, "domain", "", "Signing domain. Defaults to the configured DKIM domain.")
+	c.fs.StringVar(&c.selector, "selector", "", "DNS selector. Defaults to the configured DKIM selector.")
+	c.fs.StringVar(&c.out, "out", "", "Private key file. Defaults to the configured key file.")
+	c.fs.Usage = c.Usage
+	if err := c.fs.Parse(args); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *emailDKIMCmd) Run() error {
+	cfg, err := c.RuntimeConfig()
+	if err != nil {
+		return err
+	}
+	domain, selector, out := c.domain, c.selector, c.out
+	if domain == "" {
+		domain = cfg.EmailDKIMDomain
+	}
+	if selector == "" {
+		selector = cfg.EmailDKIMSelector
+	}
+	if out == "" {
+		out = cfg.EmailDKIMKeyFile
+	}
+	if out == "" {
+		out = config.DefaultEmailDKIMKeyPath()
+	}
+	if domain == "" || selector == "" {
+		return fmt.Errorf("missing -domain or -selector and no DKIM domain or selector configured")
+	}
+
+	keyPEM, key, err := dkim.GenerateKey(c.algorithm, c.bits)
+	if err != nil {
+		return err
+	}
+	record, err := dkim.TXTRecord(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(out), 0o700); err != nil {
+		return fmt.Errorf("create key directory: %w", err)
+	}
+	// The key is written once; replacing it would break signatures until the
+	// DNS record is updated, so rotation uses a new selector and file.
+	f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return fmt.Errorf("%s already exists; choose another -out", out)
+		}
+		return fmt.Errorf("create key file: %w", err)
+	}
+	if _, err := f.Write(keyPEM); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("write key file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("close key file: %w", err)
+	}
+	c.Infof("wrote DKIM private key to %s", out)
+
+	w := c.fs.Output()
+	_, _ = fmt.Fprintf(w, "Publish this TXT record:\n\n")
+	_, _ = fmt.Fprintf(w, "%s\tTXT\t%q\n\n", dkim.RecordName(selector, domain), record)
+	_, _ = fmt.Fprintf(w, "Then set:\n\n")
+	_, _ = fmt.Fprintf(w, "%s=%s\n%s=%s\n%s=%s\n", config.EnvEmailDKIMDomain, domain, config.EnvEmailDKIMSelector, selector, config.EnvEmailDKIMKeyFile, out)
+	return nil
+}
+
+// Usage prints the command's usage information.
+func (c *emailDKIMCmd) Usage() {
+	_ = executeUsage(c.fs.Output(), "email_dkim_usage.txt", c)
+}
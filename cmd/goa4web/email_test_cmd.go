@@ -2,9 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
 
+	"github.com/arran4/goa4web/config"
 	"github.com/arran4/goa4web/internal/email"
 	"github.com/arran4/goa4web/internal/email/jmap"
 	"github.com/arran4/goa4web/internal/email/log"
@@ -16,12 +21,16 @@ import (
 // emailTestCmd handles the `email test` subcommand.
 type emailTestCmd struct {
 	*emailCmd
-	fs *flag.FlagSet
+	fs       *flag.FlagSet
+	provider string
+	to       string
 }
 
 func parseEmailTestCmd(parent *emailCmd, args []string) (*emailTestCmd, error) {
 	c := &emailTestCmd{emailCmd: parent}
 	c.fs = newFlagSet("test")
+	c.fs.StringVar(&c.provider, "provider", "", "Test only this member of the provider list.")
+	c.fs.StringVar(&c.to, "to", "", "Also send a test email to this address through each provider.")
 	c.fs.Usage = c.Usage
 	if err := c.fs.Parse(args); err != nil {
 		return nil, err
@@ -35,6 +44,13 @@ func (c *emailTestCmd) Run() error {
 		return err
 	}
 
+	var to *mail.Address
+	if c.to != "" {
+		if to, err = mail.ParseAddress(c.to); err != nil {
+			return fmt.Errorf("invalid recipient email address: %w", err)
+		}
+	}
+
 	reg := email.NewRegistry()
 	jmap.Register(reg)
 	log.Register(reg)
@@ -42,22 +58,67 @@ func (c *emailTestCmd) Run() error {
 	sendgrid.Register(reg)
 	smtp.Register(reg)
 
-	provider, err := reg.ProviderFromConfig(cfg)
-	if err != nil || provider == nil {
-		if err != nil {
-			return fmt.Errorf("failed to create email provider for %q: %w", cfg.EmailProvider, err)
+	names := email.ParseProviderList(cfg.EmailProvider)
+	if c.provider != "" {
+		if !slices.Contains(names, strings.ToLower(c.provider)) {
+			return fmt.Errorf("provider %q is not in %q", c.provider, cfg.EmailProvider)
 		}
-		return fmt.Errorf("failed to create email provider for %q", cfg.EmailProvider)
+		names = []string{strings.ToLower(c.provider)}
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("no email provider configured")
 	}
 
-	c.Infof("Testing email provider %q", cfg.EmailProvider)
-
-	if err := provider.TestConfig(context.Background()); err != nil {
+	var errs []error
+	for _, name := range names {
+		if err := c.testProvider(reg, cfg, name, to); err != nil {
+			c.Infof("Email provider %q failed: %v", name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		c.Infof("Email provider %q test successful", name)
+	}
+	if err := errors.Join(errs...); err != nil {
 		return fmt.Errorf("email provider test failed: %w", err)
 	}
+	return nil
+}
+
+// testProvider checks the configuration of a single provider from the list
+// and, when to is set, sends it a test message.
+func (c *emailTestCmd) testProvider(reg *email.Registry, cfg *config.RuntimeConfig, name string, to *mail.Address) error {
+	member := *cfg
+	member.EmailProvider = name
+	member.EmailProviderRateLimits = ""
+	provider, err := reg.ProviderFromConfig(&member)
+	if err != nil {
+		return err
+	}
+	if provider == nil {
+		return fmt.Errorf("provider not available")
+	}
 
-	c.Infof("Email provider test successful")
+	c.Infof("Testing email provider %q", name)
+	ctx := context.Background()
+	if err := provider.TestConfig(ctx); err != nil {
+		return err
+	}
+	if to == nil {
+		return nil
+	}
 
+	from, err := mail.ParseAddress(cfg.EmailFrom)
+	if err != nil {
+		return fmt.Errorf("invalid from email address %q: %w", cfg.EmailFrom, err)
+	}
+	raw, err := email.BuildMessage(*from, *to, "goa4web test email via "+name, "This is a test email sent through the "+name+" provider.", "")
+	if err != nil {
+		return fmt.Errorf("failed to create email message: %w", err)
+	}
+	if err := provider.Send(ctx, *to, raw); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	c.Infof("Sent test email to %s via %q", to.Address, name)
 	return nil
 }
 
@@ -37,6 +37,12 @@ func (c *filesCmd) Run() error {
 			return fmt.Errorf("list: %w", err)
 		}
 		return cmd.Run()
+	case "migrate":
+		cmd, err := parseFilesMigrateCmd(c, args[1:])
+		if err != nil {
+			return fmt.Errorf("migrate: %w", err)
+		}
+		return cmd.Run()
 	case "purge":
 		cmd, err := parseFilesPurgeCmd(c, args[1:])
 		if err != nil {
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/upload"
+)
+
+// filesMigrateCmd implements "files migrate".
+type filesMigrateCmd struct {
+	*filesCmd
+	fs           *flag.FlagSet
+	from         string
+	to           string
+	fromLocation string
+	toLocation   string
+	prefix       string
+	skipExisting bool
+	dryRun       bool
+	jsonOut      bool
+}
+
+func parseFilesMigrateCmd(parent *filesCmd, args []string) (*filesMigrateCmd, error) {
+	c := &filesMigrateCmd{filesCmd: parent}
+	fs, _, err := parseFlags("migrate", args, func(fs *flag.FlagSet) {
+		fs.StringVar(&c.from, "from", "", "source upload provider")
+		fs.StringVar(&c.to, "to", "", "destination upload provider")
+		fs.StringVar(&c.fromLocation, "from-location", "", "source directory or s3:// URL (defaults to the configured upload location)")
+		fs.StringVar(&c.toLocation, "to-location", "", "destination directory or s3:// URL (defaults to the configured upload location)")
+		fs.StringVar(&c.prefix, "prefix", "", "only migrate objects whose names start with this prefix")
+		fs.BoolVar(&c.skipExisting, "skip-existing", false, "skip objects already present at the destination with the same size")
+		fs.BoolVar(&c.dryRun, "dry-run", false, "list objects without copying them")
+		fs.BoolVar(&c.jsonOut, "json", false, "machine-readable JSON output")
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.fs = fs
+	return c, nil
+}
+
+type filesMigrateOutput struct {
+	From    string              `json:"from"`
+	To      string              `json:"to"`
+	Entries []filesMigrateEntry `json:"entries"`
+	Summary upload.MigrateStats `json:"summary"`
+}
+
+type filesMigrateEntry struct {
+	Name   string `json:"name"`
+	Size   int64  `json:"size"`
+	Status string `json:"status"`
+}
+
+func (c *filesMigrateCmd) Run() error {
+	if c.from == "" || c.to == "" {
+		c.fs.Usage()
+		return fmt.Errorf("--from and --to are required")
+	}
+	src, err := namedUploadProvider(c.cfg, c.from, c.fromLocation)
+	if err != nil {
+		return fmt.Errorf("from: %w", err)
+	}
+	dst, err := namedUploadProvider(c.cfg, c.to, c.toLocation)
+	if err != nil {
+		return fmt.Errorf("to: %w", err)
+	}
+	if strings.EqualFold(c.from, c.to) && c.fromLocation == c.toLocation {
+		return fmt.Errorf("source and destination are the same")
+	}
+	if !c.dryRun {
+		if err := dst.Check(c.Context()); err != nil {
+			return fmt.Errorf("check destination: %w", err)
+		}
+	}
+
+	out := filesMigrateOutput{From: c.from, To: c.to}
+	stats, err := upload.Migrate(c.Context(), src, dst, upload.MigrateOptions{
+		Prefix:       c.prefix,
+		SkipExisting: c.skipExisting,
+		DryRun:       c.dryRun,
+		Progress: func(info upload.ObjectInfo, result upload.MigrateResult) {
+			if c.jsonOut {
+				out.Entries = append(out.Entries, filesMigrateEntry{Name: info.Name, Size: info.Size, Status: string(result)})
+				return
+			}
+			_, _ = fmt.Printf("%s\t%d\t%s\n", info.Name, info.Size, result)
+		},
+	})
+	out.Summary = stats
+	if c.jsonOut {
+		b, _ := json.MarshalIndent(out, "", "  ")
+		_, _ = fmt.Println(string(b))
+	} else {
+		_, _ = fmt.Printf("\nSummary: copied=%d skipped=%d planned=%d bytes=%d\n", stats.Copied, stats.Skipped, stats.Planned, stats.Bytes)
+	}
+	return err
+}
+
+// namedUploadProvider builds the upload provider called name, optionally
+// pointing it at location instead of the configured upload directory or URL.
+func namedUploadProvider(cfg *config.RuntimeConfig, name, location string) (upload.Provider, error) {
+	c := *cfg
+	c.ImageUploadProvider = name
+	if location != "" {
+		c.ImageUploadDir = location
+		c.ImageUploadS3URL = location
+	}
+	p := upload.ProviderFromConfig(&c)
+	if p == nil {
+		return nil, fmt.Errorf("upload provider %q unavailable (registered: %s)", name, strings.Join(upload.ProviderNames(), ", "))
+	}
+	return p, nil
+}
+
+func (c *filesMigrateCmd) Usage() {
+	_ = executeUsage(c.fs.Output(), "files_migrate_usage.txt", c)
+}
+
+func (c *filesMigrateCmd) FlagGroups() []flagGroup {
+	return []flagGroup{{Title: c.fs.Name() + " flags", Flags: flagInfos(c.fs)}}
+}
+
+var _ usageData = (*filesMigrateCmd)(nil)
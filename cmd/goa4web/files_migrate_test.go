@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+)
+
+func TestFilesMigrateCmdLocalToLocal(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "ab", "cd"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "ab", "cd", "image.jpg"), []byte("image"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.NewRuntimeConfig()
+	cfg.ImageUploadDir = src
+	root := &rootCmd{cfg: cfg, ctx: context.Background()}
+	parent := &filesCmd{rootCmd: root, fs: newFlagSet("files")}
+	cmd, err := parseFilesMigrateCmd(parent, []string{"--from", "local", "--to", "local", "--to-location", dst, "--json"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("run: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(dst, "ab", "cd", "image.jpg"))
+	if err != nil || string(data) != "image" {
+		t.Fatalf("copy = %q, %v", data, err)
+	}
+}
+
+func TestFilesMigrateCmdRejectsSameTarget(t *testing.T) {
+	cfg := config.NewRuntimeConfig()
+	cfg.ImageUploadDir = t.TempDir()
+	parent := &filesCmd{rootCmd: &rootCmd{cfg: cfg, ctx: context.Background()}, fs: newFlagSet("files")}
+	cmd, err := parseFilesMigrateCmd(parent, []string{"--from", "local", "--to", "local"})
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if err := cmd.Run(); err == nil {
+		t.Fatal("expected error")
+	}
+}
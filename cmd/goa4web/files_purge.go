@@ -5,15 +5,17 @@ import (
 	"flag"
 	"fmt"
 	"os"
-	"path/filepath"
+	"path"
 	"strings"
 	"text/tabwriter"
 	"time"
 
 	adminhandlers "github.com/arran4/goa4web/handlers/admin"
+	"github.com/arran4/goa4web/internal/upload"
 )
 
-// filesPurgeCmd implements "files purge".
+// filesPurgeCmd implements "files purge". It deletes stored uploads that no
+// uploaded_images or imagepost row references.
 type filesPurgeCmd struct {
 	*filesCmd
 	fs        *flag.FlagSet
@@ -27,7 +29,7 @@ func parseFilesPurgeCmd(parent *filesCmd, args []string) (*filesPurgeCmd, error)
 	c := &filesPurgeCmd{filesCmd: parent}
 	fs, _, err := parseFlags("purge", args, func(fs *flag.FlagSet) {
 		fs.StringVar(&c.path, "path", "", "path under the image upload directory")
-		fs.DurationVar(&c.olderThan, "older-than", 0, "only purge files older than this duration")
+		fs.DurationVar(&c.olderThan, "older-than", 0, "only purge orphans older than this duration")
 		fs.BoolVar(&c.dryRun, "dry-run", false, "preview deletions without removing files")
 		fs.BoolVar(&c.jsonOut, "json", false, "machine-readable JSON output")
 	})
@@ -64,44 +66,49 @@ func (c *filesPurgeCmd) Run() error {
 	if err != nil {
 		return fmt.Errorf("database: %w", err)
 	}
+	p := upload.ProviderFromConfig(c.cfg)
+	if p == nil {
+		return fmt.Errorf("upload provider %q unavailable", c.cfg.ImageUploadProvider)
+	}
 
-	listing, err := adminhandlers.BuildImageFilesListing(c.Context(), queries, c.cfg.ImageUploadDir, c.path, "", nil, 0)
+	cleaned := path.Clean("/" + c.path)
+	prefix := strings.TrimPrefix(cleaned, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	orphans, err := adminhandlers.OrphanedUploads(c.Context(), queries, p, prefix, c.olderThan)
 	if err != nil {
 		return err
 	}
 
-	filtered, summary := filterImageFiles(listing.Entries, c.olderThan, true)
 	purgeOutput := filesPurgeOutput{
-		Path: listing.Path,
+		Path: cleaned,
 		Summary: filesPurgeSummary{
-			Candidates: summary.Files,
+			Candidates: len(orphans),
 			DryRun:     c.dryRun,
 		},
 	}
 
-	base := c.cfg.ImageUploadDir
-	for _, entry := range filtered {
+	for _, orphan := range orphans {
 		result := filesPurgeEntry{
-			Path: entry.Path,
-			Size: entry.Size,
+			Path: "/" + orphan.Name,
+			Size: orphan.Size,
 		}
 		if c.dryRun {
 			result.Status = "dry-run"
 			purgeOutput.Summary.Deleted++
-			purgeOutput.Summary.Bytes += entry.Size
+			purgeOutput.Summary.Bytes += orphan.Size
 			purgeOutput.Entries = append(purgeOutput.Entries, result)
 			continue
 		}
-		relPath := strings.TrimPrefix(entry.Path, string(filepath.Separator))
-		target := filepath.Join(base, relPath)
-		if err := os.Remove(target); err != nil {
+		if err := p.Delete(c.Context(), orphan.Name); err != nil {
 			result.Status = "error"
 			result.Error = err.Error()
 			purgeOutput.Summary.Errors++
 		} else {
 			result.Status = "deleted"
 			purgeOutput.Summary.Deleted++
-			purgeOutput.Summary.Bytes += entry.Size
+			purgeOutput.Summary.Bytes += orphan.Size
 		}
 		purgeOutput.Entries = append(purgeOutput.Entries, result)
 	}
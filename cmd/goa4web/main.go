@@ -23,6 +23,9 @@ import (
 	linkerhandlers "github.com/arran4/goa4web/handlers/linker"
 	newshandlers "github.com/arran4/goa4web/handlers/news"
 	privateforumhandlers "github.com/arran4/goa4web/handlers/privateforum"
+	reactionhandlers "github.com/arran4/goa4web/handlers/reactions"
+	reporthandlers "github.com/arran4/goa4web/handlers/reports"
+	revisionhandlers "github.com/arran4/goa4web/handlers/revisions"
 	searchhandlers "github.com/arran4/goa4web/handlers/search"
 	userhandlers "github.com/arran4/goa4web/handlers/user"
 	writinghandlers "github.com/arran4/goa4web/handlers/writings"
@@ -35,6 +38,8 @@ import (
 	"github.com/arran4/goa4web/internal/dlq/dlqdefaults"
 	"github.com/arran4/goa4web/internal/email"
 	"github.com/arran4/goa4web/internal/email/emaildefaults"
+	"github.com/arran4/goa4web/internal/search"
+	"github.com/arran4/goa4web/internal/search/searchdefaults"
 
 	"github.com/arran4/goa4web/internal/router"
 
@@ -62,6 +67,9 @@ func registerTasks(reg *tasks.Registry, ah *adminhandlers.Handlers) {
 	register("faq", faqhandlers.RegisterTasks())
 	register("forum", forumhandlers.RegisterTasks())
 	register("privateforum", privateforumhandlers.RegisterTasks())
+	register("reactions", reactionhandlers.RegisterTasks())
+	register("reports", reporthandlers.RegisterTasks())
+	register("revisions", revisionhandlers.RegisterTasks())
 	register("images", imagehandlers.RegisterTasks())
 	register("imagebbs", imagebbshandlers.RegisterTasks())
 	register("linker", linkerhandlers.RegisterTasks())
@@ -105,6 +113,7 @@ type rootCmd struct {
 	dbReg            *dbdrivers.Registry
 	emailReg         *email.Registry
 	dlqReg           *dlq.Registry
+	searchReg        *search.Registry
 	routerReg        *router.Registry
 	adminHandlers    *adminhandlers.Handlers
 	ctx              context.Context
@@ -180,6 +189,7 @@ func parseRoot(args []string) (*rootCmd, error) {
 		dbReg:         dbdrivers.NewRegistry(),
 		emailReg:      email.NewRegistry(),
 		dlqReg:        dlq.NewRegistry(),
+		searchReg:     search.NewRegistry(),
 		routerReg:     router.NewRegistry(),
 		adminHandlers: adminhandlers.New(),
 		ctx:           context.Background(),
@@ -188,6 +198,7 @@ func parseRoot(args []string) (*rootCmd, error) {
 	registerModules(r.routerReg, r.adminHandlers)
 	emaildefaults.Register(r.emailReg)
 	dlqdefaults.RegisterDefaults(r.dlqReg, r.emailReg)
+	searchdefaults.RegisterDefaults(r.searchReg)
 	dbdefaults.Register(r.dbReg)
 
 	early := newFlagSet(args[0])
@@ -1,10 +1,12 @@
 package main
 
 import (
+	"github.com/arran4/goa4web/handlers/activitypub"
 	"github.com/arran4/goa4web/handlers/admin"
 	"github.com/arran4/goa4web/handlers/auth"
 	"github.com/arran4/goa4web/handlers/blogs"
 	"github.com/arran4/goa4web/handlers/bookmarks"
+	"github.com/arran4/goa4web/handlers/emailevents"
 	"github.com/arran4/goa4web/handlers/externallink"
 	"github.com/arran4/goa4web/handlers/faq"
 	"github.com/arran4/goa4web/handlers/forum"
@@ -14,6 +16,9 @@ import (
 	"github.com/arran4/goa4web/handlers/linker"
 	"github.com/arran4/goa4web/handlers/news"
 	"github.com/arran4/goa4web/handlers/privateforum"
+	"github.com/arran4/goa4web/handlers/reactions"
+	"github.com/arran4/goa4web/handlers/reports"
+	"github.com/arran4/goa4web/handlers/revisions"
 	"github.com/arran4/goa4web/handlers/search"
 	"github.com/arran4/goa4web/handlers/user"
 	"github.com/arran4/goa4web/handlers/writings"
@@ -26,9 +31,11 @@ var extraRegistrations []func(*router.Registry)
 // registerModules registers all router modules used by the application.
 func registerModules(reg *router.Registry, ah *admin.Handlers) {
 	ah.Register(reg)
+	activitypub.Register(reg)
 	auth.Register(reg)
 	blogs.Register(reg)
 	bookmarks.Register(reg)
+	emailevents.Register(reg)
 	faq.Register(reg)
 	forum.Register(reg)
 	imagebbs.Register(reg)
@@ -36,6 +43,9 @@ func registerModules(reg *router.Registry, ah *admin.Handlers) {
 	linker.Register(reg)
 	news.Register(reg)
 	privateforum.Register(reg)
+	reactions.Register(reg)
+	reports.Register(reg)
+	revisions.Register(reg)
 	search.Register(reg)
 	images.Register(reg)
 	externallink.Register(reg)
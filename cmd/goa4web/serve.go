@@ -78,6 +78,10 @@ func (c *serveCmd) Run() error {
 	if err != nil {
 		return fmt.Errorf("share sign secret: %w", err)
 	}
+	replyKey, err := config.LoadOrCreateEmailReplySecret(core.OSFS{}, cfg.EmailReplySecret, cfg.EmailReplySecretFile)
+	if err != nil {
+		return fmt.Errorf("email reply secret: %w", err)
+	}
 	apiKey, err := config.LoadOrCreateAdminAPISecret(core.OSFS{}, cfg.AdminAPISecret, cfg.AdminAPISecretFile)
 	if err != nil {
 		return fmt.Errorf("admin api secret: %w", err)
@@ -89,9 +93,11 @@ func (c *serveCmd) Run() error {
 		app.WithImageSignSecret(signKey),
 		app.WithLinkSignSecret(linkKey),
 		app.WithShareSignSecret(shareKey),
+		app.WithEmailReplySecret(replyKey),
 		app.WithDBRegistry(c.dbReg),
 		app.WithEmailRegistry(c.emailReg),
 		app.WithDLQRegistry(c.dlqReg),
+		app.WithSearchRegistry(c.searchReg),
 		app.WithTasksRegistry(c.tasksReg),
 		app.WithAPISecret(apiKey),
 		app.WithRouterRegistry(c.routerReg),
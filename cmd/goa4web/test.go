@@ -226,6 +226,8 @@ func (c *testMigrationsCleanCmd) Run() error {
 	switch c.DBType {
 	case "mysql":
 		q = "SHOW TABLES"
+	case "postgres":
+		q = "SELECT tablename FROM pg_catalog.pg_tables WHERE schemaname = current_schema()"
 	default:
 		return fmt.Errorf("unsupported driver %s", c.DBType)
 	}
@@ -151,6 +151,12 @@ func (c *userCmd) Run() error {
 			return fmt.Errorf("subscriptions: %w", err)
 		}
 		return cmd.Run()
+	case "2fa-reset":
+		cmd, err := parseUser2FAResetCmd(c, args[1:])
+		if err != nil {
+			return fmt.Errorf("2fa-reset: %w", err)
+		}
+		return cmd.Run()
 	case "expunge-unverified":
 		cmd, err := parseUserExpungeUnverifiedCmd(c, args[1:])
 		if err != nil {
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// user2FAResetCmd implements "user 2fa-reset" to clear a user's TOTP secret
+// and recovery codes.
+type user2FAResetCmd struct {
+	*userCmd
+	fs       *flag.FlagSet
+	ID       int
+	Username string
+}
+
+func parseUser2FAResetCmd(parent *userCmd, args []string) (*user2FAResetCmd, error) {
+	c := &user2FAResetCmd{userCmd: parent}
+	fs, _, err := parseFlags("2fa-reset", args, func(fs *flag.FlagSet) {
+		fs.IntVar(&c.ID, "user-id", 0, "user id")
+		fs.StringVar(&c.Username, "username", "", "username")
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.fs = fs
+	return c, nil
+}
+
+func (c *user2FAResetCmd) Usage() {
+	_ = executeUsage(c.fs.Output(), "user_2fa_reset_usage.txt", c)
+}
+
+func (c *user2FAResetCmd) FlagGroups() []flagGroup {
+	return []flagGroup{{Title: c.fs.Name() + " flags", Flags: flagInfos(c.fs)}}
+}
+
+var _ usageData = (*user2FAResetCmd)(nil)
+
+func (c *user2FAResetCmd) Run() error {
+	conn, err := c.DB()
+	if err != nil {
+		return fmt.Errorf("database: %w", err)
+	}
+	ctx := context.Background()
+	queries := db.New(conn)
+	uid, err := resolveUserID(ctx, queries, c.ID, c.Username)
+	if err != nil {
+		return err
+	}
+	c.Verbosef("resetting two-factor authentication for user %d", uid)
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	qtx := queries.WithTx(tx)
+	if err := qtx.DeleteRecoveryCodesForUser(ctx, uid); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("delete recovery codes: %w", err)
+	}
+	if err := qtx.DeleteTOTPForUser(ctx, uid); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("delete totp: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	fmt.Printf("two-factor authentication reset for user %d\n", uid)
+	return nil
+}
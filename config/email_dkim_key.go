@@ -0,0 +1,22 @@
+package config
+
+import (
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/internal/secrets"
+)
+
+// emailDKIMKeyName is the filename used for storing the DKIM private key.
+const emailDKIMKeyName = "email_dkim_key.pem"
+
+// DefaultEmailDKIMKeyPath returns the default path for the DKIM private key based on the execution environment.
+func DefaultEmailDKIMKeyPath() string {
+	return secrets.DefaultPath(emailDKIMKeyName, EnvDocker)
+}
+
+// LoadEmailDKIMKey retrieves the PEM encoded DKIM private key from the
+// environment or a file. Unlike the signing secrets it is never generated
+// here because the matching public key must first be published in DNS; use
+// `goa4web email dkim` to create one. An empty result means no key exists.
+func LoadEmailDKIMKey(fs core.FileSystem, val, path string) (string, error) {
+	return secrets.Load(fs, val, path, EnvEmailDKIMKey, EnvEmailDKIMKeyFile, DefaultEmailDKIMKeyPath)
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/internal/secrets"
+)
+
+// emailReplySecretName is the filename used for storing the email reply signing key.
+const emailReplySecretName = "email_reply_secret"
+
+// DefaultEmailReplySecretPath returns the default path for the email reply signing key based on the execution environment.
+func DefaultEmailReplySecretPath() string {
+	return secrets.DefaultPath(emailReplySecretName, EnvDocker)
+}
+
+// LoadOrCreateEmailReplySecret retrieves the email reply signing secret from
+// the environment or a file, generating a new secret if needed.
+func LoadOrCreateEmailReplySecret(fs core.FileSystem, val, path string) (string, error) {
+	return secrets.LoadOrCreate(fs, val, path, EnvEmailReplySecret, EnvEmailReplySecretFile, DefaultEmailReplySecretPath)
+}
@@ -19,8 +19,18 @@ const (
 	// EnvDBName is the database name.
 	EnvDBName = "DB_NAME"
 
-	// EnvEmailProvider selects the mail sending backend.
+	// EnvEmailProvider selects the mail sending backend. A comma separated
+	// list names providers to fail over between, in order of preference.
 	EnvEmailProvider = "EMAIL_PROVIDER"
+	// EnvEmailProviderRateLimits caps the send rate of individual providers,
+	// for example "ses=14/s,smtp=100/m".
+	EnvEmailProviderRateLimits = "EMAIL_PROVIDER_RATE_LIMITS"
+	// EnvEmailProviderFailureThreshold is the number of consecutive send
+	// failures after which a provider is skipped for the cooldown period.
+	EnvEmailProviderFailureThreshold = "EMAIL_PROVIDER_FAILURE_THRESHOLD"
+	// EnvEmailProviderCooldown is the number of seconds a failing provider is
+	// skipped before it is tried again.
+	EnvEmailProviderCooldown = "EMAIL_PROVIDER_COOLDOWN"
 	// EnvSMTPHost is the SMTP server hostname.
 	EnvSMTPHost = "SMTP_HOST"
 	// EnvSMTPPort is the SMTP server port.
@@ -40,6 +50,34 @@ const (
 	EnvEmailSubjectPrefix = "EMAIL_SUBJECT_PREFIX"
 	// EnvEmailSignOff specifies the sign-off text appended to emails.
 	EnvEmailSignOff = "EMAIL_SIGNOFF"
+	// EnvEmailDKIMDomain is the signing domain (d=) of DKIM signatures on
+	// outbound mail. Leaving it empty disables DKIM signing.
+	EnvEmailDKIMDomain = "EMAIL_DKIM_DOMAIN"
+	// EnvEmailDKIMSelector is the DKIM selector (s=) naming the DNS record
+	// holding the public key.
+	EnvEmailDKIMSelector = "EMAIL_DKIM_SELECTOR"
+	// EnvEmailReplyDomain sets the domain of the Reply-To addresses that let
+	// users answer reply notifications by email.
+	EnvEmailReplyDomain = "EMAIL_REPLY_DOMAIN"
+	// EnvEmailReplyListen sets the address of the LMTP/SMTP listener that
+	// receives email replies.
+	EnvEmailReplyListen = "EMAIL_REPLY_LISTEN"
+	// EnvEmailReplyMaildir names a maildir polled for email replies.
+	EnvEmailReplyMaildir = "EMAIL_REPLY_MAILDIR"
+	// EnvEmailReplyPollInterval controls how often the reply maildir is
+	// polled in seconds.
+	EnvEmailReplyPollInterval = "EMAIL_REPLY_POLL_INTERVAL"
+	// EnvEmailBounceDomain sets the domain of the signed return paths that
+	// outgoing mail is sent with, so bounces can be matched to the mail sent.
+	EnvEmailBounceDomain = "EMAIL_BOUNCE_DOMAIN"
+	// EnvEmailBounceListen sets the address of the LMTP/SMTP listener that
+	// receives delivery status notifications and abuse reports.
+	EnvEmailBounceListen = "EMAIL_BOUNCE_LISTEN"
+	// EnvEmailBounceMaildir names a maildir polled for bounce messages.
+	EnvEmailBounceMaildir = "EMAIL_BOUNCE_MAILDIR"
+	// EnvEmailBouncePollInterval controls how often the bounce maildir is
+	// polled in seconds.
+	EnvEmailBouncePollInterval = "EMAIL_BOUNCE_POLL_INTERVAL"
 	// EnvAWSRegion is the AWS region for the SES provider.
 	EnvAWSRegion = "AWS_REGION"
 	// EnvJMAPEndpoint is the JMAP API endpoint.
@@ -73,6 +111,10 @@ const (
 	// EnvFeedsEnabled toggles RSS and Atom feed generation.
 	EnvFeedsEnabled = "FEEDS_ENABLED"
 
+	// EnvActivityPubEnabled toggles ActivityPub federation of blogs and
+	// writings.
+	EnvActivityPubEnabled = "ACTIVITYPUB_ENABLED"
+
 	// EnvPageSizeMin defines the minimum allowed page size.
 	EnvPageSizeMin = "PAGE_SIZE_MIN"
 	// EnvPageSizeMax defines the maximum allowed page size.
@@ -126,6 +168,11 @@ const (
 	EnvEmailVerificationExpiryHours = "EMAIL_VERIFICATION_EXPIRY_HOURS"
 	// EnvPasswordResetExpiryHours sets the password reset expiry in hours.
 	EnvPasswordResetExpiryHours = "PASSWORD_RESET_EXPIRY_HOURS"
+	// EnvDraftRetentionDays sets how many days untouched drafts are kept.
+	EnvDraftRetentionDays = "DRAFT_RETENTION_DAYS"
+	// EnvMentionLimit sets how many distinct users one post can notify with
+	// @username mentions.
+	EnvMentionLimit = "MENTION_LIMIT"
 	// EnvLoginAttemptWindow defines the time window in minutes used to
 	// track failed login attempts.
 	EnvLoginAttemptWindow = "LOGIN_ATTEMPT_WINDOW"
@@ -154,6 +201,8 @@ const (
 	EnvImageThumbnailSize = "IMAGE_THUMBNAIL_SIZE"
 	// EnvImageThumbnailSizes sets allowed, comma-separated thumbnail bounds in width-by-height order.
 	EnvImageThumbnailSizes = "IMAGE_THUMBNAIL_SIZES"
+	// EnvImageSrcsetWidths sets the comma-separated widths of responsive image derivatives.
+	EnvImageSrcsetWidths = "IMAGE_SRCSET_WIDTHS"
 	// EnvImageMaxResizeBytes sets the maximum byte size of an image that triggers resizing in the cache server.
 	EnvImageMaxResizeBytes = "IMAGE_MAX_RESIZE_BYTES"
 	// EnvImageCacheProvider selects the cache storage backend.
@@ -190,6 +239,17 @@ const (
 	// EnvShareSignSecretFile specifies the file containing the share signing key.
 	EnvShareSignSecretFile = "SHARE_SIGN_SECRET_FILE"
 
+	// EnvEmailDKIMKey provides the PEM encoded DKIM private key.
+	EnvEmailDKIMKey = "EMAIL_DKIM_KEY"
+	// EnvEmailDKIMKeyFile specifies the file containing the DKIM private key.
+	EnvEmailDKIMKeyFile = "EMAIL_DKIM_KEY_FILE"
+
+	// EnvEmailReplySecret provides the signing key for email reply addresses.
+	EnvEmailReplySecret = "EMAIL_REPLY_SECRET"
+	// EnvEmailReplySecretFile specifies the file containing the email reply
+	// signing key.
+	EnvEmailReplySecretFile = "EMAIL_REPLY_SECRET_FILE"
+
 	// EnvDefaultLanguage specifies the site's default language.
 	EnvDefaultLanguage = "DEFAULT_LANGUAGE"
 
@@ -198,6 +258,14 @@ const (
 	// EnvDLQFile is the file path used by the file DLQ provider.
 	EnvDLQFile = "DLQ_FILE"
 
+	// EnvSearchBackend selects the full-text search backend.
+	EnvSearchBackend = "SEARCH_BACKEND"
+	// EnvSearchIndexDir is the directory used by the on-disk search index.
+	EnvSearchIndexDir = "SEARCH_INDEX_DIR"
+
+	// EnvReactions lists the reactions offered on comments and posts.
+	EnvReactions = "REACTIONS"
+
 	// EnvAutoMigrate toggles automatic database migrations on startup.
 	EnvAutoMigrate = "AUTO_MIGRATE"
 	// EnvMigrationsDir specifies a directory to load migrations from at runtime.
@@ -34,7 +34,7 @@ type BoolOption struct {
 // StringOptions lists the string runtime options shared by flag parsing and configuration generation.
 var StringOptions = []StringOption{
 	{"db-conn", EnvDBConn, "Database connection string. This is used to connect to the database.", "", nil, "db_conn.txt", func(c *RuntimeConfig) *string { return &c.DBConn }},
-	{"db-driver", EnvDBDriver, "Database driver to use. Supported drivers are 'mysql'.", "mysql", nil, "db_driver.txt", func(c *RuntimeConfig) *string { return &c.DBDriver }},
+	{"db-driver", EnvDBDriver, "Database driver to use. Supported drivers are 'mysql' and 'postgres'.", "mysql", nil, "db_driver.txt", func(c *RuntimeConfig) *string { return &c.DBDriver }},
 	{"db-timezone", EnvDBTimezone, "Timezone for the database connection.", "Australia/Melbourne", nil, "", func(c *RuntimeConfig) *string { return &c.DBTimezone }},
 	{"db-host", EnvDBHost, "Database server hostname.", "", nil, "", func(c *RuntimeConfig) *string { return &c.DBHost }},
 	{"db-port", EnvDBPort, "Database server port.", "", nil, "", func(c *RuntimeConfig) *string { return &c.DBPort }},
@@ -46,7 +46,8 @@ var StringOptions = []StringOption{
 	{"external-url", EnvExternalURL, "The base URL of the server (URI only).", "", nil, "", func(c *RuntimeConfig) *string { return &c.ExternalURL }},
 	{"host", EnvHost, "The hostname of the server.", "", nil, "", func(c *RuntimeConfig) *string { return &c.Host }},
 	{"hsts-header", EnvHSTSHeader, "The value for the Strict-Transport-Security header.", "max-age=63072000; includeSubDomains", nil, "", func(c *RuntimeConfig) *string { return &c.HSTSHeaderValue }},
-	{"email-provider", EnvEmailProvider, "The email provider to use. Supported providers are 'smtp', 'ses', 'jmap', and 'log'.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailProvider }},
+	{"email-provider", EnvEmailProvider, "The email provider to use. Supported providers are 'smtp', 'ses', 'sendgrid', 'jmap', and 'log'. A comma separated list fails over between providers in order.", "", []string{"ses", "ses,smtp"}, "", func(c *RuntimeConfig) *string { return &c.EmailProvider }},
+	{"email-provider-rate-limits", EnvEmailProviderRateLimits, "Per-provider send rate limits as name=count[/s|/m|/h] pairs separated by commas.", "", []string{"ses=14/s,smtp=100/m"}, "", func(c *RuntimeConfig) *string { return &c.EmailProviderRateLimits }},
 	{"smtp-host", EnvSMTPHost, "The hostname of the SMTP server.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailSMTPHost }},
 	{"smtp-port", EnvSMTPPort, "The port of the SMTP server.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailSMTPPort }},
 	{"smtp-user", EnvSMTPUser, "The username for the SMTP server.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailSMTPUser }},
@@ -55,6 +56,14 @@ var StringOptions = []StringOption{
 	{"email-from", EnvEmailFrom, "The default 'From' address for outgoing emails.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailFrom }},
 	{"email-subject-prefix", EnvEmailSubjectPrefix, "The prefix to add to the subject of all outgoing emails.", "goa4web", nil, "", func(c *RuntimeConfig) *string { return &c.EmailSubjectPrefix }},
 	{"email-signoff", EnvEmailSignOff, "A sign-off message to append to the end of all outgoing emails.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailSignOff }},
+	{"email-dkim-domain", EnvEmailDKIMDomain, "The domain outbound mail is DKIM signed for. Leave empty to send unsigned mail.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailDKIMDomain }},
+	{"email-dkim-selector", EnvEmailDKIMSelector, "The DKIM selector naming the DNS TXT record with the public key.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailDKIMSelector }},
+	{"email-reply-domain", EnvEmailReplyDomain, "The domain of Reply-To addresses that let users answer reply notifications by email.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailReplyDomain }},
+	{"email-reply-listen", EnvEmailReplyListen, "The address of the LMTP/SMTP listener receiving email replies.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailReplyListen }},
+	{"email-reply-maildir", EnvEmailReplyMaildir, "A maildir polled for email replies.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailReplyMaildir }},
+	{"email-bounce-domain", EnvEmailBounceDomain, "The domain of signed return paths that bounces and abuse reports are delivered to.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailBounceDomain }},
+	{"email-bounce-listen", EnvEmailBounceListen, "The address of the LMTP/SMTP listener receiving bounces and abuse reports.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailBounceListen }},
+	{"email-bounce-maildir", EnvEmailBounceMaildir, "A maildir polled for bounces and abuse reports.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailBounceMaildir }},
 	{"aws-region", EnvAWSRegion, "The AWS region to use for SES.", "", []string{"us-east-1"}, "", func(c *RuntimeConfig) *string { return &c.EmailAWSRegion }},
 	{"jmap-endpoint", EnvJMAPEndpoint, "The endpoint for the JMAP server.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailJMAPEndpoint }},
 	{"jmap-endpoint-override", EnvJMAPEndpointOverride, "The override URL for the JMAP endpoint, bypassing autodiscovery.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailJMAPEndpointOverride }},
@@ -78,8 +87,12 @@ var StringOptions = []StringOption{
 	{"image-cache-fetch-retry-delay", EnvImageCacheFetchRetryDelay, "Delay between remote image cache fetch retries, such as 1m or 30s.", "1m", nil, "", func(c *RuntimeConfig) *string { return &c.ImageCacheFetchRetryDelay }},
 	{"image-thumbnail-generator", EnvImageThumbnailGenerator, "The thumbnail generator backend to use ('bild' or 'draw').", "bild", nil, "", func(c *RuntimeConfig) *string { return &c.ImageThumbnailGenerator }},
 	{"image-thumbnail-sizes", EnvImageThumbnailSizes, "Comma-separated thumbnail bounds in width x height form. These also provide the user-selectable safe resize dimensions. The first size is generated on upload; the others are generated on demand.", "1024x800,2048x1600", []string{"1024x800,2048x1600"}, "", func(c *RuntimeConfig) *string { return &c.ImageThumbnailSizes }},
+	{"image-srcset-widths", EnvImageSrcsetWidths, "Comma-separated widths of the responsive derivatives generated for each upload and offered to browsers as srcset candidates. Widths at or above the original are skipped.", "320,640,1280", []string{"320,640,1280"}, "", func(c *RuntimeConfig) *string { return &c.ImageSrcsetWidths }},
 	{"dlq-provider", EnvDLQProvider, "The provider for the dead letter queue. Supported providers are 'file' and 'memory'.", "", nil, "", func(c *RuntimeConfig) *string { return &c.DLQProvider }},
 	{"dlq-file", EnvDLQFile, "The file path for the dead letter queue when using the 'file' provider.", "", nil, "", func(c *RuntimeConfig) *string { return &c.DLQFile }},
+	{"search-backend", EnvSearchBackend, "The full-text search backend. Supported backends are 'db' and 'index'.", "db", nil, "", func(c *RuntimeConfig) *string { return &c.SearchBackend }},
+	{"search-index-dir", EnvSearchIndexDir, "The directory for the on-disk search index when using the 'index' backend.", "", nil, "", func(c *RuntimeConfig) *string { return &c.SearchIndexDir }},
+	{"reactions", EnvReactions, "Comma-separated reactions offered on comments and posts in name=emoji form. Names are stored; removing one hides its existing reactions.", "like=👍,love=❤️,laugh=😂,wow=😮,sad=😢", nil, "", func(c *RuntimeConfig) *string { return &c.Reactions }},
 	{"session-name", EnvSessionName, "The name of the session cookie.", "my-session", nil, "", func(c *RuntimeConfig) *string { return &c.SessionName }},
 	{"admin-emails", EnvAdminEmails, "A comma-separated list of email addresses for administrative notifications.", "", nil, "", func(c *RuntimeConfig) *string { return &c.AdminEmails }},
 	{"session-secret", EnvSessionSecret, "The secret key used to encrypt session data.", "", nil, "", func(c *RuntimeConfig) *string { return &c.SessionSecret }},
@@ -91,6 +104,10 @@ var StringOptions = []StringOption{
 	{"link-sign-secret-file", EnvLinkSignSecretFile, "The path to a file containing the external link signing key.", "", nil, "", func(c *RuntimeConfig) *string { return &c.LinkSignSecretFile }},
 	{"share-sign-secret", EnvShareSignSecret, "The secret key used to sign share URLs.", "", nil, "", func(c *RuntimeConfig) *string { return &c.ShareSignSecret }},
 	{"share-sign-secret-file", EnvShareSignSecretFile, "The path to a file containing the share signing key.", "", nil, "", func(c *RuntimeConfig) *string { return &c.ShareSignSecretFile }},
+	{"email-dkim-key", EnvEmailDKIMKey, "The PEM encoded private key used to DKIM sign outbound mail.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailDKIMKey }},
+	{"email-dkim-key-file", EnvEmailDKIMKeyFile, "The path to a file containing the DKIM private key.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailDKIMKeyFile }},
+	{"email-reply-secret", EnvEmailReplySecret, "The secret key used to sign email reply addresses.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailReplySecret }},
+	{"email-reply-secret-file", EnvEmailReplySecretFile, "The path to a file containing the email reply signing key.", "", nil, "", func(c *RuntimeConfig) *string { return &c.EmailReplySecretFile }},
 	{"admin-api-secret", EnvAdminAPISecret, "The secret key used to sign administrator API tokens.", "", nil, "", func(c *RuntimeConfig) *string { return &c.AdminAPISecret }},
 	{"admin-api-secret-file", EnvAdminAPISecretFile, "The path to a file containing the administrator API signing key.", "", nil, "", func(c *RuntimeConfig) *string { return &c.AdminAPISecretFile }},
 	{"og-image-pattern", EnvOGImagePattern, "The pattern style to use for the Open Graph image.", "SierpinskiTriangle", nil, "", func(c *RuntimeConfig) *string { return &c.OGImagePattern }},
@@ -117,8 +134,14 @@ var IntOptions = []IntOption{
 	{"image-thumbnail-size", EnvImageThumbnailSize, "The legacy square fallback size of generated thumbnails.", 0, "", func(c *RuntimeConfig) *int { return &c.ImageThumbnailSize }},
 	{"image-max-resize-bytes", EnvImageMaxResizeBytes, "The maximum byte size of an image that triggers resizing in the cache server.", 20971520, "", func(c *RuntimeConfig) *int { return &c.ImageMaxResizeBytes }},
 	{"email-worker-interval", EnvEmailWorkerInterval, "The interval in seconds between runs of the email worker.", 0, "", func(c *RuntimeConfig) *int { return &c.EmailWorkerInterval }},
+	{"email-provider-failure-threshold", EnvEmailProviderFailureThreshold, "The number of consecutive send failures after which an email provider is skipped.", 0, "", func(c *RuntimeConfig) *int { return &c.EmailProviderFailureThreshold }},
+	{"email-provider-cooldown", EnvEmailProviderCooldown, "The number of seconds a failing email provider is skipped before it is retried.", 0, "", func(c *RuntimeConfig) *int { return &c.EmailProviderCooldown }},
+	{"email-reply-poll-interval", EnvEmailReplyPollInterval, "The interval in seconds between polls of the email reply maildir.", 0, "", func(c *RuntimeConfig) *int { return &c.EmailReplyPollInterval }},
+	{"email-bounce-poll-interval", EnvEmailBouncePollInterval, "The interval in seconds between polls of the bounce maildir.", 0, "", func(c *RuntimeConfig) *int { return &c.EmailBouncePollInterval }},
 	{"email-verification-expiry-hours", EnvEmailVerificationExpiryHours, "The number of hours an email verification request is valid for.", 0, "", func(c *RuntimeConfig) *int { return &c.EmailVerificationExpiryHours }},
 	{"password-reset-expiry-hours", EnvPasswordResetExpiryHours, "The number of hours a password reset request is valid for.", 0, "", func(c *RuntimeConfig) *int { return &c.PasswordResetExpiryHours }},
+	{"draft-retention-days", EnvDraftRetentionDays, "The number of days an untouched draft is kept before it is purged.", 0, "", func(c *RuntimeConfig) *int { return &c.DraftRetentionDays }},
+	{"mention-limit", EnvMentionLimit, "The number of distinct users a single post can notify with @username mentions.", 0, "", func(c *RuntimeConfig) *int { return &c.MentionLimit }},
 	{"login-attempt-window", EnvLoginAttemptWindow, "The window in minutes for tracking failed login attempts.", 15, "", func(c *RuntimeConfig) *int { return &c.LoginAttemptWindow }},
 	{"login-attempt-threshold", EnvLoginAttemptThreshold, "The number of failed login attempts allowed within the window before throttling.", 5, "", func(c *RuntimeConfig) *int { return &c.LoginAttemptThreshold }},
 	{"stats-start-year", EnvStatsStartYear, "The start year for usage statistics.", 2005, "", func(c *RuntimeConfig) *int { return &c.StatsStartYear }},
@@ -132,6 +155,7 @@ var IntOptions = []IntOption{
 var BoolOptions = []BoolOption{
 	{"og-image-rpg-theme", EnvOGImageRpgTheme, "Use the RPG theme for the Open Graph image.", false, "", func(c *RuntimeConfig) *bool { return &c.OGImageRpgTheme }},
 	{"feeds-enabled", EnvFeedsEnabled, "Enable or disable RSS/Atom feeds.", true, "", func(c *RuntimeConfig) *bool { return &c.FeedsEnabled }},
+	{"activitypub-enabled", EnvActivityPubEnabled, "Enable or disable ActivityPub federation of blogs and writings.", false, "", func(c *RuntimeConfig) *bool { return &c.ActivityPubEnabled }},
 	{"smtp-starttls", EnvSMTPStartTLS, "Enable or disable STARTTLS for SMTP connections.", true, "", func(c *RuntimeConfig) *bool { return &c.EmailSMTPStartTLS }},
 	{"jmap-insecure", EnvJMAPInsecure, "Skip TLS certificate verification for JMAP.", false, "", func(c *RuntimeConfig) *bool { return &c.EmailJMAPInsecure }},
 	{"email-enabled", EnvEmailEnabled, "Enable or disable the sending of queued emails.", true, "", func(c *RuntimeConfig) *bool { return &c.EmailEnabled }},
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -76,6 +77,23 @@ func (c *RuntimeConfig) ThumbnailSizes() []ThumbnailSize {
 	return []ThumbnailSize{{Width: DefaultImageThumbnailWidth, Height: DefaultImageThumbnailHeight}}
 }
 
+// SrcsetWidths returns the configured derivative widths in ascending order.
+func (c *RuntimeConfig) SrcsetWidths() []int {
+	if c == nil {
+		return nil
+	}
+	var widths []int
+	for value := range strings.SplitSeq(c.ImageSrcsetWidths, ",") {
+		w, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || w <= 0 || slices.Contains(widths, w) {
+			continue
+		}
+		widths = append(widths, w)
+	}
+	slices.Sort(widths)
+	return widths
+}
+
 // SafeImageDimensions returns the user-selectable resize dimensions from the thumbnail configuration.
 func (c *RuntimeConfig) SafeImageDimensions() []string {
 	sizes := c.ThumbnailSizes()
@@ -135,8 +153,40 @@ type RuntimeConfig struct {
 	EmailJMAPDiscoveryRetries int
 	EmailSendGridKey          string
 	EmailSubjectPrefix        string
+	// EmailProviderRateLimits lists per-provider send rate limits.
+	EmailProviderRateLimits string
+	// EmailProviderFailureThreshold is the number of consecutive failures
+	// that take a provider out of rotation.
+	EmailProviderFailureThreshold int
+	// EmailProviderCooldown is how long in seconds a failing provider stays
+	// out of rotation.
+	EmailProviderCooldown int
 	// EmailSignOff defines the optional sign-off appended to emails.
 	EmailSignOff string
+	// EmailDKIMDomain enables DKIM signing of outbound mail for this domain.
+	EmailDKIMDomain string
+	// EmailDKIMSelector names the DNS record publishing the DKIM public key.
+	EmailDKIMSelector string
+	// EmailReplyDomain enables reply by email using Reply-To addresses in
+	// this domain.
+	EmailReplyDomain string
+	// EmailReplyListen is the LMTP/SMTP listen address for email replies.
+	EmailReplyListen string
+	// EmailReplyMaildir is a maildir polled for email replies.
+	EmailReplyMaildir string
+	// EmailReplyPollInterval sets how often the reply maildir is polled in
+	// seconds.
+	EmailReplyPollInterval int
+	// EmailBounceDomain is the domain of the signed return paths used as the
+	// envelope sender. Mailed bounces are only processed when it is set.
+	EmailBounceDomain string
+	// EmailBounceListen is the LMTP/SMTP listen address for bounce messages.
+	EmailBounceListen string
+	// EmailBounceMaildir is a maildir polled for bounce messages.
+	EmailBounceMaildir string
+	// EmailBouncePollInterval sets how often the bounce maildir is polled in
+	// seconds.
+	EmailBouncePollInterval int
 
 	// EmailEnabled toggles sending queued emails.
 	EmailEnabled bool
@@ -157,6 +207,11 @@ type RuntimeConfig struct {
 	EmailVerificationExpiryHours int
 	// PasswordResetExpiryHours sets how long password reset requests remain valid.
 	PasswordResetExpiryHours int
+	// DraftRetentionDays sets how long untouched editor drafts are kept.
+	DraftRetentionDays int
+	// MentionLimit caps the users notified for the @username mentions in a
+	// single post; later mentions are ignored.
+	MentionLimit int
 	// LoginAttemptWindow defines the timeframe in minutes used when counting
 	// failed login attempts for throttling.
 	LoginAttemptWindow int
@@ -171,6 +226,9 @@ type RuntimeConfig struct {
 	FeedsEnabled    bool
 	StatsStartYear  int
 	DefaultLanguage string
+	// ActivityPubEnabled publishes blogs and writings to fediverse
+	// followers.
+	ActivityPubEnabled bool
 	// Timezone defines the default site timezone used when users have not
 	// specified their own.
 	Timezone string
@@ -199,11 +257,22 @@ type RuntimeConfig struct {
 	ImageThumbnailSize             int
 	// ImageThumbnailSizes lists allowed thumbnail bounds in default-first width-by-height order.
 	ImageThumbnailSizes string
+	// ImageSrcsetWidths lists the widths of the derivatives generated for each upload.
+	ImageSrcsetWidths   string
 	ImageMaxResizeBytes int
 
 	DLQProvider string
 	DLQFile     string
 
+	// SearchBackend selects the full-text search backend.
+	SearchBackend string
+	// SearchIndexDir is the directory holding the on-disk search index.
+	SearchIndexDir string
+
+	// Reactions lists the reactions offered on comments and posts as
+	// comma-separated name=emoji pairs.
+	Reactions string
+
 	// SessionName specifies the cookie name used for session data.
 	SessionName string
 
@@ -228,6 +297,16 @@ type RuntimeConfig struct {
 	// ShareSignSecretFile specifies the path to the share signing key.
 	ShareSignSecretFile string
 
+	// EmailDKIMKey holds the PEM encoded DKIM private key.
+	EmailDKIMKey string
+	// EmailDKIMKeyFile specifies the path to the DKIM private key.
+	EmailDKIMKeyFile string
+
+	// EmailReplySecret is used to sign email reply addresses.
+	EmailReplySecret string
+	// EmailReplySecretFile specifies the path to the email reply signing key.
+	EmailReplySecretFile string
+
 	// AdminAPISecret is used to sign administrator API tokens.
 	AdminAPISecret string
 	// AdminAPISecretFile specifies the path to the administrator API signing key.
@@ -518,6 +597,9 @@ func normalizeRuntimeConfig(cfg *RuntimeConfig) {
 	if cfg.ImageCacheDir == "" {
 		cfg.ImageCacheDir = DefaultCacheDir()
 	}
+	if cfg.SearchIndexDir == "" {
+		cfg.SearchIndexDir = filepath.Join(DefaultDataDir(), "search")
+	}
 	if cfg.ImageMaxBytes == 0 {
 		cfg.ImageMaxBytes = 50 * 1024 * 1024
 	}
@@ -527,12 +609,30 @@ func normalizeRuntimeConfig(cfg *RuntimeConfig) {
 	if cfg.EmailWorkerInterval == 0 {
 		cfg.EmailWorkerInterval = 60
 	}
+	if cfg.EmailProviderFailureThreshold == 0 {
+		cfg.EmailProviderFailureThreshold = 5
+	}
+	if cfg.EmailProviderCooldown == 0 {
+		cfg.EmailProviderCooldown = 60
+	}
+	if cfg.EmailReplyPollInterval == 0 {
+		cfg.EmailReplyPollInterval = 60
+	}
+	if cfg.EmailBouncePollInterval == 0 {
+		cfg.EmailBouncePollInterval = 60
+	}
 	if cfg.EmailVerificationExpiryHours == 0 {
 		cfg.EmailVerificationExpiryHours = 24
 	}
 	if cfg.PasswordResetExpiryHours == 0 {
 		cfg.PasswordResetExpiryHours = 24
 	}
+	if cfg.DraftRetentionDays == 0 {
+		cfg.DraftRetentionDays = 30
+	}
+	if cfg.MentionLimit == 0 {
+		cfg.MentionLimit = 10
+	}
 
 }
 
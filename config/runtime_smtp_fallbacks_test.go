@@ -59,3 +59,13 @@ func TestApplySMTPFallbacksMismatch(t *testing.T) {
 		t.Fatalf("changed: %#v", cfg)
 	}
 }
+
+func TestApplySMTPFallbacksFailoverList(t *testing.T) {
+	cfg := config.RuntimeConfig{EmailProvider: "ses, SMTP", EmailSMTPUser: "user@example.com"}
+	if err := config.ApplySMTPFallbacks(&cfg); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+	if cfg.EmailFrom != "user@example.com" {
+		t.Fatalf("from=%q", cfg.EmailFrom)
+	}
+}
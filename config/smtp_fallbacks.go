@@ -6,12 +6,12 @@ import (
 	"strings"
 )
 
-// ApplySMTPFallbacks ensures EmailFrom and EmailSMTPUser are set when using the
-// SMTP provider. If one is blank but the other looks like an email address it
+// ApplySMTPFallbacks ensures EmailFrom and EmailSMTPUser are set when the SMTP
+// provider is configured, alone or as part of a failover list. If one is blank but the other looks like an email address it
 // is copied over and the action is logged. If both remain empty an error is
 // returned. If both are set to different addresses a warning is logged.
 func ApplySMTPFallbacks(cfg *RuntimeConfig) error {
-	if strings.ToLower(cfg.EmailProvider) != "smtp" {
+	if !usesSMTP(cfg.EmailProvider) {
 		return nil
 	}
 	if cfg.EmailFrom == "" && cfg.EmailSMTPUser == "" {
@@ -32,3 +32,13 @@ func ApplySMTPFallbacks(cfg *RuntimeConfig) error {
 	}
 	return nil
 }
+
+// usesSMTP reports whether the comma separated provider list includes smtp.
+func usesSMTP(providers string) bool {
+	for _, p := range strings.Split(providers, ",") {
+		if strings.EqualFold(strings.TrimSpace(p), "smtp") {
+			return true
+		}
+	}
+	return false
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/arran4/goa4web/internal/email"
 	"github.com/arran4/goa4web/internal/eventbus"
 	imagesign "github.com/arran4/goa4web/internal/images"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/sign"
 	"github.com/arran4/goa4web/internal/tasks"
 )
@@ -176,6 +177,7 @@ type CoreData struct {
 	absoluteURLBase   lazy.Value[string]  // cached base URL for absolute links
 	dbRegistry        *dbdrivers.Registry // database driver registry
 	emailRegistry     *email.Registry
+	searchBackend     search.Backend
 	Nav               NavigationProvider
 	NextLink          string
 	NotFoundLink      *NotFoundLink
@@ -549,31 +551,43 @@ func (cd *CoreData) Bloggers(r *http.Request) ([]*db.ListBloggersForListerRow, e
 // BlogList returns blog entries visible to the current user.
 func (cd *CoreData) BlogList() ([]*db.ListBlogEntriesForListerRow, error) {
 	return cd.cache.blogListRows.Load(func() ([]*db.ListBlogEntriesForListerRow, error) {
-		if cd.queries == nil {
+		return cd.fetchBlogList(int32(cd.cache.blogListOffset), int32(cd.PageSize()))
+	})
+}
+
+// BlogListPage returns blog entries visible to the current user without
+// needing an HTTP request.
+func (cd *CoreData) BlogListPage(offset, limit int32) ([]*db.ListBlogEntriesForListerRow, error) {
+	return cd.fetchBlogList(offset, limit)
+}
+
+func (cd *CoreData) fetchBlogList(offset, limit int32) ([]*db.ListBlogEntriesForListerRow, error) {
+	if cd.queries == nil {
+		return nil, nil
+	}
+	rows, err := cd.queries.ListBlogEntriesForLister(cd.ctx, db.ListBlogEntriesForListerParams{
+		ListerID:          cd.UserID,
+		UserID:            sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+		Limit:             limit,
+		Offset:            offset,
+		IsAdmin:           cd.IsAdmin(),
+		ShowScheduled:     cd.IsAdmin(),
+		ScheduledViewerID: cd.UserID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
-		rows, err := cd.queries.ListBlogEntriesForLister(cd.ctx, db.ListBlogEntriesForListerParams{
-			ListerID: cd.UserID,
-			UserID:   sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
-			Limit:    int32(cd.PageSize()),
-			Offset:   int32(cd.cache.blogListOffset),
-			IsAdmin:  cd.IsAdmin(),
-		})
-		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return nil, nil
-			}
-			return nil, err
-		}
-		var list []*db.ListBlogEntriesForListerRow
-		for _, row := range rows {
-			if !cd.HasGrant("blogs", "entry", "see", row.Idblogs) {
-				continue
-			}
-			list = append(list, row)
+		return nil, err
+	}
+	var list []*db.ListBlogEntriesForListerRow
+	for _, row := range rows {
+		if !cd.HasGrant("blogs", "entry", "see", row.Idblogs) {
+			continue
 		}
-		return list, nil
-	})
+		list = append(list, row)
+	}
+	return list, nil
 }
 
 // BlogListForSelectedAuthor returns blog entries for the selected author.
@@ -583,12 +597,14 @@ func (cd *CoreData) BlogListForSelectedAuthor() ([]*db.ListBlogEntriesByAuthorFo
 			return nil, nil
 		}
 		rows, err := cd.queries.ListBlogEntriesByAuthorForLister(cd.ctx, db.ListBlogEntriesByAuthorForListerParams{
-			AuthorID: cd.currentProfileUserID,
-			ListerID: cd.UserID,
-			UserID:   sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
-			Limit:    15,
-			Offset:   int32(cd.currentOffset),
-			IsAdmin:  cd.IsAdmin(),
+			AuthorID:          cd.currentProfileUserID,
+			ListerID:          cd.UserID,
+			UserID:            sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+			Limit:             15,
+			Offset:            int32(cd.currentOffset),
+			IsAdmin:           cd.IsAdmin(),
+			ShowScheduled:     cd.IsAdmin(),
+			ScheduledViewerID: cd.UserID,
 		})
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
@@ -1225,10 +1241,12 @@ func (cd *CoreData) fetchLatestNews(offset, limit int32) ([]*db.GetNewsPostsWith
 		return nil, nil
 	}
 	rows, err := cd.queries.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending(cd.ctx, db.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams{
-		ViewerID: cd.UserID,
-		UserID:   sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
-		Limit:    limit,
-		Offset:   offset,
+		ViewerID:          cd.UserID,
+		UserID:            sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+		ShowScheduled:     cd.IsAdmin(),
+		ScheduledViewerID: cd.UserID,
+		Limit:             limit,
+		Offset:            offset,
 	})
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
@@ -1468,7 +1486,11 @@ func (cd *CoreData) LatestWritings(opts ...LatestWritingsOption) ([]*db.Writing,
 		if cd.queries == nil {
 			return nil, nil
 		}
-		params := db.GetPublicWritingsParams{Limit: int32(cd.PageSize())}
+		params := db.GetPublicWritingsParams{
+			Limit:             int32(cd.PageSize()),
+			ShowScheduled:     cd.IsAdmin(),
+			ScheduledViewerID: cd.UserID,
+		}
 		for _, o := range opts {
 			o(&params)
 		}
@@ -1834,6 +1856,8 @@ func (cd *CoreData) PublicWritings(categoryID int32, r *http.Request) ([]*db.Lis
 			ListerID:          cd.UserID,
 			WritingCategoryID: categoryID,
 			UserID:            sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+			ShowScheduled:     cd.IsAdmin(),
+			ScheduledViewerID: cd.UserID,
 			Limit:             int32(cd.PageSize()),
 			Offset:            int32(offset),
 		})
@@ -1845,9 +1869,10 @@ func (cd *CoreData) PublicWritings(categoryID int32, r *http.Request) ([]*db.Lis
 		}
 		var res []*db.ListPublicWritingsInCategoryForListerRow
 		for _, row := range rows {
-			if cd.HasGrant("writing", "article", "see", row.Idwriting) {
-				res = append(res, row)
+			if !cd.HasGrant("writing", "article", "see", row.Idwriting) {
+				continue
 			}
+			res = append(res, row)
 		}
 		return res, nil
 	})
@@ -2486,6 +2511,16 @@ func (cd *CoreData) SetEventTask(t tasks.Task) {
 // SetSession stores s on cd for later retrieval.
 func (cd *CoreData) SetSession(s *sessions.Session) { cd.session = s }
 
+// SetActingUser switches cd to act as uid and discards cached data loaded for
+// the previous identity, such as roles and permissions.
+func (cd *CoreData) SetActingUser(uid int32) {
+	cd.UserID = uid
+	cd.cache.user = lazy.Value[*db.User]{}
+	cd.cache.userRoles = lazy.Value[[]string]{}
+	cd.cache.perms = lazy.Value[[]*db.GetPermissionsByUserIDRow]{}
+	cd.cache.pref = lazy.Value[*db.Preference]{}
+}
+
 // ImageBoards retrieves sub-boards under parentID lazily.
 func (cd *CoreData) SubImageBoards(parentID int32) ([]*db.Imageboard, error) {
 	if cd.queries == nil {
@@ -2958,6 +2993,11 @@ func WithDLQRegistry(r *dlq.Registry) CoreOption {
 	return func(cd *CoreData) { cd.DLQReg = r }
 }
 
+// WithSearchBackend sets the search backend used by CoreData.
+func WithSearchBackend(b search.Backend) CoreOption {
+	return func(cd *CoreData) { cd.searchBackend = b }
+}
+
 // WithDBRegistry sets the database driver registry for CoreData.
 func WithDBRegistry(r *dbdrivers.Registry) CoreOption {
 	return func(cd *CoreData) { cd.dbRegistry = r }
@@ -3163,6 +3203,15 @@ func WithWritingsOffset(o int32) LatestWritingsOption {
 	return func(p *db.GetPublicWritingsParams) { p.Offset = o }
 }
 
+// WithWritingsLiveOnly leaves out scheduled writings even for their authors
+// and administrators.
+func WithWritingsLiveOnly() LatestWritingsOption {
+	return func(p *db.GetPublicWritingsParams) {
+		p.ShowScheduled = false
+		p.ScheduledViewerID = 0
+	}
+}
+
 // WithWritingsLimit sets the query limit.
 func WithWritingsLimit(l int32) LatestWritingsOption {
 	return func(p *db.GetPublicWritingsParams) { p.Limit = l }
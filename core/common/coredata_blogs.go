@@ -78,6 +78,7 @@ func (cd *CoreData) UpdateBlogReply(commentID, commenterID, languageID int32, te
 	if err := cd.validateImagePathsForThread(commenterID, comment.ForumthreadID, paths); err != nil {
 		return fmt.Errorf("validate images: %w", err)
 	}
+	before := cd.RevisionSnapshot(RevisionTypeComment, commentID)
 	if err := cd.queries.UpdateCommentForEditor(cd.ctx, db.UpdateCommentForEditorParams{
 		LanguageID:  sql.NullInt32{Int32: languageID, Valid: languageID != 0},
 		Text:        sql.NullString{String: text, Valid: true},
@@ -87,6 +88,7 @@ func (cd *CoreData) UpdateBlogReply(commentID, commenterID, languageID int32, te
 	}); err != nil {
 		return err
 	}
+	cd.RecordRevision(before)
 	if err := cd.recordThreadImages(comment.ForumthreadID, paths); err != nil {
 		log.Printf("record thread images: %v", err)
 	}
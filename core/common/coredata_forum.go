@@ -173,6 +173,7 @@ func (cd *CoreData) UpdateForumComment(commentID, languageID int32, text string)
 	if err := cd.validateImagePathsForThread(cd.UserID, comment.ForumthreadID, paths); err != nil {
 		return fmt.Errorf("validate images: %w", err)
 	}
+	before := cd.RevisionSnapshot(RevisionTypeComment, commentID)
 	if err := cd.queries.UpdateCommentForEditor(cd.ctx, db.UpdateCommentForEditorParams{
 		LanguageID:  sql.NullInt32{Int32: languageID, Valid: languageID != 0},
 		Text:        sql.NullString{String: text, Valid: true},
@@ -182,6 +183,7 @@ func (cd *CoreData) UpdateForumComment(commentID, languageID int32, text string)
 	}); err != nil {
 		return err
 	}
+	cd.RecordRevision(before)
 	if err := cd.recordThreadImages(comment.ForumthreadID, paths); err != nil {
 		log.Printf("record thread images: %v", err)
 	}
@@ -205,6 +207,7 @@ func (cd *CoreData) EditForumComment(commentID, commenterID, languageID int32, t
 	if err := cd.validateImagePathsForThread(commenterID, comment.ForumthreadID, paths); err != nil {
 		return fmt.Errorf("validate images: %w", err)
 	}
+	before := cd.RevisionSnapshot(RevisionTypeComment, commentID)
 	if err := cd.queries.UpdateCommentForEditor(cd.ctx, db.UpdateCommentForEditorParams{
 		LanguageID:  sql.NullInt32{Int32: languageID, Valid: languageID != 0},
 		Text:        sql.NullString{String: text, Valid: true},
@@ -214,6 +217,7 @@ func (cd *CoreData) EditForumComment(commentID, commenterID, languageID int32, t
 	}); err != nil {
 		return err
 	}
+	cd.RecordRevision(before)
 	if err := cd.recordThreadImages(comment.ForumthreadID, paths); err != nil {
 		log.Printf("record thread images: %v", err)
 	}
@@ -5,14 +5,20 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"path"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gorilla/feeds"
 
 	"github.com/arran4/goa4web/a4code/a4code2html"
 	"github.com/arran4/goa4web/internal/db"
+	imagesign "github.com/arran4/goa4web/internal/images"
+	"github.com/arran4/goa4web/internal/upload"
 )
 
 // ImageBBSFeed constructs an RSS/Atom feed for the provided image posts.
@@ -117,7 +123,34 @@ func (cd *CoreData) ImageBBSThread(boardID, threadID int32) (*ImageBBSThread, er
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
-	return &ImageBBSThread{BoardID: int(boardID), ForumThreadID: int(threadID), ImagePost: post, Thread: thread}, nil
+	data := &ImageBBSThread{BoardID: int(boardID), ForumThreadID: int(threadID), ImagePost: post, Thread: thread}
+	if post != nil {
+		data.Srcset = cd.imageBBSSrcset(post.Fullimage.String)
+	}
+	return data, nil
+}
+
+// imageBBSSrcset returns a srcset attribute value listing the derivatives
+// stored beside an image board picture, or "" when there are none.
+func (cd *CoreData) imageBBSSrcset(fullImage string) string {
+	name, ok := strings.CutPrefix(fullImage, "/imagebbs/images/")
+	if !ok || cd.Config == nil {
+		return ""
+	}
+	p := upload.ProviderFromConfig(cd.Config)
+	if p == nil {
+		return ""
+	}
+	dir, file := path.Split(name)
+	var candidates []string
+	for _, w := range imagesign.DerivativeWidths(math.MaxInt, cd.Config.SrcsetWidths()) {
+		derivative := dir + imagesign.DerivativeName(file, w)
+		if _, err := p.Stat(cd.ctx, derivative); err != nil {
+			continue
+		}
+		candidates = append(candidates, "/imagebbs/images/"+derivative+" "+strconv.Itoa(w)+"w")
+	}
+	return strings.Join(candidates, ", ")
 }
 
 // ImageBBSThread encapsulates thread and post data for templates.
@@ -126,6 +159,8 @@ type ImageBBSThread struct {
 	ForumThreadID int
 	ImagePost     *db.GetImagePostByIDForListerRow
 	Thread        *db.GetThreadLastPosterAndPermsForUserRow
+	// Srcset lists the picture's stored srcset derivatives.
+	Srcset string
 }
 
 // ImageBBSThreadPosts retrieves comment rows for the currently selected thread.
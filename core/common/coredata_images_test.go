@@ -254,6 +254,7 @@ func TestStoreImageRecordsDefaultThumbnail(t *testing.T) {
 	cfg.ImageUploadProvider = providerName
 	cfg.ImageCacheProvider = providerName
 	cfg.ImageThumbnailSizes = "128x64,256x128"
+	cfg.ImageSrcsetWidths = ""
 	cd := NewCoreData(context.Background(), queries, cfg)
 	cd.UserID = 1
 
@@ -281,6 +282,68 @@ func TestStoreImageRecordsDefaultThumbnail(t *testing.T) {
 	}
 }
 
+func TestStoreImageRecordsSrcsetDerivatives(t *testing.T) {
+	provider := newMemoryCacheProvider()
+	providerName := registerMemoryCacheProvider(t, provider)
+	queries := testhelpers.NewQuerierStub(testhelpers.WithGrant("images", "upload", "post"))
+	queries.CreateUploadedImageForUploaderResult = 42
+	cfg := config.NewRuntimeConfig()
+	cfg.ImageUploadProvider = providerName
+	cfg.ImageCacheProvider = providerName
+	cfg.ImageThumbnailSizes = "128x64"
+	cfg.ImageSrcsetWidths = "160,320,640"
+	cd := NewCoreData(context.Background(), queries, cfg)
+	cd.UserID = 1
+
+	imageID := "abcd1234"
+	if _, err := cd.StoreImage(StoreImageParams{
+		ID:         imageID,
+		Ext:        ".png",
+		Data:       []byte("image"),
+		Image:      image.NewRGBA(image.Rect(0, 0, 640, 480)),
+		UploaderID: 1,
+	}); err != nil {
+		t.Fatalf("StoreImage: %v", err)
+	}
+	for _, id := range []string{"abcd1234_w160.png", "abcd1234_w320.png"} {
+		if _, err := provider.Read(context.Background(), path.Join(imageID[:2], imageID[2:4], id)); err != nil {
+			t.Fatalf("read derivative %s: %v", id, err)
+		}
+	}
+	if _, err := provider.Read(context.Background(), path.Join(imageID[:2], imageID[2:4], "abcd1234_w640.png")); err == nil {
+		t.Fatal("derivative as wide as the original was generated")
+	}
+	if len(queries.UpsertImageCacheEntryCalls) != 3 {
+		t.Fatalf("cache entry calls = %d", len(queries.UpsertImageCacheEntryCalls))
+	}
+	entry := queries.UpsertImageCacheEntryCalls[1]
+	if entry.ID != "abcd1234_w160.png" || !entry.Width.Valid || entry.Width.Int32 != 160 || entry.Height.Int32 != 120 {
+		t.Fatalf("derivative cache entry = %#v", entry)
+	}
+}
+
+func TestImageBBSSrcsetListsStoredDerivatives(t *testing.T) {
+	provider := newMemoryCacheProvider()
+	providerName := registerMemoryCacheProvider(t, provider)
+	cfg := config.NewRuntimeConfig()
+	cfg.ImageUploadProvider = providerName
+	cfg.ImageSrcsetWidths = "640,160,320"
+	cd := NewCoreData(context.Background(), testhelpers.NewQuerierStub(), cfg)
+	for _, name := range []string{"ab/cd/abcd1234.png", "ab/cd/abcd1234_w160.png", "ab/cd/abcd1234_w320.png"} {
+		if err := provider.Write(context.Background(), name, []byte("image")); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	want := "/imagebbs/images/ab/cd/abcd1234_w160.png 160w, /imagebbs/images/ab/cd/abcd1234_w320.png 320w"
+	if got := cd.imageBBSSrcset("/imagebbs/images/ab/cd/abcd1234.png"); got != want {
+		t.Fatalf("srcset = %q, want %q", got, want)
+	}
+	if got := cd.imageBBSSrcset("/imagebbs/images/ab/cd/ef567890.png"); got != "" {
+		t.Fatalf("srcset without derivatives = %q", got)
+	}
+}
+
 func TestSanitizeCodeImagesQueuesImageAliasGoogleRedirect(t *testing.T) {
 	queries := testhelpers.NewQuerierStub()
 	cfg := config.NewRuntimeConfig()
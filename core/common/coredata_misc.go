@@ -100,6 +100,30 @@ func thumbnailFilename(imageID, ext string, size config.ThumbnailSize) string {
 	return imageID + "_thumb_" + strconv.Itoa(size.Width) + "x" + strconv.Itoa(size.Height) + ext
 }
 
+// storeImageDerivatives writes the width-bounded srcset derivatives of an
+// upload to the cache provider and records them against source.
+func (cd *CoreData) storeImageDerivatives(cp upload.Provider, source *db.UploadedImage, p StoreImageParams, generator string) error {
+	if cd.Config == nil {
+		return nil
+	}
+	sub1, sub2 := p.ID[:2], p.ID[2:4]
+	for _, w := range imagesign.DerivativeWidths(p.Image.Bounds().Dx(), cd.Config.SrcsetWidths()) {
+		name := imagesign.DerivativeName(p.ID+p.Ext, w)
+		data, h, err := imagesign.GenerateDerivative(p.Image, p.Ext, generator, w)
+		if err != nil {
+			return fmt.Errorf("generate derivative %w", err)
+		}
+		if err := cp.Write(cd.ctx, path.Join(sub1, sub2, name), data); err != nil {
+			log.Printf("cache write: %v", err)
+			return fmt.Errorf("cache write %w", err)
+		}
+		if err := cd.RecordUploadedImageDerivative(cd.ctx, name, source, data, h, w); err != nil {
+			return fmt.Errorf("record image cache entry %w", err)
+		}
+	}
+	return nil
+}
+
 // UploadedImageByImageID returns an uploaded image using its file identifier.
 func (cd *CoreData) UploadedImageByImageID(imageID string) (*db.UploadedImage, error) {
 	if cd == nil || cd.queries == nil {
@@ -198,6 +222,9 @@ func (cd *CoreData) storeImageInternal(p StoreImageParams) (string, error) {
 		if err := cd.RecordUploadedImageThumbnail(cd.ctx, thumbName, source, thumbBytes, thumbnailHeight, thumbnailWidth); err != nil {
 			return "", fmt.Errorf("record image cache entry %w", err)
 		}
+		if err := cd.storeImageDerivatives(cp, source, p, generator); err != nil {
+			return "", err
+		}
 		if ccp, ok := cp.(upload.CacheProvider); ok {
 			if err := ccp.Cleanup(cd.ctx, int64(cfg.ImageCacheMaxBytes)); err != nil {
 				log.Printf("cache cleanup: %v", err)
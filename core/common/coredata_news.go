@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 )
 
 // newsTopicName is the default name for the hidden news forum.
@@ -115,6 +116,7 @@ func (cd *CoreData) UpdateNewsReply(commentID, editorID, languageID int32, text
 	if err != nil {
 		return ThreadInfo{}, fmt.Errorf("thread fetch: %w", err)
 	}
+	before := cd.RevisionSnapshot(RevisionTypeComment, commentID)
 	if err := cd.queries.UpdateCommentForEditor(cd.ctx, db.UpdateCommentForEditorParams{
 		LanguageID:  sql.NullInt32{Int32: languageID, Valid: languageID != 0},
 		Text:        sql.NullString{String: text, Valid: true},
@@ -124,6 +126,7 @@ func (cd *CoreData) UpdateNewsReply(commentID, editorID, languageID int32, text
 	}); err != nil {
 		return ThreadInfo{}, fmt.Errorf("update comment: %w", err)
 	}
+	cd.RecordRevision(before)
 	if err := cd.recordThreadImages(comment.ForumthreadID, paths); err != nil {
 		log.Printf("record thread images: %v", err)
 	}
@@ -138,14 +141,19 @@ func (cd *CoreData) UpdateNewsPost(postID, languageID, userID int32, text string
 	if err := cd.validateCodeImagesForUser(userID, text); err != nil {
 		return fmt.Errorf("validate images: %w", err)
 	}
-	return cd.queries.UpdateNewsPostForWriter(cd.ctx, db.UpdateNewsPostForWriterParams{
+	before := cd.RevisionSnapshot(RevisionTypeNews, postID)
+	if err := cd.queries.UpdateNewsPostForWriter(cd.ctx, db.UpdateNewsPostForWriterParams{
 		PostID:      postID,
 		GrantPostID: sql.NullInt32{Int32: postID, Valid: true},
 		LanguageID:  sql.NullInt32{Int32: languageID, Valid: languageID != 0},
 		News:        sql.NullString{String: text, Valid: true},
 		GranteeID:   sql.NullInt32{Int32: userID, Valid: userID != 0},
 		WriterID:    userID,
-	})
+	}); err != nil {
+		return err
+	}
+	cd.RecordRevision(before)
+	return nil
 }
 
 // DeleteNewsPost deactivates a news post.
@@ -189,58 +197,22 @@ func (cd *CoreData) CreateNewsPost(languageID, userID int32, text string) (int64
 	return id, nil
 }
 
-// SearchNews finds news posts matching searchwords. Returns flags indicating empty or no results.
+// SearchNews finds news posts matching searchwords in rank order. Returns flags indicating empty or no results.
 func (cd *CoreData) SearchNews(r *http.Request, uid int32) ([]*db.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow, bool, bool, error) {
 	if cd.queries == nil {
 		return nil, false, false, nil
 	}
-	searchWords := cd.searchWordsFromRequest(r)
-	if len(searchWords) == 0 {
-		return nil, true, false, nil
-	}
-	var newsIDs []int32
-	for i, word := range searchWords {
-		if i == 0 {
-			ids, err := cd.queries.ListSiteNewsSearchFirstForLister(cd.ctx, db.ListSiteNewsSearchFirstForListerParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					return nil, false, false, fmt.Errorf("news search first: %w", err)
-				}
-			}
-			newsIDs = ids
-		} else {
-			ids, err := cd.queries.ListSiteNewsSearchNextForLister(cd.ctx, db.ListSiteNewsSearchNextForListerParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				Ids:      newsIDs,
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					return nil, false, false, fmt.Errorf("news search next: %w", err)
-				}
-			}
-			newsIDs = ids
-		}
-		if len(newsIDs) == 0 {
-			return nil, false, true, nil
+	return searchVisible(cd, r, search.TypeNews, func(hits []search.Hit) ([]*db.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow, error) {
+		news, err := cd.queries.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCount(cd.ctx, db.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountParams{
+			ViewerID: uid,
+			Newsids:  search.IDs(hits),
+			UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("get news: %w", err)
 		}
-	}
-	news, err := cd.queries.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCount(cd.ctx, db.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountParams{
-		ViewerID: uid,
-		Newsids:  newsIDs,
-		UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
+		return orderByHits(hits, news, func(n *db.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow) int32 { return n.Idsitenews }), nil
 	})
-	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			return nil, false, false, fmt.Errorf("get news: %w", err)
-		}
-	}
-	return news, false, false, nil
 }
 
 // AllowNewsUser grants a role to username.
@@ -3,11 +3,15 @@ package common
 import (
 	"database/sql"
 	"errors"
+	"html/template"
 	"log"
 	"net/http"
+	"slices"
 	"strconv"
 
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
+	searchdb "github.com/arran4/goa4web/internal/search/db"
 )
 
 // linkerTopicName matches the hidden linker forum name.
@@ -176,175 +180,193 @@ func (cd *CoreData) SearchBlogsEmptyWords() bool {
 	return cd.cache.searchBlogsEmptyWords
 }
 
-func (cd *CoreData) linkerSearch(r *http.Request, uid int32) ([]*db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow, bool, bool, error) {
-	searchWords := cd.searchWordsFromRequest(r)
-	var linkerIDs []int32
+// searchHitPage is the number of ranked hits fetched from the search backend
+// at a time. Further pages are fetched while permission filtering leaves
+// fewer than searchResultLimit results.
+const searchHitPage = 1000
 
-	if len(searchWords) == 0 {
-		return nil, true, false, nil
-	}
+// searchResultLimit caps the number of visible results a search returns.
+const searchResultLimit = 1000
 
-	for i, word := range searchWords {
-		if i == 0 {
-			ids, err := cd.queries.LinkerSearchFirst(cd.ctx, db.LinkerSearchFirstParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("LinkersSearchFirst Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
-			}
-			linkerIDs = ids
-		} else {
-			ids, err := cd.queries.LinkerSearchNext(cd.ctx, db.LinkerSearchNextParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				Ids:      linkerIDs,
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("LinkersSearchNext Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
-			}
-			linkerIDs = ids
-		}
-		if len(linkerIDs) == 0 {
-			return nil, false, true, nil
-		}
-	}
+// searchMaxHitPages bounds how many pages of hits one search examines.
+const searchMaxHitPages = 20
 
-	links, err := cd.queries.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescending(cd.ctx, linkerIDs)
-	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			log.Printf("getLinkers Error: %s", err)
-			return nil, false, false, ErrInternalServerError
-		}
+// SearchBackend returns the configured search backend, falling back to the
+// database tables when none was supplied.
+func (cd *CoreData) SearchBackend() search.Backend {
+	if cd.searchBackend == nil {
+		cd.searchBackend = searchdb.New(cd.queries)
 	}
+	return cd.searchBackend
+}
 
-	return links, false, false, nil
+// SearchSnippet returns the highlighted excerpt the search backend produced
+// for the document, or fallback highlighted with the search words when the
+// backend supplied none.
+func (cd *CoreData) SearchSnippet(typ string, id int32, fallback string) template.HTML {
+	if s := cd.cache.searchSnippets[typ][id]; s != "" {
+		return template.HTML(s)
+	}
+	return HighlightSearchTerms(fallback, cd.SearchWords())
 }
 
-func (cd *CoreData) writingSearch(r *http.Request, uid int32) ([]*db.ListWritingsByIDsForListerRow, bool, bool, error) {
-	searchWords := cd.searchWordsFromRequest(r)
-	var writingsIDs []int32
+// SearchTopicComments searches comments posted in the given forum topics.
+// The returned flags report whether the search lacked words and whether it
+// found nothing.
+func (cd *CoreData) SearchTopicComments(r *http.Request, forumTopicIDs []int32) ([]*db.GetCommentsByIdsForUserWithThreadInfoRow, bool, bool, error) {
+	return cd.forumCommentSearchInRestrictedTopic(r, forumTopicIDs, cd.UserID)
+}
 
-	if len(searchWords) == 0 {
+// searchVisible pages through the backend's ranked hits for typ and passes
+// each page to visible, which returns the rows the viewer may see in rank
+// order. It records the hits' snippets and stops once searchResultLimit rows
+// are collected or the hits run out.
+func searchVisible[T any](cd *CoreData, r *http.Request, typ string, visible func([]search.Hit) ([]T, error)) ([]T, bool, bool, error) {
+	if len(cd.searchWordsFromRequest(r)) == 0 {
 		return nil, true, false, nil
 	}
-
-	for i, word := range searchWords {
-		if i == 0 {
-			ids, err := cd.queries.ListWritingSearchFirstForLister(cd.ctx, db.ListWritingSearchFirstForListerParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("writingSearchFirst Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
+	if cd.cache.searchSnippets == nil {
+		cd.cache.searchSnippets = map[string]map[int32]string{}
+	}
+	snippets := map[int32]string{}
+	cd.cache.searchSnippets[typ] = snippets
+	var out []T
+	for page := 0; page < searchMaxHitPages && len(out) < searchResultLimit; page++ {
+		hits, err := cd.SearchBackend().Search(cd.ctx, search.Query{
+			Type:   typ,
+			Text:   r.PostFormValue("searchwords"),
+			Offset: page * searchHitPage,
+			Limit:  searchHitPage,
+		})
+		if err != nil {
+			log.Printf("search %s Error: %s", typ, err)
+			return nil, false, false, ErrInternalServerError
+		}
+		for _, h := range hits {
+			if h.Snippet != "" {
+				snippets[h.ID] = h.Snippet
 			}
-			writingsIDs = ids
-		} else {
-			ids, err := cd.queries.ListWritingSearchNextForLister(cd.ctx, db.ListWritingSearchNextForListerParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				Ids:      writingsIDs,
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
+		}
+		if len(hits) > 0 {
+			rows, err := visible(hits)
 			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("writingSearchNext Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
+				return nil, false, false, err
 			}
-			writingsIDs = ids
+			out = append(out, rows...)
 		}
-		if len(writingsIDs) == 0 {
-			return nil, false, true, nil
+		if len(hits) < searchHitPage {
+			break
 		}
 	}
+	if len(out) == 0 {
+		return nil, false, true, nil
+	}
+	if len(out) > searchResultLimit {
+		out = out[:searchResultLimit]
+	}
+	return out, false, false, nil
+}
 
-	limit := int32(cd.Config.PageSizeDefault)
-	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
-
-	writings, err := cd.queries.ListWritingsByIDsForLister(cd.ctx, db.ListWritingsByIDsForListerParams{
-		ListerID:      uid,
-		ListerMatchID: sql.NullInt32{Int32: uid, Valid: uid != 0},
-		WritingIds:    writingsIDs,
-		Limit:         limit,
-		Offset:        int32(offset),
-	})
-	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			log.Printf("getWritings Error: %s", err)
-			return nil, false, false, ErrInternalServerError
+// orderByHits returns rows sorted into the rank order of hits. Rows without a
+// matching hit are dropped.
+func orderByHits[T any](hits []search.Hit, rows []T, id func(T) int32) []T {
+	byID := make(map[int32]T, len(rows))
+	for _, row := range rows {
+		byID[id(row)] = row
+	}
+	out := make([]T, 0, len(rows))
+	for _, h := range hits {
+		if row, ok := byID[h.ID]; ok {
+			out = append(out, row)
 		}
 	}
+	return out
+}
 
-	return writings, false, false, nil
+// pageOf returns the page of rows selected by the request's offset parameter.
+func (cd *CoreData) pageOf(r *http.Request, n int) (int, int) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if offset < 0 || offset > n {
+		offset = n
+	}
+	end := offset + cd.Config.PageSizeDefault
+	if cd.Config.PageSizeDefault <= 0 || end > n {
+		end = n
+	}
+	return offset, end
 }
 
-func (cd *CoreData) blogSearch(r *http.Request, uid int32) ([]*db.Blog, bool, bool, error) {
-	searchWords := cd.searchWordsFromRequest(r)
-	var blogIDs []int32
+func (cd *CoreData) linkerSearch(r *http.Request, uid int32) ([]*db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow, bool, bool, error) {
+	rows, emptyWords, noResults, err := searchVisible(cd, r, search.TypeLinker, func(hits []search.Hit) ([]*db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserRow, error) {
+		rows, err := cd.queries.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUser(cd.ctx, db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserParams{
+			ViewerID:     uid,
+			ViewerUserID: sql.NullInt32{Int32: uid, Valid: uid != 0},
+			Linkerids:    search.IDs(hits),
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("getLinkers Error: %s", err)
+			return nil, ErrInternalServerError
+		}
+		return orderByHits(hits, rows, func(l *db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserRow) int32 {
+			return l.ID
+		}), nil
+	})
+	if err != nil || emptyWords || noResults {
+		return nil, emptyWords, noResults, err
+	}
 
-	if len(searchWords) == 0 {
-		return nil, true, false, nil
+	links := make([]*db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow, 0, len(rows))
+	for _, l := range rows {
+		links = append(links, (*db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow)(l))
 	}
+	return links, false, false, nil
+}
 
-	for i, word := range searchWords {
-		if i == 0 {
-			ids, err := cd.queries.ListBlogIDsBySearchWordFirstForLister(cd.ctx, db.ListBlogIDsBySearchWordFirstForListerParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				UserID:   sql.NullInt32{Int32: uid, Valid: true},
-			})
-			if err != nil {
-				log.Printf("ListBlogIDsBySearchWordFirstForLister Error: %s", err)
-				return nil, false, false, ErrInternalServerError
-			}
-			blogIDs = ids
-		} else {
-			ids, err := cd.queries.ListBlogIDsBySearchWordNextForLister(cd.ctx, db.ListBlogIDsBySearchWordNextForListerParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				Ids:      blogIDs,
-				UserID:   sql.NullInt32{Int32: uid, Valid: true},
-			})
-			if err != nil {
-				log.Printf("ListBlogIDsBySearchWordNextForLister Error: %s", err)
-				return nil, false, false, ErrInternalServerError
-			}
-			blogIDs = ids
-		}
-		if len(blogIDs) == 0 {
-			return nil, false, true, nil
+func (cd *CoreData) writingSearch(r *http.Request, uid int32) ([]*db.ListWritingsByIDsForListerRow, bool, bool, error) {
+	writings, emptyWords, noResults, err := searchVisible(cd, r, search.TypeWriting, func(hits []search.Hit) ([]*db.ListWritingsByIDsForListerRow, error) {
+		rows, err := cd.queries.ListWritingsByIDsForLister(cd.ctx, db.ListWritingsByIDsForListerParams{
+			ListerID:      uid,
+			ListerMatchID: sql.NullInt32{Int32: uid, Valid: uid != 0},
+			WritingIds:    search.IDs(hits),
+			Limit:         int32(len(hits)),
+			Offset:        0,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("getWritings Error: %s", err)
+			return nil, ErrInternalServerError
 		}
+		return orderByHits(hits, rows, func(w *db.ListWritingsByIDsForListerRow) int32 { return w.Idwriting }), nil
+	})
+	if err != nil || emptyWords || noResults {
+		return nil, emptyWords, noResults, err
 	}
 
-	limit := int32(cd.Config.PageSizeDefault)
-	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	start, end := cd.pageOf(r, len(writings))
+	return writings[start:end], false, false, nil
+}
 
-	rows, err := cd.queries.ListBlogEntriesByIDsForLister(cd.ctx, db.ListBlogEntriesByIDsForListerParams{
-		ListerID: uid,
-		UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-		Blogids:  blogIDs,
-		Limit:    limit,
-		Offset:   int32(offset),
+func (cd *CoreData) blogSearch(r *http.Request, uid int32) ([]*db.Blog, bool, bool, error) {
+	rows, emptyWords, noResults, err := searchVisible(cd, r, search.TypeBlog, func(hits []search.Hit) ([]*db.ListBlogEntriesByIDsForListerRow, error) {
+		rows, err := cd.queries.ListBlogEntriesByIDsForLister(cd.ctx, db.ListBlogEntriesByIDsForListerParams{
+			ListerID: uid,
+			UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
+			Blogids:  search.IDs(hits),
+			Limit:    int32(len(hits)),
+			Offset:   0,
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("getBlogEntriesByIdsDescending Error: %s", err)
+			return nil, ErrInternalServerError
+		}
+		return orderByHits(hits, rows, func(b *db.ListBlogEntriesByIDsForListerRow) int32 { return b.Idblogs }), nil
 	})
-	if err != nil {
-		log.Printf("getBlogEntriesByIdsDescending Error: %s", err)
-		return nil, false, false, ErrInternalServerError
+	if err != nil || emptyWords || noResults {
+		return nil, emptyWords, noResults, err
 	}
-	blogs := make([]*db.Blog, 0, len(rows))
-	for _, r := range rows {
+
+	start, end := cd.pageOf(r, len(rows))
+	blogs := make([]*db.Blog, 0, end-start)
+	for _, r := range rows[start:end] {
 		blogs = append(blogs, &db.Blog{
 			Idblogs:       r.Idblogs,
 			ForumthreadID: r.ForumthreadID,
@@ -354,122 +376,39 @@ func (cd *CoreData) blogSearch(r *http.Request, uid int32) ([]*db.Blog, bool, bo
 			Written:       r.Written,
 		})
 	}
-
 	return blogs, false, false, nil
 }
 
-func (cd *CoreData) forumCommentSearchNotInRestrictedTopic(r *http.Request, uid int32) ([]*db.GetCommentsByIdsForUserWithThreadInfoRow, bool, bool, error) {
-	searchWords := cd.searchWordsFromRequest(r)
-	var commentIDs []int32
-
-	if len(searchWords) == 0 {
-		return nil, true, false, nil
-	}
-
-	for i, word := range searchWords {
-		if i == 0 {
-			ids, err := cd.queries.ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopic(cd.ctx, db.ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopicParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopic Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
-			}
-			commentIDs = ids
-		} else {
-			ids, err := cd.queries.ListCommentIDsBySearchWordNextForListerNotInRestrictedTopic(cd.ctx, db.ListCommentIDsBySearchWordNextForListerNotInRestrictedTopicParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				Ids:      commentIDs,
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("ListCommentIDsBySearchWordNextForListerNotInRestrictedTopic Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
-			}
-			commentIDs = ids
+// commentSearch returns the comments matching the search words that the
+// viewer may see and that satisfy keep.
+func (cd *CoreData) commentSearch(r *http.Request, uid int32, keep func(*db.GetCommentsByIdsForUserWithThreadInfoRow) bool) ([]*db.GetCommentsByIdsForUserWithThreadInfoRow, bool, bool, error) {
+	return searchVisible(cd, r, search.TypeComment, func(hits []search.Hit) ([]*db.GetCommentsByIdsForUserWithThreadInfoRow, error) {
+		rows, err := cd.queries.GetCommentsByIdsForUserWithThreadInfo(cd.ctx, db.GetCommentsByIdsForUserWithThreadInfoParams{
+			ViewerID: uid,
+			Ids:      search.IDs(hits),
+			UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
+		})
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("getCommentsByIds Error: %s", err)
+			return nil, ErrInternalServerError
 		}
-		if len(commentIDs) == 0 {
-			return nil, false, true, nil
+		var comments []*db.GetCommentsByIdsForUserWithThreadInfoRow
+		for _, c := range orderByHits(hits, rows, func(c *db.GetCommentsByIdsForUserWithThreadInfoRow) int32 { return c.Idcomments }) {
+			if keep(c) {
+				comments = append(comments, c)
+			}
 		}
-	}
-
-	comments, err := cd.queries.GetCommentsByIdsForUserWithThreadInfo(cd.ctx, db.GetCommentsByIdsForUserWithThreadInfoParams{
-		ViewerID: uid,
-		Ids:      commentIDs,
-		UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
+		return comments, nil
+	})
+}
+func (cd *CoreData) forumCommentSearchNotInRestrictedTopic(r *http.Request, uid int32) ([]*db.GetCommentsByIdsForUserWithThreadInfoRow, bool, bool, error) {
+	return cd.commentSearch(r, uid, func(c *db.GetCommentsByIdsForUserWithThreadInfoRow) bool {
+		return c.Idforumcategory.Valid && c.Idforumcategory.Int32 != 0
 	})
-	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			log.Printf("getCommentsByIds Error: %s", err)
-			return nil, false, false, ErrInternalServerError
-		}
-	}
-
-	return comments, false, false, nil
 }
 
 func (cd *CoreData) forumCommentSearchInRestrictedTopic(r *http.Request, forumTopicIDs []int32, uid int32) ([]*db.GetCommentsByIdsForUserWithThreadInfoRow, bool, bool, error) {
-	searchWords := cd.searchWordsFromRequest(r)
-	var commentIDs []int32
-
-	if len(searchWords) == 0 {
-		return nil, true, false, nil
-	}
-
-	for i, word := range searchWords {
-		if i == 0 {
-			ids, err := cd.queries.ListCommentIDsBySearchWordFirstForListerInRestrictedTopic(cd.ctx, db.ListCommentIDsBySearchWordFirstForListerInRestrictedTopicParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				Ftids:    forumTopicIDs,
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("ListCommentIDsBySearchWordFirstForListerInRestrictedTopic Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
-			}
-			commentIDs = ids
-		} else {
-			ids, err := cd.queries.ListCommentIDsBySearchWordNextForListerInRestrictedTopic(cd.ctx, db.ListCommentIDsBySearchWordNextForListerInRestrictedTopicParams{
-				ListerID: uid,
-				Word:     sql.NullString{String: word, Valid: true},
-				Ids:      commentIDs,
-				Ftids:    forumTopicIDs,
-				UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				if !errors.Is(err, sql.ErrNoRows) {
-					log.Printf("ListCommentIDsBySearchWordNextForListerInRestrictedTopic Error: %s", err)
-					return nil, false, false, ErrInternalServerError
-				}
-			}
-			commentIDs = ids
-		}
-		if len(commentIDs) == 0 {
-			return nil, false, true, nil
-		}
-	}
-
-	comments, err := cd.queries.GetCommentsByIdsForUserWithThreadInfo(cd.ctx, db.GetCommentsByIdsForUserWithThreadInfoParams{
-		ViewerID: uid,
-		Ids:      commentIDs,
-		UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
+	return cd.commentSearch(r, uid, func(c *db.GetCommentsByIdsForUserWithThreadInfoRow) bool {
+		return c.Idforumtopic.Valid && slices.Contains(forumTopicIDs, c.Idforumtopic.Int32)
 	})
-	if err != nil {
-		if !errors.Is(err, sql.ErrNoRows) {
-			log.Printf("getCommentsByIds Error: %s", err)
-			return nil, false, false, ErrInternalServerError
-		}
-	}
-
-	return comments, false, false, nil
 }
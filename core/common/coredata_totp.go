@@ -0,0 +1,168 @@
+package common
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/totp"
+)
+
+// ErrInvalidTOTPCode is returned when a submitted second factor code does not
+// match.
+var ErrInvalidTOTPCode = errors.New("invalid code")
+
+// TOTPForUser returns the TOTP record for userID or nil when none exists.
+func (cd *CoreData) TOTPForUser(userID int32) (*db.UserTotp, error) {
+	if cd.queries == nil || userID == 0 {
+		return nil, nil
+	}
+	row, err := cd.queries.GetTOTPForUser(cd.ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return row, err
+}
+
+// TOTPEnabled reports whether userID has completed TOTP enrolment.
+func (cd *CoreData) TOTPEnabled(userID int32) (bool, error) {
+	row, err := cd.TOTPForUser(userID)
+	if err != nil {
+		return false, err
+	}
+	return row != nil && row.EnabledAt.Valid, nil
+}
+
+// TwoFactorRequired reports whether one of userID's roles holds the
+// auth/2fa/require grant.
+func (cd *CoreData) TwoFactorRequired(userID int32) bool {
+	if cd.queries == nil || userID == 0 {
+		return false
+	}
+	_, err := cd.queries.SystemCheckGrant(cd.ctx, db.SystemCheckGrantParams{
+		ViewerID: userID,
+		Section:  "auth",
+		Item:     sql.NullString{String: "2fa", Valid: true},
+		Action:   "require",
+		UserID:   sql.NullInt32{Int32: userID, Valid: true},
+	})
+	return err == nil
+}
+
+// TOTPIssuer names the site in authenticator apps.
+func (cd *CoreData) TOTPIssuer() string {
+	if cd.SiteTitle != "" {
+		return cd.SiteTitle
+	}
+	return "goa4web"
+}
+
+// BeginTOTPEnrolment stores a new unconfirmed secret for userID, replacing
+// any existing one, and returns it.
+func (cd *CoreData) BeginTOTPEnrolment(userID int32) (string, error) {
+	if cd.queries == nil {
+		return "", fmt.Errorf("no queries")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", fmt.Errorf("generate secret: %w", err)
+	}
+	if err := cd.queries.SetPendingTOTPForUser(cd.ctx, db.SetPendingTOTPForUserParams{UserID: userID, Secret: secret}); err != nil {
+		return "", fmt.Errorf("store secret: %w", err)
+	}
+	return secret, nil
+}
+
+// ConfirmTOTPEnrolment enables the pending secret for userID when code
+// matches and returns a fresh set of recovery codes.
+func (cd *CoreData) ConfirmTOTPEnrolment(userID int32, code string) ([]string, error) {
+	row, err := cd.TOTPForUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	if row == nil {
+		return nil, fmt.Errorf("no pending enrolment")
+	}
+	step, ok := totp.Validate(row.Secret, code, time.Now())
+	if !ok {
+		return nil, ErrInvalidTOTPCode
+	}
+	if err := cd.queries.EnableTOTPForUser(cd.ctx, db.EnableTOTPForUserParams{Step: step, UserID: userID}); err != nil {
+		return nil, fmt.Errorf("enable totp: %w", err)
+	}
+	return cd.RegenerateRecoveryCodes(userID)
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes for userID.
+func (cd *CoreData) RegenerateRecoveryCodes(userID int32) ([]string, error) {
+	if cd.queries == nil {
+		return nil, fmt.Errorf("no queries")
+	}
+	codes, err := totp.GenerateRecoveryCodes(totp.RecoveryCodeCount)
+	if err != nil {
+		return nil, fmt.Errorf("generate recovery codes: %w", err)
+	}
+	if err := cd.queries.DeleteRecoveryCodesForUser(cd.ctx, userID); err != nil {
+		return nil, fmt.Errorf("delete recovery codes: %w", err)
+	}
+	for _, c := range codes {
+		if err := cd.queries.InsertRecoveryCodeForUser(cd.ctx, db.InsertRecoveryCodeForUserParams{
+			UserID:   userID,
+			CodeHash: totp.HashRecoveryCode(c),
+		}); err != nil {
+			return nil, fmt.Errorf("insert recovery code: %w", err)
+		}
+	}
+	return codes, nil
+}
+
+// UnusedRecoveryCodes returns how many recovery codes userID has left.
+func (cd *CoreData) UnusedRecoveryCodes(userID int32) (int64, error) {
+	if cd.queries == nil {
+		return 0, nil
+	}
+	return cd.queries.CountUnusedRecoveryCodesForUser(cd.ctx, userID)
+}
+
+// VerifySecondFactor checks code as either a current TOTP code or an unused
+// recovery code for userID. TOTP codes are accepted once only.
+func (cd *CoreData) VerifySecondFactor(userID int32, code string) (bool, error) {
+	row, err := cd.TOTPForUser(userID)
+	if err != nil {
+		return false, err
+	}
+	if row == nil || !row.EnabledAt.Valid {
+		return false, nil
+	}
+	if step, ok := totp.Validate(row.Secret, code, time.Now()); ok {
+		n, err := cd.queries.UpdateTOTPLastUsedStep(cd.ctx, db.UpdateTOTPLastUsedStepParams{Step: step, UserID: userID})
+		if err != nil {
+			return false, fmt.Errorf("update totp step: %w", err)
+		}
+		return n > 0, nil
+	}
+	n, err := cd.queries.UseRecoveryCodeForUser(cd.ctx, db.UseRecoveryCodeForUserParams{
+		UserID:   userID,
+		CodeHash: totp.HashRecoveryCode(code),
+	})
+	if err != nil {
+		return false, fmt.Errorf("use recovery code: %w", err)
+	}
+	return n > 0, nil
+}
+
+// DisableTOTP removes the TOTP secret and recovery codes for userID.
+func (cd *CoreData) DisableTOTP(userID int32) error {
+	if cd.queries == nil {
+		return nil
+	}
+	if err := cd.queries.DeleteRecoveryCodesForUser(cd.ctx, userID); err != nil {
+		return fmt.Errorf("delete recovery codes: %w", err)
+	}
+	if err := cd.queries.DeleteTOTPForUser(cd.ctx, userID); err != nil {
+		return fmt.Errorf("delete totp: %w", err)
+	}
+	return nil
+}
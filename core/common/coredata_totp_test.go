@@ -0,0 +1,99 @@
+package common
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/totp"
+)
+
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func totpRows(enabled bool) *sqlmock.Rows {
+	var enabledAt any
+	if enabled {
+		enabledAt = time.Now()
+	}
+	return sqlmock.NewRows([]string{"users_idusers", "secret", "enabled_at", "last_used_step", "created_at"}).
+		AddRow(4, testTOTPSecret, enabledAt, 0, time.Now())
+}
+
+func TestVerifySecondFactorAcceptsTOTPOnce(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	step := totp.Step(time.Now())
+	code, err := totp.CodeAt(testTOTPSecret, step)
+	if err != nil {
+		t.Fatalf("CodeAt: %v", err)
+	}
+	for _, rows := range []int64{1, 0} {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT users_idusers, secret, enabled_at, last_used_step, created_at FROM user_totp")).
+			WithArgs(int32(4)).
+			WillReturnRows(totpRows(true))
+		mock.ExpectExec(regexp.QuoteMeta("UPDATE user_totp")).
+			WithArgs(sqlmock.AnyArg(), int32(4), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, rows))
+	}
+
+	cd := NewTestCoreData(t, db.New(conn))
+	if ok, err := cd.VerifySecondFactor(4, code); err != nil || !ok {
+		t.Fatalf("first use ok=%v err=%v", ok, err)
+	}
+	if ok, err := cd.VerifySecondFactor(4, code); err != nil || ok {
+		t.Fatalf("replay ok=%v err=%v", ok, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
+
+func TestVerifySecondFactorRecoveryCode(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT users_idusers, secret, enabled_at, last_used_step, created_at FROM user_totp")).
+		WithArgs(int32(4)).
+		WillReturnRows(totpRows(true))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE user_recovery_codes")).
+		WithArgs(int32(4), totp.HashRecoveryCode("abcde-12345")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cd := NewTestCoreData(t, db.New(conn))
+	if ok, err := cd.VerifySecondFactor(4, "ABCDE 12345"); err != nil || !ok {
+		t.Fatalf("recovery ok=%v err=%v", ok, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
+
+func TestVerifySecondFactorPendingEnrolment(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT users_idusers, secret, enabled_at, last_used_step, created_at FROM user_totp")).
+		WithArgs(int32(4)).
+		WillReturnRows(totpRows(false))
+
+	code, _ := totp.CodeAt(testTOTPSecret, totp.Step(time.Now()))
+	cd := NewTestCoreData(t, db.New(conn))
+	if ok, err := cd.VerifySecondFactor(4, code); err != nil || ok {
+		t.Fatalf("pending ok=%v err=%v", ok, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
@@ -74,6 +74,28 @@ func (cd *CoreData) SaveEmail(userID int32, updates, auto bool) error {
 	})
 }
 
+// SaveMuteMentions sets whether @username mentions notify the user.
+func (cd *CoreData) SaveMuteMentions(userID int32, mute bool) error {
+	if cd == nil || cd.queries == nil {
+		return nil
+	}
+	if _, err := cd.queries.GetPreferenceForLister(cd.ctx, userID); err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+		if err := cd.queries.InsertEmailPreferenceForLister(cd.ctx, db.InsertEmailPreferenceForListerParams{
+			AutoSubscribeReplies: true,
+			ListerID:             userID,
+		}); err != nil {
+			return err
+		}
+	}
+	return cd.queries.UpdateMuteMentionsForLister(cd.ctx, db.UpdateMuteMentionsForListerParams{
+		MuteMentions: mute,
+		ListerID:     userID,
+	})
+}
+
 // DeleteEmail removes an email belonging to the user.
 func (cd *CoreData) DeleteEmail(userID, id int32) error {
 	if cd == nil || cd.queries == nil {
@@ -76,6 +76,7 @@ func (cd *CoreData) UpdateArticleComment(commentID, languageID int32, text strin
 	if err := cd.validateImagePathsForThread(uid, comment.ForumthreadID, paths); err != nil {
 		return fmt.Errorf("validate images: %w", err)
 	}
+	before := cd.RevisionSnapshot(RevisionTypeComment, commentID)
 	if err := cd.queries.UpdateCommentForEditor(cd.ctx, db.UpdateCommentForEditorParams{
 		LanguageID:  sql.NullInt32{Int32: languageID, Valid: languageID != 0},
 		Text:        sql.NullString{String: text, Valid: true},
@@ -85,6 +86,7 @@ func (cd *CoreData) UpdateArticleComment(commentID, languageID int32, text strin
 	}); err != nil {
 		return err
 	}
+	cd.RecordRevision(before)
 	if err := cd.recordThreadImages(comment.ForumthreadID, paths); err != nil {
 		log.Printf("record thread images: %v", err)
 	}
@@ -115,11 +117,13 @@ func (cd *CoreData) WriterWritings(userID int32, r *http.Request) ([]*db.ListPub
 		}
 		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
 		rows, err := cd.queries.ListPublicWritingsByUserForLister(cd.ctx, db.ListPublicWritingsByUserForListerParams{
-			ListerID: cd.UserID,
-			AuthorID: userID,
-			UserID:   sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
-			Limit:    int32(cd.PageSize()),
-			Offset:   int32(offset),
+			ListerID:          cd.UserID,
+			AuthorID:          userID,
+			UserID:            sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+			ShowScheduled:     cd.IsAdmin(),
+			ScheduledViewerID: cd.UserID,
+			Limit:             int32(cd.PageSize()),
+			Offset:            int32(offset),
 		})
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
 			return nil, err
@@ -158,6 +162,7 @@ func (cd *CoreData) UpdateWritingReply(commentID, languageID int32, text string)
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
+	before := cd.RevisionSnapshot(RevisionTypeComment, cmt.Idcomments)
 	if err := cd.queries.UpdateCommentForEditor(cd.ctx, db.UpdateCommentForEditorParams{
 		LanguageID:  sql.NullInt32{Int32: languageID, Valid: languageID != 0},
 		Text:        sql.NullString{String: text, Valid: true},
@@ -167,6 +172,7 @@ func (cd *CoreData) UpdateWritingReply(commentID, languageID int32, text string)
 	}); err != nil {
 		return nil, err
 	}
+	cd.RecordRevision(before)
 	if err := cd.recordThreadImages(cmt.ForumthreadID, paths); err != nil {
 		log.Printf("record thread images: %v", err)
 	}
@@ -233,7 +239,8 @@ func (cd *CoreData) UpdateWriting(w *db.GetWritingForListerByIDRow, title, abstr
 	if err := cd.validateCodeImagesForUser(cd.UserID, body); err != nil {
 		return fmt.Errorf("validate body images: %w", err)
 	}
-	return cd.queries.UpdateWritingForWriter(cd.ctx, db.UpdateWritingForWriterParams{
+	before := cd.RevisionSnapshot(RevisionTypeWriting, w.Idwriting)
+	if err := cd.queries.UpdateWritingForWriter(cd.ctx, db.UpdateWritingForWriterParams{
 		Title:      sql.NullString{Valid: true, String: title},
 		Abstract:   sql.NullString{Valid: true, String: abstract},
 		Content:    sql.NullString{Valid: true, String: body},
@@ -242,7 +249,11 @@ func (cd *CoreData) UpdateWriting(w *db.GetWritingForListerByIDRow, title, abstr
 		WritingID:  w.Idwriting,
 		WriterID:   cd.UserID,
 		GranteeID:  sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
-	})
+	}); err != nil {
+		return err
+	}
+	cd.RecordRevision(before)
+	return nil
 }
 
 // CreateWriting creates a new article in the given category.
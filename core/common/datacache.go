@@ -31,6 +31,7 @@ type DataCache struct {
 	blogListRows                  lazy.Value[[]*db.ListBlogEntriesForListerRow]
 	blogListByAuthorRows          lazy.Value[[]*db.ListBlogEntriesByAuthorForListerRow]
 	bookmarks                     lazy.Value[*db.GetBookmarksForUserRow]
+	commentReactionTargets        map[int32]*lazy.Value[*db.SystemGetCommentReactionTargetRow]
 	externalLinks                 map[int32]*lazy.Value[*db.ExternalLink]
 	faqCategories                 lazy.Value[[]*db.FaqCategory]
 	forumCategories               lazy.Value[[]*db.Forumcategory]
@@ -61,6 +62,7 @@ type DataCache struct {
 	privateForumTopics            lazy.Value[[]*PrivateTopic]
 	publicWritings                map[string]*lazy.Value[[]*db.ListPublicWritingsInCategoryForListerRow]
 	roleRows                      map[int32]*lazy.Value[*db.Role]
+	scheduledPublications         map[string]*lazy.Value[*db.ScheduledPublication]
 	searchBlogs                   []*db.Blog
 	searchBlogsEmptyWords         bool
 	searchBlogsNoResults          bool
@@ -70,6 +72,7 @@ type DataCache struct {
 	searchLinkerEmptyWords        bool
 	searchLinkerItems             []*db.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow
 	searchLinkerNoResults         bool
+	searchSnippets                map[string]map[int32]string
 	searchWords                   []string
 	searchWritings                []*db.ListWritingsByIDsForListerRow
 	searchWritingsEmptyWords      bool
@@ -8,6 +8,7 @@ import (
 	"image"
 	"image/color"
 	"image/png"
+	"io"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -51,6 +52,56 @@ func (p *memoryCacheProvider) Read(_ context.Context, name string) ([]byte, erro
 	return append([]byte(nil), data...), nil
 }
 
+func (p *memoryCacheProvider) WriteStream(ctx context.Context, name string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return p.Write(ctx, name, data)
+}
+
+func (p *memoryCacheProvider) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	data, err := p.Read(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (p *memoryCacheProvider) Stat(_ context.Context, name string) (upload.ObjectInfo, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	data, ok := p.files[name]
+	if !ok {
+		return upload.ObjectInfo{}, upload.ErrNotExist
+	}
+	return upload.ObjectInfo{Name: name, Size: int64(len(data))}, nil
+}
+
+func (p *memoryCacheProvider) Delete(_ context.Context, name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.files, name)
+	return nil
+}
+
+func (p *memoryCacheProvider) List(_ context.Context, prefix string, fn func(upload.ObjectInfo) error) error {
+	p.mu.Lock()
+	var infos []upload.ObjectInfo
+	for name, data := range p.files {
+		if strings.HasPrefix(name, prefix) {
+			infos = append(infos, upload.ObjectInfo{Name: name, Size: int64(len(data))})
+		}
+	}
+	p.mu.Unlock()
+	for _, info := range infos {
+		if err := fn(info); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func registerMemoryCacheProvider(t *testing.T, provider *memoryCacheProvider) string {
 	t.Helper()
 	name := "test-cache-" + strings.ReplaceAll(t.Name(), "/", "-")
@@ -0,0 +1,194 @@
+package common
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// DraftFormField names the hidden input carrying a form's draft target. Once
+// the form is submitted successfully the matching draft is discarded.
+const DraftFormField = "draft_target"
+
+// MaxDraftSize limits the stored size of a draft's fields.
+const MaxDraftSize = 1 << 20
+
+// maxDraftURLLength matches the width of drafts.url.
+const maxDraftURLLength = 255
+
+var (
+	// ErrInvalidDraftTarget is returned for malformed draft targets.
+	ErrInvalidDraftTarget = errors.New("invalid draft target")
+	// ErrDraftTooLarge is returned when a draft exceeds MaxDraftSize.
+	ErrDraftTooLarge = errors.New("draft is too large")
+)
+
+// draftTargetPattern matches keys such as "thread:12:reply" or "blog:new".
+var draftTargetPattern = regexp.MustCompile(`^[a-z]+(:[0-9]+)?(:[a-z]+)?$`)
+
+// ValidDraftTarget reports whether t is a well formed draft target.
+func ValidDraftTarget(t string) bool {
+	return len(t) <= 128 && draftTargetPattern.MatchString(t)
+}
+
+// draftNouns describes the item a draft target refers to.
+var draftNouns = map[string]string{
+	"topic":   "topic",
+	"thread":  "thread",
+	"blog":    "blog entry",
+	"news":    "news post",
+	"writing": "writing",
+	"link":    "link",
+	"image":   "image post",
+	"private": "private discussion",
+}
+
+// DraftTargetLabel describes a draft target for people, for example
+// "Reply to thread 12".
+func DraftTargetLabel(target string) string {
+	parts := strings.Split(target, ":")
+	noun, ok := draftNouns[parts[0]]
+	if !ok {
+		return target
+	}
+	switch {
+	case len(parts) == 2 && parts[1] == "new":
+		return "New " + noun
+	case len(parts) == 3 && parts[2] == "thread":
+		return fmt.Sprintf("New thread in %s %s", noun, parts[1])
+	case len(parts) == 3 && parts[2] == "reply":
+		return fmt.Sprintf("Reply to %s %s", noun, parts[1])
+	case len(parts) == 3 && parts[2] == "edit":
+		return fmt.Sprintf("Edit of %s %s", noun, parts[1])
+	}
+	return target
+}
+
+// Draft is the autosaved content of one editor form.
+type Draft struct {
+	Target string
+	// URL is the page the draft was written on.
+	URL       string
+	Fields    map[string]string
+	UpdatedAt time.Time
+}
+
+// Label describes what the draft was being written for.
+func (d *Draft) Label() string { return DraftTargetLabel(d.Target) }
+
+// Excerpt returns the start of the draft's longest field.
+func (d *Draft) Excerpt() string {
+	var longest string
+	for _, v := range d.Fields {
+		if len(v) > len(longest) {
+			longest = v
+		}
+	}
+	if r := []rune(longest); len(r) > 200 {
+		return string(r[:200]) + "…"
+	}
+	return longest
+}
+
+func draftFromRow(row *db.Draft) *Draft {
+	d := &Draft{Target: row.Target, URL: row.Url, UpdatedAt: row.UpdatedAt}
+	if err := json.Unmarshal([]byte(row.Content), &d.Fields); err != nil {
+		d.Fields = map[string]string{}
+	}
+	return d
+}
+
+// localDraftURL keeps only same-site paths so draft links cannot point away.
+func localDraftURL(u string) string {
+	if !strings.HasPrefix(u, "/") || strings.HasPrefix(u, "//") || strings.HasPrefix(u, "/\\") || len(u) > maxDraftURLLength {
+		return ""
+	}
+	return u
+}
+
+// SaveDraft stores the current user's draft for target. Drafts whose fields
+// are all blank are discarded instead.
+func (cd *CoreData) SaveDraft(target, url string, fields map[string]string) (*Draft, error) {
+	if cd.queries == nil || cd.UserID == 0 {
+		return nil, fmt.Errorf("drafts unavailable")
+	}
+	if !ValidDraftTarget(target) {
+		return nil, ErrInvalidDraftTarget
+	}
+	empty := true
+	for _, v := range fields {
+		if strings.TrimSpace(v) != "" {
+			empty = false
+			break
+		}
+	}
+	if empty {
+		return nil, cd.DiscardDraft(target)
+	}
+	content, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("encode draft: %w", err)
+	}
+	if len(content) > MaxDraftSize {
+		return nil, ErrDraftTooLarge
+	}
+	d := &Draft{Target: target, URL: localDraftURL(url), Fields: fields, UpdatedAt: time.Now().UTC()}
+	if err := cd.queries.UpsertDraftForUser(cd.ctx, db.UpsertDraftForUserParams{
+		UserID:    cd.UserID,
+		Target:    d.Target,
+		Url:       d.URL,
+		Content:   string(content),
+		UpdatedAt: d.UpdatedAt,
+	}); err != nil {
+		return nil, fmt.Errorf("save draft: %w", err)
+	}
+	return d, nil
+}
+
+// Draft returns the current user's draft for target or nil when there is none.
+func (cd *CoreData) Draft(target string) (*Draft, error) {
+	if cd.queries == nil || cd.UserID == 0 || !ValidDraftTarget(target) {
+		return nil, nil
+	}
+	row, err := cd.queries.GetDraftForUser(cd.ctx, db.GetDraftForUserParams{UserID: cd.UserID, Target: target})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get draft: %w", err)
+	}
+	return draftFromRow(row), nil
+}
+
+// Drafts lists the current user's drafts, most recently saved first.
+func (cd *CoreData) Drafts() ([]*Draft, error) {
+	if cd.queries == nil || cd.UserID == 0 {
+		return nil, nil
+	}
+	rows, err := cd.queries.ListDraftsForUser(cd.ctx, cd.UserID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("list drafts: %w", err)
+	}
+	drafts := make([]*Draft, 0, len(rows))
+	for _, row := range rows {
+		drafts = append(drafts, draftFromRow(row))
+	}
+	return drafts, nil
+}
+
+// DiscardDraft removes the current user's draft for target.
+func (cd *CoreData) DiscardDraft(target string) error {
+	if cd.queries == nil || cd.UserID == 0 || !ValidDraftTarget(target) {
+		return nil
+	}
+	if err := cd.queries.DeleteDraftForUser(cd.ctx, db.DeleteDraftForUserParams{UserID: cd.UserID, Target: target}); err != nil {
+		return fmt.Errorf("delete draft: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+func TestDraftTargetLabel(t *testing.T) {
+	for target, want := range map[string]string{
+		"thread:12:reply": "Reply to thread 12",
+		"topic:3:thread":  "New thread in topic 3",
+		"writing:4:edit":  "Edit of writing 4",
+		"blog:new":        "New blog entry",
+		"other:1:reply":   "other:1:reply",
+	} {
+		if got := DraftTargetLabel(target); got != want {
+			t.Errorf("DraftTargetLabel(%q) = %q, want %q", target, got, want)
+		}
+	}
+}
+
+func TestSaveDraft(t *testing.T) {
+	t.Run("Stores local URL only", func(t *testing.T) {
+		q := &db.QuerierStub{}
+		cd := NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+		cd.UserID = 5
+		if _, err := cd.SaveDraft("thread:12:reply", "//example.com/x", map[string]string{"replytext": "hello"}); err != nil {
+			t.Fatalf("SaveDraft: %v", err)
+		}
+		if len(q.UpsertDraftForUserCalls) != 1 {
+			t.Fatalf("upsert calls = %d, want 1", len(q.UpsertDraftForUserCalls))
+		}
+		got := q.UpsertDraftForUserCalls[0]
+		if got.UserID != 5 || got.Target != "thread:12:reply" || got.Url != "" {
+			t.Fatalf("unexpected upsert %+v", got)
+		}
+	})
+
+	t.Run("Blank fields discard the draft", func(t *testing.T) {
+		q := &db.QuerierStub{}
+		cd := NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+		cd.UserID = 5
+		if _, err := cd.SaveDraft("blog:new", "/blogs/add", map[string]string{"text": "  "}); err != nil {
+			t.Fatalf("SaveDraft: %v", err)
+		}
+		if len(q.UpsertDraftForUserCalls) != 0 || len(q.DeleteDraftForUserCalls) != 1 {
+			t.Fatalf("upserts = %d deletes = %d", len(q.UpsertDraftForUserCalls), len(q.DeleteDraftForUserCalls))
+		}
+	})
+
+	t.Run("Rejects malformed targets", func(t *testing.T) {
+		cd := NewCoreData(context.Background(), &db.QuerierStub{}, config.NewRuntimeConfig())
+		cd.UserID = 5
+		if _, err := cd.SaveDraft("Thread 12", "/", map[string]string{"replytext": "x"}); err != ErrInvalidDraftTarget {
+			t.Fatalf("err = %v, want ErrInvalidDraftTarget", err)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package common
+
+import "github.com/arran4/goa4web/a4code"
+
+// MentionsEventKey is the event data key holding a MentionEventData for
+// content that mentions users with @username.
+const MentionsEventKey = "Mentions"
+
+// MentionEventData describes content that mentions other users.
+type MentionEventData struct {
+	// Usernames lists the mentioned users in the order they were written.
+	Usernames []string
+	// Author is the username of the person who wrote the content.
+	Author string
+	// Path is the site-relative location of the content.
+	Path string
+	// URL is the absolute location of the content.
+	URL string
+	// Excerpt is the start of the content as plain text.
+	Excerpt string
+}
+
+// defaultMentionLimit applies when no MentionLimit is configured.
+const defaultMentionLimit = 10
+
+// NewMentionEventData describes the @username mentions in text written by
+// author and shown at path. Only the first MentionLimit distinct usernames
+// are kept. ok is false when text mentions nobody.
+func (cd *CoreData) NewMentionEventData(text, author, path string) (data MentionEventData, ok bool) {
+	names := a4code.Mentions(text)
+	if len(names) == 0 {
+		return MentionEventData{}, false
+	}
+	limit := defaultMentionLimit
+	if cd.Config != nil && cd.Config.MentionLimit > 0 {
+		limit = cd.Config.MentionLimit
+	}
+	if len(names) > limit {
+		names = names[:limit]
+	}
+	return MentionEventData{
+		Usernames: names,
+		Author:    author,
+		Path:      path,
+		URL:       cd.AbsoluteURL(path),
+		Excerpt:   a4code.SnipTextWords(text, 30),
+	}, true
+}
+
+// RecordMentions attaches the @username mentions in text to the current
+// event so the mentioned users can be notified once the task succeeds. path
+// is the site-relative location of the content.
+func (cd *CoreData) RecordMentions(text, path string) {
+	evt := cd.Event()
+	if evt == nil {
+		return
+	}
+	var author string
+	if u, err := cd.CurrentUser(); err == nil && u != nil {
+		author = u.Username.String
+	}
+	data, ok := cd.NewMentionEventData(text, author, path)
+	if !ok {
+		return
+	}
+	if evt.Data == nil {
+		evt.Data = map[string]any{}
+	}
+	evt.Data[MentionsEventKey] = data
+}
@@ -0,0 +1,24 @@
+package common
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/testhelpers"
+)
+
+func TestNewMentionEventDataCapsUsernames(t *testing.T) {
+	cfg := config.NewRuntimeConfig()
+	cfg.MentionLimit = 2
+	cd := NewCoreData(context.Background(), testhelpers.NewQuerierStub(), cfg)
+
+	data, ok := cd.NewMentionEventData("@alice @Alice @bob @carol @dave", "erin", "/forum/topic/1/thread/1")
+	if !ok {
+		t.Fatal("no mentions found")
+	}
+	if want := []string{"alice", "bob"}; !slices.Equal(data.Usernames, want) {
+		t.Fatalf("usernames %v, want %v", data.Usernames, want)
+	}
+}
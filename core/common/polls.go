@@ -0,0 +1,256 @@
+package common
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// Poll result visibility settings.
+const (
+	// PollResultsAlways shows results to everyone who can see the thread.
+	PollResultsAlways = "always"
+	// PollResultsVoted shows results once the viewer has voted.
+	PollResultsVoted = "voted"
+	// PollResultsClosed hides results until the poll closes.
+	PollResultsClosed = "closed"
+)
+
+// MaxPollOptions limits the number of choices offered by a poll.
+const MaxPollOptions = 20
+
+var (
+	// ErrPollClosed is returned when voting on a closed poll.
+	ErrPollClosed = errors.New("poll is closed")
+	// ErrInvalidPollVote is returned when the submitted choices do not suit the poll.
+	ErrInvalidPollVote = errors.New("invalid poll choice")
+)
+
+// PollInput describes a poll attached to a new thread.
+type PollInput struct {
+	Question          string
+	Options           []string
+	MultipleChoice    bool
+	Anonymous         bool
+	ResultsVisibility string
+	// ClosesAt is when voting ends. The zero time leaves the poll open.
+	ClosesAt time.Time
+}
+
+// Validate normalises the input and reports problems a poster can fix.
+func (p *PollInput) Validate(now time.Time) error {
+	p.Question = strings.TrimSpace(p.Question)
+	if p.Question == "" {
+		return fmt.Errorf("poll question required")
+	}
+	var opts []string
+	for _, o := range p.Options {
+		o = strings.TrimSpace(o)
+		if o == "" || slices.Contains(opts, o) {
+			continue
+		}
+		opts = append(opts, o)
+	}
+	if len(opts) < 2 {
+		return fmt.Errorf("a poll needs at least two options")
+	}
+	if len(opts) > MaxPollOptions {
+		return fmt.Errorf("a poll can have at most %d options", MaxPollOptions)
+	}
+	p.Options = opts
+	switch p.ResultsVisibility {
+	case PollResultsAlways, PollResultsVoted, PollResultsClosed:
+	case "":
+		p.ResultsVisibility = PollResultsAlways
+	default:
+		return fmt.Errorf("unknown result visibility %q", p.ResultsVisibility)
+	}
+	if !p.ClosesAt.IsZero() && !p.ClosesAt.After(now) {
+		return fmt.Errorf("poll close time must be in the future")
+	}
+	return nil
+}
+
+// PollOptionResult is a poll choice with its tally.
+type PollOptionResult struct {
+	ID       int32
+	Label    string
+	Votes    int64
+	Percent  int
+	Selected bool
+	// Voters lists who chose the option on public polls.
+	Voters []string
+}
+
+// PollView holds what the thread page renders for a poll.
+type PollView struct {
+	*db.ForumPoll
+	Options     []*PollOptionResult
+	TotalVoters int64
+	Voted       bool
+	Closed      bool
+	CanVote     bool
+	ShowResults bool
+}
+
+// PollClosed reports whether voting on p has ended at now.
+func PollClosed(p *db.ForumPoll, now time.Time) bool {
+	if p == nil {
+		return true
+	}
+	if p.ClosedAt.Valid {
+		return true
+	}
+	return p.ClosesAt.Valid && !p.ClosesAt.Time.After(now)
+}
+
+// CanCreatePoll reports whether the current user may attach polls to threads.
+func (cd *CoreData) CanCreatePoll() bool {
+	if cd == nil || cd.UserID == 0 {
+		return false
+	}
+	return cd.HasGrant("forum", "poll", "create", 0)
+}
+
+// CreateThreadPoll attaches a validated poll to a thread using q, which is
+// normally the transaction the thread was created in.
+func (cd *CoreData) CreateThreadPoll(q db.Querier, threadID int32, p *PollInput) (int32, error) {
+	if q == nil || p == nil {
+		return 0, fmt.Errorf("no poll")
+	}
+	id, err := q.CreateForumPollForCreator(cd.ctx, db.CreateForumPollForCreatorParams{
+		ThreadID:          threadID,
+		Question:          p.Question,
+		MultipleChoice:    p.MultipleChoice,
+		Anonymous:         p.Anonymous,
+		ResultsVisibility: p.ResultsVisibility,
+		ClosesAt:          sql.NullTime{Time: p.ClosesAt.UTC(), Valid: !p.ClosesAt.IsZero()},
+		CreatorID:         cd.UserID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create poll: %w", err)
+	}
+	for i, o := range p.Options {
+		if err := q.CreateForumPollOption(cd.ctx, db.CreateForumPollOptionParams{
+			PollID:   int32(id),
+			Position: int32(i),
+			Label:    o,
+		}); err != nil {
+			return 0, fmt.Errorf("create poll option: %w", err)
+		}
+	}
+	return int32(id), nil
+}
+
+// ThreadPoll returns the poll attached to a thread or nil when there is none.
+// Callers are expected to have checked the viewer can see the thread.
+func (cd *CoreData) ThreadPoll(threadID int32) (*PollView, error) {
+	if cd.queries == nil {
+		return nil, nil
+	}
+	p, err := cd.queries.GetForumPollByThreadID(cd.ctx, threadID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get poll: %w", err)
+	}
+	v := &PollView{ForumPoll: p, Closed: PollClosed(p, time.Now())}
+	rows, err := cd.queries.ListForumPollOptionsWithVoteCounts(cd.ctx, p.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list poll options: %w", err)
+	}
+	if v.TotalVoters, err = cd.queries.CountForumPollVoters(cd.ctx, p.ID); err != nil {
+		return nil, fmt.Errorf("count poll voters: %w", err)
+	}
+	var mine []int32
+	if cd.UserID != 0 {
+		if mine, err = cd.queries.ListForumPollOptionIDsForVoter(cd.ctx, db.ListForumPollOptionIDsForVoterParams{PollID: p.ID, VoterID: cd.UserID}); err != nil {
+			return nil, fmt.Errorf("list poll votes: %w", err)
+		}
+	}
+	v.Voted = len(mine) > 0
+	v.CanVote = cd.UserID != 0 && !v.Closed
+	switch {
+	case v.Closed, p.ResultsVisibility == PollResultsAlways:
+		v.ShowResults = true
+	case p.ResultsVisibility == PollResultsVoted:
+		v.ShowResults = v.Voted
+	}
+	if cd.UserID != 0 && (p.CreatedBy == cd.UserID || cd.IsAdmin()) {
+		v.ShowResults = true
+	}
+
+	byID := map[int32]*PollOptionResult{}
+	for _, row := range rows {
+		o := &PollOptionResult{ID: row.ID, Label: row.Label, Selected: slices.Contains(mine, row.ID)}
+		if v.ShowResults {
+			o.Votes = row.Votes
+			if v.TotalVoters > 0 {
+				o.Percent = int(row.Votes * 100 / v.TotalVoters)
+			}
+		}
+		byID[o.ID] = o
+		v.Options = append(v.Options, o)
+	}
+	if v.ShowResults && !p.Anonymous {
+		voters, err := cd.queries.ListForumPollVotersForPoll(cd.ctx, p.ID)
+		if err != nil {
+			log.Printf("list poll voters: %v", err)
+		}
+		for _, row := range voters {
+			if o, ok := byID[row.OptionID]; ok && row.Username.Valid {
+				o.Voters = append(o.Voters, row.Username.String)
+			}
+		}
+	}
+	return v, nil
+}
+
+// VoteThreadPoll records the current user's choices on a thread's poll,
+// replacing any earlier vote.
+func (cd *CoreData) VoteThreadPoll(threadID int32, optionIDs []int32) error {
+	if cd.queries == nil || cd.UserID == 0 {
+		return ErrInvalidPollVote
+	}
+	p, err := cd.queries.GetForumPollByThreadID(cd.ctx, threadID)
+	if err != nil {
+		return fmt.Errorf("get poll: %w", err)
+	}
+	if PollClosed(p, time.Now()) {
+		return ErrPollClosed
+	}
+	slices.Sort(optionIDs)
+	optionIDs = slices.Compact(optionIDs)
+	if len(optionIDs) == 0 || (!p.MultipleChoice && len(optionIDs) > 1) {
+		return ErrInvalidPollVote
+	}
+	rows, err := cd.queries.ListForumPollOptionsWithVoteCounts(cd.ctx, p.ID)
+	if err != nil {
+		return fmt.Errorf("list poll options: %w", err)
+	}
+	for _, id := range optionIDs {
+		if !slices.ContainsFunc(rows, func(r *db.ListForumPollOptionsWithVoteCountsRow) bool { return r.ID == id }) {
+			return ErrInvalidPollVote
+		}
+	}
+	if err := cd.queries.DeleteForumPollVotesForVoter(cd.ctx, db.DeleteForumPollVotesForVoterParams{PollID: p.ID, VoterID: cd.UserID}); err != nil {
+		return fmt.Errorf("clear poll vote: %w", err)
+	}
+	for _, id := range optionIDs {
+		if err := cd.queries.InsertForumPollVoteForVoter(cd.ctx, db.InsertForumPollVoteForVoterParams{
+			PollID:   p.ID,
+			OptionID: id,
+			VoterID:  cd.UserID,
+		}); err != nil {
+			return fmt.Errorf("record poll vote: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,283 @@
+package common
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	lazy "github.com/arran4/go-be-lazy"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// Item types stored in reactions.
+const (
+	ReactionTypeComment   = "comment"
+	ReactionTypeBlog      = "blog"
+	ReactionTypeNews      = "news"
+	ReactionTypeImagePost = "imagepost"
+)
+
+// Reaction is one entry of the configured reaction set. Name is stored in the
+// database and Emoji is what is shown.
+type Reaction struct {
+	Name  string
+	Emoji string
+}
+
+// ReactionCount is the tally of a single reaction on an item.
+type ReactionCount struct {
+	Reaction
+	Count   int64
+	Reacted bool
+}
+
+// ReactionSummary holds what the reactions partial renders for an item.
+type ReactionSummary struct {
+	ItemType string
+	ItemID   int32
+	Counts   []ReactionCount
+	CanReact bool
+}
+
+// ReactionTarget describes an item that can be reacted to.
+type ReactionTarget struct {
+	ItemType string
+	ItemID   int32
+	AuthorID int32
+	// Section, Item and GrantItemID identify the react grant covering the item.
+	Section     string
+	Item        string
+	GrantItemID int32
+	// URL is the page showing the item.
+	URL string
+}
+
+var reactionNameRe = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// ValidReactionType reports whether t is a supported reaction item type.
+func ValidReactionType(t string) bool {
+	switch t {
+	case ReactionTypeComment, ReactionTypeBlog, ReactionTypeNews, ReactionTypeImagePost:
+		return true
+	}
+	return false
+}
+
+// ParseReactions parses a comma-separated list of name=emoji pairs. Entries
+// without an emoji use the name. Invalid and duplicate names are skipped.
+func ParseReactions(s string) []Reaction {
+	var res []Reaction
+	seen := map[string]bool{}
+	for _, part := range strings.Split(s, ",") {
+		name, emoji, _ := strings.Cut(part, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		emoji = strings.TrimSpace(emoji)
+		if !reactionNameRe.MatchString(name) || seen[name] {
+			continue
+		}
+		if emoji == "" {
+			emoji = name
+		}
+		seen[name] = true
+		res = append(res, Reaction{Name: name, Emoji: emoji})
+	}
+	return res
+}
+
+// ReactionSet returns the reactions offered on this site.
+func (cd *CoreData) ReactionSet() []Reaction {
+	if cd.Config == nil {
+		return nil
+	}
+	return ParseReactions(cd.Config.Reactions)
+}
+
+// ReactionByName returns the configured reaction called name.
+func (cd *CoreData) ReactionByName(name string) (Reaction, bool) {
+	for _, r := range cd.ReactionSet() {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Reaction{}, false
+}
+
+func (cd *CoreData) commentReactionTarget(id int32) (*db.SystemGetCommentReactionTargetRow, error) {
+	fetch := func(i int32) (*db.SystemGetCommentReactionTargetRow, error) {
+		if cd.queries == nil {
+			return nil, sql.ErrNoRows
+		}
+		return cd.queries.SystemGetCommentReactionTarget(cd.ctx, i)
+	}
+	return lazy.Map(&cd.cache.commentReactionTargets, &cd.cache.mapMu, id, fetch)
+}
+
+// LoadReactionTarget resolves the author, react grant and page of an item.
+// Blog entries and image posts are only returned when visible to the viewer;
+// use CanViewReactionTarget to check comments and news posts.
+func (cd *CoreData) LoadReactionTarget(itemType string, id int32) (*ReactionTarget, error) {
+	t := &ReactionTarget{ItemType: itemType, ItemID: id}
+	switch itemType {
+	case ReactionTypeComment:
+		c, err := cd.commentReactionTarget(id)
+		if err != nil {
+			return nil, err
+		}
+		t.AuthorID = c.UsersIdusers
+		t.Section, t.Item, t.GrantItemID = "forum", "topic", c.TopicID
+		var page string
+		switch c.Handler {
+		case "private":
+			// Private topics are limited to their participants so the react
+			// grant is a general one; visibility is checked separately.
+			t.Section, t.GrantItemID = "privateforum", 0
+			page = fmt.Sprintf("/private/topic/%d/thread/%d", c.TopicID, c.ForumthreadID)
+		case "blogs":
+			page = fmt.Sprintf("/blogs/blog/%d", c.BlogID.Int32)
+		case "news":
+			page = fmt.Sprintf("/news/news/%d", c.NewsID.Int32)
+		case "writing":
+			page = fmt.Sprintf("/writings/article/%d", c.WritingID.Int32)
+		case "linker":
+			page = fmt.Sprintf("/linker/comments/%d", c.LinkID.Int32)
+		case "imagebbs":
+			page = fmt.Sprintf("/imagebbs/board/%d/thread/%d", c.BoardID.Int32, c.ForumthreadID)
+		default:
+			page = fmt.Sprintf("/forum/topic/%d/thread/%d", c.TopicID, c.ForumthreadID)
+		}
+		t.URL = fmt.Sprintf("%s#comment-%d", page, id)
+	case ReactionTypeBlog:
+		b, err := cd.BlogEntryByID(id)
+		if err != nil {
+			return nil, err
+		}
+		t.AuthorID = b.UsersIdusers
+		t.Section, t.Item, t.GrantItemID = "blogs", "entry", id
+		t.URL = fmt.Sprintf("/blogs/blog/%d", id)
+	case ReactionTypeNews:
+		n, err := cd.NewsPostByID(id)
+		if err != nil {
+			return nil, err
+		}
+		t.AuthorID = n.Idusers.Int32
+		t.Section, t.Item, t.GrantItemID = "news", "post", id
+		t.URL = fmt.Sprintf("/news/news/%d", id)
+	case ReactionTypeImagePost:
+		p, err := cd.ImagePostByID(id)
+		if err != nil {
+			return nil, err
+		}
+		t.AuthorID = p.UsersIdusers
+		t.Section, t.Item, t.GrantItemID = "imagebbs", "board", p.ImageboardIdimageboard.Int32
+		t.URL = fmt.Sprintf("/imagebbs/board/%d/thread/%d", p.ImageboardIdimageboard.Int32, p.ForumthreadID)
+	default:
+		return nil, fmt.Errorf("unknown reaction type %q", itemType)
+	}
+	return t, nil
+}
+
+// CanViewReactionTarget reports whether the current user may see the item.
+func (cd *CoreData) CanViewReactionTarget(t *ReactionTarget) bool {
+	if t == nil {
+		return false
+	}
+	switch t.ItemType {
+	case ReactionTypeComment:
+		c, err := cd.CommentByID(t.ItemID)
+		return err == nil && c != nil
+	case ReactionTypeNews:
+		return cd.HasGrant("news", "post", "view", t.ItemID)
+	}
+	// Blog entries and image posts are loaded with the viewer's permissions.
+	return true
+}
+
+// CanReact reports whether the current user may react to the item.
+func (cd *CoreData) CanReact(t *ReactionTarget) bool {
+	if t == nil || cd.UserID == 0 || len(cd.ReactionSet()) == 0 {
+		return false
+	}
+	return cd.HasGrant(t.Section, t.Item, "react", t.GrantItemID)
+}
+
+// ToggleReaction adds the named reaction by the current user to the item or
+// removes it if already present. It reports whether the reaction was added.
+func (cd *CoreData) ToggleReaction(t *ReactionTarget, name string) (bool, error) {
+	if cd.queries == nil || t == nil {
+		return false, fmt.Errorf("invalid reaction target")
+	}
+	if _, ok := cd.ReactionByName(name); !ok {
+		return false, fmt.Errorf("unknown reaction %q", name)
+	}
+	n, err := cd.queries.DeleteReactionForReactor(cd.ctx, db.DeleteReactionForReactorParams{
+		ItemType:  t.ItemType,
+		ItemID:    t.ItemID,
+		ReactorID: cd.UserID,
+		Reaction:  name,
+	})
+	if err != nil {
+		return false, fmt.Errorf("remove reaction: %w", err)
+	}
+	if n > 0 {
+		return false, nil
+	}
+	if err := cd.queries.InsertReactionForReactor(cd.ctx, db.InsertReactionForReactorParams{
+		ItemType:  t.ItemType,
+		ItemID:    t.ItemID,
+		ReactorID: cd.UserID,
+		Reaction:  name,
+	}); err != nil {
+		return false, fmt.Errorf("add reaction: %w", err)
+	}
+	return true, nil
+}
+
+// Reactions returns the reaction counts of an item for display. Every
+// configured reaction is listed so the template can offer it; reactions no
+// longer configured are left out.
+func (cd *CoreData) Reactions(itemType string, id int32) *ReactionSummary {
+	s := &ReactionSummary{ItemType: itemType, ItemID: id}
+	set := cd.ReactionSet()
+	if len(set) == 0 || cd.queries == nil {
+		return s
+	}
+	rows, err := cd.queries.ListReactionCountsForViewer(cd.ctx, db.ListReactionCountsForViewerParams{
+		ViewerID: cd.UserID,
+		ItemType: itemType,
+		ItemID:   id,
+	})
+	if err != nil {
+		log.Printf("list reactions %s %d: %v", itemType, id, err)
+	}
+	byName := make(map[string]*db.ListReactionCountsForViewerRow, len(rows))
+	for _, row := range rows {
+		byName[row.Reaction] = row
+	}
+	for _, r := range set {
+		c := ReactionCount{Reaction: r}
+		if row, ok := byName[r.Name]; ok {
+			c.Count = row.Total
+			c.Reacted = row.ViewerCount > 0
+		}
+		s.Counts = append(s.Counts, c)
+	}
+	if cd.UserID != 0 {
+		if t, err := cd.LoadReactionTarget(itemType, id); err == nil {
+			s.CanReact = cd.CanReact(t)
+		}
+	}
+	return s
+}
+
+// HasReactions reports whether any reaction has been recorded.
+func (s *ReactionSummary) HasReactions() bool {
+	for _, c := range s.Counts {
+		if c.Count > 0 {
+			return true
+		}
+	}
+	return false
+}
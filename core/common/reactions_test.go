@@ -0,0 +1,92 @@
+package common
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+func TestParseReactions(t *testing.T) {
+	got := ParseReactions(" Like=👍, bad name!=x, like=👎, thanks ,=x")
+	want := []Reaction{{Name: "like", Emoji: "👍"}, {Name: "thanks", Emoji: "thanks"}}
+	if len(got) != len(want) {
+		t.Fatalf("ParseReactions = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ParseReactions[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestToggleReactionAddsThenRemoves(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM reactions")).
+		WithArgs(ReactionTypeBlog, int32(4), int32(9), "like").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO reactions")).
+		WithArgs(ReactionTypeBlog, int32(4), int32(9), "like").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM reactions")).
+		WithArgs(ReactionTypeBlog, int32(4), int32(9), "like").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	cd := NewTestCoreData(t, db.New(conn))
+	cd.Config.Reactions = "like=👍"
+	cd.UserID = 9
+	target := &ReactionTarget{ItemType: ReactionTypeBlog, ItemID: 4}
+
+	if added, err := cd.ToggleReaction(target, "like"); err != nil || !added {
+		t.Fatalf("first toggle added=%v err=%v", added, err)
+	}
+	if added, err := cd.ToggleReaction(target, "like"); err != nil || added {
+		t.Fatalf("second toggle added=%v err=%v", added, err)
+	}
+	if _, err := cd.ToggleReaction(target, "wow"); err == nil {
+		t.Fatalf("expected error for unconfigured reaction")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("mock expectations: %v", err)
+	}
+}
+
+func TestReactionsListsConfiguredSet(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT reaction,")).
+		WithArgs(int32(0), ReactionTypeComment, int32(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"reaction", "total", "viewer_count"}).
+			AddRow("gone", 3, 0).
+			AddRow("like", 2, 1))
+
+	cd := NewTestCoreData(t, db.New(conn))
+	cd.Config.Reactions = "like=👍,sad=😢"
+
+	s := cd.Reactions(ReactionTypeComment, 5)
+	if len(s.Counts) != 2 || s.CanReact {
+		t.Fatalf("unexpected summary %+v", s)
+	}
+	if c := s.Counts[0]; c.Name != "like" || c.Count != 2 || !c.Reacted {
+		t.Fatalf("like = %+v", c)
+	}
+	if c := s.Counts[1]; c.Name != "sad" || c.Count != 0 {
+		t.Fatalf("sad = %+v", c)
+	}
+	if !s.HasReactions() {
+		t.Fatalf("expected reactions")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("mock expectations: %v", err)
+	}
+}
@@ -0,0 +1,278 @@
+package common
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// Item types that can be reported. Each has a matching deactivated_* table.
+const (
+	ReportTypeComment   = "comment"
+	ReportTypeBlog      = "blog"
+	ReportTypeWriting   = "writing"
+	ReportTypeImagePost = "imagepost"
+	ReportTypeLink      = "link"
+)
+
+// Content report statuses. Anything other than ReportStatusPending records
+// the moderator's decision.
+const (
+	ReportStatusPending     = "pending"
+	ReportStatusDeactivated = "deactivated"
+	ReportStatusWarned      = "warned"
+	ReportStatusBanned      = "banned"
+	ReportStatusDismissed   = "dismissed"
+)
+
+// MaxReportReasonLength limits the explanation stored with a report.
+const MaxReportReasonLength = 2000
+
+var (
+	// ErrAlreadyReported is returned when the user has a pending report on the item.
+	ErrAlreadyReported = errors.New("you have already reported this")
+	// ErrReportReasonRequired is returned when a report has no explanation.
+	ErrReportReasonRequired = errors.New("please say why you are reporting this")
+)
+
+// ReportTarget describes an item a user may report.
+type ReportTarget struct {
+	ItemType string
+	ItemID   int32
+	AuthorID int32
+	// URL is the page showing the item.
+	URL string
+}
+
+// ValidReportType reports whether t is a supported report item type.
+func ValidReportType(t string) bool {
+	switch t {
+	case ReportTypeComment, ReportTypeBlog, ReportTypeWriting, ReportTypeImagePost, ReportTypeLink:
+		return true
+	}
+	return false
+}
+
+// ReportItemLabel describes an item type in pages and notifications.
+func ReportItemLabel(itemType string) string {
+	switch itemType {
+	case ReportTypeBlog:
+		return "blog post"
+	case ReportTypeImagePost:
+		return "image post"
+	}
+	return itemType
+}
+
+// LoadReportTarget resolves the author and page of an item visible to the
+// current user. sql.ErrNoRows is returned when the item cannot be seen.
+func (cd *CoreData) LoadReportTarget(itemType string, id int32) (*ReportTarget, error) {
+	t := &ReportTarget{ItemType: itemType, ItemID: id}
+	switch itemType {
+	case ReportTypeComment, ReportTypeBlog, ReportTypeImagePost:
+		rt, err := cd.LoadReactionTarget(itemType, id)
+		if err != nil {
+			return nil, err
+		}
+		if !cd.CanViewReactionTarget(rt) {
+			return nil, sql.ErrNoRows
+		}
+		t.AuthorID, t.URL = rt.AuthorID, rt.URL
+	case ReportTypeWriting:
+		w, err := cd.WritingByID(id)
+		if err != nil {
+			return nil, err
+		}
+		if w == nil {
+			return nil, sql.ErrNoRows
+		}
+		t.AuthorID = w.UsersIdusers
+		t.URL = fmt.Sprintf("/writings/article/%d", id)
+	case ReportTypeLink:
+		if cd.queries == nil {
+			return nil, sql.ErrNoRows
+		}
+		l, err := cd.queries.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUser(cd.ctx, db.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUserParams{
+			ViewerID:     cd.UserID,
+			ID:           id,
+			ViewerUserID: sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+		})
+		if err != nil {
+			return nil, err
+		}
+		t.AuthorID = l.AuthorID
+		t.URL = fmt.Sprintf("/linker/comments/%d", id)
+	default:
+		return nil, fmt.Errorf("unknown report type %q", itemType)
+	}
+	return t, nil
+}
+
+// CanReport reports whether the current user may report the item. Users
+// cannot report their own content.
+func (cd *CoreData) CanReport(t *ReportTarget) bool {
+	return t != nil && cd.UserID != 0 && t.AuthorID != cd.UserID
+}
+
+// ReportContent files a report by the current user against the item.
+func (cd *CoreData) ReportContent(t *ReportTarget, reason string) (int32, error) {
+	if cd.queries == nil || t == nil {
+		return 0, fmt.Errorf("invalid report target")
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return 0, ErrReportReasonRequired
+	}
+	if len(reason) > MaxReportReasonLength {
+		reason = reason[:MaxReportReasonLength]
+	}
+	n, err := cd.queries.CountPendingContentReportsForReporter(cd.ctx, db.CountPendingContentReportsForReporterParams{
+		ReporterID: cd.UserID,
+		ItemType:   t.ItemType,
+		ItemID:     t.ItemID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("check existing reports: %w", err)
+	}
+	if n > 0 {
+		return 0, ErrAlreadyReported
+	}
+	id, err := cd.queries.CreateContentReportForReporter(cd.ctx, db.CreateContentReportForReporterParams{
+		ReporterID: cd.UserID,
+		ItemType:   t.ItemType,
+		ItemID:     t.ItemID,
+		AuthorID:   t.AuthorID,
+		Reason:     reason,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create report: %w", err)
+	}
+	return int32(id), nil
+}
+
+// DeactivateContent copies an item into its deactivated_* table and scrubs
+// the live row. Items that are already deactivated are left untouched.
+func (cd *CoreData) DeactivateContent(itemType string, id int32) error {
+	if cd.queries == nil {
+		return fmt.Errorf("no database")
+	}
+	q, ctx := cd.queries, cd.ctx
+	switch itemType {
+	case ReportTypeComment:
+		if done, err := q.AdminIsCommentDeactivated(ctx, id); err != nil || done {
+			return err
+		}
+		c, err := q.GetCommentById(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get comment: %w", err)
+		}
+		if err := q.AdminArchiveComment(ctx, db.AdminArchiveCommentParams{
+			Idcomments:    c.Idcomments,
+			ForumthreadID: c.ForumthreadID,
+			UsersIdusers:  c.UsersIdusers,
+			LanguageID:    c.LanguageID,
+			Written:       c.Written,
+			Text:          c.Text,
+			Timezone:      c.Timezone,
+		}); err != nil {
+			return fmt.Errorf("archive comment: %w", err)
+		}
+		return q.AdminScrubComment(ctx, db.AdminScrubCommentParams{Text: sql.NullString{String: "", Valid: true}, Idcomments: id})
+	case ReportTypeBlog:
+		if done, err := q.AdminIsBlogDeactivated(ctx, id); err != nil || done {
+			return err
+		}
+		b, err := q.SystemGetBlogForArchive(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get blog: %w", err)
+		}
+		if err := q.AdminArchiveBlog(ctx, db.AdminArchiveBlogParams{
+			Idblogs:       b.Idblogs,
+			ForumthreadID: b.ForumthreadID.Int32,
+			UsersIdusers:  b.UsersIdusers,
+			LanguageID:    b.LanguageID,
+			Blog:          b.Blog,
+			Written:       sql.NullTime{Time: b.Written, Valid: true},
+			Timezone:      b.Timezone,
+		}); err != nil {
+			return fmt.Errorf("archive blog: %w", err)
+		}
+		return q.AdminScrubBlog(ctx, db.AdminScrubBlogParams{Blog: sql.NullString{String: "", Valid: true}, Idblogs: id})
+	case ReportTypeWriting:
+		if done, err := q.AdminIsWritingDeactivated(ctx, id); err != nil || done {
+			return err
+		}
+		w, err := q.SystemGetWritingForArchive(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get writing: %w", err)
+		}
+		if err := q.AdminArchiveWriting(ctx, db.AdminArchiveWritingParams{
+			Idwriting:         w.Idwriting,
+			UsersIdusers:      w.UsersIdusers,
+			ForumthreadID:     w.ForumthreadID,
+			LanguageID:        w.LanguageID,
+			WritingCategoryID: w.WritingCategoryID,
+			Title:             w.Title,
+			Published:         w.Published,
+			Timezone:          w.Timezone,
+			Writing:           w.Writing,
+			Abstract:          w.Abstract,
+			Private:           w.Private,
+		}); err != nil {
+			return fmt.Errorf("archive writing: %w", err)
+		}
+		empty := sql.NullString{String: "", Valid: true}
+		return q.AdminScrubWriting(ctx, db.AdminScrubWritingParams{Title: empty, Writing: empty, Abstract: empty, Idwriting: id})
+	case ReportTypeImagePost:
+		if done, err := q.AdminIsImagepostDeactivated(ctx, id); err != nil || done {
+			return err
+		}
+		p, err := q.AdminGetImagePost(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get image post: %w", err)
+		}
+		if err := q.AdminArchiveImagepost(ctx, db.AdminArchiveImagepostParams{
+			Idimagepost:            p.Idimagepost,
+			ForumthreadID:          p.ForumthreadID,
+			UsersIdusers:           p.UsersIdusers,
+			ImageboardIdimageboard: p.ImageboardIdimageboard,
+			Posted:                 p.Posted,
+			Timezone:               p.Timezone,
+			Description:            p.Description,
+			Thumbnail:              p.Thumbnail,
+			Fullimage:              p.Fullimage,
+			FileSize:               p.FileSize,
+			Approved:               sql.NullBool{Bool: p.Approved, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("archive image post: %w", err)
+		}
+		return q.AdminScrubImagepost(ctx, id)
+	case ReportTypeLink:
+		if done, err := q.AdminIsLinkDeactivated(ctx, id); err != nil || done {
+			return err
+		}
+		l, err := q.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescending(ctx, id)
+		if err != nil {
+			return fmt.Errorf("get link: %w", err)
+		}
+		if err := q.AdminArchiveLink(ctx, db.AdminArchiveLinkParams{
+			ID:          l.ID,
+			LanguageID:  l.LanguageID,
+			AuthorID:    l.AuthorID,
+			CategoryID:  l.CategoryID,
+			ThreadID:    l.ThreadID,
+			Title:       l.Title,
+			Url:         l.Url,
+			Description: l.Description,
+			Listed:      l.Listed,
+			Timezone:    l.Timezone,
+		}); err != nil {
+			return fmt.Errorf("archive link: %w", err)
+		}
+		return q.AdminScrubLink(ctx, db.AdminScrubLinkParams{Title: sql.NullString{String: "", Valid: true}, ID: id})
+	}
+	return fmt.Errorf("unknown report type %q", itemType)
+}
@@ -0,0 +1,250 @@
+package common
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// Item types stored in content_revisions.
+const (
+	RevisionTypeComment = "comment"
+	RevisionTypeBlog    = "blog"
+	RevisionTypeNews    = "news"
+	RevisionTypeWriting = "writing"
+)
+
+// RevisionContent holds the editable A4Code fields of an item. Only writings
+// use Title and Abstract.
+type RevisionContent struct {
+	Title    string
+	Abstract string
+	Body     string
+}
+
+// RevisionSource is a snapshot of an item taken before it is edited.
+type RevisionSource struct {
+	ItemType string
+	ItemID   int32
+	AuthorID int32
+	// ThreadID is the forum thread of a comment and is used for grant checks.
+	ThreadID int32
+	Authored time.Time
+	Timezone sql.NullString
+	Content  RevisionContent
+}
+
+// ValidRevisionType reports whether t is a supported revision item type.
+func ValidRevisionType(t string) bool {
+	switch t {
+	case RevisionTypeComment, RevisionTypeBlog, RevisionTypeNews, RevisionTypeWriting:
+		return true
+	}
+	return false
+}
+
+// LoadRevisionSource fetches the current content of an item without applying
+// viewer permissions.
+func (cd *CoreData) LoadRevisionSource(itemType string, id int32) (*RevisionSource, error) {
+	if cd.queries == nil {
+		return nil, nil
+	}
+	src := &RevisionSource{ItemType: itemType, ItemID: id}
+	switch itemType {
+	case RevisionTypeComment:
+		c, err := cd.queries.GetCommentById(cd.ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		src.AuthorID = c.UsersIdusers
+		src.ThreadID = c.ForumthreadID
+		src.Authored = c.Written.Time
+		src.Timezone = c.Timezone
+		src.Content.Body = c.Text.String
+	case RevisionTypeBlog:
+		b, err := cd.queries.SystemGetBlogForRevision(cd.ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		src.AuthorID = b.UsersIdusers
+		src.Authored = b.Written
+		src.Timezone = b.Timezone
+		src.Content.Body = b.Blog.String
+	case RevisionTypeNews:
+		n, err := cd.queries.SystemGetNewsPostForRevision(cd.ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		src.AuthorID = n.UsersIdusers
+		src.Authored = n.Occurred.Time
+		src.Timezone = n.Timezone
+		src.Content.Body = n.News.String
+	case RevisionTypeWriting:
+		w, err := cd.queries.SystemGetWritingForRevision(cd.ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		src.AuthorID = w.UsersIdusers
+		src.Authored = w.Published.Time
+		src.Timezone = w.Timezone
+		src.Content = RevisionContent{Title: w.Title.String, Abstract: w.Abstract.String, Body: w.Writing.String}
+	default:
+		return nil, fmt.Errorf("unknown revision type %q", itemType)
+	}
+	return src, nil
+}
+
+// RevisionSnapshot captures an item before an edit. Failures are logged and
+// nil is returned so edits are never blocked by revision tracking.
+func (cd *CoreData) RevisionSnapshot(itemType string, id int32) *RevisionSource {
+	src, err := cd.LoadRevisionSource(itemType, id)
+	if err != nil {
+		log.Printf("revision snapshot %s %d: %v", itemType, id, err)
+		return nil
+	}
+	return src
+}
+
+// RecordRevision stores the content of the item captured by before if it has
+// changed since the snapshot was taken. Errors are logged.
+func (cd *CoreData) RecordRevision(before *RevisionSource) {
+	if err := cd.recordRevision(before, 0); err != nil {
+		log.Printf("record revision %s %d: %v", before.ItemType, before.ItemID, err)
+	}
+}
+
+func (cd *CoreData) recordRevision(before *RevisionSource, restoredFrom int32) error {
+	if before == nil || cd.queries == nil {
+		return nil
+	}
+	after, err := cd.LoadRevisionSource(before.ItemType, before.ItemID)
+	if err != nil {
+		return fmt.Errorf("load current: %w", err)
+	}
+	if after.Content == before.Content && restoredFrom == 0 {
+		return nil
+	}
+	n, err := cd.queries.CountContentRevisionsByItem(cd.ctx, db.CountContentRevisionsByItemParams{
+		ItemType: before.ItemType,
+		ItemID:   before.ItemID,
+	})
+	if err != nil {
+		return fmt.Errorf("count revisions: %w", err)
+	}
+	if n == 0 {
+		// The original text predates revision tracking so keep it as the
+		// first revision attributed to the author.
+		authored := before.Authored
+		if authored.IsZero() {
+			authored = time.Now().UTC()
+		}
+		if err := cd.insertRevision(before.ItemType, before.ItemID, before.AuthorID, before.Content, 0, authored, before.Timezone); err != nil {
+			return fmt.Errorf("insert original: %w", err)
+		}
+	}
+	tz := sql.NullString{String: cd.Location().String(), Valid: true}
+	return cd.insertRevision(before.ItemType, before.ItemID, cd.UserID, after.Content, restoredFrom, time.Now().UTC(), tz)
+}
+
+func (cd *CoreData) insertRevision(itemType string, itemID, userID int32, c RevisionContent, restoredFrom int32, at time.Time, tz sql.NullString) error {
+	_, err := cd.queries.InsertContentRevision(cd.ctx, db.InsertContentRevisionParams{
+		ItemType:       itemType,
+		ItemID:         itemID,
+		UsersIdusers:   userID,
+		Title:          sql.NullString{String: c.Title, Valid: itemType == RevisionTypeWriting},
+		Abstract:       sql.NullString{String: c.Abstract, Valid: itemType == RevisionTypeWriting},
+		Body:           sql.NullString{String: c.Body, Valid: true},
+		RestoredFromID: sql.NullInt32{Int32: restoredFrom, Valid: restoredFrom != 0},
+		CreatedAt:      at,
+		Timezone:       tz,
+	})
+	return err
+}
+
+// ContentRevisions lists the stored revisions of an item, newest first.
+func (cd *CoreData) ContentRevisions(itemType string, itemID int32) ([]*db.ListContentRevisionsByItemRow, error) {
+	if cd.queries == nil {
+		return nil, nil
+	}
+	return cd.queries.ListContentRevisionsByItem(cd.ctx, db.ListContentRevisionsByItemParams{
+		ItemType: itemType,
+		ItemID:   itemID,
+	})
+}
+
+// RestoreRevision replaces the item's content with the given revision and
+// records the result as a new revision referencing it.
+func (cd *CoreData) RestoreRevision(src *RevisionSource, revisionID int32) (*db.ContentRevision, error) {
+	if cd.queries == nil || src == nil {
+		return nil, fmt.Errorf("invalid revision source")
+	}
+	rev, err := cd.queries.GetContentRevisionForItem(cd.ctx, db.GetContentRevisionForItemParams{
+		ID:       revisionID,
+		ItemType: src.ItemType,
+		ItemID:   src.ItemID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load revision: %w", err)
+	}
+	switch src.ItemType {
+	case RevisionTypeComment:
+		err = cd.queries.AdminRestoreCommentText(cd.ctx, db.AdminRestoreCommentTextParams{Text: rev.Body, CommentID: src.ItemID})
+	case RevisionTypeBlog:
+		err = cd.queries.AdminRestoreBlogText(cd.ctx, db.AdminRestoreBlogTextParams{Blog: rev.Body, BlogID: src.ItemID})
+	case RevisionTypeNews:
+		err = cd.queries.AdminRestoreNewsPostText(cd.ctx, db.AdminRestoreNewsPostTextParams{News: rev.Body, NewsID: src.ItemID})
+	case RevisionTypeWriting:
+		err = cd.queries.AdminRestoreWritingText(cd.ctx, db.AdminRestoreWritingTextParams{
+			Title:     rev.Title,
+			Abstract:  rev.Abstract,
+			Writing:   rev.Body,
+			WritingID: src.ItemID,
+		})
+	default:
+		err = fmt.Errorf("unknown revision type %q", src.ItemType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("restore: %w", err)
+	}
+	if err := cd.recordRevision(src, rev.ID); err != nil {
+		log.Printf("record revision %s %d: %v", src.ItemType, src.ItemID, err)
+	}
+	return rev, nil
+}
+
+// CanRestoreRevision reports whether the current user may restore revisions
+// of the item described by src.
+func (cd *CoreData) CanRestoreRevision(src *RevisionSource) bool {
+	if src == nil {
+		return false
+	}
+	if cd.IsAdmin() {
+		return true
+	}
+	switch src.ItemType {
+	case RevisionTypeComment:
+		return cd.HasGrant("forum", "thread", "edit-any", src.ThreadID)
+	case RevisionTypeBlog:
+		return cd.HasGrant("blogs", "entry", "edit-any", src.ItemID)
+	case RevisionTypeNews:
+		return cd.HasGrant("news", "post", "edit-any", src.ItemID)
+	case RevisionTypeWriting:
+		return cd.HasGrant("writing", "article", "edit-any", src.ItemID)
+	}
+	return false
+}
+
+// CanViewRevisions reports whether the current user may view the edit
+// history of the item described by src. Authors can always see their own.
+func (cd *CoreData) CanViewRevisions(src *RevisionSource) bool {
+	if src == nil {
+		return false
+	}
+	if cd.UserID != 0 && src.AuthorID == cd.UserID {
+		return true
+	}
+	return cd.CanRestoreRevision(src)
+}
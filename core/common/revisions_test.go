@@ -0,0 +1,97 @@
+package common
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+func newsRevisionRows(text string, written time.Time) *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"idsiteNews", "users_idusers", "news", "occurred", "timezone"}).
+		AddRow(7, 3, text, written, "UTC")
+}
+
+func TestRecordRevisionStoresOriginalAndEdit(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	written := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT idsiteNews, users_idusers, news, occurred, timezone")).
+		WithArgs(int32(7)).
+		WillReturnRows(newsRevisionRows("old text", written))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT idsiteNews, users_idusers, news, occurred, timezone")).
+		WithArgs(int32(7)).
+		WillReturnRows(newsRevisionRows("new text", written))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM content_revisions")).
+		WithArgs(RevisionTypeNews, int32(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO content_revisions")).
+		WithArgs(RevisionTypeNews, int32(7), int32(3), sql.NullString{}, sql.NullString{}, sql.NullString{String: "old text", Valid: true}, sql.NullInt32{}, written, sql.NullString{String: "UTC", Valid: true}).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO content_revisions")).
+		WithArgs(RevisionTypeNews, int32(7), int32(9), sql.NullString{}, sql.NullString{}, sql.NullString{String: "new text", Valid: true}, sql.NullInt32{}, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+
+	cd := NewTestCoreData(t, db.New(conn))
+	cd.UserID = 9
+
+	before := cd.RevisionSnapshot(RevisionTypeNews, 7)
+	if before == nil || before.Content.Body != "old text" || before.AuthorID != 3 {
+		t.Fatalf("unexpected snapshot %+v", before)
+	}
+	cd.RecordRevision(before)
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("mock expectations: %v", err)
+	}
+}
+
+func TestRecordRevisionSkipsUnchanged(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	written := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT idsiteNews, users_idusers, news, occurred, timezone")).
+		WithArgs(int32(7)).
+		WillReturnRows(newsRevisionRows("same", written))
+
+	cd := NewTestCoreData(t, db.New(conn))
+	cd.UserID = 3
+	cd.RecordRevision(&RevisionSource{ItemType: RevisionTypeNews, ItemID: 7, AuthorID: 3, Content: RevisionContent{Body: "same"}})
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("mock expectations: %v", err)
+	}
+}
+
+func TestCanViewRevisions(t *testing.T) {
+	cd := NewTestCoreData(t, &QuerierFake{SystemCheckGrantErr: sql.ErrNoRows})
+	WithPermissions([]*db.GetPermissionsByUserIDRow{})(cd)
+	cd.UserID = 5
+	src := &RevisionSource{ItemType: RevisionTypeBlog, ItemID: 1, AuthorID: 5}
+	if !cd.CanViewRevisions(src) {
+		t.Fatalf("author should see history")
+	}
+	if cd.CanRestoreRevision(src) {
+		t.Fatalf("author should not restore without edit-any")
+	}
+	src.AuthorID = 6
+	if cd.CanViewRevisions(src) {
+		t.Fatalf("other users should not see history")
+	}
+
+	WithGrants([]*db.Grant{{Section: "blogs", Item: sql.NullString{String: "entry", Valid: true}, Action: "edit-any", Active: true}})(cd)
+	if !cd.CanRestoreRevision(src) {
+		t.Fatalf("edit-any grant should allow restore")
+	}
+}
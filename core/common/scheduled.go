@@ -0,0 +1,166 @@
+package common
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/arran4/go-be-lazy"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+// Item types that can be scheduled for later publication.
+const (
+	ScheduledTypeNews    = "news"
+	ScheduledTypeBlog    = "blog"
+	ScheduledTypeWriting = "writing"
+)
+
+// PublishAtLayout is the format of a datetime-local "publish at" form field.
+const PublishAtLayout = "2006-01-02T15:04"
+
+// ScheduledEventKey marks task event data for content that is not live yet so
+// subscriber notifications wait for the publish worker.
+const ScheduledEventKey = "scheduled"
+
+// EventScheduled reports whether evt describes content held back until its
+// publish time.
+func EventScheduled(evt eventbus.TaskEvent) bool {
+	v, _ := evt.Data[ScheduledEventKey].(bool)
+	return v
+}
+
+func scheduledKey(itemType string, itemID int32) string {
+	return fmt.Sprintf("%s:%d", itemType, itemID)
+}
+
+// ParsePublishAt reads a "publish at" form value in the viewer's timezone.
+// An empty value returns the zero time.
+func (cd *CoreData) ParsePublishAt(v string) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.ParseInLocation(PublishAtLayout, v, cd.Location())
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid publish time: %w", err)
+	}
+	return t.UTC(), nil
+}
+
+// ScheduledPublication returns the pending publication for an item, or nil
+// when the item is live. Lookups are cached per request. Listings filter
+// scheduled items in SQL; this is for single items.
+func (cd *CoreData) ScheduledPublication(itemType string, itemID int32) (*db.ScheduledPublication, error) {
+	fetch := func(key string) (*db.ScheduledPublication, error) {
+		if cd.queries == nil {
+			return nil, nil
+		}
+		sp, err := cd.queries.GetScheduledPublication(cd.ctx, db.GetScheduledPublicationParams{ItemType: itemType, ItemID: itemID})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get scheduled publication %s: %w", key, err)
+		}
+		return sp, nil
+	}
+	return lazy.Map(&cd.cache.scheduledPublications, &cd.cache.mapMu, scheduledKey(itemType, itemID), fetch)
+}
+
+// ScheduledLive reports whether an item has gone live. Lookup failures are
+// logged and count as not live so pending content is never shown by mistake.
+func (cd *CoreData) ScheduledLive(itemType string, itemID int32) bool {
+	sp, err := cd.ScheduledPublication(itemType, itemID)
+	if err != nil {
+		log.Printf("scheduled publication: %v", err)
+		return false
+	}
+	return sp == nil
+}
+
+// forgetScheduledPublication drops the cached lookup for an item after its
+// schedule changes.
+func (cd *CoreData) forgetScheduledPublication(itemType string, itemID int32) {
+	cd.cache.mapMu.Lock()
+	delete(cd.cache.scheduledPublications, scheduledKey(itemType, itemID))
+	cd.cache.mapMu.Unlock()
+}
+
+// ScheduledPublishAtInput formats an item's pending publish time for a
+// datetime-local input. It returns an empty string for live items.
+func (cd *CoreData) ScheduledPublishAtInput(itemType string, itemID int32) string {
+	sp, err := cd.ScheduledPublication(itemType, itemID)
+	if err != nil {
+		log.Printf("scheduled publication: %v", err)
+		return ""
+	}
+	if sp == nil {
+		return ""
+	}
+	return sp.PublishAt.In(cd.Location()).Format(PublishAtLayout)
+}
+
+// SchedulePublication holds a newly created item back until at. It reports
+// false without recording anything when at is not in the future.
+func (cd *CoreData) SchedulePublication(itemType string, itemID, authorID int32, at time.Time) (bool, error) {
+	if cd.queries == nil || !at.After(time.Now()) {
+		return false, nil
+	}
+	if err := cd.queries.UpsertScheduledPublicationForAuthor(cd.ctx, db.UpsertScheduledPublicationForAuthorParams{
+		ItemType:  itemType,
+		ItemID:    itemID,
+		AuthorID:  authorID,
+		PublishAt: at.UTC(),
+	}); err != nil {
+		return false, fmt.Errorf("schedule publication: %w", err)
+	}
+	cd.forgetScheduledPublication(itemType, itemID)
+	return true, nil
+}
+
+// ReschedulePublication moves the publish time of an item that has not gone
+// live yet. A zero or past time publishes it on the next worker run. Items
+// that are already live cannot be scheduled again, so it reports false for
+// them.
+func (cd *CoreData) ReschedulePublication(itemType string, itemID int32, at time.Time) (bool, error) {
+	if cd.queries == nil {
+		return false, nil
+	}
+	sp, err := cd.queries.GetScheduledPublication(cd.ctx, db.GetScheduledPublicationParams{ItemType: itemType, ItemID: itemID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("get scheduled publication: %w", err)
+	}
+	if now := time.Now().UTC(); at.IsZero() || at.Before(now) {
+		at = now
+	}
+	if err := cd.queries.UpsertScheduledPublicationForAuthor(cd.ctx, db.UpsertScheduledPublicationForAuthorParams{
+		ItemType:  itemType,
+		ItemID:    itemID,
+		AuthorID:  sp.AuthorID,
+		PublishAt: at.UTC(),
+	}); err != nil {
+		return false, fmt.Errorf("reschedule publication: %w", err)
+	}
+	cd.forgetScheduledPublication(itemType, itemID)
+	return true, nil
+}
+
+// MarkEventScheduled flags the current task event so subscribers are not told
+// about content before it goes live.
+func (cd *CoreData) MarkEventScheduled() {
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data[ScheduledEventKey] = true
+	}
+}
@@ -0,0 +1,73 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+func TestScheduledLive(t *testing.T) {
+	q := &db.QuerierStub{GetScheduledPublicationReturns: &db.ScheduledPublication{
+		ID: 1, ItemType: ScheduledTypeBlog, ItemID: 5, AuthorID: 7, PublishAt: time.Now().Add(time.Hour),
+	}}
+	cd := NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+
+	if cd.ScheduledLive(ScheduledTypeBlog, 5) {
+		t.Fatal("scheduled entry reported live")
+	}
+	cd.ScheduledLive(ScheduledTypeBlog, 5)
+	if len(q.GetScheduledPublicationCalls) != 1 {
+		t.Fatalf("lookups = %d, want 1", len(q.GetScheduledPublicationCalls))
+	}
+}
+
+func TestScheduledLiveFailsClosed(t *testing.T) {
+	q := &db.QuerierStub{GetScheduledPublicationErr: errors.New("db down")}
+	cd := NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+
+	if cd.ScheduledLive(ScheduledTypeNews, 3) {
+		t.Fatal("lookup error reported item live")
+	}
+}
+
+func TestBlogListPassesScheduledViewer(t *testing.T) {
+	q := &db.QuerierStub{}
+	cd := NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+	cd.UserID = 7
+
+	if _, err := cd.BlogListPage(0, 15); err != nil {
+		t.Fatalf("BlogListPage: %v", err)
+	}
+	if len(q.ListBlogEntriesForListerCalls) != 1 {
+		t.Fatalf("list calls = %d, want 1", len(q.ListBlogEntriesForListerCalls))
+	}
+	arg := q.ListBlogEntriesForListerCalls[0]
+	if arg.ScheduledViewerID != 7 {
+		t.Fatalf("ScheduledViewerID = %d, want 7", arg.ScheduledViewerID)
+	}
+	if arg.ShowScheduled != false {
+		t.Fatalf("ShowScheduled = %v, want false", arg.ShowScheduled)
+	}
+}
+
+func TestParsePublishAtUsesViewerLocation(t *testing.T) {
+	cd := NewCoreData(context.Background(), nil, config.NewRuntimeConfig())
+	got, err := cd.ParsePublishAt("2026-03-04T05:06")
+	if err != nil {
+		t.Fatalf("ParsePublishAt: %v", err)
+	}
+	want := time.Date(2026, 3, 4, 5, 6, 0, 0, cd.Location()).UTC()
+	if !got.Equal(want) {
+		t.Fatalf("ParsePublishAt = %v, want %v", got, want)
+	}
+	if got, err := cd.ParsePublishAt(""); err != nil || !got.IsZero() {
+		t.Fatalf("empty ParsePublishAt = %v, %v", got, err)
+	}
+	if _, err := cd.ParsePublishAt("tomorrow"); err == nil {
+		t.Fatal("expected error for invalid time")
+	}
+}
@@ -3,10 +3,12 @@ package common
 import (
 	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/arran4/goa4web/core/consts"
+	imagesign "github.com/arran4/goa4web/internal/images"
 	"github.com/arran4/goa4web/internal/sign"
 	"github.com/arran4/goa4web/internal/sign/signutil"
 )
@@ -159,6 +161,28 @@ func (cd *CoreData) ThumbnailReferenceForImage(imageRef string) string {
 	return thumbnailFilename(strings.TrimSuffix(imageID, ext), ext, size)
 }
 
+// ImageSrcset returns a srcset attribute value listing the responsive
+// derivatives of an uploaded image followed by the original. It returns an
+// empty string when the image is unknown or has no derivatives.
+func (cd *CoreData) ImageSrcset(imageRef string) string {
+	imageID := strings.TrimPrefix(strings.TrimPrefix(cleanSignedParam(imageRef), "image:"), "img:")
+	image, err := cd.UploadedImageByImageID(imageID)
+	if err != nil || image == nil || !image.Width.Valid {
+		return ""
+	}
+	width := int(image.Width.Int32)
+	widths := imagesign.DerivativeWidths(width, cd.Config.SrcsetWidths())
+	if len(widths) == 0 {
+		return ""
+	}
+	candidates := make([]string, 0, len(widths)+1)
+	for _, w := range widths {
+		candidates = append(candidates, cd.SignCacheURL(imagesign.DerivativeName(imageID, w), 24*time.Hour)+" "+strconv.Itoa(w)+"w")
+	}
+	candidates = append(candidates, cd.SignImageURL(imageID, 24*time.Hour)+" "+strconv.Itoa(width)+"w")
+	return strings.Join(candidates, ", ")
+}
+
 // ThumbnailReferenceForCache returns the default thumbnail for an oversized cached image.
 func (cd *CoreData) ThumbnailReferenceForCache(cacheRef string) string {
 	cacheRef = cleanSignedParam(cacheRef)
@@ -75,13 +75,18 @@ document.addEventListener('DOMContentLoaded', function() {
             const modalTextarea = document.getElementById('md-convert-textarea-' + targetId);
             const mainTextarea = document.getElementById(targetId);
 
-            if (modal && modalTextarea && mainTextarea && window.A4Code) {
-                const mode = modal.getAttribute('data-mode');
-                if (mode === 'md-to-a4') {
-                    mainTextarea.value = window.A4Code.markdownToA4Code(modalTextarea.value);
-                } else {
-                    mainTextarea.value = window.A4Code.a4codeToMarkdown(modalTextarea.value);
-                }
+            if (modal && modalTextarea && mainTextarea && modal.getAttribute('data-mode') === 'md-to-a4') {
+                markdownToA4Code(modalTextarea.value)
+                .then(code => {
+                    mainTextarea.value = code;
+                    modal.classList.add('hidden');
+                })
+                .catch(error => {
+                    console.error('Error converting markdown:', error);
+                    alert('Failed to convert Markdown.');
+                });
+            } else if (modal && modalTextarea && mainTextarea && window.A4Code) {
+                mainTextarea.value = window.A4Code.a4codeToMarkdown(modalTextarea.value);
                 modal.classList.add('hidden');
             } else if (!window.A4Code) {
                 alert("A4Code library not loaded");
@@ -128,6 +133,7 @@ document.addEventListener('DOMContentLoaded', function() {
     });
 
     setupKeyboardShortcuts();
+    setupDraftAutosave();
 
     let lastQuoteState = { comment: null, hidden: false };
 
@@ -500,14 +506,48 @@ function scrollComment(direction) {
     }, 2000);
 }
 
+// markdownToA4Code converts Markdown on the server, which understands more
+// of the syntax than the client side converter. The client side converter is
+// used when the server cannot be reached.
+function markdownToA4Code(text) {
+    const headers = {
+        'Content-Type': 'text/plain',
+    };
+    const csrfToken = document.querySelector('input[name="gorilla.csrf.Token"]');
+    if (csrfToken) {
+        headers['X-CSRF-Token'] = csrfToken.value;
+    }
+    return fetch('/a4code/markdown', {
+        method: 'POST',
+        headers: headers,
+        body: text
+    })
+    .then(response => {
+        if (!response.ok) {
+            throw new Error('Network response was not ok');
+        }
+        return response.text();
+    })
+    .catch(error => {
+        if (window.A4Code && window.A4Code.markdownToA4Code) {
+            console.warn('Server markdown conversion failed, converting locally:', error);
+            return window.A4Code.markdownToA4Code(text);
+        }
+        throw error;
+    });
+}
+
 function convertMarkdownToA4Code(targetId) {
     const textarea = document.getElementById(targetId);
     if (!textarea) return;
-    if (window.A4Code) {
-        textarea.value = A4Code.markdownToA4Code(textarea.value);
-    } else {
-        alert("A4Code library not loaded");
-    }
+    markdownToA4Code(textarea.value)
+    .then(code => {
+        textarea.value = code;
+    })
+    .catch(error => {
+        console.error('Error converting markdown:', error);
+        alert('Failed to convert Markdown.');
+    });
 }
 
 function convertA4CodeToMarkdown(targetId) {
@@ -564,6 +604,154 @@ function previewA4Code(targetId, previewUrl, containerId) {
     });
 }
 
+const draftEndpoint = '/usr/drafts/autosave';
+
+// Forms with a draft_target input are saved to the server as the user types
+// and offered back when the page is opened again.
+function setupDraftAutosave() {
+    document.querySelectorAll('input[name="draft_target"]').forEach(input => {
+        if (input.form && input.value) {
+            initDraftForm(input.form, input.value);
+        }
+    });
+}
+
+function draftFieldElements(form) {
+    return Array.from(form.querySelectorAll('textarea[name], input[type="text"][name]'));
+}
+
+function draftValues(form) {
+    const fields = {};
+    draftFieldElements(form).forEach(el => {
+        fields[el.name] = el.value;
+    });
+    return fields;
+}
+
+function draftHeaders(form, extra) {
+    const headers = Object.assign({}, extra);
+    const csrfToken = form.querySelector('input[name="gorilla.csrf.Token"]');
+    if (csrfToken) {
+        headers['X-CSRF-Token'] = csrfToken.value;
+    }
+    return headers;
+}
+
+function initDraftForm(form, target) {
+    const original = draftValues(form);
+    let lastSaved = JSON.stringify(original);
+    let timer = null;
+
+    const status = document.createElement('span');
+    status.className = 'draft-status';
+    form.appendChild(status);
+
+    function save() {
+        timer = null;
+        const fields = draftValues(form);
+        const body = JSON.stringify(fields);
+        if (body === lastSaved) return;
+        fetch(draftEndpoint, {
+            method: 'POST',
+            headers: draftHeaders(form, { 'Content-Type': 'application/json' }),
+            body: JSON.stringify({ target: target, url: location.pathname + location.search, fields: fields }),
+            keepalive: true
+        })
+        .then(response => {
+            if (!response.ok) {
+                throw new Error('Draft save failed: ' + response.status);
+            }
+            lastSaved = body;
+            status.textContent = 'Draft saved ' + new Date().toLocaleTimeString();
+        })
+        .catch(error => {
+            console.error('Error saving draft:', error);
+            status.textContent = 'Draft not saved';
+        });
+    }
+
+    function schedule() {
+        if (timer) clearTimeout(timer);
+        timer = setTimeout(save, 2000);
+    }
+
+    function setValues(fields) {
+        draftFieldElements(form).forEach(el => {
+            if (Object.prototype.hasOwnProperty.call(fields, el.name)) {
+                el.value = fields[el.name];
+            }
+        });
+    }
+
+    function discard(notice) {
+        fetch(draftEndpoint + '?target=' + encodeURIComponent(target), {
+            method: 'DELETE',
+            headers: draftHeaders(form, {})
+        }).catch(error => console.error('Error discarding draft:', error));
+        setValues(original);
+        lastSaved = JSON.stringify(original);
+        status.textContent = '';
+        notice.remove();
+    }
+
+    form.addEventListener('input', schedule);
+    form.addEventListener('change', schedule);
+    // The server discards the draft once the form is accepted, so a save
+    // racing the submission must not recreate it.
+    form.addEventListener('submit', () => {
+        if (timer) clearTimeout(timer);
+        timer = null;
+        lastSaved = JSON.stringify(draftValues(form));
+    });
+    document.addEventListener('visibilitychange', () => {
+        if (document.visibilityState === 'hidden' && timer) {
+            clearTimeout(timer);
+            save();
+        }
+    });
+
+    fetch(draftEndpoint + '?target=' + encodeURIComponent(target), {
+        headers: { 'Accept': 'application/json' }
+    })
+    .then(response => response.ok ? response.json() : null)
+    .then(draft => {
+        if (!draft || !draft.fields) return;
+        const elements = draftFieldElements(form);
+        const changed = elements.filter(el => Object.prototype.hasOwnProperty.call(draft.fields, el.name) && draft.fields[el.name] !== el.value);
+        if (changed.length === 0) return;
+
+        const notice = document.createElement('div');
+        notice.className = 'draft-notice';
+        const when = new Date(draft.updated_at).toLocaleString();
+        const discardBtn = document.createElement('button');
+        discardBtn.type = 'button';
+        discardBtn.textContent = 'Discard draft';
+        discardBtn.addEventListener('click', () => discard(notice));
+
+        if (changed.every(el => el.value.trim() === '')) {
+            setValues(draft.fields);
+            lastSaved = JSON.stringify(draftValues(form));
+            notice.textContent = 'Restored your draft from ' + when + '. ';
+        } else {
+            notice.textContent = 'You have an unsubmitted draft from ' + when + '. ';
+            const restoreBtn = document.createElement('button');
+            restoreBtn.type = 'button';
+            restoreBtn.textContent = 'Restore draft';
+            restoreBtn.addEventListener('click', () => {
+                setValues(draft.fields);
+                lastSaved = JSON.stringify(draftValues(form));
+                notice.textContent = 'Restored your draft from ' + when + '. ';
+                notice.appendChild(discardBtn);
+            });
+            notice.appendChild(restoreBtn);
+            notice.appendChild(document.createTextNode(' '));
+        }
+        notice.appendChild(discardBtn);
+        form.insertBefore(notice, form.firstChild);
+    })
+    .catch(error => console.error('Error loading draft:', error));
+}
+
 function quoteInNewThread(commentId, topicId, event) {
     const selection = window.getSelection();
     let url = '';
@@ -22,6 +22,9 @@ func (f *fakeSearchCD) SearchComments() []*db.GetCommentsByIdsForUserWithThreadI
 func (*fakeSearchCD) SearchCommentsNoResults() bool               { return false }
 func (*fakeSearchCD) SearchCommentsEmptyWords() bool              { return false }
 func (*fakeSearchCD) LocalTimeIn(t time.Time, _ string) time.Time { return t }
+func (*fakeSearchCD) SearchSnippet(_ string, _ int32, text string) template.HTML {
+	return common.HighlightSearchTerms(text, []string{"match"})
+}
 
 func TestCommentSearchResultsHighlightsAndEscapes(t *testing.T) {
 	cd := &fakeSearchCD{
@@ -24,6 +24,7 @@ func (*fakeCD) CommentAdminURL(*db.GetCommentsByThreadIdForUserRow) string    {
 func (*fakeCD) Location() *time.Location                                      { return time.UTC }
 func (*fakeCD) LocalTime(t time.Time) time.Time                               { return t }
 func (*fakeCD) LocalTimeIn(t time.Time, _ string) time.Time                   { return t }
+func (*fakeCD) Reactions(string, int32) any                                   { return nil }
 
 func TestCommentTimestampSelfLink(t *testing.T) {
 	funcMap := template.FuncMap{
@@ -33,7 +34,7 @@ func TestCommentTimestampSelfLink(t *testing.T) {
 		"since":       func(time.Time, time.Time) string { return "" },
 		"timeAgo":     func(time.Time) string { return "" },
 	}
-	tmpl := template.Must(template.New("root").Funcs(funcMap).ParseFiles("site/partials/common/comment.gohtml", "site/partials/forms/languageCombobox.gohtml"))
+	tmpl := template.Must(template.New("root").Funcs(funcMap).ParseFiles("site/partials/common/comment.gohtml", "site/partials/common/reactions.gohtml", "site/partials/forms/languageCombobox.gohtml"))
 	var buf bytes.Buffer
 	cmt := &db.GetCommentsByThreadIdForUserRow{
 		Idcomments:     1,
@@ -92,7 +93,7 @@ func TestCommentUsernameBold(t *testing.T) {
 				"since":       func(time.Time, time.Time) string { return "" },
 				"timeAgo":     func(time.Time) string { return "" },
 			}
-			tmpl := template.Must(template.New("root").Funcs(funcMap).ParseFiles("site/partials/common/comment.gohtml", "site/partials/forms/languageCombobox.gohtml"))
+			tmpl := template.Must(template.New("root").Funcs(funcMap).ParseFiles("site/partials/common/comment.gohtml", "site/partials/common/reactions.gohtml", "site/partials/forms/languageCombobox.gohtml"))
 			var buf bytes.Buffer
 			cmt := &db.GetCommentsByThreadIdForUserRow{
 				Idcomments:     1,
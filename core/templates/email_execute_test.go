@@ -21,6 +21,7 @@ type emailData struct {
 	Recipient      any
 	Notifications  []any
 	BaseURL        string
+	ReplyTo        string
 }
 
 func sampleEmailData() emailData {
@@ -79,6 +80,7 @@ func sampleEmailData() emailData {
 		UnsubscribeUrl: "https://example.com/unsubscribe",
 		SignOff:        "signoff",
 		SignOffHTML:    htemplate.HTML("signoff"),
+		ReplyTo:        "reply@example.com",
 		Item:           item,
 		Recipient: map[string]any{
 			"Username": map[string]any{"String": "recipient"},
@@ -0,0 +1,80 @@
+package templates
+
+import (
+	"bytes"
+	"database/sql"
+	"html/template"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+type pollOption struct {
+	ID       int32
+	Label    string
+	Votes    int64
+	Percent  int
+	Selected bool
+	Voters   []string
+}
+
+type pollView struct {
+	*db.ForumPoll
+	Options     []*pollOption
+	TotalVoters int64
+	Voted       bool
+	Closed      bool
+	CanVote     bool
+	ShowResults bool
+}
+
+func renderForumPoll(t *testing.T, p *pollView) string {
+	t.Helper()
+	funcMap := template.FuncMap{
+		"cd":        func() *fakeCD { return &fakeCD{} },
+		"csrfField": csrfField,
+	}
+	tmpl := template.Must(template.New("root").Funcs(funcMap).ParseFiles("site/domains/forum/poll.gohtml"))
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "forumPoll", map[string]any{"Poll": p, "Action": "/forum/topic/1/thread/2/poll"}); err != nil {
+		t.Fatalf("execute template: %v", err)
+	}
+	return buf.String()
+}
+
+func TestForumPollVoteFormHidesResults(t *testing.T) {
+	out := renderForumPoll(t, &pollView{
+		ForumPoll: &db.ForumPoll{Question: "Lunch?", ResultsVisibility: "voted", Anonymous: true,
+			ClosesAt: sql.NullTime{Time: time.Date(2030, 1, 2, 3, 4, 0, 0, time.UTC), Valid: true}},
+		Options: []*pollOption{{ID: 1, Label: "Pizza"}, {ID: 2, Label: "Soup"}},
+		CanVote: true,
+	})
+	for _, want := range []string{`action="/forum/topic/1/thread/2/poll"`, `type="radio" name="option" value="2"`, "Closes 2030-01-02 03:04 UTC", "Results are shown after you vote."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in %s", want, out)
+		}
+	}
+	if strings.Contains(out, "poll-count") {
+		t.Errorf("results shown before voting: %s", out)
+	}
+}
+
+func TestForumPollClosedShowsPublicVoters(t *testing.T) {
+	out := renderForumPoll(t, &pollView{
+		ForumPoll:   &db.ForumPoll{Question: "Lunch?", MultipleChoice: true, ResultsVisibility: "closed"},
+		Options:     []*pollOption{{ID: 1, Label: "Pizza", Votes: 2, Percent: 100, Voters: []string{"alice", "bob"}}},
+		TotalVoters: 2,
+		Closed:      true,
+		ShowResults: true,
+	})
+	for _, want := range []string{"Closed", "2 (100%)", "alice, bob", "Public"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in %s", want, out)
+		}
+	}
+	if strings.Contains(out, "<form") {
+		t.Errorf("closed poll offers voting: %s", out)
+	}
+}
@@ -25,6 +25,7 @@ func TestThreadPageShowsDefaultPrivateLabels(t *testing.T) {
 		BackURL           string
 		TotalReplyThreads int
 		SourceReference   any
+		Poll              any
 	}{}
 	data.Topic.Idforumtopic = 1
 	data.Thread.Idforumthread = 3
@@ -57,6 +58,7 @@ func TestThreadPageAuthorizedSourceUsesDatabaseCommentAnchor(t *testing.T) {
 		BackURL           string
 		TotalReplyThreads int
 		SourceReference   *sourceReference
+		Poll              any
 	}{
 		BasePath:        "/forum",
 		SourceReference: &sourceReference{ThreadID: 55, TopicID: 5, CommentID: 66},
@@ -96,7 +98,7 @@ func parseThreadPageTemplate(t *testing.T) *template.Template {
 	tmpl := template.New("test").Funcs(funcMap)
 
 	// Provide stub templates used by threadPage.gohtml.
-	if _, err := tmpl.Parse(`{{define "head"}}{{end}}{{define "tail"}}{{end}}{{define "threadComments"}}{{end}}{{define "forumReply"}}{{end}}{{define "partials/common/_share.gohtml"}}{{end}}`); err != nil {
+	if _, err := tmpl.Parse(`{{define "head"}}{{end}}{{define "tail"}}{{end}}{{define "threadComments"}}{{end}}{{define "forumReply"}}{{end}}{{define "forumPoll"}}{{end}}{{define "partials/common/_share.gohtml"}}{{end}}`); err != nil {
 		t.Fatalf("parse stubs: %v", err)
 	}
 	if _, err := tmpl.ParseFiles("site/domains/forum/topicLabels.gohtml", "site/domains/forum/threadPage.gohtml"); err != nil {
@@ -94,9 +94,10 @@ func TestThreadNewPageJS(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			data := struct {
-				BasePath  string
-				Topic     struct{ Idforumtopic int32 }
-				QuoteText string
+				BasePath      string
+				Topic         struct{ Idforumtopic int32 }
+				QuoteText     string
+				CanCreatePoll bool
 			}{
 				BasePath: tt.basePath,
 			}
@@ -8,16 +8,29 @@ import (
 //go:embed schema.mysql.sql
 var SchemaMySQL []byte
 
+//go:embed schema.postgres.sql
+var SchemaPostgres []byte
+
 //go:embed seed.sql
 var SeedSQL []byte
 
 //go:embed seed.sqlite.sql
 var SeedSQLiteSQL []byte
 
+// SchemaForDriver returns the schema SQL for the specified database driver.
+func SchemaForDriver(driver string) []byte {
+	switch strings.ToLower(driver) {
+	case "postgres", "pgx":
+		return SchemaPostgres
+	}
+	return SchemaMySQL
+}
+
 // SeedSQLForDriver returns the seed SQL for the specified database driver.
+// PostgreSQL shares the SQLite seed as both accept ON CONFLICT upserts.
 func SeedSQLForDriver(driver string) []byte {
 	switch strings.ToLower(driver) {
-	case "sqlite", "sqlite3":
+	case "sqlite", "sqlite3", "postgres", "pgx":
 		if len(SeedSQLiteSQL) > 0 {
 			return SeedSQLiteSQL
 		}
@@ -84,6 +84,21 @@ CREATE TABLE `faq_categories` (
   PRIMARY KEY (`id`)
 );
 
+CREATE TABLE IF NOT EXISTS `content_revisions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `users_idusers` int NOT NULL,
+  `title` mediumtext DEFAULT NULL,
+  `abstract` mediumtext DEFAULT NULL,
+  `body` mediumtext DEFAULT NULL,
+  `restored_from_id` int DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `timezone` tinytext DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `content_revisions_item_idx` (`item_type`, `item_id`)
+);
+
 CREATE TABLE IF NOT EXISTS `faq_revisions` (
   `id` int NOT NULL AUTO_INCREMENT,
   `faq_id` int NOT NULL,
@@ -299,6 +314,7 @@ CREATE TABLE `preferences` (
   `monthly_digest_hour` INT DEFAULT NULL,
   `last_monthly_digest_sent_at` DATETIME DEFAULT NULL,
   `image_safe_dimension` VARCHAR(50) DEFAULT NULL,
+  `mute_mentions` tinyint(1) NOT NULL DEFAULT 0,
   PRIMARY KEY (`idpreferences`),
   KEY `preferences_FKIndex1` (`users_idusers`),
   KEY `preferences_FKIndex2` (`language_id`)
@@ -822,6 +838,17 @@ INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (93, 1);
 INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (94, 1);
 INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (95, 1);
 INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (96, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (97, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (98, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (99, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (100, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (101, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (102, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (103, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (104, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (105, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (106, 1);
+INSERT INTO `goose_db_version` (`version_id`, `is_applied`) VALUES (107, 1);
 
 
 
@@ -844,3 +871,207 @@ CREATE TABLE user_passkeys (
     KEY user_passkeys_user_idx (user_id),
     UNIQUE KEY user_passkeys_cred_idx (credential_id(255))
 );
+
+CREATE TABLE `user_totp` (
+  `users_idusers` int NOT NULL,
+  `secret` varchar(64) NOT NULL,
+  `enabled_at` datetime DEFAULT NULL,
+  `last_used_step` bigint NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`users_idusers`)
+);
+
+CREATE TABLE `user_recovery_codes` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `users_idusers` int NOT NULL,
+  `code_hash` varchar(64) NOT NULL,
+  `used_at` datetime DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `user_recovery_codes_user_idx` (`users_idusers`)
+);
+
+CREATE TABLE `webhooks` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(128) NOT NULL,
+  `url` text NOT NULL,
+  `secret` varchar(128) NOT NULL,
+  `patterns` text NOT NULL,
+  `active` tinyint(1) NOT NULL DEFAULT 1,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`)
+);
+
+CREATE TABLE `webhook_deliveries` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `webhook_id` int NOT NULL,
+  `event` varchar(128) NOT NULL,
+  `path` text NOT NULL,
+  `payload` mediumtext NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT 'pending',
+  `attempts` int NOT NULL DEFAULT 0,
+  `response_status` int DEFAULT NULL,
+  `error` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `webhook_deliveries_webhook_idx` (`webhook_id`)
+);
+
+CREATE TABLE `reactions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `users_idusers` int NOT NULL,
+  `reaction` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `reactions_item_user_reaction_idx` (`item_type`, `item_id`, `users_idusers`, `reaction`),
+  KEY `reactions_item_idx` (`item_type`, `item_id`)
+);
+
+CREATE TABLE `forum_polls` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `forumthread_id` int NOT NULL,
+  `question` text NOT NULL,
+  `multiple_choice` tinyint(1) NOT NULL DEFAULT 0,
+  `anonymous` tinyint(1) NOT NULL DEFAULT 1,
+  `results_visibility` varchar(16) NOT NULL DEFAULT 'always',
+  `closes_at` datetime DEFAULT NULL,
+  `closed_at` datetime DEFAULT NULL,
+  `created_by` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `forum_polls_thread_idx` (`forumthread_id`),
+  KEY `forum_polls_closing_idx` (`closed_at`, `closes_at`)
+);
+
+CREATE TABLE `forum_poll_options` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `poll_id` int NOT NULL,
+  `position` int NOT NULL DEFAULT 0,
+  `label` varchar(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `forum_poll_options_poll_idx` (`poll_id`, `position`)
+);
+
+CREATE TABLE `forum_poll_votes` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `poll_id` int NOT NULL,
+  `option_id` int NOT NULL,
+  `users_idusers` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `forum_poll_votes_option_user_idx` (`option_id`, `users_idusers`),
+  KEY `forum_poll_votes_poll_user_idx` (`poll_id`, `users_idusers`)
+);
+
+CREATE TABLE `content_reports` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `reporter_id` int NOT NULL,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `author_id` int NOT NULL,
+  `reason` text NOT NULL,
+  `status` varchar(20) NOT NULL DEFAULT 'pending',
+  `resolution_note` text,
+  `resolved_by` int DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `resolved_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `content_reports_status_idx` (`status`, `created_at`),
+  KEY `content_reports_item_idx` (`item_type`, `item_id`),
+  KEY `content_reports_reporter_idx` (`reporter_id`)
+);
+
+CREATE TABLE `scheduled_publications` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `author_id` int NOT NULL,
+  `publish_at` datetime NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `scheduled_publications_item_idx` (`item_type`, `item_id`),
+  KEY `scheduled_publications_publish_at_idx` (`publish_at`)
+);
+
+CREATE TABLE `drafts` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `target` varchar(128) NOT NULL,
+  `url` varchar(255) NOT NULL,
+  `content` mediumtext NOT NULL,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `drafts_user_target_idx` (`user_id`, `target`),
+  KEY `drafts_updated_at_idx` (`updated_at`)
+);
+
+CREATE TABLE `activitypub_actor_keys` (
+  `user_id` int NOT NULL,
+  `public_key_pem` text NOT NULL,
+  `private_key_pem` text NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`user_id`)
+);
+
+CREATE TABLE `activitypub_followers` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `actor_url` varchar(512) NOT NULL,
+  `inbox_url` varchar(512) NOT NULL,
+  `shared_inbox_url` varchar(512) DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `activitypub_followers_user_actor_idx` (`user_id`, `actor_url`)
+);
+
+CREATE TABLE `activitypub_activities` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `activity_type` varchar(32) NOT NULL,
+  `object_url` varchar(512) NOT NULL,
+  `object_json` mediumtext NOT NULL,
+  `published` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `activitypub_activities_user_idx` (`user_id`, `id`),
+  KEY `activitypub_activities_object_idx` (`object_url`)
+);
+
+CREATE TABLE `activitypub_deliveries` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `inbox_url` varchar(512) NOT NULL,
+  `payload` mediumtext NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT 'pending',
+  `attempts` int NOT NULL DEFAULT 0,
+  `next_attempt_at` datetime NOT NULL,
+  `error` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `activitypub_deliveries_due_idx` (`status`, `next_attempt_at`)
+);
+
+CREATE TABLE `email_bounces` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_email_id` int NOT NULL,
+  `kind` varchar(16) NOT NULL,
+  `source` varchar(16) NOT NULL,
+  `detail` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `email_bounces_user_email_idx` (`user_email_id`)
+);
+
+CREATE TABLE `email_suppressions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `email` varchar(255) NOT NULL,
+  `user_id` int DEFAULT NULL,
+  `reason` varchar(16) NOT NULL,
+  `detail` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `email_suppressions_email_idx` (`email`)
+);
@@ -65,6 +65,20 @@ priority INT NOT NULL DEFAULT 0,
 updated_at DATETIME DEFAULT NULL
 );
 
+CREATE TABLE IF NOT EXISTS content_revisions (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+item_type TEXT NOT NULL,
+item_id int NOT NULL,
+users_idusers int NOT NULL,
+title TEXT DEFAULT NULL,
+abstract TEXT DEFAULT NULL,
+body TEXT DEFAULT NULL,
+restored_from_id int DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+timezone TEXT DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS content_revisions_item_idx ON content_revisions (item_type, item_id);
+
 CREATE TABLE IF NOT EXISTS faq_revisions (
 id INTEGER PRIMARY KEY AUTOINCREMENT,
 faq_id int NOT NULL,
@@ -238,7 +252,8 @@ last_weekly_digest_sent_at DATETIME DEFAULT NULL,
 monthly_digest_day INT DEFAULT NULL,
 monthly_digest_hour INT DEFAULT NULL,
 last_monthly_digest_sent_at DATETIME DEFAULT NULL,
-image_safe_dimension TEXT DEFAULT NULL
+image_safe_dimension TEXT DEFAULT NULL,
+mute_mentions INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE TABLE searchwordlist (
@@ -694,6 +709,203 @@ expires_at DATETIME DEFAULT NULL,
 UNIQUE (credential_id)
 );
 
+CREATE TABLE user_totp (
+users_idusers INTEGER PRIMARY KEY,
+secret TEXT NOT NULL,
+enabled_at DATETIME DEFAULT NULL,
+last_used_step BIGINT NOT NULL DEFAULT 0,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE user_recovery_codes (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+users_idusers INT NOT NULL,
+code_hash TEXT NOT NULL,
+used_at DATETIME DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS user_recovery_codes_user_idx ON user_recovery_codes (users_idusers);
+
+CREATE TABLE webhooks (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+name TEXT NOT NULL,
+url TEXT NOT NULL,
+secret TEXT NOT NULL,
+patterns TEXT NOT NULL,
+active INTEGER NOT NULL DEFAULT 1,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE webhook_deliveries (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+webhook_id INT NOT NULL,
+event TEXT NOT NULL,
+path TEXT NOT NULL,
+payload TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+attempts INT NOT NULL DEFAULT 0,
+response_status INT DEFAULT NULL,
+error TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at DATETIME DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id);
+
+CREATE TABLE reactions (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+users_idusers INT NOT NULL,
+reaction TEXT NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+UNIQUE (item_type, item_id, users_idusers, reaction)
+);
+CREATE INDEX IF NOT EXISTS reactions_item_idx ON reactions (item_type, item_id);
+
+CREATE TABLE forum_polls (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+forumthread_id INT NOT NULL UNIQUE,
+question TEXT NOT NULL,
+multiple_choice BOOLEAN NOT NULL DEFAULT 0,
+anonymous BOOLEAN NOT NULL DEFAULT 1,
+results_visibility TEXT NOT NULL DEFAULT 'always',
+closes_at DATETIME DEFAULT NULL,
+closed_at DATETIME DEFAULT NULL,
+created_by INT NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS forum_polls_closing_idx ON forum_polls (closed_at, closes_at);
+
+CREATE TABLE forum_poll_options (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+poll_id INT NOT NULL,
+position INT NOT NULL DEFAULT 0,
+label TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS forum_poll_options_poll_idx ON forum_poll_options (poll_id, position);
+
+CREATE TABLE forum_poll_votes (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+poll_id INT NOT NULL,
+option_id INT NOT NULL,
+users_idusers INT NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+UNIQUE (option_id, users_idusers)
+);
+CREATE INDEX IF NOT EXISTS forum_poll_votes_poll_user_idx ON forum_poll_votes (poll_id, users_idusers);
+
+CREATE TABLE content_reports (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+reporter_id INT NOT NULL,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+author_id INT NOT NULL,
+reason TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+resolution_note TEXT,
+resolved_by INT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+resolved_at DATETIME DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS content_reports_status_idx ON content_reports (status, created_at);
+CREATE INDEX IF NOT EXISTS content_reports_item_idx ON content_reports (item_type, item_id);
+CREATE INDEX IF NOT EXISTS content_reports_reporter_idx ON content_reports (reporter_id);
+
+CREATE TABLE scheduled_publications (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+author_id INT NOT NULL,
+publish_at DATETIME NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS scheduled_publications_item_idx ON scheduled_publications (item_type, item_id);
+CREATE INDEX IF NOT EXISTS scheduled_publications_publish_at_idx ON scheduled_publications (publish_at);
+
+CREATE TABLE drafts (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+target TEXT NOT NULL,
+url TEXT NOT NULL,
+content TEXT NOT NULL,
+updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS drafts_user_target_idx ON drafts (user_id, target);
+CREATE INDEX IF NOT EXISTS drafts_updated_at_idx ON drafts (updated_at);
+
+CREATE TABLE activitypub_actor_keys (
+user_id INT PRIMARY KEY,
+public_key_pem TEXT NOT NULL,
+private_key_pem TEXT NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE activitypub_followers (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+actor_url TEXT NOT NULL,
+inbox_url TEXT NOT NULL,
+shared_inbox_url TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS activitypub_followers_user_actor_idx ON activitypub_followers (user_id, actor_url);
+
+CREATE TABLE activitypub_activities (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+activity_type TEXT NOT NULL,
+object_url TEXT NOT NULL,
+object_json TEXT NOT NULL,
+published DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS activitypub_activities_user_idx ON activitypub_activities (user_id, id);
+CREATE INDEX IF NOT EXISTS activitypub_activities_object_idx ON activitypub_activities (object_url);
+
+CREATE TABLE activitypub_deliveries (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+inbox_url TEXT NOT NULL,
+payload TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+attempts INT NOT NULL DEFAULT 0,
+next_attempt_at DATETIME NOT NULL,
+error TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at DATETIME DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS activitypub_deliveries_due_idx ON activitypub_deliveries (status, next_attempt_at);
+
+CREATE TABLE email_bounces (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_email_id INT NOT NULL,
+kind TEXT NOT NULL,
+source TEXT NOT NULL,
+detail TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS email_bounces_user_email_idx ON email_bounces (user_email_id);
+
+CREATE TABLE email_suppressions (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+email TEXT NOT NULL,
+user_id INT DEFAULT NULL,
+reason TEXT NOT NULL,
+detail TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS email_suppressions_email_idx ON email_suppressions (email);
+
 INSERT INTO goose_db_version (version_id, is_applied) VALUES (94, 1);
 INSERT INTO goose_db_version (version_id, is_applied) VALUES (95, 1);
 INSERT INTO goose_db_version (version_id, is_applied) VALUES (96, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (97, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (98, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (99, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (100, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (101, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (102, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (103, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (104, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (105, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (106, 1);
+INSERT INTO goose_db_version (version_id, is_applied) VALUES (107, 1);
@@ -25,6 +25,29 @@ JOIN roles r ON r.id = g.role_id
 WHERE g.action IN ('see', 'view')
   AND r.can_login = 1;
 
+-- Grant react rights to all logged-in roles with view access
+INSERT INTO grants (created_at, role_id, section, item, rule_type, action, active)
+SELECT DISTINCT CURRENT_TIMESTAMP, g.role_id, g.section, g.item, 'allow', 'react', 1
+FROM grants g
+JOIN roles r ON r.id = g.role_id
+WHERE g.action IN ('see', 'view')
+  AND g.section IN ('forum', 'privateforum', 'blogs', 'news', 'imagebbs')
+  AND g.item_id IS NULL
+  AND g.user_id IS NULL
+  AND r.can_login = 1;
+
+-- Allow logged-in roles that can start forum threads to attach polls
+INSERT INTO grants (created_at, role_id, section, item, rule_type, action, active)
+SELECT DISTINCT CURRENT_TIMESTAMP, g.role_id, 'forum', 'poll', 'allow', 'create', 1
+FROM grants g
+JOIN roles r ON r.id = g.role_id
+WHERE g.section = 'forum'
+  AND g.item = 'topic'
+  AND g.action = 'post'
+  AND g.item_id IS NULL
+  AND g.user_id IS NULL
+  AND r.can_login = 1;
+
 INSERT INTO grants (created_at, role_id, section, item, rule_type, action, active)
 SELECT CURRENT_TIMESTAMP, r_admin.id, 'role', NULL, 'allow', 'moderator', 1
 FROM roles r_admin
@@ -0,0 +1,115 @@
+package activitypub
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/activitypub"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+type fakeQueries struct {
+	db.Querier
+	key *db.ActivitypubActorKey
+}
+
+func (f *fakeQueries) SystemGetActivityPubActor(_ context.Context, username sql.NullString) (*db.SystemGetActivityPubActorRow, error) {
+	if username.String != "bob" {
+		return nil, sql.ErrNoRows
+	}
+	return &db.SystemGetActivityPubActorRow{Idusers: 1, Username: username}, nil
+}
+
+func (f *fakeQueries) SystemGetActivityPubActorKey(context.Context, int32) (*db.ActivitypubActorKey, error) {
+	if f.key == nil {
+		return nil, sql.ErrNoRows
+	}
+	return f.key, nil
+}
+
+func (f *fakeQueries) SystemInsertActivityPubActorKey(_ context.Context, arg db.SystemInsertActivityPubActorKeyParams) error {
+	f.key = &db.ActivitypubActorKey{UserID: arg.UserID, PublicKeyPem: arg.PublicKeyPem, PrivateKeyPem: arg.PrivateKeyPem}
+	return nil
+}
+
+func newTestRouter(t *testing.T, enabled bool) http.Handler {
+	t.Helper()
+	cfg := &config.RuntimeConfig{BaseURL: "https://blog.example", ActivityPubEnabled: enabled}
+	q := &fakeQueries{}
+	r := mux.NewRouter()
+	RegisterRoutes(r, cfg)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		cd := common.NewCoreData(req.Context(), q, cfg)
+		r.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd)))
+	})
+}
+
+func TestWebFinger(t *testing.T) {
+	h := newTestRouter(t, true)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/webfinger?resource=acct:bob@blog.example", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status=%d body=%s", rr.Code, rr.Body.String())
+	}
+	var res jrd
+	if err := json.Unmarshal(rr.Body.Bytes(), &res); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if res.Subject != "acct:bob@blog.example" || len(res.Links) == 0 || res.Links[0].Href != "https://blog.example/activitypub/users/bob" || res.Links[0].Type != activitypub.ContentType {
+		t.Fatalf("jrd=%+v", res)
+	}
+
+	for _, resource := range []string{"acct:bob@elsewhere.example", "acct:carol@blog.example", "mailto:bob@blog.example"} {
+		rr = httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/webfinger?resource="+resource, nil))
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("%s status=%d", resource, rr.Code)
+		}
+	}
+}
+
+func TestActorPage(t *testing.T) {
+	h := newTestRouter(t, true)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/activitypub/users/bob", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status=%d body=%s", rr.Code, rr.Body.String())
+	}
+	if ct := rr.Header().Get("Content-Type"); ct != activitypub.ContentType {
+		t.Errorf("content type %q", ct)
+	}
+	var actor activitypub.Actor
+	if err := json.Unmarshal(rr.Body.Bytes(), &actor); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if actor.Inbox != "https://blog.example/activitypub/users/bob/inbox" || actor.PublicKey == nil || actor.PublicKey.ID != "https://blog.example/activitypub/users/bob#main-key" {
+		t.Fatalf("actor=%+v", actor)
+	}
+	if _, err := activitypub.ParsePublicKey(actor.PublicKey.PublicKeyPem); err != nil {
+		t.Fatalf("public key: %v", err)
+	}
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/activitypub/users/carol", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("unknown actor status=%d", rr.Code)
+	}
+}
+
+func TestRoutesDisabled(t *testing.T) {
+	h := newTestRouter(t, false)
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/.well-known/webfinger?resource=acct:bob@blog.example", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("status=%d", rr.Code)
+	}
+}
@@ -0,0 +1,185 @@
+package activitypub
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/activitypub"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// outboxPageSize is the number of activities on each outbox page.
+const outboxPageSize = 20
+
+func writeActivityJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", activitypub.ContentType)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("encode activitypub document: %v", err)
+	}
+}
+
+// lookupActor loads the user named in the route, writing a 404 when there is
+// no such actor.
+func lookupActor(w http.ResponseWriter, r *http.Request, cd *common.CoreData) (*db.SystemGetActivityPubActorRow, bool) {
+	username := mux.Vars(r)["username"]
+	user, err := cd.Queries().SystemGetActivityPubActor(r.Context(), sql.NullString{String: username, Valid: true})
+	if errors.Is(err, sql.ErrNoRows) {
+		http.NotFound(w, r)
+		return nil, false
+	}
+	if err != nil {
+		log.Printf("activitypub actor %s: %v", username, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return nil, false
+	}
+	return user, true
+}
+
+// ActorPage serves a user's actor document.
+func ActorPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	actor, err := activitypub.ForCoreData(cd).Actor(r.Context(), mux.Vars(r)["username"])
+	if errors.Is(err, sql.ErrNoRows) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.Printf("activitypub actor: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	writeActivityJSON(w, actor)
+}
+
+// OutboxPage serves the activities a user has sent, newest first. Without a
+// page parameter it returns the collection pointing at the first page.
+func OutboxPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	user, ok := lookupActor(w, r, cd)
+	if !ok {
+		return
+	}
+	f := activitypub.ForCoreData(cd)
+	name := user.Username.String
+	outboxURL := f.URL(activitypub.OutboxPath(name))
+	total, err := cd.Queries().SystemCountActivityPubActivitiesForUser(r.Context(), user.Idusers)
+	if err != nil {
+		log.Printf("count outbox %s: %v", name, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	pageParam := r.URL.Query().Get("page")
+	if pageParam == "" {
+		writeActivityJSON(w, activitypub.OrderedCollection{
+			Context:    activitypub.Context,
+			ID:         outboxURL,
+			Type:       "OrderedCollection",
+			TotalItems: total,
+			First:      outboxURL + "?page=1",
+		})
+		return
+	}
+	page, err := strconv.Atoi(pageParam)
+	if err != nil || page < 1 {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+	rows, err := cd.Queries().SystemListActivityPubActivitiesForUser(r.Context(), db.SystemListActivityPubActivitiesForUserParams{
+		UserID: user.Idusers,
+		Limit:  outboxPageSize,
+		Offset: int32((page - 1) * outboxPageSize),
+	})
+	if err != nil {
+		log.Printf("list outbox %s: %v", name, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	items := make([]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		b, err := json.Marshal(f.OutboxActivity(name, row))
+		if err != nil {
+			log.Printf("encode outbox activity %d: %v", row.ID, err)
+			continue
+		}
+		items = append(items, b)
+	}
+	res := activitypub.OrderedCollectionPage{
+		Context:      activitypub.Context,
+		ID:           fmt.Sprintf("%s?page=%d", outboxURL, page),
+		Type:         "OrderedCollectionPage",
+		PartOf:       outboxURL,
+		TotalItems:   total,
+		OrderedItems: items,
+	}
+	if int64(page*outboxPageSize) < total {
+		res.Next = fmt.Sprintf("%s?page=%d", outboxURL, page+1)
+	}
+	writeActivityJSON(w, res)
+}
+
+// FollowersPage serves the size of a user's follower collection. The
+// followers themselves are not listed.
+func FollowersPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	user, ok := lookupActor(w, r, cd)
+	if !ok {
+		return
+	}
+	total, err := cd.Queries().SystemCountActivityPubFollowers(r.Context(), user.Idusers)
+	if err != nil {
+		log.Printf("count followers %s: %v", user.Username.String, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	writeActivityJSON(w, activitypub.OrderedCollection{
+		Context:    activitypub.Context,
+		ID:         activitypub.ForCoreData(cd).URL(activitypub.FollowersPath(user.Username.String)),
+		Type:       "OrderedCollection",
+		TotalItems: total,
+	})
+}
+
+// ObjectPage serves the ActivityPub form of a public blog entry or writing.
+// Items followers were told about that have since been removed are gone.
+func ObjectPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	vars := mux.Vars(r)
+	itemType := vars["type"]
+	if itemType != activitypub.ItemTypeBlog && itemType != activitypub.ItemTypeWriting {
+		http.NotFound(w, r)
+		return
+	}
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	f := activitypub.ForCoreData(cd)
+	obj, err := f.Object(r.Context(), itemType, int32(id))
+	if errors.Is(err, sql.ErrNoRows) {
+		if last, lerr := cd.Queries().SystemGetLatestActivityPubActivityForObject(r.Context(), f.URL(activitypub.ObjectPath(itemType, int32(id)))); lerr == nil && last.ActivityType == activitypub.TypeDelete {
+			http.Error(w, "Gone", http.StatusGone)
+			return
+		}
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		log.Printf("activitypub object %s %d: %v", itemType, id, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	writeActivityJSON(w, struct {
+		Context string `json:"@context"`
+		*activitypub.Object
+	}{activitypub.Context, obj})
+}
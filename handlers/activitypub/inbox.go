@@ -0,0 +1,48 @@
+package activitypub
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/activitypub"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+// InboxPage receives activities from remote servers. Replies such as the
+// Accept for a Follow are queued and sent by the background task worker.
+func InboxPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	username := mux.Vars(r)["username"]
+	queued, err := activitypub.ForCoreData(cd).HandleInbox(r.Context(), r, username)
+	switch {
+	case err == nil:
+	case errors.Is(err, sql.ErrNoRows):
+		http.NotFound(w, r)
+		return
+	case errors.Is(err, activitypub.ErrInvalidSignature):
+		log.Printf("activitypub inbox %s: %v", username, err)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	case errors.Is(err, activitypub.ErrInvalidActivity):
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	default:
+		log.Printf("activitypub inbox %s: %v", username, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	if queued {
+		if err := cd.Publish(eventbus.TaskEvent{Path: r.URL.Path, Task: activitypub.NewDeliverTask(), Time: time.Now()}); err != nil {
+			// The scheduler sends it on its next run.
+			log.Printf("activitypub inbox %s: publish delivery: %v", username, err)
+		}
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
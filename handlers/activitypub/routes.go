@@ -0,0 +1,31 @@
+package activitypub
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	nav "github.com/arran4/goa4web/internal/navigation"
+	"github.com/arran4/goa4web/internal/router"
+)
+
+// RegisterRoutes attaches WebFinger discovery and the actor endpoints to r.
+func RegisterRoutes(r *mux.Router, cfg *config.RuntimeConfig) []nav.RouterOptions {
+	if cfg == nil || !cfg.ActivityPubEnabled {
+		return nil
+	}
+	r.HandleFunc("/.well-known/webfinger", WebFinger).Methods("GET")
+	ar := r.PathPrefix("/activitypub").Subrouter()
+	ar.HandleFunc("/users/{username}", ActorPage).Methods("GET")
+	ar.HandleFunc("/users/{username}/outbox", OutboxPage).Methods("GET")
+	ar.HandleFunc("/users/{username}/followers", FollowersPage).Methods("GET")
+	ar.HandleFunc("/users/{username}/inbox", InboxPage).Methods("POST")
+	ar.HandleFunc("/objects/{type}/{id:[0-9]+}", ObjectPage).Methods("GET")
+	return nil
+}
+
+// Register registers the ActivityPub router module.
+func Register(reg *router.Registry) {
+	reg.RegisterModule("activitypub", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []nav.RouterOptions {
+		return RegisterRoutes(r, cfg)
+	})
+}
@@ -0,0 +1,91 @@
+package activitypub
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/activitypub"
+)
+
+// jrd is a WebFinger JSON Resource Descriptor.
+type jrd struct {
+	Subject string    `json:"subject"`
+	Aliases []string  `json:"aliases,omitempty"`
+	Links   []jrdLink `json:"links"`
+}
+
+type jrdLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href"`
+}
+
+// WebFinger resolves acct:username@host, as typed into fediverse search
+// boxes, to the user's actor.
+func WebFinger(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	f := activitypub.ForCoreData(cd)
+	username, ok := webFingerUsername(r.URL.Query().Get("resource"), f)
+	if !ok {
+		http.Error(w, "Unknown resource", http.StatusNotFound)
+		return
+	}
+	user, err := cd.Queries().SystemGetActivityPubActor(r.Context(), sql.NullString{String: username, Valid: true})
+	if errors.Is(err, sql.ErrNoRows) {
+		http.Error(w, "Unknown resource", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("webfinger %s: %v", username, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	name := user.Username.String
+	actorURL := f.ActorURL(name)
+	profileURL := f.URL(activitypub.ProfilePath(name))
+	w.Header().Set("Content-Type", activitypub.JRDContentType)
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if err := json.NewEncoder(w).Encode(jrd{
+		Subject: "acct:" + name + "@" + siteHost(f),
+		Aliases: []string{actorURL, profileURL},
+		Links: []jrdLink{
+			{Rel: "self", Type: activitypub.ContentType, Href: actorURL},
+			{Rel: "http://webfinger.net/rel/profile-page", Type: "text/html", Href: profileURL},
+		},
+	}); err != nil {
+		log.Printf("encode webfinger: %v", err)
+	}
+}
+
+// webFingerUsername extracts the local username from an acct: URI on this
+// site's host or from the URL of an actor.
+func webFingerUsername(resource string, f *activitypub.Federator) (string, bool) {
+	if acct, ok := strings.CutPrefix(resource, "acct:"); ok {
+		at := strings.LastIndex(acct, "@")
+		if at <= 0 || !strings.EqualFold(acct[at+1:], siteHost(f)) {
+			return "", false
+		}
+		return acct[:at], true
+	}
+	if rest, ok := strings.CutPrefix(resource, f.URL("/activitypub/users/")); ok && rest != "" && !strings.Contains(rest, "/") {
+		name, err := url.PathUnescape(rest)
+		return name, err == nil
+	}
+	return "", false
+}
+
+// siteHost is the host users are addressed at.
+func siteHost(f *activitypub.Federator) string {
+	u, err := url.Parse(f.URL("/"))
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/emailbounce"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// recentEmailBounces is how many bounce events the suppression page shows.
+const recentEmailBounces = 50
+
+// AdminEmailSuppressionsPage lists suppressed addresses alongside the most
+// recent bounce events and the URLs to give providers for event webhooks.
+func AdminEmailSuppressionsPage(w http.ResponseWriter, r *http.Request) {
+	type WebhookURL struct {
+		Provider string
+		URL      string
+	}
+	type Data struct {
+		Suppressions []*db.AdminListEmailSuppressionsRow
+		Bounces      []*db.AdminListRecentEmailBouncesRow
+		WebhookURLs  []WebhookURL
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Suppressed Emails"
+	queries := cd.Queries()
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	pageSize := cd.PageSize()
+
+	rows, err := queries.AdminListEmailSuppressions(r.Context(), db.AdminListEmailSuppressionsParams{
+		Limit:  int32(pageSize + 1),
+		Offset: int32(offset),
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("list email suppressions: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		cd.NextLink = "/admin/email/suppressions?offset=" + strconv.Itoa(offset+pageSize)
+	}
+	if offset > 0 {
+		cd.PrevLink = "/admin/email/suppressions?offset=" + strconv.Itoa(max(offset-pageSize, 0))
+	}
+	bounces, err := queries.AdminListRecentEmailBounces(r.Context(), recentEmailBounces)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("list email bounces: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+
+	data := Data{Suppressions: rows, Bounces: bounces}
+	if cd.LinkSignKey != "" {
+		for _, p := range []string{emailbounce.SourceSES, emailbounce.SourceSendGrid} {
+			data.WebhookURLs = append(data.WebhookURLs, WebhookURL{
+				Provider: p,
+				URL:      cd.AbsoluteURL("/email/events/" + p + "/" + emailbounce.WebhookToken(cd.LinkSignKey, p)),
+			})
+		}
+	}
+	_ = AdminEmailSuppressionsPageTmpl.Handle(w, r, data)
+}
+
+const AdminEmailSuppressionsPageTmpl tasks.Template = "domains/admin/emailSuppressionsPage.gohtml"
@@ -0,0 +1,238 @@
+package admin
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/activitypub"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// reportArchiveLimit caps the number of resolved reports shown.
+const reportArchiveLimit = 200
+
+// PendingReport is a queued content report with a link to the item.
+type PendingReport struct {
+	*db.AdminListPendingContentReportsRow
+	ItemLabel string
+	ItemURL   string
+}
+
+// AdminReportQueuePage lists content reports awaiting a moderator.
+func AdminReportQueuePage(w http.ResponseWriter, r *http.Request) {
+	type Data struct {
+		Reports []*PendingReport
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Content Reports"
+	rows, err := cd.Queries().AdminListPendingContentReports(r.Context())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("list content reports: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	var data Data
+	for _, row := range rows {
+		p := &PendingReport{AdminListPendingContentReportsRow: row, ItemLabel: common.ReportItemLabel(row.ItemType)}
+		if t, err := cd.LoadReportTarget(row.ItemType, row.ItemID); err == nil {
+			p.ItemURL = t.URL
+		}
+		data.Reports = append(data.Reports, p)
+	}
+	_ = AdminReportQueuePageTmpl.Handle(w, r, data)
+}
+
+const AdminReportQueuePageTmpl tasks.Template = "domains/admin/reportQueuePage.gohtml"
+
+// AdminReportArchivePage lists the most recently resolved content reports.
+func AdminReportArchivePage(w http.ResponseWriter, r *http.Request) {
+	type Data struct {
+		Reports []*db.AdminListResolvedContentReportsRow
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Report Archive"
+	rows, err := cd.Queries().AdminListResolvedContentReports(r.Context(), reportArchiveLimit)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("list resolved content reports: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	_ = AdminReportArchivePageTmpl.Handle(w, r, Data{Reports: rows})
+}
+
+const AdminReportArchivePageTmpl tasks.Template = "domains/admin/reportArchivePage.gohtml"
+
+// reportQueueTask holds the behaviour shared by the report queue actions:
+// resolving every pending report on the item and telling the reporters.
+type reportQueueTask struct{ tasks.TaskString }
+
+// resolveReport applies status to the item named by the report in the URL
+// and closes every pending report about it.
+func resolveReport(r *http.Request, status string) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if cd == nil || !cd.HasAdminRole() {
+		return handlers.ErrForbidden
+	}
+	id, err := strconv.Atoi(mux.Vars(r)["report"])
+	if err != nil {
+		return fmt.Errorf("report id parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	queries := cd.Queries()
+	report, err := queries.AdminGetContentReportByID(r.Context(), int32(id))
+	if err != nil {
+		return fmt.Errorf("get report fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if report.Status != common.ReportStatusPending {
+		return fmt.Errorf("report already resolved %w", handlers.ErrRedirectOnSamePageHandler(fmt.Errorf("report %d is %s", report.ID, report.Status)))
+	}
+	note := strings.TrimSpace(r.PostFormValue("note"))
+	reporters, err := queries.AdminListPendingContentReportReporters(r.Context(), db.AdminListPendingContentReportReportersParams{
+		ItemType: report.ItemType,
+		ItemID:   report.ItemID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("list reporters fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	label := common.ReportItemLabel(report.ItemType)
+	comment := fmt.Sprintf("%s %s %d: %s", status, label, report.ItemID, note)
+	switch status {
+	case common.ReportStatusDeactivated:
+		if err := cd.DeactivateContent(report.ItemType, report.ItemID); err != nil {
+			return fmt.Errorf("deactivate content fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+	case common.ReportStatusWarned:
+		if err := queries.InsertAdminUserComment(r.Context(), db.InsertAdminUserCommentParams{UsersIdusers: report.AuthorID, Comment: comment}); err != nil {
+			return fmt.Errorf("insert user comment fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+		msg := fmt.Sprintf("A moderator has warned you about your %s.", label)
+		if note != "" {
+			msg += " " + note
+		}
+		if err := queries.SystemCreateNotification(r.Context(), db.SystemCreateNotificationParams{
+			RecipientID: report.AuthorID,
+			Message:     sql.NullString{String: msg, Valid: true},
+		}); err != nil {
+			log.Printf("warn author notification: %v", err)
+		}
+	case common.ReportStatusBanned:
+		if report.AuthorID == cd.UserID {
+			return fmt.Errorf("ban fail %w", handlers.ErrRedirectOnSamePageHandler(errors.New("you cannot ban yourself")))
+		}
+		// A ban that only removed the login roles would leave the author
+		// with no role at all, so both changes commit together.
+		if err := db.InTx(r.Context(), queries, func(q db.Querier) error {
+			if err := q.AdminRemoveLoginRolesFromUser(r.Context(), report.AuthorID); err != nil {
+				return fmt.Errorf("remove login roles: %w", err)
+			}
+			if err := q.SystemCreateUserRole(r.Context(), db.SystemCreateUserRoleParams{UsersIdusers: report.AuthorID, Name: "rejected"}); err != nil {
+				return fmt.Errorf("add rejected role: %w", err)
+			}
+			if err := q.InsertAdminUserComment(r.Context(), db.InsertAdminUserCommentParams{UsersIdusers: report.AuthorID, Comment: comment}); err != nil {
+				return fmt.Errorf("insert user comment: %w", err)
+			}
+			return nil
+		}); err != nil {
+			return fmt.Errorf("ban fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+	}
+	if _, err := queries.AdminResolveContentReportsForItem(r.Context(), db.AdminResolveContentReportsForItemParams{
+		Status:         status,
+		ResolutionNote: sql.NullString{String: note, Valid: note != ""},
+		ResolvedBy:     sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+		ItemType:       report.ItemType,
+		ItemID:         report.ItemID,
+	}); err != nil {
+		return fmt.Errorf("resolve reports fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data["ReportID"] = report.ID
+		evt.Data["ItemType"] = report.ItemType
+		evt.Data["ItemID"] = report.ItemID
+		evt.Data["ItemLabel"] = label
+		evt.Data["Status"] = status
+		evt.Data["Note"] = note
+		evt.Data["ReporterIDs"] = reporters
+		evt.Data["AuthorID"] = report.AuthorID
+		if u, err := queries.SystemGetUserByID(r.Context(), report.AuthorID); err == nil && u.Email.Valid {
+			evt.Data["AuthorEmail"] = u.Email.String
+		}
+		if u, _ := cd.CurrentUser(); u != nil && u.Username.Valid {
+			evt.Data["Username"] = u.Username.String
+		}
+		// Followers elsewhere are told to remove deactivated entries.
+		if status == common.ReportStatusDeactivated {
+			switch report.ItemType {
+			case common.ReportTypeBlog:
+				evt.Data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeBlog, ItemID: report.ItemID}
+			case common.ReportTypeWriting:
+				evt.Data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeWriting, ItemID: report.ItemID}
+			}
+		}
+	}
+	return handlers.RefreshDirectHandler{TargetURL: "/admin/reports"}
+}
+
+// reportAuditSummary describes a report queue action for the audit log.
+func reportAuditSummary(data map[string]any) string {
+	id, _ := data["ReportID"].(int32)
+	typ, _ := data["ItemType"].(string)
+	item, _ := data["ItemID"].(int32)
+	status, _ := data["Status"].(string)
+	return fmt.Sprintf("report %d on %s %d %s", id, typ, item, status)
+}
+
+// AuditRecord summarises a report queue action.
+func (reportQueueTask) AuditRecord(data map[string]any) string {
+	return reportAuditSummary(data)
+}
+
+// TargetUserIDs returns the users whose reports were resolved.
+func (reportQueueTask) TargetUserIDs(evt eventbus.TaskEvent) ([]int32, error) {
+	ids, _ := evt.Data["ReporterIDs"].([]int32)
+	return ids, nil
+}
+
+func (reportQueueTask) TargetEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return EmailTemplateContentReportResolved.EmailTemplates(), true
+}
+
+func (reportQueueTask) TargetInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	v := EmailTemplateContentReportResolved.NotificationTemplate()
+	return &v
+}
+
+func (reportQueueTask) RequiredTemplates() []tasks.Template {
+	return EmailTemplateContentReportResolved.RequiredTemplates()
+}
+
+// authorNoticeTask emails the author of reported content about the outcome.
+type authorNoticeTask struct{ reportQueueTask }
+
+func (authorNoticeTask) DirectEmailAddress(evt eventbus.TaskEvent) (string, error) {
+	email, _ := evt.Data["AuthorEmail"].(string)
+	return email, nil
+}
+
+func (authorNoticeTask) DirectEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return EmailTemplateContentModeration.EmailTemplates(), true
+}
+
+func (t authorNoticeTask) RequiredTemplates() []tasks.Template {
+	return append(t.reportQueueTask.RequiredTemplates(), EmailTemplateContentModeration.RequiredTemplates()...)
+}
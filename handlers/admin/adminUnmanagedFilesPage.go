@@ -13,15 +13,21 @@ import (
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/upload"
 )
 
 func AdminUnmanagedFilesPage(w http.ResponseWriter, r *http.Request) {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	cd.PageTitle = "Unmanaged Files"
 	type Data struct {
-		Path    string
-		Parent  string
-		Entries []ImageFileEntry
+		Path        string
+		Parent      string
+		Entries     []ImageFileEntry
+		TaskPurge   string
+		OrphanAge   string
+		Orphans     int
+		OrphanBytes int64
+		CanPurge    bool
 	}
 
 	ttlStr := r.URL.Query().Get("ttl")
@@ -57,9 +63,23 @@ func AdminUnmanagedFilesPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data := Data{
-		Path:    listing.Path,
-		Parent:  listing.Parent,
-		Entries: unmanaged,
+		Path:      listing.Path,
+		Parent:    listing.Parent,
+		Entries:   unmanaged,
+		TaskPurge: string(TaskUploadOrphanPurge),
+		OrphanAge: defaultOrphanAge.String(),
+	}
+	if p := upload.ProviderFromConfig(cd.Config); p != nil {
+		orphans, err := OrphanedUploads(r.Context(), cd.Queries(), p, orphanPrefix(listing.Path), defaultOrphanAge)
+		if err != nil {
+			log.Printf("orphaned uploads: %v", err)
+		} else {
+			data.CanPurge = true
+			data.Orphans = len(orphans)
+			for _, o := range orphans {
+				data.OrphanBytes += o.Size
+			}
+		}
 	}
 
 	_ = AdminUnmanagedFilesPageTmpl.Handle(w, r, data)
@@ -22,16 +22,25 @@ import (
 // UserForcePasswordChangeTask resets a user's password and notifies them.
 type UserForcePasswordChangeTask struct{ tasks.TaskString }
 
+// Confidential reports true: the event carries the generated password.
+func (UserForcePasswordChangeTask) Confidential() bool { return true }
+
 var userForcePasswordChangeTask = &UserForcePasswordChangeTask{TaskString: TaskUserForcePasswordChange}
 
 // UserSendResetEmailTask sends a password reset email to the user.
 type UserSendResetEmailTask struct{ tasks.TaskString }
 
+// Confidential reports true: the event carries the signed reset link.
+func (UserSendResetEmailTask) Confidential() bool { return true }
+
 var userSendResetEmailTask = &UserSendResetEmailTask{TaskString: TaskUserSendResetEmail}
 
 // UserGenerateResetLinkTask generates a password reset link for the user.
 type UserGenerateResetLinkTask struct{ tasks.TaskString }
 
+// Confidential reports true: the event carries the signed reset link.
+func (UserGenerateResetLinkTask) Confidential() bool { return true }
+
 var userGenerateResetLinkTask = &UserGenerateResetLinkTask{TaskString: TaskUserGenerateResetLink}
 
 const (
@@ -0,0 +1,157 @@
+package admin
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// AdminWebhooksPage lists configured webhooks and offers a form to add one.
+func AdminWebhooksPage(w http.ResponseWriter, r *http.Request) {
+	type Data struct {
+		Webhooks []*db.Webhook
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Webhooks"
+	rows, err := cd.Queries().AdminListWebhooks(r.Context())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("list webhooks: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	_ = AdminWebhooksPageTmpl.Handle(w, r, Data{Webhooks: rows})
+}
+
+const AdminWebhooksPageTmpl tasks.Template = "domains/admin/webhooksPage.gohtml"
+
+// AdminWebhookPage shows a single webhook for editing.
+func AdminWebhookPage(w http.ResponseWriter, r *http.Request) {
+	type Data struct {
+		Webhook *db.Webhook
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	id, err := strconv.Atoi(mux.Vars(r)["webhook"])
+	if err != nil {
+		handlers.RenderErrorPage(w, r, handlers.ErrBadRequest)
+		return
+	}
+	hook, err := cd.Queries().AdminGetWebhook(r.Context(), int32(id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			handlers.RenderErrorPage(w, r, handlers.ErrNotFound)
+			return
+		}
+		log.Printf("get webhook: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	cd.PageTitle = fmt.Sprintf("Webhook %s", hook.Name)
+	_ = AdminWebhookPageTmpl.Handle(w, r, Data{Webhook: hook})
+}
+
+const AdminWebhookPageTmpl tasks.Template = "domains/admin/webhookPage.gohtml"
+
+// AdminWebhookDeliveriesPage shows the delivery log, optionally filtered to a
+// single webhook.
+func AdminWebhookDeliveriesPage(w http.ResponseWriter, r *http.Request) {
+	type Data struct {
+		Deliveries []*db.AdminListWebhookDeliveriesRow
+		Webhooks   []*db.Webhook
+		WebhookID  int32
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Webhook Deliveries"
+	queries := cd.Queries()
+	query := r.URL.Query()
+	webhookID, _ := strconv.Atoi(query.Get("webhook"))
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	pageSize := cd.PageSize()
+
+	rows, err := queries.AdminListWebhookDeliveries(r.Context(), db.AdminListWebhookDeliveriesParams{
+		WebhookID: int32(webhookID),
+		Limit:     int32(pageSize + 1),
+		Offset:    int32(offset),
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("list webhook deliveries: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	hooks, err := queries.AdminListWebhooks(r.Context())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("list webhooks: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+
+	params := url.Values{}
+	if webhookID != 0 {
+		params.Set("webhook", strconv.Itoa(webhookID))
+	}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		next := copyValues(params)
+		next.Set("offset", strconv.Itoa(offset+pageSize))
+		cd.NextLink = "/admin/webhooks/deliveries?" + next.Encode()
+	}
+	if offset > 0 {
+		prev := copyValues(params)
+		prev.Set("offset", strconv.Itoa(max(offset-pageSize, 0)))
+		cd.PrevLink = "/admin/webhooks/deliveries?" + prev.Encode()
+	}
+
+	_ = AdminWebhookDeliveriesPageTmpl.Handle(w, r, Data{
+		Deliveries: rows,
+		Webhooks:   hooks,
+		WebhookID:  int32(webhookID),
+	})
+}
+
+const AdminWebhookDeliveriesPageTmpl tasks.Template = "domains/admin/webhookDeliveriesPage.gohtml"
+
+// AdminWebhookDeliveryPage shows the payload and result of a single delivery.
+func AdminWebhookDeliveryPage(w http.ResponseWriter, r *http.Request) {
+	type Data struct {
+		Delivery *db.WebhookDelivery
+		Webhook  *db.Webhook
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	id, err := strconv.Atoi(mux.Vars(r)["delivery"])
+	if err != nil {
+		handlers.RenderErrorPage(w, r, handlers.ErrBadRequest)
+		return
+	}
+	queries := cd.Queries()
+	delivery, err := queries.SystemGetWebhookDelivery(r.Context(), int32(id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			handlers.RenderErrorPage(w, r, handlers.ErrNotFound)
+			return
+		}
+		log.Printf("get webhook delivery: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	data := Data{Delivery: delivery}
+	if hook, err := queries.AdminGetWebhook(r.Context(), delivery.WebhookID); err == nil {
+		data.Webhook = hook
+	} else if !errors.Is(err, sql.ErrNoRows) {
+		log.Printf("get webhook: %v", err)
+	}
+	cd.PageTitle = fmt.Sprintf("Webhook Delivery %d", delivery.ID)
+	_ = AdminWebhookDeliveryPageTmpl.Handle(w, r, data)
+}
+
+const AdminWebhookDeliveryPageTmpl tasks.Template = "domains/admin/webhookDeliveryPage.gohtml"
@@ -46,6 +46,9 @@ type AdminAddEmailTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: the event carries the verification token.
+func (AdminAddEmailTask) Confidential() bool { return true }
+
 var adminAddEmailTask = &AdminAddEmailTask{TaskString: TaskAddEmail}
 
 var _ tasks.Task = (*AdminAddEmailTask)(nil)
@@ -189,6 +192,9 @@ type AdminVerifyEmailTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: email verification events stay on the server.
+func (AdminVerifyEmailTask) Confidential() bool { return true }
+
 var adminVerifyEmailTask = &AdminVerifyEmailTask{TaskString: TaskVerifyEmail}
 
 var _ tasks.Task = (*AdminVerifyEmailTask)(nil)
@@ -234,6 +240,9 @@ type AdminUnverifyEmailTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: email verification events stay on the server.
+func (AdminUnverifyEmailTask) Confidential() bool { return true }
+
 var adminUnverifyEmailTask = &AdminUnverifyEmailTask{TaskString: TaskUnverifyEmail}
 
 var _ tasks.Task = (*AdminUnverifyEmailTask)(nil)
@@ -279,6 +288,9 @@ type AdminResendVerificationEmailTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: the event carries the verification token.
+func (AdminResendVerificationEmailTask) Confidential() bool { return true }
+
 var adminResendVerificationEmailTask = &AdminResendVerificationEmailTask{TaskString: TaskResendVerification}
 
 var _ tasks.Task = (*AdminResendVerificationEmailTask)(nil)
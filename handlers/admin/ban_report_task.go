@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// BanReportTask stops the author of reported content from logging in.
+type BanReportTask struct{ authorNoticeTask }
+
+var banReportTask = &BanReportTask{authorNoticeTask{reportQueueTask{TaskString: TaskReportBan}}}
+
+var _ tasks.Task = (*BanReportTask)(nil)
+var _ tasks.AuditableTask = (*BanReportTask)(nil)
+var _ tasks.TemplatesRequired = (*BanReportTask)(nil)
+var _ notif.TargetUsersNotificationProvider = (*BanReportTask)(nil)
+var _ notif.DirectEmailNotificationTemplateProvider = (*BanReportTask)(nil)
+
+func (BanReportTask) Action(w http.ResponseWriter, r *http.Request) any {
+	return resolveReport(r, common.ReportStatusBanned)
+}
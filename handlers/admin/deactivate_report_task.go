@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// DeactivateReportTask copies reported content into its deactivated_* table and hides it.
+type DeactivateReportTask struct{ reportQueueTask }
+
+var deactivateReportTask = &DeactivateReportTask{reportQueueTask{TaskString: TaskReportDeactivate}}
+
+var _ tasks.Task = (*DeactivateReportTask)(nil)
+var _ tasks.AuditableTask = (*DeactivateReportTask)(nil)
+var _ tasks.TemplatesRequired = (*DeactivateReportTask)(nil)
+var _ notif.TargetUsersNotificationProvider = (*DeactivateReportTask)(nil)
+
+func (DeactivateReportTask) Action(w http.ResponseWriter, r *http.Request) any {
+	return resolveReport(r, common.ReportStatusDeactivated)
+}
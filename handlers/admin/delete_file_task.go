@@ -1,16 +1,17 @@
 package admin
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
+	"path"
 	"strings"
 
 	"github.com/arran4/goa4web/core/common"
 	"github.com/arran4/goa4web/core/consts"
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/upload"
 	"github.com/gorilla/mux"
 )
 
@@ -18,7 +19,8 @@ type DeleteUnmanagedFileTask struct{}
 
 var _ tasks.Task = (*DeleteUnmanagedFileTask)(nil)
 
-// Action deletes the specified file from the image upload directory.
+// Action deletes the specified file from the upload provider. Files still
+// referenced by an uploaded image or image post are refused.
 func (t *DeleteUnmanagedFileTask) Action(w http.ResponseWriter, r *http.Request) any {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	reqPath := r.FormValue("path")
@@ -26,27 +28,36 @@ func (t *DeleteUnmanagedFileTask) Action(w http.ResponseWriter, r *http.Request)
 		return fmt.Errorf("path is required")
 	}
 
-	base := cd.Config.ImageUploadDir
-	cleaned := filepath.Clean("/" + reqPath)
-	abs := filepath.Join(base, cleaned)
-	if rel, err := filepath.Rel(base, abs); err != nil || rel == ".." || strings.HasPrefix(rel, "..") {
+	cleaned := path.Clean("/" + reqPath)
+	name := strings.TrimPrefix(cleaned, "/")
+	if name == "" {
 		return fmt.Errorf("invalid path")
 	}
 
-	info, err := os.Stat(abs)
+	p := upload.ProviderFromConfig(cd.Config)
+	if p == nil {
+		return fmt.Errorf("upload provider unavailable")
+	}
+	refs, err := ReferencedUploads(r.Context(), cd.Queries())
 	if err != nil {
-		return fmt.Errorf("file not found: %w", err)
+		return err
 	}
-	if info.IsDir() {
-		return fmt.Errorf("cannot delete directory")
+	if UploadReferenced(refs, name) {
+		return fmt.Errorf("file is still referenced")
 	}
 
-	if err := os.Remove(abs); err != nil {
+	if _, err := p.Stat(r.Context(), name); err != nil {
+		if errors.Is(err, upload.ErrNotExist) {
+			return fmt.Errorf("file not found: %w", err)
+		}
+		return fmt.Errorf("stat: %w", err)
+	}
+	if err := p.Delete(r.Context(), name); err != nil {
 		return fmt.Errorf("remove: %w", err)
 	}
 
 	// Redirect back to listing, parent dir of deleted file
-	parent := filepath.Dir(cleaned)
+	parent := path.Dir(cleaned)
 	return handlers.RedirectHandler("/admin/files/unmanaged?path=" + parent)
 }
 
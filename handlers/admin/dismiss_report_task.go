@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// DismissReportTask closes reports without acting on the content.
+type DismissReportTask struct{ reportQueueTask }
+
+var dismissReportTask = &DismissReportTask{reportQueueTask{TaskString: TaskReportDismiss}}
+
+var _ tasks.Task = (*DismissReportTask)(nil)
+var _ tasks.AuditableTask = (*DismissReportTask)(nil)
+var _ tasks.TemplatesRequired = (*DismissReportTask)(nil)
+var _ notif.TargetUsersNotificationProvider = (*DismissReportTask)(nil)
+
+func (DismissReportTask) Action(w http.ResponseWriter, r *http.Request) any {
+	return resolveReport(r, common.ReportStatusDismissed)
+}
@@ -0,0 +1,56 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// ReleaseEmailSuppressionTask lets mail flow to a suppressed address again.
+type ReleaseEmailSuppressionTask struct{ tasks.TaskString }
+
+var releaseEmailSuppressionTask = &ReleaseEmailSuppressionTask{TaskString: TaskReleaseEmailSuppression}
+
+var _ tasks.Task = (*ReleaseEmailSuppressionTask)(nil)
+var _ tasks.AuditableTask = (*ReleaseEmailSuppressionTask)(nil)
+
+func (ReleaseEmailSuppressionTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if cd == nil || !cd.HasAdminRole() {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			handlers.RenderErrorPage(w, r, handlers.ErrForbidden)
+		})
+	}
+	id, err := strconv.Atoi(r.PostFormValue("id"))
+	if err != nil {
+		return fmt.Errorf("suppression id parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	queries := cd.Queries()
+	s, err := queries.AdminGetEmailSuppressionByID(r.Context(), int32(id))
+	if err != nil {
+		return fmt.Errorf("get email suppression %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if err := queries.AdminDeleteEmailSuppression(r.Context(), s.ID); err != nil {
+		return fmt.Errorf("delete email suppression %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data["Email"] = s.Email
+	}
+	return handlers.RefreshDirectHandler{TargetURL: "/admin/email/suppressions"}
+}
+
+// AuditRecord summarises a suppressed address being released.
+func (ReleaseEmailSuppressionTask) AuditRecord(data map[string]any) string {
+	if email, ok := data["Email"].(string); ok {
+		return fmt.Sprintf("released email suppression for %s", email)
+	}
+	return "released email suppression"
+}
@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/emailbounce"
+	"github.com/arran4/goa4web/internal/testhelpers"
+)
+
+func TestAdminEmailSuppressionsPage(t *testing.T) {
+	queries := testhelpers.NewQuerierStub()
+	queries.AdminListEmailSuppressionsReturns = []*db.AdminListEmailSuppressionsRow{{
+		ID:        3,
+		Email:     "gone@example.org",
+		UserID:    sql.NullInt32{Int32: 7, Valid: true},
+		Username:  sql.NullString{String: "gone", Valid: true},
+		Reason:    "hard_bounce",
+		CreatedAt: time.Now(),
+	}}
+	queries.AdminListRecentEmailBouncesReturns = []*db.AdminListRecentEmailBouncesRow{{
+		ID:        1,
+		Email:     "gone@example.org",
+		UserID:    7,
+		Kind:      "hard_bounce",
+		Source:    "ses",
+		CreatedAt: time.Now(),
+	}}
+	cfg := config.NewRuntimeConfig()
+	cfg.BaseURL = "https://example.com"
+	cd := common.NewCoreData(context.Background(), queries, cfg, common.WithLinkSignKey("k"))
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/email/suppressions", nil)
+	req = req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+	w := httptest.NewRecorder()
+	AdminEmailSuppressionsPage(w, req)
+
+	body := w.Body.String()
+	for _, want := range []string{
+		"gone@example.org",
+		`value="Release suppression"`,
+		"https://example.com/email/events/sendgrid/" + emailbounce.WebhookToken("k", "sendgrid"),
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("page missing %q", want)
+		}
+	}
+}
+
+func TestReleaseEmailSuppressionTask(t *testing.T) {
+	queries := testhelpers.NewQuerierStub()
+	queries.AdminGetEmailSuppressionByIDReturns = &db.EmailSuppression{ID: 3, Email: "gone@example.org"}
+	req := httptest.NewRequest(http.MethodPost, "/admin/email/suppressions", strings.NewReader(url.Values{"id": {"3"}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	cd := common.NewCoreData(req.Context(), queries, config.NewRuntimeConfig(),
+		common.WithPermissions([]*db.GetPermissionsByUserIDRow{{Name: "administrator", IsAdmin: true}}))
+	req = req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+
+	res := releaseEmailSuppressionTask.Action(httptest.NewRecorder(), req)
+	if rdh, ok := res.(handlers.RefreshDirectHandler); !ok || rdh.TargetURL != "/admin/email/suppressions" {
+		t.Fatalf("result=%#v", res)
+	}
+	if len(queries.AdminDeleteEmailSuppressionCalls) != 1 || queries.AdminDeleteEmailSuppressionCalls[0] != 3 {
+		t.Fatalf("delete calls %v", queries.AdminDeleteEmailSuppressionCalls)
+	}
+}
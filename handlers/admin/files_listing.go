@@ -83,7 +83,7 @@ func BuildImageFilesListing(ctx context.Context, queries db.Querier, uploadDir s
 			ModTime: modTime,
 		}
 		if !entry.IsDir() {
-			dbPath := path.Join("/imagebbs/images", ent.Path)
+			dbPath := path.Join(imageBBSUploadPrefix, ent.Path)
 			row, err := queries.GetImagePostInfoByPath(ctx, db.GetImagePostInfoByPathParams{
 				Fullimage: sql.NullString{Valid: true, String: dbPath},
 				Thumbnail: sql.NullString{Valid: true, String: dbPath},
@@ -96,6 +96,15 @@ func BuildImageFilesListing(ctx context.Context, queries db.Querier, uploadDir s
 					ent.Posted = row.Posted.Time
 				}
 			}
+			if !ent.IsManaged {
+				for _, candidate := range []string{ent.Path, dbPath} {
+					row, err := queries.GetUploadedImageByPath(ctx, sql.NullString{Valid: true, String: candidate})
+					if err == nil && row != nil {
+						ent.IsManaged = true
+						break
+					}
+				}
+			}
 			if signKey != "" && sign != nil {
 				id := filepath.Base(ent.Path)
 				ent.URL = sign(id, ttl)
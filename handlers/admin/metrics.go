@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/metrics"
+)
+
+// MetricsScope is the API key scope that allows /metrics to be scraped.
+const MetricsScope = "metrics:read"
+
+// Metrics serves server metrics in the Prometheus text exposition format.
+// Only administrators may read them; API keys also need MetricsScope.
+func (h *Handlers) Metrics(w http.ResponseWriter, r *http.Request) {
+	if scopes, ok := r.Context().Value(consts.KeyAPIScopes).(map[string]bool); ok && !scopes[MetricsScope] {
+		handlers.RenderErrorPage(w, r, handlers.ErrUnauthorized)
+		return
+	}
+	if !common.Allowed(r, "administrator") {
+		handlers.RenderErrorPage(w, r, handlers.ErrForbidden)
+		return
+	}
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := metrics.Default.Write(r.Context(), w, metrics.DBStatsCollector(h.DBPool), emailQueueCollector(cd)); err != nil {
+		log.Printf("write metrics: %v", err)
+	}
+}
+
+// emailQueueCollector reports the number of unsent queued emails.
+func emailQueueCollector(cd *common.CoreData) metrics.Collector {
+	return func(ctx context.Context, e *metrics.Emitter) {
+		q := cd.Queries()
+		if q == nil {
+			return
+		}
+		row, err := q.SystemCountPendingEmailQueue(ctx)
+		if err != nil {
+			log.Printf("count email queue: %v", err)
+			return
+		}
+		e.Gauge("goa4web_email_queue_depth", "Queued emails not yet sent.", float64(row.Queued))
+		e.Gauge("goa4web_email_queue_failing", "Queued emails that have failed at least once.", float64(row.Failing))
+	}
+}
@@ -2,11 +2,13 @@ package admin
 
 import (
 	"database/sql"
+	"net/http"
 
 	"github.com/arran4/goa4web/config"
 	"github.com/arran4/goa4web/core"
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/app/server"
+	"github.com/arran4/goa4web/internal/middleware/apiauth"
 	"github.com/arran4/goa4web/internal/navigation"
 	"github.com/arran4/goa4web/internal/router"
 	"github.com/gorilla/mux"
@@ -49,6 +51,7 @@ func WithUpdateConfigKeyFunc(fn func(fs core.FileSystem, path, key, value string
 // Register registers the admin router module using h's dependencies.
 func (h *Handlers) Register(reg *router.Registry) {
 	reg.RegisterModule("admin", []string{"faq", "forum", "imagebbs", "languages", "linker", "news", "search", "user", "writings", "blogs"}, func(r *mux.Router, cfg *config.RuntimeConfig) []navigation.RouterOptions {
+		r.Handle("/metrics", apiauth.APIKeyAuthMiddleware(http.HandlerFunc(h.Metrics))).Methods("GET")
 		ar := r.PathPrefix("/admin").Subrouter()
 		ar.Use(router.AdminCheckerMiddleware)
 		ar.Use(handlers.IndexMiddleware(CustomIndex))
@@ -15,4 +15,7 @@ const (
 
 	EmailTemplateAdminPasswordReset            notif.EmailTemplateName = "passwordResetEmail"
 	EmailTemplateAdminUserRequestPasswordReset notif.EmailTemplateName = "adminNotificationUserRequestPasswordResetEmail"
+
+	EmailTemplateContentReportResolved notif.EmailTemplateName = "contentReportResolvedEmail"
+	EmailTemplateContentModeration     notif.EmailTemplateName = "contentModerationEmail"
 )
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/images"
+	"github.com/arran4/goa4web/internal/upload"
+)
+
+// imageBBSUploadPrefix is prepended to image board paths recorded in the
+// database but is not part of the stored object name.
+const imageBBSUploadPrefix = "/imagebbs/images"
+
+// legacyUploadPrefix begins uploaded_images paths written before
+// MigrateImagePathsTask dropped it; the object name never included it.
+const legacyUploadPrefix = "uploads/"
+
+// UploadObjectName returns the upload provider object name for a path
+// recorded in uploaded_images or imagepost, including legacy paths still
+// carrying the "uploads/" prefix.
+func UploadObjectName(dbPath string) string {
+	name := strings.TrimPrefix(strings.TrimPrefix(dbPath, imageBBSUploadPrefix), "/")
+	return strings.TrimPrefix(name, legacyUploadPrefix)
+}
+
+// ReferencedUploads returns the set of upload object names still referenced
+// by uploaded_images or imagepost rows.
+func ReferencedUploads(ctx context.Context, queries db.Querier) (map[string]struct{}, error) {
+	paths, err := queries.ListReferencedUploadPaths(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list referenced uploads: %w", err)
+	}
+	refs := make(map[string]struct{}, len(paths))
+	for _, p := range paths {
+		if p.Valid && p.String != "" {
+			refs[UploadObjectName(p.String)] = struct{}{}
+		}
+	}
+	return refs, nil
+}
+
+// UploadReferenced reports whether name is in refs or is a srcset derivative,
+// named by images.DerivativeName, of an upload in refs.
+func UploadReferenced(refs map[string]struct{}, name string) bool {
+	if _, ok := refs[name]; ok {
+		return true
+	}
+	dir, file := path.Split(name)
+	source, _, ok := images.ParseDerivativeName(file)
+	if !ok {
+		return false
+	}
+	_, ok = refs[dir+source]
+	return ok
+}
+
+// OrphanedUploads lists the objects under prefix in p that no database row
+// references. Objects modified less than olderThan ago are skipped so an
+// upload stored moments before its row is written is not collected.
+func OrphanedUploads(ctx context.Context, queries db.Querier, p upload.Provider, prefix string, olderThan time.Duration) ([]upload.ObjectInfo, error) {
+	refs, err := ReferencedUploads(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := time.Now().Add(-olderThan)
+	var orphans []upload.ObjectInfo
+	err = p.List(ctx, prefix, func(info upload.ObjectInfo) error {
+		if UploadReferenced(refs, info.Name) {
+			return nil
+		}
+		if olderThan > 0 && (info.ModTime.IsZero() || info.ModTime.After(cutoff)) {
+			return nil
+		}
+		orphans = append(orphans, info)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list uploads: %w", err)
+	}
+	return orphans, nil
+}
+
+// PurgeOrphanedUploads deletes the objects returned by OrphanedUploads and
+// reports how many objects and bytes were removed. Deletion continues past
+// individual failures; the first error is returned.
+func PurgeOrphanedUploads(ctx context.Context, queries db.Querier, p upload.Provider, prefix string, olderThan time.Duration) (int, int64, error) {
+	orphans, err := OrphanedUploads(ctx, queries, p, prefix, olderThan)
+	if err != nil {
+		return 0, 0, err
+	}
+	var deleted int
+	var bytes int64
+	var firstErr error
+	for _, o := range orphans {
+		if err := p.Delete(ctx, o.Name); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("delete %s: %w", o.Name, err)
+			}
+			continue
+		}
+		deleted++
+		bytes += o.Size
+	}
+	return deleted, bytes, firstErr
+}
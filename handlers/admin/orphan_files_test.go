@@ -0,0 +1,123 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/testhelpers"
+	"github.com/arran4/goa4web/internal/upload/local"
+)
+
+func writeAgedFile(t *testing.T, dir, name string, age time.Duration) {
+	t.Helper()
+	full := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(full, []byte("data"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mod := time.Now().Add(-age)
+	if err := os.Chtimes(full, mod, mod); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadObjectName(t *testing.T) {
+	for in, want := range map[string]string{
+		"/imagebbs/images/ab/cd/x.jpg": "ab/cd/x.jpg",
+		"/ab/cd/y.png":                 "ab/cd/y.png",
+		"uploads/ab/cd/z.png":          "ab/cd/z.png",
+	} {
+		if got := UploadObjectName(in); got != want {
+			t.Errorf("UploadObjectName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestOrphanedUploads(t *testing.T) {
+	dir := t.TempDir()
+	writeAgedFile(t, dir, "ab/cd/post.jpg", 48*time.Hour)
+	writeAgedFile(t, dir, "ab/cd/post_thumb.jpg", 48*time.Hour)
+	writeAgedFile(t, dir, "ab/cd/post_w640.jpg", 48*time.Hour)
+	writeAgedFile(t, dir, "ab/cd/orphan_w640.jpg", 48*time.Hour)
+	writeAgedFile(t, dir, "ef/gh/upload.png", 48*time.Hour)
+	writeAgedFile(t, dir, "ab/cd/legacy.png", 48*time.Hour)
+	writeAgedFile(t, dir, "ab/cd/orphan.jpg", 48*time.Hour)
+	writeAgedFile(t, dir, "ab/cd/fresh.jpg", time.Minute)
+
+	queries := testhelpers.NewQuerierStub()
+	queries.ListReferencedUploadPathsReturns = []sql.NullString{
+		{Valid: true, String: "/imagebbs/images/ab/cd/post.jpg"},
+		{Valid: true, String: "/imagebbs/images/ab/cd/post_thumb.jpg"},
+		{Valid: true, String: "/ef/gh/upload.png"},
+		{Valid: true, String: "uploads/ab/cd/legacy.png"},
+	}
+	p := local.Provider{Dir: dir}
+
+	orphans, err := OrphanedUploads(context.Background(), queries, p, "", time.Hour)
+	if err != nil {
+		t.Fatalf("orphans: %v", err)
+	}
+	if len(orphans) != 2 || orphans[0].Name != "ab/cd/orphan.jpg" || orphans[1].Name != "ab/cd/orphan_w640.jpg" {
+		t.Fatalf("orphans = %+v", orphans)
+	}
+
+	deleted, bytes, err := PurgeOrphanedUploads(context.Background(), queries, p, "ab/", time.Hour)
+	if err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+	if deleted != 2 || bytes != 8 {
+		t.Fatalf("deleted=%d bytes=%d", deleted, bytes)
+	}
+	for name, want := range map[string]bool{"ab/cd/orphan.jpg": false, "ab/cd/orphan_w640.jpg": false, "ab/cd/fresh.jpg": true, "ab/cd/post.jpg": true, "ab/cd/post_w640.jpg": true, "ef/gh/upload.png": true, "ab/cd/legacy.png": true} {
+		_, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name)))
+		if got := err == nil; got != want {
+			t.Errorf("%s exists=%v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestDeleteUnmanagedFileTaskRefusesReferenced(t *testing.T) {
+	local.Register()
+	dir := t.TempDir()
+	writeAgedFile(t, dir, "ab/cd/post.jpg", time.Hour)
+	writeAgedFile(t, dir, "ab/cd/orphan.jpg", time.Hour)
+
+	queries := testhelpers.NewQuerierStub()
+	queries.ListReferencedUploadPathsReturns = []sql.NullString{{Valid: true, String: "/imagebbs/images/ab/cd/post.jpg"}}
+	cfg := config.NewRuntimeConfig()
+	cfg.ImageUploadProvider = "local"
+	cfg.ImageUploadDir = dir
+
+	run := func(name string) any {
+		req := httptest.NewRequest("POST", "/admin/files/delete", strings.NewReader(url.Values{"path": {name}}.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		cd := common.NewCoreData(req.Context(), queries, cfg, common.WithUserRoles([]string{"administrator"}))
+		req = req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+		return (&DeleteUnmanagedFileTask{}).Action(httptest.NewRecorder(), req)
+	}
+
+	if res, ok := run("/ab/cd/post.jpg").(error); !ok || !strings.Contains(res.Error(), "referenced") {
+		t.Fatalf("expected referenced error, got %v", res)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ab", "cd", "post.jpg")); err != nil {
+		t.Fatalf("referenced file removed: %v", err)
+	}
+	if res, ok := run("/ab/cd/orphan.jpg").(error); ok {
+		t.Fatalf("delete orphan: %v", res)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ab", "cd", "orphan.jpg")); !os.IsNotExist(err) {
+		t.Fatalf("orphan not removed: %v", err)
+	}
+}
@@ -41,7 +41,12 @@ func TestHappyPathAdminTemplatesExist(t *testing.T) {
 		AdminDeactivatedCommentsPageTmpl,
 		TemplateUserResetPasswordConfirmPage, // Note: This constant name was kept as is in adminUserPasswordReset.go
 		AdminAnnouncementsPageTmpl,
+		AdminWebhooksPageTmpl,
+		AdminWebhookPageTmpl,
+		AdminWebhookDeliveriesPageTmpl,
+		AdminWebhookDeliveryPageTmpl,
 		AdminEmailTestPageTmpl,
+		AdminEmailSuppressionsPageTmpl,
 		AdminUserWritingsPageTmpl,
 		AdminRolePageTmpl,
 		AdminFilesPageTmpl,
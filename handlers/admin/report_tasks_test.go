@@ -0,0 +1,207 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/core/templates"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+type reportTaskQueries struct {
+	db.Querier
+	report        *db.ContentReport
+	resolved      []db.AdminResolveContentReportsForItemParams
+	removedLogin  []int32
+	roles         []db.SystemCreateUserRoleParams
+	userComments  []db.InsertAdminUserCommentParams
+	notifications []db.SystemCreateNotificationParams
+	roleErr       error
+	txs           int
+}
+
+// InTx runs fn directly and discards the ban changes it made when it fails,
+// as a rollback would.
+func (q *reportTaskQueries) InTx(_ context.Context, fn func(db.Querier) error) error {
+	q.txs++
+	removed, roles, comments := len(q.removedLogin), len(q.roles), len(q.userComments)
+	if err := fn(q); err != nil {
+		q.removedLogin, q.roles, q.userComments = q.removedLogin[:removed], q.roles[:roles], q.userComments[:comments]
+		return err
+	}
+	return nil
+}
+
+func (q *reportTaskQueries) AdminGetContentReportByID(context.Context, int32) (*db.ContentReport, error) {
+	return q.report, nil
+}
+
+func (q *reportTaskQueries) AdminListPendingContentReportReporters(context.Context, db.AdminListPendingContentReportReportersParams) ([]int32, error) {
+	return []int32{4, 5}, nil
+}
+
+func (q *reportTaskQueries) AdminResolveContentReportsForItem(_ context.Context, arg db.AdminResolveContentReportsForItemParams) (int64, error) {
+	q.resolved = append(q.resolved, arg)
+	return 2, nil
+}
+
+func (q *reportTaskQueries) AdminRemoveLoginRolesFromUser(_ context.Context, id int32) error {
+	q.removedLogin = append(q.removedLogin, id)
+	return nil
+}
+
+func (q *reportTaskQueries) SystemCreateUserRole(_ context.Context, arg db.SystemCreateUserRoleParams) error {
+	if q.roleErr != nil {
+		return q.roleErr
+	}
+	q.roles = append(q.roles, arg)
+	return nil
+}
+
+func (q *reportTaskQueries) InsertAdminUserComment(_ context.Context, arg db.InsertAdminUserCommentParams) error {
+	q.userComments = append(q.userComments, arg)
+	return nil
+}
+
+func (q *reportTaskQueries) SystemCreateNotification(_ context.Context, arg db.SystemCreateNotificationParams) error {
+	q.notifications = append(q.notifications, arg)
+	return nil
+}
+
+func (q *reportTaskQueries) SystemGetUserByID(_ context.Context, id int32) (*db.SystemGetUserByIDRow, error) {
+	return &db.SystemGetUserByIDRow{
+		Idusers:  id,
+		Username: sql.NullString{String: "user", Valid: true},
+		Email:    sql.NullString{String: "author@example.com", Valid: true},
+	}, nil
+}
+
+func newReportTaskRequest(q db.Querier, task, note string) (*http.Request, *common.CoreData) {
+	form := url.Values{"task": {task}, "note": {note}}
+	req := httptest.NewRequest(http.MethodPost, "/admin/report/11", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = mux.SetURLVars(req, map[string]string{"report": "11"})
+	cd := common.NewCoreData(req.Context(), q, config.NewRuntimeConfig(),
+		common.WithPermissions([]*db.GetPermissionsByUserIDRow{{Name: "administrator", IsAdmin: true}}),
+		common.WithEvent(&eventbus.TaskEvent{Path: "/admin/report/11", Task: TaskReportDismiss}),
+	)
+	cd.UserID = 1
+	return req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd)), cd
+}
+
+func pendingReport() *db.ContentReport {
+	return &db.ContentReport{ID: 11, ReporterID: 4, ItemType: common.ReportTypeComment, ItemID: 12, AuthorID: 7, Reason: "spam", Status: common.ReportStatusPending}
+}
+
+func TestReportTasksTemplatesRequiredExist(t *testing.T) {
+	for _, task := range []interface{ RequiredTemplates() []tasks.Template }{deactivateReportTask, warnReportTask, banReportTask, dismissReportTask} {
+		for _, name := range task.RequiredTemplates() {
+			if !name.Exists(templates.WithSilence(true)) {
+				t.Fatalf("missing template: %s", name)
+			}
+		}
+	}
+}
+
+func TestDismissReportTaskNotifiesReporters(t *testing.T) {
+	q := &reportTaskQueries{report: pendingReport()}
+	req, cd := newReportTaskRequest(q, string(TaskReportDismiss), "not spam")
+
+	res := dismissReportTask.Action(httptest.NewRecorder(), req)
+	if rdh, ok := res.(handlers.RefreshDirectHandler); !ok || rdh.TargetURL != "/admin/reports" {
+		t.Fatalf("result=%#v", res)
+	}
+	if len(q.resolved) != 1 || q.resolved[0].Status != common.ReportStatusDismissed || q.resolved[0].ResolutionNote.String != "not spam" || q.resolved[0].ResolvedBy.Int32 != 1 {
+		t.Fatalf("resolved=%+v", q.resolved)
+	}
+	if len(q.removedLogin) != 0 || len(q.userComments) != 0 {
+		t.Fatalf("dismiss acted on author: %+v %+v", q.removedLogin, q.userComments)
+	}
+	evt := *cd.Event()
+	if ids, _ := dismissReportTask.TargetUserIDs(evt); len(ids) != 2 || ids[0] != 4 || ids[1] != 5 {
+		t.Fatalf("targets=%v", ids)
+	}
+	if rec := dismissReportTask.AuditRecord(evt.Data); rec != "report 11 on comment 12 dismissed" {
+		t.Fatalf("audit=%q", rec)
+	}
+}
+
+func TestBanReportTaskRemovesLogin(t *testing.T) {
+	q := &reportTaskQueries{report: pendingReport()}
+	req, cd := newReportTaskRequest(q, string(TaskReportBan), "")
+
+	if res := banReportTask.Action(httptest.NewRecorder(), req); res != (handlers.RefreshDirectHandler{TargetURL: "/admin/reports"}) {
+		t.Fatalf("result=%#v", res)
+	}
+	if q.txs != 1 || len(q.removedLogin) != 1 || q.removedLogin[0] != 7 {
+		t.Fatalf("txs=%d removed=%v", q.txs, q.removedLogin)
+	}
+	if len(q.roles) != 1 || q.roles[0] != (db.SystemCreateUserRoleParams{UsersIdusers: 7, Name: "rejected"}) {
+		t.Fatalf("roles=%+v", q.roles)
+	}
+	if len(q.resolved) != 1 || q.resolved[0].Status != common.ReportStatusBanned {
+		t.Fatalf("resolved=%+v", q.resolved)
+	}
+	if addr, _ := banReportTask.DirectEmailAddress(*cd.Event()); addr != "author@example.com" {
+		t.Fatalf("author email=%q", addr)
+	}
+}
+
+func TestBanReportTaskRollsBackOnRoleFailure(t *testing.T) {
+	q := &reportTaskQueries{report: pendingReport(), roleErr: errors.New("insert failed")}
+	req, _ := newReportTaskRequest(q, string(TaskReportBan), "")
+
+	res := banReportTask.Action(httptest.NewRecorder(), req)
+	if err, ok := res.(error); !ok || !strings.Contains(err.Error(), "insert failed") {
+		t.Fatalf("result=%#v", res)
+	}
+	if len(q.removedLogin) != 0 || len(q.resolved) != 0 {
+		t.Fatalf("removed=%v resolved=%+v", q.removedLogin, q.resolved)
+	}
+}
+
+func TestWarnReportTaskNotifiesAuthor(t *testing.T) {
+	q := &reportTaskQueries{report: pendingReport()}
+	req, _ := newReportTaskRequest(q, string(TaskReportWarn), "be nice")
+
+	if res := warnReportTask.Action(httptest.NewRecorder(), req); res != (handlers.RefreshDirectHandler{TargetURL: "/admin/reports"}) {
+		t.Fatalf("result=%#v", res)
+	}
+	if len(q.notifications) != 1 || q.notifications[0].RecipientID != 7 || !strings.Contains(q.notifications[0].Message.String, "be nice") {
+		t.Fatalf("notifications=%+v", q.notifications)
+	}
+	if len(q.userComments) != 1 || q.userComments[0].UsersIdusers != 7 {
+		t.Fatalf("comments=%+v", q.userComments)
+	}
+	if len(q.removedLogin) != 0 {
+		t.Fatalf("warn removed login: %v", q.removedLogin)
+	}
+}
+
+func TestResolvedReportIsRejected(t *testing.T) {
+	r := pendingReport()
+	r.Status = common.ReportStatusDismissed
+	q := &reportTaskQueries{report: r}
+	req, _ := newReportTaskRequest(q, string(TaskReportDeactivate), "")
+
+	if _, ok := deactivateReportTask.Action(httptest.NewRecorder(), req).(error); !ok {
+		t.Fatal("expected error")
+	}
+	if len(q.resolved) != 0 {
+		t.Fatalf("resolved=%+v", q.resolved)
+	}
+}
@@ -43,15 +43,19 @@ func getAdminNavLinks() []navpkg.RouterOptions {
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Maintenance"), "Link Discovery", "/admin/link-discovery", 32),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Notifications"), "Notifications", "/admin/notifications", 90),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Notifications"), "Subscription Templates", "/admin/subscriptions/templates", 95),
+		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Notifications"), "Webhooks", "/admin/webhooks", 96),
+		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Notifications"), "Webhook Deliveries", "/admin/webhooks/deliveries", 97),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Queued Emails", "/admin/email/queue", 110),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Failed Emails", "/admin/email/failed", 112),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Sent Emails", "/admin/email/sent", 115),
+		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Suppressed Emails", "/admin/email/suppressions", 116),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Email Tester", "/admin/email/test", 118),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Email Template", "/admin/email/template", 120),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Template Export", "/admin/templates/export", 121),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Email"), "Dead Letter Queue", "/admin/dlq", 130),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Stats"), "Server Stats", "/admin/stats", 140),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Users"), "Requests", "/admin/requests", 145),
+		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Users"), "Reports", "/admin/reports", 145),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Users"), "Password Resets", "/admin/password_resets", 146),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Users"), "Comments", "/admin/comments", 147),
 		navpkg.NewAdminControlCenterLink(navpkg.AdminCCCategory("Core", "Users"), "Deactivated Comments", "/admin/comments/deactivated", 148),
@@ -118,6 +122,7 @@ func (h *Handlers) registerSystemRoutes(ar *mux.Router) {
 	ar.HandleFunc("/page-size", AdminPageSizePage).Methods("GET", "POST")
 	ar.HandleFunc("/files", AdminFilesPage).Methods("GET").MatcherFunc(handlers.RequiredAccess("administrator"))
 	ar.HandleFunc("/files/unmanaged", AdminUnmanagedFilesPage).Methods("GET").MatcherFunc(handlers.RequiredAccess("administrator"))
+	ar.HandleFunc("/files/unmanaged", handlers.TaskHandler(uploadOrphanPurgeTask)).Methods("POST").MatcherFunc(handlers.RequiredAccess("administrator")).MatcherFunc(uploadOrphanPurgeTask.Matcher())
 	deleteUnmanagedFileTask := h.NewDeleteUnmanagedFileTask()
 	ar.HandleFunc("/files/delete", handlers.TaskHandler(deleteUnmanagedFileTask)).Methods("POST").MatcherFunc(handlers.RequiredAccess("administrator")).MatcherFunc(deleteUnmanagedFileTask.Matcher())
 
@@ -154,6 +159,9 @@ func (h *Handlers) registerCommunicationRoutes(ar *mux.Router) {
 	ar.HandleFunc("/email/queue", handlers.TaskHandler(resendQueueTask)).Methods("POST").MatcherFunc(resendQueueTask.Matcher())
 	ar.HandleFunc("/email/queue", handlers.TaskHandler(deleteQueueTask)).Methods("POST").MatcherFunc(deleteQueueTask.Matcher())
 
+	ar.HandleFunc("/email/suppressions", AdminEmailSuppressionsPage).Methods("GET")
+	ar.HandleFunc("/email/suppressions", handlers.TaskHandler(releaseEmailSuppressionTask)).Methods("POST").MatcherFunc(releaseEmailSuppressionTask.Matcher())
+
 	ar.HandleFunc("/email/template", AdminEmailTemplatePage).Methods("GET")
 	ar.HandleFunc("/email/template", handlers.TaskHandler(saveTemplateTask)).Methods("POST").MatcherFunc(saveTemplateTask.Matcher())
 	ar.HandleFunc("/email/template", handlers.TaskHandler(testTemplateTask)).Methods("POST").MatcherFunc(testTemplateTask.Matcher())
@@ -180,6 +188,16 @@ func (h *Handlers) registerCommunicationRoutes(ar *mux.Router) {
 	applySubscriptionTemplateTask := h.NewApplySubscriptionTemplateTask()
 	ar.HandleFunc("/subscriptions/templates", handlers.TaskHandler(applySubscriptionTemplateTask)).Methods("POST").MatcherFunc(applySubscriptionTemplateTask.Matcher())
 
+	ar.HandleFunc("/webhooks", AdminWebhooksPage).Methods("GET")
+	ar.HandleFunc("/webhooks", handlers.TaskHandler(createWebhookTask)).Methods("POST").MatcherFunc(createWebhookTask.Matcher())
+	ar.HandleFunc("/webhooks/deliveries", AdminWebhookDeliveriesPage).Methods("GET")
+	ar.HandleFunc("/webhooks/deliveries", handlers.TaskHandler(redeliverWebhookTask)).Methods("POST").MatcherFunc(redeliverWebhookTask.Matcher())
+	ar.HandleFunc("/webhooks/deliveries/{delivery:[0-9]+}", AdminWebhookDeliveryPage).Methods("GET")
+	ar.HandleFunc("/webhooks/deliveries/{delivery:[0-9]+}", handlers.TaskHandler(redeliverWebhookTask)).Methods("POST").MatcherFunc(redeliverWebhookTask.Matcher())
+	ar.HandleFunc("/webhooks/{webhook:[0-9]+}", AdminWebhookPage).Methods("GET")
+	ar.HandleFunc("/webhooks/{webhook:[0-9]+}", handlers.TaskHandler(updateWebhookTask)).Methods("POST").MatcherFunc(updateWebhookTask.Matcher())
+	ar.HandleFunc("/webhooks/{webhook:[0-9]+}", handlers.TaskHandler(deleteWebhookTask)).Methods("POST").MatcherFunc(deleteWebhookTask.Matcher())
+
 	ar.HandleFunc("/announcements", AdminAnnouncementsPage).Methods("GET")
 	ar.HandleFunc("/announcements", handlers.TaskHandler(addAnnouncementTask)).Methods("POST").MatcherFunc(addAnnouncementTask.Matcher())
 	ar.HandleFunc("/announcements", handlers.TaskHandler(deleteAnnouncementTask)).Methods("POST").MatcherFunc(deleteAnnouncementTask.Matcher())
@@ -194,6 +212,12 @@ func (h *Handlers) registerUserManagementRoutes(ar *mux.Router) {
 	ar.HandleFunc("/request/{request}/reject", handlers.TaskHandler(rejectRequestTask)).Methods("POST").MatcherFunc(rejectRequestTask.Matcher())
 	ar.HandleFunc("/request/{request}/dismiss", handlers.TaskHandler(dismissRequestTask)).Methods("POST").MatcherFunc(dismissRequestTask.Matcher())
 	ar.HandleFunc("/request/{request}/query", handlers.TaskHandler(queryRequestTask)).Methods("POST").MatcherFunc(queryRequestTask.Matcher())
+	ar.HandleFunc("/reports", AdminReportQueuePage).Methods("GET")
+	ar.HandleFunc("/reports/archive", AdminReportArchivePage).Methods("GET")
+	ar.HandleFunc("/report/{report:[0-9]+}", handlers.TaskHandler(deactivateReportTask)).Methods("POST").MatcherFunc(deactivateReportTask.Matcher())
+	ar.HandleFunc("/report/{report:[0-9]+}", handlers.TaskHandler(warnReportTask)).Methods("POST").MatcherFunc(warnReportTask.Matcher())
+	ar.HandleFunc("/report/{report:[0-9]+}", handlers.TaskHandler(banReportTask)).Methods("POST").MatcherFunc(banReportTask.Matcher())
+	ar.HandleFunc("/report/{report:[0-9]+}", handlers.TaskHandler(dismissReportTask)).Methods("POST").MatcherFunc(dismissReportTask.Matcher())
 
 	ar.HandleFunc("/password_resets", handlers.TaskHandler(clearExpiredPasswordResetsTask)).Methods("POST").MatcherFunc(clearExpiredPasswordResetsTask.Matcher())
 	ar.HandleFunc("/password_resets", handlers.TaskHandler(clearUserPasswordResetsTask)).Methods("POST").MatcherFunc(clearUserPasswordResetsTask.Matcher())
@@ -87,6 +87,9 @@ const (
 	// TaskImageCacheRefresh refreshes a specific cache entry.
 	TaskImageCacheRefresh tasks.TaskString = "Refresh cache"
 
+	// TaskUploadOrphanPurge deletes uploaded files no database row references.
+	TaskUploadOrphanPurge tasks.TaskString = "Purge orphaned files"
+
 	// TaskDeactivate moves an item to the deactivated store.
 	TaskDeactivate tasks.TaskString = "Deactivate"
 
@@ -373,6 +376,33 @@ const (
 )
 
 const (
+	// TaskWebhookCreate adds an outbound webhook.
+	TaskWebhookCreate tasks.TaskString = "Create webhook"
+
+	// TaskWebhookUpdate saves changes to an outbound webhook.
+	TaskWebhookUpdate tasks.TaskString = "Save webhook"
+
+	// TaskWebhookDelete removes an outbound webhook.
+	TaskWebhookDelete tasks.TaskString = "Delete webhook"
+
+	// TaskWebhookRedeliver sends a recorded webhook delivery again.
+	TaskWebhookRedeliver tasks.TaskString = "Redeliver"
+
+	// TaskReleaseEmailSuppression allows mail to a suppressed address again.
+	TaskReleaseEmailSuppression tasks.TaskString = "Release suppression"
+
+	// TaskReportDeactivate deactivates reported content.
+	TaskReportDeactivate tasks.TaskString = "Deactivate content"
+
+	// TaskReportWarn warns the author of reported content.
+	TaskReportWarn tasks.TaskString = "Warn author"
+
+	// TaskReportBan stops the author of reported content from logging in.
+	TaskReportBan tasks.TaskString = "Ban author"
+
+	// TaskReportDismiss closes a content report without action.
+	TaskReportDismiss tasks.TaskString = "Dismiss report"
+
 	// TaskCheckPrivateForumGrants checks private forum grants for inconsistencies.
 	TaskCheckPrivateForumGrants tasks.TaskString = "Check private forum grants"
 )
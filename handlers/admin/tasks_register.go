@@ -7,6 +7,11 @@ func (h *Handlers) RegisterTasks() []tasks.NamedTask {
 	return []tasks.NamedTask{
 		addAnnouncementTask,
 		deleteAnnouncementTask,
+		createWebhookTask,
+		updateWebhookTask,
+		deleteWebhookTask,
+		redeliverWebhookTask,
+		releaseEmailSuppressionTask,
 		resendQueueTask,
 		deleteQueueTask,
 		bulkResendQueueTask,
@@ -34,6 +39,10 @@ func (h *Handlers) RegisterTasks() []tasks.NamedTask {
 		acceptRequestTask,
 		rejectRequestTask,
 		queryRequestTask,
+		deactivateReportTask,
+		warnReportTask,
+		banReportTask,
+		dismissReportTask,
 		deleteCommentTask,
 		editCommentTask,
 		deactivateCommentTask,
@@ -48,6 +57,7 @@ func (h *Handlers) RegisterTasks() []tasks.NamedTask {
 		imageCacheListTask,
 		imageCachePruneTask,
 		imageCacheDeleteTask,
+		uploadOrphanPurgeTask,
 		dbBackupTask,
 		dbRestoreTask,
 		h.NewServerShutdownTask(),
@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/upload"
+)
+
+// defaultOrphanAge is the minimum age of an unreferenced upload before the
+// admin pages will collect it.
+const defaultOrphanAge = 24 * time.Hour
+
+// UploadOrphanPurgeTask deletes uploaded files that no database row references.
+type UploadOrphanPurgeTask struct{ tasks.TaskString }
+
+var uploadOrphanPurgeTask = &UploadOrphanPurgeTask{TaskString: TaskUploadOrphanPurge}
+
+var _ tasks.Task = (*UploadOrphanPurgeTask)(nil)
+var _ tasks.AuditableTask = (*UploadOrphanPurgeTask)(nil)
+
+func (UploadOrphanPurgeTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if err := r.ParseForm(); err != nil {
+		return fmt.Errorf("parse form fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	olderThan := defaultOrphanAge
+	if v := r.PostFormValue("older_than"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return fmt.Errorf("invalid age %w", handlers.ErrRedirectOnSamePageHandler(fmt.Errorf("older_than must be a duration such as 24h")))
+		}
+		olderThan = d
+	}
+	dir := path.Clean("/" + r.PostFormValue("path"))
+	p := upload.ProviderFromConfig(cd.Config)
+	if p == nil {
+		return fmt.Errorf("upload provider unavailable %w", handlers.ErrRedirectOnSamePageHandler(fmt.Errorf("no upload provider configured")))
+	}
+	deleted, bytes, err := PurgeOrphanedUploads(r.Context(), cd.Queries(), p, orphanPrefix(dir), olderThan)
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data["UploadOrphansDeleted"] = deleted
+		evt.Data["UploadOrphanBytes"] = bytes
+	}
+	if err != nil {
+		return fmt.Errorf("purge orphaned files %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	return handlers.RefreshDirectHandler{TargetURL: "/admin/files/unmanaged?path=" + url.QueryEscape(dir)}
+}
+
+// AuditRecord summarises the purge.
+func (UploadOrphanPurgeTask) AuditRecord(data map[string]any) string {
+	deleted, _ := data["UploadOrphansDeleted"].(int)
+	bytes, _ := data["UploadOrphanBytes"].(int64)
+	return fmt.Sprintf("purged %d orphaned uploads (%d bytes)", deleted, bytes)
+}
+
+// orphanPrefix converts a listing directory such as "/ab/cd" into an object
+// name prefix.
+func orphanPrefix(dir string) string {
+	prefix := strings.TrimPrefix(dir, "/")
+	if prefix != "" {
+		prefix += "/"
+	}
+	return prefix
+}
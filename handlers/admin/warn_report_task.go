@@ -0,0 +1,24 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// WarnReportTask records a warning against the author of reported content.
+type WarnReportTask struct{ authorNoticeTask }
+
+var warnReportTask = &WarnReportTask{authorNoticeTask{reportQueueTask{TaskString: TaskReportWarn}}}
+
+var _ tasks.Task = (*WarnReportTask)(nil)
+var _ tasks.AuditableTask = (*WarnReportTask)(nil)
+var _ tasks.TemplatesRequired = (*WarnReportTask)(nil)
+var _ notif.TargetUsersNotificationProvider = (*WarnReportTask)(nil)
+var _ notif.DirectEmailNotificationTemplateProvider = (*WarnReportTask)(nil)
+
+func (WarnReportTask) Action(w http.ResponseWriter, r *http.Request) any {
+	return resolveReport(r, common.ReportStatusWarned)
+}
@@ -0,0 +1,248 @@
+package admin
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/webhooks"
+)
+
+// CreateWebhookTask adds a new outbound webhook.
+type CreateWebhookTask struct{ tasks.TaskString }
+
+var createWebhookTask = &CreateWebhookTask{TaskString: TaskWebhookCreate}
+
+// UpdateWebhookTask saves changes to a webhook.
+type UpdateWebhookTask struct{ tasks.TaskString }
+
+var updateWebhookTask = &UpdateWebhookTask{TaskString: TaskWebhookUpdate}
+
+// DeleteWebhookTask removes a webhook and its delivery log.
+type DeleteWebhookTask struct{ tasks.TaskString }
+
+var deleteWebhookTask = &DeleteWebhookTask{TaskString: TaskWebhookDelete}
+
+// RedeliverWebhookTask queues an earlier delivery to be sent again.
+type RedeliverWebhookTask struct{ tasks.TaskString }
+
+var redeliverWebhookTask = &RedeliverWebhookTask{TaskString: TaskWebhookRedeliver}
+
+var _ tasks.Task = (*CreateWebhookTask)(nil)
+var _ tasks.AuditableTask = (*CreateWebhookTask)(nil)
+var _ tasks.Task = (*UpdateWebhookTask)(nil)
+var _ tasks.AuditableTask = (*UpdateWebhookTask)(nil)
+var _ tasks.Task = (*DeleteWebhookTask)(nil)
+var _ tasks.AuditableTask = (*DeleteWebhookTask)(nil)
+var _ tasks.Task = (*RedeliverWebhookTask)(nil)
+var _ tasks.AuditableTask = (*RedeliverWebhookTask)(nil)
+
+// webhookForm holds the validated fields shared by the create and update forms.
+type webhookForm struct {
+	Name     string
+	URL      string
+	Secret   string
+	Patterns string
+	Active   bool
+}
+
+func parseWebhookForm(r *http.Request) (webhookForm, error) {
+	f := webhookForm{
+		Name:     strings.TrimSpace(r.PostFormValue("name")),
+		URL:      strings.TrimSpace(r.PostFormValue("url")),
+		Secret:   strings.TrimSpace(r.PostFormValue("secret")),
+		Patterns: strings.Join(webhooks.ParsePatterns(strings.ReplaceAll(r.PostFormValue("patterns"), "\r", "")), "\n"),
+		Active:   r.PostFormValue("active") != "",
+	}
+	if f.Name == "" {
+		return f, fmt.Errorf("name required")
+	}
+	u, err := url.Parse(f.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return f, fmt.Errorf("url must be an absolute http or https URL")
+	}
+	if f.Patterns == "" {
+		return f, fmt.Errorf("at least one pattern required")
+	}
+	for _, p := range webhooks.ParsePatterns(f.Patterns) {
+		if !strings.Contains(p, ":") {
+			return f, fmt.Errorf("pattern %q must be of the form task:path", p)
+		}
+	}
+	return f, nil
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func setWebhookEventData(cd *common.CoreData, key string, value any) {
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data[key] = value
+	}
+}
+
+func (CreateWebhookTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if cd == nil || !cd.HasAdminRole() {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			handlers.RenderErrorPage(w, r, handlers.ErrForbidden)
+		})
+	}
+	f, err := parseWebhookForm(r)
+	if err != nil {
+		return fmt.Errorf("webhook form %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if f.Secret == "" {
+		if f.Secret, err = newWebhookSecret(); err != nil {
+			return fmt.Errorf("generate secret %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+	}
+	id, err := cd.Queries().AdminCreateWebhook(r.Context(), db.AdminCreateWebhookParams{
+		Name:     f.Name,
+		Url:      f.URL,
+		Secret:   f.Secret,
+		Patterns: f.Patterns,
+		Active:   f.Active,
+	})
+	if err != nil {
+		return fmt.Errorf("create webhook %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	setWebhookEventData(cd, "WebhookID", int(id))
+	setWebhookEventData(cd, "WebhookName", f.Name)
+	return handlers.RefreshDirectHandler{TargetURL: fmt.Sprintf("/admin/webhooks/%d", id)}
+}
+
+// AuditRecord summarises a webhook being created.
+func (CreateWebhookTask) AuditRecord(data map[string]any) string {
+	if name, ok := data["WebhookName"].(string); ok {
+		return fmt.Sprintf("created webhook %s", name)
+	}
+	return "created webhook"
+}
+
+func (UpdateWebhookTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if cd == nil || !cd.HasAdminRole() {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			handlers.RenderErrorPage(w, r, handlers.ErrForbidden)
+		})
+	}
+	id, err := strconv.Atoi(r.PostFormValue("id"))
+	if err != nil {
+		return fmt.Errorf("webhook id parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	queries := cd.Queries()
+	existing, err := queries.AdminGetWebhook(r.Context(), int32(id))
+	if err != nil {
+		return fmt.Errorf("get webhook %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	f, err := parseWebhookForm(r)
+	if err != nil {
+		return fmt.Errorf("webhook form %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	// A blank secret keeps the current one so the edit form never has to
+	// echo it back.
+	if f.Secret == "" {
+		f.Secret = existing.Secret
+	}
+	if err := queries.AdminUpdateWebhook(r.Context(), db.AdminUpdateWebhookParams{
+		Name:     f.Name,
+		Url:      f.URL,
+		Secret:   f.Secret,
+		Patterns: f.Patterns,
+		Active:   f.Active,
+		ID:       existing.ID,
+	}); err != nil {
+		return fmt.Errorf("update webhook %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	setWebhookEventData(cd, "WebhookID", id)
+	setWebhookEventData(cd, "WebhookName", f.Name)
+	return nil
+}
+
+// AuditRecord summarises a webhook being updated.
+func (UpdateWebhookTask) AuditRecord(data map[string]any) string {
+	if name, ok := data["WebhookName"].(string); ok {
+		return fmt.Sprintf("updated webhook %s", name)
+	}
+	return "updated webhook"
+}
+
+func (DeleteWebhookTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if cd == nil || !cd.HasAdminRole() {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			handlers.RenderErrorPage(w, r, handlers.ErrForbidden)
+		})
+	}
+	id, err := strconv.Atoi(r.PostFormValue("id"))
+	if err != nil {
+		return fmt.Errorf("webhook id parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	queries := cd.Queries()
+	if err := queries.AdminDeleteWebhookDeliveriesByWebhook(r.Context(), int32(id)); err != nil {
+		return fmt.Errorf("delete webhook deliveries %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if err := queries.AdminDeleteWebhook(r.Context(), int32(id)); err != nil {
+		return fmt.Errorf("delete webhook %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	setWebhookEventData(cd, "WebhookID", id)
+	return handlers.RefreshDirectHandler{TargetURL: "/admin/webhooks"}
+}
+
+// AuditRecord summarises a webhook being deleted.
+func (DeleteWebhookTask) AuditRecord(data map[string]any) string {
+	if id, ok := data["WebhookID"].(int); ok {
+		return fmt.Sprintf("deleted webhook %d", id)
+	}
+	return "deleted webhook"
+}
+
+func (RedeliverWebhookTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if cd == nil || !cd.HasAdminRole() {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			handlers.RenderErrorPage(w, r, handlers.ErrForbidden)
+		})
+	}
+	id, err := strconv.Atoi(r.PostFormValue("delivery_id"))
+	if err != nil {
+		return fmt.Errorf("delivery id parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if _, err := cd.Queries().SystemGetWebhookDelivery(r.Context(), int32(id)); err != nil {
+		return fmt.Errorf("get webhook delivery %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	// The webhook worker performs the delivery so it is not tied to the
+	// lifetime of this request.
+	if err := cd.Publish(eventbus.WebhookRedeliverEvent{DeliveryID: int32(id)}); err != nil {
+		return fmt.Errorf("queue redelivery %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	setWebhookEventData(cd, "DeliveryID", id)
+	return nil
+}
+
+// AuditRecord summarises a delivery being re-sent.
+func (RedeliverWebhookTask) AuditRecord(data map[string]any) string {
+	if id, ok := data["DeliveryID"].(int); ok {
+		return fmt.Sprintf("redelivered webhook delivery %d", id)
+	}
+	return "redelivered webhook delivery"
+}
@@ -0,0 +1,100 @@
+package admin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+type webhookQueries struct {
+	db.Querier
+	created  []db.AdminCreateWebhookParams
+	delivery *db.WebhookDelivery
+}
+
+func (q *webhookQueries) AdminCreateWebhook(_ context.Context, arg db.AdminCreateWebhookParams) (int64, error) {
+	q.created = append(q.created, arg)
+	return int64(len(q.created)), nil
+}
+
+func (q *webhookQueries) SystemGetWebhookDelivery(_ context.Context, id int32) (*db.WebhookDelivery, error) {
+	return q.delivery, nil
+}
+
+func newWebhookTaskRequest(q db.Querier, form url.Values, opts ...common.CoreOption) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/admin/webhooks", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	opts = append(opts, common.WithPermissions([]*db.GetPermissionsByUserIDRow{{Name: "administrator", IsAdmin: true}}))
+	cd := common.NewCoreData(req.Context(), q, config.NewRuntimeConfig(), opts...)
+	return req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+}
+
+func TestCreateWebhookTask(t *testing.T) {
+	t.Run("Happy Path - Generates Secret", func(t *testing.T) {
+		q := &webhookQueries{}
+		req := newWebhookTaskRequest(q, url.Values{
+			"name":     {"ci"},
+			"url":      {"https://example.com/hook"},
+			"patterns": {"create thread:/forum/*\r\n\r\nreply:/news/*\r\n"},
+			"active":   {"1"},
+		})
+		res := createWebhookTask.Action(httptest.NewRecorder(), req)
+		rdh, ok := res.(handlers.RefreshDirectHandler)
+		if !ok || rdh.TargetURL != "/admin/webhooks/1" {
+			t.Fatalf("result=%#v", res)
+		}
+		if len(q.created) != 1 {
+			t.Fatalf("created=%d", len(q.created))
+		}
+		got := q.created[0]
+		if got.Patterns != "create thread:/forum/*\nreply:/news/*" || !got.Active || len(got.Secret) != 64 {
+			t.Fatalf("created=%+v", got)
+		}
+	})
+
+	t.Run("Unhappy Path - Invalid URL", func(t *testing.T) {
+		q := &webhookQueries{}
+		req := newWebhookTaskRequest(q, url.Values{
+			"name":     {"ci"},
+			"url":      {"ftp://example.com"},
+			"patterns": {"*:*"},
+		})
+		if _, ok := createWebhookTask.Action(httptest.NewRecorder(), req).(error); !ok {
+			t.Fatalf("expected error")
+		}
+		if len(q.created) != 0 {
+			t.Fatalf("webhook created with invalid url")
+		}
+	})
+}
+
+func TestRedeliverWebhookTaskPublishesEvent(t *testing.T) {
+	bus := eventbus.NewBus()
+	ch := bus.Subscribe(eventbus.WebhookRedeliverMessageType)
+	q := &webhookQueries{delivery: &db.WebhookDelivery{ID: 5, WebhookID: 1}}
+	req := newWebhookTaskRequest(q, url.Values{"delivery_id": {"5"}}, common.WithEventBus(bus))
+
+	if res := redeliverWebhookTask.Action(httptest.NewRecorder(), req); res != nil {
+		t.Fatalf("result=%#v", res)
+	}
+	select {
+	case env := <-ch:
+		evt, ok := env.Msg.(eventbus.WebhookRedeliverEvent)
+		if !ok || evt.DeliveryID != 5 {
+			t.Fatalf("msg=%#v", env.Msg)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("redeliver event not published")
+	}
+}
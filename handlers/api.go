@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"encoding/json"
+	"html/template"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// APIComment is the JSON representation of a comment returned by the REST API.
+type APIComment struct {
+	ID       int32  `json:"id"`
+	Username string `json:"username"`
+	Text     string `json:"text"`
+	HTML     string `json:"html"`
+	Written  string `json:"written"`
+}
+
+// WriteJSON encodes v as the JSON response body with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Printf("write json: %v", err)
+	}
+}
+
+// WriteJSONError writes a JSON error object with the given status code.
+func WriteJSONError(w http.ResponseWriter, status int, msg string) {
+	WriteJSON(w, status, map[string]any{
+		"status": "error",
+		"error":  msg,
+	})
+}
+
+// APIPage returns the 1-based page requested via the "page" query parameter.
+func APIPage(r *http.Request) int {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	return page
+}
+
+// APIPaginate returns the slice of items for page along with whether more
+// items follow it.
+func APIPaginate[T any](items []T, page, pageSize int) ([]T, bool) {
+	offset := (page - 1) * pageSize
+	if offset >= len(items) {
+		return nil, false
+	}
+	end := offset + pageSize
+	if end >= len(items) {
+		return items[offset:], false
+	}
+	return items[offset:end], true
+}
+
+// APIComments converts thread comments into their API representation with
+// the markup rendered to HTML.
+func APIComments(cd *common.CoreData, r *http.Request, comments []*db.GetCommentsByThreadIdForUserRow) []APIComment {
+	a4code2html, _ := cd.Funcs(r)["a4code2html"].(func(string) template.HTML)
+	out := make([]APIComment, 0, len(comments))
+	for _, c := range comments {
+		html := ""
+		if c.Text.Valid && a4code2html != nil {
+			html = string(a4code2html(c.Text.String))
+		}
+		out = append(out, APIComment{
+			ID:       c.Idcomments,
+			Username: c.Posterusername.String,
+			Text:     c.Text.String,
+			HTML:     html,
+			Written:  c.Written.Time.Format("2006-01-02T15:04:05Z"),
+		})
+	}
+	return out
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// apiResponseRecorder captures the output of a task so HTML pages rendered
+// by the task are not sent to API clients.
+type apiResponseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *apiResponseRecorder) Header() http.Header { return rec.header }
+
+func (rec *apiResponseRecorder) Write(b []byte) (int, error) {
+	if rec.status == 0 {
+		rec.status = http.StatusOK
+	}
+	return rec.body.Write(b)
+}
+
+func (rec *apiResponseRecorder) WriteHeader(status int) {
+	if rec.status == 0 {
+		rec.status = status
+	}
+}
+
+// APITaskHandler wraps t.Action like TaskHandler but reports the result as
+// JSON. Redirect results are returned as the location of the created or
+// updated resource.
+func APITaskHandler(t tasks.Task) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cd, _ := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+		if cd != nil {
+			cd.SetEventTask(t)
+		}
+		rec := &apiResponseRecorder{header: http.Header{}}
+		result := t.Action(rec, r)
+		if rec.status >= http.StatusBadRequest {
+			WriteJSONError(w, rec.status, http.StatusText(rec.status))
+			return
+		}
+		switch result := result.(type) {
+		case RedirectHandler:
+			WriteJSON(w, http.StatusOK, map[string]any{
+				"status":   "success",
+				"location": string(result),
+			})
+		case RefreshDirectHandler:
+			WriteJSON(w, http.StatusOK, map[string]any{
+				"status":   "success",
+				"location": result.TargetURL,
+			})
+		case SessionFetchFail, *SessionFetchFail:
+			WriteJSONError(w, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
+		case nil:
+			if cd != nil && cd.CurrentError() != "" {
+				WriteJSONError(w, http.StatusBadRequest, cd.CurrentError())
+				return
+			}
+			if loc := rec.header.Get("Location"); loc != "" {
+				WriteJSON(w, http.StatusOK, map[string]any{
+					"status":   "success",
+					"location": loc,
+				})
+				return
+			}
+			WriteJSON(w, http.StatusOK, map[string]any{"status": "success"})
+		case error:
+			log.Printf("api task action: %v", result)
+			status := http.StatusInternalServerError
+			var rp *errRedirectOnSamePageHandler
+			if errors.As(result, &rp) {
+				// Errors the HTML handlers would show back on the form are
+				// problems with the request.
+				status = http.StatusBadRequest
+				result = rp.error
+			}
+			var he *HTTPError
+			if errors.As(result, &he) {
+				status = he.Status
+			}
+			var ue interface{ UserErrorMessage() string }
+			if errors.As(result, &ue) && ue.UserErrorMessage() != "" {
+				if status == http.StatusInternalServerError {
+					status = http.StatusBadRequest
+				}
+				WriteJSONError(w, status, ue.UserErrorMessage())
+				return
+			}
+			WriteJSONError(w, status, http.StatusText(status))
+		default:
+			WriteJSON(w, http.StatusOK, map[string]any{"status": "success"})
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+type apiTestTask struct {
+	tasks.TaskString
+	action func(w http.ResponseWriter, r *http.Request) any
+}
+
+func (t apiTestTask) Action(w http.ResponseWriter, r *http.Request) any { return t.action(w, r) }
+
+func TestAPITaskHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     func(w http.ResponseWriter, r *http.Request) any
+		wantStatus int
+		wantBody   map[string]any
+	}{
+		{
+			name: "redirect result",
+			action: func(w http.ResponseWriter, r *http.Request) any {
+				return RedirectHandler("/forum/topic/1/thread/2")
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   map[string]any{"status": "success", "location": "/forum/topic/1/thread/2"},
+		},
+		{
+			name: "redirect written by task",
+			action: func(w http.ResponseWriter, r *http.Request) any {
+				http.Redirect(w, r, "/news/news/3", http.StatusSeeOther)
+				return nil
+			},
+			wantStatus: http.StatusOK,
+			wantBody:   map[string]any{"status": "success", "location": "/news/news/3"},
+		},
+		{
+			name: "forbidden error",
+			action: func(w http.ResponseWriter, r *http.Request) any {
+				return ErrRedirectOnSamePageHandler(ErrForbidden)
+			},
+			wantStatus: http.StatusForbidden,
+			wantBody:   map[string]any{"status": "error", "error": "Forbidden"},
+		},
+		{
+			name: "user error",
+			action: func(w http.ResponseWriter, r *http.Request) any {
+				return common.UserError{ErrorMessage: "missing replytext"}
+			},
+			wantStatus: http.StatusBadRequest,
+			wantBody:   map[string]any{"status": "error", "error": "missing replytext"},
+		},
+		{
+			name: "error page rendered by task",
+			action: func(w http.ResponseWriter, r *http.Request) any {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte("<html>not found</html>"))
+				return nil
+			},
+			wantStatus: http.StatusNotFound,
+			wantBody:   map[string]any{"status": "error", "error": "Not Found"},
+		},
+		{
+			name: "internal error",
+			action: func(w http.ResponseWriter, r *http.Request) any {
+				return errors.New("database exploded")
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   map[string]any{"status": "error", "error": "Internal Server Error"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/test", nil)
+			cd := common.NewCoreData(context.Background(), nil, nil)
+			req = req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+			rr := httptest.NewRecorder()
+
+			APITaskHandler(apiTestTask{TaskString: "Test", action: tt.action})(rr, req)
+
+			if rr.Code != tt.wantStatus {
+				t.Fatalf("status: got %d want %d", rr.Code, tt.wantStatus)
+			}
+			if ct := rr.Header().Get("Content-Type"); ct != "application/json" {
+				t.Fatalf("content type: got %q", ct)
+			}
+			var got map[string]any
+			if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+				t.Fatalf("decode: %v", err)
+			}
+			for k, v := range tt.wantBody {
+				if got[k] != v {
+					t.Errorf("%s: got %v want %v", k, got[k], v)
+				}
+			}
+		})
+	}
+}
@@ -15,6 +15,9 @@ import (
 // EmailAssociationRequestTask allows a user to request an email association.
 type EmailAssociationRequestTask struct{ tasks.TaskString }
 
+// Confidential reports true: association requests carry the claimed address and contact details.
+func (EmailAssociationRequestTask) Confidential() bool { return true }
+
 var (
 	_ tasks.Task                       = (*EmailAssociationRequestTask)(nil)
 	_ tasks.AuditableTask              = (*EmailAssociationRequestTask)(nil)
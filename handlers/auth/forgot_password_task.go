@@ -21,6 +21,9 @@ type ForgotPasswordTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: the event carries the reset code and link.
+func (ForgotPasswordTask) Confidential() bool { return true }
+
 var (
 	_ tasks.Task                             = (*ForgotPasswordTask)(nil)
 	_ tasks.AuditableTask                    = (*ForgotPasswordTask)(nil)
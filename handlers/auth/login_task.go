@@ -26,6 +26,9 @@ type LoginTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: login events must never leave the server.
+func (LoginTask) Confidential() bool { return true }
+
 // loginTask handles login requests.
 var loginTask = &LoginTask{TaskString: TaskLogin}
 
@@ -56,16 +59,9 @@ func (LoginTask) Action(w http.ResponseWriter, r *http.Request) any {
 
 	queries := cd.Queries()
 
-	cfg := cd.Config
 	ip := strings.Split(r.RemoteAddr, ":")[0]
-	if cfg.LoginAttemptThreshold > 0 {
-		since := time.Now().Add(-time.Duration(cfg.LoginAttemptWindow) * time.Minute)
-		cnt, err := queries.SystemCountRecentLoginAttempts(r.Context(), db.SystemCountRecentLoginAttemptsParams{Username: username, IpAddress: ip, CreatedAt: since})
-		if err != nil {
-			log.Printf("count login attempts: %v", err)
-		} else if cnt >= int64(cfg.LoginAttemptThreshold) {
-			return loginFormHandler{msg: "Too many failed attempts"}
-		}
+	if tooManyLoginAttempts(r, cd, username, ip) {
+		return loginFormHandler{msg: "Too many failed attempts"}
 	}
 
 	row, err := cd.UserCredentials(username)
@@ -124,12 +120,22 @@ func (LoginTask) Action(w http.ResponseWriter, r *http.Request) any {
 		}
 	}
 
+	if res := requireSecondFactor(w, r, cd, row.Idusers, username); res != nil {
+		return res
+	}
+
+	return completeLogin(w, r, cd, row.Idusers)
+}
+
+// completeLogin stores uid in the session and redirects to the page the user
+// was trying to reach.
+func completeLogin(w http.ResponseWriter, r *http.Request, cd *common.CoreData, uid int32) any {
 	session := cd.GetSession()
-	session.Values["UID"] = int32(row.Idusers)
+	session.Values["UID"] = uid
 	session.Values["LoginTime"] = time.Now().Unix()
 	session.Values["ExpiryTime"] = time.Now().AddDate(1, 0, 0).Unix()
 
-	backURL, _ := r.Context().Value(consts.KeyCoreData).(*common.CoreData).SanitizeBackURL(r, r.FormValue("back"))
+	backURL, _ := cd.SanitizeBackURL(r, r.FormValue("back"))
 	backMethod := r.FormValue("method")
 	backData := r.FormValue("data")
 
@@ -138,7 +144,7 @@ func (LoginTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}
 
 	if cd.Config.LogFlags&config.LogFlagAuth != 0 {
-		log.Printf("login success uid=%d session=%s", row.Idusers, handlers.HashSessionID(session.ID))
+		log.Printf("login success uid=%d session=%s", uid, handlers.HashSessionID(session.ID))
 	}
 
 	if backURL != "" {
@@ -0,0 +1,219 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/sessions"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/totp"
+)
+
+// LoginTwoFactorTask checks the second factor after a password login.
+type LoginTwoFactorTask struct {
+	tasks.TaskString
+}
+
+// Confidential reports true: two factor logins are authentication events.
+func (LoginTwoFactorTask) Confidential() bool { return true }
+
+// loginTwoFactorTask handles submitted TOTP and recovery codes.
+var loginTwoFactorTask = &LoginTwoFactorTask{TaskString: TaskLoginTwoFactor}
+
+var _ tasks.Task = (*LoginTwoFactorTask)(nil)
+var _ tasks.TemplatesRequired = (*LoginTwoFactorTask)(nil)
+
+const (
+	templateLoginTwoFactorPage = "pages/auth/loginTwoFactorPage.gohtml"
+	templateRecoveryCodesPage  = "pages/auth/recoveryCodesPage.gohtml"
+
+	// twoFactorPendingTTL bounds how long a password login may wait for its
+	// second factor.
+	twoFactorPendingTTL = 5 * time.Minute
+
+	sessionTwoFactorUID      = "TwoFactorUID"
+	sessionTwoFactorUsername = "TwoFactorUsername"
+	sessionTwoFactorTime     = "TwoFactorTime"
+)
+
+type twoFactorPageData struct {
+	Enrol   bool
+	Secret  string
+	URI     string
+	QR      template.URL
+	Back    string
+	BackSig string
+	BackTS  string
+	Method  string
+	Data    string
+}
+
+type recoveryCodesPageData struct {
+	Codes    []string
+	Continue string
+}
+
+// requireSecondFactor parks a password login in the session when uid has TOTP
+// enabled or belongs to a role requiring it. It returns nil when no second
+// factor is needed.
+func requireSecondFactor(w http.ResponseWriter, r *http.Request, cd *common.CoreData, uid int32, username string) any {
+	enabled, err := cd.TOTPEnabled(uid)
+	if err != nil {
+		return fmt.Errorf("totp lookup %w", err)
+	}
+	if !enabled && !cd.TwoFactorRequired(uid) {
+		return nil
+	}
+	session := cd.GetSession()
+	session.Values[sessionTwoFactorUID] = uid
+	session.Values[sessionTwoFactorUsername] = username
+	session.Values[sessionTwoFactorTime] = time.Now().Unix()
+	if err := session.Save(r, w); err != nil {
+		return fmt.Errorf("session save %w", err)
+	}
+	return twoFactorPage(r, cd, uid, username, enabled)
+}
+
+// twoFactorPage renders the code prompt. Users without a confirmed secret are
+// shown enrolment details instead.
+func twoFactorPage(r *http.Request, cd *common.CoreData, uid int32, username string, enabled bool) any {
+	cd.PageTitle = "Two-Factor Authentication"
+	backURL, _ := cd.SanitizeBackURL(r, r.FormValue("back"))
+	data := twoFactorPageData{
+		Enrol:   !enabled,
+		Back:    backURL,
+		BackSig: r.FormValue("back_sig"),
+		BackTS:  r.FormValue("back_ts"),
+		Method:  r.FormValue("method"),
+		Data:    r.FormValue("data"),
+	}
+	if !enabled {
+		row, err := cd.TOTPForUser(uid)
+		if err != nil {
+			return fmt.Errorf("totp lookup %w", err)
+		}
+		if row != nil {
+			data.Secret = row.Secret
+		} else if data.Secret, err = cd.BeginTOTPEnrolment(uid); err != nil {
+			return fmt.Errorf("begin totp %w", err)
+		}
+		data.URI = totp.ProvisioningURI(cd.TOTPIssuer(), username, data.Secret)
+		if qr, err := totp.QRDataURI(data.URI); err == nil {
+			data.QR = template.URL(qr)
+		} else {
+			log.Printf("totp qr: %v", err)
+		}
+	}
+	return handlers.TemplateWithDataHandler(templateLoginTwoFactorPage, data)
+}
+
+func clearPendingTwoFactor(session *sessions.Session) {
+	delete(session.Values, sessionTwoFactorUID)
+	delete(session.Values, sessionTwoFactorUsername)
+	delete(session.Values, sessionTwoFactorTime)
+}
+
+// Action verifies the submitted code and completes the pending login.
+func (LoginTwoFactorTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	session := cd.GetSession()
+	uid, _ := session.Values[sessionTwoFactorUID].(int32)
+	username, _ := session.Values[sessionTwoFactorUsername].(string)
+	started, _ := session.Values[sessionTwoFactorTime].(int64)
+	if uid == 0 || time.Since(time.Unix(started, 0)) > twoFactorPendingTTL {
+		clearPendingTwoFactor(session)
+		if err := session.Save(r, w); err != nil {
+			return fmt.Errorf("session save %w", err)
+		}
+		return loginFormHandler{msg: "Login expired, please sign in again"}
+	}
+
+	ip := strings.Split(r.RemoteAddr, ":")[0]
+	if tooManyLoginAttempts(r, cd, username, ip) {
+		clearPendingTwoFactor(session)
+		if err := session.Save(r, w); err != nil {
+			return fmt.Errorf("session save %w", err)
+		}
+		return loginFormHandler{msg: "Too many failed attempts"}
+	}
+
+	enabled, err := cd.TOTPEnabled(uid)
+	if err != nil {
+		return fmt.Errorf("totp lookup %w", err)
+	}
+	code := r.PostFormValue("code")
+	var recovery []string
+	if enabled {
+		ok, err := cd.VerifySecondFactor(uid, code)
+		if err != nil {
+			return fmt.Errorf("verify second factor %w", err)
+		}
+		if !ok {
+			recordFailedLogin(r, cd, username, ip)
+			cd.SetCurrentError("Invalid code")
+			return twoFactorPage(r, cd, uid, username, enabled)
+		}
+	} else {
+		recovery, err = cd.ConfirmTOTPEnrolment(uid, code)
+		if errors.Is(err, common.ErrInvalidTOTPCode) {
+			recordFailedLogin(r, cd, username, ip)
+			cd.SetCurrentError("Invalid code")
+			return twoFactorPage(r, cd, uid, username, enabled)
+		}
+		if err != nil {
+			return fmt.Errorf("confirm totp %w", err)
+		}
+	}
+
+	clearPendingTwoFactor(session)
+	res := completeLogin(w, r, cd, uid)
+	if _, failed := res.(error); failed || recovery == nil {
+		return res
+	}
+	next := "/"
+	if backURL, _ := cd.SanitizeBackURL(r, r.FormValue("back")); backURL != "" && (r.FormValue("method") == "" || r.FormValue("method") == http.MethodGet) {
+		next = backURL
+	}
+	cd.PageTitle = "Recovery Codes"
+	return handlers.TemplateWithDataHandler(templateRecoveryCodesPage, recoveryCodesPageData{Codes: recovery, Continue: next})
+}
+
+// RequiredTemplates declares the templates used by this task's pages.
+func (LoginTwoFactorTask) RequiredTemplates() []tasks.Template {
+	return []tasks.Template{
+		tasks.Template(templateLoginTwoFactorPage),
+		tasks.Template(templateRecoveryCodesPage),
+	}
+}
+
+// tooManyLoginAttempts reports whether recent failures for username or ip
+// exceed the configured threshold.
+func tooManyLoginAttempts(r *http.Request, cd *common.CoreData, username, ip string) bool {
+	cfg := cd.Config
+	if cfg.LoginAttemptThreshold <= 0 {
+		return false
+	}
+	since := time.Now().Add(-time.Duration(cfg.LoginAttemptWindow) * time.Minute)
+	cnt, err := cd.Queries().SystemCountRecentLoginAttempts(r.Context(), db.SystemCountRecentLoginAttemptsParams{Username: username, IpAddress: ip, CreatedAt: since})
+	if err != nil {
+		log.Printf("count login attempts: %v", err)
+		return false
+	}
+	return cnt >= int64(cfg.LoginAttemptThreshold)
+}
+
+func recordFailedLogin(r *http.Request, cd *common.CoreData, username, ip string) {
+	if err := cd.Queries().SystemInsertLoginAttempt(r.Context(), db.SystemInsertLoginAttemptParams{Username: username, IpAddress: ip}); err != nil {
+		log.Printf("insert login attempt: %v", err)
+	}
+}
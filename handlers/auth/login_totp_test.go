@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/sessions"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/testhelpers"
+	"github.com/arran4/goa4web/internal/totp"
+)
+
+const testTOTPSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func enabledTOTP() *db.UserTotp {
+	return &db.UserTotp{
+		UsersIdusers: 1,
+		Secret:       testTOTPSecret,
+		EnabledAt:    sql.NullTime{Time: time.Now(), Valid: true},
+	}
+}
+
+func newTwoFactorRequest(t *testing.T, q db.Querier, path string, form url.Values) (*http.Request, *common.CoreData, *sessions.Session) {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.RemoteAddr = "1.2.3.4:1111"
+	store := sessions.NewCookieStore([]byte("test"))
+	core.Store = store
+	core.SessionName = "test-session"
+	session, _ := store.New(req, core.SessionName)
+	cd := common.NewCoreData(req.Context(), q, config.NewRuntimeConfig(), common.WithSession(session))
+	return req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd)), cd, session
+}
+
+func TestLoginTaskPromptsForSecondFactor(t *testing.T) {
+	q := testhelpers.NewQuerierStub()
+	pwHash, alg, _ := HashPassword("pw")
+	q.SystemGetLoginFn = func(ctx context.Context, username sql.NullString) (*db.SystemGetLoginRow, error) {
+		return &db.SystemGetLoginRow{
+			Idusers:         1,
+			Passwd:          sql.NullString{String: pwHash, Valid: true},
+			PasswdAlgorithm: sql.NullString{String: alg, Valid: true},
+			Username:        sql.NullString{String: "bob", Valid: true},
+		}, nil
+	}
+	q.GetLoginRoleForUserReturns = 1
+	q.GetTOTPForUserReturns = enabledTOTP()
+
+	req, _, session := newTwoFactorRequest(t, q, "/login", url.Values{"username": {"bob"}, "password": {"pw"}})
+	rr := httptest.NewRecorder()
+	handlers.TaskHandler(loginTask)(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status=%d", rr.Code)
+	}
+	if _, ok := session.Values["UID"]; ok {
+		t.Fatalf("user logged in before second factor")
+	}
+	if uid, _ := session.Values[sessionTwoFactorUID].(int32); uid != 1 {
+		t.Fatalf("pending uid=%v", session.Values[sessionTwoFactorUID])
+	}
+	if !strings.Contains(rr.Body.String(), `action="/login/2fa"`) {
+		t.Fatalf("missing 2fa form: %q", rr.Body.String())
+	}
+}
+
+func TestLoginTwoFactorTask(t *testing.T) {
+	t.Run("Happy Path - Valid Code", func(t *testing.T) {
+		q := testhelpers.NewQuerierStub()
+		q.GetTOTPForUserReturns = enabledTOTP()
+		q.UpdateTOTPLastUsedStepReturns = 1
+		code, err := totp.CodeAt(testTOTPSecret, totp.Step(time.Now()))
+		if err != nil {
+			t.Fatalf("code: %v", err)
+		}
+
+		req, cd, session := newTwoFactorRequest(t, q, "/login/2fa", url.Values{"code": {code}})
+		session.Values[sessionTwoFactorUID] = int32(1)
+		session.Values[sessionTwoFactorUsername] = "bob"
+		session.Values[sessionTwoFactorTime] = time.Now().Unix()
+		rr := httptest.NewRecorder()
+		handlers.TaskHandler(loginTwoFactorTask)(rr, req)
+
+		if uid, _ := session.Values["UID"].(int32); uid != 1 {
+			t.Fatalf("UID=%v", session.Values["UID"])
+		}
+		if _, ok := session.Values[sessionTwoFactorUID]; ok {
+			t.Fatalf("pending state not cleared")
+		}
+		if cd.AutoRefresh == "" {
+			t.Fatalf("expected redirect")
+		}
+	})
+
+	t.Run("Unhappy Path - Wrong Code", func(t *testing.T) {
+		q := testhelpers.NewQuerierStub()
+		q.GetTOTPForUserReturns = enabledTOTP()
+
+		req, _, session := newTwoFactorRequest(t, q, "/login/2fa", url.Values{"code": {"000000x"}})
+		session.Values[sessionTwoFactorUID] = int32(1)
+		session.Values[sessionTwoFactorUsername] = "bob"
+		session.Values[sessionTwoFactorTime] = time.Now().Unix()
+		rr := httptest.NewRecorder()
+		handlers.TaskHandler(loginTwoFactorTask)(rr, req)
+
+		if _, ok := session.Values["UID"]; ok {
+			t.Fatalf("user logged in with wrong code")
+		}
+		if len(q.SystemInsertLoginAttemptCalls) != 1 {
+			t.Fatalf("login attempts=%d", len(q.SystemInsertLoginAttemptCalls))
+		}
+		if !strings.Contains(rr.Body.String(), "Invalid code") {
+			t.Fatalf("missing error: %q", rr.Body.String())
+		}
+	})
+
+	t.Run("Unhappy Path - Expired", func(t *testing.T) {
+		q := testhelpers.NewQuerierStub()
+
+		req, _, session := newTwoFactorRequest(t, q, "/login/2fa", url.Values{"code": {"123456"}})
+		session.Values[sessionTwoFactorUID] = int32(1)
+		session.Values[sessionTwoFactorTime] = time.Now().Add(-time.Hour).Unix()
+		rr := httptest.NewRecorder()
+		handlers.TaskHandler(loginTwoFactorTask)(rr, req)
+
+		if _, ok := session.Values["UID"]; ok {
+			t.Fatalf("user logged in after expiry")
+		}
+		if len(q.GetTOTPForUserCalls) != 0 {
+			t.Fatalf("unexpected totp lookup")
+		}
+		if !strings.Contains(rr.Body.String(), "Login expired") {
+			t.Fatalf("missing expiry message: %q", rr.Body.String())
+		}
+	})
+}
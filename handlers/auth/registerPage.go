@@ -22,6 +22,9 @@ type RegisterTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: registration events carry the new account's details.
+func (RegisterTask) Confidential() bool { return true }
+
 // registerTask handles user registration.
 var registerTask = &RegisterTask{TaskString: TaskRegister}
 
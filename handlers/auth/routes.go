@@ -20,6 +20,7 @@ func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []nav.RouterOptions
 	lr := r.PathPrefix("/login").Subrouter()
 	lr.HandleFunc("", handlers.WithNoCache(loginTask.Page)).Methods("GET").MatcherFunc(gml.Not(handlers.RequiresAnAccount()))
 	lr.HandleFunc("", handlers.TaskHandler(loginTask)).Methods("POST").MatcherFunc(gml.Not(handlers.RequiresAnAccount())).MatcherFunc(loginTask.Matcher())
+	lr.HandleFunc("/2fa", handlers.TaskHandler(loginTwoFactorTask)).Methods("POST").MatcherFunc(gml.Not(handlers.RequiresAnAccount())).MatcherFunc(loginTwoFactorTask.Matcher())
 	lr.HandleFunc("/verify", handlers.TaskHandler(verifyPasswordTask)).Methods("POST").MatcherFunc(gml.Not(handlers.RequiresAnAccount())).MatcherFunc(verifyPasswordTask.Matcher())
 
 	lr.HandleFunc("/passkey/begin", HasWebAuthn(handlers.WithNoCache(loginPasskeyBegin))).Methods("GET")
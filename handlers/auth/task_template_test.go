@@ -13,6 +13,7 @@ func TestAuthTasksTemplatesExist(t *testing.T) {
 		task tasks.TemplatesRequired
 	}{
 		{"LoginTask", &LoginTask{}},
+		{"LoginTwoFactorTask", &LoginTwoFactorTask{}},
 		{"ForgotPasswordTask", &ForgotPasswordTask{}},
 	}
 
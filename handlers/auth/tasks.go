@@ -13,6 +13,9 @@ const (
 	// TaskLogin performs a user login.
 	TaskLogin tasks.TaskString = "Login"
 
+	// TaskLoginTwoFactor checks the second factor of a password login.
+	TaskLoginTwoFactor tasks.TaskString = "Verify Code"
+
 	// TaskRegister registers a new user account.
 	TaskRegister = "Register"
 
@@ -14,6 +14,9 @@ type VerifyPasswordTask struct {
 	tasks.TaskString
 }
 
+// Confidential reports true: password verification is an authentication event.
+func (VerifyPasswordTask) Confidential() bool { return true }
+
 // verifyPasswordTask handles password verification requests.
 var verifyPasswordTask = &VerifyPasswordTask{TaskString: TaskPasswordVerify}
 
@@ -0,0 +1,86 @@
+package blogs
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/gorilla/mux"
+)
+
+// APIListEntries handles GET /api/blogs/entries
+func APIListEntries(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	page := handlers.APIPage(r)
+	pageSize := cd.PageSize()
+
+	entries, err := cd.BlogListPage(int32((page-1)*pageSize), int32(pageSize+1))
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	hasMore := len(entries) > pageSize
+	if hasMore {
+		entries = entries[:pageSize]
+	}
+
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"entries":  entries,
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APIShowEntry handles GET /api/blogs/entry/{blog}
+func APIShowEntry(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.LoadSelectionsFromRequest(r)
+	blogID, err := strconv.Atoi(mux.Vars(r)["blog"])
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusBadRequest, "Invalid blog ID")
+		return
+	}
+	if !cd.HasGrant("blogs", "entry", "view", int32(blogID)) {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Blog entry not found")
+		return
+	}
+	blog, err := cd.BlogPost()
+	if err != nil || blog == nil {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Blog entry not found")
+		return
+	}
+
+	comments, err := cd.BlogComments()
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	page := handlers.APIPage(r)
+	comments, hasMore := handlers.APIPaginate(comments, page, cd.PageSize())
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"entry":    blog,
+		"comments": handlers.APIComments(cd, r, comments),
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APICreateEntry handles POST /api/blogs/entries
+func APICreateEntry(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if !cd.IsAdmin() && !cd.HasGrant("blogs", "entry", "post", 0) {
+		handlers.WriteJSONError(w, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+		return
+	}
+	handlers.APITaskHandler(addBlogTask)(w, r)
+}
+
+// APIPostComment handles POST /api/blogs/entry/{blog}/reply
+func APIPostComment(w http.ResponseWriter, r *http.Request) {
+	handlers.APITaskHandler(replyBlogTask)(w, r)
+}
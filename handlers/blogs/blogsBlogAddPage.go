@@ -7,6 +7,7 @@ import (
 
 	"github.com/arran4/goa4web/core/consts"
 
+	"github.com/arran4/goa4web/internal/activitypub"
 	"github.com/arran4/goa4web/internal/db"
 
 	"net/http"
@@ -29,6 +30,7 @@ var _ tasks.Task = (*AddBlogTask)(nil)
 var _ notif.SubscribersNotificationTemplateProvider = (*AddBlogTask)(nil)
 var _ notif.AdminEmailTemplateProvider = (*AddBlogTask)(nil)
 var _ notif.GrantsRequiredProvider = (*AddBlogTask)(nil)
+var _ notif.MentionsNotificationProvider = (*AddBlogTask)(nil)
 var _ tasks.EmailTemplatesRequired = (*AddBlogTask)(nil)
 
 func (AddBlogTask) AdminEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
@@ -40,18 +42,28 @@ func (AddBlogTask) AdminInternalNotificationTemplate(evt eventbus.TaskEvent) *st
 	return &v
 }
 
+// SubscribedEmailTemplate stays quiet for scheduled entries; the publish
+// worker notifies subscribers once the entry goes live.
 func (AddBlogTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	if common.EventScheduled(evt) {
+		return nil, false
+	}
 	return EmailTemplateBlogAdd.EmailTemplates(), true
 }
 
 func (AddBlogTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	if common.EventScheduled(evt) {
+		return nil
+	}
 	s := NotificationTemplateBlogAdd.NotificationTemplate()
 	return &s
 }
 
 func (AddBlogTask) RequiredTemplates() []tasks.Template {
-	return append([]tasks.Template{tasks.Template(BlogsBlogAddPageTmpl)},
+	r := append([]tasks.Template{tasks.Template(BlogsBlogAddPageTmpl)},
 		append(EmailTemplateAdminNotificationBlogAdd.RequiredTemplates(), EmailTemplateBlogAdd.RequiredTemplates()...)...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 // GrantsRequired implements notif.GrantsRequiredProvider for new blog entries.
@@ -63,6 +75,22 @@ func (AddBlogTask) GrantsRequired(evt eventbus.TaskEvent) ([]notif.GrantRequirem
 }
 
 func (AddBlogTask) Page(w http.ResponseWriter, r *http.Request) { BlogAddPage(w, r) }
+
+// MentionEmailTemplate emails users mentioned with @username.
+func (AddBlogTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (AddBlogTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (AddBlogTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return AddBlogTask{}.GrantsRequired(evt)
+}
+
 func (AddBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 	if err := handlers.ValidateForm(r, []string{"language", "text"}, []string{"language", "text"}); err != nil {
 		return fmt.Errorf("validation fail %w", err)
@@ -73,6 +101,10 @@ func (AddBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}
 	text := r.PostFormValue("text")
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	publishAt, err := cd.ParsePublishAt(r.PostFormValue("publish_at"))
+	if err != nil {
+		return fmt.Errorf("publish at parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
 	queries := cd.Queries()
 	session := cd.GetSession()
 	uid, _ := session.Values["UID"].(int32)
@@ -95,6 +127,13 @@ func (AddBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 	if err != nil {
 		return fmt.Errorf("blog create fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
+	scheduled, err := cd.SchedulePublication(common.ScheduledTypeBlog, int32(id), uid, publishAt)
+	if err != nil {
+		return fmt.Errorf("schedule blog fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if scheduled {
+		cd.MarkEventScheduled()
+	}
 
 	if cd, ok := r.Context().Value(consts.KeyCoreData).(*common.CoreData); ok {
 		if evt := cd.Event(); evt != nil {
@@ -103,9 +142,14 @@ func (AddBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 			}
 			evt.Data["PostURL"] = cd.AbsoluteURL(fmt.Sprintf("/blogs/blog/%d", id))
 			evt.Data["target"] = notif.Target{Type: "blog", ID: int32(id)}
+			evt.Data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeBlog, ItemID: int32(id)}
 		}
 	}
 
+	if !scheduled {
+		cd.RecordMentions(text, fmt.Sprintf("/blogs/blog/%d", id))
+	}
+
 	return handlers.RedirectHandler(fmt.Sprintf("/blogs/blog/%d", id))
 }
 
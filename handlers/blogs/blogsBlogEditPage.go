@@ -7,6 +7,7 @@ import (
 	"github.com/arran4/goa4web/core/consts"
 	"github.com/arran4/goa4web/internal/eventbus"
 
+	"github.com/arran4/goa4web/internal/activitypub"
 	"github.com/arran4/goa4web/internal/db"
 
 	"net/http"
@@ -68,6 +69,7 @@ func (EditBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 		return fmt.Errorf("validate images: %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
+	before := cd.RevisionSnapshot(common.RevisionTypeBlog, row.Idblogs)
 	if err = queries.UpdateBlogEntryForWriter(r.Context(), db.UpdateBlogEntryForWriterParams{
 		EntryID:      row.Idblogs,
 		GrantEntryID: sql.NullInt32{Int32: row.Idblogs, Valid: true},
@@ -81,17 +83,30 @@ func (EditBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}); err != nil {
 		return fmt.Errorf("update blog fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
+	cd.RecordRevision(before)
 
 	if err := cd.SetBlogAuthorLabels(row.Idblogs, labels); err != nil {
 		return fmt.Errorf("set author labels fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
+	// The field is only offered while the entry is still scheduled.
+	if _, ok := r.PostForm["publish_at"]; ok {
+		publishAt, err := cd.ParsePublishAt(r.PostFormValue("publish_at"))
+		if err != nil {
+			return fmt.Errorf("publish at parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+		if _, err := cd.ReschedulePublication(common.ScheduledTypeBlog, row.Idblogs, publishAt); err != nil {
+			return fmt.Errorf("reschedule blog fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+	}
+
 	if cd, ok := r.Context().Value(consts.KeyCoreData).(*common.CoreData); ok {
 		if evt := cd.Event(); evt != nil {
 			if evt.Data == nil {
 				evt.Data = map[string]any{}
 			}
 			evt.Data["PostURL"] = cd.AbsoluteURL(fmt.Sprintf("/blogs/blog/%d", row.Idblogs))
+			evt.Data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeBlog, ItemID: row.Idblogs}
 		}
 	}
 
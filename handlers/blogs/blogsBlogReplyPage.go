@@ -31,11 +31,23 @@ var replyBlogTask = &ReplyBlogTask{TaskString: TaskReply}
 var (
 	_ tasks.Task                                    = (*ReplyBlogTask)(nil)
 	_ notif.SubscribersNotificationTemplateProvider = (*ReplyBlogTask)(nil)
+	_ notif.EmailReplyProvider                      = (*ReplyBlogTask)(nil)
 	_ notif.AutoSubscribeProvider                   = (*ReplyBlogTask)(nil)
 	_ notif.GrantsRequiredProvider                  = (*ReplyBlogTask)(nil)
+	_ notif.MentionsNotificationProvider            = (*ReplyBlogTask)(nil)
 	_ tasks.EmailTemplatesRequired                  = (*ReplyBlogTask)(nil)
 )
 
+// EmailReplyPath posts email replies to the blog's comment form, also when
+// the comment came in through the API.
+func (ReplyBlogTask) EmailReplyPath(evt eventbus.TaskEvent) (string, bool) {
+	target, ok := evt.Data["target"].(notif.Target)
+	if !ok || evt.Outcome != eventbus.TaskOutcomeSuccess {
+		return "", false
+	}
+	return fmt.Sprintf("/blogs/blog/%d/reply", target.ID), true
+}
+
 func (ReplyBlogTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
 	return EmailTemplateBlogReply.EmailTemplates(), evt.Outcome == eventbus.TaskOutcomeSuccess
 }
@@ -49,7 +61,9 @@ func (ReplyBlogTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEve
 }
 
 func (ReplyBlogTask) RequiredTemplates() []tasks.Template {
-	return EmailTemplateBlogReply.RequiredTemplates()
+	r := EmailTemplateBlogReply.RequiredTemplates()
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 // GrantsRequired implements notif.GrantsRequiredProvider for blog replies.
@@ -93,6 +107,21 @@ func (ReplyBlogTask) IndexData(data map[string]any) []searchworker.IndexEventDat
 
 var _ searchworker.IndexedTask = ReplyBlogTask{}
 
+// MentionEmailTemplate emails users mentioned with @username.
+func (ReplyBlogTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (ReplyBlogTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (ReplyBlogTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return ReplyBlogTask{}.GrantsRequired(evt)
+}
+
 func (ReplyBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	cd.LoadSelectionsFromRequest(r)
@@ -216,6 +245,7 @@ func (ReplyBlogTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}); err != nil {
 		log.Printf("blog reply side effects: %v", err)
 	}
+	cd.RecordMentions(text, endUrl)
 
 	return handlers.RedirectHandler(endUrl)
 }
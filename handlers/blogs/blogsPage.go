@@ -153,6 +153,8 @@ func FeedGen(r *http.Request, queries db.Querier, uid int, username string) (*fe
 		Created:     time.Date(2005, 6, 25, 0, 0, 0, 0, time.UTC),
 	}
 
+	// Scheduled entries are left out: feeds only carry live entries, even
+	// for the author.
 	rows, err := queries.ListBlogEntriesByAuthorForLister(r.Context(), db.ListBlogEntriesByAuthorForListerParams{
 		AuthorID: int32(uid),
 		ListerID: cd.UserID,
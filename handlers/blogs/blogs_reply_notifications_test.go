@@ -271,3 +271,23 @@ func getEmailBody(t *testing.T, msg *mail.Message) string {
 	}
 	return buf.String()
 }
+
+func TestReplyBlogTaskEmailReplyPath(t *testing.T) {
+	data := map[string]any{
+		"target": notifications.Target{Type: "blog", ID: 42},
+	}
+	cases := map[string]string{
+		"/blogs/blog/42/reply":      "/blogs/blog/42/reply",
+		"/api/blogs/entry/42/reply": "/blogs/blog/42/reply",
+	}
+	for evtPath, want := range cases {
+		evt := eventbus.TaskEvent{Data: data, Path: evtPath, Outcome: eventbus.TaskOutcomeSuccess}
+		path, ok := replyBlogTask.EmailReplyPath(evt)
+		if !ok || path != want {
+			t.Errorf("EmailReplyPath(%q)=%q,%v want %q", evtPath, path, ok, want)
+		}
+	}
+	if _, ok := replyBlogTask.EmailReplyPath(eventbus.TaskEvent{Data: data, Path: "/blogs/blog/42/reply"}); ok {
+		t.Errorf("EmailReplyPath offered for a failed reply")
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/arran4/goa4web/config"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/middleware/apiauth"
 	"github.com/arran4/goa4web/internal/router"
 
 	"github.com/arran4/goa4web/handlers/share"
@@ -53,6 +54,19 @@ func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []navpkg.RouterOptio
 
 	api := r.PathPrefix("/api/blogs").Subrouter()
 	api.HandleFunc("/share", share.ShareLink).Methods("GET")
+
+	rest := api.NewRoute().Subrouter()
+	rest.Use(apiauth.APIKeyAuthMiddleware)
+
+	apiRead := rest.NewRoute().Subrouter()
+	apiRead.Use(apiauth.RequireScope("blogs:read"))
+	apiRead.HandleFunc("/entries", APIListEntries).Methods(http.MethodGet)
+	apiRead.HandleFunc("/entry/{blog}", APIShowEntry).Methods(http.MethodGet)
+
+	apiWrite := rest.NewRoute().Subrouter()
+	apiWrite.Use(apiauth.RequireScope("blogs:write"))
+	apiWrite.HandleFunc("/entries", APICreateEntry).Methods(http.MethodPost)
+	apiWrite.HandleFunc("/entry/{blog}/reply", APIPostComment).Methods(http.MethodPost)
 	return opts
 }
 
@@ -61,4 +75,5 @@ func Register(reg *router.Registry) {
 	reg.RegisterModule("blogs", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
 		return RegisterRoutes(r, cfg)
 	})
+	reg.RegisterSitemap("blogs", sitemapEntries)
 }
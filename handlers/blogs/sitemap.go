@@ -0,0 +1,26 @@
+package blogs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// sitemapEntries lists the blogs index and every entry anonymous visitors may
+// open.
+func sitemapEntries(ctx context.Context, q db.Querier) ([]sitemap.Entry, error) {
+	rows, err := q.SystemListSitemapBlogEntries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list blog entries: %w", err)
+	}
+	entries := make([]sitemap.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, sitemap.Entry{
+			Path:    fmt.Sprintf("/blogs/blog/%d", row.Idblogs),
+			LastMod: row.Written,
+		})
+	}
+	return append([]sitemap.Entry{{Path: "/blogs", LastMod: sitemap.Latest(entries)}}, entries...), nil
+}
@@ -0,0 +1,76 @@
+package emailevents
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/emailbounce"
+)
+
+const testKey = "link-key"
+
+func newTestRouter(q db.Querier) http.Handler {
+	cfg := &config.RuntimeConfig{}
+	r := mux.NewRouter()
+	RegisterRoutes(r, cfg)
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		cd := common.NewCoreData(req.Context(), q, cfg, common.WithLinkSignKey(testKey))
+		r.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd)))
+	})
+}
+
+func TestSendGridEvents(t *testing.T) {
+	q := &db.QuerierStub{
+		GetUserEmailByEmailFn: func(_ context.Context, email string) (*db.UserEmail, error) {
+			return &db.UserEmail{ID: 4, UserID: 2, Email: email}, nil
+		},
+	}
+	h := newTestRouter(q)
+	body := `[{"email":"gone@example.org","event":"bounce","type":"bounce","reason":"550 unknown"}]`
+
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/email/events/sendgrid/"+emailbounce.WebhookToken(testKey, "sendgrid"), strings.NewReader(body)))
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("status %d: %s", rr.Code, rr.Body.String())
+	}
+	if len(q.SystemInsertEmailBounceCalls) != 1 || len(q.SystemSuppressEmailCalls) != 1 {
+		t.Fatalf("calls %+v %+v", q.SystemInsertEmailBounceCalls, q.SystemSuppressEmailCalls)
+	}
+	if got := q.SystemInsertEmailBounceCalls[0]; got.UserEmailID != 4 || got.Source != "sendgrid" || got.Kind != "hard_bounce" {
+		t.Fatalf("bounce %+v", got)
+	}
+
+	rr = httptest.NewRecorder()
+	h.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/email/events/sendgrid/"+emailbounce.WebhookToken(testKey, "sendgrid"), strings.NewReader("{")))
+	if rr.Code != http.StatusBadRequest {
+		t.Fatalf("malformed body status %d", rr.Code)
+	}
+}
+
+func TestEventsRejectsBadToken(t *testing.T) {
+	q := &db.QuerierStub{}
+	h := newTestRouter(q)
+	for _, path := range []string{
+		"/email/events/sendgrid/nope",
+		"/email/events/sendgrid/" + emailbounce.WebhookToken(testKey, "ses"),
+		"/email/events/sendgrid/" + emailbounce.WebhookToken("other", "sendgrid"),
+	} {
+		rr := httptest.NewRecorder()
+		h.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, path, strings.NewReader(`[{"email":"a@example.org","event":"spamreport"}]`)))
+		if rr.Code != http.StatusNotFound {
+			t.Errorf("%s: status %d", path, rr.Code)
+		}
+	}
+	if len(q.GetUserEmailByEmailCalls) != 0 {
+		t.Fatal("events recorded without a valid token")
+	}
+}
@@ -0,0 +1,65 @@
+package emailevents
+
+import (
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/emailbounce"
+)
+
+// maxEventBody bounds webhook posts; providers batch events well below this.
+const maxEventBody = 1 << 20
+
+// EventsPage records bounce and complaint events posted by an email
+// provider. The path token, issued by emailbounce.WebhookToken, stands in for
+// the providers' own signing schemes.
+func EventsPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	vars := mux.Vars(r)
+	provider := vars["provider"]
+	if !emailbounce.VerifyWebhookToken(cd.LinkSignKey, provider, vars["token"]) {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxEventBody))
+	if err != nil {
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	var evs []emailbounce.Event
+	switch provider {
+	case emailbounce.SourceSES:
+		var subscribeURL string
+		evs, subscribeURL, err = emailbounce.ParseSES(body)
+		if err == nil && subscribeURL != "" {
+			if err := emailbounce.ConfirmSubscription(r.Context(), cd.HTTPClient(), subscribeURL); err != nil {
+				log.Printf("email events %s: %v", provider, err)
+				http.Error(w, "Bad Request", http.StatusBadRequest)
+				return
+			}
+			log.Printf("email events %s: subscription confirmed", provider)
+		}
+	case emailbounce.SourceSendGrid:
+		evs, err = emailbounce.ParseSendGrid(body)
+	}
+	if err != nil {
+		log.Printf("email events %s: %v", provider, err)
+		http.Error(w, "Bad Request", http.StatusBadRequest)
+		return
+	}
+
+	p := &emailbounce.Processor{Queries: cd.Queries()}
+	if err := p.RecordAll(r.Context(), provider, evs); err != nil {
+		// The provider retries failed posts.
+		log.Printf("email events %s: %v", provider, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,22 @@
+package emailevents
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	nav "github.com/arran4/goa4web/internal/navigation"
+	"github.com/arran4/goa4web/internal/router"
+)
+
+// RegisterRoutes attaches the bounce and complaint webhooks to r.
+func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []nav.RouterOptions {
+	r.HandleFunc("/email/events/{provider:ses|sendgrid}/{token}", EventsPage).Methods("POST")
+	return nil
+}
+
+// Register registers the email events router module.
+func Register(reg *router.Registry) {
+	reg.RegisterModule("emailevents", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []nav.RouterOptions {
+		return RegisterRoutes(r, cfg)
+	})
+}
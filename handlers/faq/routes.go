@@ -64,4 +64,5 @@ func Register(reg *router.Registry) {
 	reg.RegisterModule("faq", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
 		return RegisterRoutes(r, cfg)
 	})
+	reg.RegisterSitemap("faq", sitemapEntries)
 }
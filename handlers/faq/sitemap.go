@@ -0,0 +1,26 @@
+package faq
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// sitemapEntries lists the FAQ page, which shows every answered question, dated
+// by the most recently updated question anonymous visitors may see.
+func sitemapEntries(ctx context.Context, q db.Querier) ([]sitemap.Entry, error) {
+	rows, err := q.SystemListSitemapFAQQuestions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list faq questions: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	questions := make([]sitemap.Entry, 0, len(rows))
+	for _, row := range rows {
+		questions = append(questions, sitemap.Entry{LastMod: row.UpdatedAt.Time})
+	}
+	return []sitemap.Entry{{Path: "/faq", LastMod: sitemap.Latest(questions)}}, nil
+}
@@ -0,0 +1,133 @@
+package forum
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/gorilla/mux"
+)
+
+// APIListTopics handles GET /api/forum/topics
+func APIListTopics(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	categoryID, _ := strconv.Atoi(r.URL.Query().Get("category"))
+
+	rows, err := cd.ForumTopics(int32(categoryID))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	// Private topics are served by the private forum API.
+	topics := make([]*db.GetForumTopicsForUserRow, 0, len(rows))
+	for _, row := range rows {
+		if row.Handler == "private" {
+			continue
+		}
+		topics = append(topics, row)
+	}
+
+	page := handlers.APIPage(r)
+	topics, hasMore := handlers.APIPaginate(topics, page, cd.PageSize())
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"topics":   topics,
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APIListThreads handles GET /api/forum/topic/{topic}/threads
+func APIListThreads(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	topic, ok := apiPublicTopic(w, r, cd)
+	if !ok {
+		return
+	}
+
+	rows, err := cd.ForumThreads(topic.Idforumtopic)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	page := handlers.APIPage(r)
+	threads, hasMore := handlers.APIPaginate(rows, page, cd.PageSize())
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"threads":  threads,
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APIShowComments handles GET /api/forum/topic/{topic}/thread/{thread}
+func APIShowComments(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	topic, ok := apiPublicTopic(w, r, cd)
+	if !ok {
+		return
+	}
+	threadID, err := strconv.Atoi(mux.Vars(r)["thread"])
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusBadRequest, "Invalid thread ID")
+		return
+	}
+	thread, err := cd.ForumThreadByID(int32(threadID))
+	if err != nil || thread == nil || thread.ForumtopicIdforumtopic != topic.Idforumtopic {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Thread not found")
+		return
+	}
+
+	comments, err := cd.ThreadComments(thread.Idforumthread)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	page := handlers.APIPage(r)
+	comments, hasMore := handlers.APIPaginate(comments, page, cd.PageSize())
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"comments": handlers.APIComments(cd, r, comments),
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APICreateThread handles POST /api/forum/topic/{topic}/threads
+func APICreateThread(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if _, ok := apiPublicTopic(w, r, cd); !ok {
+		return
+	}
+	handlers.APITaskHandler(createThreadTask)(w, r)
+}
+
+// APIPostComment handles POST /api/forum/topic/{topic}/thread/{thread}/reply
+func APIPostComment(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if _, ok := apiPublicTopic(w, r, cd); !ok {
+		return
+	}
+	RequireThreadAndTopic(http.HandlerFunc(handlers.APITaskHandler(replyTask))).ServeHTTP(w, r)
+}
+
+// apiPublicTopic loads the topic named in the URL, rejecting topics the user
+// cannot see and those belonging to the private forum.
+func apiPublicTopic(w http.ResponseWriter, r *http.Request, cd *common.CoreData) (*db.GetForumTopicByIdForUserRow, bool) {
+	topicID, err := strconv.Atoi(mux.Vars(r)["topic"])
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusBadRequest, "Invalid topic ID")
+		return nil, false
+	}
+	topic, err := cd.ForumTopicByID(int32(topicID))
+	if err != nil || topic == nil || topic.Handler == "private" {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Topic not found")
+		return nil, false
+	}
+	return topic, true
+}
@@ -0,0 +1,78 @@
+package forum
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/testhelpers"
+	"github.com/gorilla/mux"
+)
+
+type apiTopicsQuerier struct {
+	*db.QuerierStub
+	topics []*db.GetForumTopicsForUserRow
+}
+
+func (q apiTopicsQuerier) GetForumTopicsForUser(ctx context.Context, arg db.GetForumTopicsForUserParams) ([]*db.GetForumTopicsForUserRow, error) {
+	return q.topics, nil
+}
+
+func TestAPIListTopicsOmitsPrivateTopics(t *testing.T) {
+	q := apiTopicsQuerier{
+		QuerierStub: testhelpers.NewQuerierStub(),
+		topics: []*db.GetForumTopicsForUserRow{
+			{Idforumtopic: 1, Title: sql.NullString{String: "General", Valid: true}},
+			{Idforumtopic: 2, Title: sql.NullString{String: "Secret", Valid: true}, Handler: "private"},
+		},
+	}
+	cd := common.NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+	req := httptest.NewRequest(http.MethodGet, "/api/forum/topics", nil)
+	req = req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+	rr := httptest.NewRecorder()
+
+	APIListTopics(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status: got %d want %d", rr.Code, http.StatusOK)
+	}
+	var got struct {
+		Topics []struct {
+			Idforumtopic int32
+		} `json:"topics"`
+		HasMore bool `json:"has_more"`
+		Page    int  `json:"page"`
+	}
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(got.Topics) != 1 || got.Topics[0].Idforumtopic != 1 {
+		t.Fatalf("unexpected topics %+v", got.Topics)
+	}
+	if got.Page != 1 || got.HasMore {
+		t.Fatalf("unexpected pagination page=%d has_more=%v", got.Page, got.HasMore)
+	}
+}
+
+func TestAPIListThreadsRejectsPrivateTopic(t *testing.T) {
+	q := testhelpers.NewQuerierStub()
+	q.GetForumTopicByIdForUserReturns = &db.GetForumTopicByIdForUserRow{Idforumtopic: 5, Handler: "private"}
+	cd := common.NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+	req := httptest.NewRequest(http.MethodGet, "/api/forum/topic/5/threads", nil)
+	req = mux.SetURLVars(req, map[string]string{"topic": "5"})
+	req = req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+	rr := httptest.NewRecorder()
+
+	APIListThreads(rr, req)
+
+	if rr.Code != http.StatusNotFound {
+		t.Fatalf("status: got %d want %d", rr.Code, http.StatusNotFound)
+	}
+}
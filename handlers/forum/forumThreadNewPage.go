@@ -42,6 +42,7 @@ var (
 	_ notif.AdminEmailTemplateProvider              = (*CreateThreadTask)(nil)
 	_ notif.AutoSubscribeProvider                   = (*CreateThreadTask)(nil)
 	_ notif.GrantsRequiredProvider                  = (*CreateThreadTask)(nil)
+	_ notif.MentionsNotificationProvider            = (*CreateThreadTask)(nil)
 	_ tasks.EmailTemplatesRequired                  = (*CreateThreadTask)(nil)
 	_ searchworker.IndexedTask                      = CreateThreadTask{}
 )
@@ -74,8 +75,10 @@ func (CreateThreadTask) AdminInternalNotificationTemplate(evt eventbus.TaskEvent
 }
 
 func (CreateThreadTask) RequiredTemplates() []tasks.Template {
-	return append([]tasks.Template{tasks.Template(ForumThreadNewPageTmpl)},
+	r := append([]tasks.Template{tasks.Template(ForumThreadNewPageTmpl)},
 		append(EmailTemplateForumThreadCreate.RequiredTemplates(), EmailTemplateAdminNotificationForumThreadCreate.RequiredTemplates()...)...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 // AutoSubscribePath records the created thread so the author and topic
@@ -123,6 +126,7 @@ func (CreateThreadTask) Page(w http.ResponseWriter, r *http.Request) {
 		BasePath           string
 		Topic              *db.GetForumTopicByIdForUserRow
 		QuoteText          string
+		CanCreatePoll      bool
 	}
 
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
@@ -158,6 +162,7 @@ func (CreateThreadTask) Page(w http.ResponseWriter, r *http.Request) {
 		SelectedLanguageId: int(cd.PreferredLanguageID(cd.Config.DefaultLanguage)),
 		BasePath:           base,
 		Topic:              topic,
+		CanCreatePoll:      cd.CanCreatePoll(),
 	}
 
 	// Handle quoting if query parameters are present.
@@ -205,6 +210,21 @@ func (CreateThreadTask) Page(w http.ResponseWriter, r *http.Request) {
 
 const ForumThreadNewPageTmpl tasks.Template = "domains/forum/threadNewPage.gohtml"
 
+// MentionEmailTemplate emails users mentioned with @username.
+func (CreateThreadTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (CreateThreadTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (CreateThreadTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return CreateThreadTask{}.AutoSubscribeGrants(evt)
+}
+
 func (CreateThreadTask) Action(w http.ResponseWriter, r *http.Request) any {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	queries := cd.Queries()
@@ -256,6 +276,16 @@ func (CreateThreadTask) Action(w http.ResponseWriter, r *http.Request) any {
 		return fmt.Errorf("parse thread form: %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
+	poll, err := parsePollForm(r, cd)
+	if err != nil {
+		return fmt.Errorf("poll form %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if poll != nil && !cd.CanCreatePoll() {
+		w.WriteHeader(http.StatusForbidden)
+		handlers.RenderErrorPage(w, r, fmt.Errorf("forbidden"))
+		return nil
+	}
+
 	fork, status, err := validateForkRequest(r, cd, topic, uid)
 	if err != nil {
 		w.WriteHeader(status)
@@ -263,15 +293,32 @@ func (CreateThreadTask) Action(w http.ResponseWriter, r *http.Request) any {
 		return nil
 	}
 
+	createThread := func(q db.Querier) (int64, error) {
+		if fork != nil {
+			return q.SystemCreateReplyThread(r.Context(), db.SystemCreateReplyThreadParams{
+				TopicID:          topic.Idforumtopic,
+				ReplyToCommentID: sql.NullInt32{Int32: fork.commentID, Valid: true},
+				ReplyToThreadID:  sql.NullInt32{Int32: fork.threadID, Valid: true},
+			})
+		}
+		return q.SystemCreateThread(r.Context(), topic.Idforumtopic)
+	}
 	var threadId int64
-	if fork != nil {
-		threadId, err = queries.SystemCreateReplyThread(r.Context(), db.SystemCreateReplyThreadParams{
-			TopicID:          topic.Idforumtopic,
-			ReplyToCommentID: sql.NullInt32{Int32: fork.commentID, Valid: true},
-			ReplyToThreadID:  sql.NullInt32{Int32: fork.threadID, Valid: true},
-		})
+	if poll == nil {
+		threadId, err = createThread(queries)
 	} else {
-		threadId, err = queries.SystemCreateThread(r.Context(), topic.Idforumtopic)
+		// The poll is created with the thread so a failure leaves neither.
+		err = db.InTx(r.Context(), queries, func(q db.Querier) error {
+			id, err := createThread(q)
+			if err != nil {
+				return err
+			}
+			if _, err := cd.CreateThreadPoll(q, int32(id), poll); err != nil {
+				return err
+			}
+			threadId = id
+			return nil
+		})
 	}
 	if err != nil {
 		log.Printf("Error: makeThread: %s", err)
@@ -359,6 +406,7 @@ func (CreateThreadTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}); err != nil {
 		log.Printf("thread create side effects: %v", err)
 	}
+	cd.RecordMentions(text, endUrl)
 
 	return handlers.RedirectHandler(endUrl)
 }
@@ -40,6 +40,7 @@ func ThreadPageWithBasePath(w http.ResponseWriter, r *http.Request, basePath str
 		BasePath          string
 		Labels            []templates.TopicLabel
 		BackURL           string
+		Poll              *common.PollView
 	}
 
 	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
@@ -199,6 +200,9 @@ func ThreadPageWithBasePath(w http.ResponseWriter, r *http.Request, basePath str
 	}
 
 	data.Thread = threadRow
+	if data.Poll, err = cd.ThreadPoll(threadRow.Idforumthread); err != nil {
+		log.Printf("thread poll: %v", err)
+	}
 	data.Topic = &ForumtopicPlus{
 		Idforumtopic:                 topicRow.Idforumtopic,
 		Lastposter:                   topicRow.Lastposter,
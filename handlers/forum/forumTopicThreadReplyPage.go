@@ -40,6 +40,8 @@ var (
 	_                notif.AdminEmailTemplateProvider              = (*ReplyTask)(nil)
 	_                notif.AutoSubscribeProvider                   = (*ReplyTask)(nil)
 	_                notif.GrantsRequiredProvider                  = (*ReplyTask)(nil)
+	_                notif.MentionsNotificationProvider            = (*ReplyTask)(nil)
+	_                notif.EmailReplyProvider                      = (*ReplyTask)(nil)
 	_                tasks.EmailTemplatesRequired                  = (*ReplyTask)(nil)
 	_                searchworker.IndexedTask                      = ReplyTask{}
 )
@@ -75,7 +77,9 @@ func (ReplyTask) AdminInternalNotificationTemplate(evt eventbus.TaskEvent) *stri
 	return &v
 }
 func (ReplyTask) RequiredTemplates() []tasks.Template {
-	return append(EmailTemplateForumReply.RequiredTemplates(), EmailTemplateAdminNotificationForumReply.RequiredTemplates()...)
+	r := append(EmailTemplateForumReply.RequiredTemplates(), EmailTemplateAdminNotificationForumReply.RequiredTemplates()...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 // AutoSubscribePath ensures authors automatically receive updates on replies.
@@ -106,10 +110,40 @@ func (ReplyTask) AutoSubscribeGrants(evt eventbus.TaskEvent) ([]notif.GrantRequi
 	return nil, nil
 }
 
+// EmailReplyPath lets subscribers answer the notification by email. Replies
+// are posted to the web form of the thread, also when the comment came in
+// through the API.
+func (ReplyTask) EmailReplyPath(evt eventbus.TaskEvent) (string, bool) {
+	data, ok := evt.Data[postcountworker.EventKey].(postcountworker.UpdateEventData)
+	if !ok || evt.Outcome != eventbus.TaskOutcomeSuccess {
+		return "", false
+	}
+	base := "/forum"
+	if strings.HasPrefix(evt.Path, "/private/") {
+		base = "/private"
+	}
+	return fmt.Sprintf("%s/topic/%d/thread/%d/reply", base, data.TopicID, data.ThreadID), true
+}
+
 func (ReplyTask) GrantsRequired(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
 	return privateThreadSubscriberGrants(evt)
 }
 
+// MentionEmailTemplate emails users mentioned with @username.
+func (ReplyTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (ReplyTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (ReplyTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return ReplyTask{}.AutoSubscribeGrants(evt)
+}
+
 func (ReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	session := cd.GetSession()
@@ -192,6 +226,7 @@ func (ReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}); err != nil {
 		log.Printf("thread reply side effects: %v", err)
 	}
+	cd.RecordMentions(text, endUrl)
 	if evt := cd.Event(); evt != nil {
 		evt.Data["URL"] = cd.AbsoluteURL(endUrl)
 	}
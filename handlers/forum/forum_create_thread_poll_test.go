@@ -0,0 +1,95 @@
+package forum
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/sessions"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/testhelpers"
+)
+
+func createThreadWithPoll(t *testing.T, qs *db.QuerierStub) any {
+	t.Helper()
+	const uid, topicID = int32(1), int32(5)
+	qs.GetPermissionsByUserIDFn = func(int32) ([]*db.GetPermissionsByUserIDRow, error) {
+		return []*db.GetPermissionsByUserIDRow{}, nil
+	}
+	qs.SystemCheckGrantFn = func(db.SystemCheckGrantParams) (int32, error) { return 1, nil }
+	qs.GetForumTopicByIdForUserFn = func(context.Context, db.GetForumTopicByIdForUserParams) (*db.GetForumTopicByIdForUserRow, error) {
+		return &db.GetForumTopicByIdForUserRow{Idforumtopic: topicID, Title: sql.NullString{String: "Topic", Valid: true}}, nil
+	}
+	qs.SystemCreateThreadFn = func(context.Context, int32) (int64, error) { return 100, nil }
+	qs.CreateCommentInSectionForCommenterFn = func(context.Context, db.CreateCommentInSectionForCommenterParams) (int64, error) {
+		return 999, nil
+	}
+	qs.CreateForumPollForCreatorReturns = 7
+
+	store := sessions.NewCookieStore([]byte("test"))
+	core.Store = store
+	core.SessionName = "test"
+	sess := testhelpers.Must(store.Get(httptest.NewRequest(http.MethodGet, "http://example.com", nil), core.SessionName))
+	sess.Values["UID"] = uid
+
+	path := fmt.Sprintf("/forum/topic/%d/thread", topicID)
+	evt := &eventbus.TaskEvent{Data: map[string]any{}, UserID: uid, Path: path, Task: CreateThreadTaskHandler}
+	cd := common.NewCoreData(context.Background(), qs, config.NewRuntimeConfig(), common.WithSession(sess), common.WithEvent(evt), common.WithUserRoles([]string{"member"}))
+	cd.UserID = uid
+	ctx := context.WithValue(context.Background(), core.ContextValues("session"), sess)
+	ctx = context.WithValue(ctx, consts.KeyCoreData, cd)
+
+	form := url.Values{
+		"replytext":     {"First post"},
+		"language":      {"1"},
+		"task":          {string(TaskCreateThread)},
+		"poll_question": {"Tea or coffee?"},
+		"poll_options":  {"Tea\nCoffee"},
+	}
+	req := httptest.NewRequest(http.MethodPost, "http://example.com"+path, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = mux.SetURLVars(req.WithContext(ctx), map[string]string{"topic": fmt.Sprint(topicID)})
+	return CreateThreadTaskHandler.Action(httptest.NewRecorder(), req)
+}
+
+func TestCreateThreadWithPollInOneTransaction(t *testing.T) {
+	qs := testhelpers.NewQuerierStub()
+	createThreadWithPoll(t, qs)
+
+	if qs.InTxCalls != 1 {
+		t.Fatalf("transactions %d", qs.InTxCalls)
+	}
+	if len(qs.CreateForumPollForCreatorCalls) != 1 || qs.CreateForumPollForCreatorCalls[0].ThreadID != 100 {
+		t.Fatalf("poll calls %+v", qs.CreateForumPollForCreatorCalls)
+	}
+	if len(qs.CreateForumPollOptionCalls) != 2 || qs.CreateForumPollOptionCalls[1].PollID != 7 {
+		t.Fatalf("option calls %+v", qs.CreateForumPollOptionCalls)
+	}
+}
+
+func TestCreateThreadPollFailureReturnsToForm(t *testing.T) {
+	qs := testhelpers.NewQuerierStub()
+	qs.CreateForumPollOptionErr = errors.New("option insert failed")
+	res := createThreadWithPoll(t, qs)
+
+	err, ok := res.(error)
+	if !ok || !strings.Contains(err.Error(), "option insert failed") {
+		t.Fatalf("result %v", res)
+	}
+	if len(qs.CreateCommentInSectionForCommenterCalls) != 0 {
+		t.Fatal("opening comment created after the poll failed")
+	}
+}
@@ -446,3 +446,25 @@ func TestForumAutoSubscribeTasks(t *testing.T) {
 		t.Fatalf("expected private create thread auto-subscribe path /private/topic/20/thread/64, got %q", path)
 	}
 }
+
+func TestReplyTaskEmailReplyPath(t *testing.T) {
+	data := map[string]any{
+		postcountworker.EventKey: postcountworker.UpdateEventData{ThreadID: 77, TopicID: 88, CommentID: 999},
+	}
+	cases := map[string]string{
+		"/forum/topic/88/thread/77/reply":       "/forum/topic/88/thread/77/reply",
+		"/api/forum/topic/88/thread/77/reply":   "/forum/topic/88/thread/77/reply",
+		"/private/topic/88/thread/77/reply":     "/private/topic/88/thread/77/reply",
+		"/private/api/topic/88/thread/77/reply": "/private/topic/88/thread/77/reply",
+	}
+	for evtPath, want := range cases {
+		evt := eventbus.TaskEvent{Data: data, Path: evtPath, Outcome: eventbus.TaskOutcomeSuccess}
+		path, ok := replyTask.EmailReplyPath(evt)
+		if !ok || path != want {
+			t.Errorf("EmailReplyPath(%q)=%q,%v want %q", evtPath, path, ok, want)
+		}
+	}
+	if _, ok := replyTask.EmailReplyPath(eventbus.TaskEvent{Data: data, Path: "/forum/topic/88/thread/77/reply"}); ok {
+		t.Errorf("EmailReplyPath offered for a failed reply")
+	}
+}
@@ -0,0 +1,81 @@
+package forum
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// pollCloseLayout is the value format of a datetime-local input.
+const pollCloseLayout = "2006-01-02T15:04"
+
+// parsePollForm reads the optional poll fields of the new thread form. It
+// returns nil when no poll question was entered.
+func parsePollForm(r *http.Request, cd *common.CoreData) (*common.PollInput, error) {
+	question := strings.TrimSpace(r.PostFormValue("poll_question"))
+	if question == "" {
+		return nil, nil
+	}
+	p := &common.PollInput{
+		Question:          question,
+		Options:           strings.Split(strings.ReplaceAll(r.PostFormValue("poll_options"), "\r\n", "\n"), "\n"),
+		MultipleChoice:    r.PostFormValue("poll_multiple") != "",
+		Anonymous:         r.PostFormValue("poll_public") == "",
+		ResultsVisibility: r.PostFormValue("poll_results"),
+	}
+	if v := strings.TrimSpace(r.PostFormValue("poll_closes")); v != "" {
+		t, err := time.ParseInLocation(pollCloseLayout, v, cd.Location())
+		if err != nil {
+			return nil, fmt.Errorf("invalid poll close time")
+		}
+		p.ClosesAt = t
+	}
+	if err := p.Validate(time.Now()); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// VotePollTask records a vote on the poll attached to a thread.
+type VotePollTask struct{ tasks.TaskString }
+
+var (
+	votePollTask = &VotePollTask{TaskString: TaskVotePoll}
+
+	// VotePollTaskHandler records poll votes and is exported for reuse.
+	VotePollTaskHandler = votePollTask
+
+	_ tasks.Task = (*VotePollTask)(nil)
+)
+
+func (VotePollTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	threadID, err := strconv.Atoi(mux.Vars(r)["thread"])
+	if err != nil {
+		return fmt.Errorf("thread id parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if err := r.ParseForm(); err != nil {
+		return fmt.Errorf("parse vote form %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	var choices []int32
+	for _, v := range r.PostForm["option"] {
+		id, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("option id parse fail %w", handlers.ErrRedirectOnSamePageHandler(common.ErrInvalidPollVote))
+		}
+		choices = append(choices, int32(id))
+	}
+	if err := cd.VoteThreadPoll(int32(threadID), choices); err != nil {
+		return fmt.Errorf("vote fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	return handlers.RefreshDirectHandler{TargetURL: strings.TrimSuffix(r.URL.Path, "/poll") + "#poll"}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/arran4/goa4web/config"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/middleware/apiauth"
 	"github.com/arran4/goa4web/internal/router"
 
 	"github.com/arran4/goa4web/handlers/share"
@@ -77,6 +78,7 @@ func RegisterRoutes(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOpt
 	fr.Handle("/topic/{topic}/thread/{thread}", RequireThreadAndTopic(http.HandlerFunc(ThreadPage))).Methods("GET")
 	fr.Handle("/topic/{topic}/thread/{thread}", RequireThreadAndTopic(http.HandlerFunc(ThreadPage))).Methods("POST")
 	fr.Handle("/topic/{topic}/thread/{thread}/reply", RequireThreadAndTopic(http.HandlerFunc(handlers.TaskHandler(replyTask)))).Methods("POST").MatcherFunc(replyTask.Matcher())
+	fr.Handle("/topic/{topic}/thread/{thread}/poll", RequireThreadAndTopic(http.HandlerFunc(handlers.TaskHandler(votePollTask)))).Methods("POST").MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(votePollTask.Matcher())
 	fr.Handle("/topic/{topic}/thread/{thread}/comment/{comment}", RequireThreadAndTopic(comments.RequireCommentAuthor(http.HandlerFunc(handlers.TaskHandler(topicThreadCommentEditAction))))).Methods("POST").MatcherFunc(topicThreadCommentEditAction.Matcher())
 	fr.Handle("/topic/{topic}/thread/{thread}/comment/{comment}", RequireThreadAndTopic(comments.RequireCommentAuthor(http.HandlerFunc(handlers.TaskHandler(topicThreadCommentEditActionCancel))))).Methods("POST").MatcherFunc(topicThreadCommentEditActionCancel.Matcher())
 
@@ -86,6 +88,20 @@ func RegisterRoutes(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOpt
 	api.HandleFunc("/quote/{commentid}", QuoteApi).Methods("GET")
 	api.HandleFunc("/quote-selection", QuoteSelectionApi).Methods("POST")
 	api.HandleFunc("/share", share.ShareLink).Methods("GET")
+
+	rest := api.NewRoute().Subrouter()
+	rest.Use(apiauth.APIKeyAuthMiddleware)
+
+	apiRead := rest.NewRoute().Subrouter()
+	apiRead.Use(apiauth.RequireScope("forum:read"))
+	apiRead.HandleFunc("/topics", APIListTopics).Methods(http.MethodGet)
+	apiRead.HandleFunc("/topic/{topic}/threads", APIListThreads).Methods(http.MethodGet)
+	apiRead.HandleFunc("/topic/{topic}/thread/{thread}", APIShowComments).Methods(http.MethodGet)
+
+	apiWrite := rest.NewRoute().Subrouter()
+	apiWrite.Use(apiauth.RequireScope("forum:write"))
+	apiWrite.HandleFunc("/topic/{topic}/threads", APICreateThread).Methods(http.MethodPost)
+	apiWrite.HandleFunc("/topic/{topic}/thread/{thread}/reply", APIPostComment).Methods(http.MethodPost)
 	return opts
 }
 
@@ -94,4 +110,5 @@ func Register(reg *router.Registry) {
 	reg.RegisterModule("forum", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
 		return RegisterRoutes(r, cfg)
 	})
+	reg.RegisterSitemap("forum", sitemapEntries)
 }
@@ -0,0 +1,36 @@
+package forum
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// sitemapEntries lists the forum index with every public topic and thread
+// anonymous visitors may open. Private forums are never included.
+func sitemapEntries(ctx context.Context, q db.Querier) ([]sitemap.Entry, error) {
+	topics, err := q.SystemListSitemapForumTopics(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list forum topics: %w", err)
+	}
+	threads, err := q.SystemListSitemapForumThreads(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list forum threads: %w", err)
+	}
+	entries := make([]sitemap.Entry, 0, len(topics)+len(threads))
+	for _, row := range topics {
+		entries = append(entries, sitemap.Entry{
+			Path:    fmt.Sprintf("/forum/topic/%d", row.Idforumtopic),
+			LastMod: row.Lastaddition.Time,
+		})
+	}
+	for _, row := range threads {
+		entries = append(entries, sitemap.Entry{
+			Path:    fmt.Sprintf("/forum/topic/%d/thread/%d", row.ForumtopicIdforumtopic, row.Idforumthread),
+			LastMod: row.Lastaddition.Time,
+		})
+	}
+	return append([]sitemap.Entry{{Path: "/forum", LastMod: sitemap.Latest(entries)}}, entries...), nil
+}
@@ -122,4 +122,7 @@ const (
 
 	// TaskSetTopicLabels replaces public and private labels on a topic.
 	TaskSetTopicLabels tasks.TaskString = "Set Topic Labels"
+
+	// TaskVotePoll records the viewer's vote on a thread poll.
+	TaskVotePoll tasks.TaskString = "Vote"
 )
@@ -28,5 +28,6 @@ func RegisterTasks() []tasks.NamedTask {
 		addTopicPublicLabelTask,
 		removeTopicPublicLabelTask,
 		setTopicLabelsTask,
+		votePollTask,
 	}
 }
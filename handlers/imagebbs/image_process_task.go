@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"image"
 	"path"
 
 	"github.com/arran4/goa4web/config"
@@ -12,10 +11,11 @@ import (
 	imagesign "github.com/arran4/goa4web/internal/images"
 	"github.com/arran4/goa4web/internal/tasks"
 	"github.com/arran4/goa4web/internal/upload"
-	"golang.org/x/image/draw"
 )
 
-// ProcessImageTask handles background thumbnail generation.
+// ProcessImageTask handles background thumbnail and srcset derivative
+// generation. Derivatives are stored beside the upload; the orphan purge
+// keeps them while their source is referenced.
 type ProcessImageTask struct {
 	tasks.TaskString
 	Config *config.RuntimeConfig
@@ -43,37 +43,33 @@ func (t *ProcessImageTask) BackgroundTask(ctx context.Context, q db.Querier) (ta
 		return nil, fmt.Errorf("read image fail %w", err)
 	}
 
-	img, _, err := image.Decode(bytes.NewReader(data))
+	img, err := imagesign.DecodeUpright(bytes.NewReader(data))
 	if err != nil {
 		return nil, fmt.Errorf("decode image error %w", err)
 	}
 
-	src := img.Bounds()
-	var crop image.Rectangle
-	if src.Dx() > src.Dy() {
-		side := src.Dy()
-		x0 := src.Min.X + (src.Dx()-side)/2
-		crop = image.Rect(x0, src.Min.Y, x0+side, src.Min.Y+side)
-	} else {
-		side := src.Dx()
-		y0 := src.Min.Y + (src.Dy()-side)/2
-		crop = image.Rect(src.Min.X, y0, src.Min.X+side, y0+side)
+	generator := "draw"
+	if t.Config.ImageThumbnailGenerator != "" {
+		generator = t.Config.ImageThumbnailGenerator
 	}
-	thumb := image.NewRGBA(image.Rect(0, 0, 200, 200))
-	draw.CatmullRom.Scale(thumb, thumb.Bounds(), img, crop, draw.Over, nil)
-
-	var buf bytes.Buffer
-	enc, err := imagesign.EncoderByExtension(t.Ext)
+	thumb, err := imagesign.GenerateThumbnail(img, t.Ext, generator, 200)
 	if err != nil {
-		return nil, fmt.Errorf("encoder fail %w", err)
-	}
-	if err := enc(&buf, thumb); err != nil {
-		return nil, fmt.Errorf("encode thumb fail %w", err)
+		return nil, fmt.Errorf("generate thumb fail %w", err)
 	}
 	thumbName := t.ShaHex + "_thumb" + t.Ext
-	if err := p.Write(ctx, path.Join(sub1, sub2, thumbName), buf.Bytes()); err != nil {
+	if err := p.Write(ctx, path.Join(sub1, sub2, thumbName), thumb); err != nil {
 		return nil, fmt.Errorf("thumb write fail %w", err)
 	}
 
+	for _, w := range imagesign.DerivativeWidths(img.Bounds().Dx(), t.Config.SrcsetWidths()) {
+		derivative, _, err := imagesign.GenerateDerivative(img, t.Ext, generator, w)
+		if err != nil {
+			return nil, fmt.Errorf("generate derivative fail %w", err)
+		}
+		if err := p.Write(ctx, path.Join(sub1, sub2, imagesign.DerivativeName(fname, w)), derivative); err != nil {
+			return nil, fmt.Errorf("derivative write fail %w", err)
+		}
+	}
+
 	return nil, nil
 }
@@ -1,12 +1,10 @@
 package imagebbs
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha256"
 	"database/sql"
 	"fmt"
-	"image"
 	_ "image/gif"
 	_ "image/jpeg"
 	_ "image/png"
@@ -97,29 +95,49 @@ func (UploadImageTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}
 	defer func() { _ = file.Close() }()
 
-	var buf bytes.Buffer
+	// The multipart file is seekable, so it is read twice rather than
+	// buffered: once to hash and once to decode. The decoded image is then
+	// re-encoded so that EXIF data such as GPS positions is not stored.
 	h := sha256.New()
-	size, err := io.Copy(io.MultiWriter(&buf, h), file)
+	size, err := io.Copy(h, file)
 	if err != nil {
 		return fmt.Errorf("copy upload error %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
 	shaHex := fmt.Sprintf("%x", h.Sum(nil))
-	ext, err := imagesign.CleanExtension(header.Filename)
+	ext, err := imagesign.CleanUploadExtension(header.Filename)
 	if err != nil {
 		return fmt.Errorf("invalid extension %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 	sub1, sub2 := shaHex[:2], shaHex[2:4]
-	data := buf.Bytes()
-	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind upload error %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	img, err := imagesign.DecodeUpright(file)
 	if err != nil {
 		return fmt.Errorf("decode image error %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
+	bounds := img.Bounds()
+	ext = imagesign.StoredExtension(ext)
 	fname := shaHex + ext
 	if p := upload.ProviderFromConfig(cd.Config); p != nil {
-		if err := p.Write(r.Context(), path.Join(sub1, sub2, fname), data); err != nil {
+		var body io.Reader = file
+		var enc *imagesign.UploadEncoder
+		if imagesign.KeepsOriginal(ext) {
+			if _, err := file.Seek(0, io.SeekStart); err != nil {
+				return fmt.Errorf("rewind upload error %w", handlers.ErrRedirectOnSamePageHandler(err))
+			}
+		} else {
+			enc = imagesign.NewUploadEncoder(img, ext)
+			defer func() { _ = enc.Close() }()
+			body = enc
+		}
+		if err := p.WriteStream(r.Context(), path.Join(sub1, sub2, fname), body); err != nil {
 			return fmt.Errorf("upload write fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 		}
+		if enc != nil {
+			size = enc.Size()
+		}
 	}
 
 	relBase := path.Join("/imagebbs/images", sub1, sub2)
@@ -130,8 +148,8 @@ func (UploadImageTask) Action(w http.ResponseWriter, r *http.Request) any {
 	if _, err := queries.CreateUploadedImageForUploader(r.Context(), db.CreateUploadedImageForUploaderParams{
 		UploaderID: uid,
 		Path:       sql.NullString{String: relFull, Valid: true},
-		Width:      sql.NullInt32{Int32: int32(cfg.Width), Valid: true},
-		Height:     sql.NullInt32{Int32: int32(cfg.Height), Valid: true},
+		Width:      sql.NullInt32{Int32: int32(bounds.Dx()), Valid: true},
+		Height:     sql.NullInt32{Int32: int32(bounds.Dy()), Valid: true},
 		FileSize:   int32(size),
 	}); err != nil {
 		return fmt.Errorf("record uploaded image %w", handlers.ErrRedirectOnSamePageHandler(err))
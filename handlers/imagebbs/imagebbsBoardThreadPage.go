@@ -19,6 +19,7 @@ import (
 	"github.com/arran4/goa4web/internal/eventbus"
 	notif "github.com/arran4/goa4web/internal/notifications"
 	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/workers/postcountworker"
 	"github.com/arran4/goa4web/workers/searchworker"
 )
 
@@ -32,6 +33,7 @@ var _ tasks.Task = (*ReplyTask)(nil)
 // ReplyTask alerts watchers of new posts and auto-subscribes the replier so
 // they see further responses.
 var _ notif.SubscribersNotificationTemplateProvider = (*ReplyTask)(nil)
+var _ notif.EmailReplyProvider = (*ReplyTask)(nil)
 var _ notif.AutoSubscribeProvider = (*ReplyTask)(nil)
 var _ tasks.EmailTemplatesRequired = (*ReplyTask)(nil)
 
@@ -46,6 +48,20 @@ func (ReplyTask) IndexData(data map[string]any) []searchworker.IndexEventData {
 
 var _ searchworker.IndexedTask = ReplyTask{}
 
+// EmailReplyPath posts email replies back to the board thread, also when the
+// comment came in through the API.
+func (ReplyTask) EmailReplyPath(evt eventbus.TaskEvent) (string, bool) {
+	target, ok := evt.Data["target"].(notif.Target)
+	if !ok || evt.Outcome != eventbus.TaskOutcomeSuccess {
+		return "", false
+	}
+	data, ok := evt.Data[postcountworker.EventKey].(postcountworker.UpdateEventData)
+	if !ok {
+		return "", false
+	}
+	return fmt.Sprintf("/imagebbs/board/%d/thread/%d", target.ID, data.ThreadID), true
+}
+
 func (ReplyTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
 	return EmailTemplateImagebbsReply.EmailTemplates(), evt.Outcome == eventbus.TaskOutcomeSuccess
 }
@@ -224,6 +240,9 @@ func (ReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 		MarkThreadRead:       true,
 		IncludePostCount:     true,
 		IncludeSearch:        true,
+		AdditionalData: map[string]any{
+			"target": notif.Target{Type: "imagepost", ID: int32(bid)},
+		},
 	}); err != nil {
 		log.Printf("imagebbs reply side effects: %v", err)
 	}
@@ -28,6 +28,7 @@ import (
 	"github.com/arran4/goa4web/internal/notifications"
 	"github.com/arran4/goa4web/internal/testhelpers"
 	"github.com/arran4/goa4web/workers/emailqueue"
+	"github.com/arran4/goa4web/workers/postcountworker"
 )
 
 type imageBbsQueries struct {
@@ -284,3 +285,24 @@ func getEmailBody(t *testing.T, msg *mail.Message) string {
 	}
 	return buf.String()
 }
+
+func TestReplyTaskEmailReplyPath(t *testing.T) {
+	data := map[string]any{
+		"target":                 notifications.Target{Type: "imagepost", ID: 42},
+		postcountworker.EventKey: postcountworker.UpdateEventData{ThreadID: 77, TopicID: 88, CommentID: 999},
+	}
+	cases := map[string]string{
+		"/imagebbs/board/42/thread/77":           "/imagebbs/board/42/thread/77",
+		"/api/imagebbs/board/42/thread/77/reply": "/imagebbs/board/42/thread/77",
+	}
+	for evtPath, want := range cases {
+		evt := eventbus.TaskEvent{Data: data, Path: evtPath, Outcome: eventbus.TaskOutcomeSuccess}
+		path, ok := replyTask.EmailReplyPath(evt)
+		if !ok || path != want {
+			t.Errorf("EmailReplyPath(%q)=%q,%v want %q", evtPath, path, ok, want)
+		}
+	}
+	if _, ok := replyTask.EmailReplyPath(eventbus.TaskEvent{Data: data, Path: "/imagebbs/board/42/thread/77"}); ok {
+		t.Errorf("EmailReplyPath offered for a failed reply")
+	}
+}
@@ -45,4 +45,5 @@ func Register(reg *router.Registry) {
 	reg.RegisterModule("imagebbs", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
 		return RegisterRoutes(r, cfg)
 	})
+	reg.RegisterSitemap("imagebbs", sitemapEntries)
 }
@@ -0,0 +1,43 @@
+package imagebbs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// sitemapEntries lists the imagebbs index with every board and image thread
+// anonymous visitors may open. A board is as fresh as its newest post.
+func sitemapEntries(ctx context.Context, q db.Querier) ([]sitemap.Entry, error) {
+	boards, err := q.SystemListSitemapImageBoards(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list image boards: %w", err)
+	}
+	posts, err := q.SystemListSitemapImagePosts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list image posts: %w", err)
+	}
+	latest := make(map[int32]time.Time, len(boards))
+	threads := make([]sitemap.Entry, 0, len(posts))
+	for _, row := range posts {
+		if row.Posted.Time.After(latest[row.Idimageboard]) {
+			latest[row.Idimageboard] = row.Posted.Time
+		}
+		threads = append(threads, sitemap.Entry{
+			Path:    fmt.Sprintf("/imagebbs/board/%d/thread/%d", row.Idimageboard, row.ForumthreadID),
+			LastMod: row.Posted.Time,
+		})
+	}
+	entries := make([]sitemap.Entry, 0, len(boards)+len(threads))
+	for _, id := range boards {
+		entries = append(entries, sitemap.Entry{
+			Path:    fmt.Sprintf("/imagebbs/board/%d", id),
+			LastMod: latest[id],
+		})
+	}
+	entries = append(entries, threads...)
+	return append([]sitemap.Entry{{Path: "/imagebbs", LastMod: sitemap.Latest(entries)}}, entries...), nil
+}
@@ -1,12 +1,10 @@
 package images
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"database/sql"
 	"encoding/json"
 	"fmt"
-	"image"
 	_ "image/gif"
 	_ "image/jpeg"
 	_ "image/png"
@@ -100,26 +98,26 @@ func APIUploadImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	img, _, err := image.Decode(bytes.NewReader(data))
+	ext, err := intimages.CleanUploadExtension(header.Filename)
 	if err != nil {
-		http.Error(w, "Failed to decode image data", http.StatusBadRequest)
+		http.Error(w, "Invalid file extension", http.StatusBadRequest)
 		return
 	}
-
-	hash := sha256.Sum256(data)
-	id := fmt.Sprintf("%x", hash[:20])
-	ext, err := intimages.CleanExtension(header.Filename)
+	prepared, err := intimages.PrepareUpload(data, ext)
 	if err != nil {
-		http.Error(w, "Invalid file extension", http.StatusBadRequest)
+		http.Error(w, "Failed to decode image data", http.StatusBadRequest)
 		return
 	}
+
+	hash := sha256.Sum256(prepared.Data)
+	id := fmt.Sprintf("%x", hash[:20])
 	if !intimages.ValidID(id) {
 		http.Error(w, "Invalid ID generated", http.StatusInternalServerError)
 		return
 	}
 
 	uid := cd.UserID
-	fname, err := cd.StoreImage(common.StoreImageParams{ID: id, Ext: ext, Data: data, Image: img, UploaderID: uid})
+	fname, err := cd.StoreImage(common.StoreImageParams{ID: id, Ext: prepared.Ext, Data: prepared.Data, Image: prepared.Image, UploaderID: uid})
 	if err != nil {
 		http.Error(w, "Failed to store image", http.StatusInternalServerError)
 		return
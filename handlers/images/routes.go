@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"image"
+	"io"
 	"log"
+	"mime"
 	"net/http"
 	"net/url"
 	"os"
@@ -127,9 +130,23 @@ func serveImage(w http.ResponseWriter, r *http.Request) {
 	cfg := cd.Config
 	sub1, sub2 := id[:2], id[2:4]
 
+	key := path.Join(sub1, sub2, id)
+	up := upload.ProviderFromConfig(cfg)
 	full := filepath.Join(cfg.ImageUploadDir, sub1, sub2, id)
-	info, err := os.Stat(full)
-	if os.IsNotExist(err) {
+	var size int64
+	var modTime time.Time
+	var err error
+	if up != nil {
+		var info upload.ObjectInfo
+		info, err = up.Stat(r.Context(), key)
+		size, modTime = info.Size, info.ModTime
+	} else {
+		var info os.FileInfo
+		if info, err = os.Stat(full); err == nil {
+			size, modTime = info.Size(), info.ModTime()
+		}
+	}
+	if errors.Is(err, upload.ErrNotExist) {
 		var opts []templates.Option
 		if cfg != nil && cfg.TemplatesDir != "" {
 			opts = append(opts, templates.WithDir(cfg.TemplatesDir))
@@ -138,6 +155,11 @@ func serveImage(w http.ResponseWriter, r *http.Request) {
 		http.ServeContent(w, r, "missing_image.svg", time.Time{}, bytes.NewReader(templates.GetMissingImageData(opts...)))
 		return
 	}
+	if err != nil {
+		log.Printf("stat uploaded image %s: %v", id, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
 
 	// Get preferred dimension
 	safeDim := ""
@@ -155,15 +177,13 @@ func serveImage(w http.ResponseWriter, r *http.Request) {
 		maxW, maxH, _ = intimages.ParseDimension(safeDim)
 	}
 
-	key := path.Join(sub1, sub2, id)
-
 	// Check size before reading into memory
-	if cfg != nil && info.Size() > int64(cfg.ImageMaxResizeBytes) && maxW > 0 && maxH > 0 {
-		if up, cacheProvider := upload.ProviderFromConfig(cfg), upload.CacheProviderFromConfig(cfg); up != nil && cacheProvider != nil {
+	if cfg != nil && size > int64(cfg.ImageMaxResizeBytes) && maxW > 0 && maxH > 0 {
+		if cacheProvider := upload.CacheProviderFromConfig(cfg); up != nil && cacheProvider != nil {
 			safeKey := key + "_safe_" + safeDim
 			safeBytes, safeErr := cacheProvider.Read(r.Context(), safeKey)
 			if safeErr == nil {
-				http.ServeContent(w, r, id, info.ModTime(), bytes.NewReader(safeBytes))
+				http.ServeContent(w, r, id, modTime, bytes.NewReader(safeBytes))
 				return
 			}
 
@@ -174,7 +194,7 @@ func serveImage(w http.ResponseWriter, r *http.Request) {
 						if err := cacheProvider.Write(r.Context(), safeKey, origBytes); err == nil {
 							recordUploadedImageDerivative(r.Context(), cd, path.Base(safeKey), id, origBytes, config.Height, config.Width)
 						}
-						http.ServeContent(w, r, id, info.ModTime(), bytes.NewReader(origBytes))
+						http.ServeContent(w, r, id, modTime, bytes.NewReader(origBytes))
 						return
 					}
 				}
@@ -193,7 +213,7 @@ func serveImage(w http.ResponseWriter, r *http.Request) {
 								recordUploadedImageDerivative(r.Context(), cd, path.Base(safeKey), id, resizedBytes, height, width)
 							}
 						}
-						http.ServeContent(w, r, id, info.ModTime(), bytes.NewReader(resizedBytes))
+						http.ServeContent(w, r, id, modTime, bytes.NewReader(resizedBytes))
 						return
 					}
 				}
@@ -201,9 +221,37 @@ func serveImage(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if up != nil {
+		serveObject(w, r, up, key, id, modTime)
+		return
+	}
 	http.ServeFile(w, r, full)
 }
 
+// serveObject streams name from p. Seekable readers, such as local files,
+// go through http.ServeContent so range requests keep working.
+func serveObject(w http.ResponseWriter, r *http.Request, p upload.Provider, name, id string, modTime time.Time) {
+	rc, err := p.Open(r.Context(), name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer func() { _ = rc.Close() }()
+	if rs, ok := rc.(io.ReadSeeker); ok {
+		http.ServeContent(w, r, id, modTime, rs)
+		return
+	}
+	if ct := mime.TypeByExtension(filepath.Ext(id)); ct != "" {
+		w.Header().Set("Content-Type", ct)
+	}
+	if !modTime.IsZero() {
+		w.Header().Set("Last-Modified", modTime.UTC().Format(http.TimeFormat))
+	}
+	if _, err := io.Copy(w, rc); err != nil {
+		log.Printf("serve %s: %v", name, err)
+	}
+}
+
 func recordUploadedImageDerivative(ctx context.Context, cd *common.CoreData, cacheID, imageID string, body []byte, height, width int) {
 	source, err := cd.UploadedImageByImageID(imageID)
 	if err != nil {
@@ -352,6 +400,7 @@ func serveCache(w http.ResponseWriter, r *http.Request) {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	cfg := cd.Config
 	originalID, thumbnailSize, isThumbnail := thumbnailRequest(id, cfg)
+	derivativeSource, derivativeWidth, isDerivative := derivativeRequest(id, cfg)
 	ok, err := cd.PrepareImageCacheEntryForServe(r.Context(), id)
 	if err != nil || !ok {
 		entry, entryErr := cd.ImageCacheEntry(r.Context(), id)
@@ -369,7 +418,7 @@ func serveCache(w http.ResponseWriter, r *http.Request) {
 		var data []byte
 		var err error
 
-		if maxW > 0 && maxH > 0 && !isThumbnail {
+		if maxW > 0 && maxH > 0 && !isThumbnail && !isDerivative {
 			var served bool
 			data, served, err = enforceSafeImageSize(w, r, cd, p, id, key, safeDim, maxW, maxH)
 			if served {
@@ -382,6 +431,9 @@ func serveCache(w http.ResponseWriter, r *http.Request) {
 		if err != nil && isThumbnail {
 			data = regenerateMissingThumbnail(r.Context(), cd, p, id, key, originalID, thumbnailSize)
 		}
+		if err != nil && isDerivative {
+			data = regenerateMissingDerivative(r.Context(), cd, p, id, key, derivativeSource, derivativeWidth)
+		}
 
 		if data == nil {
 			http.NotFound(w, r)
@@ -429,6 +481,48 @@ func thumbnailRequest(id string, cfg *config.RuntimeConfig) (string, config.Thum
 	return originalID, size, intimages.ValidID(originalID)
 }
 
+// derivativeRequest identifies a request for a configured srcset derivative
+// and returns its source image and width.
+func derivativeRequest(id string, cfg *config.RuntimeConfig) (string, int, bool) {
+	originalID, width, ok := intimages.ParseDerivativeName(id)
+	if !ok || cfg == nil || !slices.Contains(cfg.SrcsetWidths(), width) {
+		return "", 0, false
+	}
+	return originalID, width, intimages.ValidID(originalID)
+}
+
+// regenerateMissingDerivative rebuilds a srcset derivative of an uploaded
+// image, for example after the configured widths change.
+func regenerateMissingDerivative(ctx context.Context, cd *common.CoreData, p upload.Provider, id, key, originalID string, width int) []byte {
+	up := upload.ProviderFromConfig(cd.Config)
+	if up == nil {
+		return nil
+	}
+	origBytes, err := up.Read(ctx, path.Join(originalID[:2], originalID[2:4], originalID))
+	if err != nil {
+		return nil
+	}
+	img, err := intimages.DecodeUpright(bytes.NewReader(origBytes))
+	if err != nil || img.Bounds().Dx() <= width {
+		return nil
+	}
+	generator := "draw"
+	if cd.Config.ImageThumbnailGenerator != "" {
+		generator = cd.Config.ImageThumbnailGenerator
+	}
+	data, height, err := intimages.GenerateDerivative(img, filepath.Ext(originalID), generator, width)
+	if err != nil {
+		log.Printf("generate derivative %s: %v", id, err)
+		return nil
+	}
+	if err := p.Write(ctx, key, data); err != nil {
+		log.Printf("cache write: %v", err)
+		return data
+	}
+	recordUploadedImageDerivative(ctx, cd, id, originalID, data, height, width)
+	return data
+}
+
 func ServeMissingImage(w http.ResponseWriter, r *http.Request, cfg *config.RuntimeConfig) {
 	var opts []templates.Option
 	if cfg != nil && cfg.TemplatesDir != "" {
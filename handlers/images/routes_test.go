@@ -84,6 +84,28 @@ func TestThumbnailRequest(t *testing.T) {
 	}
 }
 
+func TestDerivativeRequest(t *testing.T) {
+	cfg := &config.RuntimeConfig{ImageSrcsetWidths: "320,640"}
+	cases := []struct {
+		id        string
+		wantID    string
+		wantWidth int
+		wantOK    bool
+	}{
+		{id: "abcd1234_w320.jpg", wantID: "abcd1234.jpg", wantWidth: 320, wantOK: true},
+		{id: "abcd1234_w640.png", wantID: "abcd1234.png", wantWidth: 640, wantOK: true},
+		{id: "abcd1234_w480.jpg", wantOK: false},
+		{id: "abcd1234_wide.jpg", wantOK: false},
+		{id: "abcd1234.jpg", wantOK: false},
+	}
+	for _, tc := range cases {
+		gotID, gotWidth, gotOK := derivativeRequest(tc.id, cfg)
+		if gotID != tc.wantID || gotWidth != tc.wantWidth || gotOK != tc.wantOK {
+			t.Errorf("derivativeRequest(%q) = (%q, %d, %t), want (%q, %d, %t)", tc.id, gotID, gotWidth, gotOK, tc.wantID, tc.wantWidth, tc.wantOK)
+		}
+	}
+}
+
 func imageRouteInvalidID(t *testing.T) {
 	r := mux.NewRouter()
 	cfg := config.NewRuntimeConfig()
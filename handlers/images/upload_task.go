@@ -1,10 +1,8 @@
 package images
 
 import (
-	"bytes"
 	"crypto/sha256"
 	"fmt"
-	"image"
 	_ "image/gif"
 	_ "image/jpeg"
 	_ "image/png"
@@ -58,22 +56,22 @@ func (UploadImageTask) Action(w http.ResponseWriter, r *http.Request) any {
 		return fmt.Errorf("read file %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
-	img, _, err := image.Decode(bytes.NewReader(data))
+	ext, err := intimages.CleanUploadExtension(header.Filename)
+	if err != nil {
+		return fmt.Errorf("invalid extension %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	prepared, err := intimages.PrepareUpload(data, ext)
 	if err != nil {
 		return fmt.Errorf("decode image %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
-	hash := sha256.Sum256(data)
+	hash := sha256.Sum256(prepared.Data)
 	id := fmt.Sprintf("%x", hash[:20])
-	ext, err := intimages.CleanExtension(header.Filename)
-	if err != nil {
-		return fmt.Errorf("invalid extension %w", handlers.ErrRedirectOnSamePageHandler(err))
-	}
 	if !intimages.ValidID(id) {
 		return fmt.Errorf("invalid id %w", handlers.ErrRedirectOnSamePageHandler(fmt.Errorf("bad id")))
 	}
 	uid := cd.UserID
-	fname, err := cd.StoreImage(common.StoreImageParams{ID: id, Ext: ext, Data: data, Image: img, UploaderID: uid})
+	fname, err := cd.StoreImage(common.StoreImageParams{ID: id, Ext: prepared.Ext, Data: prepared.Data, Image: prepared.Image, UploaderID: uid})
 	if err != nil {
 		return fmt.Errorf("store image %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
@@ -0,0 +1,97 @@
+package linker
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/gorilla/mux"
+)
+
+// APIListCategories handles GET /api/linker/categories
+func APIListCategories(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	categories, err := cd.LinkerCategoriesForUser()
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"categories": categories,
+	})
+}
+
+// APIListLinks handles GET /api/linker/category/{category}/links
+func APIListLinks(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	categoryID, err := strconv.Atoi(mux.Vars(r)["category"])
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusBadRequest, "Invalid category ID")
+		return
+	}
+	page := handlers.APIPage(r)
+	pageSize := cd.PageSize()
+
+	links, err := cd.LinkerItemsForUser(int32(categoryID), int32((page-1)*pageSize))
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"links":    links,
+		"has_more": len(links) >= pageSize,
+		"page":     page,
+	})
+}
+
+// APIShowLink handles GET /api/linker/link/{link}
+func APIShowLink(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.LoadSelectionsFromRequest(r)
+	link, err := cd.SelectedLinkerItem()
+	if err != nil || link == nil {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Link not found")
+		return
+	}
+	if !cd.HasGrant("linker", "link", "view", link.ID) {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Link not found")
+		return
+	}
+
+	comments, err := cd.SectionThreadComments("linker", "link", link.ThreadID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	page := handlers.APIPage(r)
+	comments, hasMore := handlers.APIPaginate(comments, page, cd.PageSize())
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"link":     link,
+		"comments": handlers.APIComments(cd, r, comments),
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APISuggestLink handles POST /api/linker/suggestions
+func APISuggestLink(w http.ResponseWriter, r *http.Request) {
+	handlers.APITaskHandler(suggestTask)(w, r)
+}
+
+// APIPostComment handles POST /api/linker/link/{link}/reply
+func APIPostComment(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.LoadSelectionsFromRequest(r)
+	link, err := cd.SelectedLinkerItem()
+	if err != nil || link == nil {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Link not found")
+		return
+	}
+	handlers.APITaskHandler(replyTaskEvent)(w, r)
+}
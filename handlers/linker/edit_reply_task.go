@@ -64,6 +64,7 @@ func (EditReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 		return fmt.Errorf("validate images: %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
+	before := cd.RevisionSnapshot(common.RevisionTypeComment, int32(commentId))
 	if err = queries.UpdateCommentForEditor(r.Context(), db.UpdateCommentForEditorParams{
 		LanguageID: sql.NullInt32{Int32: int32(languageId), Valid: languageId != 0},
 		Text: sql.NullString{
@@ -76,6 +77,7 @@ func (EditReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}); err != nil {
 		return fmt.Errorf("update comment fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
+	cd.RecordRevision(before)
 
 	if err := cd.HandleThreadUpdated(r.Context(), common.ThreadUpdatedEvent{
 		ThreadID:             thread.Idforumthread,
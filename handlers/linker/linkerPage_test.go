@@ -58,7 +58,7 @@ func TestLinkerApproveAddsToSearch(t *testing.T) {
 		bus := eventbus.NewBus()
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
-		go searchworker.Worker(ctx, bus, queries)
+		go searchworker.Worker(ctx, bus, queries, nil)
 
 		req := httptest.NewRequest("POST", "/admin/queue?qid=3", nil)
 		evt := &eventbus.TaskEvent{Data: map[string]any{}}
@@ -162,6 +162,10 @@ func (l *linkerIndexRecorder) GetLinkerItemByIdWithPosterUsernameAndCategoryTitl
 	return l.link, nil
 }
 
+func (l *linkerIndexRecorder) SystemDeleteLinkerSearchByLinkerID(context.Context, int32) error {
+	return nil
+}
+
 func (l *linkerIndexRecorder) SystemCreateSearchWord(_ context.Context, word string) (int64, error) {
 	l.mu.Lock()
 	defer l.mu.Unlock()
@@ -8,6 +8,7 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/middleware/apiauth"
 	navpkg "github.com/arran4/goa4web/internal/navigation"
 	"github.com/arran4/goa4web/internal/router"
 )
@@ -44,6 +45,20 @@ func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []navpkg.RouterOptio
 	lr.HandleFunc("/suggest", SuggestPage).Methods("GET")
 	lr.HandleFunc("/suggest", handlers.TaskHandler(suggestTask)).Methods("POST").MatcherFunc(suggestTask.Matcher())
 
+	api := r.PathPrefix("/api/linker").Subrouter()
+	api.Use(apiauth.APIKeyAuthMiddleware)
+
+	apiRead := api.NewRoute().Subrouter()
+	apiRead.Use(apiauth.RequireScope("linker:read"))
+	apiRead.HandleFunc("/categories", APIListCategories).Methods(http.MethodGet)
+	apiRead.HandleFunc("/category/{category}/links", APIListLinks).Methods(http.MethodGet)
+	apiRead.HandleFunc("/link/{link}", APIShowLink).Methods(http.MethodGet)
+
+	apiWrite := api.NewRoute().Subrouter()
+	apiWrite.Use(apiauth.RequireScope("linker:write"))
+	apiWrite.HandleFunc("/suggestions", APISuggestLink).Methods(http.MethodPost)
+	apiWrite.HandleFunc("/link/{link}/reply", APIPostComment).Methods(http.MethodPost)
+
 	if legacyRedirectsEnabled {
 		// legacy redirects
 		r.Path("/links").HandlerFunc(handlers.RedirectPermanentPrefix("/links", "/linker"))
@@ -57,4 +72,5 @@ func Register(reg *router.Registry) {
 	reg.RegisterModule("linker", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
 		return RegisterRoutes(r, cfg)
 	})
+	reg.RegisterSitemap("linker", sitemapEntries)
 }
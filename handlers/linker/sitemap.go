@@ -0,0 +1,26 @@
+package linker
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// sitemapEntries lists the linker index and every link anonymous visitors may
+// open.
+func sitemapEntries(ctx context.Context, q db.Querier) ([]sitemap.Entry, error) {
+	rows, err := q.SystemListSitemapLinkerItems(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list linker items: %w", err)
+	}
+	entries := make([]sitemap.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, sitemap.Entry{
+			Path:    fmt.Sprintf("/linker/show/%d", row.ID),
+			LastMod: row.Listed.Time,
+		})
+	}
+	return append([]sitemap.Entry{{Path: "/linker", LastMod: sitemap.Latest(entries)}}, entries...), nil
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/arran4/goa4web/a4code"
+)
+
+// markdownMaxBytes caps the Markdown accepted by MarkdownToA4CodePage.
+const markdownMaxBytes = 64 << 10
+
+// MarkdownToA4CodePage converts the Markdown request body to A4Code for the
+// editor's paste as Markdown dialog.
+func MarkdownToA4CodePage(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, markdownMaxBytes)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, "Markdown too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "Failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	code, err := a4code.FromMarkdown(string(body))
+	if err != nil {
+		log.Printf("markdown to a4code: %v", err)
+		http.Error(w, "Error converting markdown", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := io.WriteString(w, code); err != nil {
+		log.Printf("write a4code: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/sessions"
+
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/internal/middleware/csrf"
+)
+
+func TestMarkdownToA4CodePage(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/a4code/markdown", strings.NewReader("**Hi** @bob\n\n- one\n- two"))
+	rr := httptest.NewRecorder()
+	MarkdownToA4CodePage(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status=%d", rr.Code)
+	}
+	if got, want := rr.Body.String(), "[b Hi] @bob\n\n[list [li one][li two]]"; got != want {
+		t.Errorf("body=%q want %q", got, want)
+	}
+	if ct := rr.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("content type %q", ct)
+	}
+}
+
+func TestMarkdownToA4CodePageMethod(t *testing.T) {
+	rr := httptest.NewRecorder()
+	MarkdownToA4CodePage(rr, httptest.NewRequest(http.MethodGet, "/a4code/markdown", nil))
+	if rr.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("status=%d", rr.Code)
+	}
+}
+
+func TestMarkdownToA4CodePageTooLarge(t *testing.T) {
+	rr := httptest.NewRecorder()
+	body := strings.NewReader(strings.Repeat("a", markdownMaxBytes+1))
+	MarkdownToA4CodePage(rr, httptest.NewRequest(http.MethodPost, "/a4code/markdown", body))
+	if rr.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("status=%d", rr.Code)
+	}
+}
+
+// TestMarkdownToA4CodePageThroughCSRF posts the way site.js does: the token is
+// read from the gorilla.csrf.Token field of the page and sent as a header.
+func TestMarkdownToA4CodePageThroughCSRF(t *testing.T) {
+	core.Store = sessions.NewCookieStore([]byte("testsecret"))
+	core.SessionName = "test-session"
+
+	r := mux.NewRouter()
+	r.HandleFunc("/editor", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(csrf.TemplateField(r)))
+	}).Methods("GET")
+	r.HandleFunc("/a4code/markdown", MarkdownToA4CodePage).Methods("POST")
+	handler := csrf.NewCSRFMiddleware("testsecret", "http://example.com", "dev")(r)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "http://example.com/editor", nil))
+	m := regexp.MustCompile(`name="gorilla\.csrf\.Token" value="([^"]+)"`).FindStringSubmatch(rr.Body.String())
+	if m == nil {
+		t.Fatalf("no csrf field in %q", rr.Body.String())
+	}
+	cookie := rr.Header().Get("Set-Cookie")
+
+	post := func(token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "http://example.com/a4code/markdown", strings.NewReader("**Hi**"))
+		req.Header.Set("Content-Type", "text/plain")
+		req.Header.Set("Cookie", cookie)
+		if token != "" {
+			req.Header.Set("X-CSRF-Token", token)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	if rr := post(""); rr.Code != http.StatusForbidden {
+		t.Fatalf("without token status=%d", rr.Code)
+	}
+	rr = post(m[1])
+	if rr.Code != http.StatusOK {
+		t.Fatalf("with token status=%d", rr.Code)
+	}
+	if got, want := rr.Body.String(), "[b Hi]"; got != want {
+		t.Errorf("body=%q want %q", got, want)
+	}
+}
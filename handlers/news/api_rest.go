@@ -0,0 +1,90 @@
+package news
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/gorilla/mux"
+)
+
+// APIListPosts handles GET /api/news/posts
+func APIListPosts(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	page := handlers.APIPage(r)
+	pageSize := cd.PageSize()
+
+	posts, err := cd.LatestNewsList(int32((page-1)*pageSize), int32(pageSize+1))
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	hasMore := len(posts) > pageSize
+	if hasMore {
+		posts = posts[:pageSize]
+	}
+
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"posts":    posts,
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APIShowPost handles GET /api/news/post/{news}
+func APIShowPost(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	postID, err := strconv.Atoi(mux.Vars(r)["news"])
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusBadRequest, "Invalid news ID")
+		return
+	}
+	if !cd.HasGrant("news", "post", "view", int32(postID)) {
+		handlers.WriteJSONError(w, http.StatusNotFound, "News post not found")
+		return
+	}
+	post, err := cd.Queries().GetNewsPostByIdWithWriterIdAndThreadCommentCount(r.Context(), db.GetNewsPostByIdWithWriterIdAndThreadCommentCountParams{
+		ViewerID: cd.UserID,
+		ID:       int32(postID),
+		UserID:   sql.NullInt32{Int32: cd.UserID, Valid: cd.UserID != 0},
+	})
+	if err != nil || post == nil {
+		handlers.WriteJSONError(w, http.StatusNotFound, "News post not found")
+		return
+	}
+
+	comments, err := cd.SectionThreadComments("news", "post", post.ForumthreadID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	page := handlers.APIPage(r)
+	comments, hasMore := handlers.APIPaginate(comments, page, cd.PageSize())
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"post":     post,
+		"comments": handlers.APIComments(cd, r, comments),
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APICreatePost handles POST /api/news/posts
+func APICreatePost(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if !CanPostNews(cd) {
+		handlers.WriteJSONError(w, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+		return
+	}
+	handlers.APITaskHandler(newPostTask)(w, r)
+}
+
+// APIPostComment handles POST /api/news/post/{news}/reply
+func APIPostComment(w http.ResponseWriter, r *http.Request) {
+	handlers.APITaskHandler(replyTask)(w, r)
+}
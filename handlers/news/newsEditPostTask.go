@@ -78,6 +78,17 @@ func (EditTask) Action(w http.ResponseWriter, r *http.Request) any {
 		return fmt.Errorf("set author labels fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
+	// The field is only offered while the post is still scheduled.
+	if _, ok := r.PostForm["publish_at"]; ok {
+		publishAt, err := cd.ParsePublishAt(r.PostFormValue("publish_at"))
+		if err != nil {
+			return fmt.Errorf("publish at parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+		if _, err := cd.ReschedulePublication(common.ScheduledTypeNews, int32(postId), publishAt); err != nil {
+			return fmt.Errorf("reschedule news post fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+	}
+
 	return nil
 }
 
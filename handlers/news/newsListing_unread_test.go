@@ -45,6 +45,7 @@ func (m *mockCD) IsAdmin() bool                               { return false }
 func (m *mockCD) IsAdminMode() bool                           { return false }
 func (m *mockCD) NewsAnnouncement(int32) *db.SiteAnnouncement { return nil }
 func (m *mockCD) SelectedThreadCanReply() bool                { return false } // Added to match previous test dependencies if needed
+func (m *mockCD) Reactions(string, int32) any                 { return nil }
 
 func TestNewsListingDismissLink(t *testing.T) {
 	t.Run("Happy Path", func(t *testing.T) {
@@ -86,7 +87,8 @@ func TestNewsListingDismissLink(t *testing.T) {
 {{ define "tail" }}{{ end }}
 {{ define "threadComments" }}{{ end }}
 {{ define "comment" }}{{ end }}
-{{ define "languageCombobox" }}{{ end }}`))
+{{ define "languageCombobox" }}{{ end }}
+{{ define "reactions" }}{{ end }}`))
 
 		var buf bytes.Buffer
 		if err := tmpl.ExecuteTemplate(&buf, "page.gohtml", nil); err != nil {
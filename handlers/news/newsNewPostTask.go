@@ -25,6 +25,7 @@ var (
 	_ notif.SubscribersNotificationTemplateProvider = (*NewPostTask)(nil)
 	_ notif.AdminEmailTemplateProvider              = (*NewPostTask)(nil)
 	_ notif.AutoSubscribeProvider                   = (*NewPostTask)(nil)
+	_ notif.MentionsNotificationProvider            = (*NewPostTask)(nil)
 	_ tasks.EmailTemplatesRequired                  = (*NewPostTask)(nil)
 )
 
@@ -37,17 +38,27 @@ func (NewPostTask) AdminInternalNotificationTemplate(evt eventbus.TaskEvent) *st
 	return &v
 }
 
+// SubscribedEmailTemplate stays quiet for scheduled posts; the publish worker
+// notifies subscribers once the post goes live.
 func (NewPostTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	if common.EventScheduled(evt) {
+		return nil, false
+	}
 	return EmailTemplateNewsAdd.EmailTemplates(), true
 }
 
 func (NewPostTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	if common.EventScheduled(evt) {
+		return nil
+	}
 	s := NotificationTemplateNewsAdd.NotificationTemplate()
 	return &s
 }
 
 func (NewPostTask) RequiredTemplates() []tasks.Template {
-	return append(EmailTemplateAdminNotificationNewsAdd.RequiredTemplates(), EmailTemplateNewsAdd.RequiredTemplates()...)
+	r := append(EmailTemplateAdminNotificationNewsAdd.RequiredTemplates(), EmailTemplateNewsAdd.RequiredTemplates()...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 // AutoSubscribePath links the newly created post so that any future replies notify the author by default.
@@ -68,6 +79,21 @@ func (NewPostTask) AutoSubscribeGrants(evt eventbus.TaskEvent) ([]notif.GrantReq
 	return nil, nil
 }
 
+// MentionEmailTemplate emails users mentioned with @username.
+func (NewPostTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (NewPostTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (NewPostTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return NewPostTask{}.AutoSubscribeGrants(evt)
+}
+
 func (NewPostTask) Action(w http.ResponseWriter, r *http.Request) any {
 	if err := handlers.ValidateForm(r, []string{"language", "text"}, []string{"language", "text"}); err != nil {
 		return fmt.Errorf("validation fail %w", err)
@@ -78,6 +104,10 @@ func (NewPostTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}
 	text := r.PostFormValue("text")
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	publishAt, err := cd.ParsePublishAt(r.PostFormValue("publish_at"))
+	if err != nil {
+		return fmt.Errorf("publish at parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
 	session := cd.GetSession()
 	uid, _ := session.Values["UID"].(int32)
 
@@ -90,6 +120,13 @@ func (NewPostTask) Action(w http.ResponseWriter, r *http.Request) any {
 	if err != nil {
 		return fmt.Errorf("create news post fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
+	scheduled, err := cd.SchedulePublication(common.ScheduledTypeNews, int32(id), uid, publishAt)
+	if err != nil {
+		return fmt.Errorf("schedule news post fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if scheduled {
+		cd.MarkEventScheduled()
+	}
 
 	if u, err := cd.CurrentUser(); err == nil && u != nil {
 		if evt := cd.Event(); evt != nil {
@@ -101,6 +138,10 @@ func (NewPostTask) Action(w http.ResponseWriter, r *http.Request) any {
 		}
 	}
 
+	if !scheduled {
+		cd.RecordMentions(text, fmt.Sprintf("/news/news/%d", id))
+	}
+
 	handlers.RedirectSeeOther(w, r, fmt.Sprintf("/news/news/%d", id))
 
 	return nil
@@ -28,6 +28,7 @@ func (*fakeCD) NewsAnnouncement(int32) *db.SiteAnnouncement
 func (*fakeCD) Location() *time.Location                                             { return time.UTC }
 func (*fakeCD) LocalTime(t time.Time) time.Time                                      { return t }
 func (*fakeCD) LocalTimeIn(t time.Time, _ string) time.Time                          { return t }
+func (*fakeCD) Reactions(string, int32) any                                          { return nil }
 func (*fakeCD) NewsLabels(int32, int32) []templates.TopicLabel {
 	return []templates.TopicLabel{{Name: "foo", Type: "author"}}
 }
@@ -70,7 +71,8 @@ func TestNewsPostPageLabelBars(t *testing.T) {
 {{ define "threadComments" }}{{ end }}
 {{ define "comment" }}{{ end }}
 {{ define "topicLabels" }}{{ end }}
-{{ define "languageCombobox" }}{{ end }}`))
+{{ define "languageCombobox" }}{{ end }}
+{{ define "reactions" }}{{ end }}`))
 
 		post := &db.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow{
 			Idsitenews:   1,
@@ -143,7 +145,7 @@ func TestNewsPostPagePrivateLabelsOnce(t *testing.T) {
 			filepath.Join(base, "domains", "news", "post.gohtml"),
 			filepath.Join(base, "partials/common/_share.gohtml"),
 		))
-		tmpl = template.Must(tmpl.Parse(`{{ define "head" }}{{ end }}{{ define "tail" }}{{ end }}{{ define "threadComments" }}{{ end }}{{ define "comment" }}{{ end }}{{ define "topicLabels" }}{{ end }}{{ define "languageCombobox" }}{{ end }}`))
+		tmpl = template.Must(tmpl.Parse(`{{ define "head" }}{{ end }}{{ define "tail" }}{{ end }}{{ define "threadComments" }}{{ end }}{{ define "comment" }}{{ end }}{{ define "topicLabels" }}{{ end }}{{ define "languageCombobox" }}{{ end }}{{ define "reactions" }}{{ end }}`))
 
 		post := &db.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow{
 			Idsitenews:   1,
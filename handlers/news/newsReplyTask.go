@@ -27,8 +27,10 @@ var (
 
 	_ tasks.Task                                    = (*ReplyTask)(nil)
 	_ notif.SubscribersNotificationTemplateProvider = (*ReplyTask)(nil)
+	_ notif.EmailReplyProvider                      = (*ReplyTask)(nil)
 	_ notif.AdminEmailTemplateProvider              = (*ReplyTask)(nil)
 	_ notif.AutoSubscribeProvider                   = (*ReplyTask)(nil)
+	_ notif.MentionsNotificationProvider            = (*ReplyTask)(nil)
 	_ tasks.EmailTemplatesRequired                  = (*ReplyTask)(nil)
 )
 
@@ -43,6 +45,16 @@ func (ReplyTask) IndexData(data map[string]any) []searchworker.IndexEventData {
 
 var _ searchworker.IndexedTask = ReplyTask{}
 
+// EmailReplyPath sends email replies to the comment form of the news post,
+// also when the comment came in through the API.
+func (ReplyTask) EmailReplyPath(evt eventbus.TaskEvent) (string, bool) {
+	target, ok := evt.Data["target"].(notif.Target)
+	if !ok || evt.Outcome != eventbus.TaskOutcomeSuccess {
+		return "", false
+	}
+	return fmt.Sprintf("/news/news/%d", target.ID), true
+}
+
 func (ReplyTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
 	return EmailTemplateNewsReply.EmailTemplates(), evt.Outcome == eventbus.TaskOutcomeSuccess
 }
@@ -68,7 +80,9 @@ func (ReplyTask) AdminInternalNotificationTemplate(evt eventbus.TaskEvent) *stri
 }
 
 func (ReplyTask) RequiredTemplates() []tasks.Template {
-	return append(EmailTemplateNewsReply.RequiredTemplates(), EmailTemplateAdminNotificationNewsReply.RequiredTemplates()...)
+	r := append(EmailTemplateNewsReply.RequiredTemplates(), EmailTemplateAdminNotificationNewsReply.RequiredTemplates()...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 // AutoSubscribePath registers this reply so the author automatically follows subsequent comments on the news post.
@@ -89,6 +103,21 @@ func (ReplyTask) AutoSubscribeGrants(evt eventbus.TaskEvent) ([]notif.GrantRequi
 	return nil, nil
 }
 
+// MentionEmailTemplate emails users mentioned with @username.
+func (ReplyTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (ReplyTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (ReplyTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return ReplyTask{}.AutoSubscribeGrants(evt)
+}
+
 func (ReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	session := cd.GetSession()
@@ -135,9 +164,13 @@ func (ReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 		MarkThreadRead:       true,
 		IncludePostCount:     true,
 		IncludeSearch:        true,
+		AdditionalData: map[string]any{
+			"target": notif.Target{Type: "news", ID: int32(pid)},
+		},
 	}); err != nil {
 		log.Printf("news reply side effects: %v", err)
 	}
+	cd.RecordMentions(text, fmt.Sprintf("/news/news/%d", pid))
 
 	return nil
 }
@@ -52,6 +52,11 @@ func NewsRssPage(w http.ResponseWriter, r *http.Request) {
 		if !cd.HasGrant("news", "post", "see", row.Idsitenews) {
 			continue
 		}
+		// Feeds only carry live posts, even for the author. Anonymous
+		// listings already leave scheduled posts out.
+		if cd.UserID != 0 && !cd.ScheduledLive(common.ScheduledTypeNews, row.Idsitenews) {
+			continue
+		}
 		text := row.News.String
 		conv := a4code2html.New(cd.ImageURLMapper, a4code2html.FullImageURLMapper(cd.MapFullImageURL))
 		conv.CodeType = a4code2html.CTTagStrip
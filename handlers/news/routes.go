@@ -9,6 +9,7 @@ import (
 
 	"github.com/arran4/goa4web/config"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/middleware/apiauth"
 	"github.com/arran4/goa4web/internal/router"
 
 	"github.com/arran4/goa4web/handlers/share"
@@ -60,6 +61,19 @@ func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []navpkg.RouterOptio
 
 	api := r.PathPrefix("/api/news").Subrouter()
 	api.HandleFunc("/share", share.ShareLink).Methods("GET")
+
+	rest := api.NewRoute().Subrouter()
+	rest.Use(apiauth.APIKeyAuthMiddleware)
+
+	apiRead := rest.NewRoute().Subrouter()
+	apiRead.Use(apiauth.RequireScope("news:read"))
+	apiRead.HandleFunc("/posts", APIListPosts).Methods(http.MethodGet)
+	apiRead.HandleFunc("/post/{news}", APIShowPost).Methods(http.MethodGet)
+
+	apiWrite := rest.NewRoute().Subrouter()
+	apiWrite.Use(apiauth.RequireScope("news:write"))
+	apiWrite.HandleFunc("/posts", APICreatePost).Methods(http.MethodPost)
+	apiWrite.HandleFunc("/post/{news}/reply", APIPostComment).Methods(http.MethodPost)
 	return opts
 }
 
@@ -68,4 +82,5 @@ func Register(reg *router.Registry) {
 	reg.RegisterModule("news", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
 		return RegisterRoutes(r, cfg)
 	})
+	reg.RegisterSitemap("news", sitemapEntries)
 }
@@ -2,7 +2,6 @@ package news
 
 import (
 	"database/sql"
-	"errors"
 	"github.com/arran4/goa4web/internal/tasks"
 	"log"
 	"net/http"
@@ -12,7 +11,6 @@ import (
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/db"
-	searchutil "github.com/arran4/goa4web/workers/searchworker"
 )
 
 func SearchResultNewsActionPage(w http.ResponseWriter, r *http.Request) {
@@ -42,7 +40,8 @@ func SearchResultNewsActionPage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if comments, emptyWords, noResults, err := forumCommentSearchInRestrictedTopic(w, r, queries, []int32{ftbn.Idforumtopic}, uid); err != nil {
+	if comments, emptyWords, noResults, err := cd.SearchTopicComments(r, []int32{ftbn.Idforumtopic}); err != nil {
+		handlers.RenderErrorPage(w, r, err)
 		return
 	} else {
 		data.Comments = comments
@@ -63,77 +62,3 @@ func SearchResultNewsActionPage(w http.ResponseWriter, r *http.Request) {
 }
 
 const SearchResultNewsActionPageTmpl tasks.Template = "domains/search/resultNewsActionPage.gohtml"
-
-func forumCommentSearchInRestrictedTopic(w http.ResponseWriter, r *http.Request, queries db.Querier, forumTopicId []int32, uid int32) ([]*db.GetCommentsByIdsForUserWithThreadInfoRow, bool, bool, error) {
-	searchWords := searchutil.BreakupTextToWords(r.PostFormValue("searchwords"))
-	var commentIds []int32
-
-	if len(searchWords) == 0 {
-		return nil, true, false, nil
-	}
-
-	for i, word := range searchWords {
-		if i == 0 {
-			ids, err := queries.ListCommentIDsBySearchWordFirstForListerInRestrictedTopic(r.Context(), db.ListCommentIDsBySearchWordFirstForListerInRestrictedTopicParams{
-				ListerID: uid,
-				Word: sql.NullString{
-					String: word,
-					Valid:  true,
-				},
-				Ftids:  forumTopicId,
-				UserID: sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				switch {
-				case errors.Is(err, sql.ErrNoRows):
-				default:
-					log.Printf("ListCommentIDsBySearchWordFirstForListerInRestrictedTopic Error: %s", err)
-					handlers.RenderErrorPage(w, r, err)
-					return nil, false, false, err
-				}
-			}
-			commentIds = ids
-		} else {
-			ids, err := queries.ListCommentIDsBySearchWordNextForListerInRestrictedTopic(r.Context(), db.ListCommentIDsBySearchWordNextForListerInRestrictedTopicParams{
-				ListerID: uid,
-				Word: sql.NullString{
-					String: word,
-					Valid:  true,
-				},
-				Ids:    commentIds,
-				Ftids:  forumTopicId,
-				UserID: sql.NullInt32{Int32: uid, Valid: uid != 0},
-			})
-			if err != nil {
-				switch {
-				case errors.Is(err, sql.ErrNoRows):
-				default:
-					log.Printf("ListCommentIDsBySearchWordNextForListerInRestrictedTopic Error: %s", err)
-					handlers.RenderErrorPage(w, r, err)
-					return nil, false, false, err
-				}
-			}
-			commentIds = ids
-		}
-		if len(commentIds) == 0 {
-			return nil, false, true, nil
-		}
-	}
-
-	comments, err := queries.GetCommentsByIdsForUserWithThreadInfo(r.Context(), db.GetCommentsByIdsForUserWithThreadInfoParams{
-		ViewerID: uid,
-		Ids:      commentIds,
-		UserID:   sql.NullInt32{Int32: uid, Valid: uid != 0},
-	})
-	if err != nil {
-		switch {
-		case errors.Is(err, sql.ErrNoRows):
-		default:
-			log.Printf("getCommentsByIds Error: %s", err)
-			handlers.RenderErrorPage(w, r, err)
-			return nil, false, false, err
-		}
-	}
-
-	return comments, false, false, nil
-}
@@ -9,14 +9,29 @@ import (
 	"testing"
 	"time"
 
+	"github.com/arran4/goa4web/core/common"
 	"github.com/arran4/goa4web/handlers/handlertest"
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 )
 
+type fakeSearchBackend struct {
+	hits  []search.Hit
+	query search.Query
+}
+
+func (*fakeSearchBackend) Index(context.Context, search.Document) error { return nil }
+func (*fakeSearchBackend) Delete(context.Context, string, int32) error  { return nil }
+func (*fakeSearchBackend) Reset(context.Context, string) error          { return nil }
+func (f *fakeSearchBackend) Search(_ context.Context, q search.Query) ([]search.Hit, error) {
+	f.query = q
+	return f.hits, nil
+}
+
 func TestNewsSearchFiltersUnauthorized(t *testing.T) {
 	t.Run("Happy Path", func(t *testing.T) {
-		cd, stub := handlertest.NewCoreData(t, context.Background())
-		stub.ListSiteNewsSearchFirstForListerReturns = []int32{1, 2}
+		backend := &fakeSearchBackend{hits: []search.Hit{{ID: 2, Score: 2}, {ID: 1, Score: 1}}}
+		cd, stub := handlertest.NewCoreData(t, context.Background(), common.WithSearchBackend(backend))
 		stub.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountReturns = []*db.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow{
 			{
 				Writername:    sql.NullString{String: "bob", Valid: true},
@@ -50,5 +65,47 @@ func TestNewsSearchFiltersUnauthorized(t *testing.T) {
 		if news[0].Idsitenews != 1 {
 			t.Errorf("unexpected id %d", news[0].Idsitenews)
 		}
+		if backend.query.Type != search.TypeNews || backend.query.Text != "foo" {
+			t.Errorf("unexpected query %+v", backend.query)
+		}
 	})
 }
+
+type pagedSearchBackend struct {
+	fakeSearchBackend
+	calls int
+}
+
+func (f *pagedSearchBackend) Search(_ context.Context, q search.Query) ([]search.Hit, error) {
+	f.calls++
+	return search.Page(f.hits, q.Offset, q.Limit), nil
+}
+
+func TestNewsSearchPagesPastHiddenHits(t *testing.T) {
+	// The best ranked thousand hits are all hidden from the viewer; the
+	// visible post ranks below them.
+	var hits []search.Hit
+	for id := int32(2000); id > 1000; id-- {
+		hits = append(hits, search.Hit{ID: id, Score: float64(id)})
+	}
+	hits = append(hits, search.Hit{ID: 1, Score: 1})
+	backend := &pagedSearchBackend{fakeSearchBackend: fakeSearchBackend{hits: hits}}
+	cd, stub := handlertest.NewCoreData(t, context.Background(), common.WithSearchBackend(backend))
+	stub.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountReturns = []*db.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow{
+		{Idsitenews: 1, News: sql.NullString{String: "text", Valid: true}},
+	}
+
+	form := url.Values{"searchwords": {"foo"}}
+	req := httptest.NewRequest("POST", "/", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	news, _, noResults, err := cd.SearchNews(req, 1)
+	if err != nil {
+		t.Fatalf("NewsSearch: %v", err)
+	}
+	if noResults || len(news) != 1 || news[0].Idsitenews != 1 {
+		t.Fatalf("news %+v noResults %v", news, noResults)
+	}
+	if backend.calls != 2 {
+		t.Fatalf("backend searched %d times", backend.calls)
+	}
+}
@@ -0,0 +1,26 @@
+package news
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// sitemapEntries lists the news index and every post anonymous visitors may
+// open.
+func sitemapEntries(ctx context.Context, q db.Querier) ([]sitemap.Entry, error) {
+	rows, err := q.SystemListSitemapNewsPosts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list news posts: %w", err)
+	}
+	entries := make([]sitemap.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, sitemap.Entry{
+			Path:    fmt.Sprintf("/news/news/%d", row.Idsitenews),
+			LastMod: row.Occurred.Time,
+		})
+	}
+	return append([]sitemap.Entry{{Path: "/news", LastMod: sitemap.Latest(entries)}}, entries...), nil
+}
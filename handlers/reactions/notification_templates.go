@@ -0,0 +1,10 @@
+package reactions
+
+import (
+	notif "github.com/arran4/goa4web/internal/notifications"
+)
+
+const (
+	EmailTemplateReaction        notif.EmailTemplateName        = "reactionEmail"
+	NotificationTemplateReaction notif.NotificationTemplateName = "reaction"
+)
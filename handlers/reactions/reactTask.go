@@ -0,0 +1,203 @@
+package reactions
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/eventbus"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/subscriptions"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// mutePrefix is prepended to the event path when looking for subscriptions
+// that silence reaction notifications.
+const mutePrefix = "mute react:"
+
+// ReactTask toggles the viewer's reaction on an item and tells its author.
+type ReactTask struct{ tasks.TaskString }
+
+var reactTask = &ReactTask{TaskString: TaskReact}
+
+var _ tasks.Task = (*ReactTask)(nil)
+var _ tasks.AuditableTask = (*ReactTask)(nil)
+var _ tasks.TemplatesRequired = (*ReactTask)(nil)
+var _ notif.TargetUsersNotificationProvider = (*ReactTask)(nil)
+
+// loadTarget resolves the item addressed by the route variables.
+func loadTarget(r *http.Request) (*common.ReactionTarget, error) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	vars := mux.Vars(r)
+	itemType := vars["type"]
+	if !common.ValidReactionType(itemType) {
+		return nil, handlers.ErrNotFound
+	}
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		return nil, handlers.ErrBadRequest
+	}
+	t, err := cd.LoadReactionTarget(itemType, int32(id))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, handlers.ErrNotFound
+	case err != nil:
+		log.Printf("load reaction target: %v", err)
+		return nil, common.ErrInternalServerError
+	case t == nil || !cd.CanViewReactionTarget(t):
+		return nil, handlers.ErrNotFound
+	}
+	return t, nil
+}
+
+// itemLabel describes an item type in notifications.
+func itemLabel(itemType string) string {
+	switch itemType {
+	case common.ReactionTypeBlog:
+		return "blog post"
+	case common.ReactionTypeNews:
+		return "news post"
+	case common.ReactionTypeImagePost:
+		return "image post"
+	}
+	return itemType
+}
+
+// ItemRedirect sends the browser to the page showing the item. Reaction
+// notifications link here.
+func ItemRedirect(w http.ResponseWriter, r *http.Request) {
+	t, err := loadTarget(r)
+	if err != nil {
+		handlers.RenderErrorPage(w, r, err)
+		return
+	}
+	http.Redirect(w, r, t.URL, http.StatusSeeOther)
+}
+
+func (ReactTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	t, err := loadTarget(r)
+	if err != nil {
+		return fmt.Errorf("load item fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if !cd.CanReact(t) {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { handlers.RenderErrorPage(w, r, handlers.ErrForbidden) })
+	}
+	reaction, ok := cd.ReactionByName(r.PostFormValue("reaction"))
+	if !ok {
+		return fmt.Errorf("unknown reaction %w", handlers.ErrRedirectOnSamePageHandler(handlers.ErrBadRequest))
+	}
+	added, err := cd.ToggleReaction(t, reaction.Name)
+	if err != nil {
+		return fmt.Errorf("toggle reaction fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data["ItemType"] = t.ItemType
+		evt.Data["ItemID"] = t.ItemID
+		evt.Data["ItemLabel"] = itemLabel(t.ItemType)
+		evt.Data["Reaction"] = reaction.Name
+		evt.Data["Emoji"] = reaction.Emoji
+		evt.Data["Added"] = added
+		evt.Data["URL"] = cd.AbsoluteURL(t.URL)
+		if u, _ := cd.CurrentUser(); u != nil && u.Username.Valid {
+			evt.Data["Username"] = u.Username.String
+		}
+		// Only new reactions by someone other than the author are worth
+		// telling the author about.
+		if added && t.AuthorID != 0 && t.AuthorID != cd.UserID {
+			evt.Data["AuthorID"] = t.AuthorID
+			email, internal := notifyMethods(r, t.AuthorID, evt.Path)
+			evt.Data["NotifyEmail"] = email
+			evt.Data["NotifyInternal"] = internal
+		}
+	}
+
+	back, _ := cd.SanitizeBackURL(r, r.PostFormValue("back"))
+	if back == "" {
+		back, _ = cd.SanitizeBackURL(r, r.Header.Get("Referer"))
+	}
+	if back == "" {
+		back = t.URL
+	}
+	return handlers.RefreshDirectHandler{TargetURL: back}
+}
+
+// notifyMethods reports which notification methods the author has not muted
+// with a "mute react:" subscription matching path.
+func notifyMethods(r *http.Request, authorID int32, path string) (email, internal bool) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	email, internal = true, true
+	subs, err := cd.Queries().ListSubscriptionsByUser(r.Context(), authorID)
+	if err != nil {
+		log.Printf("list author subscriptions: %v", err)
+		return
+	}
+	for _, s := range subs {
+		if _, ok := subscriptions.MatchPattern(s.Pattern, mutePrefix+path); !ok {
+			continue
+		}
+		switch s.Method {
+		case "email":
+			email = false
+		case "internal":
+			internal = false
+		}
+	}
+	return
+}
+
+// AuditRecord summarises a reaction being added or removed.
+func (ReactTask) AuditRecord(data map[string]any) string {
+	user, _ := data["Username"].(string)
+	reaction, _ := data["Reaction"].(string)
+	typ, _ := data["ItemType"].(string)
+	id, _ := data["ItemID"].(int32)
+	if added, _ := data["Added"].(bool); !added {
+		return fmt.Sprintf("%s removed %s reaction from %s %d", user, reaction, typ, id)
+	}
+	return fmt.Sprintf("%s reacted %s to %s %d", user, reaction, typ, id)
+}
+
+func (ReactTask) TargetUserIDs(evt eventbus.TaskEvent) ([]int32, error) {
+	id, ok := evt.Data["AuthorID"].(int32)
+	if !ok {
+		return nil, nil
+	}
+	email, _ := evt.Data["NotifyEmail"].(bool)
+	internal, _ := evt.Data["NotifyInternal"].(bool)
+	if !email && !internal {
+		return nil, nil
+	}
+	return []int32{id}, nil
+}
+
+func (ReactTask) TargetEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	if email, _ := evt.Data["NotifyEmail"].(bool); !email {
+		return nil, false
+	}
+	return EmailTemplateReaction.EmailTemplates(), true
+}
+
+func (ReactTask) TargetInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	if internal, _ := evt.Data["NotifyInternal"].(bool); !internal {
+		return nil
+	}
+	v := NotificationTemplateReaction.NotificationTemplate()
+	return &v
+}
+
+func (ReactTask) RequiredTemplates() []tasks.Template {
+	return append(EmailTemplateReaction.RequiredTemplates(), NotificationTemplateReaction.RequiredTemplates()...)
+}
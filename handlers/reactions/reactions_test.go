@@ -0,0 +1,130 @@
+package reactions
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/core/templates"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+type reactionQueries struct {
+	db.Querier
+	subs     []*db.ListSubscriptionsByUserRow
+	inserted []db.InsertReactionForReactorParams
+}
+
+func (q *reactionQueries) GetBlogEntryForListerByID(_ context.Context, arg db.GetBlogEntryForListerByIDParams) (*db.GetBlogEntryForListerByIDRow, error) {
+	return &db.GetBlogEntryForListerByIDRow{Idblogs: arg.ID, UsersIdusers: 3}, nil
+}
+
+func (q *reactionQueries) DeleteReactionForReactor(context.Context, db.DeleteReactionForReactorParams) (int64, error) {
+	return 0, nil
+}
+
+func (q *reactionQueries) InsertReactionForReactor(_ context.Context, arg db.InsertReactionForReactorParams) error {
+	q.inserted = append(q.inserted, arg)
+	return nil
+}
+
+func (q *reactionQueries) ListSubscriptionsByUser(context.Context, int32) ([]*db.ListSubscriptionsByUserRow, error) {
+	return q.subs, nil
+}
+
+func (q *reactionQueries) SystemCheckGrant(context.Context, db.SystemCheckGrantParams) (int32, error) {
+	return 1, nil
+}
+
+func (q *reactionQueries) SystemGetUserByID(_ context.Context, id int32) (*db.SystemGetUserByIDRow, error) {
+	return &db.SystemGetUserByIDRow{Idusers: id, Username: sql.NullString{String: "bob", Valid: true}}, nil
+}
+
+func newReactRequest(q db.Querier, reaction string) (*http.Request, *common.CoreData) {
+	form := url.Values{"task": {string(TaskReact)}, "reaction": {reaction}, "back": {"/blogs"}}
+	req := httptest.NewRequest(http.MethodPost, "/reactions/blog/4", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = mux.SetURLVars(req, map[string]string{"type": "blog", "id": "4"})
+	cfg := config.NewRuntimeConfig()
+	cfg.Reactions = "like=👍"
+	cd := common.NewCoreData(req.Context(), q, cfg,
+		common.WithPermissions([]*db.GetPermissionsByUserIDRow{{Name: "user"}}),
+		common.WithEvent(&eventbus.TaskEvent{Path: "/reactions/blog/4", Task: TaskReact}),
+	)
+	cd.UserID = 9
+	return req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd)), cd
+}
+
+func TestHappyPathReactionTasksTemplatesRequiredExist(t *testing.T) {
+	for _, name := range reactTask.RequiredTemplates() {
+		if !name.Exists(templates.WithSilence(true)) {
+			t.Fatalf("missing template: %s", name)
+		}
+	}
+}
+
+func TestReactTaskNotifiesAuthor(t *testing.T) {
+	q := &reactionQueries{}
+	req, cd := newReactRequest(q, "like")
+
+	res := reactTask.Action(httptest.NewRecorder(), req)
+	if rdh, ok := res.(handlers.RefreshDirectHandler); !ok || rdh.TargetURL != "/blogs" {
+		t.Fatalf("result=%#v", res)
+	}
+	if len(q.inserted) != 1 || q.inserted[0].Reaction != "like" || q.inserted[0].ReactorID != 9 {
+		t.Fatalf("inserted=%+v", q.inserted)
+	}
+	evt := *cd.Event()
+	if ids, _ := reactTask.TargetUserIDs(evt); len(ids) != 1 || ids[0] != 3 {
+		t.Fatalf("targets=%v", ids)
+	}
+	if _, send := reactTask.TargetEmailTemplate(evt); !send {
+		t.Fatalf("expected email")
+	}
+	if reactTask.TargetInternalNotificationTemplate(evt) == nil {
+		t.Fatalf("expected internal notification")
+	}
+}
+
+func TestReactTaskRespectsMute(t *testing.T) {
+	q := &reactionQueries{subs: []*db.ListSubscriptionsByUserRow{{Pattern: "mute react:/reactions/*", Method: "email"}}}
+	req, cd := newReactRequest(q, "like")
+
+	reactTask.Action(httptest.NewRecorder(), req)
+	evt := *cd.Event()
+	if _, send := reactTask.TargetEmailTemplate(evt); send {
+		t.Fatalf("email should be muted")
+	}
+	if reactTask.TargetInternalNotificationTemplate(evt) == nil {
+		t.Fatalf("internal notification should not be muted")
+	}
+}
+
+func TestReactTaskRejectsUnknownReaction(t *testing.T) {
+	q := &reactionQueries{}
+	req, _ := newReactRequest(q, "wow")
+	if _, ok := reactTask.Action(httptest.NewRecorder(), req).(error); !ok {
+		t.Fatalf("expected error")
+	}
+	if len(q.inserted) != 0 {
+		t.Fatalf("reaction stored: %+v", q.inserted)
+	}
+}
+
+func TestReactAuditRecord(t *testing.T) {
+	got := reactTask.AuditRecord(map[string]any{"Username": "bob", "Reaction": "like", "ItemType": "news", "ItemID": int32(4), "Added": true})
+	if want := "bob reacted like to news 4"; got != want {
+		t.Fatalf("AuditRecord = %q, want %q", got, want)
+	}
+}
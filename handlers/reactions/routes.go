@@ -0,0 +1,29 @@
+package reactions
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/router"
+
+	navpkg "github.com/arran4/goa4web/internal/navigation"
+)
+
+// RegisterRoutes attaches the reaction endpoints to r.
+func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []navpkg.RouterOptions {
+	rr := r.PathPrefix("/reactions").Subrouter()
+	rr.NotFoundHandler = http.HandlerFunc(handlers.RenderNotFoundOrLogin)
+	rr.HandleFunc("/{type:[a-z]+}/{id:[0-9]+}", ItemRedirect).Methods("GET")
+	rr.HandleFunc("/{type:[a-z]+}/{id:[0-9]+}", handlers.TaskHandler(reactTask)).Methods("POST").MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(reactTask.Matcher())
+	return nil
+}
+
+// Register registers the reactions router module.
+func Register(reg *router.Registry) {
+	reg.RegisterModule("reactions", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
+		return RegisterRoutes(r, cfg)
+	})
+}
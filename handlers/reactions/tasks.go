@@ -0,0 +1,11 @@
+package reactions
+
+import "github.com/arran4/goa4web/internal/tasks"
+
+// The following constants define the allowed values of the "task" form field.
+// Each HTML form includes a hidden or submit input named "task" whose value
+// identifies the intended action.
+const (
+	// TaskReact adds or removes a reaction on an item.
+	TaskReact tasks.TaskString = "React"
+)
@@ -0,0 +1,10 @@
+package reactions
+
+import "github.com/arran4/goa4web/internal/tasks"
+
+// RegisterTasks returns reaction related tasks.
+func RegisterTasks() []tasks.NamedTask {
+	return []tasks.NamedTask{
+		reactTask,
+	}
+}
@@ -0,0 +1,9 @@
+package reports
+
+import (
+	notif "github.com/arran4/goa4web/internal/notifications"
+)
+
+const (
+	EmailTemplateAdminNotificationContentReport notif.EmailTemplateName = "adminNotificationContentReportEmail"
+)
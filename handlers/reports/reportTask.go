@@ -0,0 +1,133 @@
+package reports
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/eventbus"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// ReportPageTmpl renders the form used to report an item.
+const ReportPageTmpl tasks.Template = "domains/reports/reportPage.gohtml"
+
+// ReportTask files a report about an item for moderators to review.
+type ReportTask struct{ tasks.TaskString }
+
+var reportTask = &ReportTask{TaskString: TaskReport}
+
+var _ tasks.Task = (*ReportTask)(nil)
+var _ tasks.AuditableTask = (*ReportTask)(nil)
+var _ tasks.TemplatesRequired = (*ReportTask)(nil)
+var _ notif.AdminEmailTemplateProvider = (*ReportTask)(nil)
+
+// loadTarget resolves the item addressed by the route variables.
+func loadTarget(r *http.Request) (*common.ReportTarget, error) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	vars := mux.Vars(r)
+	itemType := vars["type"]
+	if !common.ValidReportType(itemType) {
+		return nil, handlers.ErrNotFound
+	}
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		return nil, handlers.ErrBadRequest
+	}
+	t, err := cd.LoadReportTarget(itemType, int32(id))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, handlers.ErrNotFound
+	case err != nil:
+		log.Printf("load report target: %v", err)
+		return nil, common.ErrInternalServerError
+	case t == nil:
+		return nil, handlers.ErrNotFound
+	}
+	if !cd.CanReport(t) {
+		return nil, handlers.ErrForbidden
+	}
+	return t, nil
+}
+
+// reportPageData is shared by the form and the confirmation shown after a
+// report is filed.
+type reportPageData struct {
+	Target    *common.ReportTarget
+	ItemLabel string
+	Submitted bool
+}
+
+// ReportPage shows the form used to report an item.
+func ReportPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	t, err := loadTarget(r)
+	if err != nil {
+		handlers.RenderErrorPage(w, r, err)
+		return
+	}
+	cd.PageTitle = fmt.Sprintf("Report %s", common.ReportItemLabel(t.ItemType))
+	if err := ReportPageTmpl.Handle(w, r, reportPageData{Target: t, ItemLabel: common.ReportItemLabel(t.ItemType)}); err != nil {
+		log.Printf("report page: %v", err)
+	}
+}
+
+func (ReportTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	t, err := loadTarget(r)
+	if err != nil {
+		return fmt.Errorf("load item fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	id, err := cd.ReportContent(t, r.PostFormValue("reason"))
+	if err != nil {
+		return fmt.Errorf("report fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	label := common.ReportItemLabel(t.ItemType)
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data["ReportID"] = id
+		evt.Data["ItemType"] = t.ItemType
+		evt.Data["ItemID"] = t.ItemID
+		evt.Data["ItemLabel"] = label
+		evt.Data["Reason"] = r.PostFormValue("reason")
+		evt.Data["URL"] = cd.AbsoluteURL(t.URL)
+		evt.Data["QueueURL"] = cd.AbsoluteURL("/admin/reports")
+		if u, _ := cd.CurrentUser(); u != nil && u.Username.Valid {
+			evt.Data["Username"] = u.Username.String
+		}
+	}
+	cd.PageTitle = fmt.Sprintf("Report %s", label)
+	return handlers.TemplateWithDataHandler(ReportPageTmpl, reportPageData{Target: t, ItemLabel: label, Submitted: true})
+}
+
+// AuditRecord summarises a report being filed.
+func (ReportTask) AuditRecord(data map[string]any) string {
+	user, _ := data["Username"].(string)
+	typ, _ := data["ItemType"].(string)
+	id, _ := data["ItemID"].(int32)
+	return fmt.Sprintf("%s reported %s %d", user, typ, id)
+}
+
+func (ReportTask) AdminEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return EmailTemplateAdminNotificationContentReport.EmailTemplates(), true
+}
+
+func (ReportTask) AdminInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	v := EmailTemplateAdminNotificationContentReport.NotificationTemplate()
+	return &v
+}
+
+func (ReportTask) RequiredTemplates() []tasks.Template {
+	return append([]tasks.Template{ReportPageTmpl}, EmailTemplateAdminNotificationContentReport.RequiredTemplates()...)
+}
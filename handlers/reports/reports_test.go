@@ -0,0 +1,113 @@
+package reports
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/core/templates"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+type reportQueries struct {
+	db.Querier
+	pending int64
+	created []db.CreateContentReportForReporterParams
+}
+
+func (q *reportQueries) GetBlogEntryForListerByID(_ context.Context, arg db.GetBlogEntryForListerByIDParams) (*db.GetBlogEntryForListerByIDRow, error) {
+	return &db.GetBlogEntryForListerByIDRow{Idblogs: arg.ID, UsersIdusers: 3}, nil
+}
+
+func (q *reportQueries) SystemCheckGrant(context.Context, db.SystemCheckGrantParams) (int32, error) {
+	return 1, nil
+}
+
+func (q *reportQueries) CountPendingContentReportsForReporter(context.Context, db.CountPendingContentReportsForReporterParams) (int64, error) {
+	return q.pending, nil
+}
+
+func (q *reportQueries) CreateContentReportForReporter(_ context.Context, arg db.CreateContentReportForReporterParams) (int64, error) {
+	q.created = append(q.created, arg)
+	return int64(len(q.created)), nil
+}
+
+func (q *reportQueries) SystemGetUserByID(_ context.Context, id int32) (*db.SystemGetUserByIDRow, error) {
+	return &db.SystemGetUserByIDRow{Idusers: id, Username: sql.NullString{String: "bob", Valid: true}}, nil
+}
+
+func newReportRequest(q db.Querier, userID int32, reason string) (*http.Request, *common.CoreData) {
+	form := url.Values{"task": {string(TaskReport)}, "reason": {reason}}
+	req := httptest.NewRequest(http.MethodPost, "/report/blog/4", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = mux.SetURLVars(req, map[string]string{"type": "blog", "id": "4"})
+	cd := common.NewCoreData(req.Context(), q, config.NewRuntimeConfig(),
+		common.WithPermissions([]*db.GetPermissionsByUserIDRow{{Name: "user"}}),
+		common.WithEvent(&eventbus.TaskEvent{Path: "/report/blog/4", Task: TaskReport}),
+	)
+	cd.UserID = userID
+	return req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd)), cd
+}
+
+func TestHappyPathReportTaskTemplatesRequiredExist(t *testing.T) {
+	for _, name := range reportTask.RequiredTemplates() {
+		if !name.Exists(templates.WithSilence(true)) {
+			t.Fatalf("missing template: %s", name)
+		}
+	}
+}
+
+func TestReportTaskFilesReport(t *testing.T) {
+	q := &reportQueries{}
+	req, cd := newReportRequest(q, 9, "  spam  ")
+
+	if res := reportTask.Action(httptest.NewRecorder(), req); res == nil {
+		t.Fatalf("expected confirmation page")
+	} else if err, ok := res.(error); ok {
+		t.Fatalf("action: %v", err)
+	}
+	if len(q.created) != 1 {
+		t.Fatalf("created=%+v", q.created)
+	}
+	got := q.created[0]
+	if got.ReporterID != 9 || got.AuthorID != 3 || got.ItemType != common.ReportTypeBlog || got.ItemID != 4 || got.Reason != "spam" {
+		t.Fatalf("report=%+v", got)
+	}
+	if rec := reportTask.AuditRecord(cd.Event().Data); rec != "bob reported blog 4" {
+		t.Fatalf("audit=%q", rec)
+	}
+}
+
+func TestReportTaskRejectsDuplicates(t *testing.T) {
+	q := &reportQueries{pending: 1}
+	req, _ := newReportRequest(q, 9, "spam")
+
+	res := reportTask.Action(httptest.NewRecorder(), req)
+	if err, ok := res.(error); !ok || !strings.Contains(err.Error(), common.ErrAlreadyReported.Error()) {
+		t.Fatalf("result=%#v", res)
+	}
+	if len(q.created) != 0 {
+		t.Fatalf("created=%+v", q.created)
+	}
+}
+
+func TestReportTaskRejectsOwnContent(t *testing.T) {
+	q := &reportQueries{}
+	req, _ := newReportRequest(q, 3, "spam")
+
+	res := reportTask.Action(httptest.NewRecorder(), req)
+	if err, ok := res.(error); !ok || !strings.Contains(err.Error(), handlers.ErrForbidden.Error()) {
+		t.Fatalf("result=%#v", res)
+	}
+}
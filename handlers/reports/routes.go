@@ -0,0 +1,29 @@
+package reports
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/router"
+
+	navpkg "github.com/arran4/goa4web/internal/navigation"
+)
+
+// RegisterRoutes attaches the content report endpoints to r.
+func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []navpkg.RouterOptions {
+	rr := r.PathPrefix("/report").Subrouter()
+	rr.NotFoundHandler = http.HandlerFunc(handlers.RenderNotFoundOrLogin)
+	rr.HandleFunc("/{type:[a-z]+}/{id:[0-9]+}", ReportPage).Methods("GET").MatcherFunc(handlers.RequiresAnAccount())
+	rr.HandleFunc("/{type:[a-z]+}/{id:[0-9]+}", handlers.TaskHandler(reportTask)).Methods("POST").MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(reportTask.Matcher())
+	return nil
+}
+
+// Register registers the reports router module.
+func Register(reg *router.Registry) {
+	reg.RegisterModule("reports", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
+		return RegisterRoutes(r, cfg)
+	})
+}
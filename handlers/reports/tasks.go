@@ -0,0 +1,11 @@
+package reports
+
+import "github.com/arran4/goa4web/internal/tasks"
+
+// The following constants define the allowed values of the "task" form field.
+// Each HTML form includes a hidden or submit input named "task" whose value
+// identifies the intended action.
+const (
+	// TaskReport files a report about abusive content.
+	TaskReport tasks.TaskString = "Report"
+)
@@ -0,0 +1,10 @@
+package reports
+
+import "github.com/arran4/goa4web/internal/tasks"
+
+// RegisterTasks returns content report related tasks.
+func RegisterTasks() []tasks.NamedTask {
+	return []tasks.NamedTask{
+		reportTask,
+	}
+}
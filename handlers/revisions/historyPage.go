@@ -0,0 +1,143 @@
+package revisions
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/worddiff"
+)
+
+// HistoryPageTmpl renders the edit history of a single item.
+const HistoryPageTmpl tasks.Template = "domains/revisions/historyPage.gohtml"
+
+// FieldDiff is the word level difference of one field between a revision and
+// the one before it.
+type FieldDiff struct {
+	Name string
+	Ops  []worddiff.Op
+}
+
+// Entry is one revision in the history listing.
+type Entry struct {
+	Revision *db.ListContentRevisionsByItemRow
+	Fields   []FieldDiff
+	Current  bool
+}
+
+// loadSource resolves the item addressed by the route variables.
+func loadSource(r *http.Request) (*common.RevisionSource, error) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	vars := mux.Vars(r)
+	itemType := vars["type"]
+	if !common.ValidRevisionType(itemType) {
+		return nil, handlers.ErrNotFound
+	}
+	id, err := strconv.Atoi(vars["id"])
+	if err != nil {
+		return nil, handlers.ErrBadRequest
+	}
+	src, err := cd.LoadRevisionSource(itemType, int32(id))
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return nil, handlers.ErrNotFound
+	case err != nil:
+		log.Printf("load revision source: %v", err)
+		return nil, common.ErrInternalServerError
+	case src == nil:
+		return nil, handlers.ErrNotFound
+	}
+	if !cd.CanViewRevisions(src) {
+		return nil, handlers.ErrForbidden
+	}
+	return src, nil
+}
+
+// ItemURL returns the public page of an item or an empty string when the
+// item has no single page of its own.
+func ItemURL(itemType string, id int32) string {
+	switch itemType {
+	case common.RevisionTypeBlog:
+		return fmt.Sprintf("/blogs/blog/%d", id)
+	case common.RevisionTypeNews:
+		return fmt.Sprintf("/news/news/%d", id)
+	case common.RevisionTypeWriting:
+		return fmt.Sprintf("/writings/article/%d", id)
+	}
+	return ""
+}
+
+// HistoryPage lists the revisions of an item with a diff against the
+// previous revision.
+func HistoryPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	src, err := loadSource(r)
+	if err != nil {
+		handlers.RenderErrorPage(w, r, err)
+		return
+	}
+	revs, err := cd.ContentRevisions(src.ItemType, src.ItemID)
+	if err != nil {
+		log.Printf("list revisions: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+
+	type Data struct {
+		ItemType   string
+		ItemID     int32
+		ItemURL    string
+		Entries    []*Entry
+		CanRestore bool
+	}
+	data := Data{
+		ItemType:   src.ItemType,
+		ItemID:     src.ItemID,
+		ItemURL:    ItemURL(src.ItemType, src.ItemID),
+		Entries:    buildEntries(src.ItemType, revs),
+		CanRestore: cd.CanRestoreRevision(src),
+	}
+	cd.PageTitle = fmt.Sprintf("Edit History: %s %d", src.ItemType, src.ItemID)
+	if err := HistoryPageTmpl.Handle(w, r, data); err != nil {
+		log.Printf("history page: %v", err)
+	}
+}
+
+// buildEntries diffs each revision against the next older one. revs must be
+// ordered newest first.
+func buildEntries(itemType string, revs []*db.ListContentRevisionsByItemRow) []*Entry {
+	entries := make([]*Entry, 0, len(revs))
+	for i, rev := range revs {
+		var prev *db.ListContentRevisionsByItemRow
+		if i+1 < len(revs) {
+			prev = revs[i+1]
+		}
+		e := &Entry{Revision: rev, Current: i == 0}
+		if itemType == common.RevisionTypeWriting {
+			e.Fields = append(e.Fields,
+				FieldDiff{Name: "Title", Ops: worddiff.Diff(prevField(prev, func(p *db.ListContentRevisionsByItemRow) sql.NullString { return p.Title }), rev.Title.String)},
+				FieldDiff{Name: "Abstract", Ops: worddiff.Diff(prevField(prev, func(p *db.ListContentRevisionsByItemRow) sql.NullString { return p.Abstract }), rev.Abstract.String)},
+			)
+		}
+		e.Fields = append(e.Fields, FieldDiff{Name: "Text", Ops: worddiff.Diff(prevField(prev, func(p *db.ListContentRevisionsByItemRow) sql.NullString { return p.Body }), rev.Body.String)})
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func prevField(prev *db.ListContentRevisionsByItemRow, f func(*db.ListContentRevisionsByItemRow) sql.NullString) string {
+	if prev == nil {
+		return ""
+	}
+	return f(prev).String
+}
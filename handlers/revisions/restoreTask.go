@@ -0,0 +1,74 @@
+package revisions
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/workers/searchworker"
+)
+
+// RestoreTask reverts an item to one of its earlier revisions.
+type RestoreTask struct{ tasks.TaskString }
+
+var restoreTask = &RestoreTask{TaskString: TaskRestore}
+
+var _ tasks.Task = (*RestoreTask)(nil)
+var _ tasks.AuditableTask = (*RestoreTask)(nil)
+var _ tasks.TemplatesRequired = (*RestoreTask)(nil)
+
+func (RestoreTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	src, err := loadSource(r)
+	if err != nil {
+		return fmt.Errorf("load item fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if !cd.CanRestoreRevision(src) {
+		return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { handlers.RenderErrorPage(w, r, handlers.ErrForbidden) })
+	}
+	revID, err := strconv.Atoi(r.PostFormValue("revision"))
+	if err != nil {
+		return fmt.Errorf("revision parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	rev, err := cd.RestoreRevision(src, int32(revID))
+	if err != nil {
+		return fmt.Errorf("restore revision fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+
+	if evt := cd.Event(); evt != nil {
+		if evt.Data == nil {
+			evt.Data = map[string]any{}
+		}
+		evt.Data["ItemType"] = src.ItemType
+		evt.Data["ItemID"] = src.ItemID
+		evt.Data["RevisionID"] = rev.ID
+		if u, _ := cd.CurrentUser(); u != nil && u.Username.Valid {
+			evt.Data["Moderator"] = u.Username.String
+		}
+		text := rev.Body.String
+		if src.ItemType == common.RevisionTypeWriting {
+			text = strings.Join([]string{rev.Abstract.String, rev.Title.String, rev.Body.String}, " ")
+		}
+		evt.Data[searchworker.EventKey] = searchworker.IndexEventData{Type: src.ItemType, ID: src.ItemID, Text: text}
+	}
+
+	return handlers.RefreshDirectHandler{TargetURL: fmt.Sprintf("/history/%s/%d", src.ItemType, src.ItemID)}
+}
+
+// AuditRecord summarises an item being reverted to an earlier revision.
+func (RestoreTask) AuditRecord(data map[string]any) string {
+	mod, _ := data["Moderator"].(string)
+	typ, _ := data["ItemType"].(string)
+	id, _ := data["ItemID"].(int32)
+	rev, _ := data["RevisionID"].(int32)
+	return fmt.Sprintf("%s restored %s %d to revision %d", mod, typ, id, rev)
+}
+
+func (RestoreTask) RequiredTemplates() []tasks.Template {
+	return []tasks.Template{HistoryPageTmpl}
+}
@@ -0,0 +1,50 @@
+package revisions
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/templates"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+func TestHappyPathRevisionTasksTemplatesRequiredExist(t *testing.T) {
+	for _, name := range restoreTask.RequiredTemplates() {
+		if !name.Exists(templates.WithSilence(true)) {
+			t.Fatalf("missing template: %s", name)
+		}
+	}
+}
+
+func TestBuildEntriesDiffsAgainstPrevious(t *testing.T) {
+	revs := []*db.ListContentRevisionsByItemRow{
+		{ID: 2, Body: sql.NullString{String: "hello big world", Valid: true}},
+		{ID: 1, Body: sql.NullString{String: "hello world", Valid: true}},
+	}
+	entries := buildEntries(common.RevisionTypeBlog, revs)
+	if len(entries) != 2 || !entries[0].Current || entries[1].Current {
+		t.Fatalf("unexpected entries %+v", entries)
+	}
+	ops := entries[0].Fields[0].Ops
+	if len(ops) != 3 || !ops[1].Inserted() || ops[1].Text != "big " {
+		t.Fatalf("unexpected diff %+v", ops)
+	}
+	if first := entries[1].Fields[0].Ops; len(first) != 1 || !first[0].Inserted() {
+		t.Fatalf("first revision should be all inserted: %+v", first)
+	}
+}
+
+func TestBuildEntriesWritingFields(t *testing.T) {
+	revs := []*db.ListContentRevisionsByItemRow{{ID: 1}}
+	if got := len(buildEntries(common.RevisionTypeWriting, revs)[0].Fields); got != 3 {
+		t.Fatalf("writing fields = %d, want 3", got)
+	}
+}
+
+func TestRestoreAuditRecord(t *testing.T) {
+	got := restoreTask.AuditRecord(map[string]any{"Moderator": "bob", "ItemType": "news", "ItemID": int32(4), "RevisionID": int32(9)})
+	if want := "bob restored news 4 to revision 9"; got != want {
+		t.Fatalf("AuditRecord = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,29 @@
+package revisions
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/router"
+
+	navpkg "github.com/arran4/goa4web/internal/navigation"
+)
+
+// RegisterRoutes attaches the edit history endpoints to r.
+func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []navpkg.RouterOptions {
+	hr := r.PathPrefix("/history").Subrouter()
+	hr.NotFoundHandler = http.HandlerFunc(handlers.RenderNotFoundOrLogin)
+	hr.HandleFunc("/{type:[a-z]+}/{id:[0-9]+}", HistoryPage).Methods("GET").MatcherFunc(handlers.RequiresAnAccount())
+	hr.HandleFunc("/{type:[a-z]+}/{id:[0-9]+}", handlers.TaskHandler(restoreTask)).Methods("POST").MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(restoreTask.Matcher())
+	return nil
+}
+
+// Register registers the revisions router module.
+func Register(reg *router.Registry) {
+	reg.RegisterModule("revisions", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
+		return RegisterRoutes(r, cfg)
+	})
+}
@@ -0,0 +1,10 @@
+package revisions
+
+import "github.com/arran4/goa4web/internal/tasks"
+
+// RegisterTasks returns revision related tasks.
+func RegisterTasks() []tasks.NamedTask {
+	return []tasks.NamedTask{
+		restoreTask,
+	}
+}
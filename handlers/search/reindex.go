@@ -4,39 +4,41 @@ import (
 	"context"
 	"strings"
 
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
 	"github.com/arran4/goa4web/internal/db"
-	"github.com/arran4/goa4web/workers/searchworker"
+	"github.com/arran4/goa4web/internal/search"
+	searchdb "github.com/arran4/goa4web/internal/search/db"
 )
 
-// indexText tokenizes text, ensures the words exist in searchwordlist and then
-// calls add for each (word id, count) pair. It caches word ids to avoid
-// repeated database lookups when the same word occurs multiple times across
-// documents.
-func indexText(ctx context.Context, q db.Querier, cache map[string]int64, text string, add func(context.Context, int64, int32) error) error {
-	counts := map[string]int32{}
-	for _, w := range searchworker.BreakupTextToWords(text) {
-		counts[strings.ToLower(w)]++
+// searchBackend returns the backend configured on the CoreData in ctx or the
+// database backend when none is available.
+func searchBackend(ctx context.Context, q db.Querier) search.Backend {
+	if cd, ok := ctx.Value(consts.KeyCoreData).(*common.CoreData); ok && cd != nil {
+		return cd.SearchBackend()
 	}
-	for w, c := range counts {
-		id, ok := cache[w]
-		if !ok {
-			var err error
-			id, err = q.SystemCreateSearchWord(ctx, w)
-			if err != nil {
-				return err
-			}
-			if id == 0 {
-				sw, err := q.SystemGetSearchWordByWordLowercased(ctx, w)
-				if err != nil {
-					return err
-				}
-				id = int64(sw.Idsearchwordlist)
-			}
-			cache[w] = id
+	return searchdb.New(q)
+}
+
+// reindex clears the index for typ and then indexes each document, calling
+// setLastIndex once a document has been stored. Documents without text are
+// skipped.
+func reindex(ctx context.Context, q db.Querier, typ string, docs []search.Document, setLastIndex func(context.Context, int32) error) error {
+	backend := searchBackend(ctx, q)
+	if err := backend.Reset(ctx, typ); err != nil {
+		return err
+	}
+	for _, doc := range docs {
+		doc.Text = strings.TrimSpace(doc.Text)
+		if doc.Text == "" {
+			continue
+		}
+		if err := backend.Index(ctx, doc); err != nil {
+			return err
 		}
-		if err := add(ctx, id, c); err != nil {
+		if err := setLastIndex(ctx, doc.ID); err != nil {
 			return err
 		}
 	}
-	return nil
+	return search.Flush(ctx, backend)
 }
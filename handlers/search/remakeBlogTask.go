@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/tasks"
 )
 
@@ -36,31 +36,16 @@ func (RemakeBlogTask) RequiredTemplates() []tasks.Template {
 }
 
 func (RemakeBlogTask) BackgroundTask(ctx context.Context, q db.Querier) (tasks.Task, error) {
-	if err := q.SystemDeleteBlogsSearch(ctx); err != nil {
-		return nil, err
-	}
 	rows, err := q.SystemGetAllBlogsForIndex(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("SystemGetAllBlogsForIndex: %w", err)
 	}
-	cache := map[string]int64{}
+	docs := make([]search.Document, 0, len(rows))
 	for _, row := range rows {
-		text := strings.TrimSpace(row.Blog.String)
-		if text == "" {
-			continue
-		}
-		if err := indexText(ctx, q, cache, text, func(c context.Context, wid int64, count int32) error {
-			return q.SystemAddToBlogsSearch(c, db.SystemAddToBlogsSearchParams{
-				BlogID:                         row.Idblogs,
-				SearchwordlistIdsearchwordlist: int32(wid),
-				WordCount:                      count,
-			})
-		}); err != nil {
-			return nil, err
-		}
-		if err := q.SystemSetBlogLastIndex(ctx, row.Idblogs); err != nil {
-			return nil, err
-		}
+		docs = append(docs, search.Document{Type: search.TypeBlog, ID: row.Idblogs, Text: row.Blog.String})
+	}
+	if err := reindex(ctx, q, search.TypeBlog, docs, q.SystemSetBlogLastIndex); err != nil {
+		return nil, err
 	}
 	return remakeBlogFinishedTask, nil
 }
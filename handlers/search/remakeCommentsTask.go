@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/tasks"
 )
 
@@ -36,31 +36,16 @@ func (RemakeCommentsTask) RequiredTemplates() []tasks.Template {
 }
 
 func (RemakeCommentsTask) BackgroundTask(ctx context.Context, q db.Querier) (tasks.Task, error) {
-	if err := q.SystemDeleteCommentsSearch(ctx); err != nil {
-		return nil, err
-	}
 	rows, err := q.GetAllCommentsForIndex(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("GetAllCommentsForIndex: %w", err)
 	}
-	cache := map[string]int64{}
+	docs := make([]search.Document, 0, len(rows))
 	for _, row := range rows {
-		text := strings.TrimSpace(row.Text.String)
-		if text == "" {
-			continue
-		}
-		if err := indexText(ctx, q, cache, text, func(c context.Context, wid int64, count int32) error {
-			return q.SystemAddToForumCommentSearch(c, db.SystemAddToForumCommentSearchParams{
-				CommentID:                      row.Idcomments,
-				SearchwordlistIdsearchwordlist: int32(wid),
-				WordCount:                      count,
-			})
-		}); err != nil {
-			return nil, err
-		}
-		if err := q.SystemSetCommentLastIndex(ctx, row.Idcomments); err != nil {
-			return nil, err
-		}
+		docs = append(docs, search.Document{Type: search.TypeComment, ID: row.Idcomments, Text: row.Text.String})
+	}
+	if err := reindex(ctx, q, search.TypeComment, docs, q.SystemSetCommentLastIndex); err != nil {
+		return nil, err
 	}
 	return remakeCommentsFinishedTask, nil
 }
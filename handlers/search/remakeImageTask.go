@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/tasks"
 )
 
@@ -36,31 +36,16 @@ func (RemakeImageTask) RequiredTemplates() []tasks.Template {
 }
 
 func (RemakeImageTask) BackgroundTask(ctx context.Context, q db.Querier) (tasks.Task, error) {
-	if err := q.SystemDeleteImagePostSearch(ctx); err != nil {
-		return nil, err
-	}
 	rows, err := q.GetAllImagePostsForIndex(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("GetAllImagePostsForIndex: %w", err)
 	}
-	cache := map[string]int64{}
+	docs := make([]search.Document, 0, len(rows))
 	for _, row := range rows {
-		text := strings.TrimSpace(row.Description.String)
-		if text == "" {
-			continue
-		}
-		if err := indexText(ctx, q, cache, text, func(c context.Context, wid int64, count int32) error {
-			return q.SystemAddToImagePostSearch(c, db.SystemAddToImagePostSearchParams{
-				ImagePostID:                    row.Idimagepost,
-				SearchwordlistIdsearchwordlist: int32(wid),
-				WordCount:                      count,
-			})
-		}); err != nil {
-			return nil, err
-		}
-		if err := q.SystemSetImagePostLastIndex(ctx, row.Idimagepost); err != nil {
-			return nil, err
-		}
+		docs = append(docs, search.Document{Type: search.TypeImage, ID: row.Idimagepost, Text: row.Description.String})
+	}
+	if err := reindex(ctx, q, search.TypeImage, docs, q.SystemSetImagePostLastIndex); err != nil {
+		return nil, err
 	}
 	return remakeImageFinishedTask, nil
 }
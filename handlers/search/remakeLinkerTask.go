@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/tasks"
 )
 
@@ -36,31 +36,16 @@ func (RemakeLinkerTask) RequiredTemplates() []tasks.Template {
 }
 
 func (RemakeLinkerTask) BackgroundTask(ctx context.Context, q db.Querier) (tasks.Task, error) {
-	if err := q.SystemDeleteLinkerSearch(ctx); err != nil {
-		return nil, err
-	}
 	rows, err := q.GetAllLinkersForIndex(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("GetAllLinkersForIndex: %w", err)
 	}
-	cache := map[string]int64{}
+	docs := make([]search.Document, 0, len(rows))
 	for _, row := range rows {
-		text := strings.TrimSpace(row.Title.String + " " + row.Description.String)
-		if text == "" {
-			continue
-		}
-		if err := indexText(ctx, q, cache, text, func(c context.Context, wid int64, count int32) error {
-			return q.SystemAddToLinkerSearch(c, db.SystemAddToLinkerSearchParams{
-				LinkerID:                       row.ID,
-				SearchwordlistIdsearchwordlist: int32(wid),
-				WordCount:                      count,
-			})
-		}); err != nil {
-			return nil, err
-		}
-		if err := q.SystemSetLinkerLastIndex(ctx, row.ID); err != nil {
-			return nil, err
-		}
+		docs = append(docs, search.Document{Type: search.TypeLinker, ID: row.ID, Text: row.Title.String + " " + row.Description.String})
+	}
+	if err := reindex(ctx, q, search.TypeLinker, docs, q.SystemSetLinkerLastIndex); err != nil {
+		return nil, err
 	}
 	return remakeLinkerFinishedTask, nil
 }
@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/tasks"
 )
 
@@ -36,31 +36,16 @@ func (RemakeNewsTask) RequiredTemplates() []tasks.Template {
 }
 
 func (RemakeNewsTask) BackgroundTask(ctx context.Context, q db.Querier) (tasks.Task, error) {
-	if err := q.SystemDeleteSiteNewsSearch(ctx); err != nil {
-		return nil, err
-	}
 	rows, err := q.GetAllSiteNewsForIndex(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("GetAllSiteNewsForIndex: %w", err)
 	}
-	cache := map[string]int64{}
+	docs := make([]search.Document, 0, len(rows))
 	for _, row := range rows {
-		text := strings.TrimSpace(row.News.String)
-		if text == "" {
-			continue
-		}
-		if err := indexText(ctx, q, cache, text, func(c context.Context, wid int64, count int32) error {
-			return q.SystemAddToSiteNewsSearch(c, db.SystemAddToSiteNewsSearchParams{
-				SiteNewsID:                     row.Idsitenews,
-				SearchwordlistIdsearchwordlist: int32(wid),
-				WordCount:                      count,
-			})
-		}); err != nil {
-			return nil, err
-		}
-		if err := q.SystemSetSiteNewsLastIndex(ctx, row.Idsitenews); err != nil {
-			return nil, err
-		}
+		docs = append(docs, search.Document{Type: search.TypeNews, ID: row.Idsitenews, Text: row.News.String})
+	}
+	if err := reindex(ctx, q, search.TypeNews, docs, q.SystemSetSiteNewsLastIndex); err != nil {
+		return nil, err
 	}
 	return remakeNewsFinishedTask, nil
 }
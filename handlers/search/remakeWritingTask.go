@@ -4,10 +4,10 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/arran4/goa4web/handlers"
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/tasks"
 )
 
@@ -36,31 +36,16 @@ func (RemakeWritingTask) RequiredTemplates() []tasks.Template {
 }
 
 func (RemakeWritingTask) BackgroundTask(ctx context.Context, q db.Querier) (tasks.Task, error) {
-	if err := q.SystemDeleteWritingSearch(ctx); err != nil {
-		return nil, err
-	}
 	rows, err := q.GetAllWritingsForIndex(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("GetAllWritingsForIndex: %w", err)
 	}
-	cache := map[string]int64{}
+	docs := make([]search.Document, 0, len(rows))
 	for _, row := range rows {
-		text := strings.TrimSpace(row.Title.String + " " + row.Abstract.String + " " + row.Writing.String)
-		if text == "" {
-			continue
-		}
-		if err := indexText(ctx, q, cache, text, func(c context.Context, wid int64, count int32) error {
-			return q.SystemAddToForumWritingSearch(c, db.SystemAddToForumWritingSearchParams{
-				WritingID:                      row.Idwriting,
-				SearchwordlistIdsearchwordlist: int32(wid),
-				WordCount:                      count,
-			})
-		}); err != nil {
-			return nil, err
-		}
-		if err := q.SystemSetWritingLastIndex(ctx, row.Idwriting); err != nil {
-			return nil, err
-		}
+		docs = append(docs, search.Document{Type: search.TypeWriting, ID: row.Idwriting, Text: row.Title.String + " " + row.Abstract.String + " " + row.Writing.String})
+	}
+	if err := reindex(ctx, q, search.TypeWriting, docs, q.SystemSetWritingLastIndex); err != nil {
+		return nil, err
 	}
 	return remakeWritingFinishedTask, nil
 }
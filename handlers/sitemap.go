@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"bytes"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/core/templates"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// absoluteURLFunc returns a function making site relative paths absolute
+// using the request's CoreData, falling back to the configured base URL or
+// the request host.
+func absoluteURLFunc(r *http.Request, cfg *config.RuntimeConfig) func(string) string {
+	if cd, ok := r.Context().Value(consts.KeyCoreData).(*common.CoreData); ok && cd != nil {
+		return func(path string) string { return cd.AbsoluteURL(path) }
+	}
+	base := "http://" + r.Host
+	if cfg != nil && cfg.BaseURL != "" {
+		base = strings.TrimRight(cfg.BaseURL, "/")
+	}
+	return func(path string) string { return base + path }
+}
+
+// ensureSitemap builds the sitemap on demand when the scheduler has not yet
+// generated it.
+func ensureSitemap(r *http.Request, sm *sitemap.Sitemap) {
+	if !sm.Generated().IsZero() {
+		return
+	}
+	cd, ok := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if !ok || cd == nil || cd.Queries() == nil {
+		return
+	}
+	if err := sm.Regenerate(r.Context(), cd.Queries()); err != nil {
+		log.Printf("regenerate sitemap: %v", err)
+	}
+}
+
+// SitemapIndex serves /sitemap.xml, the index of every section's sitemap pages.
+func SitemapIndex(cfg *config.RuntimeConfig, sm *sitemap.Sitemap) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ensureSitemap(r, sm)
+		var buf bytes.Buffer
+		if err := sitemap.WriteIndex(&buf, sm.Pages(), absoluteURLFunc(r, cfg)); err != nil {
+			log.Printf("write sitemap index: %v", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		http.ServeContent(w, r, "sitemap.xml", sm.Generated(), bytes.NewReader(buf.Bytes()))
+	}
+}
+
+// SitemapPage serves one page of a section's sitemap.
+func SitemapPage(cfg *config.RuntimeConfig, sm *sitemap.Sitemap) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		n, err := strconv.Atoi(vars["page"])
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		ensureSitemap(r, sm)
+		entries, ok := sm.Entries(vars["section"], n)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		var buf bytes.Buffer
+		if err := sitemap.WriteURLSet(&buf, entries, absoluteURLFunc(r, cfg)); err != nil {
+			log.Printf("write sitemap page: %v", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml; charset=utf-8")
+		http.ServeContent(w, r, "sitemap.xml", sm.Generated(), bytes.NewReader(buf.Bytes()))
+	}
+}
+
+// RobotsTXT serves the robots.txt file followed by the location of the
+// sitemap index unless the file already names a sitemap.
+func RobotsTXT(cfg *config.RuntimeConfig) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var opts []templates.Option
+		if cfg != nil && cfg.TemplatesDir != "" {
+			opts = append(opts, templates.WithDir(cfg.TemplatesDir))
+		}
+		data := templates.GetRobotsTXTData(opts...)
+		if !bytes.Contains(bytes.ToLower(data), []byte("sitemap:")) {
+			var buf bytes.Buffer
+			buf.Write(bytes.TrimRight(data, "\n"))
+			buf.WriteString("\nSitemap: " + absoluteURLFunc(r, cfg)("/sitemap.xml") + "\n")
+			data = buf.Bytes()
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		http.ServeContent(w, r, "robots.txt", time.Time{}, bytes.NewReader(data))
+	}
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+func TestRobotsTXTReferencesSitemap(t *testing.T) {
+	cfg := &config.RuntimeConfig{BaseURL: "https://example.com/"}
+	rr := httptest.NewRecorder()
+	RobotsTXT(cfg)(rr, httptest.NewRequest(http.MethodGet, "/robots.txt", nil))
+	if rr.Code != http.StatusOK {
+		t.Fatalf("status=%d", rr.Code)
+	}
+	if body := rr.Body.String(); !strings.HasSuffix(body, "\nSitemap: https://example.com/sitemap.xml\n") {
+		t.Errorf("robots.txt missing sitemap:\n%s", body)
+	}
+}
+
+func TestSitemapHandlers(t *testing.T) {
+	cfg := &config.RuntimeConfig{BaseURL: "https://example.com"}
+	sm := sitemap.New()
+	sm.Register("news", func(context.Context, db.Querier) ([]sitemap.Entry, error) {
+		return []sitemap.Entry{{Path: "/news"}, {Path: "/news/news/1"}}, nil
+	})
+	if err := sm.Regenerate(context.Background(), nil); err != nil {
+		t.Fatalf("regenerate: %v", err)
+	}
+	r := mux.NewRouter()
+	r.HandleFunc("/sitemap.xml", SitemapIndex(cfg, sm))
+	r.HandleFunc("/sitemap/{section}/{page:[0-9]+}.xml", SitemapPage(cfg, sm))
+
+	rr := httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil))
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), "<loc>https://example.com/sitemap/news/1.xml</loc>") {
+		t.Fatalf("index %d:\n%s", rr.Code, rr.Body.String())
+	}
+	if ct := rr.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/xml") {
+		t.Errorf("content type %q", ct)
+	}
+
+	rr = httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/sitemap/news/1.xml", nil))
+	if rr.Code != http.StatusOK || !strings.Contains(rr.Body.String(), "<loc>https://example.com/news/news/1</loc>") {
+		t.Fatalf("page %d:\n%s", rr.Code, rr.Body.String())
+	}
+
+	rr = httptest.NewRecorder()
+	r.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/sitemap/news/2.xml", nil))
+	if rr.Code != http.StatusNotFound {
+		t.Errorf("missing page status=%d", rr.Code)
+	}
+}
@@ -56,9 +56,6 @@ var (
 
 	// A4CodeJS serves the A4Code parser/converter JavaScript.
 	A4CodeJS = StaticAssetHandler("a4code.js", "application/javascript", templates.GetA4CodeJSData)
-
-	// RobotsTXT serves the robots.txt file.
-	RobotsTXT = StaticAssetHandler("robots.txt", "text/plain", templates.GetRobotsTXTData)
 )
 
 // RedirectPermanent returns a handler that redirects to the provided path using StatusPermanentRedirect.
@@ -19,7 +19,17 @@ func TaskHandler(t tasks.Task) func(http.ResponseWriter, *http.Request) {
 		if v := r.Context().Value(consts.KeyCoreData).(*common.CoreData); v != nil {
 			v.SetEventTask(t)
 		}
-		result := t.Action(w, r)
+		tw := &taskResponseWriter{ResponseWriter: w}
+		result := t.Action(tw, r)
+		switch result.(type) {
+		case RedirectHandler, RefreshDirectHandler:
+			discardSubmittedDraft(r)
+		case nil:
+			// Some tasks render their own error page and return nil.
+			if !tw.wrote {
+				discardSubmittedDraft(r)
+			}
+		}
 		switch result := result.(type) {
 		case RedirectHandler:
 			// Use 303 See Other so POST actions redirect to a GET of the target resource.
@@ -72,6 +82,38 @@ func TaskHandler(t tasks.Task) func(http.ResponseWriter, *http.Request) {
 	}
 }
 
+// taskResponseWriter records whether a task action wrote its own response.
+type taskResponseWriter struct {
+	http.ResponseWriter
+	wrote bool
+}
+
+func (tw *taskResponseWriter) WriteHeader(code int) {
+	tw.wrote = true
+	tw.ResponseWriter.WriteHeader(code)
+}
+
+func (tw *taskResponseWriter) Write(b []byte) (int, error) {
+	tw.wrote = true
+	return tw.ResponseWriter.Write(b)
+}
+
+// discardSubmittedDraft removes the autosaved draft of a form once it has been
+// submitted successfully.
+func discardSubmittedDraft(r *http.Request) {
+	target := r.PostForm.Get(common.DraftFormField)
+	if target == "" {
+		return
+	}
+	cd, ok := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if !ok || cd == nil {
+		return
+	}
+	if err := cd.DiscardDraft(target); err != nil {
+		log.Printf("discard draft %s: %v", target, err)
+	}
+}
+
 func loginRedirect(w http.ResponseWriter, r *http.Request) {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 	vals := url.Values{}
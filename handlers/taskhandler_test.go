@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+type draftTestTask struct {
+	tasks.TaskString
+	result func(w http.ResponseWriter) any
+}
+
+func (t draftTestTask) Action(w http.ResponseWriter, r *http.Request) any {
+	_ = r.ParseForm()
+	return t.result(w)
+}
+
+func runDraftTask(t *testing.T, result func(w http.ResponseWriter) any) *db.QuerierStub {
+	t.Helper()
+	q := &db.QuerierStub{}
+	cd := common.NewCoreData(context.Background(), q, config.NewRuntimeConfig())
+	cd.UserID = 5
+	form := url.Values{common.DraftFormField: {"thread:12:reply"}}
+	req := httptest.NewRequest(http.MethodPost, "/forum/topic/1/thread/12/reply", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+	TaskHandler(draftTestTask{TaskString: "Reply", result: result})(httptest.NewRecorder(), req)
+	return q
+}
+
+func TestTaskHandlerDiscardsSubmittedDraft(t *testing.T) {
+	t.Run("Happy Path - redirect", func(t *testing.T) {
+		q := runDraftTask(t, func(http.ResponseWriter) any { return RedirectHandler("/forum/topic/1/thread/12") })
+		if len(q.DeleteDraftForUserCalls) != 1 || q.DeleteDraftForUserCalls[0].Target != "thread:12:reply" {
+			t.Fatalf("draft not discarded: %+v", q.DeleteDraftForUserCalls)
+		}
+	})
+
+	t.Run("Unhappy Path - error kept", func(t *testing.T) {
+		q := runDraftTask(t, func(http.ResponseWriter) any { return errors.New("boom") })
+		if len(q.DeleteDraftForUserCalls) != 0 {
+			t.Fatalf("draft discarded after failure")
+		}
+	})
+
+	t.Run("Unhappy Path - task rendered its own page", func(t *testing.T) {
+		q := runDraftTask(t, func(w http.ResponseWriter) any {
+			w.WriteHeader(http.StatusForbidden)
+			return nil
+		})
+		if len(q.DeleteDraftForUserCalls) != 0 {
+			t.Fatalf("draft discarded after failure")
+		}
+	})
+}
@@ -28,6 +28,9 @@ type AddEmailTask struct {
 	codeGenerator func() (string, error)
 }
 
+// Confidential reports true: the event carries the verification token.
+func (AddEmailTask) Confidential() bool { return true }
+
 var addEmailTask = &AddEmailTask{TaskString: tasks.TaskString(TaskAdd)}
 
 var _ tasks.Task = (*AddEmailTask)(nil)
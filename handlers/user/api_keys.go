@@ -72,6 +72,17 @@ func (CreateAPIKeyTask) Action(w http.ResponseWriter, r *http.Request) any {
 		"private_forum:write": true,
 		"images:read":         true,
 		"images:write":        true,
+		"forum:read":          true,
+		"forum:write":         true,
+		"news:read":           true,
+		"news:write":          true,
+		"blogs:read":          true,
+		"blogs:write":         true,
+		"writings:read":       true,
+		"writings:write":      true,
+		"linker:read":         true,
+		"linker:write":        true,
+		"metrics:read":        true,
 	}
 
 	var validatedScopes []string
@@ -18,6 +18,7 @@ var CustomIndex = func(cd *common.CoreData, r *http.Request) {
 			common.IndexItem{Name: "Your uploaded images", Link: "/usr/notifications/gallery"},
 			common.IndexItem{Name: "Pagination settings", Link: "/usr/paging"},
 			common.IndexItem{Name: "Subscriptions", Link: "/usr/subscriptions"},
+			common.IndexItem{Name: "Drafts", Link: "/usr/drafts"},
 			common.IndexItem{Name: "Public profile settings", Link: "/usr/profile"},
 			common.IndexItem{Name: "API Keys", Link: "/usr/api-keys"},
 		)
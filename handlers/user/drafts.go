@@ -0,0 +1,135 @@
+package user
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// UserDraftsPage lists the drafts a user has not submitted yet.
+const UserDraftsPage tasks.Template = "domains/user/drafts.gohtml"
+
+func userDraftsPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Drafts"
+	drafts, err := cd.Drafts()
+	if err != nil {
+		handlers.RenderErrorPage(w, r, err)
+		return
+	}
+	data := struct {
+		Drafts []*common.Draft
+	}{
+		Drafts: drafts,
+	}
+	_ = UserDraftsPage.Handle(w, r, data)
+}
+
+// DeleteDraftTask discards one of the user's drafts.
+type DeleteDraftTask struct{ tasks.TaskString }
+
+var deleteDraftTask = &DeleteDraftTask{TaskString: TaskDeleteDraft}
+
+var _ tasks.Task = (*DeleteDraftTask)(nil)
+
+func (DeleteDraftTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if err := r.ParseForm(); err != nil {
+		return fmt.Errorf("parse form fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if err := cd.DiscardDraft(r.PostFormValue("target")); err != nil {
+		return fmt.Errorf("delete draft fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	return handlers.RefreshDirectHandler{TargetURL: "/usr/drafts"}
+}
+
+// draftResponse is the JSON form of a draft used by the editor autosave.
+type draftResponse struct {
+	Target    string            `json:"target"`
+	Fields    map[string]string `json:"fields"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
+type draftSaveRequest struct {
+	Target string            `json:"target"`
+	URL    string            `json:"url"`
+	Fields map[string]string `json:"fields"`
+}
+
+func writeDraftJSON(w http.ResponseWriter, d *common.Draft) {
+	w.Header().Set("Content-Type", "application/json")
+	if d == nil {
+		_, _ = w.Write([]byte("null\n"))
+		return
+	}
+	if err := json.NewEncoder(w).Encode(draftResponse{Target: d.Target, Fields: d.Fields, UpdatedAt: d.UpdatedAt}); err != nil {
+		log.Printf("encode draft: %v", err)
+	}
+}
+
+// draftAutosaveGet returns the user's draft for the "target" query parameter,
+// or null when there is none.
+func draftAutosaveGet(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	target := r.URL.Query().Get("target")
+	if !common.ValidDraftTarget(target) {
+		http.Error(w, "Invalid draft target", http.StatusBadRequest)
+		return
+	}
+	d, err := cd.Draft(target)
+	if err != nil {
+		log.Printf("load draft: %v", err)
+		http.Error(w, "Failed to load draft", http.StatusInternalServerError)
+		return
+	}
+	writeDraftJSON(w, d)
+}
+
+// draftAutosavePost stores the editor contents posted as JSON.
+func draftAutosavePost(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	var req draftSaveRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 2*common.MaxDraftSize)).Decode(&req); err != nil {
+		http.Error(w, "Invalid request", http.StatusBadRequest)
+		return
+	}
+	d, err := cd.SaveDraft(req.Target, req.URL, req.Fields)
+	switch {
+	case errors.Is(err, common.ErrInvalidDraftTarget):
+		http.Error(w, "Invalid draft target", http.StatusBadRequest)
+		return
+	case errors.Is(err, common.ErrDraftTooLarge):
+		http.Error(w, "Draft is too large", http.StatusRequestEntityTooLarge)
+		return
+	case err != nil:
+		log.Printf("save draft: %v", err)
+		http.Error(w, "Failed to save draft", http.StatusInternalServerError)
+		return
+	}
+	writeDraftJSON(w, d)
+}
+
+// draftAutosaveDelete discards the draft named by the "target" query
+// parameter.
+func draftAutosaveDelete(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	target := r.URL.Query().Get("target")
+	if !common.ValidDraftTarget(target) {
+		http.Error(w, "Invalid draft target", http.StatusBadRequest)
+		return
+	}
+	if err := cd.DiscardDraft(target); err != nil {
+		log.Printf("discard draft: %v", err)
+		http.Error(w, "Failed to discard draft", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -35,6 +35,7 @@ var allPages = []tasks.Template{
 	user.UserSubscriptionsPage,
 	user.UserThreadSubscriptionsPage,
 	user.UserTimezonePage,
+	user.UserUnsubscribePage,
 }
 
 func TestAllRegisteredPagesExist(t *testing.T) {
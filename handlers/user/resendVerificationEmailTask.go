@@ -12,6 +12,9 @@ import (
 // ResendVerificationEmailTask resends the verification link for an unverified user email address.
 type ResendVerificationEmailTask struct{ tasks.TaskString }
 
+// Confidential reports true: the event carries the verification token.
+func (ResendVerificationEmailTask) Confidential() bool { return true }
+
 var resendVerificationEmailTask = &ResendVerificationEmailTask{TaskString: TaskResend}
 
 var _ tasks.Task = (*ResendVerificationEmailTask)(nil)
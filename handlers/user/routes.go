@@ -45,6 +45,13 @@ func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []nav.RouterOptions
 	ur.HandleFunc("/passkeys/add/finish", HasWebAuthn(passkeysFinishRegistration)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount())
 	ur.HandleFunc("/passkeys/remove", HasWebAuthn(passkeysDelete)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount())
 
+	// Two-factor authentication
+	ur.HandleFunc("/2fa", userTwoFactorPage).Methods(http.MethodGet).MatcherFunc(handlers.RequiresAnAccount())
+	ur.HandleFunc("/2fa", handlers.TaskHandler(twoFactorSetupTask)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(twoFactorSetupTask.Matcher())
+	ur.HandleFunc("/2fa", handlers.TaskHandler(twoFactorConfirmTask)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(twoFactorConfirmTask.Matcher())
+	ur.HandleFunc("/2fa", handlers.TaskHandler(twoFactorDisableTask)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(twoFactorDisableTask.Matcher())
+	ur.HandleFunc("/2fa", handlers.TaskHandler(twoFactorRecoveryCodesTask)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(twoFactorRecoveryCodesTask.Matcher())
+
 	// API Keys
 	ur.HandleFunc("/api-keys", ListAPIKeysPage).Methods(http.MethodGet).MatcherFunc(handlers.RequiresAnAccount())
 	ur.HandleFunc("/api-keys/swagger.yaml", DownloadSwagger).Methods(http.MethodGet)
@@ -67,7 +74,13 @@ func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []nav.RouterOptions
 	ur.HandleFunc("/subscriptions/add", userSubscriptionAddPage).Methods(http.MethodGet).MatcherFunc(handlers.RequiresAnAccount())
 	ur.HandleFunc("/subscriptions/threads", userThreadSubscriptionsPage).Methods(http.MethodGet).MatcherFunc(handlers.RequiresAnAccount())
 	ur.HandleFunc("/subscriptions/update", handlers.TaskHandler(updateSubscriptionsTask)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(updateSubscriptionsTask.Matcher())
+	ur.HandleFunc("/subscriptions/unsubscribe/{id:[0-9]+}", userUnsubscribePage).Methods(http.MethodGet, http.MethodPost)
 	ur.HandleFunc("/subscriptions/delete", handlers.TaskHandler(deleteTask)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(deleteTask.Matcher())
+	ur.HandleFunc("/drafts", userDraftsPage).Methods(http.MethodGet).MatcherFunc(handlers.RequiresAnAccount())
+	ur.HandleFunc("/drafts", handlers.TaskHandler(deleteDraftTask)).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount()).MatcherFunc(deleteDraftTask.Matcher())
+	ur.HandleFunc("/drafts/autosave", draftAutosaveGet).Methods(http.MethodGet).MatcherFunc(handlers.RequiresAnAccount())
+	ur.HandleFunc("/drafts/autosave", draftAutosavePost).Methods(http.MethodPost).MatcherFunc(handlers.RequiresAnAccount())
+	ur.HandleFunc("/drafts/autosave", draftAutosaveDelete).Methods(http.MethodDelete).MatcherFunc(handlers.RequiresAnAccount())
 
 	// legacy redirects
 	r.HandleFunc("/user/lang", handlers.RedirectPermanent("/usr/lang"))
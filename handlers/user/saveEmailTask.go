@@ -32,11 +32,16 @@ func (SaveEmailTask) Action(w http.ResponseWriter, r *http.Request) any {
 
 	updates := r.PostFormValue("emailupdates") != ""
 	auto := r.PostFormValue("autosubscribe") != ""
+	mentions := r.PostFormValue("mentions") != ""
 
 	if err := cd.SaveEmail(uid, updates, auto); err != nil {
 		log.Printf("save email pref: %v", err)
 		return fmt.Errorf("save email pref fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
+	if err := cd.SaveMuteMentions(uid, !mentions); err != nil {
+		log.Printf("save mention pref: %v", err)
+		return fmt.Errorf("save mention pref fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
 
 	return handlers.RefreshDirectHandler{TargetURL: "/usr/email"}
 }
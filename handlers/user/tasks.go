@@ -23,6 +23,14 @@ const (
 	TaskSaveAppearance tasks.TaskString = "Save appearance"
 	// TaskSaveAll saves all changes in bulk.
 	TaskSaveAll tasks.TaskString = "Save all"
+	// TaskTwoFactorSetup starts TOTP enrolment.
+	TaskTwoFactorSetup tasks.TaskString = "Set up two-factor"
+	// TaskTwoFactorConfirm confirms TOTP enrolment with a code.
+	TaskTwoFactorConfirm tasks.TaskString = "Confirm two-factor"
+	// TaskTwoFactorDisable turns off TOTP for the current user.
+	TaskTwoFactorDisable tasks.TaskString = "Disable two-factor"
+	// TaskTwoFactorRecoveryCodes issues a new set of recovery codes.
+	TaskTwoFactorRecoveryCodes tasks.TaskString = "Regenerate recovery codes"
 	// TaskTestMail sends a test email to the current user.
 	TaskTestMail tasks.TaskString = "Test mail"
 	// TaskDismiss marks a notification as read.
@@ -37,6 +45,9 @@ const (
 	// TaskDelete removes an existing item.
 	TaskDelete tasks.TaskString = "Delete"
 
+	// TaskDeleteDraft discards one of the user's drafts.
+	TaskDeleteDraft tasks.TaskString = "Delete draft"
+
 	// TaskUpdate updates an existing item.
 	TaskUpdate tasks.TaskString = "Update"
 
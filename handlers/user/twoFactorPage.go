@@ -0,0 +1,186 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/totp"
+)
+
+const (
+	UserTwoFactorPage    tasks.Template = "domains/user/twoFactorPage.gohtml"
+	RecoveryCodesPage    tasks.Template = "pages/auth/recoveryCodesPage.gohtml"
+	twoFactorSettingsURL                = "/usr/2fa"
+)
+
+// userTwoFactorPage shows the TOTP status for the current user along with
+// any enrolment in progress.
+func userTwoFactorPage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Two-Factor Authentication"
+
+	row, err := cd.TOTPForUser(cd.UserID)
+	if err != nil {
+		log.Printf("get totp: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	type Data struct {
+		Enabled       bool
+		Pending       bool
+		Required      bool
+		RecoveryCodes int64
+		Secret        string
+		QR            template.URL
+	}
+	data := Data{Required: cd.TwoFactorRequired(cd.UserID)}
+	if row != nil {
+		data.Enabled = row.EnabledAt.Valid
+		data.Pending = !row.EnabledAt.Valid
+	}
+	if data.Enabled {
+		if data.RecoveryCodes, err = cd.UnusedRecoveryCodes(cd.UserID); err != nil {
+			log.Printf("count recovery codes: %v", err)
+		}
+	}
+	if data.Pending {
+		data.Secret = row.Secret
+		account := ""
+		if u, err := cd.CurrentUser(); err == nil && u != nil {
+			account = u.Username.String
+		}
+		if qr, err := totp.QRDataURI(totp.ProvisioningURI(cd.TOTPIssuer(), account, row.Secret)); err == nil {
+			data.QR = template.URL(qr)
+		} else {
+			log.Printf("totp qr: %v", err)
+		}
+	}
+	_ = UserTwoFactorPage.Handle(w, r, data)
+}
+
+// TwoFactorSetupTask starts TOTP enrolment for the current user.
+type TwoFactorSetupTask struct{ tasks.TaskString }
+
+// Confidential reports true: the event relates to the user's second factor.
+func (TwoFactorSetupTask) Confidential() bool { return true }
+
+var twoFactorSetupTask = &TwoFactorSetupTask{TaskString: TaskTwoFactorSetup}
+
+var _ tasks.Task = (*TwoFactorSetupTask)(nil)
+
+func (TwoFactorSetupTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	enabled, err := cd.TOTPEnabled(cd.UserID)
+	if err != nil {
+		return fmt.Errorf("totp lookup %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if enabled {
+		return common.UserError{ErrorMessage: "two-factor authentication is already enabled"}
+	}
+	if _, err := cd.BeginTOTPEnrolment(cd.UserID); err != nil {
+		return fmt.Errorf("begin totp %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	return handlers.RefreshDirectHandler{TargetURL: twoFactorSettingsURL}
+}
+
+// TwoFactorConfirmTask enables TOTP once the user proves their app works.
+type TwoFactorConfirmTask struct{ tasks.TaskString }
+
+// Confidential reports true: the event relates to the user's second factor.
+func (TwoFactorConfirmTask) Confidential() bool { return true }
+
+var twoFactorConfirmTask = &TwoFactorConfirmTask{TaskString: TaskTwoFactorConfirm}
+
+var _ tasks.Task = (*TwoFactorConfirmTask)(nil)
+var _ tasks.TemplatesRequired = (*TwoFactorConfirmTask)(nil)
+
+func (TwoFactorConfirmTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	codes, err := cd.ConfirmTOTPEnrolment(cd.UserID, r.PostFormValue("code"))
+	if errors.Is(err, common.ErrInvalidTOTPCode) {
+		return common.UserError{ErrorMessage: "invalid code"}
+	}
+	if err != nil {
+		return fmt.Errorf("confirm totp %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	return recoveryCodesResult(cd, codes)
+}
+
+func (TwoFactorConfirmTask) RequiredTemplates() []tasks.Template {
+	return []tasks.Template{RecoveryCodesPage}
+}
+
+// TwoFactorDisableTask removes TOTP after checking a current code.
+type TwoFactorDisableTask struct{ tasks.TaskString }
+
+// Confidential reports true: the event relates to the user's second factor.
+func (TwoFactorDisableTask) Confidential() bool { return true }
+
+var twoFactorDisableTask = &TwoFactorDisableTask{TaskString: TaskTwoFactorDisable}
+
+var _ tasks.Task = (*TwoFactorDisableTask)(nil)
+
+func (TwoFactorDisableTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	if cd.TwoFactorRequired(cd.UserID) {
+		return common.UserError{ErrorMessage: "two-factor authentication is required for your role"}
+	}
+	ok, err := cd.VerifySecondFactor(cd.UserID, r.PostFormValue("code"))
+	if err != nil {
+		return fmt.Errorf("verify second factor %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if !ok {
+		return common.UserError{ErrorMessage: "invalid code"}
+	}
+	if err := cd.DisableTOTP(cd.UserID); err != nil {
+		return fmt.Errorf("disable totp %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	return handlers.RefreshDirectHandler{TargetURL: twoFactorSettingsURL}
+}
+
+// TwoFactorRecoveryCodesTask replaces the current user's recovery codes.
+type TwoFactorRecoveryCodesTask struct{ tasks.TaskString }
+
+// Confidential reports true: recovery codes are credentials.
+func (TwoFactorRecoveryCodesTask) Confidential() bool { return true }
+
+var twoFactorRecoveryCodesTask = &TwoFactorRecoveryCodesTask{TaskString: TaskTwoFactorRecoveryCodes}
+
+var _ tasks.Task = (*TwoFactorRecoveryCodesTask)(nil)
+var _ tasks.TemplatesRequired = (*TwoFactorRecoveryCodesTask)(nil)
+
+func (TwoFactorRecoveryCodesTask) Action(w http.ResponseWriter, r *http.Request) any {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	ok, err := cd.VerifySecondFactor(cd.UserID, r.PostFormValue("code"))
+	if err != nil {
+		return fmt.Errorf("verify second factor %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	if !ok {
+		return common.UserError{ErrorMessage: "invalid code"}
+	}
+	codes, err := cd.RegenerateRecoveryCodes(cd.UserID)
+	if err != nil {
+		return fmt.Errorf("regenerate recovery codes %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
+	return recoveryCodesResult(cd, codes)
+}
+
+func (TwoFactorRecoveryCodesTask) RequiredTemplates() []tasks.Template {
+	return []tasks.Template{RecoveryCodesPage}
+}
+
+func recoveryCodesResult(cd *common.CoreData, codes []string) any {
+	cd.PageTitle = "Recovery Codes"
+	type Data struct {
+		Codes    []string
+		Continue string
+	}
+	return handlers.TemplateWithDataHandler(RecoveryCodesPage, Data{Codes: codes, Continue: twoFactorSettingsURL})
+}
@@ -26,6 +26,7 @@ func userEmailPage(w http.ResponseWriter, r *http.Request) {
 		UserPreferences struct {
 			EmailUpdates         bool
 			AutoSubscribeReplies bool
+			MentionNotifications bool
 		}
 		Error string
 	}
@@ -55,8 +56,10 @@ func userEmailPage(w http.ResponseWriter, r *http.Request) {
 			data.UserPreferences.EmailUpdates = pref.Emailforumupdates.Bool
 		}
 		data.UserPreferences.AutoSubscribeReplies = pref.AutoSubscribeReplies
+		data.UserPreferences.MentionNotifications = !pref.MuteMentions
 	} else {
 		data.UserPreferences.AutoSubscribeReplies = true
+		data.UserPreferences.MentionNotifications = true
 	}
 
 	_ = UserEmailPage.Handle(w, r, data)
@@ -21,6 +21,7 @@ import (
 
 type galleryImage struct {
 	Thumb  string
+	Srcset string
 	Full   string
 	A4Code string
 }
@@ -109,6 +110,7 @@ func userGalleryPage(w http.ResponseWriter, r *http.Request) {
 			}
 			imgs = append(imgs, galleryImage{
 				Thumb:  thumbURL,
+				Srcset: cd.ImageSrcset("image:" + fname),
 				Full:   full,
 				A4Code: "[img=image:" + fname + "]",
 			})
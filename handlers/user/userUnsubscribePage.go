@@ -0,0 +1,72 @@
+package user
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// userUnsubscribePage serves the signed links in the List-Unsubscribe header
+// of notification emails. A GET asks for confirmation while a POST, which
+// mail clients send for RFC 8058 one-click unsubscribe, removes the
+// subscription. The signature stands in for a login.
+func userUnsubscribePage(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.PageTitle = "Unsubscribe"
+
+	id, err := strconv.Atoi(mux.Vars(r)["id"])
+	uid, uidErr := strconv.Atoi(r.URL.Query().Get("uid"))
+	if err != nil || uidErr != nil || notifications.VerifyUnsubscribe(cd.LinkSignKey, int32(uid), int32(id), r.URL.Query().Get("sig")) != nil {
+		w.WriteHeader(http.StatusNotFound)
+		r.URL.RawQuery = "error=" + url.QueryEscape("Invalid unsubscribe link")
+		handlers.TaskErrorAcknowledgementPage(w, r)
+		return
+	}
+
+	queries := cd.Queries()
+	subs, err := queries.ListSubscriptionsByUser(r.Context(), int32(uid))
+	if err != nil {
+		log.Printf("list subscriptions: %v", err)
+		handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+		return
+	}
+	var sub *db.ListSubscriptionsByUserRow
+	for _, s := range subs {
+		if s.ID == int32(id) {
+			sub = s
+			break
+		}
+	}
+
+	data := struct {
+		Pattern string
+		Method  string
+		Done    bool
+	}{Done: sub == nil}
+	if sub != nil {
+		data.Pattern = sub.Pattern
+		data.Method = sub.Method
+	}
+
+	if r.Method == http.MethodPost && sub != nil {
+		if err := queries.DeleteSubscriptionByIDForSubscriber(r.Context(), db.DeleteSubscriptionByIDForSubscriberParams{SubscriberID: int32(uid), ID: int32(id)}); err != nil {
+			log.Printf("unsubscribe: %v", err)
+			handlers.RenderErrorPage(w, r, common.ErrInternalServerError)
+			return
+		}
+		data.Done = true
+	}
+	_ = UserUnsubscribePage.Handle(w, r, data)
+}
+
+const UserUnsubscribePage tasks.Template = "domains/user/unsubscribePage.gohtml"
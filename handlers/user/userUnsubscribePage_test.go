@@ -0,0 +1,78 @@
+package user
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/testhelpers"
+)
+
+func newUnsubscribeRequest(t *testing.T, method, link string, queries db.Querier) *http.Request {
+	t.Helper()
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("parse %q: %v", link, err)
+	}
+	body := ""
+	if method == http.MethodPost {
+		body = "List-Unsubscribe=One-Click"
+	}
+	req := httptest.NewRequest(method, u.RequestURI(), strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req = mux.SetURLVars(req, map[string]string{"id": strings.TrimPrefix(u.Path, notifications.UnsubscribePath)})
+	cd := common.NewCoreData(req.Context(), queries, config.NewRuntimeConfig(), common.WithLinkSignKey("k"))
+	return req.WithContext(context.WithValue(req.Context(), consts.KeyCoreData, cd))
+}
+
+func TestUserUnsubscribePage(t *testing.T) {
+	subs := []*db.ListSubscriptionsByUserRow{{ID: 5, Pattern: "reply:/forum/topic/1/thread/2/*", Method: "email"}}
+
+	t.Run("Happy Path", func(t *testing.T) {
+		queries := testhelpers.NewQuerierStub(testhelpers.WithSubscriptions(subs))
+		link := notifications.UnsubscribeURL("", "k", 7, 5)
+
+		rr := httptest.NewRecorder()
+		userUnsubscribePage(rr, newUnsubscribeRequest(t, http.MethodGet, link, queries))
+		if rr.Code != http.StatusOK {
+			t.Fatalf("GET status=%d", rr.Code)
+		}
+		if len(queries.DeleteSubscriptionByIDForSubscriberCalls) != 0 {
+			t.Fatal("GET removed the subscription")
+		}
+
+		rr = httptest.NewRecorder()
+		userUnsubscribePage(rr, newUnsubscribeRequest(t, http.MethodPost, link, queries))
+		if rr.Code != http.StatusOK {
+			t.Fatalf("POST status=%d", rr.Code)
+		}
+		calls := queries.DeleteSubscriptionByIDForSubscriberCalls
+		if len(calls) != 1 || calls[0].SubscriberID != 7 || calls[0].ID != 5 {
+			t.Fatalf("delete calls=%+v", calls)
+		}
+	})
+
+	t.Run("Unhappy Path", func(t *testing.T) {
+		queries := testhelpers.NewQuerierStub(testhelpers.WithSubscriptions(subs))
+		link := strings.Replace(notifications.UnsubscribeURL("", "k", 7, 5), "uid=7", "uid=8", 1)
+
+		rr := httptest.NewRecorder()
+		userUnsubscribePage(rr, newUnsubscribeRequest(t, http.MethodPost, link, queries))
+		if rr.Code != http.StatusNotFound {
+			t.Fatalf("status=%d", rr.Code)
+		}
+		if len(queries.DeleteSubscriptionByIDForSubscriberCalls) != 0 {
+			t.Fatal("forged link removed a subscription")
+		}
+	})
+}
@@ -21,6 +21,9 @@ import (
 // UserResetPasswordTask handles the public password reset via magic link.
 type UserResetPasswordTask struct{ tasks.TaskString }
 
+// Confidential reports true: the event carries the reset code.
+func (UserResetPasswordTask) Confidential() bool { return true }
+
 var userResetPasswordTask = &UserResetPasswordTask{TaskString: "Password Reset"}
 
 const TemplateUserResetPasswordPage tasks.Template = "pages/user/userResetPasswordPage.gohtml"
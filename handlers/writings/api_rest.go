@@ -0,0 +1,90 @@
+package writings
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/handlers"
+	"github.com/gorilla/mux"
+)
+
+// APIListArticles handles GET /api/writings/articles
+func APIListArticles(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	page := handlers.APIPage(r)
+	pageSize := cd.PageSize()
+
+	articles, err := cd.LatestWritings(
+		common.WithWritingsOffset(int32((page-1)*pageSize)),
+		common.WithWritingsLimit(int32(pageSize+1)),
+	)
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	hasMore := len(articles) > pageSize
+	if hasMore {
+		articles = articles[:pageSize]
+	}
+
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"articles": articles,
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APIShowArticle handles GET /api/writings/article/{writing}
+func APIShowArticle(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	cd.LoadSelectionsFromRequest(r)
+	writing, err := cd.Article()
+	if err != nil || writing == nil {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Article not found")
+		return
+	}
+	cd.SetCurrentThreadAndTopic(writing.ForumthreadID, 0)
+	if !cd.HasGrant("writing", "article", "view", writing.Idwriting) {
+		handlers.WriteJSONError(w, http.StatusNotFound, "Article not found")
+		return
+	}
+
+	comments, err := cd.ArticleComments()
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		handlers.WriteJSONError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	page := handlers.APIPage(r)
+	comments, hasMore := handlers.APIPaginate(comments, page, cd.PageSize())
+	handlers.WriteJSON(w, http.StatusOK, map[string]any{
+		"article":  writing,
+		"comments": handlers.APIComments(cd, r, comments),
+		"has_more": hasMore,
+		"page":     page,
+	})
+}
+
+// APICreateArticle handles POST /api/writings/category/{category}/articles
+func APICreateArticle(w http.ResponseWriter, r *http.Request) {
+	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
+	categoryID, err := strconv.Atoi(mux.Vars(r)["category"])
+	if err != nil {
+		handlers.WriteJSONError(w, http.StatusBadRequest, "Invalid category ID")
+		return
+	}
+	if !cd.HasGrant("writing", "category", "post", int32(categoryID)) {
+		handlers.WriteJSONError(w, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+		return
+	}
+	handlers.APITaskHandler(submitWritingTask)(w, r)
+}
+
+// APIPostComment handles POST /api/writings/article/{writing}/reply
+func APIPostComment(w http.ResponseWriter, r *http.Request) {
+	handlers.APITaskHandler(replyTask)(w, r)
+}
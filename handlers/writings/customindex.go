@@ -58,6 +58,11 @@ func WritingsPageSpecificItems(cd *common.CoreData, r *http.Request) []common.In
 				Name: "Edit Writing", Icon: "✏️", Link: fmt.Sprintf("/writings/article/%d/edit", writing.Idwriting),
 			})
 		}
+		if canEdit || cd.HasGrant("writing", "article", "edit-any", writing.Idwriting) {
+			items = append(items, common.IndexItem{
+				Name: "Edit History", Icon: "🕘", Link: fmt.Sprintf("/history/writing/%d", writing.Idwriting),
+			})
+		}
 
 		// Admin
 		if cd.IsAdmin() && cd.IsAdminMode() {
@@ -26,7 +26,9 @@ var replyTask = &ReplyTask{TaskString: TaskReply}
 var _ tasks.Task = (*ReplyTask)(nil)
 var _ notif.GrantsRequiredProvider = (*ReplyTask)(nil)
 var _ notif.SubscribersNotificationTemplateProvider = (*ReplyTask)(nil)
+var _ notif.EmailReplyProvider = (*ReplyTask)(nil)
 var _ notif.AutoSubscribeProvider = (*ReplyTask)(nil)
+var _ notif.MentionsNotificationProvider = (*ReplyTask)(nil)
 var _ tasks.EmailTemplatesRequired = (*ReplyTask)(nil)
 var _ searchworker.IndexedTask = ReplyTask{}
 
@@ -39,6 +41,16 @@ func (ReplyTask) IndexData(data map[string]any) []searchworker.IndexEventData {
 	return nil
 }
 
+// EmailReplyPath returns the article path so email replies reach its form,
+// also when the comment came in through the API.
+func (ReplyTask) EmailReplyPath(evt eventbus.TaskEvent) (string, bool) {
+	target, ok := evt.Data["target"].(notif.Target)
+	if !ok || evt.Outcome != eventbus.TaskOutcomeSuccess {
+		return "", false
+	}
+	return fmt.Sprintf("/writings/article/%d", target.ID), true
+}
+
 func (ReplyTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
 	return EmailTemplateWritingReply.EmailTemplates(), evt.Outcome == eventbus.TaskOutcomeSuccess
 }
@@ -52,7 +64,9 @@ func (ReplyTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent)
 }
 
 func (ReplyTask) RequiredTemplates() []tasks.Template {
-	return append(EmailTemplateWritingReply.RequiredTemplates(), NotificationTemplateWritingReply.RequiredTemplates()...)
+	r := append(EmailTemplateWritingReply.RequiredTemplates(), NotificationTemplateWritingReply.RequiredTemplates()...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 func (ReplyTask) GrantsRequired(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
@@ -76,6 +90,21 @@ func (ReplyTask) AutoSubscribeGrants(evt eventbus.TaskEvent) ([]notif.GrantRequi
 	return nil, nil
 }
 
+// MentionEmailTemplate emails users mentioned with @username.
+func (ReplyTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (ReplyTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (ReplyTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return ReplyTask{}.GrantsRequired(evt)
+}
+
 func (ReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 	cd := r.Context().Value(consts.KeyCoreData).(*common.CoreData)
 
@@ -132,6 +161,7 @@ func (ReplyTask) Action(w http.ResponseWriter, r *http.Request) any {
 	}); err != nil {
 		log.Printf("writing reply side effects: %v", err)
 	}
+	cd.RecordMentions(text, fmt.Sprintf("/writings/article/%d", writing.Idwriting))
 
 	return nil
 }
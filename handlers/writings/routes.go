@@ -8,6 +8,7 @@ import (
 
 	"github.com/arran4/goa4web/config"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/middleware/apiauth"
 	"github.com/arran4/goa4web/internal/router"
 
 	"github.com/arran4/goa4web/handlers/share"
@@ -65,6 +66,19 @@ func RegisterRoutes(r *mux.Router, _ *config.RuntimeConfig) []navpkg.RouterOptio
 
 	api := r.PathPrefix("/api/writings").Subrouter()
 	api.HandleFunc("/share", share.ShareLink).Methods("GET")
+
+	rest := api.NewRoute().Subrouter()
+	rest.Use(apiauth.APIKeyAuthMiddleware)
+
+	apiRead := rest.NewRoute().Subrouter()
+	apiRead.Use(apiauth.RequireScope("writings:read"))
+	apiRead.HandleFunc("/articles", APIListArticles).Methods(http.MethodGet)
+	apiRead.HandleFunc("/article/{writing}", APIShowArticle).Methods(http.MethodGet)
+
+	apiWrite := rest.NewRoute().Subrouter()
+	apiWrite.Use(apiauth.RequireScope("writings:write"))
+	apiWrite.HandleFunc("/category/{category}/articles", APICreateArticle).Methods(http.MethodPost)
+	apiWrite.HandleFunc("/article/{writing}/reply", APIPostComment).Methods(http.MethodPost)
 	return opts
 }
 
@@ -73,4 +87,5 @@ func Register(reg *router.Registry) {
 	reg.RegisterModule("writings", nil, func(r *mux.Router, cfg *config.RuntimeConfig) []navpkg.RouterOptions {
 		return RegisterRoutes(r, cfg)
 	})
+	reg.RegisterSitemap("writings", sitemapEntries)
 }
@@ -0,0 +1,26 @@
+package writings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/sitemap"
+)
+
+// sitemapEntries lists the writings index and every public article anonymous
+// visitors may open.
+func sitemapEntries(ctx context.Context, q db.Querier) ([]sitemap.Entry, error) {
+	rows, err := q.SystemListSitemapWritings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list writings: %w", err)
+	}
+	entries := make([]sitemap.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, sitemap.Entry{
+			Path:    fmt.Sprintf("/writings/article/%d", row.Idwriting),
+			LastMod: row.Published.Time,
+		})
+	}
+	return append([]sitemap.Entry{{Path: "/writings", LastMod: sitemap.Latest(entries)}}, entries...), nil
+}
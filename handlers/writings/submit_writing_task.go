@@ -11,6 +11,7 @@ import (
 	"github.com/arran4/goa4web/core/common"
 	"github.com/arran4/goa4web/core/consts"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/activitypub"
 	"github.com/arran4/goa4web/internal/eventbus"
 	notif "github.com/arran4/goa4web/internal/notifications"
 	"github.com/arran4/goa4web/internal/tasks"
@@ -25,10 +26,26 @@ var submitWritingTask = &SubmitWritingTask{TaskString: TaskSubmitWriting}
 var _ tasks.Task = (*SubmitWritingTask)(nil)
 var _ notif.SubscribersNotificationTemplateProvider = (*SubmitWritingTask)(nil)
 var _ notif.GrantsRequiredProvider = (*SubmitWritingTask)(nil)
+var _ notif.MentionsNotificationProvider = (*SubmitWritingTask)(nil)
 var _ tasks.EmailTemplatesRequired = (*SubmitWritingTask)(nil)
 
 func (SubmitWritingTask) Page(w http.ResponseWriter, r *http.Request) { ArticleAddPage(w, r) }
 
+// MentionEmailTemplate emails users mentioned with @username.
+func (SubmitWritingTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (SubmitWritingTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who can view the item.
+func (SubmitWritingTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return SubmitWritingTask{}.GrantsRequired(evt)
+}
+
 func (SubmitWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 	vars := mux.Vars(r)
 	categoryID, err := strconv.Atoi(vars["category"])
@@ -50,6 +67,10 @@ func (SubmitWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 	abstract := r.PostFormValue("abstract")
 	body := r.PostFormValue("body")
 	uid, _ := session.Values["UID"].(int32)
+	publishAt, err := cd.ParsePublishAt(r.PostFormValue("publish_at"))
+	if err != nil {
+		return fmt.Errorf("publish at parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
 
 	articleID, err := cd.CreateWriting(int32(categoryID), int32(languageID), title, abstract, body, private)
 	if err != nil {
@@ -58,6 +79,10 @@ func (SubmitWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 	if articleID == 0 {
 		return fmt.Errorf("create writing deny %w", handlers.ErrRedirectOnSamePageHandler(handlers.ErrForbidden))
 	}
+	scheduled, err := cd.SchedulePublication(common.ScheduledTypeWriting, int32(articleID), uid, publishAt)
+	if err != nil {
+		return fmt.Errorf("schedule writing fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+	}
 
 	var author string
 	queries := cd.Queries()
@@ -75,29 +100,47 @@ func (SubmitWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 		evt.Data["target"] = notif.Target{Type: "writing", ID: int32(articleID)}
 	}
 
+	if scheduled {
+		// The publish worker indexes the writing once it goes live.
+		cd.MarkEventScheduled()
+		return handlers.RedirectHandler(fmt.Sprintf("/writings/article/%d", articleID))
+	}
+
 	fullText := strings.Join([]string{abstract, title, body}, " ")
 	if evt := cd.Event(); evt != nil {
 		if evt.Data == nil {
 			evt.Data = map[string]any{}
 		}
 		evt.Data[searchworker.EventKey] = searchworker.IndexEventData{Type: searchworker.TypeWriting, ID: int32(articleID), Text: fullText}
+		evt.Data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeWriting, ItemID: int32(articleID)}
 	}
+	cd.RecordMentions(abstract+"\n"+body, fmt.Sprintf("/writings/article/%d", articleID))
 
 	return handlers.RedirectHandler(fmt.Sprintf("/writings/article/%d", articleID))
 }
 
+// SubscribedEmailTemplate stays quiet for scheduled writings; the publish
+// worker notifies subscribers once the writing goes live.
 func (SubmitWritingTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	if common.EventScheduled(evt) {
+		return nil, false
+	}
 	return EmailTemplateWriting.EmailTemplates(), true
 }
 
 func (SubmitWritingTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	if common.EventScheduled(evt) {
+		return nil
+	}
 	s := NotificationTemplateWriting.NotificationTemplate()
 	return &s
 }
 
 func (SubmitWritingTask) RequiredTemplates() []tasks.Template {
-	return append([]tasks.Template{tasks.Template(WritingsArticleAddPageTmpl)},
+	r := append([]tasks.Template{tasks.Template(WritingsArticleAddPageTmpl)},
 		append(EmailTemplateWriting.RequiredTemplates(), NotificationTemplateWriting.RequiredTemplates()...)...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	return append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
 }
 
 func (SubmitWritingTask) GrantsRequired(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
@@ -5,10 +5,12 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/arran4/goa4web/core/common"
 	"github.com/arran4/goa4web/core/consts"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/activitypub"
 	"github.com/arran4/goa4web/internal/eventbus"
 	notif "github.com/arran4/goa4web/internal/notifications"
 	"github.com/arran4/goa4web/internal/tasks"
@@ -59,6 +61,15 @@ func (UpdateWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 
 	queries := cd.Queries()
 
+	var publishAt time.Time
+	// The field is only offered while the writing is still scheduled.
+	_, reschedule := r.PostForm["publish_at"]
+	if reschedule {
+		if publishAt, err = cd.ParsePublishAt(r.PostFormValue("publish_at")); err != nil {
+			return fmt.Errorf("publish at parse fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+	}
+
 	if err := cd.UpdateWriting(writing, title, abstract, body, private, int32(languageID)); err != nil {
 		return fmt.Errorf("update writing fail %w", err)
 	}
@@ -67,6 +78,17 @@ func (UpdateWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 		return fmt.Errorf("set author labels fail %w", handlers.ErrRedirectOnSamePageHandler(err))
 	}
 
+	if reschedule {
+		if _, err := cd.ReschedulePublication(common.ScheduledTypeWriting, writing.Idwriting, publishAt); err != nil {
+			return fmt.Errorf("reschedule writing fail %w", handlers.ErrRedirectOnSamePageHandler(err))
+		}
+	}
+	if !cd.ScheduledLive(common.ScheduledTypeWriting, writing.Idwriting) {
+		// Not live yet: the publish worker indexes and announces it later.
+		cd.MarkEventScheduled()
+		return nil
+	}
+
 	if cd, ok := r.Context().Value(consts.KeyCoreData).(*common.CoreData); ok {
 		if evt := cd.Event(); evt != nil {
 			if evt.Data == nil {
@@ -94,6 +116,7 @@ func (UpdateWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 				evt.Data = map[string]any{}
 			}
 			evt.Data[searchworker.EventKey] = searchworker.IndexEventData{Type: searchworker.TypeWriting, ID: writing.Idwriting, Text: fullText}
+			evt.Data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeWriting, ItemID: writing.Idwriting}
 		}
 	}
 
@@ -101,10 +124,16 @@ func (UpdateWritingTask) Action(w http.ResponseWriter, r *http.Request) any {
 }
 
 func (UpdateWritingTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	if common.EventScheduled(evt) {
+		return nil, false
+	}
 	return EmailTemplateWritingUpdate.EmailTemplates(), true
 }
 
 func (UpdateWritingTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	if common.EventScheduled(evt) {
+		return nil
+	}
 	s := NotificationTemplateWritingUpdate.NotificationTemplate()
 	return &s
 }
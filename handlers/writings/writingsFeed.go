@@ -30,7 +30,7 @@ func feedGen(r *http.Request, cd *common.CoreData) (*feeds.Feed, error) {
 	}
 
 	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
-	rows, err := cd.LatestWritings(common.WithWritingsOffset(int32(offset)))
+	rows, err := cd.LatestWritings(common.WithWritingsOffset(int32(offset)), common.WithWritingsLiveOnly())
 	if err != nil {
 		return nil, err
 	}
@@ -284,3 +284,23 @@ func getEmailBody(t *testing.T, msg *mail.Message) string {
 	}
 	return buf.String()
 }
+
+func TestReplyTaskEmailReplyPath(t *testing.T) {
+	data := map[string]any{
+		"target": notifications.Target{Type: "writing", ID: 42},
+	}
+	cases := map[string]string{
+		"/writings/article/42":           "/writings/article/42",
+		"/api/writings/article/42/reply": "/writings/article/42",
+	}
+	for evtPath, want := range cases {
+		evt := eventbus.TaskEvent{Data: data, Path: evtPath, Outcome: eventbus.TaskOutcomeSuccess}
+		path, ok := replyTask.EmailReplyPath(evt)
+		if !ok || path != want {
+			t.Errorf("EmailReplyPath(%q)=%q,%v want %q", evtPath, path, ok, want)
+		}
+	}
+	if _, ok := replyTask.EmailReplyPath(eventbus.TaskEvent{Data: data, Path: "/writings/article/42"}); ok {
+		t.Errorf("EmailReplyPath offered for a failed reply")
+	}
+}
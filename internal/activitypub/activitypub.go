@@ -0,0 +1,174 @@
+// Package activitypub publishes each user's blog entries and writings to
+// fediverse servers. Every active user is an actor that remote servers find
+// through WebFinger and follow; the Create, Update and Delete activities for
+// their public items are recorded in an outbox and delivered to followers
+// with HTTP signatures.
+package activitypub
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// ContentType is the media type of ActivityPub documents.
+	ContentType = "application/activity+json"
+	// LDContentType is the JSON-LD media type remote servers may ask for
+	// instead of ContentType.
+	LDContentType = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+	// JRDContentType is the media type of WebFinger responses.
+	JRDContentType = "application/jrd+json"
+
+	// Context is the ActivityStreams JSON-LD context.
+	Context = "https://www.w3.org/ns/activitystreams"
+	// SecurityContext declares the publicKey terms used on actors.
+	SecurityContext = "https://w3id.org/security/v1"
+	// Public addresses an activity to everyone.
+	Public = "https://www.w3.org/ns/activitystreams#Public"
+)
+
+// Activity types sent and understood by the site.
+const (
+	TypeCreate = "Create"
+	TypeUpdate = "Update"
+	TypeDelete = "Delete"
+	TypeFollow = "Follow"
+	TypeAccept = "Accept"
+	TypeUndo   = "Undo"
+)
+
+// Item types federated by the site. They match the scheduled publication
+// types so events can be described the same way.
+const (
+	ItemTypeBlog    = "blog"
+	ItemTypeWriting = "writing"
+)
+
+// EventKey is the event data key holding an EventData for a blog entry or
+// writing whose federated copy may need updating.
+const EventKey = "activitypub"
+
+// EventData names an item changed by a task. The worker works out from the
+// item's visibility and the outbox whether followers need a Create, Update
+// or Delete.
+type EventData struct {
+	ItemType string
+	ItemID   int32
+}
+
+// Actor is a user as seen by remote servers.
+type Actor struct {
+	Context                   any        `json:"@context,omitempty"`
+	ID                        string     `json:"id"`
+	Type                      string     `json:"type"`
+	PreferredUsername         string     `json:"preferredUsername,omitempty"`
+	Name                      string     `json:"name,omitempty"`
+	URL                       string     `json:"url,omitempty"`
+	Inbox                     string     `json:"inbox"`
+	Outbox                    string     `json:"outbox,omitempty"`
+	Followers                 string     `json:"followers,omitempty"`
+	ManuallyApprovesFollowers bool       `json:"manuallyApprovesFollowers"`
+	Endpoints                 *Endpoints `json:"endpoints,omitempty"`
+	PublicKey                 *PublicKey `json:"publicKey,omitempty"`
+}
+
+// Endpoints lists additional actor endpoints.
+type Endpoints struct {
+	SharedInbox string `json:"sharedInbox,omitempty"`
+}
+
+// PublicKey is the key remote servers use to verify an actor's requests.
+type PublicKey struct {
+	ID           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// Object is a blog entry, writing or the tombstone left once it is gone.
+type Object struct {
+	ID           string     `json:"id"`
+	Type         string     `json:"type"`
+	AttributedTo string     `json:"attributedTo,omitempty"`
+	Name         string     `json:"name,omitempty"`
+	Summary      string     `json:"summary,omitempty"`
+	Content      string     `json:"content,omitempty"`
+	MediaType    string     `json:"mediaType,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	Published    *time.Time `json:"published,omitempty"`
+	Updated      *time.Time `json:"updated,omitempty"`
+	To           []string   `json:"to,omitempty"`
+	Cc           []string   `json:"cc,omitempty"`
+}
+
+// Activity wraps an object or another activity.
+type Activity struct {
+	Context   any       `json:"@context,omitempty"`
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Actor     string    `json:"actor"`
+	Object    any       `json:"object"`
+	Published time.Time `json:"published,omitzero"`
+	To        []string  `json:"to,omitempty"`
+	Cc        []string  `json:"cc,omitempty"`
+}
+
+// OrderedCollection is an outbox or followers collection.
+type OrderedCollection struct {
+	Context    string `json:"@context,omitempty"`
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	TotalItems int64  `json:"totalItems"`
+	First      string `json:"first,omitempty"`
+}
+
+// OrderedCollectionPage is one page of an outbox.
+type OrderedCollectionPage struct {
+	Context      string            `json:"@context,omitempty"`
+	ID           string            `json:"id"`
+	Type         string            `json:"type"`
+	PartOf       string            `json:"partOf"`
+	Next         string            `json:"next,omitempty"`
+	TotalItems   int64             `json:"totalItems"`
+	OrderedItems []json.RawMessage `json:"orderedItems"`
+}
+
+// ActorPath is the site-relative location of username's actor.
+func ActorPath(username string) string {
+	return "/activitypub/users/" + url.PathEscape(username)
+}
+
+// InboxPath is where remote servers post activities for username.
+func InboxPath(username string) string { return ActorPath(username) + "/inbox" }
+
+// OutboxPath lists the activities sent by username.
+func OutboxPath(username string) string { return ActorPath(username) + "/outbox" }
+
+// FollowersPath is the collection of username's followers.
+func FollowersPath(username string) string { return ActorPath(username) + "/followers" }
+
+// KeyID identifies the public key of the actor at actorURL.
+func KeyID(actorURL string) string { return actorURL + "#main-key" }
+
+// ItemPath is the site-relative page of a federated item.
+func ItemPath(itemType string, id int32) string {
+	switch itemType {
+	case ItemTypeBlog:
+		return fmt.Sprintf("/blogs/blog/%d", id)
+	case ItemTypeWriting:
+		return fmt.Sprintf("/writings/article/%d", id)
+	}
+	return ""
+}
+
+// ObjectPath is the site-relative ActivityPub id of a federated item. The
+// object's url points at ItemPath.
+func ObjectPath(itemType string, id int32) string {
+	return fmt.Sprintf("/activitypub/objects/%s/%d", url.PathEscape(itemType), id)
+}
+
+// ProfilePath is the page about username that WebFinger links to.
+func ProfilePath(username string) string {
+	return "/blogs/blogger/" + url.PathEscape(username)
+}
@@ -0,0 +1,474 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/safehttp"
+)
+
+const localBase = "https://blog.example"
+
+type fakeQueries struct {
+	db.Querier
+	mu         sync.Mutex
+	users      map[int32]string
+	keys       map[int32]*db.ActivitypubActorKey
+	followers  []*db.ActivitypubFollower
+	activities []*db.ActivitypubActivity
+	deliveries []*db.ActivitypubDelivery
+	blogs      map[int32]*db.SystemGetActivityPubBlogEntryRow
+}
+
+func newFakeQueries() *fakeQueries {
+	return &fakeQueries{
+		users: map[int32]string{1: "bob"},
+		keys:  map[int32]*db.ActivitypubActorKey{},
+		blogs: map[int32]*db.SystemGetActivityPubBlogEntryRow{},
+	}
+}
+
+func (f *fakeQueries) SystemGetActivityPubActor(_ context.Context, username sql.NullString) (*db.SystemGetActivityPubActorRow, error) {
+	for id, name := range f.users {
+		if name == username.String {
+			return &db.SystemGetActivityPubActorRow{Idusers: id, Username: sql.NullString{String: name, Valid: true}}, nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (f *fakeQueries) SystemGetUserByID(_ context.Context, id int32) (*db.SystemGetUserByIDRow, error) {
+	name, ok := f.users[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &db.SystemGetUserByIDRow{Idusers: id, Username: sql.NullString{String: name, Valid: true}}, nil
+}
+
+func (f *fakeQueries) SystemGetActivityPubActorKey(_ context.Context, userID int32) (*db.ActivitypubActorKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	k, ok := f.keys[userID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return k, nil
+}
+
+func (f *fakeQueries) SystemInsertActivityPubActorKey(_ context.Context, arg db.SystemInsertActivityPubActorKeyParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.keys[arg.UserID]; !ok {
+		f.keys[arg.UserID] = &db.ActivitypubActorKey{UserID: arg.UserID, PublicKeyPem: arg.PublicKeyPem, PrivateKeyPem: arg.PrivateKeyPem}
+	}
+	return nil
+}
+
+func (f *fakeQueries) SystemUpsertActivityPubFollower(_ context.Context, arg db.SystemUpsertActivityPubFollowerParams) error {
+	for _, fl := range f.followers {
+		if fl.UserID == arg.UserID && fl.ActorUrl == arg.ActorUrl {
+			fl.InboxUrl, fl.SharedInboxUrl = arg.InboxUrl, arg.SharedInboxUrl
+			return nil
+		}
+	}
+	f.followers = append(f.followers, &db.ActivitypubFollower{
+		ID:             int32(len(f.followers) + 1),
+		UserID:         arg.UserID,
+		ActorUrl:       arg.ActorUrl,
+		InboxUrl:       arg.InboxUrl,
+		SharedInboxUrl: arg.SharedInboxUrl,
+	})
+	return nil
+}
+
+func (f *fakeQueries) SystemDeleteActivityPubFollower(_ context.Context, arg db.SystemDeleteActivityPubFollowerParams) (int64, error) {
+	for i, fl := range f.followers {
+		if fl.UserID == arg.UserID && fl.ActorUrl == arg.ActorUrl {
+			f.followers = append(f.followers[:i], f.followers[i+1:]...)
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
+
+func (f *fakeQueries) SystemListActivityPubFollowers(_ context.Context, userID int32) ([]*db.ActivitypubFollower, error) {
+	var r []*db.ActivitypubFollower
+	for _, fl := range f.followers {
+		if fl.UserID == userID {
+			r = append(r, fl)
+		}
+	}
+	return r, nil
+}
+
+func (f *fakeQueries) SystemInsertActivityPubActivity(_ context.Context, arg db.SystemInsertActivityPubActivityParams) (int64, error) {
+	id := int32(len(f.activities) + 1)
+	f.activities = append(f.activities, &db.ActivitypubActivity{
+		ID:           id,
+		UserID:       arg.UserID,
+		ActivityType: arg.ActivityType,
+		ObjectUrl:    arg.ObjectUrl,
+		ObjectJson:   arg.ObjectJson,
+		Published:    arg.Published,
+	})
+	return int64(id), nil
+}
+
+func (f *fakeQueries) SystemGetLatestActivityPubActivityForObject(_ context.Context, objectURL string) (*db.ActivitypubActivity, error) {
+	for i := len(f.activities) - 1; i >= 0; i-- {
+		if f.activities[i].ObjectUrl == objectURL {
+			return f.activities[i], nil
+		}
+	}
+	return nil, sql.ErrNoRows
+}
+
+func (f *fakeQueries) SystemInsertActivityPubDelivery(_ context.Context, arg db.SystemInsertActivityPubDeliveryParams) error {
+	f.deliveries = append(f.deliveries, &db.ActivitypubDelivery{
+		ID:            int32(len(f.deliveries) + 1),
+		UserID:        arg.UserID,
+		InboxUrl:      arg.InboxUrl,
+		Payload:       arg.Payload,
+		Status:        StatusPending,
+		NextAttemptAt: arg.NextAttemptAt,
+	})
+	return nil
+}
+
+func (f *fakeQueries) SystemListDueActivityPubDeliveries(_ context.Context, arg db.SystemListDueActivityPubDeliveriesParams) ([]*db.ActivitypubDelivery, error) {
+	var r []*db.ActivitypubDelivery
+	for _, d := range f.deliveries {
+		if d.Status == StatusPending && !d.NextAttemptAt.After(arg.Now) {
+			c := *d
+			r = append(r, &c)
+		}
+	}
+	sort.Slice(r, func(i, j int) bool { return r[i].ID < r[j].ID })
+	return r, nil
+}
+
+func (f *fakeQueries) SystemClaimActivityPubDelivery(_ context.Context, arg db.SystemClaimActivityPubDeliveryParams) (int64, error) {
+	d := f.deliveries[arg.ID-1]
+	if d.Status != StatusPending || d.NextAttemptAt.After(arg.Now) {
+		return 0, nil
+	}
+	d.NextAttemptAt = arg.LeaseUntil
+	return 1, nil
+}
+
+func (f *fakeQueries) SystemUpdateActivityPubDelivery(_ context.Context, arg db.SystemUpdateActivityPubDeliveryParams) error {
+	d := f.deliveries[arg.ID-1]
+	d.Status, d.Attempts, d.NextAttemptAt, d.Error = arg.Status, arg.Attempts, arg.NextAttemptAt, arg.Error
+	return nil
+}
+
+func (f *fakeQueries) SystemGetActivityPubBlogEntry(_ context.Context, id int32) (*db.SystemGetActivityPubBlogEntryRow, error) {
+	b, ok := f.blogs[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return b, nil
+}
+
+// remoteServer stands in for a fediverse server with a single actor, alice,
+// whose inbox records the activities it receives.
+type remoteServer struct {
+	*httptest.Server
+	key      *rsa.PrivateKey
+	mu       sync.Mutex
+	received []Activity
+	status   int
+	// senderKey verifies deliveries from the local site.
+	senderKey func() *rsa.PublicKey
+	// inbox overrides the inbox alice's actor document advertises.
+	inbox string
+}
+
+func newRemoteServer(t *testing.T) *remoteServer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	rs := &remoteServer{key: key, status: http.StatusAccepted}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /users/alice", func(w http.ResponseWriter, r *http.Request) {
+		pub, _ := EncodePublicKey(&key.PublicKey)
+		inbox := rs.inbox
+		if inbox == "" {
+			inbox = rs.URL + "/users/alice/inbox"
+		}
+		w.Header().Set("Content-Type", ContentType)
+		_ = json.NewEncoder(w).Encode(Actor{
+			ID:        rs.actorURL(),
+			Type:      "Person",
+			Inbox:     inbox,
+			PublicKey: &PublicKey{ID: KeyID(rs.actorURL()), Owner: rs.actorURL(), PublicKeyPem: pub},
+		})
+	})
+	mux.HandleFunc("POST /users/alice/inbox", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := Verify(r, body, rs.senderKey(), time.Now()); err != nil {
+			t.Errorf("delivery signature: %v", err)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		var act Activity
+		if err := json.Unmarshal(body, &act); err != nil {
+			t.Errorf("decode delivery: %v", err)
+		}
+		rs.mu.Lock()
+		rs.received = append(rs.received, act)
+		status := rs.status
+		rs.mu.Unlock()
+		w.WriteHeader(status)
+	})
+	rs.Server = httptest.NewServer(mux)
+	t.Cleanup(rs.Close)
+	return rs
+}
+
+func (rs *remoteServer) actorURL() string { return rs.URL + "/users/alice" }
+
+func (rs *remoteServer) take() []Activity {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	r := rs.received
+	rs.received = nil
+	return r
+}
+
+// post signs activity as alice and delivers it to bob's inbox.
+func (rs *remoteServer) post(t *testing.T, f *Federator, activity any) (bool, error) {
+	t.Helper()
+	body, err := json.Marshal(activity)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	req := httptest.NewRequest(http.MethodPost, localBase+InboxPath("bob"), bytes.NewReader(body))
+	req.Header.Set("Content-Type", ContentType)
+	if err := Sign(req, KeyID(rs.actorURL()), rs.key, body, time.Now()); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	return f.HandleInbox(context.Background(), req, "bob")
+}
+
+func newTestFederator(t *testing.T, q *fakeQueries, rs *remoteServer) *Federator {
+	t.Helper()
+	f := New(q, localBase, WithHTTPClient(rs.Client()))
+	rs.senderKey = func() *rsa.PublicKey {
+		k, err := ActorKey(context.Background(), q, 1)
+		if err != nil {
+			t.Fatalf("actor key: %v", err)
+		}
+		return &k.Private.PublicKey
+	}
+	return f
+}
+
+func TestSignVerify(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	body := []byte(`{"type":"Create"}`)
+	now := time.Now()
+	req := httptest.NewRequest(http.MethodPost, "https://remote.example/inbox", bytes.NewReader(body))
+	if err := Sign(req, "https://blog.example/activitypub/users/bob#main-key", key, body, now); err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+	if id, err := SignatureKeyID(req); err != nil || id != "https://blog.example/activitypub/users/bob#main-key" {
+		t.Fatalf("key id %q %v", id, err)
+	}
+	if err := Verify(req, body, &key.PublicKey, now); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if err := Verify(req, []byte(`{"type":"Delete"}`), &key.PublicKey, now); err == nil {
+		t.Fatal("expected digest mismatch")
+	}
+	if err := Verify(req, body, &key.PublicKey, now.Add(13*time.Hour)); err == nil {
+		t.Fatal("expected stale date to fail")
+	}
+	other, _ := rsa.GenerateKey(rand.Reader, 2048)
+	if err := Verify(req, body, &other.PublicKey, now); err == nil {
+		t.Fatal("expected wrong key to fail")
+	}
+	req.Header.Del("Signature")
+	if err := Verify(req, body, &key.PublicKey, now); err == nil {
+		t.Fatal("expected unsigned request to fail")
+	}
+}
+
+func TestFollowAndPublish(t *testing.T) {
+	q := newFakeQueries()
+	rs := newRemoteServer(t)
+	f := newTestFederator(t, q, rs)
+	ctx := context.Background()
+	bob := f.ActorURL("bob")
+
+	follow := map[string]any{"id": rs.URL + "/follows/1", "type": TypeFollow, "actor": rs.actorURL(), "object": bob}
+	queued, err := rs.post(t, f, follow)
+	if err != nil || !queued {
+		t.Fatalf("follow: queued=%v err=%v", queued, err)
+	}
+	if len(q.followers) != 1 || q.followers[0].InboxUrl != rs.URL+"/users/alice/inbox" {
+		t.Fatalf("followers=%+v", q.followers)
+	}
+	if n, err := f.DeliverDue(ctx); err != nil || n != 1 {
+		t.Fatalf("deliver accept: n=%d err=%v", n, err)
+	}
+	got := rs.take()
+	if len(got) != 1 || got[0].Type != TypeAccept || got[0].Actor != bob {
+		t.Fatalf("accept=%+v", got)
+	}
+
+	q.blogs[7] = &db.SystemGetActivityPubBlogEntryRow{
+		Idblogs:      7,
+		UsersIdusers: 1,
+		Username:     sql.NullString{String: "bob", Valid: true},
+		Blog:         sql.NullString{String: "hello <fediverse>", Valid: true},
+		Written:      time.Now(),
+	}
+	for _, want := range []string{TypeCreate, TypeUpdate} {
+		if err := f.Sync(ctx, ItemTypeBlog, 7); err != nil {
+			t.Fatalf("sync: %v", err)
+		}
+		if _, err := f.DeliverDue(ctx); err != nil {
+			t.Fatalf("deliver: %v", err)
+		}
+		got := rs.take()
+		if len(got) != 1 || got[0].Type != want {
+			t.Fatalf("want %s got %+v", want, got)
+		}
+		obj, _ := got[0].Object.(map[string]any)
+		if obj["id"] != localBase+"/activitypub/objects/blog/7" || obj["url"] != localBase+"/blogs/blog/7" || obj["type"] != "Note" {
+			t.Fatalf("object=%v", obj)
+		}
+		if obj["content"] != "hello &lt;fediverse&gt;" {
+			t.Fatalf("content=%v", obj["content"])
+		}
+	}
+
+	delete(q.blogs, 7)
+	if err := f.Sync(ctx, ItemTypeBlog, 7); err != nil {
+		t.Fatalf("sync delete: %v", err)
+	}
+	if _, err := f.DeliverDue(ctx); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	got = rs.take()
+	if len(got) != 1 || got[0].Type != TypeDelete {
+		t.Fatalf("delete=%+v", got)
+	}
+	if obj, _ := got[0].Object.(map[string]any); obj["type"] != "Tombstone" {
+		t.Fatalf("tombstone=%v", obj)
+	}
+	// Once deleted there is nothing further to tell followers.
+	if err := f.Sync(ctx, ItemTypeBlog, 7); err != nil {
+		t.Fatalf("sync: %v", err)
+	}
+	if len(q.activities) != 3 {
+		t.Fatalf("activities=%d", len(q.activities))
+	}
+
+	undo := map[string]any{"id": rs.URL + "/undo/1", "type": TypeUndo, "actor": rs.actorURL(), "object": follow}
+	if _, err := rs.post(t, f, undo); err != nil {
+		t.Fatalf("undo: %v", err)
+	}
+	if len(q.followers) != 0 {
+		t.Fatalf("followers after undo=%+v", q.followers)
+	}
+}
+
+func TestInboxRejectsForgedActor(t *testing.T) {
+	q := newFakeQueries()
+	rs := newRemoteServer(t)
+	f := newTestFederator(t, q, rs)
+	follow := map[string]any{"id": "x", "type": TypeFollow, "actor": "https://elsewhere.example/users/mallory", "object": f.ActorURL("bob")}
+	if _, err := rs.post(t, f, follow); err == nil || !strings.Contains(err.Error(), ErrInvalidSignature.Error()) {
+		t.Fatalf("expected signature error got %v", err)
+	}
+	if len(q.followers) != 0 {
+		t.Fatalf("followers=%+v", q.followers)
+	}
+}
+
+func TestInboxRejectsInboxOnOtherHost(t *testing.T) {
+	q := newFakeQueries()
+	rs := newRemoteServer(t)
+	rs.inbox = "http://169.254.169.254/latest/meta-data"
+	f := newTestFederator(t, q, rs)
+	follow := map[string]any{"id": rs.URL + "/follows/1", "type": TypeFollow, "actor": rs.actorURL(), "object": f.ActorURL("bob")}
+	if _, err := rs.post(t, f, follow); !errors.Is(err, ErrInvalidSignature) {
+		t.Fatalf("expected signature error got %v", err)
+	}
+	if len(q.followers) != 0 || len(q.deliveries) != 0 {
+		t.Fatalf("followers=%+v deliveries=%+v", q.followers, q.deliveries)
+	}
+}
+
+func TestDefaultClientRefusesPrivateActor(t *testing.T) {
+	q := newFakeQueries()
+	rs := newRemoteServer(t)
+	fetched := false
+	rs.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { fetched = true })
+	f := New(q, localBase)
+	follow := map[string]any{"id": rs.URL + "/follows/1", "type": TypeFollow, "actor": rs.actorURL(), "object": f.ActorURL("bob")}
+	if _, err := rs.post(t, f, follow); !errors.Is(err, ErrInvalidSignature) || !strings.Contains(err.Error(), safehttp.ErrBlockedAddress.Error()) {
+		t.Fatalf("expected blocked fetch got %v", err)
+	}
+	if fetched {
+		t.Fatal("actor fetched from a loopback address")
+	}
+}
+
+func TestDeliveryRetry(t *testing.T) {
+	q := newFakeQueries()
+	rs := newRemoteServer(t)
+	rs.status = http.StatusServiceUnavailable
+	f := newTestFederator(t, q, rs)
+	f.maxAttempts = 2
+	now := time.Now()
+	f.now = func() time.Time { return now }
+	ctx := context.Background()
+	if err := f.queue(ctx, 1, rs.URL+"/users/alice/inbox", []byte(`{"type":"Create"}`)); err != nil {
+		t.Fatalf("queue: %v", err)
+	}
+
+	if _, err := f.DeliverDue(ctx); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	d := q.deliveries[0]
+	if d.Status != StatusPending || d.Attempts != 1 || !d.Error.Valid || !d.NextAttemptAt.Equal(now.UTC().Add(DefaultBackoff)) {
+		t.Fatalf("after first attempt: %+v", d)
+	}
+	// Not due yet.
+	if n, _ := f.DeliverDue(ctx); n != 0 {
+		t.Fatalf("retried early")
+	}
+
+	now = now.Add(DefaultBackoff)
+	if _, err := f.DeliverDue(ctx); err != nil {
+		t.Fatalf("deliver: %v", err)
+	}
+	if d.Status != StatusFailed || d.Attempts != 2 {
+		t.Fatalf("after last attempt: %+v", d)
+	}
+	if got := rs.take(); len(got) != 2 {
+		t.Fatalf("received %d attempts", len(got))
+	}
+}
@@ -0,0 +1,138 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+const userAgent = "goa4web-activitypub"
+
+// TaskDeliver is the name of DeliverTask events.
+const TaskDeliver tasks.TaskString = "ActivityPub Deliver"
+
+// DeliverTask sends queued activities from the background task worker. It
+// is published whenever deliveries are queued and by the scheduler while
+// retries are due.
+type DeliverTask struct{ tasks.TaskString }
+
+// NewDeliverTask returns the task that sends due deliveries.
+func NewDeliverTask() *DeliverTask { return &DeliverTask{TaskString: TaskDeliver} }
+
+var _ tasks.Task = (*DeliverTask)(nil)
+var _ tasks.BackgroundTasker = (*DeliverTask)(nil)
+
+// Action is unused; the event is raised by workers rather than a form.
+func (DeliverTask) Action(http.ResponseWriter, *http.Request) any { return nil }
+
+// BackgroundTask sends every delivery that is due.
+func (DeliverTask) BackgroundTask(ctx context.Context, q db.Querier) (tasks.Task, error) {
+	cd, ok := ctx.Value(consts.KeyCoreData).(*common.CoreData)
+	if !ok || cd == nil {
+		cd = common.NewCoreData(ctx, q, nil)
+	}
+	_, err := ForCoreData(cd).DeliverDue(ctx)
+	return nil, err
+}
+
+// DeliverDue sends the deliveries whose next attempt is due and returns how
+// many were attempted. Each one is claimed first so concurrent runs do not
+// send it twice.
+func (f *Federator) DeliverDue(ctx context.Context) (int, error) {
+	now := f.now().UTC()
+	rows, err := f.queries.SystemListDueActivityPubDeliveries(ctx, db.SystemListDueActivityPubDeliveriesParams{
+		Now:   now,
+		Limit: deliveryBatch,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("list due deliveries: %w", err)
+	}
+	sent := 0
+	for _, d := range rows {
+		n, err := f.queries.SystemClaimActivityPubDelivery(ctx, db.SystemClaimActivityPubDeliveryParams{
+			LeaseUntil: now.Add(deliveryLease),
+			ID:         d.ID,
+			Now:        now,
+		})
+		if err != nil {
+			return sent, fmt.Errorf("claim delivery %d: %w", d.ID, err)
+		}
+		if n == 0 {
+			continue
+		}
+		f.deliver(ctx, d)
+		sent++
+	}
+	return sent, nil
+}
+
+// deliver makes one attempt at d and records the outcome. Failed attempts
+// are retried with exponential backoff until DefaultMaxAttempts is reached.
+func (f *Federator) deliver(ctx context.Context, d *db.ActivitypubDelivery) {
+	attempts := int(d.Attempts) + 1
+	err := f.post(ctx, d)
+	arg := db.SystemUpdateActivityPubDeliveryParams{
+		Status:        StatusDelivered,
+		Attempts:      int32(attempts),
+		NextAttemptAt: d.NextAttemptAt,
+		ID:            d.ID,
+	}
+	if err != nil {
+		arg.Error = sql.NullString{String: err.Error(), Valid: true}
+		if attempts >= f.maxAttempts {
+			arg.Status = StatusFailed
+			log.Printf("activitypub delivery %d to %s failed: %v", d.ID, d.InboxUrl, err)
+		} else {
+			arg.Status = StatusPending
+			arg.NextAttemptAt = f.now().UTC().Add(f.backoff << (attempts - 1))
+		}
+	}
+	// The outcome is recorded even when ctx has been cancelled so that
+	// shutdowns leave the delivery to be retried rather than leased.
+	if uerr := f.queries.SystemUpdateActivityPubDelivery(context.WithoutCancel(ctx), arg); uerr != nil {
+		log.Printf("activitypub delivery %d: update: %v", d.ID, uerr)
+	}
+}
+
+func (f *Federator) post(ctx context.Context, d *db.ActivitypubDelivery) error {
+	u, err := f.queries.SystemGetUserByID(ctx, d.UserID)
+	if err != nil {
+		return fmt.Errorf("get sender: %w", err)
+	}
+	key, err := ActorKey(ctx, f.queries, d.UserID)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.InboxUrl, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+	req.Header.Set("Content-Type", ContentType)
+	req.Header.Set("User-Agent", userAgent)
+	if err := Sign(req, KeyID(f.ActorURL(u.Username.String)), key.Private, body, f.now()); err != nil {
+		return err
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return fmt.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+	return nil
+}
@@ -0,0 +1,335 @@
+package activitypub
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/a4code/a4code2html"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/safehttp"
+)
+
+const (
+	// DefaultMaxAttempts is the number of times a delivery is tried before
+	// it is marked failed.
+	DefaultMaxAttempts = 8
+	// DefaultBackoff is the wait before the first retry. Each further retry
+	// doubles it.
+	DefaultBackoff = time.Minute
+	// DefaultTimeout bounds a single outgoing request.
+	DefaultTimeout = 10 * time.Second
+
+	// deliveryLease is how long a claimed delivery is hidden from other runs.
+	deliveryLease = 5 * time.Minute
+	// deliveryBatch limits how many deliveries one run sends.
+	deliveryBatch = 50
+	// maxDocumentSize bounds documents read from remote servers.
+	maxDocumentSize = 1 << 20
+	maxErrorBody    = 512
+)
+
+// Delivery statuses.
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Federator builds the documents describing local actors and their items and
+// exchanges activities with remote servers.
+type Federator struct {
+	queries     db.Querier
+	baseURL     string
+	client      *http.Client
+	render      func(string) string
+	maxAttempts int
+	backoff     time.Duration
+	now         func() time.Time
+}
+
+// Option configures a Federator.
+type Option func(*Federator)
+
+// WithHTTPClient sets the client used for remote requests. The default
+// refuses loopback, private and link-local addresses because actor and inbox
+// URLs come from remote servers.
+func WithHTTPClient(c *http.Client) Option {
+	return func(f *Federator) {
+		if c != nil {
+			f.client = c
+		}
+	}
+}
+
+// WithRenderer sets how item markup is turned into HTML content. By default
+// the markup is escaped as plain text.
+func WithRenderer(render func(string) string) Option {
+	return func(f *Federator) {
+		if render != nil {
+			f.render = render
+		}
+	}
+}
+
+// WithMaxAttempts overrides DefaultMaxAttempts.
+func WithMaxAttempts(n int) Option {
+	return func(f *Federator) {
+		if n > 0 {
+			f.maxAttempts = n
+		}
+	}
+}
+
+// WithBackoff overrides DefaultBackoff.
+func WithBackoff(b time.Duration) Option {
+	return func(f *Federator) { f.backoff = b }
+}
+
+// New returns a Federator publishing actors under baseURL.
+func New(q db.Querier, baseURL string, opts ...Option) *Federator {
+	f := &Federator{
+		queries:     q,
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		client:      safehttp.NewClient(DefaultTimeout),
+		render:      html.EscapeString,
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     DefaultBackoff,
+		now:         time.Now,
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(f)
+		}
+	}
+	return f
+}
+
+// ForCoreData returns a Federator for the site cd describes, rendering items
+// the way the site's pages do.
+func ForCoreData(cd *common.CoreData, opts ...Option) *Federator {
+	var baseURL string
+	if cd.Config != nil {
+		baseURL = cd.Config.BaseURL
+	}
+	render := func(s string) string {
+		conv := a4code2html.New(cd.ImageURLMapper, a4code2html.FullImageURLMapper(cd.MapFullImageURL))
+		conv.SetInput(s)
+		out, _ := io.ReadAll(conv.Process())
+		return string(out)
+	}
+	return New(cd.Queries(), baseURL, append([]Option{WithRenderer(render)}, opts...)...)
+}
+
+// URL returns the absolute form of a site-relative path.
+func (f *Federator) URL(path string) string { return f.baseURL + path }
+
+// ActorURL is the id of username's actor.
+func (f *Federator) ActorURL(username string) string { return f.URL(ActorPath(username)) }
+
+// Actor describes username for remote servers. It returns sql.ErrNoRows when
+// there is no such active user.
+func (f *Federator) Actor(ctx context.Context, username string) (*Actor, error) {
+	user, err := f.queries.SystemGetActivityPubActor(ctx, sql.NullString{String: username, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	key, err := ActorKey(ctx, f.queries, user.Idusers)
+	if err != nil {
+		return nil, err
+	}
+	id := f.ActorURL(user.Username.String)
+	return &Actor{
+		Context:           []string{Context, SecurityContext},
+		ID:                id,
+		Type:              "Person",
+		PreferredUsername: user.Username.String,
+		Name:              user.Username.String,
+		URL:               f.URL(ProfilePath(user.Username.String)),
+		Inbox:             f.URL(InboxPath(user.Username.String)),
+		Outbox:            f.URL(OutboxPath(user.Username.String)),
+		Followers:         f.URL(FollowersPath(user.Username.String)),
+		PublicKey: &PublicKey{
+			ID:           KeyID(id),
+			Owner:        id,
+			PublicKeyPem: key.PublicPEM,
+		},
+	}, nil
+}
+
+type author struct {
+	id       int32
+	username string
+}
+
+// Object describes a public item. It returns sql.ErrNoRows when the item does
+// not exist or is not visible to everyone.
+func (f *Federator) Object(ctx context.Context, itemType string, id int32) (*Object, error) {
+	obj, _, err := f.object(ctx, itemType, id)
+	return obj, err
+}
+
+func (f *Federator) object(ctx context.Context, itemType string, id int32) (*Object, author, error) {
+	obj := &Object{
+		ID:        f.URL(ObjectPath(itemType, id)),
+		URL:       f.URL(ItemPath(itemType, id)),
+		MediaType: "text/html",
+	}
+	var a author
+	switch itemType {
+	case ItemTypeBlog:
+		row, err := f.queries.SystemGetActivityPubBlogEntry(ctx, id)
+		if err != nil {
+			return nil, a, err
+		}
+		a = author{id: row.UsersIdusers, username: row.Username.String}
+		obj.Type = "Note"
+		obj.Content = f.render(row.Blog.String)
+		published := row.Written.UTC()
+		obj.Published = &published
+	case ItemTypeWriting:
+		row, err := f.queries.SystemGetActivityPubWriting(ctx, id)
+		if err != nil {
+			return nil, a, err
+		}
+		a = author{id: row.UsersIdusers, username: row.Username.String}
+		obj.Type = "Article"
+		obj.Name = row.Title.String
+		if row.Abstract.String != "" {
+			obj.Summary = f.render(row.Abstract.String)
+		}
+		obj.Content = f.render(row.Writing.String)
+		if row.Published.Valid {
+			published := row.Published.Time.UTC()
+			obj.Published = &published
+		}
+	default:
+		return nil, a, fmt.Errorf("unknown item type %q", itemType)
+	}
+	actorURL := f.ActorURL(a.username)
+	obj.AttributedTo = actorURL
+	obj.To = []string{Public}
+	obj.Cc = []string{f.URL(FollowersPath(a.username))}
+	return obj, a, nil
+}
+
+// Sync brings followers up to date with an item. A newly public item is sent
+// as a Create and a changed one as an Update. Once an item is deleted, made
+// private or hidden from guests followers are sent a Delete.
+func (f *Federator) Sync(ctx context.Context, itemType string, id int32) error {
+	objURL := f.URL(ObjectPath(itemType, id))
+	last, err := f.queries.SystemGetLatestActivityPubActivityForObject(ctx, objURL)
+	if errors.Is(err, sql.ErrNoRows) {
+		last, err = nil, nil
+	}
+	if err != nil {
+		return fmt.Errorf("latest activity: %w", err)
+	}
+	obj, a, err := f.object(ctx, itemType, id)
+	switch {
+	case err == nil:
+		typ := TypeCreate
+		if last != nil && last.ActivityType != TypeDelete {
+			typ = TypeUpdate
+			now := f.now().UTC()
+			obj.Updated = &now
+		}
+		return f.publish(ctx, a, typ, objURL, obj)
+	case errors.Is(err, sql.ErrNoRows):
+		if last == nil || last.ActivityType == TypeDelete {
+			return nil
+		}
+		u, err := f.queries.SystemGetUserByID(ctx, last.UserID)
+		if err != nil {
+			return fmt.Errorf("get author: %w", err)
+		}
+		a := author{id: u.Idusers, username: u.Username.String}
+		return f.publish(ctx, a, TypeDelete, objURL, &Object{ID: objURL, Type: "Tombstone"})
+	default:
+		return fmt.Errorf("load %s %d: %w", itemType, id, err)
+	}
+}
+
+// publish records an activity in the author's outbox and queues it for every
+// follower.
+func (f *Federator) publish(ctx context.Context, a author, typ, objURL string, obj *Object) error {
+	objJSON, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("encode object: %w", err)
+	}
+	now := f.now().UTC().Truncate(time.Second)
+	actID, err := f.queries.SystemInsertActivityPubActivity(ctx, db.SystemInsertActivityPubActivityParams{
+		UserID:       a.id,
+		ActivityType: typ,
+		ObjectUrl:    objURL,
+		ObjectJson:   string(objJSON),
+		Published:    now,
+	})
+	if err != nil {
+		return fmt.Errorf("record activity: %w", err)
+	}
+	act := f.activity(a.username, int32(actID), typ, json.RawMessage(objJSON), now)
+	act.Context = Context
+	payload, err := json.Marshal(act)
+	if err != nil {
+		return fmt.Errorf("encode activity: %w", err)
+	}
+	followers, err := f.queries.SystemListActivityPubFollowers(ctx, a.id)
+	if err != nil {
+		return fmt.Errorf("list followers: %w", err)
+	}
+	// Servers with a shared inbox take one copy for all their users.
+	seen := map[string]bool{}
+	for _, fl := range followers {
+		inbox := fl.InboxUrl
+		if fl.SharedInboxUrl.Valid && fl.SharedInboxUrl.String != "" {
+			inbox = fl.SharedInboxUrl.String
+		}
+		if seen[inbox] {
+			continue
+		}
+		seen[inbox] = true
+		if err := f.queue(ctx, a.id, inbox, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OutboxActivity rebuilds the activity stored as row for username's outbox.
+func (f *Federator) OutboxActivity(username string, row *db.ActivitypubActivity) *Activity {
+	return f.activity(username, row.ID, row.ActivityType, json.RawMessage(row.ObjectJson), row.Published.UTC())
+}
+
+func (f *Federator) activity(username string, id int32, typ string, obj json.RawMessage, published time.Time) *Activity {
+	actorURL := f.ActorURL(username)
+	return &Activity{
+		ID:        fmt.Sprintf("%s/activities/%d", actorURL, id),
+		Type:      typ,
+		Actor:     actorURL,
+		Object:    obj,
+		Published: published,
+		To:        []string{Public},
+		Cc:        []string{f.URL(FollowersPath(username))},
+	}
+}
+
+func (f *Federator) queue(ctx context.Context, userID int32, inbox string, payload []byte) error {
+	if err := f.queries.SystemInsertActivityPubDelivery(ctx, db.SystemInsertActivityPubDeliveryParams{
+		UserID:        userID,
+		InboxUrl:      inbox,
+		Payload:       string(payload),
+		NextAttemptAt: f.now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("queue delivery to %s: %w", inbox, err)
+	}
+	return nil
+}
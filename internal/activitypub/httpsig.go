@@ -0,0 +1,153 @@
+package activitypub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MaxClockSkew is how far the Date of a signed request may be from now.
+const MaxClockSkew = 12 * time.Hour
+
+// ErrInvalidSignature is returned when a request is unsigned or its
+// signature does not verify.
+var ErrInvalidSignature = errors.New("invalid http signature")
+
+// Digest returns the Digest header value for body.
+func Digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// Sign signs req with key in the draft-cavage HTTP signature scheme used
+// across the fediverse. Requests with a body also carry its Digest.
+func Sign(req *http.Request, keyID string, key *rsa.PrivateKey, body []byte, now time.Time) error {
+	req.Header.Set("Date", now.UTC().Format(http.TimeFormat))
+	headers := []string{"(request-target)", "host", "date"}
+	if body != nil {
+		req.Header.Set("Digest", Digest(body))
+		headers = append(headers, "digest")
+	}
+	sum := sha256.Sum256([]byte(signingString(req, headers)))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, sum[:])
+	if err != nil {
+		return fmt.Errorf("sign request: %w", err)
+	}
+	req.Header.Set("Signature", fmt.Sprintf(`keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(sig)))
+	return nil
+}
+
+// SignatureKeyID returns the keyId named by the Signature header of req.
+func SignatureKeyID(req *http.Request) (string, error) {
+	p, err := parseSignature(req.Header.Get("Signature"))
+	if err != nil {
+		return "", err
+	}
+	return p.keyID, nil
+}
+
+// Verify checks the Signature header of req against key. body is the request
+// body read by the caller; when the signature covers a Digest it must match.
+func Verify(req *http.Request, body []byte, key *rsa.PublicKey, now time.Time) error {
+	p, err := parseSignature(req.Header.Get("Signature"))
+	if err != nil {
+		return err
+	}
+	if p.algorithm != "" && p.algorithm != "rsa-sha256" && p.algorithm != "hs2019" {
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidSignature, p.algorithm)
+	}
+	covered := map[string]bool{}
+	for _, h := range p.headers {
+		covered[h] = true
+	}
+	if !covered["(request-target)"] || !covered["date"] {
+		return fmt.Errorf("%w: request target and date must be signed", ErrInvalidSignature)
+	}
+	date, err := http.ParseTime(req.Header.Get("Date"))
+	if err != nil {
+		return fmt.Errorf("%w: bad date", ErrInvalidSignature)
+	}
+	if d := now.Sub(date); d > MaxClockSkew || d < -MaxClockSkew {
+		return fmt.Errorf("%w: date out of range", ErrInvalidSignature)
+	}
+	if body != nil {
+		if !covered["digest"] {
+			return fmt.Errorf("%w: digest must be signed", ErrInvalidSignature)
+		}
+		if req.Header.Get("Digest") != Digest(body) {
+			return fmt.Errorf("%w: digest mismatch", ErrInvalidSignature)
+		}
+	}
+	sum := sha256.Sum256([]byte(signingString(req, p.headers)))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, sum[:], p.signature); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	return nil
+}
+
+func signingString(req *http.Request, headers []string) string {
+	lines := make([]string, 0, len(headers))
+	for _, h := range headers {
+		var v string
+		switch h {
+		case "(request-target)":
+			v = strings.ToLower(req.Method) + " " + req.URL.RequestURI()
+		case "host":
+			v = req.Host
+			if v == "" {
+				v = req.URL.Host
+			}
+		default:
+			v = strings.Join(req.Header.Values(h), ", ")
+		}
+		lines = append(lines, h+": "+v)
+	}
+	return strings.Join(lines, "\n")
+}
+
+type signatureParams struct {
+	keyID     string
+	algorithm string
+	headers   []string
+	signature []byte
+}
+
+func parseSignature(header string) (*signatureParams, error) {
+	if header == "" {
+		return nil, fmt.Errorf("%w: missing signature", ErrInvalidSignature)
+	}
+	p := &signatureParams{headers: []string{"date"}}
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		v = strings.Trim(v, `"`)
+		switch k {
+		case "keyId":
+			p.keyID = v
+		case "algorithm":
+			p.algorithm = v
+		case "headers":
+			p.headers = strings.Fields(strings.ToLower(v))
+		case "signature":
+			sig, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return nil, fmt.Errorf("%w: bad signature encoding", ErrInvalidSignature)
+			}
+			p.signature = sig
+		}
+	}
+	if p.keyID == "" || p.signature == nil {
+		return nil, fmt.Errorf("%w: keyId and signature are required", ErrInvalidSignature)
+	}
+	return p, nil
+}
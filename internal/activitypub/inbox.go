@@ -0,0 +1,215 @@
+package activitypub
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// ErrInvalidActivity is returned for inbox posts that are not activities the
+// site can act on.
+var ErrInvalidActivity = errors.New("invalid activity")
+
+// incoming is the part of a received activity the inbox looks at.
+type incoming struct {
+	ID     string          `json:"id"`
+	Type   string          `json:"type"`
+	Actor  string          `json:"actor"`
+	Object json.RawMessage `json:"object"`
+}
+
+// HandleInbox processes an activity posted to username's inbox. The request
+// must be signed by the activity's actor. Follow requests are accepted
+// straight away and an Undo of a Follow removes the follower; other
+// activities are ignored. It returns sql.ErrNoRows when username is not an
+// actor and reports whether a reply was queued for delivery.
+func (f *Federator) HandleInbox(ctx context.Context, r *http.Request, username string) (queued bool, err error) {
+	user, err := f.queries.SystemGetActivityPubActor(ctx, sql.NullString{String: username, Valid: true})
+	if err != nil {
+		return false, err
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxDocumentSize+1))
+	if err != nil {
+		return false, fmt.Errorf("read body: %w", err)
+	}
+	if len(body) > maxDocumentSize {
+		return false, fmt.Errorf("%w: body too large", ErrInvalidActivity)
+	}
+	var act incoming
+	if err := json.Unmarshal(body, &act); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrInvalidActivity, err)
+	}
+	if act.Actor == "" {
+		return false, fmt.Errorf("%w: missing actor", ErrInvalidActivity)
+	}
+	signer, err := f.verifyRequest(ctx, r, body, user.Idusers, user.Username.String)
+	if err != nil {
+		return false, err
+	}
+	if signer.ID != act.Actor {
+		return false, fmt.Errorf("%w: signed by %s for %s", ErrInvalidSignature, signer.ID, act.Actor)
+	}
+	actorURL := f.ActorURL(user.Username.String)
+	switch act.Type {
+	case TypeFollow:
+		if id, _ := objectRef(act.Object); id != actorURL {
+			return false, fmt.Errorf("%w: follow of %q", ErrInvalidActivity, id)
+		}
+		if signer.Inbox == "" {
+			return false, fmt.Errorf("%w: follower has no inbox", ErrInvalidActivity)
+		}
+		var shared sql.NullString
+		if signer.Endpoints != nil && signer.Endpoints.SharedInbox != "" {
+			shared = sql.NullString{String: signer.Endpoints.SharedInbox, Valid: true}
+		}
+		if err := f.queries.SystemUpsertActivityPubFollower(ctx, db.SystemUpsertActivityPubFollowerParams{
+			UserID:         user.Idusers,
+			ActorUrl:       signer.ID,
+			InboxUrl:       signer.Inbox,
+			SharedInboxUrl: shared,
+		}); err != nil {
+			return false, fmt.Errorf("store follower: %w", err)
+		}
+		sum := sha256.Sum256([]byte(act.ID))
+		accept := Activity{
+			Context: Context,
+			ID:      actorURL + "#accepts/" + hex.EncodeToString(sum[:8]),
+			Type:    TypeAccept,
+			Actor:   actorURL,
+			Object:  json.RawMessage(body),
+			To:      []string{signer.ID},
+		}
+		payload, err := json.Marshal(accept)
+		if err != nil {
+			return false, fmt.Errorf("encode accept: %w", err)
+		}
+		if err := f.queue(ctx, user.Idusers, signer.Inbox, payload); err != nil {
+			return false, err
+		}
+		return true, nil
+	case TypeUndo:
+		if _, typ := objectRef(act.Object); typ != "" && typ != TypeFollow {
+			return false, nil
+		}
+		if _, err := f.queries.SystemDeleteActivityPubFollower(ctx, db.SystemDeleteActivityPubFollowerParams{
+			UserID:   user.Idusers,
+			ActorUrl: signer.ID,
+		}); err != nil {
+			return false, fmt.Errorf("remove follower: %w", err)
+		}
+	}
+	return false, nil
+}
+
+// objectRef returns the id and, when embedded, the type of an activity's
+// object.
+func objectRef(raw json.RawMessage) (id, typ string) {
+	if err := json.Unmarshal(raw, &id); err == nil {
+		return id, ""
+	}
+	var obj struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return "", ""
+	}
+	return obj.ID, obj.Type
+}
+
+// verifyRequest checks the signature of r against the key of the remote
+// actor named in it and returns that actor.
+func (f *Federator) verifyRequest(ctx context.Context, r *http.Request, body []byte, userID int32, username string) (*Actor, error) {
+	keyID, err := SignatureKeyID(r)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := f.FetchActor(ctx, keyID, userID, username)
+	if err != nil {
+		return nil, fmt.Errorf("%w: fetch key: %v", ErrInvalidSignature, err)
+	}
+	if actor.PublicKey == nil || actor.PublicKey.ID != keyID || actor.PublicKey.Owner != actor.ID {
+		return nil, fmt.Errorf("%w: key %s does not belong to %s", ErrInvalidSignature, keyID, actor.ID)
+	}
+	key, err := ParsePublicKey(actor.PublicKey.PublicKeyPem)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+	if err := Verify(r, body, key, f.now()); err != nil {
+		return nil, err
+	}
+	return actor, nil
+}
+
+// FetchActor loads the remote actor owning keyID. The request is signed as
+// username so servers that require signed fetches answer it. The actor and its
+// inboxes must live on the host keyID names.
+func (f *Federator) FetchActor(ctx context.Context, keyID string, userID int32, username string) (*Actor, error) {
+	u, err := url.Parse(keyID)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, fmt.Errorf("bad actor url %q", keyID)
+	}
+	u.Fragment = ""
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", ContentType+", "+LDContentType)
+	req.Header.Set("User-Agent", userAgent)
+	if key, err := ActorKey(ctx, f.queries, userID); err == nil {
+		if err := Sign(req, KeyID(f.ActorURL(username)), key.Private, nil, f.now()); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(b) > maxDocumentSize {
+		return nil, errors.New("actor document too large")
+	}
+	var actor Actor
+	if err := json.Unmarshal(b, &actor); err != nil {
+		return nil, fmt.Errorf("decode actor: %w", err)
+	}
+	if !strings.EqualFold(hostOf(actor.ID), u.Host) {
+		return nil, fmt.Errorf("actor %q served from %s", actor.ID, u.Host)
+	}
+	// Deliveries go to these inboxes, so they must not point a signed post
+	// at some other server.
+	if actor.Inbox != "" && !strings.EqualFold(hostOf(actor.Inbox), u.Host) {
+		return nil, fmt.Errorf("actor %q has inbox on another host %q", actor.ID, actor.Inbox)
+	}
+	if actor.Endpoints != nil && actor.Endpoints.SharedInbox != "" && !strings.EqualFold(hostOf(actor.Endpoints.SharedInbox), u.Host) {
+		return nil, fmt.Errorf("actor %q has shared inbox on another host %q", actor.ID, actor.Endpoints.SharedInbox)
+	}
+	return &actor, nil
+}
+
+func hostOf(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
@@ -0,0 +1,117 @@
+package activitypub
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"database/sql"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// KeyBits is the size of generated actor keys.
+const KeyBits = 2048
+
+// Key is an actor's signing key pair.
+type Key struct {
+	Private   *rsa.PrivateKey
+	PublicPEM string
+}
+
+// ActorKey returns the key of userID, generating and storing one the first
+// time it is needed.
+func ActorKey(ctx context.Context, q db.Querier, userID int32) (*Key, error) {
+	row, err := q.SystemGetActivityPubActorKey(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		if err := createActorKey(ctx, q, userID); err != nil {
+			return nil, err
+		}
+		// Another request may have stored its key first; use whichever won.
+		row, err = q.SystemGetActivityPubActorKey(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get actor key: %w", err)
+	}
+	priv, err := ParsePrivateKey(row.PrivateKeyPem)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{Private: priv, PublicPEM: row.PublicKeyPem}, nil
+}
+
+func createActorKey(ctx context.Context, q db.Querier, userID int32) error {
+	priv, err := rsa.GenerateKey(rand.Reader, KeyBits)
+	if err != nil {
+		return fmt.Errorf("generate actor key: %w", err)
+	}
+	pub, err := EncodePublicKey(&priv.PublicKey)
+	if err != nil {
+		return err
+	}
+	privDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return fmt.Errorf("encode private key: %w", err)
+	}
+	if err := q.SystemInsertActivityPubActorKey(ctx, db.SystemInsertActivityPubActorKeyParams{
+		UserID:        userID,
+		PublicKeyPem:  pub,
+		PrivateKeyPem: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privDER})),
+	}); err != nil {
+		return fmt.Errorf("store actor key: %w", err)
+	}
+	return nil
+}
+
+// EncodePublicKey returns key in the PEM form published on actors.
+func EncodePublicKey(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", fmt.Errorf("encode public key: %w", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// ParsePublicKey reads an RSA public key published by a remote actor.
+func ParsePublicKey(s string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("public key: no PEM data")
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("public key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key: unsupported type %T", key)
+	}
+	return rsaKey, nil
+}
+
+// ParsePrivateKey reads a stored actor private key.
+func ParsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("private key: no PEM data")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("private key: %w", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key: unsupported type %T", key)
+	}
+	return rsaKey, nil
+}
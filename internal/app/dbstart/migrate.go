@@ -54,8 +54,11 @@ func transitionToGoose(ctx context.Context, db *sql.DB) error {
 func Apply(ctx context.Context, db *sql.DB, f fs.FS, verbose bool, driver string) error {
 	goose.SetBaseFS(migrations.FilterFS(f, driver))
 	dialect := driver
-	if dialect == "sqlite" {
+	switch dialect {
+	case "sqlite":
 		dialect = "sqlite3"
+	case "pgx":
+		dialect = "postgres"
 	}
 	if err := goose.SetDialect(dialect); err != nil {
 		return fmt.Errorf("set goose dialect: %w", err)
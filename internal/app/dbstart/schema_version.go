@@ -15,8 +15,11 @@ func SchemaVersion(ctx context.Context, db *sql.DB) (int, error) {
 // SchemaVersionWithDriver returns the current schema version from the database for the given driver.
 func SchemaVersionWithDriver(ctx context.Context, db *sql.DB, driver string) (int, error) {
 	dialect := "mysql"
-	if driver == "sqlite" || driver == "sqlite3" {
+	switch driver {
+	case "sqlite", "sqlite3":
 		dialect = "sqlite3"
+	case "postgres", "pgx":
+		dialect = "postgres"
 	}
 	if err := goose.SetDialect(dialect); err != nil {
 		return 0, err
@@ -30,6 +30,7 @@ import (
 	csrfmw "github.com/arran4/goa4web/internal/middleware/csrf"
 	nav "github.com/arran4/goa4web/internal/navigation"
 	routerpkg "github.com/arran4/goa4web/internal/router"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/stats"
 	"github.com/arran4/goa4web/internal/tasks"
 	"github.com/arran4/goa4web/internal/websocket"
@@ -49,20 +50,22 @@ func init() {
 type ServerOption func(*serverOptions)
 
 type serverOptions struct {
-	SessionSecret   string
-	ImageSignSecret string
-	LinkSignSecret  string
-	ShareSignSecret string
-	APISecret       string
-	DBReg           *dbdrivers.Registry
-	EmailReg        *email.Registry
-	DLQReg          *dlq.Registry
-	TasksReg        *tasks.Registry
-	Bus             *eventbus.Bus
-	Store           *sessions.CookieStore
-	DB              *sql.DB
-	Querier         db.Querier
-	RouterReg       *routerpkg.Registry
+	SessionSecret    string
+	ImageSignSecret  string
+	LinkSignSecret   string
+	ShareSignSecret  string
+	EmailReplySecret string
+	APISecret        string
+	DBReg            *dbdrivers.Registry
+	EmailReg         *email.Registry
+	DLQReg           *dlq.Registry
+	SearchReg        *search.Registry
+	TasksReg         *tasks.Registry
+	Bus              *eventbus.Bus
+	Store            *sessions.CookieStore
+	DB               *sql.DB
+	Querier          db.Querier
+	RouterReg        *routerpkg.Registry
 }
 
 // WithSessionSecret supplies the session cookie encryption secret.
@@ -85,6 +88,11 @@ func WithShareSignSecret(secret string) ServerOption {
 	return func(o *serverOptions) { o.ShareSignSecret = secret }
 }
 
+// WithEmailReplySecret supplies the key used to sign email reply addresses.
+func WithEmailReplySecret(secret string) ServerOption {
+	return func(o *serverOptions) { o.EmailReplySecret = secret }
+}
+
 // WithAPISecret sets the administrator API secret.
 func WithAPISecret(secret string) ServerOption {
 	return func(o *serverOptions) { o.APISecret = secret }
@@ -105,6 +113,11 @@ func WithDLQRegistry(r *dlq.Registry) ServerOption {
 	return func(o *serverOptions) { o.DLQReg = r }
 }
 
+// WithSearchRegistry sets the search backend registry.
+func WithSearchRegistry(r *search.Registry) ServerOption {
+	return func(o *serverOptions) { o.SearchReg = r }
+}
+
 // WithTasksRegistry sets the task registry.
 func WithTasksRegistry(r *tasks.Registry) ServerOption {
 	return func(o *serverOptions) { o.TasksReg = r }
@@ -213,12 +226,14 @@ func NewServer(ctx context.Context, cfg *config.RuntimeConfig, ah *adminhandlers
 		server.WithRouterRegistry(o.RouterReg),
 		server.WithNavRegistry(navReg),
 		server.WithDLQRegistry(o.DLQReg),
+		server.WithSearchRegistry(o.SearchReg),
 		server.WithTasksRegistry(o.TasksReg),
 		server.WithBus(o.Bus),
 		server.WithEmailRegistry(o.EmailReg),
 		server.WithImageSignKey(o.ImageSignSecret),
 		server.WithLinkSignKey(o.LinkSignSecret),
 		server.WithShareSignKey(o.ShareSignSecret),
+		server.WithEmailReplyKey(o.EmailReplySecret),
 		server.WithFeedSignKey(o.LinkSignSecret),
 		server.WithDBRegistry(o.DBReg),
 		server.WithWebsocket(wsMod),
@@ -254,6 +269,7 @@ func NewServer(ctx context.Context, cfg *config.RuntimeConfig, ah *adminhandlers
 		taskEventMW.Middleware,
 		middleware.SecurityHeadersMiddleware,
 	).Wrap(r)
+	srv.InternalHandler = handler
 	if cfg.CSRFEnabled {
 		handler = csrfmw.NewCSRFMiddleware(o.SessionSecret, cfg.BaseURL, goa4web.Version)(handler)
 	}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net"
 	"net/http"
@@ -30,6 +31,7 @@ import (
 	"github.com/arran4/goa4web/internal/middleware"
 	nav "github.com/arran4/goa4web/internal/navigation"
 	"github.com/arran4/goa4web/internal/router"
+	"github.com/arran4/goa4web/internal/search"
 	"github.com/arran4/goa4web/internal/stats"
 	"github.com/arran4/goa4web/internal/tasks"
 	"github.com/arran4/goa4web/internal/websocket"
@@ -44,6 +46,9 @@ type Server struct {
 	ConfigFile      string
 	Router          http.Handler
 	NotFoundHandler http.Handler
+	// InternalHandler serves in-process requests, such as email replies,
+	// through the middleware chain without CSRF checks.
+	InternalHandler http.Handler
 	Store           *sessions.CookieStore
 	DB              *sql.DB
 	Queries         db.Querier
@@ -55,11 +60,15 @@ type Server struct {
 	ImageSignKey string
 	LinkSignKey  string
 	ShareSignKey string
+	// EmailReplyKey signs Reply-To addresses of notification emails.
+	EmailReplyKey string
 
 	SessionManager common.SessionManager
 	TasksReg       *tasks.Registry
 	DBReg          *dbdrivers.Registry
 	DLQReg         *dlq.Registry
+	SearchReg      *search.Registry
+	SearchBackend  search.Backend
 	Websocket      *websocket.Module
 	LanguageCache  *common.LanguageCache
 	HTTPClient     *http.Client
@@ -74,6 +83,8 @@ type Server struct {
 	lastEmailConfig     *config.RuntimeConfig
 	emailMu             sync.Mutex
 
+	searchMu sync.Mutex
+
 	trustedProxiesParsed []netip.Prefix
 	lastTrustedProxies   string
 	configMu             sync.Mutex
@@ -122,6 +133,11 @@ func (s *Server) Close() {
 			log.Printf("eventbus shutdown: %v", err)
 		}
 	}
+	if c, ok := s.SearchBackend.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			log.Printf("search backend close: %v", err)
+		}
+	}
 	if s.DB != nil {
 		if err := s.DB.Close(); err != nil {
 			log.Printf("DB close error: %v", err)
@@ -159,6 +175,12 @@ func WithNavRegistry(n *nav.Registry) Option { return func(s *Server) { s.Nav =
 // WithDLQRegistry sets the dead letter queue registry.
 func WithDLQRegistry(r *dlq.Registry) Option { return func(s *Server) { s.DLQReg = r } }
 
+// WithSearchRegistry sets the search backend registry.
+func WithSearchRegistry(r *search.Registry) Option { return func(s *Server) { s.SearchReg = r } }
+
+// WithSearchBackend sets the search backend directly.
+func WithSearchBackend(b search.Backend) Option { return func(s *Server) { s.SearchBackend = b } }
+
 // WithBus sets the event bus used by the server.
 func WithBus(b *eventbus.Bus) Option { return func(s *Server) { s.Bus = b } }
 
@@ -185,6 +207,11 @@ func WithShareSignKey(key string) Option {
 	return func(s *Server) { s.ShareSignKey = key }
 }
 
+// WithEmailReplyKey sets the email reply address signing key.
+func WithEmailReplyKey(key string) Option {
+	return func(s *Server) { s.EmailReplyKey = key }
+}
+
 // WithFeedSignKey sets the feed signing key.
 func WithFeedSignKey(key string) Option {
 	return func(s *Server) { s.FeedSignKey = key }
@@ -216,6 +243,23 @@ func New(opts ...Option) *Server {
 	return s
 }
 
+// getSearchBackend returns the configured search backend, creating it on
+// first use so that every request and worker shares one instance.
+func (s *Server) getSearchBackend(q db.Querier) search.Backend {
+	s.searchMu.Lock()
+	defer s.searchMu.Unlock()
+	if s.SearchBackend != nil || s.SearchReg == nil {
+		return s.SearchBackend
+	}
+	b, err := s.SearchReg.BackendFromConfig(s.Config, q)
+	if err != nil {
+		log.Printf("Search backend init failed: %v", err)
+		return nil
+	}
+	s.SearchBackend = b
+	return b
+}
+
 func (s *Server) getEmailProvider() (common.MailProvider, error) {
 	s.emailMu.Lock()
 	defer s.emailMu.Unlock()
@@ -346,6 +390,7 @@ func (s *Server) GetCoreData(w http.ResponseWriter, r *http.Request) (*common.Co
 		common.WithNavRegistry(s.Nav),
 		common.WithTasksRegistry(s.TasksReg),
 		common.WithDLQRegistry(s.DLQReg),
+		common.WithSearchBackend(s.getSearchBackend(queries)),
 		common.WithDBRegistry(s.DBReg),
 		common.WithEmailRegistry(s.EmailReg),
 		common.WithRouterModules(modules),
@@ -414,11 +459,26 @@ func (s *Server) startWorkers(ctx context.Context) {
 		return
 	}
 	workerCtx, cancel := context.WithCancel(ctx)
-	dlqProvider := s.DLQReg.ProviderFromConfig(s.Config, q)
+	dlqProvider := dlq.NewCounted(s.DLQReg.ProviderFromConfig(s.Config, q))
 	var workerOpts []workers.Option
 	if s.HTTPClient != nil {
 		workerOpts = append(workerOpts, workers.WithHTTPClient(s.HTTPClient))
 	}
+	if b := s.getSearchBackend(q); b != nil {
+		workerOpts = append(workerOpts,
+			workers.WithSearchBackend(b),
+			workers.WithCoreOptions(common.WithSearchBackend(b)),
+		)
+	}
+	if s.RouterReg != nil {
+		workerOpts = append(workerOpts, workers.WithSitemap(s.RouterReg.Sitemap()))
+	}
+	if s.LinkSignKey != "" {
+		workerOpts = append(workerOpts, workers.WithLinkSignKey(s.LinkSignKey))
+	}
+	if s.EmailReplyKey != "" && s.InternalHandler != nil {
+		workerOpts = append(workerOpts, workers.WithEmailReply(s.EmailReplyKey, s.InternalHandler))
+	}
 	workers.Start(workerCtx, q, emailProvider, dlqProvider, s.Config, s.Bus, workerOpts...)
 	s.WorkerCancel = cancel
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	"github.com/arran4/goa4web/config"
@@ -16,6 +17,19 @@ type testProvider struct {
 func (t testProvider) Check(ctx context.Context) error                           { return t.checkErr }
 func (t testProvider) Write(ctx context.Context, name string, data []byte) error { return nil }
 func (t testProvider) Read(ctx context.Context, name string) ([]byte, error)     { return nil, nil }
+func (t testProvider) WriteStream(ctx context.Context, name string, r io.Reader) error {
+	return nil
+}
+func (t testProvider) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	return nil, intupload.ErrNotExist
+}
+func (t testProvider) Stat(ctx context.Context, name string) (intupload.ObjectInfo, error) {
+	return intupload.ObjectInfo{}, intupload.ErrNotExist
+}
+func (t testProvider) Delete(ctx context.Context, name string) error { return nil }
+func (t testProvider) List(ctx context.Context, prefix string, fn func(intupload.ObjectInfo) error) error {
+	return nil
+}
 
 func TestCheckUploadTargetOK(t *testing.T) {
 	intupload.RegisterProvider("testok", func(*config.RuntimeConfig) intupload.Provider { return testProvider{} })
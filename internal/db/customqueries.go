@@ -8,3 +8,14 @@ type CustomQueries interface {
 	MonthlyUsageCounts(ctx context.Context, startYear int32) ([]*MonthlyUsageRow, error)
 	UserMonthlyUsageCounts(ctx context.Context, startYear int32) ([]*UserMonthlyUsageRow, error)
 }
+
+// TableQueries reads and writes whole tables by name. The portable site
+// export uses it to move data between database engines.
+type TableQueries interface {
+	SystemListTables(ctx context.Context) ([]string, error)
+	SystemListTableColumns(ctx context.Context, table string) ([]*TableColumn, error)
+	SystemScanTable(ctx context.Context, table string, columns []string, fn func(values []any) error) error
+	SystemDeleteTableRows(ctx context.Context, table string) error
+	SystemInsertTableRow(ctx context.Context, table string, columns []string, values []any) error
+	SystemResetTableSequences(ctx context.Context, table string) error
+}
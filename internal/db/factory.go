@@ -5,6 +5,8 @@ func NewForDriver(dbtx DBTX, driver string) Querier {
 	switch driver {
 	case "sqlite", "sqlite3", "sqlite3-modernc":
 		return newSQLiteQuerier(dbtx)
+	case "postgres", "pgx":
+		return NewPostgresQuerier(dbtx)
 	default:
 		return New(dbtx)
 	}
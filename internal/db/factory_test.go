@@ -12,6 +12,11 @@ func TestNewForDriverStub(t *testing.T) {
 		t.Errorf("expected *Queries for mysql driver, got %T", querierMySQL)
 	}
 
+	querierPostgres := NewForDriver(nil, "postgres")
+	if _, ok := querierPostgres.(*postgresQuerier); !ok {
+		t.Errorf("expected *postgresQuerier for postgres driver, got %T", querierPostgres)
+	}
+
 	defer func() {
 		r := recover()
 		if r == nil {
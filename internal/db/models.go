@@ -9,6 +9,44 @@ import (
 	"time"
 )
 
+type ActivitypubActivity struct {
+	ID           int32
+	UserID       int32
+	ActivityType string
+	ObjectUrl    string
+	ObjectJson   string
+	Published    time.Time
+}
+
+type ActivitypubActorKey struct {
+	UserID        int32
+	PublicKeyPem  string
+	PrivateKeyPem string
+	CreatedAt     time.Time
+}
+
+type ActivitypubDelivery struct {
+	ID            int32
+	UserID        int32
+	InboxUrl      string
+	Payload       string
+	Status        string
+	Attempts      int32
+	NextAttemptAt time.Time
+	Error         sql.NullString
+	CreatedAt     time.Time
+	UpdatedAt     sql.NullTime
+}
+
+type ActivitypubFollower struct {
+	ID             int32
+	UserID         int32
+	ActorUrl       string
+	InboxUrl       string
+	SharedInboxUrl sql.NullString
+	CreatedAt      time.Time
+}
+
 type AdminRequestComment struct {
 	ID        int32
 	RequestID int32
@@ -140,6 +178,33 @@ type ContentReadMarker struct {
 	LastCommentID int32
 }
 
+type ContentReport struct {
+	ID             int32
+	ReporterID     int32
+	ItemType       string
+	ItemID         int32
+	AuthorID       int32
+	Reason         string
+	Status         string
+	ResolutionNote sql.NullString
+	ResolvedBy     sql.NullInt32
+	CreatedAt      time.Time
+	ResolvedAt     sql.NullTime
+}
+
+type ContentRevision struct {
+	ID             int32
+	ItemType       string
+	ItemID         int32
+	UsersIdusers   int32
+	Title          sql.NullString
+	Abstract       sql.NullString
+	Body           sql.NullString
+	RestoredFromID sql.NullInt32
+	CreatedAt      time.Time
+	Timezone       sql.NullString
+}
+
 type DeactivatedBlog struct {
 	Idblogs       int32
 	ForumthreadID int32
@@ -227,6 +292,33 @@ type DeadLetter struct {
 	CreatedAt time.Time
 }
 
+type Draft struct {
+	ID        int32
+	UserID    int32
+	Target    string
+	Url       string
+	Content   string
+	UpdatedAt time.Time
+}
+
+type EmailBounce struct {
+	ID          int32
+	UserEmailID int32
+	Kind        string
+	Source      string
+	Detail      sql.NullString
+	CreatedAt   time.Time
+}
+
+type EmailSuppression struct {
+	ID        int32
+	Email     string
+	UserID    sql.NullInt32
+	Reason    string
+	Detail    sql.NullString
+	CreatedAt time.Time
+}
+
 type ExternalLink struct {
 	ID              int32
 	Url             string
@@ -278,6 +370,34 @@ type FaqRevision struct {
 	Timezone     sql.NullString
 }
 
+type ForumPoll struct {
+	ID                int32
+	ForumthreadID     int32
+	Question          string
+	MultipleChoice    bool
+	Anonymous         bool
+	ResultsVisibility string
+	ClosesAt          sql.NullTime
+	ClosedAt          sql.NullTime
+	CreatedBy         int32
+	CreatedAt         time.Time
+}
+
+type ForumPollOption struct {
+	ID       int32
+	PollID   int32
+	Position int32
+	Label    string
+}
+
+type ForumPollVote struct {
+	ID           int32
+	PollID       int32
+	OptionID     int32
+	UsersIdusers int32
+	CreatedAt    time.Time
+}
+
 type Forumcategory struct {
 	Idforumcategory              int32
 	ForumcategoryIdforumcategory int32
@@ -498,6 +618,16 @@ type Preference struct {
 	MonthlyDigestHour       sql.NullInt32
 	LastMonthlyDigestSentAt sql.NullTime
 	ImageSafeDimension      sql.NullString
+	MuteMentions            bool
+}
+
+type Reaction struct {
+	ID           int32
+	ItemType     string
+	ItemID       int32
+	UsersIdusers int32
+	Reaction     string
+	CreatedAt    time.Time
 }
 
 type Role struct {
@@ -518,6 +648,15 @@ type RoleSubscriptionArchetype struct {
 	CreatedAt     time.Time
 }
 
+type ScheduledPublication struct {
+	ID        int32
+	ItemType  string
+	ItemID    int32
+	AuthorID  int32
+	PublishAt time.Time
+	CreatedAt time.Time
+}
+
 type SchedulerState struct {
 	TaskName  string
 	LastRunAt sql.NullTime
@@ -636,12 +775,52 @@ type UserPasskey struct {
 	ExpiresAt       sql.NullTime
 }
 
+type UserRecoveryCode struct {
+	ID           int32
+	UsersIdusers int32
+	CodeHash     string
+	UsedAt       sql.NullTime
+	CreatedAt    time.Time
+}
+
 type UserRole struct {
 	IduserRoles  int32
 	UsersIdusers int32
 	RoleID       int32
 }
 
+type UserTotp struct {
+	UsersIdusers int32
+	Secret       string
+	EnabledAt    sql.NullTime
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+type Webhook struct {
+	ID        int32
+	Name      string
+	Url       string
+	Secret    string
+	Patterns  string
+	Active    bool
+	CreatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID             int32
+	WebhookID      int32
+	Event          string
+	Path           string
+	Payload        string
+	Status         string
+	Attempts       int32
+	ResponseStatus sql.NullInt32
+	Error          sql.NullString
+	CreatedAt      time.Time
+	UpdatedAt      sql.NullTime
+}
+
 type Writing struct {
 	Idwriting         int32
 	UsersIdusers      int32
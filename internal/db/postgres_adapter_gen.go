@@ -0,0 +1,12775 @@
+// Code generated by cmd/gen-postgres-adapter. DO NOT EDIT.
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/arran4/goa4web/internal/dbpostgres"
+)
+
+// postgresInsertResult reports the key returned by an INSERT ... RETURNING
+// query through the sql.Result interface used by the MySQL queries. rows is
+// the number of rows the RETURNING clause produced, which for these single
+// row inserts matches the count in the command tag: 0 when ON CONFLICT DO
+// NOTHING skipped the row.
+type postgresInsertResult struct {
+	id   int64
+	rows int64
+}
+
+func (r postgresInsertResult) LastInsertId() (int64, error) { return r.id, nil }
+
+func (r postgresInsertResult) RowsAffected() (int64, error) { return r.rows, nil }
+
+// pgConv converts the loosely typed values used for untyped query parameters
+// and results. Conversions that fail yield the zero value and record the
+// first error so the caller can return it.
+type pgConv struct {
+	err error
+}
+
+func (c *pgConv) fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+// value unwraps driver.Valuer implementations such as sql.NullString so the
+// conversions below see the underlying value.
+func (c *pgConv) value(v any) any {
+	if vr, ok := v.(driver.Valuer); ok {
+		val, err := vr.Value()
+		if err != nil {
+			c.fail(fmt.Errorf("postgres adapter: %T: %w", v, err))
+			return nil
+		}
+		return val
+	}
+	return v
+}
+
+func (c *pgConv) toInt64(v any) int64 {
+	switch val := c.value(v).(type) {
+	case nil:
+		return 0
+	case int64:
+		return val
+	case int32:
+		return int64(val)
+	case int16:
+		return int64(val)
+	case int:
+		return int64(val)
+	case float64:
+		return int64(val)
+	case bool:
+		if val {
+			return 1
+		}
+		return 0
+	case []byte:
+		return c.toInt64(string(val))
+	case string:
+		if val == "" {
+			return 0
+		}
+		n, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			c.fail(fmt.Errorf("postgres adapter: cannot parse %q as int64: %w", val, err))
+		}
+		return n
+	default:
+		c.fail(fmt.Errorf("postgres adapter: unexpected type %T for int64", val))
+		return 0
+	}
+}
+
+func (c *pgConv) toString(v any) string {
+	switch val := c.value(v).(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func (c *pgConv) toNullString(v any) sql.NullString {
+	if v = c.value(v); v == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: c.toString(v), Valid: true}
+}
+
+func (c *pgConv) toNullInt32(v any) sql.NullInt32 {
+	if v = c.value(v); v == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(c.toInt64(v)), Valid: true}
+}
+
+func (c *pgConv) toNullInt64(v any) sql.NullInt64 {
+	if v = c.value(v); v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: c.toInt64(v), Valid: true}
+}
+
+func (c *pgConv) toNullTime(v any) sql.NullTime {
+	switch val := c.value(v).(type) {
+	case nil:
+		return sql.NullTime{}
+	case time.Time:
+		return sql.NullTime{Time: val, Valid: true}
+	default:
+		c.fail(fmt.Errorf("postgres adapter: unexpected type %T for time", val))
+		return sql.NullTime{}
+	}
+}
+
+type postgresQuerier struct {
+	q  *dbpostgres.Queries
+	db DBTX
+}
+
+// NewPostgresQuerier constructs a db.Querier backed by the PostgreSQL query implementation.
+func NewPostgresQuerier(db DBTX) Querier {
+	return &postgresQuerier{q: dbpostgres.New(db), db: db}
+}
+
+func (s *postgresQuerier) AddContentLabelStatus(ctx context.Context, arg AddContentLabelStatusParams) error {
+	return s.q.AddContentLabelStatus(ctx, dbpostgres.AddContentLabelStatusParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		Label:  arg.Label,
+	})
+}
+
+func (s *postgresQuerier) AddContentPrivateLabel(ctx context.Context, arg AddContentPrivateLabelParams) error {
+	return s.q.AddContentPrivateLabel(ctx, dbpostgres.AddContentPrivateLabelParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		UserID: arg.UserID,
+		Label:  arg.Label,
+		Invert: arg.Invert,
+	})
+}
+
+func (s *postgresQuerier) AddContentPublicLabel(ctx context.Context, arg AddContentPublicLabelParams) error {
+	return s.q.AddContentPublicLabel(ctx, dbpostgres.AddContentPublicLabelParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		Label:  arg.Label,
+	})
+}
+
+func (s *postgresQuerier) AdminAddUserEmail(ctx context.Context, arg AdminAddUserEmailParams) error {
+	return s.q.AdminAddUserEmail(ctx, dbpostgres.AdminAddUserEmailParams{
+		UserID:               arg.UserID,
+		Email:                arg.Email,
+		VerifiedAt:           arg.VerifiedAt,
+		NotificationPriority: arg.NotificationPriority,
+	})
+}
+
+func (s *postgresQuerier) AdminApproveImagePost(ctx context.Context, idimagepost int32) error {
+	return s.q.AdminApproveImagePost(ctx, idimagepost)
+}
+
+func (s *postgresQuerier) AdminArchiveBlog(ctx context.Context, arg AdminArchiveBlogParams) error {
+	return s.q.AdminArchiveBlog(ctx, dbpostgres.AdminArchiveBlogParams{
+		Idblogs:       arg.Idblogs,
+		ForumthreadID: arg.ForumthreadID,
+		UsersIdusers:  arg.UsersIdusers,
+		LanguageID:    arg.LanguageID,
+		Blog:          arg.Blog,
+		Written:       arg.Written,
+		Timezone:      arg.Timezone,
+	})
+}
+
+func (s *postgresQuerier) AdminArchiveComment(ctx context.Context, arg AdminArchiveCommentParams) error {
+	return s.q.AdminArchiveComment(ctx, dbpostgres.AdminArchiveCommentParams{
+		Idcomments:    arg.Idcomments,
+		ForumthreadID: arg.ForumthreadID,
+		UsersIdusers:  arg.UsersIdusers,
+		LanguageID:    arg.LanguageID,
+		Written:       arg.Written,
+		Text:          arg.Text,
+		Timezone:      arg.Timezone,
+	})
+}
+
+func (s *postgresQuerier) AdminArchiveImagepost(ctx context.Context, arg AdminArchiveImagepostParams) error {
+	return s.q.AdminArchiveImagepost(ctx, dbpostgres.AdminArchiveImagepostParams{
+		Idimagepost:            arg.Idimagepost,
+		ForumthreadID:          arg.ForumthreadID,
+		UsersIdusers:           arg.UsersIdusers,
+		ImageboardIdimageboard: arg.ImageboardIdimageboard,
+		Posted:                 arg.Posted,
+		Timezone:               arg.Timezone,
+		Description:            arg.Description,
+		Thumbnail:              arg.Thumbnail,
+		Fullimage:              arg.Fullimage,
+		FileSize:               arg.FileSize,
+		Approved: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Approved.Bool), Valid: arg.Approved.Valid},
+	})
+}
+
+func (s *postgresQuerier) AdminArchiveLink(ctx context.Context, arg AdminArchiveLinkParams) error {
+	return s.q.AdminArchiveLink(ctx, dbpostgres.AdminArchiveLinkParams{
+		ID:          arg.ID,
+		LanguageID:  arg.LanguageID,
+		AuthorID:    arg.AuthorID,
+		CategoryID:  arg.CategoryID,
+		ThreadID:    arg.ThreadID,
+		Title:       arg.Title,
+		Url:         arg.Url,
+		Description: arg.Description,
+		Listed:      arg.Listed,
+		Timezone:    arg.Timezone,
+	})
+}
+
+func (s *postgresQuerier) AdminArchiveUser(ctx context.Context, idusers int32) error {
+	return s.q.AdminArchiveUser(ctx, idusers)
+}
+
+func (s *postgresQuerier) AdminArchiveWriting(ctx context.Context, arg AdminArchiveWritingParams) error {
+	return s.q.AdminArchiveWriting(ctx, dbpostgres.AdminArchiveWritingParams{
+		Idwriting:         arg.Idwriting,
+		UsersIdusers:      arg.UsersIdusers,
+		ForumthreadID:     arg.ForumthreadID,
+		LanguageID:        arg.LanguageID,
+		WritingCategoryID: arg.WritingCategoryID,
+		Title:             arg.Title,
+		Published:         arg.Published,
+		Timezone:          arg.Timezone,
+		Writing:           arg.Writing,
+		Abstract:          arg.Abstract,
+		Private: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Private.Bool), Valid: arg.Private.Valid},
+	})
+}
+
+func (s *postgresQuerier) AdminAuditLogActionSummary(ctx context.Context, arg AdminAuditLogActionSummaryParams) ([]*AdminAuditLogActionSummaryRow, error) {
+	res, err := s.q.AdminAuditLogActionSummary(ctx, dbpostgres.AdminAuditLogActionSummaryParams{
+		Username:  arg.Username,
+		Action:    arg.Action,
+		Section:   arg.Section,
+		StartTime: arg.StartTime,
+		EndTime:   arg.EndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminAuditLogActionSummaryRow) []*AdminAuditLogActionSummaryRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminAuditLogActionSummaryRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminAuditLogActionSummaryRow{
+				Action: item.Action,
+				Total:  item.Total,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminCancelBannedIp(ctx context.Context, ipNet string) error {
+	return s.q.AdminCancelBannedIp(ctx, ipNet)
+}
+
+func (s *postgresQuerier) AdminClearExternalLinkCache(ctx context.Context, arg AdminClearExternalLinkCacheParams) error {
+	return s.q.AdminClearExternalLinkCache(ctx, dbpostgres.AdminClearExternalLinkCacheParams{
+		UpdatedBy: arg.UpdatedBy,
+		ID:        arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminCompleteWordList(ctx context.Context) ([]sql.NullString, error) {
+	res, err := s.q.AdminCompleteWordList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountAllImagePosts(ctx context.Context) (int64, error) {
+	res, err := s.q.AdminCountAllImagePosts(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountForumCategories(ctx context.Context, arg AdminCountForumCategoriesParams) (int64, error) {
+	res, err := s.q.AdminCountForumCategories(ctx, arg.ViewerID)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountForumThreads(ctx context.Context) (int64, error) {
+	res, err := s.q.AdminCountForumThreads(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountForumTopics(ctx context.Context) (int64, error) {
+	res, err := s.q.AdminCountForumTopics(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountLinksByCategory(ctx context.Context, categoryID sql.NullInt32) (int64, error) {
+	res, err := s.q.AdminCountLinksByCategory(ctx, categoryID)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountPasswordResets(ctx context.Context, arg AdminCountPasswordResetsParams) (int64, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminCountPasswordResetsParams{
+		Status:        conv.toNullString(arg.Status),
+		UserID:        arg.UserID,
+		CreatedBefore: arg.CreatedBefore,
+	}
+	if conv.err != nil {
+		return 0, conv.err
+	}
+	res, err := s.q.AdminCountPasswordResets(ctx, pgArg1)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountPendingPasswordResetsByUser(ctx context.Context) ([]*AdminCountPendingPasswordResetsByUserRow, error) {
+	res, err := s.q.AdminCountPendingPasswordResetsByUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminCountPendingPasswordResetsByUserRow) []*AdminCountPendingPasswordResetsByUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminCountPendingPasswordResetsByUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminCountPendingPasswordResetsByUserRow{
+				UserID: item.UserID,
+				Count:  item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminCountSentEmails(ctx context.Context, arg AdminCountSentEmailsParams) (int64, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminCountSentEmailsParams{
+		Provider:      conv.toNullString(arg.Provider),
+		CreatedBefore: arg.CreatedBefore,
+		LanguageID:    arg.LanguageID,
+		RoleName:      arg.RoleName,
+	}
+	if conv.err != nil {
+		return 0, conv.err
+	}
+	res, err := s.q.AdminCountSentEmails(ctx, pgArg1)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountThreadsByBoard(ctx context.Context, imageboardIdimageboard sql.NullInt32) (int64, error) {
+	res, err := s.q.AdminCountThreadsByBoard(ctx, imageboardIdimageboard)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountUnsentPendingEmails(ctx context.Context, arg AdminCountUnsentPendingEmailsParams) (int64, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminCountUnsentPendingEmailsParams{
+		Status:        conv.toNullString(arg.Status),
+		Provider:      conv.toNullString(arg.Provider),
+		CreatedBefore: arg.CreatedBefore,
+		LanguageID:    arg.LanguageID,
+		RoleName:      arg.RoleName,
+	}
+	if conv.err != nil {
+		return 0, conv.err
+	}
+	res, err := s.q.AdminCountUnsentPendingEmails(ctx, pgArg1)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountWordList(ctx context.Context) (int64, error) {
+	res, err := s.q.AdminCountWordList(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCountWordListByPrefix(ctx context.Context, prefix interface{}) (int64, error) {
+	var conv pgConv
+	pgArg1 := conv.toString(prefix)
+	if conv.err != nil {
+		return 0, conv.err
+	}
+	res, err := s.q.AdminCountWordListByPrefix(ctx, pgArg1)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminCreateFAQ(ctx context.Context, arg AdminCreateFAQParams) (sql.Result, error) {
+	res, err := s.q.AdminCreateFAQ(ctx, dbpostgres.AdminCreateFAQParams{
+		Question:    arg.Question,
+		Answer:      arg.Answer,
+		CategoryID:  arg.CategoryID,
+		AuthorID:    arg.AuthorID,
+		LanguageID:  arg.LanguageID,
+		Priority:    arg.Priority,
+		Description: arg.Description,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return postgresInsertResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return postgresInsertResult{id: int64(res), rows: 1}, nil
+}
+
+func (s *postgresQuerier) AdminCreateFAQCategory(ctx context.Context, arg AdminCreateFAQCategoryParams) (sql.Result, error) {
+	res, err := s.q.AdminCreateFAQCategory(ctx, dbpostgres.AdminCreateFAQCategoryParams{
+		Name:             arg.Name,
+		ParentCategoryID: arg.ParentCategoryID,
+		LanguageID:       arg.LanguageID,
+		Priority:         arg.Priority,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return postgresInsertResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return postgresInsertResult{id: int64(res), rows: 1}, nil
+}
+
+func (s *postgresQuerier) AdminCreateForumCategory(ctx context.Context, arg AdminCreateForumCategoryParams) (int64, error) {
+	res, err := s.q.AdminCreateForumCategory(ctx, dbpostgres.AdminCreateForumCategoryParams{
+		ParentID:           arg.ParentID,
+		CategoryLanguageID: arg.CategoryLanguageID,
+		Title:              arg.Title,
+		Description:        arg.Description,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) AdminCreateForumTopic(ctx context.Context, arg AdminCreateForumTopicParams) (int64, error) {
+	res, err := s.q.AdminCreateForumTopic(ctx, dbpostgres.AdminCreateForumTopicParams{
+		ForumcategoryID: arg.ForumcategoryID,
+		LanguageID:      arg.LanguageID,
+		Title:           arg.Title,
+		Description:     arg.Description,
+		Handler:         arg.Handler,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) AdminCreateGrant(ctx context.Context, arg AdminCreateGrantParams) (int64, error) {
+	res, err := s.q.AdminCreateGrant(ctx, dbpostgres.AdminCreateGrantParams{
+		UserID:   arg.UserID,
+		RoleID:   arg.RoleID,
+		Section:  arg.Section,
+		Item:     arg.Item,
+		RuleType: arg.RuleType,
+		ItemID:   arg.ItemID,
+		ItemRule: arg.ItemRule,
+		Action:   arg.Action,
+		Extra:    arg.Extra,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) AdminCreateImageBoard(ctx context.Context, arg AdminCreateImageBoardParams) error {
+	return s.q.AdminCreateImageBoard(ctx, dbpostgres.AdminCreateImageBoardParams{
+		ImageboardIdimageboard: arg.ImageboardIdimageboard,
+		Title:                  arg.Title,
+		Description:            arg.Description,
+		ApprovalRequired: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.ApprovalRequired),
+	})
+}
+
+func (s *postgresQuerier) AdminCreateLanguage(ctx context.Context, nameof sql.NullString) error {
+	return s.q.AdminCreateLanguage(ctx, nameof)
+}
+
+func (s *postgresQuerier) AdminCreateLinkerCategory(ctx context.Context, arg AdminCreateLinkerCategoryParams) error {
+	return s.q.AdminCreateLinkerCategory(ctx, dbpostgres.AdminCreateLinkerCategoryParams{
+		Title:    arg.Title,
+		Position: arg.Position,
+	})
+}
+
+func (s *postgresQuerier) AdminCreateLinkerItem(ctx context.Context, arg AdminCreateLinkerItemParams) error {
+	return s.q.AdminCreateLinkerItem(ctx, dbpostgres.AdminCreateLinkerItemParams{
+		AuthorID:    arg.AuthorID,
+		CategoryID:  arg.CategoryID,
+		Title:       arg.Title,
+		Url:         arg.Url,
+		Description: arg.Description,
+		Listed:      arg.Listed,
+		Timezone:    arg.Timezone,
+	})
+}
+
+func (s *postgresQuerier) AdminCreateWebhook(ctx context.Context, arg AdminCreateWebhookParams) (int64, error) {
+	res, err := s.q.AdminCreateWebhook(ctx, dbpostgres.AdminCreateWebhookParams{
+		Name:     arg.Name,
+		Url:      arg.Url,
+		Secret:   arg.Secret,
+		Patterns: arg.Patterns,
+		Active: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) AdminDeleteCommentsByThread(ctx context.Context, forumthreadID int32) error {
+	return s.q.AdminDeleteCommentsByThread(ctx, forumthreadID)
+}
+
+func (s *postgresQuerier) AdminDeleteEmailSuppression(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteEmailSuppression(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteExternalLink(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteExternalLink(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteExternalLinkByURL(ctx context.Context, url string) error {
+	return s.q.AdminDeleteExternalLinkByURL(ctx, url)
+}
+
+func (s *postgresQuerier) AdminDeleteFAQ(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteFAQ(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteFAQCategory(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteFAQCategory(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteForumCategory(ctx context.Context, idforumcategory int32) error {
+	return s.q.AdminDeleteForumCategory(ctx, idforumcategory)
+}
+
+func (s *postgresQuerier) AdminDeleteForumThread(ctx context.Context, idforumthread int32) error {
+	return s.q.AdminDeleteForumThread(ctx, idforumthread)
+}
+
+func (s *postgresQuerier) AdminDeleteForumTopic(ctx context.Context, idforumtopic int32) error {
+	return s.q.AdminDeleteForumTopic(ctx, idforumtopic)
+}
+
+func (s *postgresQuerier) AdminDeleteGrant(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteGrant(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteImageBoard(ctx context.Context, idimageboard int32) error {
+	return s.q.AdminDeleteImageBoard(ctx, idimageboard)
+}
+
+func (s *postgresQuerier) AdminDeleteImagePost(ctx context.Context, idimagepost int32) error {
+	return s.q.AdminDeleteImagePost(ctx, idimagepost)
+}
+
+func (s *postgresQuerier) AdminDeleteLanguage(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteLanguage(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteLinkerCategory(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteLinkerCategory(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteLinkerQueuedItem(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteLinkerQueuedItem(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteNotification(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteNotification(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteNotificationsByMessage(ctx context.Context, message sql.NullString) error {
+	return s.q.AdminDeleteNotificationsByMessage(ctx, message)
+}
+
+func (s *postgresQuerier) AdminDeletePendingEmail(ctx context.Context, id int32) error {
+	return s.q.AdminDeletePendingEmail(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteTemplateOverride(ctx context.Context, name string) error {
+	return s.q.AdminDeleteTemplateOverride(ctx, name)
+}
+
+func (s *postgresQuerier) AdminDeleteUserByID(ctx context.Context, idusers int32) error {
+	return s.q.AdminDeleteUserByID(ctx, idusers)
+}
+
+func (s *postgresQuerier) AdminDeleteUserEmail(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteUserEmail(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteUserRole(ctx context.Context, iduserRoles int32) error {
+	return s.q.AdminDeleteUserRole(ctx, iduserRoles)
+}
+
+func (s *postgresQuerier) AdminDeleteWebhook(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteWebhook(ctx, id)
+}
+
+func (s *postgresQuerier) AdminDeleteWebhookDeliveriesByWebhook(ctx context.Context, webhookID int32) error {
+	return s.q.AdminDeleteWebhookDeliveriesByWebhook(ctx, webhookID)
+}
+
+func (s *postgresQuerier) AdminDemoteAnnouncement(ctx context.Context, id int32) error {
+	return s.q.AdminDemoteAnnouncement(ctx, id)
+}
+
+func (s *postgresQuerier) AdminForumCategoryThreadCounts(ctx context.Context) ([]*AdminForumCategoryThreadCountsRow, error) {
+	res, err := s.q.AdminForumCategoryThreadCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminForumCategoryThreadCountsRow) []*AdminForumCategoryThreadCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminForumCategoryThreadCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminForumCategoryThreadCountsRow{
+				Idforumcategory: item.Idforumcategory,
+				Title:           item.Title,
+				Threads:         item.Threads,
+				Comments:        item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminForumHandlerThreadCounts(ctx context.Context) ([]*AdminForumHandlerThreadCountsRow, error) {
+	res, err := s.q.AdminForumHandlerThreadCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminForumHandlerThreadCountsRow) []*AdminForumHandlerThreadCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminForumHandlerThreadCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminForumHandlerThreadCountsRow{
+				Handler:  item.Handler,
+				Threads:  item.Threads,
+				Comments: item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminForumTopicThreadCounts(ctx context.Context) ([]*AdminForumTopicThreadCountsRow, error) {
+	res, err := s.q.AdminForumTopicThreadCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminForumTopicThreadCountsRow) []*AdminForumTopicThreadCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminForumTopicThreadCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminForumTopicThreadCountsRow{
+				Idforumtopic: item.Idforumtopic,
+				Title:        item.Title,
+				Handler:      item.Handler,
+				Threads:      item.Threads,
+				Comments:     item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetAllBlogEntriesByUser(ctx context.Context, authorID int32) ([]*AdminGetAllBlogEntriesByUserRow, error) {
+	res, err := s.q.AdminGetAllBlogEntriesByUser(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetAllBlogEntriesByUserRow) []*AdminGetAllBlogEntriesByUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetAllBlogEntriesByUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetAllBlogEntriesByUserRow{
+				Idblogs:            item.Idblogs,
+				ForumthreadID:      item.ForumthreadID,
+				UsersIdusers:       item.UsersIdusers,
+				LanguageID:         item.LanguageID,
+				Blog:               item.Blog,
+				Written:            item.Written,
+				Timezone:           item.Timezone,
+				Username:           item.Username,
+				Comments:           item.Comments,
+				Idforumcategory:    item.Idforumcategory,
+				ForumcategoryTitle: item.ForumcategoryTitle,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetAllCommentsByUser(ctx context.Context, userID int32) ([]*AdminGetAllCommentsByUserRow, error) {
+	res, err := s.q.AdminGetAllCommentsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetAllCommentsByUserRow) []*AdminGetAllCommentsByUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetAllCommentsByUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetAllCommentsByUserRow{
+				Idcomments:             item.Idcomments,
+				ForumthreadID:          item.ForumthreadID,
+				UsersIdusers:           item.UsersIdusers,
+				LanguageID:             item.LanguageID,
+				Written:                item.Written,
+				Text:                   item.Text,
+				DeletedAt:              item.DeletedAt,
+				LastIndex:              item.LastIndex,
+				Timezone:               item.Timezone,
+				ForumtopicIdforumtopic: item.ForumtopicIdforumtopic,
+				ForumtopicTitle:        item.ForumtopicTitle,
+				ThreadTitle:            item.ThreadTitle,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetAllWritingsByAuthor(ctx context.Context, authorID int32) ([]*AdminGetAllWritingsByAuthorRow, error) {
+	res, err := s.q.AdminGetAllWritingsByAuthor(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetAllWritingsByAuthorRow) []*AdminGetAllWritingsByAuthorRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetAllWritingsByAuthorRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetAllWritingsByAuthorRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetContentReportByID(ctx context.Context, id int32) (*ContentReport, error) {
+	res, err := s.q.AdminGetContentReportByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ContentReport) *ContentReport {
+		if v == nil {
+			return nil
+		}
+		return &ContentReport{
+			ID:             v.ID,
+			ReporterID:     v.ReporterID,
+			ItemType:       v.ItemType,
+			ItemID:         v.ItemID,
+			AuthorID:       v.AuthorID,
+			Reason:         v.Reason,
+			Status:         v.Status,
+			ResolutionNote: v.ResolutionNote,
+			ResolvedBy:     v.ResolvedBy,
+			CreatedAt:      v.CreatedAt,
+			ResolvedAt:     v.ResolvedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetDashboardStats(ctx context.Context) (*AdminGetDashboardStatsRow, error) {
+	res, err := s.q.AdminGetDashboardStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminGetDashboardStatsRow) *AdminGetDashboardStatsRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminGetDashboardStatsRow{
+			Users:        v.Users,
+			Languages:    v.Languages,
+			News:         v.News,
+			Blogs:        v.Blogs,
+			ForumTopics:  v.ForumTopics,
+			ForumThreads: v.ForumThreads,
+			Writings:     v.Writings,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetDeactivatedCommentById(ctx context.Context, idcomments int32) (*DeactivatedComment, error) {
+	res, err := s.q.AdminGetDeactivatedCommentById(ctx, idcomments)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.DeactivatedComment) *DeactivatedComment {
+		if v == nil {
+			return nil
+		}
+		return &DeactivatedComment{
+			Idcomments:    v.Idcomments,
+			ForumthreadID: v.ForumthreadID,
+			UsersIdusers:  v.UsersIdusers,
+			LanguageID:    v.LanguageID,
+			Written:       v.Written,
+			Text:          v.Text,
+			Timezone:      v.Timezone,
+			DeletedAt:     v.DeletedAt,
+			RestoredAt:    v.RestoredAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetEmailSuppressionByID(ctx context.Context, id int32) (*EmailSuppression, error) {
+	res, err := s.q.AdminGetEmailSuppressionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.EmailSuppression) *EmailSuppression {
+		if v == nil {
+			return nil
+		}
+		return &EmailSuppression{
+			ID:        v.ID,
+			Email:     v.Email,
+			UserID:    v.UserID,
+			Reason:    v.Reason,
+			Detail:    v.Detail,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetExternalLinkByCacheID(ctx context.Context, arg AdminGetExternalLinkByCacheIDParams) (*ExternalLink, error) {
+	res, err := s.q.AdminGetExternalLinkByCacheID(ctx, dbpostgres.AdminGetExternalLinkByCacheIDParams{
+		CardImageCache: arg.CardImageCache,
+		FaviconCache:   arg.FaviconCache,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ExternalLink) *ExternalLink {
+		if v == nil {
+			return nil
+		}
+		return &ExternalLink{
+			ID:              v.ID,
+			Url:             v.Url,
+			UrlHash:         v.UrlHash,
+			Clicks:          v.Clicks,
+			CreatedAt:       v.CreatedAt,
+			UpdatedAt:       v.UpdatedAt,
+			UpdatedBy:       v.UpdatedBy,
+			CardTitle:       v.CardTitle,
+			CardDescription: v.CardDescription,
+			CardImage:       v.CardImage,
+			CardImageCache:  v.CardImageCache,
+			FaviconCache:    v.FaviconCache,
+			CardDuration:    v.CardDuration,
+			CardUploadDate:  v.CardUploadDate,
+			CardAuthor:      v.CardAuthor,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQActiveQuestions(ctx context.Context) ([]*Faq, error) {
+	res, err := s.q.AdminGetFAQActiveQuestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Faq) []*Faq {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Faq, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Faq{
+				ID:          item.ID,
+				CategoryID:  item.CategoryID,
+				LanguageID:  item.LanguageID,
+				AuthorID:    item.AuthorID,
+				Answer:      item.Answer,
+				Question:    item.Question,
+				Description: item.Description,
+				Priority:    item.Priority,
+				DeletedAt:   item.DeletedAt,
+				UpdatedAt:   item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQByID(ctx context.Context, id int32) (*Faq, error) {
+	res, err := s.q.AdminGetFAQByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Faq) *Faq {
+		if v == nil {
+			return nil
+		}
+		return &Faq{
+			ID:          v.ID,
+			CategoryID:  v.CategoryID,
+			LanguageID:  v.LanguageID,
+			AuthorID:    v.AuthorID,
+			Answer:      v.Answer,
+			Question:    v.Question,
+			Description: v.Description,
+			Priority:    v.Priority,
+			DeletedAt:   v.DeletedAt,
+			UpdatedAt:   v.UpdatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQCategories(ctx context.Context) ([]*FaqCategory, error) {
+	res, err := s.q.AdminGetFAQCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.FaqCategory) []*FaqCategory {
+		if items == nil {
+			return nil
+		}
+		out := make([]*FaqCategory, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &FaqCategory{
+				ID:               item.ID,
+				ParentCategoryID: item.ParentCategoryID,
+				LanguageID:       item.LanguageID,
+				Name:             item.Name,
+				DeletedAt:        item.DeletedAt,
+				Priority:         item.Priority,
+				UpdatedAt:        item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQCategoriesWithQuestionCount(ctx context.Context) ([]*AdminGetFAQCategoriesWithQuestionCountRow, error) {
+	res, err := s.q.AdminGetFAQCategoriesWithQuestionCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetFAQCategoriesWithQuestionCountRow) []*AdminGetFAQCategoriesWithQuestionCountRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetFAQCategoriesWithQuestionCountRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetFAQCategoriesWithQuestionCountRow{
+				ID:               item.ID,
+				ParentCategoryID: item.ParentCategoryID,
+				LanguageID:       item.LanguageID,
+				Name:             item.Name,
+				Priority:         item.Priority,
+				UpdatedAt:        item.UpdatedAt,
+				Questioncount:    item.Questioncount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQCategory(ctx context.Context, id int32) (*FaqCategory, error) {
+	res, err := s.q.AdminGetFAQCategory(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.FaqCategory) *FaqCategory {
+		if v == nil {
+			return nil
+		}
+		return &FaqCategory{
+			ID:               v.ID,
+			ParentCategoryID: v.ParentCategoryID,
+			LanguageID:       v.LanguageID,
+			Name:             v.Name,
+			DeletedAt:        v.DeletedAt,
+			Priority:         v.Priority,
+			UpdatedAt:        v.UpdatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQCategoryWithQuestionCountByID(ctx context.Context, id int32) (*AdminGetFAQCategoryWithQuestionCountByIDRow, error) {
+	res, err := s.q.AdminGetFAQCategoryWithQuestionCountByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminGetFAQCategoryWithQuestionCountByIDRow) *AdminGetFAQCategoryWithQuestionCountByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminGetFAQCategoryWithQuestionCountByIDRow{
+			ID:               v.ID,
+			ParentCategoryID: v.ParentCategoryID,
+			LanguageID:       v.LanguageID,
+			Name:             v.Name,
+			Priority:         v.Priority,
+			UpdatedAt:        v.UpdatedAt,
+			Questioncount:    v.Questioncount,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQDismissedQuestions(ctx context.Context) ([]*AdminGetFAQDismissedQuestionsRow, error) {
+	res, err := s.q.AdminGetFAQDismissedQuestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetFAQDismissedQuestionsRow) []*AdminGetFAQDismissedQuestionsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetFAQDismissedQuestionsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetFAQDismissedQuestionsRow{
+				ID:          item.ID,
+				CategoryID:  item.CategoryID,
+				LanguageID:  item.LanguageID,
+				AuthorID:    item.AuthorID,
+				Answer:      item.Answer,
+				Question:    item.Question,
+				Description: item.Description,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQQuestionsByCategory(ctx context.Context, categoryID sql.NullInt32) ([]*Faq, error) {
+	res, err := s.q.AdminGetFAQQuestionsByCategory(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Faq) []*Faq {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Faq, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Faq{
+				ID:          item.ID,
+				CategoryID:  item.CategoryID,
+				LanguageID:  item.LanguageID,
+				AuthorID:    item.AuthorID,
+				Answer:      item.Answer,
+				Question:    item.Question,
+				Description: item.Description,
+				Priority:    item.Priority,
+				DeletedAt:   item.DeletedAt,
+				UpdatedAt:   item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetFAQUnansweredQuestions(ctx context.Context) ([]*Faq, error) {
+	res, err := s.q.AdminGetFAQUnansweredQuestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Faq) []*Faq {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Faq, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Faq{
+				ID:          item.ID,
+				CategoryID:  item.CategoryID,
+				LanguageID:  item.LanguageID,
+				AuthorID:    item.AuthorID,
+				Answer:      item.Answer,
+				Question:    item.Question,
+				Description: item.Description,
+				Priority:    item.Priority,
+				DeletedAt:   item.DeletedAt,
+				UpdatedAt:   item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetForumStats(ctx context.Context) (*AdminGetForumStatsRow, error) {
+	res, err := s.q.AdminGetForumStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminGetForumStatsRow) *AdminGetForumStatsRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminGetForumStatsRow{
+			Categories: v.Categories,
+			Topics:     v.Topics,
+			Threads:    v.Threads,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetForumThreadById(ctx context.Context, idforumthread int32) (*AdminGetForumThreadByIdRow, error) {
+	var conv pgConv
+	res, err := s.q.AdminGetForumThreadById(ctx, idforumthread)
+	if err != nil {
+		return nil, err
+	}
+	out := func(v *dbpostgres.AdminGetForumThreadByIdRow) *AdminGetForumThreadByIdRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminGetForumThreadByIdRow{
+			Idforumthread: v.Idforumthread,
+			Idforumtopic:  v.Idforumtopic,
+			Title:         conv.toString(v.Title),
+			CreatedAt:     v.CreatedAt,
+			CreatedBy:     v.CreatedBy,
+			LastPostBy:    v.LastPostBy,
+			LastPostAt:    v.LastPostAt,
+			PostCount:     v.PostCount,
+			TopicTitle:    v.TopicTitle,
+			TopicHandler:  v.TopicHandler,
+		}
+	}(res)
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	return out, nil
+}
+
+func (s *postgresQuerier) AdminGetImagePost(ctx context.Context, idimagepost int32) (*AdminGetImagePostRow, error) {
+	res, err := s.q.AdminGetImagePost(ctx, idimagepost)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminGetImagePostRow) *AdminGetImagePostRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminGetImagePostRow{
+			Idimagepost:            v.Idimagepost,
+			ForumthreadID:          v.ForumthreadID,
+			UsersIdusers:           v.UsersIdusers,
+			ImageboardIdimageboard: v.ImageboardIdimageboard,
+			Posted:                 v.Posted,
+			Timezone:               v.Timezone,
+			Description:            v.Description,
+			Thumbnail:              v.Thumbnail,
+			Fullimage:              v.Fullimage,
+			FileSize:               v.FileSize,
+			Approved:               (v.Approved != 0),
+			DeletedAt:              v.DeletedAt,
+			LastIndex:              v.LastIndex,
+			Username:               v.Username,
+			Comments:               v.Comments,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetNotification(ctx context.Context, id int32) (*Notification, error) {
+	res, err := s.q.AdminGetNotification(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Notification) *Notification {
+		if v == nil {
+			return nil
+		}
+		return &Notification{
+			ID:           v.ID,
+			UsersIdusers: v.UsersIdusers,
+			Link:         v.Link,
+			Message:      v.Message,
+			CreatedAt:    v.CreatedAt,
+			ReadAt:       v.ReadAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetPasswordResetByID(ctx context.Context, id int32) (*PendingPassword, error) {
+	res, err := s.q.AdminGetPasswordResetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.PendingPassword) *PendingPassword {
+		if v == nil {
+			return nil
+		}
+		return &PendingPassword{
+			ID:               v.ID,
+			UserID:           v.UserID,
+			Passwd:           v.Passwd,
+			PasswdAlgorithm:  v.PasswdAlgorithm,
+			VerificationCode: v.VerificationCode,
+			CreatedAt:        v.CreatedAt,
+			VerifiedAt:       v.VerifiedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetPendingEmailByID(ctx context.Context, id int32) (*AdminGetPendingEmailByIDRow, error) {
+	res, err := s.q.AdminGetPendingEmailByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminGetPendingEmailByIDRow) *AdminGetPendingEmailByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminGetPendingEmailByIDRow{
+			ID:          v.ID,
+			ToUserID:    v.ToUserID,
+			Body:        v.Body,
+			ErrorCount:  v.ErrorCount,
+			DirectEmail: (v.DirectEmail != 0),
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetRecentAuditLogs(ctx context.Context, limit int32) ([]*AdminGetRecentAuditLogsRow, error) {
+	res, err := s.q.AdminGetRecentAuditLogs(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetRecentAuditLogsRow) []*AdminGetRecentAuditLogsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetRecentAuditLogsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetRecentAuditLogsRow{
+				ID:           item.ID,
+				UsersIdusers: item.UsersIdusers,
+				Username:     item.Username,
+				Action:       item.Action,
+				Path:         item.Path,
+				Details:      item.Details,
+				Data:         item.Data,
+				CreatedAt:    item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetRequest(ctx context.Context, id int32) (*AdminRequestQueue, error) {
+	res, err := s.q.AdminGetRequest(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminRequestQueue) *AdminRequestQueue {
+		if v == nil {
+			return nil
+		}
+		return &AdminRequestQueue{
+			ID:             v.ID,
+			UsersIdusers:   v.UsersIdusers,
+			ChangeTable:    v.ChangeTable,
+			ChangeField:    v.ChangeField,
+			ChangeRowID:    v.ChangeRowID,
+			ChangeValue:    v.ChangeValue,
+			ContactOptions: v.ContactOptions,
+			Status:         v.Status,
+			CreatedAt:      v.CreatedAt,
+			ActedAt:        v.ActedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetRequestByID(ctx context.Context, id int32) (*AdminRequestQueue, error) {
+	res, err := s.q.AdminGetRequestByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminRequestQueue) *AdminRequestQueue {
+		if v == nil {
+			return nil
+		}
+		return &AdminRequestQueue{
+			ID:             v.ID,
+			UsersIdusers:   v.UsersIdusers,
+			ChangeTable:    v.ChangeTable,
+			ChangeField:    v.ChangeField,
+			ChangeRowID:    v.ChangeRowID,
+			ChangeValue:    v.ChangeValue,
+			ContactOptions: v.ContactOptions,
+			Status:         v.Status,
+			CreatedAt:      v.CreatedAt,
+			ActedAt:        v.ActedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetRoleByID(ctx context.Context, id int32) (*Role, error) {
+	res, err := s.q.AdminGetRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Role) *Role {
+		if v == nil {
+			return nil
+		}
+		return &Role{
+			ID:                     v.ID,
+			Name:                   v.Name,
+			CanLogin:               (v.CanLogin != 0),
+			IsAdmin:                (v.IsAdmin != 0),
+			PrivateLabels:          (v.PrivateLabels != 0),
+			PublicProfileAllowedAt: v.PublicProfileAllowedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetRoleByNameForUser(ctx context.Context, arg AdminGetRoleByNameForUserParams) (int32, error) {
+	res, err := s.q.AdminGetRoleByNameForUser(ctx, dbpostgres.AdminGetRoleByNameForUserParams{
+		UsersIdusers: arg.UsersIdusers,
+		Name:         arg.Name,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminGetSearchStats(ctx context.Context) (*AdminGetSearchStatsRow, error) {
+	res, err := s.q.AdminGetSearchStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminGetSearchStatsRow) *AdminGetSearchStatsRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminGetSearchStatsRow{
+			Words:    v.Words,
+			Comments: v.Comments,
+			News:     v.News,
+			Blogs:    v.Blogs,
+			Linker:   v.Linker,
+			Writings: v.Writings,
+			Images:   v.Images,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetSubsequentCommentID(ctx context.Context, arg AdminGetSubsequentCommentIDParams) (int32, error) {
+	res, err := s.q.AdminGetSubsequentCommentID(ctx, dbpostgres.AdminGetSubsequentCommentIDParams{
+		ForumthreadID: arg.ForumthreadID,
+		Idcomments:    arg.Idcomments,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminGetThreadsStartedByUser(ctx context.Context, usersIdusers int32) ([]*Forumthread, error) {
+	res, err := s.q.AdminGetThreadsStartedByUser(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Forumthread) []*Forumthread {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Forumthread, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Forumthread{
+				Idforumthread:          item.Idforumthread,
+				Firstpost:              item.Firstpost,
+				Lastposter:             item.Lastposter,
+				ForumtopicIdforumtopic: item.ForumtopicIdforumtopic,
+				Comments:               item.Comments,
+				Lastaddition:           item.Lastaddition,
+				Locked:                 sql.NullBool{Bool: item.Locked.Int32 != 0, Valid: item.Locked.Valid},
+				ReplyToCommentID:       item.ReplyToCommentID,
+				ReplyToThreadID:        item.ReplyToThreadID,
+				DeletedAt:              item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetThreadsStartedByUserWithTopic(ctx context.Context, usersIdusers int32) ([]*AdminGetThreadsStartedByUserWithTopicRow, error) {
+	res, err := s.q.AdminGetThreadsStartedByUserWithTopic(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetThreadsStartedByUserWithTopicRow) []*AdminGetThreadsStartedByUserWithTopicRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetThreadsStartedByUserWithTopicRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetThreadsStartedByUserWithTopicRow{
+				Idforumthread:          item.Idforumthread,
+				Firstpost:              item.Firstpost,
+				Lastposter:             item.Lastposter,
+				ForumtopicIdforumtopic: item.ForumtopicIdforumtopic,
+				Comments:               item.Comments,
+				Lastaddition:           item.Lastaddition,
+				Locked:                 sql.NullBool{Bool: item.Locked.Int32 != 0, Valid: item.Locked.Valid},
+				ReplyToCommentID:       item.ReplyToCommentID,
+				ReplyToThreadID:        item.ReplyToThreadID,
+				DeletedAt:              item.DeletedAt,
+				TopicTitle:             item.TopicTitle,
+				CategoryID:             item.CategoryID,
+				CategoryTitle:          item.CategoryTitle,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetTopicGrants(ctx context.Context, topicID sql.NullInt32) ([]*AdminGetTopicGrantsRow, error) {
+	res, err := s.q.AdminGetTopicGrants(ctx, topicID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetTopicGrantsRow) []*AdminGetTopicGrantsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetTopicGrantsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetTopicGrantsRow{
+				Section:  item.Section,
+				RoleID:   item.RoleID,
+				RoleName: item.RoleName,
+				UserID:   item.UserID,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetUserEmailByID(ctx context.Context, id int32) (*UserEmail, error) {
+	res, err := s.q.AdminGetUserEmailByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserEmail) *UserEmail {
+		if v == nil {
+			return nil
+		}
+		return &UserEmail{
+			ID:                    v.ID,
+			UserID:                v.UserID,
+			Email:                 v.Email,
+			VerifiedAt:            v.VerifiedAt,
+			LastVerificationCode:  v.LastVerificationCode,
+			VerificationExpiresAt: v.VerificationExpiresAt,
+			NotificationPriority:  v.NotificationPriority,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetWebhook(ctx context.Context, id int32) (*Webhook, error) {
+	res, err := s.q.AdminGetWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Webhook) *Webhook {
+		if v == nil {
+			return nil
+		}
+		return &Webhook{
+			ID:        v.ID,
+			Name:      v.Name,
+			Url:       v.Url,
+			Secret:    v.Secret,
+			Patterns:  v.Patterns,
+			Active:    (v.Active != 0),
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminGetWritingsByCategoryId(ctx context.Context, writingCategoryID int32) ([]*AdminGetWritingsByCategoryIdRow, error) {
+	res, err := s.q.AdminGetWritingsByCategoryId(ctx, writingCategoryID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminGetWritingsByCategoryIdRow) []*AdminGetWritingsByCategoryIdRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminGetWritingsByCategoryIdRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminGetWritingsByCategoryIdRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminHardDeleteComment(ctx context.Context, idcomments int32) error {
+	return s.q.AdminHardDeleteComment(ctx, idcomments)
+}
+
+func (s *postgresQuerier) AdminImageboardPostCounts(ctx context.Context) ([]*AdminImageboardPostCountsRow, error) {
+	res, err := s.q.AdminImageboardPostCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminImageboardPostCountsRow) []*AdminImageboardPostCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminImageboardPostCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminImageboardPostCountsRow{
+				Idimageboard: item.Idimageboard,
+				Title:        item.Title,
+				Count:        item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminInsertBannedIp(ctx context.Context, arg AdminInsertBannedIpParams) error {
+	return s.q.AdminInsertBannedIp(ctx, dbpostgres.AdminInsertBannedIpParams{
+		IpNet:     arg.IpNet,
+		Reason:    arg.Reason,
+		ExpiresAt: arg.ExpiresAt,
+	})
+}
+
+func (s *postgresQuerier) AdminInsertLanguage(ctx context.Context, nameof sql.NullString) (sql.Result, error) {
+	res, err := s.q.AdminInsertLanguage(ctx, nameof)
+	if errors.Is(err, sql.ErrNoRows) {
+		return postgresInsertResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return postgresInsertResult{id: int64(res), rows: 1}, nil
+}
+
+func (s *postgresQuerier) AdminInsertQueuedLinkFromQueue(ctx context.Context, id int32) (int64, error) {
+	res, err := s.q.AdminInsertQueuedLinkFromQueue(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) AdminInsertRequestComment(ctx context.Context, arg AdminInsertRequestCommentParams) error {
+	return s.q.AdminInsertRequestComment(ctx, dbpostgres.AdminInsertRequestCommentParams{
+		RequestID: arg.RequestID,
+		Comment:   arg.Comment,
+	})
+}
+
+func (s *postgresQuerier) AdminInsertRequestQueue(ctx context.Context, arg AdminInsertRequestQueueParams) (sql.Result, error) {
+	res, err := s.q.AdminInsertRequestQueue(ctx, dbpostgres.AdminInsertRequestQueueParams{
+		UsersIdusers:   arg.UsersIdusers,
+		ChangeTable:    arg.ChangeTable,
+		ChangeField:    arg.ChangeField,
+		ChangeRowID:    arg.ChangeRowID,
+		ChangeValue:    arg.ChangeValue,
+		ContactOptions: arg.ContactOptions,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return postgresInsertResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return postgresInsertResult{id: int64(res), rows: 1}, nil
+}
+
+func (s *postgresQuerier) AdminInsertWritingCategory(ctx context.Context, arg AdminInsertWritingCategoryParams) error {
+	return s.q.AdminInsertWritingCategory(ctx, dbpostgres.AdminInsertWritingCategoryParams{
+		WritingCategoryID: arg.WritingCategoryID,
+		Title:             arg.Title,
+		Description:       arg.Description,
+	})
+}
+
+func (s *postgresQuerier) AdminIsBlogDeactivated(ctx context.Context, idblogs int32) (bool, error) {
+	res, err := s.q.AdminIsBlogDeactivated(ctx, idblogs)
+	if err != nil {
+		return false, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminIsCommentDeactivated(ctx context.Context, idcomments int32) (bool, error) {
+	res, err := s.q.AdminIsCommentDeactivated(ctx, idcomments)
+	if err != nil {
+		return false, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminIsImagepostDeactivated(ctx context.Context, idimagepost int32) (bool, error) {
+	res, err := s.q.AdminIsImagepostDeactivated(ctx, idimagepost)
+	if err != nil {
+		return false, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminIsLinkDeactivated(ctx context.Context, id int32) (bool, error) {
+	res, err := s.q.AdminIsLinkDeactivated(ctx, id)
+	if err != nil {
+		return false, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminIsUserDeactivated(ctx context.Context, idusers int32) (bool, error) {
+	res, err := s.q.AdminIsUserDeactivated(ctx, idusers)
+	if err != nil {
+		return false, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminIsWritingDeactivated(ctx context.Context, idwriting int32) (bool, error) {
+	res, err := s.q.AdminIsWritingDeactivated(ctx, idwriting)
+	if err != nil {
+		return false, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminLanguageUsageCounts(ctx context.Context, arg AdminLanguageUsageCountsParams) (*AdminLanguageUsageCountsRow, error) {
+	res, err := s.q.AdminLanguageUsageCounts(ctx, arg.LangID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminLanguageUsageCountsRow) *AdminLanguageUsageCountsRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminLanguageUsageCountsRow{
+			Comments: v.Comments,
+			Writings: v.Writings,
+			Blogs:    v.Blogs,
+			News:     v.News,
+			Links:    v.Links,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAdministratorEmails(ctx context.Context) ([]string, error) {
+	res, err := s.q.AdminListAdministratorEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListAllCommentsWithThreadInfo(ctx context.Context, arg AdminListAllCommentsWithThreadInfoParams) ([]*AdminListAllCommentsWithThreadInfoRow, error) {
+	res, err := s.q.AdminListAllCommentsWithThreadInfo(ctx, dbpostgres.AdminListAllCommentsWithThreadInfoParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAllCommentsWithThreadInfoRow) []*AdminListAllCommentsWithThreadInfoRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAllCommentsWithThreadInfoRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAllCommentsWithThreadInfoRow{
+				Idcomments:      item.Idcomments,
+				Written:         item.Written,
+				Text:            item.Text,
+				DeletedAt:       item.DeletedAt,
+				Timezone:        item.Timezone,
+				Idforumthread:   item.Idforumthread,
+				Idforumtopic:    item.Idforumtopic,
+				ForumtopicTitle: item.ForumtopicTitle,
+				TopicHandler:    item.TopicHandler,
+				Idusers:         item.Idusers,
+				Posterusername:  item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAllImagePosts(ctx context.Context, arg AdminListAllImagePostsParams) ([]*AdminListAllImagePostsRow, error) {
+	res, err := s.q.AdminListAllImagePosts(ctx, dbpostgres.AdminListAllImagePostsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAllImagePostsRow) []*AdminListAllImagePostsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAllImagePostsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAllImagePostsRow{
+				Idimagepost: item.Idimagepost,
+				Thumbnail:   item.Thumbnail,
+				Fullimage:   item.Fullimage,
+				Description: item.Description,
+				Posted:      item.Posted,
+				FileSize:    item.FileSize,
+				Username:    item.Username,
+				Title:       item.Title,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAllPrivateForumGrants(ctx context.Context) ([]*AdminListAllPrivateForumGrantsRow, error) {
+	res, err := s.q.AdminListAllPrivateForumGrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAllPrivateForumGrantsRow) []*AdminListAllPrivateForumGrantsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAllPrivateForumGrantsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAllPrivateForumGrantsRow{
+				ID:       item.ID,
+				Section:  item.Section,
+				Item:     item.Item,
+				Action:   item.Action,
+				ItemID:   item.ItemID,
+				RoleName: item.RoleName,
+				UserID:   item.UserID,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAllPrivateForumThreads(ctx context.Context) ([]*AdminListAllPrivateForumThreadsRow, error) {
+	res, err := s.q.AdminListAllPrivateForumThreads(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAllPrivateForumThreadsRow) []*AdminListAllPrivateForumThreadsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAllPrivateForumThreadsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAllPrivateForumThreadsRow{
+				Idforumthread:   item.Idforumthread,
+				Idforumtopic:    item.Idforumtopic,
+				Title:           item.Title,
+				CreatedAt:       item.CreatedAt,
+				CreatedBy:       item.CreatedBy,
+				LastPostBy:      item.LastPostBy,
+				LastPostAt:      item.LastPostAt,
+				PostCount:       item.PostCount,
+				TopicTitle:      item.TopicTitle,
+				TotalComments:   item.TotalComments,
+				ValidComments:   item.ValidComments,
+				InvalidComments: item.InvalidComments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAllPrivateTopics(ctx context.Context) ([]*AdminListAllPrivateTopicsRow, error) {
+	res, err := s.q.AdminListAllPrivateTopics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAllPrivateTopicsRow) []*AdminListAllPrivateTopicsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAllPrivateTopicsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAllPrivateTopicsRow{
+				Idforumtopic: item.Idforumtopic,
+				Title:        item.Title,
+				LastPostBy:   item.LastPostBy,
+				LastPostAt:   item.LastPostAt,
+				PostCount:    item.PostCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAllUploadedImages(ctx context.Context) ([]*AdminListAllUploadedImagesRow, error) {
+	res, err := s.q.AdminListAllUploadedImages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAllUploadedImagesRow) []*AdminListAllUploadedImagesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAllUploadedImagesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAllUploadedImagesRow{
+				Iduploadedimage: item.Iduploadedimage,
+				Path:            item.Path,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAllUserIDs(ctx context.Context) ([]int32, error) {
+	res, err := s.q.AdminListAllUserIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListAllUsers(ctx context.Context) ([]*AdminListAllUsersRow, error) {
+	res, err := s.q.AdminListAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAllUsersRow) []*AdminListAllUsersRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAllUsersRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAllUsersRow{
+				Idusers:  item.Idusers,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAnnouncementsWithNews(ctx context.Context) ([]*AdminListAnnouncementsWithNewsRow, error) {
+	res, err := s.q.AdminListAnnouncementsWithNews(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAnnouncementsWithNewsRow) []*AdminListAnnouncementsWithNewsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAnnouncementsWithNewsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAnnouncementsWithNewsRow{
+				ID:         item.ID,
+				SiteNewsID: item.SiteNewsID,
+				Active:     (item.Active != 0),
+				CreatedAt:  item.CreatedAt,
+				News:       item.News,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListArchivedRequests(ctx context.Context) ([]*AdminRequestQueue, error) {
+	res, err := s.q.AdminListArchivedRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminRequestQueue) []*AdminRequestQueue {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminRequestQueue, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminRequestQueue{
+				ID:             item.ID,
+				UsersIdusers:   item.UsersIdusers,
+				ChangeTable:    item.ChangeTable,
+				ChangeField:    item.ChangeField,
+				ChangeRowID:    item.ChangeRowID,
+				ChangeValue:    item.ChangeValue,
+				ContactOptions: item.ContactOptions,
+				Status:         item.Status,
+				CreatedAt:      item.CreatedAt,
+				ActedAt:        item.ActedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListAuditLogs(ctx context.Context, arg AdminListAuditLogsParams) ([]*AdminListAuditLogsRow, error) {
+	res, err := s.q.AdminListAuditLogs(ctx, dbpostgres.AdminListAuditLogsParams{
+		Username:  arg.Username,
+		Action:    arg.Action,
+		Section:   arg.Section,
+		StartTime: arg.StartTime,
+		EndTime:   arg.EndTime,
+		Offset:    arg.Offset,
+		Limit:     arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListAuditLogsRow) []*AdminListAuditLogsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListAuditLogsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListAuditLogsRow{
+				ID:           item.ID,
+				UsersIdusers: item.UsersIdusers,
+				Action:       item.Action,
+				Path:         item.Path,
+				Details:      item.Details,
+				Data:         item.Data,
+				CreatedAt:    item.CreatedAt,
+				Username:     item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListBadComments(ctx context.Context) ([]*Comment, error) {
+	res, err := s.q.AdminListBadComments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Comment) []*Comment {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Comment, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Comment{
+				Idcomments:    item.Idcomments,
+				ForumthreadID: item.ForumthreadID,
+				UsersIdusers:  item.UsersIdusers,
+				LanguageID:    item.LanguageID,
+				Written:       item.Written,
+				Text:          item.Text,
+				Timezone:      item.Timezone,
+				DeletedAt:     item.DeletedAt,
+				LastIndex:     item.LastIndex,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListBoards(ctx context.Context, arg AdminListBoardsParams) ([]*Imageboard, error) {
+	res, err := s.q.AdminListBoards(ctx, dbpostgres.AdminListBoardsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Imageboard) []*Imageboard {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Imageboard, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Imageboard{
+				Idimageboard:           item.Idimageboard,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Title:                  item.Title,
+				Description:            item.Description,
+				ApprovalRequired:       (item.ApprovalRequired != 0),
+				DeletedAt:              item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListDeactivatedBlogs(ctx context.Context, arg AdminListDeactivatedBlogsParams) ([]*AdminListDeactivatedBlogsRow, error) {
+	res, err := s.q.AdminListDeactivatedBlogs(ctx, dbpostgres.AdminListDeactivatedBlogsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListDeactivatedBlogsRow) []*AdminListDeactivatedBlogsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListDeactivatedBlogsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListDeactivatedBlogsRow{
+				Idblogs: item.Idblogs,
+				Blog:    item.Blog,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListDeactivatedComments(ctx context.Context, arg AdminListDeactivatedCommentsParams) ([]*AdminListDeactivatedCommentsRow, error) {
+	res, err := s.q.AdminListDeactivatedComments(ctx, dbpostgres.AdminListDeactivatedCommentsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListDeactivatedCommentsRow) []*AdminListDeactivatedCommentsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListDeactivatedCommentsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListDeactivatedCommentsRow{
+				Idcomments: item.Idcomments,
+				Text:       item.Text,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListDeactivatedImageposts(ctx context.Context, arg AdminListDeactivatedImagepostsParams) ([]*AdminListDeactivatedImagepostsRow, error) {
+	res, err := s.q.AdminListDeactivatedImageposts(ctx, dbpostgres.AdminListDeactivatedImagepostsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListDeactivatedImagepostsRow) []*AdminListDeactivatedImagepostsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListDeactivatedImagepostsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListDeactivatedImagepostsRow{
+				Idimagepost: item.Idimagepost,
+				Description: item.Description,
+				Thumbnail:   item.Thumbnail,
+				Fullimage:   item.Fullimage,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListDeactivatedLinks(ctx context.Context, arg AdminListDeactivatedLinksParams) ([]*AdminListDeactivatedLinksRow, error) {
+	res, err := s.q.AdminListDeactivatedLinks(ctx, dbpostgres.AdminListDeactivatedLinksParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListDeactivatedLinksRow) []*AdminListDeactivatedLinksRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListDeactivatedLinksRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListDeactivatedLinksRow{
+				ID:          item.ID,
+				Title:       item.Title,
+				Url:         item.Url,
+				Description: item.Description,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListDeactivatedUsers(ctx context.Context, arg AdminListDeactivatedUsersParams) ([]*AdminListDeactivatedUsersRow, error) {
+	res, err := s.q.AdminListDeactivatedUsers(ctx, dbpostgres.AdminListDeactivatedUsersParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListDeactivatedUsersRow) []*AdminListDeactivatedUsersRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListDeactivatedUsersRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListDeactivatedUsersRow{
+				Idusers:  item.Idusers,
+				Email:    item.Email,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListDeactivatedWritings(ctx context.Context, arg AdminListDeactivatedWritingsParams) ([]*AdminListDeactivatedWritingsRow, error) {
+	res, err := s.q.AdminListDeactivatedWritings(ctx, dbpostgres.AdminListDeactivatedWritingsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListDeactivatedWritingsRow) []*AdminListDeactivatedWritingsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListDeactivatedWritingsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListDeactivatedWritingsRow{
+				Idwriting: item.Idwriting,
+				Title:     item.Title,
+				Writing:   item.Writing,
+				Abstract:  item.Abstract,
+				Private:   sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListEmailSuppressions(ctx context.Context, arg AdminListEmailSuppressionsParams) ([]*AdminListEmailSuppressionsRow, error) {
+	res, err := s.q.AdminListEmailSuppressions(ctx, dbpostgres.AdminListEmailSuppressionsParams{
+		Limit:  arg.Limit,
+		Offset: arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListEmailSuppressionsRow) []*AdminListEmailSuppressionsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListEmailSuppressionsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListEmailSuppressionsRow{
+				ID:        item.ID,
+				Email:     item.Email,
+				UserID:    item.UserID,
+				Username:  item.Username,
+				Reason:    item.Reason,
+				Detail:    item.Detail,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListExternalLinks(ctx context.Context, arg AdminListExternalLinksParams) ([]*ExternalLink, error) {
+	res, err := s.q.AdminListExternalLinks(ctx, dbpostgres.AdminListExternalLinksParams{
+		Limit:  arg.Limit,
+		Offset: arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ExternalLink) []*ExternalLink {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ExternalLink, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ExternalLink{
+				ID:              item.ID,
+				Url:             item.Url,
+				UrlHash:         item.UrlHash,
+				Clicks:          item.Clicks,
+				CreatedAt:       item.CreatedAt,
+				UpdatedAt:       item.UpdatedAt,
+				UpdatedBy:       item.UpdatedBy,
+				CardTitle:       item.CardTitle,
+				CardDescription: item.CardDescription,
+				CardImage:       item.CardImage,
+				CardImageCache:  item.CardImageCache,
+				FaviconCache:    item.FaviconCache,
+				CardDuration:    item.CardDuration,
+				CardUploadDate:  item.CardUploadDate,
+				CardAuthor:      item.CardAuthor,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListFAQCategories(ctx context.Context) ([]*FaqCategory, error) {
+	res, err := s.q.AdminListFAQCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.FaqCategory) []*FaqCategory {
+		if items == nil {
+			return nil
+		}
+		out := make([]*FaqCategory, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &FaqCategory{
+				ID:               item.ID,
+				ParentCategoryID: item.ParentCategoryID,
+				LanguageID:       item.LanguageID,
+				Name:             item.Name,
+				DeletedAt:        item.DeletedAt,
+				Priority:         item.Priority,
+				UpdatedAt:        item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListFailedEmailIDs(ctx context.Context, arg AdminListFailedEmailIDsParams) ([]int32, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminListFailedEmailIDsParams{
+		Provider:      conv.toNullString(arg.Provider),
+		CreatedBefore: arg.CreatedBefore,
+		LanguageID:    arg.LanguageID,
+		RoleName:      arg.RoleName,
+	}
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	res, err := s.q.AdminListFailedEmailIDs(ctx, pgArg1)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListFailedEmails(ctx context.Context, arg AdminListFailedEmailsParams) ([]*AdminListFailedEmailsRow, error) {
+	res, err := s.q.AdminListFailedEmails(ctx, dbpostgres.AdminListFailedEmailsParams{
+		LanguageID: arg.LanguageID,
+		RoleName:   arg.RoleName,
+		Offset:     arg.Offset,
+		Limit:      arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListFailedEmailsRow) []*AdminListFailedEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListFailedEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListFailedEmailsRow{
+				ID:          item.ID,
+				ToUserID:    item.ToUserID,
+				Body:        item.Body,
+				ErrorCount:  item.ErrorCount,
+				CreatedAt:   item.CreatedAt,
+				DirectEmail: (item.DirectEmail != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListForumCategoriesWithCounts(ctx context.Context, arg AdminListForumCategoriesWithCountsParams) ([]*AdminListForumCategoriesWithCountsRow, error) {
+	res, err := s.q.AdminListForumCategoriesWithCounts(ctx, dbpostgres.AdminListForumCategoriesWithCountsParams{
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+		ViewerID: arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListForumCategoriesWithCountsRow) []*AdminListForumCategoriesWithCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListForumCategoriesWithCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListForumCategoriesWithCountsRow{
+				Idforumcategory:              item.Idforumcategory,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				DeletedAt:                    item.DeletedAt,
+				Subcategorycount:             item.Subcategorycount,
+				Topiccount:                   item.Topiccount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListForumThreadGrantsByThreadID(ctx context.Context, itemID sql.NullInt32) ([]*AdminListForumThreadGrantsByThreadIDRow, error) {
+	res, err := s.q.AdminListForumThreadGrantsByThreadID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListForumThreadGrantsByThreadIDRow) []*AdminListForumThreadGrantsByThreadIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListForumThreadGrantsByThreadIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListForumThreadGrantsByThreadIDRow{
+				ID:       item.ID,
+				Section:  item.Section,
+				Action:   item.Action,
+				RoleName: item.RoleName,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListForumThreads(ctx context.Context, arg AdminListForumThreadsParams) ([]*AdminListForumThreadsRow, error) {
+	var conv pgConv
+	res, err := s.q.AdminListForumThreads(ctx, dbpostgres.AdminListForumThreadsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := func(items []*dbpostgres.AdminListForumThreadsRow) []*AdminListForumThreadsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListForumThreadsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListForumThreadsRow{
+				Idforumthread: item.Idforumthread,
+				Idforumtopic:  item.Idforumtopic,
+				Title:         conv.toString(item.Title),
+				CreatedAt:     item.CreatedAt,
+				CreatedBy:     item.CreatedBy,
+				LastPostBy:    item.LastPostBy,
+				LastPostAt:    item.LastPostAt,
+				PostCount:     item.PostCount,
+				TopicTitle:    item.TopicTitle,
+				TopicHandler:  item.TopicHandler,
+			}
+		}
+		return out
+	}(res)
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	return out, nil
+}
+
+func (s *postgresQuerier) AdminListForumTopicGrantsByTopicID(ctx context.Context, itemID sql.NullInt32) ([]*AdminListForumTopicGrantsByTopicIDRow, error) {
+	res, err := s.q.AdminListForumTopicGrantsByTopicID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListForumTopicGrantsByTopicIDRow) []*AdminListForumTopicGrantsByTopicIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListForumTopicGrantsByTopicIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListForumTopicGrantsByTopicIDRow{
+				ID:       item.ID,
+				Section:  item.Section,
+				Action:   item.Action,
+				RoleName: item.RoleName,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListForumTopics(ctx context.Context, arg AdminListForumTopicsParams) ([]*Forumtopic, error) {
+	res, err := s.q.AdminListForumTopics(ctx, dbpostgres.AdminListForumTopicsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Forumtopic) []*Forumtopic {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Forumtopic, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Forumtopic{
+				Idforumtopic:                 item.Idforumtopic,
+				Lastposter:                   item.Lastposter,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				Threads:                      item.Threads,
+				Comments:                     item.Comments,
+				Lastaddition:                 item.Lastaddition,
+				Handler:                      item.Handler,
+				DeletedAt:                    item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListGrantsByRoleID(ctx context.Context, roleID sql.NullInt32) ([]*Grant, error) {
+	res, err := s.q.AdminListGrantsByRoleID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Grant) []*Grant {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Grant, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Grant{
+				ID:        item.ID,
+				CreatedAt: item.CreatedAt,
+				UpdatedAt: item.UpdatedAt,
+				UserID:    item.UserID,
+				RoleID:    item.RoleID,
+				Section:   item.Section,
+				Item:      item.Item,
+				RuleType:  item.RuleType,
+				ItemID:    item.ItemID,
+				ItemRule:  item.ItemRule,
+				Action:    item.Action,
+				Extra:     item.Extra,
+				Active:    (item.Active != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListGrantsByThreadID(ctx context.Context, itemID sql.NullInt32) ([]*AdminListGrantsByThreadIDRow, error) {
+	res, err := s.q.AdminListGrantsByThreadID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListGrantsByThreadIDRow) []*AdminListGrantsByThreadIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListGrantsByThreadIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListGrantsByThreadIDRow{
+				ID:       item.ID,
+				Section:  item.Section,
+				Action:   item.Action,
+				RoleName: item.RoleName,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListGrantsByTopicID(ctx context.Context, itemID sql.NullInt32) ([]*AdminListGrantsByTopicIDRow, error) {
+	res, err := s.q.AdminListGrantsByTopicID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListGrantsByTopicIDRow) []*AdminListGrantsByTopicIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListGrantsByTopicIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListGrantsByTopicIDRow{
+				ID:       item.ID,
+				Section:  item.Section,
+				Action:   item.Action,
+				RoleName: item.RoleName,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListLoginAttempts(ctx context.Context) ([]*LoginAttempt, error) {
+	res, err := s.q.AdminListLoginAttempts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.LoginAttempt) []*LoginAttempt {
+		if items == nil {
+			return nil
+		}
+		out := make([]*LoginAttempt, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &LoginAttempt{
+				ID:        item.ID,
+				Username:  item.Username,
+				IpAddress: item.IpAddress,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescending(ctx context.Context, arg AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams) ([]*AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow, error) {
+	res, err := s.q.AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescending(ctx, dbpostgres.AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow) []*AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow{
+				Writername:    item.Writername,
+				Writerid:      item.Writerid,
+				Idsitenews:    item.Idsitenews,
+				ForumthreadID: item.ForumthreadID,
+				LanguageID:    item.LanguageID,
+				UsersIdusers:  item.UsersIdusers,
+				News:          item.News,
+				Occurred:      item.Occurred,
+				Timezone:      item.Timezone,
+				Comments:      item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListOrphanComments(ctx context.Context) ([]int32, error) {
+	res, err := s.q.AdminListOrphanComments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListOrphanForumThreads(ctx context.Context) ([]int32, error) {
+	res, err := s.q.AdminListOrphanForumThreads(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListPasswordResets(ctx context.Context, arg AdminListPasswordResetsParams) ([]*AdminListPasswordResetsRow, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminListPasswordResetsParams{
+		Status:        conv.toNullString(arg.Status),
+		UserID:        arg.UserID,
+		CreatedBefore: arg.CreatedBefore,
+		Offset:        arg.Offset,
+		Limit:         arg.Limit,
+	}
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	res, err := s.q.AdminListPasswordResets(ctx, pgArg1)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPasswordResetsRow) []*AdminListPasswordResetsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPasswordResetsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPasswordResetsRow{
+				ID:         item.ID,
+				UserID:     item.UserID,
+				Username:   item.Username,
+				CreatedAt:  item.CreatedAt,
+				VerifiedAt: item.VerifiedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingContentReportReporters(ctx context.Context, arg AdminListPendingContentReportReportersParams) ([]int32, error) {
+	res, err := s.q.AdminListPendingContentReportReporters(ctx, dbpostgres.AdminListPendingContentReportReportersParams{
+		ItemType: arg.ItemType,
+		ItemID:   arg.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListPendingContentReports(ctx context.Context) ([]*AdminListPendingContentReportsRow, error) {
+	res, err := s.q.AdminListPendingContentReports(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPendingContentReportsRow) []*AdminListPendingContentReportsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingContentReportsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingContentReportsRow{
+				ID:               item.ID,
+				ReporterID:       item.ReporterID,
+				ItemType:         item.ItemType,
+				ItemID:           item.ItemID,
+				AuthorID:         item.AuthorID,
+				Reason:           item.Reason,
+				Status:           item.Status,
+				ResolutionNote:   item.ResolutionNote,
+				ResolvedBy:       item.ResolvedBy,
+				CreatedAt:        item.CreatedAt,
+				ResolvedAt:       item.ResolvedAt,
+				ReporterUsername: item.ReporterUsername,
+				AuthorUsername:   item.AuthorUsername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingDeactivatedBlogs(ctx context.Context, arg AdminListPendingDeactivatedBlogsParams) ([]*AdminListPendingDeactivatedBlogsRow, error) {
+	res, err := s.q.AdminListPendingDeactivatedBlogs(ctx, dbpostgres.AdminListPendingDeactivatedBlogsParams{
+		UsersIdusers: arg.UsersIdusers,
+		Limit:        arg.Limit,
+		Offset:       arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPendingDeactivatedBlogsRow) []*AdminListPendingDeactivatedBlogsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingDeactivatedBlogsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingDeactivatedBlogsRow{
+				Idblogs: item.Idblogs,
+				Blog:    item.Blog,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingDeactivatedComments(ctx context.Context, arg AdminListPendingDeactivatedCommentsParams) ([]*AdminListPendingDeactivatedCommentsRow, error) {
+	res, err := s.q.AdminListPendingDeactivatedComments(ctx, dbpostgres.AdminListPendingDeactivatedCommentsParams{
+		UsersIdusers: arg.UsersIdusers,
+		Limit:        arg.Limit,
+		Offset:       arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPendingDeactivatedCommentsRow) []*AdminListPendingDeactivatedCommentsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingDeactivatedCommentsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingDeactivatedCommentsRow{
+				Idcomments: item.Idcomments,
+				Text:       item.Text,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingDeactivatedImageposts(ctx context.Context, arg AdminListPendingDeactivatedImagepostsParams) ([]*AdminListPendingDeactivatedImagepostsRow, error) {
+	res, err := s.q.AdminListPendingDeactivatedImageposts(ctx, dbpostgres.AdminListPendingDeactivatedImagepostsParams{
+		UsersIdusers: arg.UsersIdusers,
+		Limit:        arg.Limit,
+		Offset:       arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPendingDeactivatedImagepostsRow) []*AdminListPendingDeactivatedImagepostsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingDeactivatedImagepostsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingDeactivatedImagepostsRow{
+				Idimagepost: item.Idimagepost,
+				Description: item.Description,
+				Thumbnail:   item.Thumbnail,
+				Fullimage:   item.Fullimage,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingDeactivatedLinks(ctx context.Context, arg AdminListPendingDeactivatedLinksParams) ([]*AdminListPendingDeactivatedLinksRow, error) {
+	res, err := s.q.AdminListPendingDeactivatedLinks(ctx, dbpostgres.AdminListPendingDeactivatedLinksParams{
+		AuthorID: arg.AuthorID,
+		Limit:    arg.Limit,
+		Offset:   arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPendingDeactivatedLinksRow) []*AdminListPendingDeactivatedLinksRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingDeactivatedLinksRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingDeactivatedLinksRow{
+				ID:          item.ID,
+				Title:       item.Title,
+				Url:         item.Url,
+				Description: item.Description,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingDeactivatedWritings(ctx context.Context, arg AdminListPendingDeactivatedWritingsParams) ([]*AdminListPendingDeactivatedWritingsRow, error) {
+	res, err := s.q.AdminListPendingDeactivatedWritings(ctx, dbpostgres.AdminListPendingDeactivatedWritingsParams{
+		UsersIdusers: arg.UsersIdusers,
+		Limit:        arg.Limit,
+		Offset:       arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPendingDeactivatedWritingsRow) []*AdminListPendingDeactivatedWritingsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingDeactivatedWritingsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingDeactivatedWritingsRow{
+				Idwriting: item.Idwriting,
+				Title:     item.Title,
+				Writing:   item.Writing,
+				Abstract:  item.Abstract,
+				Private:   sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingRequests(ctx context.Context) ([]*AdminRequestQueue, error) {
+	res, err := s.q.AdminListPendingRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminRequestQueue) []*AdminRequestQueue {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminRequestQueue, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminRequestQueue{
+				ID:             item.ID,
+				UsersIdusers:   item.UsersIdusers,
+				ChangeTable:    item.ChangeTable,
+				ChangeField:    item.ChangeField,
+				ChangeRowID:    item.ChangeRowID,
+				ChangeValue:    item.ChangeValue,
+				ContactOptions: item.ContactOptions,
+				Status:         item.Status,
+				CreatedAt:      item.CreatedAt,
+				ActedAt:        item.ActedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPendingUsers(ctx context.Context) ([]*AdminListPendingUsersRow, error) {
+	res, err := s.q.AdminListPendingUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPendingUsersRow) []*AdminListPendingUsersRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingUsersRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingUsersRow{
+				Idusers:  item.Idusers,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPrivateForumComments(ctx context.Context, arg AdminListPrivateForumCommentsParams) ([]*AdminListPrivateForumCommentsRow, error) {
+	res, err := s.q.AdminListPrivateForumComments(ctx, dbpostgres.AdminListPrivateForumCommentsParams{
+		Limit:  arg.Limit,
+		Offset: arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPrivateForumCommentsRow) []*AdminListPrivateForumCommentsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPrivateForumCommentsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPrivateForumCommentsRow{
+				Idcomments:      item.Idcomments,
+				Written:         item.Written,
+				Text:            item.Text,
+				DeletedAt:       item.DeletedAt,
+				Idforumthread:   item.Idforumthread,
+				Idforumtopic:    item.Idforumtopic,
+				ForumtopicTitle: item.ForumtopicTitle,
+				TopicHandler:    item.TopicHandler,
+				Idusers:         item.Idusers,
+				Posterusername:  item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPrivateForumInvalidCommentsByThread(ctx context.Context, forumthreadID int32) ([]int32, error) {
+	res, err := s.q.AdminListPrivateForumInvalidCommentsByThread(ctx, forumthreadID)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListPrivateForumThreads(ctx context.Context, arg AdminListPrivateForumThreadsParams) ([]*AdminListPrivateForumThreadsRow, error) {
+	var conv pgConv
+	res, err := s.q.AdminListPrivateForumThreads(ctx, dbpostgres.AdminListPrivateForumThreadsParams{
+		Limit:  arg.Limit,
+		Offset: arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := func(items []*dbpostgres.AdminListPrivateForumThreadsRow) []*AdminListPrivateForumThreadsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPrivateForumThreadsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPrivateForumThreadsRow{
+				Idforumthread: item.Idforumthread,
+				Idforumtopic:  item.Idforumtopic,
+				Title:         conv.toString(item.Title),
+				CreatedAt:     item.CreatedAt,
+				CreatedBy:     item.CreatedBy,
+				LastPostBy:    item.LastPostBy,
+				LastPostAt:    item.LastPostAt,
+				PostCount:     item.PostCount,
+				TopicTitle:    item.TopicTitle,
+				TopicHandler:  item.TopicHandler,
+			}
+		}
+		return out
+	}(res)
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	return out, nil
+}
+
+func (s *postgresQuerier) AdminListPrivateForumTopics(ctx context.Context, arg AdminListPrivateForumTopicsParams) ([]*AdminListPrivateForumTopicsRow, error) {
+	res, err := s.q.AdminListPrivateForumTopics(ctx, dbpostgres.AdminListPrivateForumTopicsParams{
+		Limit:  arg.Limit,
+		Offset: arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPrivateForumTopicsRow) []*AdminListPrivateForumTopicsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPrivateForumTopicsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPrivateForumTopicsRow{
+				Idforumtopic: item.Idforumtopic,
+				Title:        item.Title,
+				Handler:      item.Handler,
+				Threads:      item.Threads,
+				Comments:     item.Comments,
+				Lastaddition: item.Lastaddition,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListPrivateTopicParticipantsByTopicID(ctx context.Context, itemID sql.NullInt32) ([]*AdminListPrivateTopicParticipantsByTopicIDRow, error) {
+	res, err := s.q.AdminListPrivateTopicParticipantsByTopicID(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListPrivateTopicParticipantsByTopicIDRow) []*AdminListPrivateTopicParticipantsByTopicIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPrivateTopicParticipantsByTopicIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPrivateTopicParticipantsByTopicIDRow{
+				Idusers:  item.Idusers,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRecentEmailBounces(ctx context.Context, limit int32) ([]*AdminListRecentEmailBouncesRow, error) {
+	res, err := s.q.AdminListRecentEmailBounces(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListRecentEmailBouncesRow) []*AdminListRecentEmailBouncesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListRecentEmailBouncesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListRecentEmailBouncesRow{
+				ID:          item.ID,
+				UserEmailID: item.UserEmailID,
+				Email:       item.Email,
+				UserID:      item.UserID,
+				Kind:        item.Kind,
+				Source:      item.Source,
+				Detail:      item.Detail,
+				CreatedAt:   item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRecentNotifications(ctx context.Context, limit int32) ([]*Notification, error) {
+	res, err := s.q.AdminListRecentNotifications(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Notification) []*Notification {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Notification, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Notification{
+				ID:           item.ID,
+				UsersIdusers: item.UsersIdusers,
+				Link:         item.Link,
+				Message:      item.Message,
+				CreatedAt:    item.CreatedAt,
+				ReadAt:       item.ReadAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRequestComments(ctx context.Context, requestID int32) ([]*AdminRequestComment, error) {
+	res, err := s.q.AdminListRequestComments(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminRequestComment) []*AdminRequestComment {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminRequestComment, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminRequestComment{
+				ID:        item.ID,
+				RequestID: item.RequestID,
+				Comment:   item.Comment,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRequestQueue(ctx context.Context) ([]*AdminRequestQueue, error) {
+	res, err := s.q.AdminListRequestQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminRequestQueue) []*AdminRequestQueue {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminRequestQueue, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminRequestQueue{
+				ID:             item.ID,
+				UsersIdusers:   item.UsersIdusers,
+				ChangeTable:    item.ChangeTable,
+				ChangeField:    item.ChangeField,
+				ChangeRowID:    item.ChangeRowID,
+				ChangeValue:    item.ChangeValue,
+				ContactOptions: item.ContactOptions,
+				Status:         item.Status,
+				CreatedAt:      item.CreatedAt,
+				ActedAt:        item.ActedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRequestQueueByStatus(ctx context.Context, status string) ([]*AdminRequestQueue, error) {
+	res, err := s.q.AdminListRequestQueueByStatus(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminRequestQueue) []*AdminRequestQueue {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminRequestQueue, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminRequestQueue{
+				ID:             item.ID,
+				UsersIdusers:   item.UsersIdusers,
+				ChangeTable:    item.ChangeTable,
+				ChangeField:    item.ChangeField,
+				ChangeRowID:    item.ChangeRowID,
+				ChangeValue:    item.ChangeValue,
+				ContactOptions: item.ContactOptions,
+				Status:         item.Status,
+				CreatedAt:      item.CreatedAt,
+				ActedAt:        item.ActedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRequestsByUserID(ctx context.Context, usersIdusers int32) ([]*AdminRequestQueue, error) {
+	res, err := s.q.AdminListRequestsByUserID(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminRequestQueue) []*AdminRequestQueue {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminRequestQueue, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminRequestQueue{
+				ID:             item.ID,
+				UsersIdusers:   item.UsersIdusers,
+				ChangeTable:    item.ChangeTable,
+				ChangeField:    item.ChangeField,
+				ChangeRowID:    item.ChangeRowID,
+				ChangeValue:    item.ChangeValue,
+				ContactOptions: item.ContactOptions,
+				Status:         item.Status,
+				CreatedAt:      item.CreatedAt,
+				ActedAt:        item.ActedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListResolvedContentReports(ctx context.Context, limit int32) ([]*AdminListResolvedContentReportsRow, error) {
+	res, err := s.q.AdminListResolvedContentReports(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListResolvedContentReportsRow) []*AdminListResolvedContentReportsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListResolvedContentReportsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListResolvedContentReportsRow{
+				ID:               item.ID,
+				ReporterID:       item.ReporterID,
+				ItemType:         item.ItemType,
+				ItemID:           item.ItemID,
+				AuthorID:         item.AuthorID,
+				Reason:           item.Reason,
+				Status:           item.Status,
+				ResolutionNote:   item.ResolutionNote,
+				ResolvedBy:       item.ResolvedBy,
+				CreatedAt:        item.CreatedAt,
+				ResolvedAt:       item.ResolvedAt,
+				ReporterUsername: item.ReporterUsername,
+				AuthorUsername:   item.AuthorUsername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRoles(ctx context.Context) ([]*Role, error) {
+	res, err := s.q.AdminListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Role) []*Role {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Role, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Role{
+				ID:                     item.ID,
+				Name:                   item.Name,
+				CanLogin:               (item.CanLogin != 0),
+				IsAdmin:                (item.IsAdmin != 0),
+				PrivateLabels:          (item.PrivateLabels != 0),
+				PublicProfileAllowedAt: item.PublicProfileAllowedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListRolesWithUsers(ctx context.Context) ([]*AdminListRolesWithUsersRow, error) {
+	res, err := s.q.AdminListRolesWithUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListRolesWithUsersRow) []*AdminListRolesWithUsersRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListRolesWithUsersRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListRolesWithUsersRow{
+				ID:    item.ID,
+				Name:  item.Name,
+				Users: sql.NullString{String: string(item.Users), Valid: item.Users != nil},
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListSentEmailIDs(ctx context.Context, arg AdminListSentEmailIDsParams) ([]int32, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminListSentEmailIDsParams{
+		Provider:      conv.toNullString(arg.Provider),
+		CreatedBefore: arg.CreatedBefore,
+		LanguageID:    arg.LanguageID,
+		RoleName:      arg.RoleName,
+	}
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	res, err := s.q.AdminListSentEmailIDs(ctx, pgArg1)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListSentEmails(ctx context.Context, arg AdminListSentEmailsParams) ([]*AdminListSentEmailsRow, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminListSentEmailsParams{
+		Provider:      conv.toNullString(arg.Provider),
+		CreatedBefore: arg.CreatedBefore,
+		LanguageID:    arg.LanguageID,
+		RoleName:      arg.RoleName,
+		Offset:        arg.Offset,
+		Limit:         arg.Limit,
+	}
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	res, err := s.q.AdminListSentEmails(ctx, pgArg1)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListSentEmailsRow) []*AdminListSentEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListSentEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListSentEmailsRow{
+				ID:          item.ID,
+				ToUserID:    item.ToUserID,
+				Body:        item.Body,
+				ErrorCount:  item.ErrorCount,
+				CreatedAt:   item.CreatedAt,
+				SentAt:      item.SentAt,
+				DirectEmail: (item.DirectEmail != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListSessions(ctx context.Context) ([]*AdminListSessionsRow, error) {
+	res, err := s.q.AdminListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListSessionsRow) []*AdminListSessionsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListSessionsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListSessionsRow{
+				SessionID:    item.SessionID,
+				UsersIdusers: item.UsersIdusers,
+				Username:     item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListTopicsWithUserGrantsNoRoles(ctx context.Context, includeAdmin interface{}) ([]*AdminListTopicsWithUserGrantsNoRolesRow, error) {
+	res, err := s.q.AdminListTopicsWithUserGrantsNoRoles(ctx, includeAdmin)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListTopicsWithUserGrantsNoRolesRow) []*AdminListTopicsWithUserGrantsNoRolesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListTopicsWithUserGrantsNoRolesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListTopicsWithUserGrantsNoRolesRow{
+				Idforumtopic: item.Idforumtopic,
+				Title:        item.Title,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListUnsentPendingEmails(ctx context.Context, arg AdminListUnsentPendingEmailsParams) ([]*AdminListUnsentPendingEmailsRow, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminListUnsentPendingEmailsParams{
+		Status:        conv.toNullString(arg.Status),
+		Provider:      conv.toNullString(arg.Provider),
+		CreatedBefore: arg.CreatedBefore,
+		LanguageID:    arg.LanguageID,
+		RoleName:      arg.RoleName,
+		Offset:        arg.Offset,
+		Limit:         arg.Limit,
+	}
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	res, err := s.q.AdminListUnsentPendingEmails(ctx, pgArg1)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListUnsentPendingEmailsRow) []*AdminListUnsentPendingEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListUnsentPendingEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListUnsentPendingEmailsRow{
+				ID:          item.ID,
+				ToUserID:    item.ToUserID,
+				Body:        item.Body,
+				ErrorCount:  item.ErrorCount,
+				CreatedAt:   item.CreatedAt,
+				DirectEmail: (item.DirectEmail != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListUploadedImages(ctx context.Context, arg AdminListUploadedImagesParams) ([]*UploadedImage, error) {
+	res, err := s.q.AdminListUploadedImages(ctx, dbpostgres.AdminListUploadedImagesParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UploadedImage) []*UploadedImage {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UploadedImage, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UploadedImage{
+				Iduploadedimage: item.Iduploadedimage,
+				UsersIdusers:    item.UsersIdusers,
+				Path:            item.Path,
+				Width:           item.Width,
+				Height:          item.Height,
+				FileSize:        item.FileSize,
+				Uploaded:        item.Uploaded,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListUserEmails(ctx context.Context, userID int32) ([]*UserEmail, error) {
+	res, err := s.q.AdminListUserEmails(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserEmail) []*UserEmail {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserEmail, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserEmail{
+				ID:                    item.ID,
+				UserID:                item.UserID,
+				Email:                 item.Email,
+				VerifiedAt:            item.VerifiedAt,
+				LastVerificationCode:  item.LastVerificationCode,
+				VerificationExpiresAt: item.VerificationExpiresAt,
+				NotificationPriority:  item.NotificationPriority,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListUserIDsByRole(ctx context.Context, name string) ([]int32, error) {
+	res, err := s.q.AdminListUserIDsByRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminListUsersByID(ctx context.Context, ids []int32) ([]*AdminListUsersByIDRow, error) {
+	res, err := s.q.AdminListUsersByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListUsersByIDRow) []*AdminListUsersByIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListUsersByIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListUsersByIDRow{
+				Idusers:  item.Idusers,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListUsersByRoleID(ctx context.Context, roleID int32) ([]*AdminListUsersByRoleIDRow, error) {
+	res, err := s.q.AdminListUsersByRoleID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListUsersByRoleIDRow) []*AdminListUsersByRoleIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListUsersByRoleIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListUsersByRoleIDRow{
+				IduserRoles: item.IduserRoles,
+				Idusers:     item.Idusers,
+				Username:    item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListWebhookDeliveries(ctx context.Context, arg AdminListWebhookDeliveriesParams) ([]*AdminListWebhookDeliveriesRow, error) {
+	res, err := s.q.AdminListWebhookDeliveries(ctx, dbpostgres.AdminListWebhookDeliveriesParams{
+		WebhookID: arg.WebhookID,
+		Offset:    arg.Offset,
+		Limit:     arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminListWebhookDeliveriesRow) []*AdminListWebhookDeliveriesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListWebhookDeliveriesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListWebhookDeliveriesRow{
+				ID:             item.ID,
+				WebhookID:      item.WebhookID,
+				Event:          item.Event,
+				Path:           item.Path,
+				Status:         item.Status,
+				Attempts:       item.Attempts,
+				ResponseStatus: item.ResponseStatus,
+				Error:          item.Error,
+				CreatedAt:      item.CreatedAt,
+				UpdatedAt:      item.UpdatedAt,
+				WebhookName:    item.WebhookName,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	res, err := s.q.AdminListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Webhook) []*Webhook {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Webhook, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Webhook{
+				ID:        item.ID,
+				Name:      item.Name,
+				Url:       item.Url,
+				Secret:    item.Secret,
+				Patterns:  item.Patterns,
+				Active:    (item.Active != 0),
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminMarkBlogRestored(ctx context.Context, idblogs int32) error {
+	return s.q.AdminMarkBlogRestored(ctx, idblogs)
+}
+
+func (s *postgresQuerier) AdminMarkCommentRestored(ctx context.Context, idcomments int32) error {
+	return s.q.AdminMarkCommentRestored(ctx, idcomments)
+}
+
+func (s *postgresQuerier) AdminMarkImagepostRestored(ctx context.Context, idimagepost int32) error {
+	return s.q.AdminMarkImagepostRestored(ctx, idimagepost)
+}
+
+func (s *postgresQuerier) AdminMarkLinkRestored(ctx context.Context, id int32) error {
+	return s.q.AdminMarkLinkRestored(ctx, id)
+}
+
+func (s *postgresQuerier) AdminMarkNotificationRead(ctx context.Context, id int32) error {
+	return s.q.AdminMarkNotificationRead(ctx, id)
+}
+
+func (s *postgresQuerier) AdminMarkNotificationUnread(ctx context.Context, id int32) error {
+	return s.q.AdminMarkNotificationUnread(ctx, id)
+}
+
+func (s *postgresQuerier) AdminMarkUserRestored(ctx context.Context, idusers int32) error {
+	return s.q.AdminMarkUserRestored(ctx, idusers)
+}
+
+func (s *postgresQuerier) AdminMarkWritingRestored(ctx context.Context, idwriting int32) error {
+	return s.q.AdminMarkWritingRestored(ctx, idwriting)
+}
+
+func (s *postgresQuerier) AdminMoveFAQChildren(ctx context.Context, arg AdminMoveFAQChildrenParams) error {
+	return s.q.AdminMoveFAQChildren(ctx, dbpostgres.AdminMoveFAQChildrenParams{
+		NewParentID: arg.NewParentID,
+		OldParentID: arg.OldParentID,
+	})
+}
+
+func (s *postgresQuerier) AdminMoveFAQContent(ctx context.Context, arg AdminMoveFAQContentParams) error {
+	return s.q.AdminMoveFAQContent(ctx, dbpostgres.AdminMoveFAQContentParams{
+		NewCategoryID: arg.NewCategoryID,
+		OldCategoryID: arg.OldCategoryID,
+	})
+}
+
+func (s *postgresQuerier) AdminMoveThreadsToTopic(ctx context.Context, arg AdminMoveThreadsToTopicParams) error {
+	return s.q.AdminMoveThreadsToTopic(ctx, dbpostgres.AdminMoveThreadsToTopicParams{
+		ForumtopicIdforumtopic:   arg.ForumtopicIdforumtopic,
+		ForumtopicIdforumtopic_2: arg.ForumtopicIdforumtopic_2,
+	})
+}
+
+func (s *postgresQuerier) AdminPromoteAnnouncement(ctx context.Context, siteNewsID int32) error {
+	return s.q.AdminPromoteAnnouncement(ctx, siteNewsID)
+}
+
+func (s *postgresQuerier) AdminPurgeReadNotifications(ctx context.Context) error {
+	return s.q.AdminPurgeReadNotifications(ctx)
+}
+
+func (s *postgresQuerier) AdminRebuildAllForumTopicMetaColumns(ctx context.Context) error {
+	return s.q.AdminRebuildAllForumTopicMetaColumns(ctx)
+}
+
+func (s *postgresQuerier) AdminRecalculateAllForumThreadMetaData(ctx context.Context) error {
+	return s.q.AdminRecalculateAllForumThreadMetaData(ctx)
+}
+
+func (s *postgresQuerier) AdminRecalculateForumThreadByIdMetaData(ctx context.Context, idforumthread int32) error {
+	return s.q.AdminRecalculateForumThreadByIdMetaData(ctx, idforumthread)
+}
+
+func (s *postgresQuerier) AdminRemoveLoginRolesFromUser(ctx context.Context, userID int32) error {
+	return s.q.AdminRemoveLoginRolesFromUser(ctx, userID)
+}
+
+func (s *postgresQuerier) AdminRenameFAQCategory(ctx context.Context, arg AdminRenameFAQCategoryParams) error {
+	return s.q.AdminRenameFAQCategory(ctx, dbpostgres.AdminRenameFAQCategoryParams{
+		Name: arg.Name,
+		ID:   arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminRenameLanguage(ctx context.Context, arg AdminRenameLanguageParams) error {
+	return s.q.AdminRenameLanguage(ctx, dbpostgres.AdminRenameLanguageParams{
+		Nameof: arg.Nameof,
+		ID:     arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminRenameLinkerCategory(ctx context.Context, arg AdminRenameLinkerCategoryParams) error {
+	return s.q.AdminRenameLinkerCategory(ctx, dbpostgres.AdminRenameLinkerCategoryParams{
+		Title:    arg.Title,
+		Position: arg.Position,
+		ID:       arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminReplaceSiteNewsURL(ctx context.Context, arg AdminReplaceSiteNewsURLParams) error {
+	return s.q.AdminReplaceSiteNewsURL(ctx, dbpostgres.AdminReplaceSiteNewsURLParams{
+		OldUrl: arg.OldUrl,
+		NewUrl: arg.NewUrl,
+		ID:     arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminResolveContentReportsForItem(ctx context.Context, arg AdminResolveContentReportsForItemParams) (int64, error) {
+	res, err := s.q.AdminResolveContentReportsForItem(ctx, dbpostgres.AdminResolveContentReportsForItemParams{
+		Status:         arg.Status,
+		ResolutionNote: arg.ResolutionNote,
+		ResolvedBy:     arg.ResolvedBy,
+		ItemType:       arg.ItemType,
+		ItemID:         arg.ItemID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) AdminRestoreBlog(ctx context.Context, arg AdminRestoreBlogParams) error {
+	return s.q.AdminRestoreBlog(ctx, dbpostgres.AdminRestoreBlogParams{
+		Blog:    arg.Blog,
+		Idblogs: arg.Idblogs,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreBlogText(ctx context.Context, arg AdminRestoreBlogTextParams) error {
+	return s.q.AdminRestoreBlogText(ctx, dbpostgres.AdminRestoreBlogTextParams{
+		Blog:   arg.Blog,
+		BlogID: arg.BlogID,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreComment(ctx context.Context, arg AdminRestoreCommentParams) error {
+	return s.q.AdminRestoreComment(ctx, dbpostgres.AdminRestoreCommentParams{
+		Text:       arg.Text,
+		Idcomments: arg.Idcomments,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreCommentText(ctx context.Context, arg AdminRestoreCommentTextParams) error {
+	return s.q.AdminRestoreCommentText(ctx, dbpostgres.AdminRestoreCommentTextParams{
+		Text:      arg.Text,
+		CommentID: arg.CommentID,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreImagepost(ctx context.Context, arg AdminRestoreImagepostParams) error {
+	return s.q.AdminRestoreImagepost(ctx, dbpostgres.AdminRestoreImagepostParams{
+		Description: arg.Description,
+		Thumbnail:   arg.Thumbnail,
+		Fullimage:   arg.Fullimage,
+		Idimagepost: arg.Idimagepost,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreLink(ctx context.Context, arg AdminRestoreLinkParams) error {
+	return s.q.AdminRestoreLink(ctx, dbpostgres.AdminRestoreLinkParams{
+		Title:       arg.Title,
+		Url:         arg.Url,
+		Description: arg.Description,
+		ID:          arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreNewsPostText(ctx context.Context, arg AdminRestoreNewsPostTextParams) error {
+	return s.q.AdminRestoreNewsPostText(ctx, dbpostgres.AdminRestoreNewsPostTextParams{
+		News:   arg.News,
+		NewsID: arg.NewsID,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreUser(ctx context.Context, idusers int32) error {
+	return s.q.AdminRestoreUser(ctx, idusers)
+}
+
+func (s *postgresQuerier) AdminRestoreUserEmail(ctx context.Context, idusers int32) error {
+	return s.q.AdminRestoreUserEmail(ctx, idusers)
+}
+
+func (s *postgresQuerier) AdminRestoreUserPassword(ctx context.Context, idusers int32) error {
+	return s.q.AdminRestoreUserPassword(ctx, idusers)
+}
+
+func (s *postgresQuerier) AdminRestoreWriting(ctx context.Context, arg AdminRestoreWritingParams) error {
+	return s.q.AdminRestoreWriting(ctx, dbpostgres.AdminRestoreWritingParams{
+		Title:    arg.Title,
+		Writing:  arg.Writing,
+		Abstract: arg.Abstract,
+		Private: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Private.Bool), Valid: arg.Private.Valid},
+		Idwriting: arg.Idwriting,
+	})
+}
+
+func (s *postgresQuerier) AdminRestoreWritingText(ctx context.Context, arg AdminRestoreWritingTextParams) error {
+	return s.q.AdminRestoreWritingText(ctx, dbpostgres.AdminRestoreWritingTextParams{
+		Title:     arg.Title,
+		Abstract:  arg.Abstract,
+		Writing:   arg.Writing,
+		WritingID: arg.WritingID,
+	})
+}
+
+func (s *postgresQuerier) AdminScrubBlog(ctx context.Context, arg AdminScrubBlogParams) error {
+	return s.q.AdminScrubBlog(ctx, dbpostgres.AdminScrubBlogParams{
+		Blog:    arg.Blog,
+		Idblogs: arg.Idblogs,
+	})
+}
+
+func (s *postgresQuerier) AdminScrubComment(ctx context.Context, arg AdminScrubCommentParams) error {
+	return s.q.AdminScrubComment(ctx, dbpostgres.AdminScrubCommentParams{
+		Text:       arg.Text,
+		Idcomments: arg.Idcomments,
+	})
+}
+
+func (s *postgresQuerier) AdminScrubImagepost(ctx context.Context, idimagepost int32) error {
+	return s.q.AdminScrubImagepost(ctx, idimagepost)
+}
+
+func (s *postgresQuerier) AdminScrubLink(ctx context.Context, arg AdminScrubLinkParams) error {
+	return s.q.AdminScrubLink(ctx, dbpostgres.AdminScrubLinkParams{
+		Title: arg.Title,
+		ID:    arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminScrubUser(ctx context.Context, arg AdminScrubUserParams) error {
+	return s.q.AdminScrubUser(ctx, dbpostgres.AdminScrubUserParams{
+		Username: arg.Username,
+		Idusers:  arg.Idusers,
+	})
+}
+
+func (s *postgresQuerier) AdminScrubUserEmails(ctx context.Context, userID int32) error {
+	return s.q.AdminScrubUserEmails(ctx, userID)
+}
+
+func (s *postgresQuerier) AdminScrubUserPasswords(ctx context.Context, usersIdusers int32) error {
+	return s.q.AdminScrubUserPasswords(ctx, usersIdusers)
+}
+
+func (s *postgresQuerier) AdminScrubWriting(ctx context.Context, arg AdminScrubWritingParams) error {
+	return s.q.AdminScrubWriting(ctx, dbpostgres.AdminScrubWritingParams{
+		Title:     arg.Title,
+		Writing:   arg.Writing,
+		Abstract:  arg.Abstract,
+		Idwriting: arg.Idwriting,
+	})
+}
+
+func (s *postgresQuerier) AdminSetAnnouncementActive(ctx context.Context, arg AdminSetAnnouncementActiveParams) error {
+	return s.q.AdminSetAnnouncementActive(ctx, dbpostgres.AdminSetAnnouncementActiveParams{
+		Active: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active),
+		ID: arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminSetTemplateOverride(ctx context.Context, arg AdminSetTemplateOverrideParams) error {
+	return s.q.AdminSetTemplateOverride(ctx, dbpostgres.AdminSetTemplateOverrideParams{
+		Name: arg.Name,
+		Body: arg.Body,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateBannedIp(ctx context.Context, arg AdminUpdateBannedIpParams) error {
+	return s.q.AdminUpdateBannedIp(ctx, dbpostgres.AdminUpdateBannedIpParams{
+		Reason:    arg.Reason,
+		ExpiresAt: arg.ExpiresAt,
+		ID:        arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateFAQ(ctx context.Context, arg AdminUpdateFAQParams) error {
+	return s.q.AdminUpdateFAQ(ctx, dbpostgres.AdminUpdateFAQParams{
+		Answer:      arg.Answer,
+		Question:    arg.Question,
+		CategoryID:  arg.CategoryID,
+		Priority:    arg.Priority,
+		Description: arg.Description,
+		ID:          arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateFAQCategory(ctx context.Context, arg AdminUpdateFAQCategoryParams) error {
+	return s.q.AdminUpdateFAQCategory(ctx, dbpostgres.AdminUpdateFAQCategoryParams{
+		Name:             arg.Name,
+		ParentCategoryID: arg.ParentCategoryID,
+		LanguageID:       arg.LanguageID,
+		Priority:         arg.Priority,
+		ID:               arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateFAQPriority(ctx context.Context, arg AdminUpdateFAQPriorityParams) error {
+	return s.q.AdminUpdateFAQPriority(ctx, dbpostgres.AdminUpdateFAQPriorityParams{
+		Priority: arg.Priority,
+		ID:       arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateFAQQuestionAnswer(ctx context.Context, arg AdminUpdateFAQQuestionAnswerParams) error {
+	return s.q.AdminUpdateFAQQuestionAnswer(ctx, dbpostgres.AdminUpdateFAQQuestionAnswerParams{
+		Answer:      arg.Answer,
+		Question:    arg.Question,
+		CategoryID:  arg.CategoryID,
+		Description: arg.Description,
+		ID:          arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateForumCategory(ctx context.Context, arg AdminUpdateForumCategoryParams) error {
+	return s.q.AdminUpdateForumCategory(ctx, dbpostgres.AdminUpdateForumCategoryParams{
+		Title:           arg.Title,
+		Description:     arg.Description,
+		ParentID:        arg.ParentID,
+		LanguageID:      arg.LanguageID,
+		Idforumcategory: arg.Idforumcategory,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateForumTopic(ctx context.Context, arg AdminUpdateForumTopicParams) error {
+	return s.q.AdminUpdateForumTopic(ctx, dbpostgres.AdminUpdateForumTopicParams{
+		Title:                        arg.Title,
+		Description:                  arg.Description,
+		ForumcategoryIdforumcategory: arg.ForumcategoryIdforumcategory,
+		TopicLanguageID:              arg.TopicLanguageID,
+		Idforumtopic:                 arg.Idforumtopic,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateGrantActive(ctx context.Context, arg AdminUpdateGrantActiveParams) error {
+	return s.q.AdminUpdateGrantActive(ctx, dbpostgres.AdminUpdateGrantActiveParams{
+		Active: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active),
+		ID: arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateGrantItemIDBySectionAndTopicID(ctx context.Context, arg AdminUpdateGrantItemIDBySectionAndTopicIDParams) error {
+	return s.q.AdminUpdateGrantItemIDBySectionAndTopicID(ctx, dbpostgres.AdminUpdateGrantItemIDBySectionAndTopicIDParams{
+		ItemID:   arg.ItemID,
+		ItemID_2: arg.ItemID_2,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateImageBoard(ctx context.Context, arg AdminUpdateImageBoardParams) error {
+	return s.q.AdminUpdateImageBoard(ctx, dbpostgres.AdminUpdateImageBoardParams{
+		Title:                  arg.Title,
+		Description:            arg.Description,
+		ImageboardIdimageboard: arg.ImageboardIdimageboard,
+		ApprovalRequired: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.ApprovalRequired),
+		Idimageboard: arg.Idimageboard,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateImagePost(ctx context.Context, arg AdminUpdateImagePostParams) error {
+	return s.q.AdminUpdateImagePost(ctx, dbpostgres.AdminUpdateImagePostParams{
+		ImageboardIdimageboard: arg.ImageboardIdimageboard,
+		Description:            arg.Description,
+		Approved: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Approved),
+		Idimagepost: arg.Idimagepost,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateLinkerCategorySortOrder(ctx context.Context, arg AdminUpdateLinkerCategorySortOrderParams) error {
+	return s.q.AdminUpdateLinkerCategorySortOrder(ctx, dbpostgres.AdminUpdateLinkerCategorySortOrderParams{
+		Sortorder: arg.Sortorder,
+		ID:        arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateLinkerItem(ctx context.Context, arg AdminUpdateLinkerItemParams) error {
+	return s.q.AdminUpdateLinkerItem(ctx, dbpostgres.AdminUpdateLinkerItemParams{
+		Title:       arg.Title,
+		Url:         arg.Url,
+		Description: arg.Description,
+		CategoryID:  arg.CategoryID,
+		LanguageID:  arg.LanguageID,
+		ID:          arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateLinkerQueuedItem(ctx context.Context, arg AdminUpdateLinkerQueuedItemParams) error {
+	return s.q.AdminUpdateLinkerQueuedItem(ctx, dbpostgres.AdminUpdateLinkerQueuedItemParams{
+		CategoryID:  arg.CategoryID,
+		Title:       arg.Title,
+		Url:         arg.Url,
+		Description: arg.Description,
+		ID:          arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateRequestStatus(ctx context.Context, arg AdminUpdateRequestStatusParams) error {
+	return s.q.AdminUpdateRequestStatus(ctx, dbpostgres.AdminUpdateRequestStatusParams{
+		Status: arg.Status,
+		ID:     arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateRequestStatusByTableAndRow(ctx context.Context, arg AdminUpdateRequestStatusByTableAndRowParams) error {
+	return s.q.AdminUpdateRequestStatusByTableAndRow(ctx, dbpostgres.AdminUpdateRequestStatusByTableAndRowParams{
+		Status:      arg.Status,
+		ChangeTable: arg.ChangeTable,
+		ChangeRowID: arg.ChangeRowID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateRole(ctx context.Context, arg AdminUpdateRoleParams) error {
+	return s.q.AdminUpdateRole(ctx, dbpostgres.AdminUpdateRoleParams{
+		Name: arg.Name,
+		CanLogin: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.CanLogin),
+		IsAdmin: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.IsAdmin),
+		PrivateLabels: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.PrivateLabels),
+		PublicProfileAllowedAt: arg.PublicProfileAllowedAt,
+		ID:                     arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateRolePublicProfileAllowed(ctx context.Context, arg AdminUpdateRolePublicProfileAllowedParams) error {
+	return s.q.AdminUpdateRolePublicProfileAllowed(ctx, dbpostgres.AdminUpdateRolePublicProfileAllowedParams{
+		PublicProfileAllowedAt: arg.PublicProfileAllowedAt,
+		ID:                     arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateUploadedImagePath(ctx context.Context, arg AdminUpdateUploadedImagePathParams) error {
+	return s.q.AdminUpdateUploadedImagePath(ctx, dbpostgres.AdminUpdateUploadedImagePathParams{
+		Path:            arg.Path,
+		Iduploadedimage: arg.Iduploadedimage,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateUserEmail(ctx context.Context, arg AdminUpdateUserEmailParams) error {
+	return s.q.AdminUpdateUserEmail(ctx, dbpostgres.AdminUpdateUserEmailParams{
+		Email:  arg.Email,
+		UserID: arg.UserID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateUserEmailDetails(ctx context.Context, arg AdminUpdateUserEmailDetailsParams) error {
+	return s.q.AdminUpdateUserEmailDetails(ctx, dbpostgres.AdminUpdateUserEmailDetailsParams{
+		Email:                arg.Email,
+		VerifiedAt:           arg.VerifiedAt,
+		NotificationPriority: arg.NotificationPriority,
+		ID:                   arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateUserRole(ctx context.Context, arg AdminUpdateUserRoleParams) error {
+	return s.q.AdminUpdateUserRole(ctx, dbpostgres.AdminUpdateUserRoleParams{
+		Name:        arg.Name,
+		IduserRoles: arg.IduserRoles,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateUsernameByID(ctx context.Context, arg AdminUpdateUsernameByIDParams) error {
+	return s.q.AdminUpdateUsernameByID(ctx, dbpostgres.AdminUpdateUsernameByIDParams{
+		Username: arg.Username,
+		Idusers:  arg.Idusers,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateWebhook(ctx context.Context, arg AdminUpdateWebhookParams) error {
+	return s.q.AdminUpdateWebhook(ctx, dbpostgres.AdminUpdateWebhookParams{
+		Name:     arg.Name,
+		Url:      arg.Url,
+		Secret:   arg.Secret,
+		Patterns: arg.Patterns,
+		Active: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active),
+		ID: arg.ID,
+	})
+}
+
+func (s *postgresQuerier) AdminUpdateWritingCategory(ctx context.Context, arg AdminUpdateWritingCategoryParams) error {
+	return s.q.AdminUpdateWritingCategory(ctx, dbpostgres.AdminUpdateWritingCategoryParams{
+		Title:             arg.Title,
+		Description:       arg.Description,
+		WritingCategoryID: arg.WritingCategoryID,
+		Idwritingcategory: arg.Idwritingcategory,
+	})
+}
+
+func (s *postgresQuerier) AdminUserPostCounts(ctx context.Context) ([]*AdminUserPostCountsRow, error) {
+	res, err := s.q.AdminUserPostCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminUserPostCountsRow) []*AdminUserPostCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminUserPostCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminUserPostCountsRow{
+				Idusers:  item.Idusers,
+				Username: item.Username,
+				Blogs:    item.Blogs,
+				News:     item.News,
+				Comments: item.Comments,
+				Images:   item.Images,
+				Links:    item.Links,
+				Writings: item.Writings,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminUserPostCountsByID(ctx context.Context, idusers int32) (*AdminUserPostCountsByIDRow, error) {
+	res, err := s.q.AdminUserPostCountsByID(ctx, idusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminUserPostCountsByIDRow) *AdminUserPostCountsByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &AdminUserPostCountsByIDRow{
+			Blogs:    v.Blogs,
+			News:     v.News,
+			Comments: v.Comments,
+			Images:   v.Images,
+			Links:    v.Links,
+			Writings: v.Writings,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminWordListWithCounts(ctx context.Context, arg AdminWordListWithCountsParams) ([]*AdminWordListWithCountsRow, error) {
+	res, err := s.q.AdminWordListWithCounts(ctx, dbpostgres.AdminWordListWithCountsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminWordListWithCountsRow) []*AdminWordListWithCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminWordListWithCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminWordListWithCountsRow{
+				Word:  item.Word,
+				Count: item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminWordListWithCountsByPrefix(ctx context.Context, arg AdminWordListWithCountsByPrefixParams) ([]*AdminWordListWithCountsByPrefixRow, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.AdminWordListWithCountsByPrefixParams{
+		Prefix: conv.toString(arg.Prefix),
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	}
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	res, err := s.q.AdminWordListWithCountsByPrefix(ctx, pgArg1)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminWordListWithCountsByPrefixRow) []*AdminWordListWithCountsByPrefixRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminWordListWithCountsByPrefixRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminWordListWithCountsByPrefixRow{
+				Word:  item.Word,
+				Count: item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) AdminWritingCategoryCounts(ctx context.Context) ([]*AdminWritingCategoryCountsRow, error) {
+	res, err := s.q.AdminWritingCategoryCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminWritingCategoryCountsRow) []*AdminWritingCategoryCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminWritingCategoryCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminWritingCategoryCountsRow{
+				Idwritingcategory: item.Idwritingcategory,
+				Title:             item.Title,
+				Count:             item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) CheckUserHasGrant(ctx context.Context, arg CheckUserHasGrantParams) (bool, error) {
+	res, err := s.q.CheckUserHasGrant(ctx, dbpostgres.CheckUserHasGrantParams{
+		UserID:  arg.UserID,
+		Section: arg.Section,
+		Item:    arg.Item,
+		Action:  arg.Action,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ClearUnreadContentPrivateLabelExceptUser(ctx context.Context, arg ClearUnreadContentPrivateLabelExceptUserParams) error {
+	return s.q.ClearUnreadContentPrivateLabelExceptUser(ctx, dbpostgres.ClearUnreadContentPrivateLabelExceptUserParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		UserID: arg.UserID,
+	})
+}
+
+func (s *postgresQuerier) CountContentRevisionsByItem(ctx context.Context, arg CountContentRevisionsByItemParams) (int64, error) {
+	res, err := s.q.CountContentRevisionsByItem(ctx, dbpostgres.CountContentRevisionsByItemParams{
+		ItemType: arg.ItemType,
+		ItemID:   arg.ItemID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) CountForumPollVoters(ctx context.Context, pollID int32) (int64, error) {
+	res, err := s.q.CountForumPollVoters(ctx, pollID)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) CountPendingContentReportsForReporter(ctx context.Context, arg CountPendingContentReportsForReporterParams) (int64, error) {
+	res, err := s.q.CountPendingContentReportsForReporter(ctx, dbpostgres.CountPendingContentReportsForReporterParams{
+		ReporterID: arg.ReporterID,
+		ItemType:   arg.ItemType,
+		ItemID:     arg.ItemID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) CountUnreadPrivateThreadsForUser(ctx context.Context, arg CountUnreadPrivateThreadsForUserParams) (int64, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.CountUnreadPrivateThreadsForUserParams{
+		TopicIDNull: conv.toNullInt32(arg.TopicIDNull),
+		TopicIDVal:  arg.TopicIDVal,
+		GrantUserID: arg.GrantUserID,
+		GranteeID:   arg.GranteeID,
+	}
+	if conv.err != nil {
+		return 0, conv.err
+	}
+	res, err := s.q.CountUnreadPrivateThreadsForUser(ctx, pgArg1)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) CountUnusedRecoveryCodesForUser(ctx context.Context, usersIdusers int32) (int64, error) {
+	res, err := s.q.CountUnusedRecoveryCodesForUser(ctx, usersIdusers)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (int64, error) {
+	res, err := s.q.CreateAPIKey(ctx, dbpostgres.CreateAPIKeyParams{
+		UsersIdusers: arg.UsersIdusers,
+		ApiKey:       arg.ApiKey,
+		Name:         arg.Name,
+		Scopes:       arg.Scopes,
+		ExpiresAt:    arg.ExpiresAt,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateBlogEntryForWriter(ctx context.Context, arg CreateBlogEntryForWriterParams) (int64, error) {
+	res, err := s.q.CreateBlogEntryForWriter(ctx, dbpostgres.CreateBlogEntryForWriterParams{
+		UsersIdusers: arg.UsersIdusers,
+		LanguageID:   arg.LanguageID,
+		Blog:         arg.Blog,
+		Written:      arg.Written,
+		Timezone:     arg.Timezone,
+		UserID:       arg.UserID,
+		ListerID:     arg.ListerID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateBookmarksForLister(ctx context.Context, arg CreateBookmarksForListerParams) error {
+	return s.q.CreateBookmarksForLister(ctx, dbpostgres.CreateBookmarksForListerParams{
+		UsersIdusers: arg.UsersIdusers,
+		List: func(s sql.NullString) []byte {
+			if s.Valid {
+				return []byte(s.String)
+			}
+			return nil
+		}(arg.List),
+	})
+}
+
+func (s *postgresQuerier) CreateCommentInSectionForCommenter(ctx context.Context, arg CreateCommentInSectionForCommenterParams) (int64, error) {
+	res, err := s.q.CreateCommentInSectionForCommenter(ctx, dbpostgres.CreateCommentInSectionForCommenterParams{
+		LanguageID:    arg.LanguageID,
+		CommenterID:   arg.CommenterID.Int32,
+		ForumthreadID: arg.ForumthreadID,
+		Text:          arg.Text,
+		Written:       arg.Written,
+		Timezone:      arg.Timezone,
+		Section:       arg.Section,
+		ItemType:      arg.ItemType,
+		Action:        arg.Action,
+		ItemID:        arg.ItemID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateContentReportForReporter(ctx context.Context, arg CreateContentReportForReporterParams) (int64, error) {
+	res, err := s.q.CreateContentReportForReporter(ctx, dbpostgres.CreateContentReportForReporterParams{
+		ReporterID: arg.ReporterID,
+		ItemType:   arg.ItemType,
+		ItemID:     arg.ItemID,
+		AuthorID:   arg.AuthorID,
+		Reason:     arg.Reason,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateExternalLink(ctx context.Context, url string) (sql.Result, error) {
+	res, err := s.q.CreateExternalLink(ctx, url)
+	if errors.Is(err, sql.ErrNoRows) {
+		return postgresInsertResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return postgresInsertResult{id: int64(res), rows: 1}, nil
+}
+
+func (s *postgresQuerier) CreateFAQQuestionForWriter(ctx context.Context, arg CreateFAQQuestionForWriterParams) error {
+	return s.q.CreateFAQQuestionForWriter(ctx, dbpostgres.CreateFAQQuestionForWriterParams{
+		Question:   arg.Question,
+		WriterID:   arg.WriterID,
+		LanguageID: arg.LanguageID,
+		GranteeID:  arg.GranteeID,
+	})
+}
+
+func (s *postgresQuerier) CreateForumPollForCreator(ctx context.Context, arg CreateForumPollForCreatorParams) (int64, error) {
+	res, err := s.q.CreateForumPollForCreator(ctx, dbpostgres.CreateForumPollForCreatorParams{
+		ThreadID:          arg.ThreadID,
+		Question:          arg.Question,
+		MultipleChoice:    arg.MultipleChoice,
+		Anonymous:         arg.Anonymous,
+		ResultsVisibility: arg.ResultsVisibility,
+		ClosesAt:          arg.ClosesAt,
+		CreatorID:         arg.CreatorID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateForumPollOption(ctx context.Context, arg CreateForumPollOptionParams) error {
+	return s.q.CreateForumPollOption(ctx, dbpostgres.CreateForumPollOptionParams{
+		PollID:   arg.PollID,
+		Position: arg.Position,
+		Label:    arg.Label,
+	})
+}
+
+func (s *postgresQuerier) CreateForumTopicForPoster(ctx context.Context, arg CreateForumTopicForPosterParams) (int64, error) {
+	res, err := s.q.CreateForumTopicForPoster(ctx, dbpostgres.CreateForumTopicForPosterParams{
+		ForumcategoryID: arg.ForumcategoryID,
+		ForumLang:       arg.ForumLang,
+		Title:           arg.Title,
+		Description:     arg.Description,
+		Handler:         arg.Handler,
+		Section:         arg.Section,
+		GrantCategoryID: arg.GrantCategoryID,
+		GranteeID:       arg.GranteeID,
+		PosterID:        arg.PosterID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateGrant(ctx context.Context, arg CreateGrantParams) error {
+	return s.q.CreateGrant(ctx, dbpostgres.CreateGrantParams{
+		RoleID:   arg.RoleID,
+		Section:  arg.Section,
+		Item:     arg.Item,
+		RuleType: arg.RuleType,
+		ItemID:   arg.ItemID,
+		ItemRule: arg.ItemRule,
+		Action:   arg.Action,
+		Extra:    arg.Extra,
+		Active: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active),
+	})
+}
+
+func (s *postgresQuerier) CreateImagePostForPoster(ctx context.Context, arg CreateImagePostForPosterParams) (int64, error) {
+	res, err := s.q.CreateImagePostForPoster(ctx, dbpostgres.CreateImagePostForPosterParams{
+		ImageboardID: arg.ImageboardID,
+		Thumbnail:    arg.Thumbnail,
+		Fullimage:    arg.Fullimage,
+		PosterID:     arg.PosterID,
+		Description:  arg.Description,
+		Posted:       arg.Posted,
+		Timezone:     arg.Timezone,
+		Approved: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Approved),
+		FileSize:     arg.FileSize,
+		GrantBoardID: arg.GrantBoardID,
+		GranteeID:    arg.GranteeID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateLinkerQueuedItemForWriter(ctx context.Context, arg CreateLinkerQueuedItemForWriterParams) error {
+	return s.q.CreateLinkerQueuedItemForWriter(ctx, dbpostgres.CreateLinkerQueuedItemForWriterParams{
+		WriterID:        arg.WriterID,
+		CategoryID:      arg.CategoryID,
+		Title:           arg.Title,
+		Url:             arg.Url,
+		Description:     arg.Description,
+		Timezone:        arg.Timezone,
+		GrantCategoryID: arg.GrantCategoryID,
+		GranteeID:       arg.GranteeID,
+	})
+}
+
+func (s *postgresQuerier) CreateNewsPostForWriter(ctx context.Context, arg CreateNewsPostForWriterParams) (int64, error) {
+	res, err := s.q.CreateNewsPostForWriter(ctx, dbpostgres.CreateNewsPostForWriterParams{
+		News:       arg.News,
+		WriterID:   arg.WriterID,
+		Occurred:   arg.Occurred,
+		Timezone:   arg.Timezone,
+		LanguageID: arg.LanguageID,
+		GranteeID:  arg.GranteeID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreatePasswordResetForUser(ctx context.Context, arg CreatePasswordResetForUserParams) error {
+	return s.q.CreatePasswordResetForUser(ctx, dbpostgres.CreatePasswordResetForUserParams{
+		UserID:           arg.UserID,
+		Passwd:           arg.Passwd,
+		PasswdAlgorithm:  arg.PasswdAlgorithm,
+		VerificationCode: arg.VerificationCode,
+	})
+}
+
+func (s *postgresQuerier) CreatePendingImageCacheEntry(ctx context.Context, arg CreatePendingImageCacheEntryParams) error {
+	return s.q.CreatePendingImageCacheEntry(ctx, dbpostgres.CreatePendingImageCacheEntryParams{
+		ID:            arg.ID,
+		SourceUrl:     arg.SourceUrl,
+		SourceKind:    arg.SourceKind,
+		CreatedAt:     arg.CreatedAt,
+		LastUsedAt:    arg.LastUsedAt,
+		NextAttemptAt: arg.NextAttemptAt,
+	})
+}
+
+func (s *postgresQuerier) CreateSubscriptionArchetype(ctx context.Context, arg CreateSubscriptionArchetypeParams) error {
+	return s.q.CreateSubscriptionArchetype(ctx, dbpostgres.CreateSubscriptionArchetypeParams{
+		RoleID:        arg.RoleID,
+		ArchetypeName: arg.ArchetypeName,
+		Pattern:       arg.Pattern,
+		Method:        arg.Method,
+	})
+}
+
+func (s *postgresQuerier) CreateThreadImage(ctx context.Context, arg CreateThreadImageParams) error {
+	return s.q.CreateThreadImage(ctx, dbpostgres.CreateThreadImageParams{
+		ThreadID: arg.ThreadID,
+		Path:     arg.Path,
+	})
+}
+
+func (s *postgresQuerier) CreateUploadedImageForUploader(ctx context.Context, arg CreateUploadedImageForUploaderParams) (int64, error) {
+	res, err := s.q.CreateUploadedImageForUploader(ctx, dbpostgres.CreateUploadedImageForUploaderParams{
+		UploaderID: arg.UploaderID,
+		Path:       arg.Path,
+		Width:      arg.Width,
+		Height:     arg.Height,
+		FileSize:   arg.FileSize,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) CreateWritingForWriter(ctx context.Context, arg CreateWritingForWriterParams) (int64, error) {
+	res, err := s.q.CreateWritingForWriter(ctx, dbpostgres.CreateWritingForWriterParams{
+		WritingCategoryID: arg.WritingCategoryID,
+		Title:             arg.Title,
+		Abstract:          arg.Abstract,
+		Writing:           arg.Writing,
+		Private: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Private.Bool), Valid: arg.Private.Valid},
+		LanguageID:      arg.LanguageID,
+		Published:       arg.Published,
+		Timezone:        arg.Timezone,
+		WriterID:        arg.WriterID,
+		GrantCategoryID: arg.GrantCategoryID,
+		GranteeID:       arg.GranteeID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) DeactivateNewsPost(ctx context.Context, idsitenews int32) error {
+	return s.q.DeactivateNewsPost(ctx, idsitenews)
+}
+
+func (s *postgresQuerier) DeleteDraftForUser(ctx context.Context, arg DeleteDraftForUserParams) error {
+	return s.q.DeleteDraftForUser(ctx, dbpostgres.DeleteDraftForUserParams{
+		UserID: arg.UserID,
+		Target: arg.Target,
+	})
+}
+
+func (s *postgresQuerier) DeleteForumPollVotesForVoter(ctx context.Context, arg DeleteForumPollVotesForVoterParams) error {
+	return s.q.DeleteForumPollVotesForVoter(ctx, dbpostgres.DeleteForumPollVotesForVoterParams{
+		PollID:  arg.PollID,
+		VoterID: arg.VoterID,
+	})
+}
+
+func (s *postgresQuerier) DeleteGrantByProperties(ctx context.Context, arg DeleteGrantByPropertiesParams) error {
+	return s.q.DeleteGrantByProperties(ctx, dbpostgres.DeleteGrantByPropertiesParams{
+		RoleID:  arg.RoleID,
+		Section: arg.Section,
+		Item:    arg.Item,
+		Action:  arg.Action,
+	})
+}
+
+func (s *postgresQuerier) DeleteGrantsByRoleID(ctx context.Context, roleID sql.NullInt32) error {
+	return s.q.DeleteGrantsByRoleID(ctx, roleID)
+}
+
+func (s *postgresQuerier) DeleteImageCacheEntry(ctx context.Context, id string) error {
+	return s.q.DeleteImageCacheEntry(ctx, id)
+}
+
+func (s *postgresQuerier) DeleteNotificationForLister(ctx context.Context, arg DeleteNotificationForListerParams) error {
+	return s.q.DeleteNotificationForLister(ctx, dbpostgres.DeleteNotificationForListerParams{
+		ID:       arg.ID,
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) DeletePasskey(ctx context.Context, arg DeletePasskeyParams) error {
+	return s.q.DeletePasskey(ctx, dbpostgres.DeletePasskeyParams{
+		ID:     arg.ID,
+		UserID: arg.UserID,
+	})
+}
+
+func (s *postgresQuerier) DeletePendingPassword(ctx context.Context, userID int32) error {
+	return s.q.DeletePendingPassword(ctx, userID)
+}
+
+func (s *postgresQuerier) DeleteReactionForReactor(ctx context.Context, arg DeleteReactionForReactorParams) (int64, error) {
+	res, err := s.q.DeleteReactionForReactor(ctx, dbpostgres.DeleteReactionForReactorParams{
+		ItemType:  arg.ItemType,
+		ItemID:    arg.ItemID,
+		ReactorID: arg.ReactorID,
+		Reaction:  arg.Reaction,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) DeleteRecoveryCodesForUser(ctx context.Context, usersIdusers int32) error {
+	return s.q.DeleteRecoveryCodesForUser(ctx, usersIdusers)
+}
+
+func (s *postgresQuerier) DeleteSubscriptionArchetypesByRoleAndName(ctx context.Context, arg DeleteSubscriptionArchetypesByRoleAndNameParams) error {
+	return s.q.DeleteSubscriptionArchetypesByRoleAndName(ctx, dbpostgres.DeleteSubscriptionArchetypesByRoleAndNameParams{
+		RoleID:        arg.RoleID,
+		ArchetypeName: arg.ArchetypeName,
+	})
+}
+
+func (s *postgresQuerier) DeleteSubscriptionByIDForSubscriber(ctx context.Context, arg DeleteSubscriptionByIDForSubscriberParams) error {
+	return s.q.DeleteSubscriptionByIDForSubscriber(ctx, dbpostgres.DeleteSubscriptionByIDForSubscriberParams{
+		SubscriberID: arg.SubscriberID,
+		ID:           arg.ID,
+	})
+}
+
+func (s *postgresQuerier) DeleteSubscriptionForSubscriber(ctx context.Context, arg DeleteSubscriptionForSubscriberParams) error {
+	return s.q.DeleteSubscriptionForSubscriber(ctx, dbpostgres.DeleteSubscriptionForSubscriberParams{
+		SubscriberID: arg.SubscriberID,
+		Pattern:      arg.Pattern,
+		Method:       arg.Method,
+	})
+}
+
+func (s *postgresQuerier) DeleteTOTPForUser(ctx context.Context, usersIdusers int32) error {
+	return s.q.DeleteTOTPForUser(ctx, usersIdusers)
+}
+
+func (s *postgresQuerier) DeleteThreadsByTopicID(ctx context.Context, forumtopicIdforumtopic int32) error {
+	return s.q.DeleteThreadsByTopicID(ctx, forumtopicIdforumtopic)
+}
+
+func (s *postgresQuerier) DeleteUserEmailForOwner(ctx context.Context, arg DeleteUserEmailForOwnerParams) error {
+	return s.q.DeleteUserEmailForOwner(ctx, dbpostgres.DeleteUserEmailForOwnerParams{
+		ID:      arg.ID,
+		OwnerID: arg.OwnerID,
+	})
+}
+
+func (s *postgresQuerier) DeleteUserLanguagesForUser(ctx context.Context, userID int32) error {
+	return s.q.DeleteUserLanguagesForUser(ctx, userID)
+}
+
+func (s *postgresQuerier) EnableTOTPForUser(ctx context.Context, arg EnableTOTPForUserParams) error {
+	return s.q.EnableTOTPForUser(ctx, dbpostgres.EnableTOTPForUserParams{
+		Step:   arg.Step,
+		UserID: arg.UserID,
+	})
+}
+
+func (s *postgresQuerier) EnsureExternalLink(ctx context.Context, url string) (sql.Result, error) {
+	res, err := s.q.EnsureExternalLink(ctx, url)
+	if errors.Is(err, sql.ErrNoRows) {
+		return postgresInsertResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return postgresInsertResult{id: int64(res), rows: 1}, nil
+}
+
+func (s *postgresQuerier) GetAPIKeyByHash(ctx context.Context, apiKey string) (*ApiKey, error) {
+	res, err := s.q.GetAPIKeyByHash(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ApiKey) *ApiKey {
+		if v == nil {
+			return nil
+		}
+		return &ApiKey{
+			ID:           v.ID,
+			UsersIdusers: v.UsersIdusers,
+			ApiKey:       v.ApiKey,
+			Name:         v.Name,
+			Scopes:       v.Scopes,
+			ExpiresAt:    v.ExpiresAt,
+			LastUsedAt:   v.LastUsedAt,
+			CreatedAt:    v.CreatedAt,
+			RevokedAt:    v.RevokedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetActiveAnnouncementWithNewsForLister(ctx context.Context, arg GetActiveAnnouncementWithNewsForListerParams) (*GetActiveAnnouncementWithNewsForListerRow, error) {
+	res, err := s.q.GetActiveAnnouncementWithNewsForLister(ctx, dbpostgres.GetActiveAnnouncementWithNewsForListerParams{
+		ListerID: arg.ListerID,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetActiveAnnouncementWithNewsForListerRow) *GetActiveAnnouncementWithNewsForListerRow {
+		if v == nil {
+			return nil
+		}
+		return &GetActiveAnnouncementWithNewsForListerRow{
+			ID:         v.ID,
+			Idsitenews: v.Idsitenews,
+			News:       v.News,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAdministratorUserRole(ctx context.Context, usersIdusers int32) (*UserRole, error) {
+	res, err := s.q.GetAdministratorUserRole(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserRole) *UserRole {
+		if v == nil {
+			return nil
+		}
+		return &UserRole{
+			IduserRoles:  v.IduserRoles,
+			UsersIdusers: v.UsersIdusers,
+			RoleID:       v.RoleID,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllAnsweredFAQWithFAQCategoriesForUser(ctx context.Context, arg GetAllAnsweredFAQWithFAQCategoriesForUserParams) ([]*GetAllAnsweredFAQWithFAQCategoriesForUserRow, error) {
+	res, err := s.q.GetAllAnsweredFAQWithFAQCategoriesForUser(ctx, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllAnsweredFAQWithFAQCategoriesForUserRow) []*GetAllAnsweredFAQWithFAQCategoriesForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllAnsweredFAQWithFAQCategoriesForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllAnsweredFAQWithFAQCategoriesForUserRow{
+				CategoryID:   item.CategoryID,
+				Name:         item.Name,
+				FaqID:        item.FaqID,
+				CategoryID_2: item.CategoryID_2,
+				LanguageID:   item.LanguageID,
+				AuthorID:     item.AuthorID,
+				Answer:       item.Answer,
+				Question:     item.Question,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllCommentsForIndex(ctx context.Context) ([]*GetAllCommentsForIndexRow, error) {
+	res, err := s.q.GetAllCommentsForIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllCommentsForIndexRow) []*GetAllCommentsForIndexRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllCommentsForIndexRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllCommentsForIndexRow{
+				Idcomments: item.Idcomments,
+				Text:       item.Text,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllForumCategories(ctx context.Context, arg GetAllForumCategoriesParams) ([]*Forumcategory, error) {
+	res, err := s.q.GetAllForumCategories(ctx, arg.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Forumcategory) []*Forumcategory {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Forumcategory, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Forumcategory{
+				Idforumcategory:              item.Idforumcategory,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				DeletedAt:                    item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllForumCategoriesWithSubcategoryCount(ctx context.Context, arg GetAllForumCategoriesWithSubcategoryCountParams) ([]*GetAllForumCategoriesWithSubcategoryCountRow, error) {
+	res, err := s.q.GetAllForumCategoriesWithSubcategoryCount(ctx, arg.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllForumCategoriesWithSubcategoryCountRow) []*GetAllForumCategoriesWithSubcategoryCountRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllForumCategoriesWithSubcategoryCountRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllForumCategoriesWithSubcategoryCountRow{
+				Idforumcategory:              item.Idforumcategory,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				DeletedAt:                    item.DeletedAt,
+				Subcategorycount:             item.Subcategorycount,
+				Topiccount:                   item.Topiccount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllForumThreadsWithTopic(ctx context.Context) ([]*GetAllForumThreadsWithTopicRow, error) {
+	res, err := s.q.GetAllForumThreadsWithTopic(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllForumThreadsWithTopicRow) []*GetAllForumThreadsWithTopicRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllForumThreadsWithTopicRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllForumThreadsWithTopicRow{
+				Idforumthread:          item.Idforumthread,
+				Firstpost:              item.Firstpost,
+				Lastposter:             item.Lastposter,
+				ForumtopicIdforumtopic: item.ForumtopicIdforumtopic,
+				Comments:               item.Comments,
+				Lastaddition:           item.Lastaddition,
+				Locked:                 sql.NullBool{Bool: item.Locked.Int32 != 0, Valid: item.Locked.Valid},
+				ReplyToCommentID:       item.ReplyToCommentID,
+				ReplyToThreadID:        item.ReplyToThreadID,
+				DeletedAt:              item.DeletedAt,
+				TopicTitle:             item.TopicTitle,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllForumTopics(ctx context.Context, arg GetAllForumTopicsParams) ([]*Forumtopic, error) {
+	res, err := s.q.GetAllForumTopics(ctx, arg.ViewerID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Forumtopic) []*Forumtopic {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Forumtopic, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Forumtopic{
+				Idforumtopic:                 item.Idforumtopic,
+				Lastposter:                   item.Lastposter,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				Threads:                      item.Threads,
+				Comments:                     item.Comments,
+				Lastaddition:                 item.Lastaddition,
+				Handler:                      item.Handler,
+				DeletedAt:                    item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllForumTopicsByCategoryIdForUserWithLastPosterName(ctx context.Context, arg GetAllForumTopicsByCategoryIdForUserWithLastPosterNameParams) ([]*GetAllForumTopicsByCategoryIdForUserWithLastPosterNameRow, error) {
+	res, err := s.q.GetAllForumTopicsByCategoryIdForUserWithLastPosterName(ctx, dbpostgres.GetAllForumTopicsByCategoryIdForUserWithLastPosterNameParams{
+		CategoryID:    arg.CategoryID,
+		ViewerMatchID: arg.ViewerMatchID,
+		ViewerID:      arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllForumTopicsByCategoryIdForUserWithLastPosterNameRow) []*GetAllForumTopicsByCategoryIdForUserWithLastPosterNameRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllForumTopicsByCategoryIdForUserWithLastPosterNameRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllForumTopicsByCategoryIdForUserWithLastPosterNameRow{
+				Idforumtopic:                 item.Idforumtopic,
+				Lastposter:                   item.Lastposter,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				Threads:                      item.Threads,
+				Comments:                     item.Comments,
+				Lastaddition:                 item.Lastaddition,
+				Handler:                      item.Handler,
+				DeletedAt:                    item.DeletedAt,
+				Lastposterusername:           item.Lastposterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllImagePostsForIndex(ctx context.Context) ([]*GetAllImagePostsForIndexRow, error) {
+	res, err := s.q.GetAllImagePostsForIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllImagePostsForIndexRow) []*GetAllImagePostsForIndexRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllImagePostsForIndexRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllImagePostsForIndexRow{
+				Idimagepost: item.Idimagepost,
+				Description: item.Description,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerCategories(ctx context.Context) ([]*LinkerCategory, error) {
+	res, err := s.q.GetAllLinkerCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.LinkerCategory) []*LinkerCategory {
+		if items == nil {
+			return nil
+		}
+		out := make([]*LinkerCategory, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &LinkerCategory{
+				ID:        item.ID,
+				Position:  item.Position,
+				Title:     item.Title,
+				Sortorder: item.Sortorder,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerCategoriesForUser(ctx context.Context, arg GetAllLinkerCategoriesForUserParams) ([]*LinkerCategory, error) {
+	res, err := s.q.GetAllLinkerCategoriesForUser(ctx, dbpostgres.GetAllLinkerCategoriesForUserParams{
+		ViewerID:     arg.ViewerID,
+		ViewerUserID: arg.ViewerUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.LinkerCategory) []*LinkerCategory {
+		if items == nil {
+			return nil
+		}
+		out := make([]*LinkerCategory, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &LinkerCategory{
+				ID:        item.ID,
+				Position:  item.Position,
+				Title:     item.Title,
+				Sortorder: item.Sortorder,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescending(ctx context.Context, arg GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingParams) ([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingRow, error) {
+	res, err := s.q.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescending(ctx, arg.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingRow) []*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingRow{
+				ID:             item.ID,
+				LanguageID:     item.LanguageID,
+				AuthorID:       item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ThreadID:       item.ThreadID,
+				Title:          item.Title,
+				Url:            item.Url,
+				Description:    item.Description,
+				Listed:         item.Listed,
+				Timezone:       item.Timezone,
+				Comments:       item.Comments,
+				CategoryTitle:  item.CategoryTitle,
+				Posterusername: item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUser(ctx context.Context, arg GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserParams) ([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserRow, error) {
+	res, err := s.q.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUser(ctx, dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserParams{
+		CategoryID:   arg.CategoryID,
+		ViewerID:     arg.ViewerID,
+		ViewerUserID: arg.ViewerUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserRow) []*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserRow{
+				ID:             item.ID,
+				LanguageID:     item.LanguageID,
+				AuthorID:       item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ThreadID:       item.ThreadID,
+				Title:          item.Title,
+				Url:            item.Url,
+				Description:    item.Description,
+				Listed:         item.Listed,
+				Timezone:       item.Timezone,
+				Comments:       item.Comments,
+				CategoryTitle:  item.CategoryTitle,
+				Posterusername: item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginated(ctx context.Context, arg GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedParams) ([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRow, error) {
+	res, err := s.q.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginated(ctx, dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedParams{
+		CategoryID:   arg.CategoryID,
+		Offset:       arg.Offset,
+		Limit:        arg.Limit,
+		ViewerID:     arg.ViewerID,
+		ViewerUserID: arg.ViewerUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRow) []*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRow{
+				ID:             item.ID,
+				LanguageID:     item.LanguageID,
+				AuthorID:       item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ThreadID:       item.ThreadID,
+				Title:          item.Title,
+				Url:            item.Url,
+				Description:    item.Description,
+				Listed:         item.Listed,
+				Timezone:       item.Timezone,
+				Comments:       item.Comments,
+				CategoryTitle:  item.CategoryTitle,
+				Posterusername: item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRow(ctx context.Context, arg GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRowParams) ([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRowRow, error) {
+	res, err := s.q.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRow(ctx, dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRowParams{
+		CategoryID:   arg.CategoryID,
+		Offset:       arg.Offset,
+		Limit:        arg.Limit,
+		ViewerID:     arg.ViewerID,
+		ViewerUserID: arg.ViewerUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRowRow) []*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRowRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRowRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingForUserPaginatedRowRow{
+				ID:             item.ID,
+				LanguageID:     item.LanguageID,
+				AuthorID:       item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ThreadID:       item.ThreadID,
+				Title:          item.Title,
+				Url:            item.Url,
+				Description:    item.Description,
+				Listed:         item.Listed,
+				Timezone:       item.Timezone,
+				Comments:       item.Comments,
+				CategoryTitle:  item.CategoryTitle,
+				Posterusername: item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginated(ctx context.Context, arg GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginatedParams) ([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginatedRow, error) {
+	res, err := s.q.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginated(ctx, dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginatedParams{
+		CategoryID: arg.CategoryID,
+		Offset:     arg.Offset,
+		Limit:      arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginatedRow) []*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginatedRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginatedRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllLinkerItemsByCategoryIdWitherPosterUsernameAndCategoryTitleDescendingPaginatedRow{
+				ID:             item.ID,
+				LanguageID:     item.LanguageID,
+				AuthorID:       item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ThreadID:       item.ThreadID,
+				Title:          item.Title,
+				Url:            item.Url,
+				Description:    item.Description,
+				Listed:         item.Listed,
+				Timezone:       item.Timezone,
+				Comments:       item.Comments,
+				CategoryTitle:  item.CategoryTitle,
+				Posterusername: item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkerQueuedItemsWithUserAndLinkerCategoryDetails(ctx context.Context) ([]*GetAllLinkerQueuedItemsWithUserAndLinkerCategoryDetailsRow, error) {
+	res, err := s.q.GetAllLinkerQueuedItemsWithUserAndLinkerCategoryDetails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllLinkerQueuedItemsWithUserAndLinkerCategoryDetailsRow) []*GetAllLinkerQueuedItemsWithUserAndLinkerCategoryDetailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllLinkerQueuedItemsWithUserAndLinkerCategoryDetailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllLinkerQueuedItemsWithUserAndLinkerCategoryDetailsRow{
+				ID:            item.ID,
+				LanguageID:    item.LanguageID,
+				SubmitterID:   item.SubmitterID,
+				CategoryID:    item.CategoryID,
+				Title:         item.Title,
+				Url:           item.Url,
+				Description:   item.Description,
+				Timezone:      item.Timezone,
+				Username:      item.Username,
+				CategoryTitle: item.CategoryTitle,
+				CategoryID_2:  item.CategoryID_2,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllLinkersForIndex(ctx context.Context) ([]*GetAllLinkersForIndexRow, error) {
+	res, err := s.q.GetAllLinkersForIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllLinkersForIndexRow) []*GetAllLinkersForIndexRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllLinkersForIndexRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllLinkersForIndexRow{
+				ID:          item.ID,
+				Title:       item.Title,
+				Description: item.Description,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllSiteNewsForIndex(ctx context.Context) ([]*GetAllSiteNewsForIndexRow, error) {
+	res, err := s.q.GetAllSiteNewsForIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllSiteNewsForIndexRow) []*GetAllSiteNewsForIndexRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllSiteNewsForIndexRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllSiteNewsForIndexRow{
+				Idsitenews: item.Idsitenews,
+				News:       item.News,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllWritingsByAuthorForLister(ctx context.Context, arg GetAllWritingsByAuthorForListerParams) ([]*GetAllWritingsByAuthorForListerRow, error) {
+	res, err := s.q.GetAllWritingsByAuthorForLister(ctx, dbpostgres.GetAllWritingsByAuthorForListerParams{
+		AuthorID:      arg.AuthorID,
+		ListerMatchID: arg.ListerMatchID,
+		ListerID:      arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllWritingsByAuthorForListerRow) []*GetAllWritingsByAuthorForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllWritingsByAuthorForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllWritingsByAuthorForListerRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetAllWritingsForIndex(ctx context.Context) ([]*GetAllWritingsForIndexRow, error) {
+	res, err := s.q.GetAllWritingsForIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetAllWritingsForIndexRow) []*GetAllWritingsForIndexRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetAllWritingsForIndexRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetAllWritingsForIndexRow{
+				Idwriting: item.Idwriting,
+				Title:     item.Title,
+				Abstract:  item.Abstract,
+				Writing:   item.Writing,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetBlogEntryForListerByID(ctx context.Context, arg GetBlogEntryForListerByIDParams) (*GetBlogEntryForListerByIDRow, error) {
+	var conv pgConv
+	res, err := s.q.GetBlogEntryForListerByID(ctx, dbpostgres.GetBlogEntryForListerByIDParams{
+		ListerID: arg.ListerID,
+		ID:       arg.ID,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := func(v *dbpostgres.GetBlogEntryForListerByIDRow) *GetBlogEntryForListerByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &GetBlogEntryForListerByIDRow{
+			Idblogs:       v.Idblogs,
+			ForumthreadID: v.ForumthreadID,
+			UsersIdusers:  v.UsersIdusers,
+			LanguageID:    v.LanguageID,
+			Blog:          v.Blog,
+			Written:       v.Written,
+			Timezone:      v.Timezone,
+			Username:      v.Username,
+			Comments:      v.Comments,
+			IsOwner:       v.IsOwner,
+			Title:         conv.toString(v.Title),
+		}
+	}(res)
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	return out, nil
+}
+
+func (s *postgresQuerier) GetBookmarksForUser(ctx context.Context, usersIdusers int32) (*GetBookmarksForUserRow, error) {
+	res, err := s.q.GetBookmarksForUser(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetBookmarksForUserRow) *GetBookmarksForUserRow {
+		if v == nil {
+			return nil
+		}
+		return &GetBookmarksForUserRow{
+			Idbookmarks: v.Idbookmarks,
+			List:        sql.NullString{String: string(v.List), Valid: v.List != nil},
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetCommentById(ctx context.Context, idcomments int32) (*Comment, error) {
+	res, err := s.q.GetCommentById(ctx, idcomments)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Comment) *Comment {
+		if v == nil {
+			return nil
+		}
+		return &Comment{
+			Idcomments:    v.Idcomments,
+			ForumthreadID: v.ForumthreadID,
+			UsersIdusers:  v.UsersIdusers,
+			LanguageID:    v.LanguageID,
+			Written:       v.Written,
+			Text:          v.Text,
+			Timezone:      v.Timezone,
+			DeletedAt:     v.DeletedAt,
+			LastIndex:     v.LastIndex,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetCommentByIdForUser(ctx context.Context, arg GetCommentByIdForUserParams) (*GetCommentByIdForUserRow, error) {
+	res, err := s.q.GetCommentByIdForUser(ctx, dbpostgres.GetCommentByIdForUserParams{
+		ViewerID: arg.ViewerID,
+		ID:       arg.ID,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetCommentByIdForUserRow) *GetCommentByIdForUserRow {
+		if v == nil {
+			return nil
+		}
+		return &GetCommentByIdForUserRow{
+			Idcomments:    v.Idcomments,
+			ForumthreadID: v.ForumthreadID,
+			UsersIdusers:  v.UsersIdusers,
+			LanguageID:    v.LanguageID,
+			Written:       v.Written,
+			Text:          v.Text,
+			Timezone:      v.Timezone,
+			DeletedAt:     v.DeletedAt,
+			LastIndex:     v.LastIndex,
+			Username:      v.Username,
+			IsOwner:       v.IsOwner,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetCommentsByIdsForUserWithThreadInfo(ctx context.Context, arg GetCommentsByIdsForUserWithThreadInfoParams) ([]*GetCommentsByIdsForUserWithThreadInfoRow, error) {
+	res, err := s.q.GetCommentsByIdsForUserWithThreadInfo(ctx, dbpostgres.GetCommentsByIdsForUserWithThreadInfoParams{
+		ViewerID: arg.ViewerID,
+		Ids:      arg.Ids,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetCommentsByIdsForUserWithThreadInfoRow) []*GetCommentsByIdsForUserWithThreadInfoRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetCommentsByIdsForUserWithThreadInfoRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetCommentsByIdsForUserWithThreadInfoRow{
+				Idcomments:         item.Idcomments,
+				ForumthreadID:      item.ForumthreadID,
+				UsersIdusers:       item.UsersIdusers,
+				LanguageID:         item.LanguageID,
+				Written:            item.Written,
+				Text:               item.Text,
+				Timezone:           item.Timezone,
+				DeletedAt:          item.DeletedAt,
+				LastIndex:          item.LastIndex,
+				Posterusername:     item.Posterusername,
+				IsOwner:            item.IsOwner,
+				Idforumthread:      item.Idforumthread,
+				Idforumtopic:       item.Idforumtopic,
+				ForumtopicTitle:    item.ForumtopicTitle,
+				ThreadTitle:        item.ThreadTitle,
+				Idforumcategory:    item.Idforumcategory,
+				ForumcategoryTitle: item.ForumcategoryTitle,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetCommentsBySectionThreadIdForUser(ctx context.Context, arg GetCommentsBySectionThreadIdForUserParams) ([]*GetCommentsBySectionThreadIdForUserRow, error) {
+	res, err := s.q.GetCommentsBySectionThreadIdForUser(ctx, dbpostgres.GetCommentsBySectionThreadIdForUserParams{
+		ViewerID: arg.ViewerID,
+		ThreadID: arg.ThreadID,
+		Section:  arg.Section,
+		ItemType: arg.ItemType,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetCommentsBySectionThreadIdForUserRow) []*GetCommentsBySectionThreadIdForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetCommentsBySectionThreadIdForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetCommentsBySectionThreadIdForUserRow{
+				Idcomments:     item.Idcomments,
+				ForumthreadID:  item.ForumthreadID,
+				UsersIdusers:   item.UsersIdusers,
+				LanguageID:     item.LanguageID,
+				Written:        item.Written,
+				Text:           item.Text,
+				Timezone:       item.Timezone,
+				DeletedAt:      item.DeletedAt,
+				LastIndex:      item.LastIndex,
+				Posterusername: item.Posterusername,
+				IsOwner:        item.IsOwner,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetCommentsByThreadIdForUser(ctx context.Context, arg GetCommentsByThreadIdForUserParams) ([]*GetCommentsByThreadIdForUserRow, error) {
+	res, err := s.q.GetCommentsByThreadIdForUser(ctx, dbpostgres.GetCommentsByThreadIdForUserParams{
+		ViewerID: arg.ViewerID,
+		ThreadID: arg.ThreadID,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetCommentsByThreadIdForUserRow) []*GetCommentsByThreadIdForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetCommentsByThreadIdForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetCommentsByThreadIdForUserRow{
+				Idcomments:     item.Idcomments,
+				ForumthreadID:  item.ForumthreadID,
+				UsersIdusers:   item.UsersIdusers,
+				LanguageID:     item.LanguageID,
+				Written:        item.Written,
+				Text:           item.Text,
+				Timezone:       item.Timezone,
+				DeletedAt:      item.DeletedAt,
+				LastIndex:      item.LastIndex,
+				Posterusername: item.Posterusername,
+				IsOwner:        item.IsOwner,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetContentReadMarker(ctx context.Context, arg GetContentReadMarkerParams) (*GetContentReadMarkerRow, error) {
+	res, err := s.q.GetContentReadMarker(ctx, dbpostgres.GetContentReadMarkerParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		UserID: arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetContentReadMarkerRow) *GetContentReadMarkerRow {
+		if v == nil {
+			return nil
+		}
+		return &GetContentReadMarkerRow{
+			Item:          v.Item,
+			ItemID:        v.ItemID,
+			UserID:        v.UserID,
+			LastCommentID: v.LastCommentID,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetContentRevisionForItem(ctx context.Context, arg GetContentRevisionForItemParams) (*ContentRevision, error) {
+	res, err := s.q.GetContentRevisionForItem(ctx, dbpostgres.GetContentRevisionForItemParams{
+		ID:       arg.ID,
+		ItemType: arg.ItemType,
+		ItemID:   arg.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ContentRevision) *ContentRevision {
+		if v == nil {
+			return nil
+		}
+		return &ContentRevision{
+			ID:             v.ID,
+			ItemType:       v.ItemType,
+			ItemID:         v.ItemID,
+			UsersIdusers:   v.UsersIdusers,
+			Title:          v.Title,
+			Abstract:       v.Abstract,
+			Body:           v.Body,
+			RestoredFromID: v.RestoredFromID,
+			CreatedAt:      v.CreatedAt,
+			Timezone:       v.Timezone,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetDigestTimezones(ctx context.Context) ([]sql.NullString, error) {
+	res, err := s.q.GetDigestTimezones(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetDraftForUser(ctx context.Context, arg GetDraftForUserParams) (*Draft, error) {
+	res, err := s.q.GetDraftForUser(ctx, dbpostgres.GetDraftForUserParams{
+		UserID: arg.UserID,
+		Target: arg.Target,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Draft) *Draft {
+		if v == nil {
+			return nil
+		}
+		return &Draft{
+			ID:        v.ID,
+			UserID:    v.UserID,
+			Target:    v.Target,
+			Url:       v.Url,
+			Content:   v.Content,
+			UpdatedAt: v.UpdatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetEmailSuppressionByEmail(ctx context.Context, email string) (*EmailSuppression, error) {
+	res, err := s.q.GetEmailSuppressionByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.EmailSuppression) *EmailSuppression {
+		if v == nil {
+			return nil
+		}
+		return &EmailSuppression{
+			ID:        v.ID,
+			Email:     v.Email,
+			UserID:    v.UserID,
+			Reason:    v.Reason,
+			Detail:    v.Detail,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetExternalLink(ctx context.Context, url string) (*ExternalLink, error) {
+	res, err := s.q.GetExternalLink(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ExternalLink) *ExternalLink {
+		if v == nil {
+			return nil
+		}
+		return &ExternalLink{
+			ID:              v.ID,
+			Url:             v.Url,
+			UrlHash:         v.UrlHash,
+			Clicks:          v.Clicks,
+			CreatedAt:       v.CreatedAt,
+			UpdatedAt:       v.UpdatedAt,
+			UpdatedBy:       v.UpdatedBy,
+			CardTitle:       v.CardTitle,
+			CardDescription: v.CardDescription,
+			CardImage:       v.CardImage,
+			CardImageCache:  v.CardImageCache,
+			FaviconCache:    v.FaviconCache,
+			CardDuration:    v.CardDuration,
+			CardUploadDate:  v.CardUploadDate,
+			CardAuthor:      v.CardAuthor,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetExternalLinkByID(ctx context.Context, id int32) (*ExternalLink, error) {
+	res, err := s.q.GetExternalLinkByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ExternalLink) *ExternalLink {
+		if v == nil {
+			return nil
+		}
+		return &ExternalLink{
+			ID:              v.ID,
+			Url:             v.Url,
+			UrlHash:         v.UrlHash,
+			Clicks:          v.Clicks,
+			CreatedAt:       v.CreatedAt,
+			UpdatedAt:       v.UpdatedAt,
+			UpdatedBy:       v.UpdatedBy,
+			CardTitle:       v.CardTitle,
+			CardDescription: v.CardDescription,
+			CardImage:       v.CardImage,
+			CardImageCache:  v.CardImageCache,
+			FaviconCache:    v.FaviconCache,
+			CardDuration:    v.CardDuration,
+			CardUploadDate:  v.CardUploadDate,
+			CardAuthor:      v.CardAuthor,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetFAQAnsweredQuestions(ctx context.Context, arg GetFAQAnsweredQuestionsParams) ([]*GetFAQAnsweredQuestionsRow, error) {
+	res, err := s.q.GetFAQAnsweredQuestions(ctx, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetFAQAnsweredQuestionsRow) []*GetFAQAnsweredQuestionsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetFAQAnsweredQuestionsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetFAQAnsweredQuestionsRow{
+				ID:         item.ID,
+				CategoryID: item.CategoryID,
+				LanguageID: item.LanguageID,
+				AuthorID:   item.AuthorID,
+				Answer:     item.Answer,
+				Question:   item.Question,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetFAQByID(ctx context.Context, arg GetFAQByIDParams) (*GetFAQByIDRow, error) {
+	res, err := s.q.GetFAQByID(ctx, dbpostgres.GetFAQByIDParams{
+		FaqID:  arg.FaqID,
+		UserID: arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetFAQByIDRow) *GetFAQByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &GetFAQByIDRow{
+			ID:         v.ID,
+			CategoryID: v.CategoryID,
+			LanguageID: v.LanguageID,
+			AuthorID:   v.AuthorID,
+			Answer:     v.Answer,
+			Question:   v.Question,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetFAQQuestionsByCategory(ctx context.Context, arg GetFAQQuestionsByCategoryParams) ([]*GetFAQQuestionsByCategoryRow, error) {
+	res, err := s.q.GetFAQQuestionsByCategory(ctx, dbpostgres.GetFAQQuestionsByCategoryParams{
+		CategoryID: arg.CategoryID,
+		UserID:     arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetFAQQuestionsByCategoryRow) []*GetFAQQuestionsByCategoryRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetFAQQuestionsByCategoryRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetFAQQuestionsByCategoryRow{
+				ID:         item.ID,
+				CategoryID: item.CategoryID,
+				LanguageID: item.LanguageID,
+				AuthorID:   item.AuthorID,
+				Answer:     item.Answer,
+				Question:   item.Question,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetFAQRevisionsForAdmin(ctx context.Context, faqID int32) ([]*FaqRevision, error) {
+	res, err := s.q.GetFAQRevisionsForAdmin(ctx, faqID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.FaqRevision) []*FaqRevision {
+		if items == nil {
+			return nil
+		}
+		out := make([]*FaqRevision, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &FaqRevision{
+				ID:           item.ID,
+				FaqID:        item.FaqID,
+				UsersIdusers: item.UsersIdusers,
+				Question:     item.Question,
+				Answer:       item.Answer,
+				CreatedAt:    item.CreatedAt,
+				Timezone:     item.Timezone,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumCategoryById(ctx context.Context, arg GetForumCategoryByIdParams) (*Forumcategory, error) {
+	res, err := s.q.GetForumCategoryById(ctx, dbpostgres.GetForumCategoryByIdParams{
+		Idforumcategory: arg.Idforumcategory,
+		ViewerID:        arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Forumcategory) *Forumcategory {
+		if v == nil {
+			return nil
+		}
+		return &Forumcategory{
+			Idforumcategory:              v.Idforumcategory,
+			ForumcategoryIdforumcategory: v.ForumcategoryIdforumcategory,
+			LanguageID:                   v.LanguageID,
+			Title:                        v.Title,
+			Description:                  v.Description,
+			DeletedAt:                    v.DeletedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumPollByThreadID(ctx context.Context, threadID int32) (*ForumPoll, error) {
+	res, err := s.q.GetForumPollByThreadID(ctx, threadID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ForumPoll) *ForumPoll {
+		if v == nil {
+			return nil
+		}
+		return &ForumPoll{
+			ID:                v.ID,
+			ForumthreadID:     v.ForumthreadID,
+			Question:          v.Question,
+			MultipleChoice:    v.MultipleChoice,
+			Anonymous:         v.Anonymous,
+			ResultsVisibility: v.ResultsVisibility,
+			ClosesAt:          v.ClosesAt,
+			ClosedAt:          v.ClosedAt,
+			CreatedBy:         v.CreatedBy,
+			CreatedAt:         v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumThreadIdByNewsPostId(ctx context.Context, idsitenews int32) (*GetForumThreadIdByNewsPostIdRow, error) {
+	res, err := s.q.GetForumThreadIdByNewsPostId(ctx, idsitenews)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetForumThreadIdByNewsPostIdRow) *GetForumThreadIdByNewsPostIdRow {
+		if v == nil {
+			return nil
+		}
+		return &GetForumThreadIdByNewsPostIdRow{
+			ForumthreadID: v.ForumthreadID,
+			Idusers:       v.Idusers,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostText(ctx context.Context, arg GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextParams) ([]*GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextRow, error) {
+	res, err := s.q.GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostText(ctx, dbpostgres.GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextParams{
+		TopicID:       arg.TopicID,
+		ViewerMatchID: arg.ViewerMatchID,
+		ViewerID:      arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextRow) []*GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextRow{
+				Idforumthread:          item.Idforumthread,
+				Firstpost:              item.Firstpost,
+				Lastposter:             item.Lastposter,
+				ForumtopicIdforumtopic: item.ForumtopicIdforumtopic,
+				Comments:               item.Comments,
+				Lastaddition:           item.Lastaddition,
+				Locked:                 sql.NullBool{Bool: item.Locked.Int32 != 0, Valid: item.Locked.Valid},
+				ReplyToCommentID:       item.ReplyToCommentID,
+				ReplyToThreadID:        item.ReplyToThreadID,
+				DeletedAt:              item.DeletedAt,
+				Lastposterusername:     item.Lastposterusername,
+				Lastposterid:           item.Lastposterid,
+				Firstpostusername:      item.Firstpostusername,
+				Firstpostuserid:        item.Firstpostuserid,
+				Firstpostwritten:       item.Firstpostwritten,
+				Firstposttext:          item.Firstposttext,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumTopicById(ctx context.Context, idforumtopic int32) (*Forumtopic, error) {
+	res, err := s.q.GetForumTopicById(ctx, idforumtopic)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Forumtopic) *Forumtopic {
+		if v == nil {
+			return nil
+		}
+		return &Forumtopic{
+			Idforumtopic:                 v.Idforumtopic,
+			Lastposter:                   v.Lastposter,
+			ForumcategoryIdforumcategory: v.ForumcategoryIdforumcategory,
+			LanguageID:                   v.LanguageID,
+			Title:                        v.Title,
+			Description:                  v.Description,
+			Threads:                      v.Threads,
+			Comments:                     v.Comments,
+			Lastaddition:                 v.Lastaddition,
+			Handler:                      v.Handler,
+			DeletedAt:                    v.DeletedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumTopicByIdForUser(ctx context.Context, arg GetForumTopicByIdForUserParams) (*GetForumTopicByIdForUserRow, error) {
+	res, err := s.q.GetForumTopicByIdForUser(ctx, dbpostgres.GetForumTopicByIdForUserParams{
+		Idforumtopic:  arg.Idforumtopic,
+		ViewerMatchID: arg.ViewerMatchID,
+		ViewerID:      arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetForumTopicByIdForUserRow) *GetForumTopicByIdForUserRow {
+		if v == nil {
+			return nil
+		}
+		return &GetForumTopicByIdForUserRow{
+			Idforumtopic:                 v.Idforumtopic,
+			Lastposter:                   v.Lastposter,
+			ForumcategoryIdforumcategory: v.ForumcategoryIdforumcategory,
+			LanguageID:                   v.LanguageID,
+			Title:                        v.Title,
+			Description:                  v.Description,
+			Threads:                      v.Threads,
+			Comments:                     v.Comments,
+			Lastaddition:                 v.Lastaddition,
+			Handler:                      v.Handler,
+			DeletedAt:                    v.DeletedAt,
+			Lastposterusername:           v.Lastposterusername,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumTopicIdByThreadId(ctx context.Context, idforumthread int32) (int32, error) {
+	res, err := s.q.GetForumTopicIdByThreadId(ctx, idforumthread)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetForumTopicsByCategoryId(ctx context.Context, arg GetForumTopicsByCategoryIdParams) ([]*Forumtopic, error) {
+	res, err := s.q.GetForumTopicsByCategoryId(ctx, dbpostgres.GetForumTopicsByCategoryIdParams{
+		CategoryID: arg.CategoryID,
+		ViewerID:   arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Forumtopic) []*Forumtopic {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Forumtopic, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Forumtopic{
+				Idforumtopic:                 item.Idforumtopic,
+				Lastposter:                   item.Lastposter,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				Threads:                      item.Threads,
+				Comments:                     item.Comments,
+				Lastaddition:                 item.Lastaddition,
+				Handler:                      item.Handler,
+				DeletedAt:                    item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetForumTopicsForUser(ctx context.Context, arg GetForumTopicsForUserParams) ([]*GetForumTopicsForUserRow, error) {
+	res, err := s.q.GetForumTopicsForUser(ctx, dbpostgres.GetForumTopicsForUserParams{
+		ViewerMatchID: arg.ViewerMatchID,
+		ViewerID:      arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetForumTopicsForUserRow) []*GetForumTopicsForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetForumTopicsForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetForumTopicsForUserRow{
+				Idforumtopic:                 item.Idforumtopic,
+				Lastposter:                   item.Lastposter,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				Threads:                      item.Threads,
+				Comments:                     item.Comments,
+				Lastaddition:                 item.Lastaddition,
+				Handler:                      item.Handler,
+				DeletedAt:                    item.DeletedAt,
+				Lastposterusername:           item.Lastposterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetGrantsByRoleID(ctx context.Context, roleID sql.NullInt32) ([]*Grant, error) {
+	res, err := s.q.GetGrantsByRoleID(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Grant) []*Grant {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Grant, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Grant{
+				ID:        item.ID,
+				CreatedAt: item.CreatedAt,
+				UpdatedAt: item.UpdatedAt,
+				UserID:    item.UserID,
+				RoleID:    item.RoleID,
+				Section:   item.Section,
+				Item:      item.Item,
+				RuleType:  item.RuleType,
+				ItemID:    item.ItemID,
+				ItemRule:  item.ItemRule,
+				Action:    item.Action,
+				Extra:     item.Extra,
+				Active:    (item.Active != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetImageBoardById(ctx context.Context, idimageboard int32) (*Imageboard, error) {
+	res, err := s.q.GetImageBoardById(ctx, idimageboard)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Imageboard) *Imageboard {
+		if v == nil {
+			return nil
+		}
+		return &Imageboard{
+			Idimageboard:           v.Idimageboard,
+			ImageboardIdimageboard: v.ImageboardIdimageboard,
+			Title:                  v.Title,
+			Description:            v.Description,
+			ApprovalRequired:       (v.ApprovalRequired != 0),
+			DeletedAt:              v.DeletedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetImageCacheEntry(ctx context.Context, id string) (*ImageCacheEntry, error) {
+	res, err := s.q.GetImageCacheEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ImageCacheEntry) *ImageCacheEntry {
+		if v == nil {
+			return nil
+		}
+		return &ImageCacheEntry{
+			ID:               v.ID,
+			SourceUrl:        v.SourceUrl,
+			SourceKind:       v.SourceKind,
+			Status:           v.Status,
+			CreatedAt:        v.CreatedAt,
+			LastUsedAt:       v.LastUsedAt,
+			FetchedAt:        v.FetchedAt,
+			ExpiresAt:        v.ExpiresAt,
+			ContentExpiresAt: v.ContentExpiresAt,
+			ContentType:      v.ContentType,
+			SizeBytes:        v.SizeBytes,
+			Width:            v.Width,
+			Height:           v.Height,
+			Checksum:         v.Checksum,
+			ThumbnailID:      v.ThumbnailID,
+			UploadedImageID:  v.UploadedImageID,
+			ErrorMessage:     v.ErrorMessage,
+			RetryCount:       v.RetryCount,
+			LastAttemptAt:    v.LastAttemptAt,
+			NextAttemptAt:    v.NextAttemptAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetImagePostByIDForLister(ctx context.Context, arg GetImagePostByIDForListerParams) (*GetImagePostByIDForListerRow, error) {
+	res, err := s.q.GetImagePostByIDForLister(ctx, dbpostgres.GetImagePostByIDForListerParams{
+		ID:           arg.ID,
+		ListerUserID: arg.ListerUserID,
+		ListerID:     arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetImagePostByIDForListerRow) *GetImagePostByIDForListerRow {
+		if v == nil {
+			return nil
+		}
+		return &GetImagePostByIDForListerRow{
+			Idimagepost:            v.Idimagepost,
+			ForumthreadID:          v.ForumthreadID,
+			UsersIdusers:           v.UsersIdusers,
+			ImageboardIdimageboard: v.ImageboardIdimageboard,
+			Posted:                 v.Posted,
+			Timezone:               v.Timezone,
+			Description:            v.Description,
+			Thumbnail:              v.Thumbnail,
+			Fullimage:              v.Fullimage,
+			FileSize:               v.FileSize,
+			Approved:               (v.Approved != 0),
+			DeletedAt:              v.DeletedAt,
+			LastIndex:              v.LastIndex,
+			Username:               v.Username,
+			Comments:               v.Comments,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetImagePostInfoByPath(ctx context.Context, arg GetImagePostInfoByPathParams) (*GetImagePostInfoByPathRow, error) {
+	res, err := s.q.GetImagePostInfoByPath(ctx, dbpostgres.GetImagePostInfoByPathParams{
+		Fullimage: arg.Fullimage,
+		Thumbnail: arg.Thumbnail,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetImagePostInfoByPathRow) *GetImagePostInfoByPathRow {
+		if v == nil {
+			return nil
+		}
+		return &GetImagePostInfoByPathRow{
+			Idimagepost:            v.Idimagepost,
+			ImageboardIdimageboard: v.ImageboardIdimageboard,
+			UsersIdusers:           v.UsersIdusers,
+			Posted:                 v.Posted,
+			Username:               v.Username,
+			Title:                  v.Title,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetImagePostsByUserDescending(ctx context.Context, arg GetImagePostsByUserDescendingParams) ([]*GetImagePostsByUserDescendingRow, error) {
+	res, err := s.q.GetImagePostsByUserDescending(ctx, dbpostgres.GetImagePostsByUserDescendingParams{
+		UsersIdusers: arg.UsersIdusers,
+		Limit:        arg.Limit,
+		Offset:       arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetImagePostsByUserDescendingRow) []*GetImagePostsByUserDescendingRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetImagePostsByUserDescendingRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetImagePostsByUserDescendingRow{
+				Idimagepost:            item.Idimagepost,
+				ForumthreadID:          item.ForumthreadID,
+				UsersIdusers:           item.UsersIdusers,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Posted:                 item.Posted,
+				Timezone:               item.Timezone,
+				Description:            item.Description,
+				Thumbnail:              item.Thumbnail,
+				Fullimage:              item.Fullimage,
+				FileSize:               item.FileSize,
+				Approved:               (item.Approved != 0),
+				DeletedAt:              item.DeletedAt,
+				LastIndex:              item.LastIndex,
+				Username:               item.Username,
+				Comments:               item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetImagePostsByUserDescendingAll(ctx context.Context, arg GetImagePostsByUserDescendingAllParams) ([]*GetImagePostsByUserDescendingAllRow, error) {
+	res, err := s.q.GetImagePostsByUserDescendingAll(ctx, dbpostgres.GetImagePostsByUserDescendingAllParams{
+		UsersIdusers: arg.UsersIdusers,
+		Limit:        arg.Limit,
+		Offset:       arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetImagePostsByUserDescendingAllRow) []*GetImagePostsByUserDescendingAllRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetImagePostsByUserDescendingAllRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetImagePostsByUserDescendingAllRow{
+				Idimagepost:            item.Idimagepost,
+				ForumthreadID:          item.ForumthreadID,
+				UsersIdusers:           item.UsersIdusers,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Posted:                 item.Posted,
+				Timezone:               item.Timezone,
+				Description:            item.Description,
+				Thumbnail:              item.Thumbnail,
+				Fullimage:              item.Fullimage,
+				FileSize:               item.FileSize,
+				Approved:               (item.Approved != 0),
+				DeletedAt:              item.DeletedAt,
+				LastIndex:              item.LastIndex,
+				Username:               item.Username,
+				Comments:               item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLatestAnnouncementByNewsID(ctx context.Context, siteNewsID int32) (*SiteAnnouncement, error) {
+	res, err := s.q.GetLatestAnnouncementByNewsID(ctx, siteNewsID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SiteAnnouncement) *SiteAnnouncement {
+		if v == nil {
+			return nil
+		}
+		return &SiteAnnouncement{
+			ID:         v.ID,
+			SiteNewsID: v.SiteNewsID,
+			Active:     (v.Active != 0),
+			CreatedAt:  v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerCategoriesWithCount(ctx context.Context) ([]*GetLinkerCategoriesWithCountRow, error) {
+	res, err := s.q.GetLinkerCategoriesWithCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetLinkerCategoriesWithCountRow) []*GetLinkerCategoriesWithCountRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetLinkerCategoriesWithCountRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetLinkerCategoriesWithCountRow{
+				ID:        item.ID,
+				Title:     item.Title,
+				Sortorder: item.Sortorder,
+				Linkcount: item.Linkcount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerCategoryById(ctx context.Context, id int32) (*LinkerCategory, error) {
+	res, err := s.q.GetLinkerCategoryById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.LinkerCategory) *LinkerCategory {
+		if v == nil {
+			return nil
+		}
+		return &LinkerCategory{
+			ID:        v.ID,
+			Position:  v.Position,
+			Title:     v.Title,
+			Sortorder: v.Sortorder,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerCategoryLinkCounts(ctx context.Context) ([]*GetLinkerCategoryLinkCountsRow, error) {
+	res, err := s.q.GetLinkerCategoryLinkCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetLinkerCategoryLinkCountsRow) []*GetLinkerCategoryLinkCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetLinkerCategoryLinkCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetLinkerCategoryLinkCountsRow{
+				ID:        item.ID,
+				Title:     item.Title,
+				Position:  item.Position,
+				Linkcount: item.Linkcount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescending(ctx context.Context, id int32) (*GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingRow, error) {
+	res, err := s.q.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescending(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingRow) *GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingRow {
+		if v == nil {
+			return nil
+		}
+		return &GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingRow{
+			ID:          v.ID,
+			LanguageID:  v.LanguageID,
+			AuthorID:    v.AuthorID,
+			CategoryID:  v.CategoryID,
+			ThreadID:    v.ThreadID,
+			Title:       v.Title,
+			Url:         v.Url,
+			Description: v.Description,
+			Listed:      v.Listed,
+			Timezone:    v.Timezone,
+			Username:    v.Username,
+			Title_2:     v.Title_2,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUser(ctx context.Context, arg GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUserParams) (*GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUserRow, error) {
+	res, err := s.q.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUser(ctx, dbpostgres.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUserParams{
+		ID:           arg.ID,
+		ViewerID:     arg.ViewerID,
+		ViewerUserID: arg.ViewerUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUserRow) *GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUserRow {
+		if v == nil {
+			return nil
+		}
+		return &GetLinkerItemByIdWithPosterUsernameAndCategoryTitleDescendingForUserRow{
+			ID:          v.ID,
+			LanguageID:  v.LanguageID,
+			AuthorID:    v.AuthorID,
+			CategoryID:  v.CategoryID,
+			ThreadID:    v.ThreadID,
+			Title:       v.Title,
+			Url:         v.Url,
+			Description: v.Description,
+			Listed:      v.Listed,
+			Timezone:    v.Timezone,
+			Username:    v.Username,
+			Title_2:     v.Title_2,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescending(ctx context.Context, linkerids []int32) ([]*GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow, error) {
+	res, err := s.q.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescending(ctx, linkerids)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow) []*GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingRow{
+				ID:          item.ID,
+				LanguageID:  item.LanguageID,
+				AuthorID:    item.AuthorID,
+				CategoryID:  item.CategoryID,
+				ThreadID:    item.ThreadID,
+				Title:       item.Title,
+				Url:         item.Url,
+				Description: item.Description,
+				Listed:      item.Listed,
+				Timezone:    item.Timezone,
+				Username:    item.Username,
+				Title_2:     item.Title_2,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUser(ctx context.Context, arg GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserParams) ([]*GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserRow, error) {
+	res, err := s.q.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUser(ctx, dbpostgres.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserParams{
+		Linkerids:    arg.Linkerids,
+		ViewerID:     arg.ViewerID,
+		ViewerUserID: arg.ViewerUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserRow) []*GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetLinkerItemsByIdsWithPosterUsernameAndCategoryTitleDescendingForUserRow{
+				ID:          item.ID,
+				LanguageID:  item.LanguageID,
+				AuthorID:    item.AuthorID,
+				CategoryID:  item.CategoryID,
+				ThreadID:    item.ThreadID,
+				Title:       item.Title,
+				Url:         item.Url,
+				Description: item.Description,
+				Listed:      item.Listed,
+				Timezone:    item.Timezone,
+				Username:    item.Username,
+				Title_2:     item.Title_2,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerItemsByUserDescending(ctx context.Context, arg GetLinkerItemsByUserDescendingParams) ([]*GetLinkerItemsByUserDescendingRow, error) {
+	res, err := s.q.GetLinkerItemsByUserDescending(ctx, dbpostgres.GetLinkerItemsByUserDescendingParams{
+		AuthorID: arg.AuthorID,
+		Limit:    arg.Limit,
+		Offset:   arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetLinkerItemsByUserDescendingRow) []*GetLinkerItemsByUserDescendingRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetLinkerItemsByUserDescendingRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetLinkerItemsByUserDescendingRow{
+				ID:             item.ID,
+				LanguageID:     item.LanguageID,
+				AuthorID:       item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ThreadID:       item.ThreadID,
+				Title:          item.Title,
+				Url:            item.Url,
+				Description:    item.Description,
+				Listed:         item.Listed,
+				Timezone:       item.Timezone,
+				Comments:       item.Comments,
+				CategoryTitle:  item.CategoryTitle,
+				Posterusername: item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLinkerItemsByUserDescendingForUser(ctx context.Context, arg GetLinkerItemsByUserDescendingForUserParams) ([]*GetLinkerItemsByUserDescendingForUserRow, error) {
+	res, err := s.q.GetLinkerItemsByUserDescendingForUser(ctx, dbpostgres.GetLinkerItemsByUserDescendingForUserParams{
+		UserID:       arg.UserID,
+		Offset:       arg.Offset,
+		Limit:        arg.Limit,
+		ViewerID:     arg.ViewerID,
+		ViewerUserID: arg.ViewerUserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetLinkerItemsByUserDescendingForUserRow) []*GetLinkerItemsByUserDescendingForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetLinkerItemsByUserDescendingForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetLinkerItemsByUserDescendingForUserRow{
+				ID:             item.ID,
+				LanguageID:     item.LanguageID,
+				AuthorID:       item.AuthorID,
+				CategoryID:     item.CategoryID,
+				ThreadID:       item.ThreadID,
+				Title:          item.Title,
+				Url:            item.Url,
+				Description:    item.Description,
+				Listed:         item.Listed,
+				Timezone:       item.Timezone,
+				Comments:       item.Comments,
+				CategoryTitle:  item.CategoryTitle,
+				Posterusername: item.Posterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetLoginRoleForUser(ctx context.Context, usersIdusers int32) (int32, error) {
+	res, err := s.q.GetLoginRoleForUser(ctx, usersIdusers)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetMaxNotificationPriority(ctx context.Context, userID int32) (interface{}, error) {
+	res, err := s.q.GetMaxNotificationPriority(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetNewsPostByIdWithWriterIdAndThreadCommentCount(ctx context.Context, arg GetNewsPostByIdWithWriterIdAndThreadCommentCountParams) (*GetNewsPostByIdWithWriterIdAndThreadCommentCountRow, error) {
+	res, err := s.q.GetNewsPostByIdWithWriterIdAndThreadCommentCount(ctx, dbpostgres.GetNewsPostByIdWithWriterIdAndThreadCommentCountParams{
+		ID:       arg.ID,
+		UserID:   arg.UserID,
+		ViewerID: arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetNewsPostByIdWithWriterIdAndThreadCommentCountRow) *GetNewsPostByIdWithWriterIdAndThreadCommentCountRow {
+		if v == nil {
+			return nil
+		}
+		return &GetNewsPostByIdWithWriterIdAndThreadCommentCountRow{
+			Writername:    v.Writername,
+			Writerid:      v.Writerid,
+			Idsitenews:    v.Idsitenews,
+			ForumthreadID: v.ForumthreadID,
+			LanguageID:    v.LanguageID,
+			UsersIdusers:  v.UsersIdusers,
+			News:          v.News,
+			Occurred:      v.Occurred,
+			Timezone:      v.Timezone,
+			Comments:      v.Comments,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCount(ctx context.Context, arg GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountParams) ([]*GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow, error) {
+	res, err := s.q.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCount(ctx, dbpostgres.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountParams{
+		Newsids:  arg.Newsids,
+		UserID:   arg.UserID,
+		ViewerID: arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow) []*GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCountRow{
+				Writername:    item.Writername,
+				Writerid:      item.Writerid,
+				Idsitenews:    item.Idsitenews,
+				ForumthreadID: item.ForumthreadID,
+				LanguageID:    item.LanguageID,
+				UsersIdusers:  item.UsersIdusers,
+				News:          item.News,
+				Occurred:      item.Occurred,
+				Timezone:      item.Timezone,
+				Comments:      item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending(ctx context.Context, arg GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams) ([]*GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow, error) {
+	res, err := s.q.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending(ctx, dbpostgres.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams{
+		UserID:            arg.UserID,
+		Offset:            arg.Offset,
+		Limit:             arg.Limit,
+		ViewerID:          arg.ViewerID,
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: arg.ScheduledViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow) []*GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow{
+				Writername:    item.Writername,
+				Writerid:      item.Writerid,
+				Idsitenews:    item.Idsitenews,
+				ForumthreadID: item.ForumthreadID,
+				LanguageID:    item.LanguageID,
+				UsersIdusers:  item.UsersIdusers,
+				News:          item.News,
+				Occurred:      item.Occurred,
+				Timezone:      item.Timezone,
+				Comments:      item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetNotificationCountForLister(ctx context.Context, listerID int32) (int64, error) {
+	res, err := s.q.GetNotificationCountForLister(ctx, listerID)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetNotificationEmailByUserID(ctx context.Context, userID int32) (*UserEmail, error) {
+	res, err := s.q.GetNotificationEmailByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserEmail) *UserEmail {
+		if v == nil {
+			return nil
+		}
+		return &UserEmail{
+			ID:                    v.ID,
+			UserID:                v.UserID,
+			Email:                 v.Email,
+			VerifiedAt:            v.VerifiedAt,
+			LastVerificationCode:  v.LastVerificationCode,
+			VerificationExpiresAt: v.VerificationExpiresAt,
+			NotificationPriority:  v.NotificationPriority,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetNotificationForLister(ctx context.Context, arg GetNotificationForListerParams) (*Notification, error) {
+	res, err := s.q.GetNotificationForLister(ctx, dbpostgres.GetNotificationForListerParams{
+		ID:       arg.ID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Notification) *Notification {
+		if v == nil {
+			return nil
+		}
+		return &Notification{
+			ID:           v.ID,
+			UsersIdusers: v.UsersIdusers,
+			Link:         v.Link,
+			Message:      v.Message,
+			CreatedAt:    v.CreatedAt,
+			ReadAt:       v.ReadAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPasskeyByCredentialID(ctx context.Context, credentialID []byte) (*UserPasskey, error) {
+	res, err := s.q.GetPasskeyByCredentialID(ctx, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserPasskey) *UserPasskey {
+		if v == nil {
+			return nil
+		}
+		return &UserPasskey{
+			ID:              v.ID,
+			UserID:          v.UserID,
+			Name:            v.Name,
+			BackupEligible:  v.BackupEligible,
+			BackupState:     v.BackupState,
+			CredentialID:    v.CredentialID,
+			PublicKey:       v.PublicKey,
+			AttestationType: v.AttestationType,
+			Aaguid:          v.Aaguid,
+			SignCount:       v.SignCount,
+			CreatedAt:       v.CreatedAt,
+			UpdatedAt:       v.UpdatedAt,
+			LastUsedAt:      v.LastUsedAt,
+			ExpiresAt:       v.ExpiresAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPasskeysByUserID(ctx context.Context, userID int32) ([]*UserPasskey, error) {
+	res, err := s.q.GetPasskeysByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserPasskey) []*UserPasskey {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserPasskey, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserPasskey{
+				ID:              item.ID,
+				UserID:          item.UserID,
+				Name:            item.Name,
+				BackupEligible:  item.BackupEligible,
+				BackupState:     item.BackupState,
+				CredentialID:    item.CredentialID,
+				PublicKey:       item.PublicKey,
+				AttestationType: item.AttestationType,
+				Aaguid:          item.Aaguid,
+				SignCount:       item.SignCount,
+				CreatedAt:       item.CreatedAt,
+				UpdatedAt:       item.UpdatedAt,
+				LastUsedAt:      item.LastUsedAt,
+				ExpiresAt:       item.ExpiresAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPasswordResetByCode(ctx context.Context, arg GetPasswordResetByCodeParams) (*PendingPassword, error) {
+	res, err := s.q.GetPasswordResetByCode(ctx, dbpostgres.GetPasswordResetByCodeParams{
+		VerificationCode: arg.VerificationCode,
+		CreatedAt:        arg.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.PendingPassword) *PendingPassword {
+		if v == nil {
+			return nil
+		}
+		return &PendingPassword{
+			ID:               v.ID,
+			UserID:           v.UserID,
+			Passwd:           v.Passwd,
+			PasswdAlgorithm:  v.PasswdAlgorithm,
+			VerificationCode: v.VerificationCode,
+			CreatedAt:        v.CreatedAt,
+			VerifiedAt:       v.VerifiedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPasswordResetByUser(ctx context.Context, arg GetPasswordResetByUserParams) (*PendingPassword, error) {
+	res, err := s.q.GetPasswordResetByUser(ctx, dbpostgres.GetPasswordResetByUserParams{
+		UserID:    arg.UserID,
+		CreatedAt: arg.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.PendingPassword) *PendingPassword {
+		if v == nil {
+			return nil
+		}
+		return &PendingPassword{
+			ID:               v.ID,
+			UserID:           v.UserID,
+			Passwd:           v.Passwd,
+			PasswdAlgorithm:  v.PasswdAlgorithm,
+			VerificationCode: v.VerificationCode,
+			CreatedAt:        v.CreatedAt,
+			VerifiedAt:       v.VerifiedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPendingEmailErrorCount(ctx context.Context, id int32) (int32, error) {
+	res, err := s.q.GetPendingEmailErrorCount(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetPendingPassword(ctx context.Context, userID int32) (*PendingPassword, error) {
+	res, err := s.q.GetPendingPassword(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.PendingPassword) *PendingPassword {
+		if v == nil {
+			return nil
+		}
+		return &PendingPassword{
+			ID:               v.ID,
+			UserID:           v.UserID,
+			Passwd:           v.Passwd,
+			PasswdAlgorithm:  v.PasswdAlgorithm,
+			VerificationCode: v.VerificationCode,
+			CreatedAt:        v.CreatedAt,
+			VerifiedAt:       v.VerifiedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPendingPasswordByCode(ctx context.Context, verificationCode string) (*PendingPassword, error) {
+	res, err := s.q.GetPendingPasswordByCode(ctx, verificationCode)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.PendingPassword) *PendingPassword {
+		if v == nil {
+			return nil
+		}
+		return &PendingPassword{
+			ID:               v.ID,
+			UserID:           v.UserID,
+			Passwd:           v.Passwd,
+			PasswdAlgorithm:  v.PasswdAlgorithm,
+			VerificationCode: v.VerificationCode,
+			CreatedAt:        v.CreatedAt,
+			VerifiedAt:       v.VerifiedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPermissionsByUserID(ctx context.Context, usersIdusers int32) ([]*GetPermissionsByUserIDRow, error) {
+	res, err := s.q.GetPermissionsByUserID(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetPermissionsByUserIDRow) []*GetPermissionsByUserIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetPermissionsByUserIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetPermissionsByUserIDRow{
+				IduserRoles:  item.IduserRoles,
+				UsersIdusers: item.UsersIdusers,
+				RoleID:       item.RoleID,
+				Name:         item.Name,
+				IsAdmin:      (item.IsAdmin != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPermissionsWithUsers(ctx context.Context, arg GetPermissionsWithUsersParams) ([]*GetPermissionsWithUsersRow, error) {
+	res, err := s.q.GetPermissionsWithUsers(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetPermissionsWithUsersRow) []*GetPermissionsWithUsersRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetPermissionsWithUsersRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetPermissionsWithUsersRow{
+				IduserRoles:  item.IduserRoles,
+				UsersIdusers: item.UsersIdusers,
+				Name:         item.Name,
+				Username:     item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPreferenceForLister(ctx context.Context, listerID int32) (*Preference, error) {
+	res, err := s.q.GetPreferenceForLister(ctx, listerID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Preference) *Preference {
+		if v == nil {
+			return nil
+		}
+		return &Preference{
+			Idpreferences:           v.Idpreferences,
+			LanguageID:              v.LanguageID,
+			UsersIdusers:            v.UsersIdusers,
+			Emailforumupdates:       sql.NullBool{Bool: v.Emailforumupdates.Int32 != 0, Valid: v.Emailforumupdates.Valid},
+			PageSize:                v.PageSize,
+			AutoSubscribeReplies:    (v.AutoSubscribeReplies != 0),
+			Timezone:                v.Timezone,
+			CustomCss:               v.CustomCss,
+			DailyDigestHour:         v.DailyDigestHour,
+			DailyDigestMarkRead:     (v.DailyDigestMarkRead != 0),
+			LastDigestSentAt:        v.LastDigestSentAt,
+			WeeklyDigestDay:         v.WeeklyDigestDay,
+			WeeklyDigestHour:        v.WeeklyDigestHour,
+			LastWeeklyDigestSentAt:  v.LastWeeklyDigestSentAt,
+			MonthlyDigestDay:        v.MonthlyDigestDay,
+			MonthlyDigestHour:       v.MonthlyDigestHour,
+			LastMonthlyDigestSentAt: v.LastMonthlyDigestSentAt,
+			ImageSafeDimension:      v.ImageSafeDimension,
+			MuteMentions:            (v.MuteMentions != 0),
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPrivateTopicThreadsAndLabelsForUser(ctx context.Context, arg GetPrivateTopicThreadsAndLabelsForUserParams) ([]*GetPrivateTopicThreadsAndLabelsForUserRow, error) {
+	res, err := s.q.GetPrivateTopicThreadsAndLabelsForUser(ctx, dbpostgres.GetPrivateTopicThreadsAndLabelsForUserParams{
+		UserID:        arg.UserID,
+		TopicID:       arg.TopicID,
+		ViewerMatchID: arg.ViewerMatchID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetPrivateTopicThreadsAndLabelsForUserRow) []*GetPrivateTopicThreadsAndLabelsForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetPrivateTopicThreadsAndLabelsForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetPrivateTopicThreadsAndLabelsForUserRow{
+				Idforumthread: item.Idforumthread,
+				AuthorID:      item.AuthorID,
+				Label:         item.Label,
+				Invert:        item.Invert,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetPublicProfileRoleForUser(ctx context.Context, usersIdusers int32) (int32, error) {
+	res, err := s.q.GetPublicProfileRoleForUser(ctx, usersIdusers)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetPublicWritings(ctx context.Context, arg GetPublicWritingsParams) ([]*Writing, error) {
+	res, err := s.q.GetPublicWritings(ctx, dbpostgres.GetPublicWritingsParams{
+		Offset:            arg.Offset,
+		Limit:             arg.Limit,
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: arg.ScheduledViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Writing) []*Writing {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Writing, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Writing{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetReplyThreadsForLister(ctx context.Context, arg GetReplyThreadsForListerParams) ([]*GetReplyThreadsForListerRow, error) {
+	res, err := s.q.GetReplyThreadsForLister(ctx, dbpostgres.GetReplyThreadsForListerParams{
+		ViewerID:        arg.ViewerID,
+		ReplyToThreadID: arg.ReplyToThreadID,
+		ViewerMatchID:   arg.ViewerMatchID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetReplyThreadsForListerRow) []*GetReplyThreadsForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetReplyThreadsForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetReplyThreadsForListerRow{
+				Idforumthread:          item.Idforumthread,
+				Firstpost:              item.Firstpost,
+				Lastposter:             item.Lastposter,
+				ForumtopicIdforumtopic: item.ForumtopicIdforumtopic,
+				Comments:               item.Comments,
+				Lastaddition:           item.Lastaddition,
+				Locked:                 sql.NullBool{Bool: item.Locked.Int32 != 0, Valid: item.Locked.Valid},
+				ReplyToCommentID:       item.ReplyToCommentID,
+				ReplyToThreadID:        item.ReplyToThreadID,
+				DeletedAt:              item.DeletedAt,
+				FirstPostText:          item.FirstPostText,
+				TotalComments:          item.TotalComments,
+				Idforumthread_2:        item.Idforumthread_2,
+				LastPosterName:         item.LastPosterName,
+				Firstpostusername:      item.Firstpostusername,
+				Firstpostuserid:        item.Firstpostuserid,
+				Firstpostwritten:       item.Firstpostwritten,
+				IsUnread:               item.IsUnread,
+				IsNew:                  item.IsNew,
+				PublicLabels:           sql.NullString{String: string(item.PublicLabels), Valid: item.PublicLabels != nil},
+				AuthorLabels:           sql.NullString{String: string(item.AuthorLabels), Valid: item.AuthorLabels != nil},
+				PrivateLabels:          sql.NullString{String: string(item.PrivateLabels), Valid: item.PrivateLabels != nil},
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetRoleByName(ctx context.Context, name string) (*Role, error) {
+	res, err := s.q.GetRoleByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Role) *Role {
+		if v == nil {
+			return nil
+		}
+		return &Role{
+			ID:                     v.ID,
+			Name:                   v.Name,
+			CanLogin:               (v.CanLogin != 0),
+			IsAdmin:                (v.IsAdmin != 0),
+			PrivateLabels:          (v.PrivateLabels != 0),
+			PublicProfileAllowedAt: v.PublicProfileAllowedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetScheduledPublication(ctx context.Context, arg GetScheduledPublicationParams) (*ScheduledPublication, error) {
+	res, err := s.q.GetScheduledPublication(ctx, dbpostgres.GetScheduledPublicationParams{
+		ItemType: arg.ItemType,
+		ItemID:   arg.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ScheduledPublication) *ScheduledPublication {
+		if v == nil {
+			return nil
+		}
+		return &ScheduledPublication{
+			ID:        v.ID,
+			ItemType:  v.ItemType,
+			ItemID:    v.ItemID,
+			AuthorID:  v.AuthorID,
+			PublishAt: v.PublishAt,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetSchedulerState(ctx context.Context, taskName string) (*SchedulerState, error) {
+	res, err := s.q.GetSchedulerState(ctx, taskName)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SchedulerState) *SchedulerState {
+		if v == nil {
+			return nil
+		}
+		return &SchedulerState{
+			TaskName:  v.TaskName,
+			LastRunAt: v.LastRunAt,
+			Metadata:  v.Metadata,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetSubscriptionArchetypesByRole(ctx context.Context, roleID int32) ([]*RoleSubscriptionArchetype, error) {
+	res, err := s.q.GetSubscriptionArchetypesByRole(ctx, roleID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.RoleSubscriptionArchetype) []*RoleSubscriptionArchetype {
+		if items == nil {
+			return nil
+		}
+		out := make([]*RoleSubscriptionArchetype, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &RoleSubscriptionArchetype{
+				ID:            item.ID,
+				RoleID:        item.RoleID,
+				ArchetypeName: item.ArchetypeName,
+				Pattern:       item.Pattern,
+				Method:        item.Method,
+				CreatedAt:     item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetTOTPForUser(ctx context.Context, usersIdusers int32) (*UserTotp, error) {
+	res, err := s.q.GetTOTPForUser(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserTotp) *UserTotp {
+		if v == nil {
+			return nil
+		}
+		return &UserTotp{
+			UsersIdusers: v.UsersIdusers,
+			Secret:       v.Secret,
+			EnabledAt:    v.EnabledAt,
+			LastUsedStep: v.LastUsedStep,
+			CreatedAt:    v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetThreadBySectionThreadIDForReplier(ctx context.Context, arg GetThreadBySectionThreadIDForReplierParams) (*Forumthread, error) {
+	res, err := s.q.GetThreadBySectionThreadIDForReplier(ctx, dbpostgres.GetThreadBySectionThreadIDForReplierParams{
+		ThreadID:       arg.ThreadID,
+		Section:        arg.Section,
+		ItemType:       arg.ItemType,
+		ItemID:         arg.ItemID,
+		ReplierMatchID: arg.ReplierMatchID,
+		ReplierID:      arg.ReplierID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Forumthread) *Forumthread {
+		if v == nil {
+			return nil
+		}
+		return &Forumthread{
+			Idforumthread:          v.Idforumthread,
+			Firstpost:              v.Firstpost,
+			Lastposter:             v.Lastposter,
+			ForumtopicIdforumtopic: v.ForumtopicIdforumtopic,
+			Comments:               v.Comments,
+			Lastaddition:           v.Lastaddition,
+			Locked:                 sql.NullBool{Bool: v.Locked.Int32 != 0, Valid: v.Locked.Valid},
+			ReplyToCommentID:       v.ReplyToCommentID,
+			ReplyToThreadID:        v.ReplyToThreadID,
+			DeletedAt:              v.DeletedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetThreadLastPosterAndPermsForUser(ctx context.Context, arg GetThreadLastPosterAndPermsForUserParams) (*GetThreadLastPosterAndPermsForUserRow, error) {
+	res, err := s.q.GetThreadLastPosterAndPermsForUser(ctx, dbpostgres.GetThreadLastPosterAndPermsForUserParams{
+		ThreadID:      arg.ThreadID,
+		ViewerMatchID: arg.ViewerMatchID,
+		ViewerID:      arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetThreadLastPosterAndPermsForUserRow) *GetThreadLastPosterAndPermsForUserRow {
+		if v == nil {
+			return nil
+		}
+		return &GetThreadLastPosterAndPermsForUserRow{
+			Idforumthread:          v.Idforumthread,
+			Firstpost:              v.Firstpost,
+			Lastposter:             v.Lastposter,
+			ForumtopicIdforumtopic: v.ForumtopicIdforumtopic,
+			Comments:               v.Comments,
+			Lastaddition:           v.Lastaddition,
+			Locked:                 sql.NullBool{Bool: v.Locked.Int32 != 0, Valid: v.Locked.Valid},
+			ReplyToCommentID:       v.ReplyToCommentID,
+			ReplyToThreadID:        v.ReplyToThreadID,
+			DeletedAt:              v.DeletedAt,
+			Lastposterusername:     v.Lastposterusername,
+			Firstpostuserid:        v.Firstpostuserid,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUnreadNotificationCountForLister(ctx context.Context, listerID int32) (int64, error) {
+	res, err := s.q.GetUnreadNotificationCountForLister(ctx, listerID)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetUploadedImageByPath(ctx context.Context, path sql.NullString) (*UploadedImage, error) {
+	res, err := s.q.GetUploadedImageByPath(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UploadedImage) *UploadedImage {
+		if v == nil {
+			return nil
+		}
+		return &UploadedImage{
+			Iduploadedimage: v.Iduploadedimage,
+			UsersIdusers:    v.UsersIdusers,
+			Path:            v.Path,
+			Width:           v.Width,
+			Height:          v.Height,
+			FileSize:        v.FileSize,
+			Uploaded:        v.Uploaded,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUserEmailByCode(ctx context.Context, lastVerificationCode sql.NullString) (*UserEmail, error) {
+	res, err := s.q.GetUserEmailByCode(ctx, lastVerificationCode)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserEmail) *UserEmail {
+		if v == nil {
+			return nil
+		}
+		return &UserEmail{
+			ID:                    v.ID,
+			UserID:                v.UserID,
+			Email:                 v.Email,
+			VerifiedAt:            v.VerifiedAt,
+			LastVerificationCode:  v.LastVerificationCode,
+			VerificationExpiresAt: v.VerificationExpiresAt,
+			NotificationPriority:  v.NotificationPriority,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUserEmailByEmail(ctx context.Context, email string) (*UserEmail, error) {
+	res, err := s.q.GetUserEmailByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserEmail) *UserEmail {
+		if v == nil {
+			return nil
+		}
+		return &UserEmail{
+			ID:                    v.ID,
+			UserID:                v.UserID,
+			Email:                 v.Email,
+			VerifiedAt:            v.VerifiedAt,
+			LastVerificationCode:  v.LastVerificationCode,
+			VerificationExpiresAt: v.VerificationExpiresAt,
+			NotificationPriority:  v.NotificationPriority,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUserEmailByID(ctx context.Context, id int32) (*UserEmail, error) {
+	res, err := s.q.GetUserEmailByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.UserEmail) *UserEmail {
+		if v == nil {
+			return nil
+		}
+		return &UserEmail{
+			ID:                    v.ID,
+			UserID:                v.UserID,
+			Email:                 v.Email,
+			VerifiedAt:            v.VerifiedAt,
+			LastVerificationCode:  v.LastVerificationCode,
+			VerificationExpiresAt: v.VerificationExpiresAt,
+			NotificationPriority:  v.NotificationPriority,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUserLanguages(ctx context.Context, usersIdusers int32) ([]*UserLanguage, error) {
+	res, err := s.q.GetUserLanguages(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserLanguage) []*UserLanguage {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserLanguage, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserLanguage{
+				Iduserlang:   item.Iduserlang,
+				UsersIdusers: item.UsersIdusers,
+				LanguageID:   item.LanguageID,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUserRole(ctx context.Context, usersIdusers int32) (string, error) {
+	res, err := s.q.GetUserRole(ctx, usersIdusers)
+	if err != nil {
+		return "", err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) GetUserRoleByID(ctx context.Context, id int32) (*GetUserRoleByIDRow, error) {
+	res, err := s.q.GetUserRoleByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetUserRoleByIDRow) *GetUserRoleByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &GetUserRoleByIDRow{
+			IduserRoles:  v.IduserRoles,
+			UsersIdusers: v.UsersIdusers,
+			Name:         v.Name,
+			Username:     v.Username,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUserRoles(ctx context.Context) ([]*GetUserRolesRow, error) {
+	res, err := s.q.GetUserRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUserRolesRow) []*GetUserRolesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUserRolesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUserRolesRow{
+				IduserRoles:  item.IduserRoles,
+				UsersIdusers: item.UsersIdusers,
+				Role:         item.Role,
+				IsAdmin:      (item.IsAdmin != 0),
+				Username:     item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUsersForDailyDigest(ctx context.Context, arg GetUsersForDailyDigestParams) ([]*GetUsersForDailyDigestRow, error) {
+	res, err := s.q.GetUsersForDailyDigest(ctx, dbpostgres.GetUsersForDailyDigestParams{
+		Hour:   arg.Hour,
+		Cutoff: arg.Cutoff,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUsersForDailyDigestRow) []*GetUsersForDailyDigestRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUsersForDailyDigestRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUsersForDailyDigestRow{
+				UsersIdusers:        item.UsersIdusers,
+				Email:               item.Email,
+				DailyDigestMarkRead: (item.DailyDigestMarkRead != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUsersForDailyDigestByTimezone(ctx context.Context, arg GetUsersForDailyDigestByTimezoneParams) ([]*GetUsersForDailyDigestByTimezoneRow, error) {
+	res, err := s.q.GetUsersForDailyDigestByTimezone(ctx, dbpostgres.GetUsersForDailyDigestByTimezoneParams{
+		Hour:     arg.Hour,
+		Timezone: arg.Timezone,
+		Cutoff:   arg.Cutoff,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUsersForDailyDigestByTimezoneRow) []*GetUsersForDailyDigestByTimezoneRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUsersForDailyDigestByTimezoneRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUsersForDailyDigestByTimezoneRow{
+				UsersIdusers:        item.UsersIdusers,
+				Email:               item.Email,
+				DailyDigestMarkRead: (item.DailyDigestMarkRead != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUsersForDailyDigestNoTimezone(ctx context.Context, arg GetUsersForDailyDigestNoTimezoneParams) ([]*GetUsersForDailyDigestNoTimezoneRow, error) {
+	res, err := s.q.GetUsersForDailyDigestNoTimezone(ctx, dbpostgres.GetUsersForDailyDigestNoTimezoneParams{
+		Hour:   arg.Hour,
+		Cutoff: arg.Cutoff,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUsersForDailyDigestNoTimezoneRow) []*GetUsersForDailyDigestNoTimezoneRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUsersForDailyDigestNoTimezoneRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUsersForDailyDigestNoTimezoneRow{
+				UsersIdusers:        item.UsersIdusers,
+				Email:               item.Email,
+				DailyDigestMarkRead: (item.DailyDigestMarkRead != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUsersForMonthlyDigestByTimezone(ctx context.Context, arg GetUsersForMonthlyDigestByTimezoneParams) ([]*GetUsersForMonthlyDigestByTimezoneRow, error) {
+	res, err := s.q.GetUsersForMonthlyDigestByTimezone(ctx, dbpostgres.GetUsersForMonthlyDigestByTimezoneParams{
+		Day:      arg.Day,
+		Hour:     arg.Hour,
+		Timezone: arg.Timezone,
+		Cutoff:   arg.Cutoff,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUsersForMonthlyDigestByTimezoneRow) []*GetUsersForMonthlyDigestByTimezoneRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUsersForMonthlyDigestByTimezoneRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUsersForMonthlyDigestByTimezoneRow{
+				UsersIdusers:        item.UsersIdusers,
+				Email:               item.Email,
+				DailyDigestMarkRead: (item.DailyDigestMarkRead != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUsersForMonthlyDigestNoTimezone(ctx context.Context, arg GetUsersForMonthlyDigestNoTimezoneParams) ([]*GetUsersForMonthlyDigestNoTimezoneRow, error) {
+	res, err := s.q.GetUsersForMonthlyDigestNoTimezone(ctx, dbpostgres.GetUsersForMonthlyDigestNoTimezoneParams{
+		Day:    arg.Day,
+		Hour:   arg.Hour,
+		Cutoff: arg.Cutoff,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUsersForMonthlyDigestNoTimezoneRow) []*GetUsersForMonthlyDigestNoTimezoneRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUsersForMonthlyDigestNoTimezoneRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUsersForMonthlyDigestNoTimezoneRow{
+				UsersIdusers:        item.UsersIdusers,
+				Email:               item.Email,
+				DailyDigestMarkRead: (item.DailyDigestMarkRead != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUsersForWeeklyDigestByTimezone(ctx context.Context, arg GetUsersForWeeklyDigestByTimezoneParams) ([]*GetUsersForWeeklyDigestByTimezoneRow, error) {
+	res, err := s.q.GetUsersForWeeklyDigestByTimezone(ctx, dbpostgres.GetUsersForWeeklyDigestByTimezoneParams{
+		Day:      arg.Day,
+		Hour:     arg.Hour,
+		Timezone: arg.Timezone,
+		Cutoff:   arg.Cutoff,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUsersForWeeklyDigestByTimezoneRow) []*GetUsersForWeeklyDigestByTimezoneRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUsersForWeeklyDigestByTimezoneRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUsersForWeeklyDigestByTimezoneRow{
+				UsersIdusers:        item.UsersIdusers,
+				Email:               item.Email,
+				DailyDigestMarkRead: (item.DailyDigestMarkRead != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetUsersForWeeklyDigestNoTimezone(ctx context.Context, arg GetUsersForWeeklyDigestNoTimezoneParams) ([]*GetUsersForWeeklyDigestNoTimezoneRow, error) {
+	res, err := s.q.GetUsersForWeeklyDigestNoTimezone(ctx, dbpostgres.GetUsersForWeeklyDigestNoTimezoneParams{
+		Day:    arg.Day,
+		Hour:   arg.Hour,
+		Cutoff: arg.Cutoff,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetUsersForWeeklyDigestNoTimezoneRow) []*GetUsersForWeeklyDigestNoTimezoneRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetUsersForWeeklyDigestNoTimezoneRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetUsersForWeeklyDigestNoTimezoneRow{
+				UsersIdusers:        item.UsersIdusers,
+				Email:               item.Email,
+				DailyDigestMarkRead: (item.DailyDigestMarkRead != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetVerifiedUserEmails(ctx context.Context) ([]*GetVerifiedUserEmailsRow, error) {
+	res, err := s.q.GetVerifiedUserEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.GetVerifiedUserEmailsRow) []*GetVerifiedUserEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*GetVerifiedUserEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &GetVerifiedUserEmailsRow{
+				UserID: item.UserID,
+				Email:  item.Email,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetWritingCategoryById(ctx context.Context, idwritingcategory int32) (*WritingCategory, error) {
+	res, err := s.q.GetWritingCategoryById(ctx, idwritingcategory)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.WritingCategory) *WritingCategory {
+		if v == nil {
+			return nil
+		}
+		return &WritingCategory{
+			Idwritingcategory: v.Idwritingcategory,
+			WritingCategoryID: v.WritingCategoryID,
+			Title:             v.Title,
+			Description:       v.Description,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) GetWritingForListerByID(ctx context.Context, arg GetWritingForListerByIDParams) (*GetWritingForListerByIDRow, error) {
+	res, err := s.q.GetWritingForListerByID(ctx, dbpostgres.GetWritingForListerByIDParams{
+		Idwriting:     arg.Idwriting,
+		ListerMatchID: arg.ListerMatchID,
+		ListerID:      arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.GetWritingForListerByIDRow) *GetWritingForListerByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &GetWritingForListerByIDRow{
+			Idwriting:         v.Idwriting,
+			UsersIdusers:      v.UsersIdusers,
+			ForumthreadID:     v.ForumthreadID,
+			LanguageID:        v.LanguageID,
+			WritingCategoryID: v.WritingCategoryID,
+			Title:             v.Title,
+			Published:         v.Published,
+			Timezone:          v.Timezone,
+			Writing:           v.Writing,
+			Abstract:          v.Abstract,
+			Private:           sql.NullBool{Bool: v.Private.Int32 != 0, Valid: v.Private.Valid},
+			DeletedAt:         v.DeletedAt,
+			LastIndex:         v.LastIndex,
+			Writerid:          v.Writerid,
+			Writerusername:    v.Writerusername,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) InsertAdminUserComment(ctx context.Context, arg InsertAdminUserCommentParams) error {
+	return s.q.InsertAdminUserComment(ctx, dbpostgres.InsertAdminUserCommentParams{
+		UsersIdusers: arg.UsersIdusers,
+		Comment:      arg.Comment,
+	})
+}
+
+func (s *postgresQuerier) InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error {
+	return s.q.InsertAuditLog(ctx, dbpostgres.InsertAuditLogParams{
+		UsersIdusers: arg.UsersIdusers,
+		Action:       arg.Action,
+		Path:         arg.Path,
+		Details:      arg.Details,
+		Data:         arg.Data,
+	})
+}
+
+func (s *postgresQuerier) InsertContentRevision(ctx context.Context, arg InsertContentRevisionParams) (int64, error) {
+	res, err := s.q.InsertContentRevision(ctx, dbpostgres.InsertContentRevisionParams{
+		ItemType:       arg.ItemType,
+		ItemID:         arg.ItemID,
+		UsersIdusers:   arg.UsersIdusers,
+		Title:          arg.Title,
+		Abstract:       arg.Abstract,
+		Body:           arg.Body,
+		RestoredFromID: arg.RestoredFromID,
+		CreatedAt:      arg.CreatedAt,
+		Timezone:       arg.Timezone,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) InsertEmailPreferenceForLister(ctx context.Context, arg InsertEmailPreferenceForListerParams) error {
+	return s.q.InsertEmailPreferenceForLister(ctx, dbpostgres.InsertEmailPreferenceForListerParams{
+		EmailForumUpdates: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.EmailForumUpdates.Bool), Valid: arg.EmailForumUpdates.Valid},
+		AutoSubscribeReplies: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.AutoSubscribeReplies),
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) InsertFAQQuestionForWriter(ctx context.Context, arg InsertFAQQuestionForWriterParams) (sql.Result, error) {
+	res, err := s.q.InsertFAQQuestionForWriter(ctx, dbpostgres.InsertFAQQuestionForWriterParams{
+		Question:   arg.Question,
+		Answer:     arg.Answer,
+		CategoryID: arg.CategoryID,
+		WriterID:   arg.WriterID,
+		LanguageID: arg.LanguageID,
+		Priority:   arg.Priority,
+		GranteeID:  arg.GranteeID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return postgresInsertResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return postgresInsertResult{id: int64(res), rows: 1}, nil
+}
+
+func (s *postgresQuerier) InsertFAQRevisionForUser(ctx context.Context, arg InsertFAQRevisionForUserParams) error {
+	return s.q.InsertFAQRevisionForUser(ctx, dbpostgres.InsertFAQRevisionForUserParams{
+		FaqID:        arg.FaqID,
+		UsersIdusers: arg.UsersIdusers,
+		Question:     arg.Question,
+		Answer:       arg.Answer,
+		Timezone:     arg.Timezone,
+		UserID:       arg.UserID,
+	})
+}
+
+func (s *postgresQuerier) InsertForumPollVoteForVoter(ctx context.Context, arg InsertForumPollVoteForVoterParams) error {
+	return s.q.InsertForumPollVoteForVoter(ctx, dbpostgres.InsertForumPollVoteForVoterParams{
+		PollID:   arg.PollID,
+		OptionID: arg.OptionID,
+		VoterID:  arg.VoterID,
+	})
+}
+
+func (s *postgresQuerier) InsertPasskey(ctx context.Context, arg InsertPasskeyParams) error {
+	return s.q.InsertPasskey(ctx, dbpostgres.InsertPasskeyParams{
+		UserID:          arg.UserID,
+		Name:            arg.Name,
+		BackupEligible:  arg.BackupEligible,
+		BackupState:     arg.BackupState,
+		CredentialID:    arg.CredentialID,
+		PublicKey:       arg.PublicKey,
+		AttestationType: arg.AttestationType,
+		Aaguid:          arg.Aaguid,
+		SignCount:       arg.SignCount,
+	})
+}
+
+func (s *postgresQuerier) InsertPassword(ctx context.Context, arg InsertPasswordParams) error {
+	return s.q.InsertPassword(ctx, dbpostgres.InsertPasswordParams{
+		UsersIdusers:    arg.UsersIdusers,
+		Passwd:          arg.Passwd,
+		PasswdAlgorithm: arg.PasswdAlgorithm,
+	})
+}
+
+func (s *postgresQuerier) InsertPendingEmail(ctx context.Context, arg InsertPendingEmailParams) error {
+	return s.q.InsertPendingEmail(ctx, dbpostgres.InsertPendingEmailParams{
+		ToUserID: arg.ToUserID,
+		Body:     arg.Body,
+		DirectEmail: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.DirectEmail),
+	})
+}
+
+func (s *postgresQuerier) InsertPreferenceForLister(ctx context.Context, arg InsertPreferenceForListerParams) error {
+	return s.q.InsertPreferenceForLister(ctx, dbpostgres.InsertPreferenceForListerParams{
+		LanguageID: arg.LanguageID,
+		ListerID:   arg.ListerID,
+		PageSize:   arg.PageSize,
+		Timezone:   arg.Timezone,
+	})
+}
+
+func (s *postgresQuerier) InsertReactionForReactor(ctx context.Context, arg InsertReactionForReactorParams) error {
+	return s.q.InsertReactionForReactor(ctx, dbpostgres.InsertReactionForReactorParams{
+		ItemType:  arg.ItemType,
+		ItemID:    arg.ItemID,
+		ReactorID: arg.ReactorID,
+		Reaction:  arg.Reaction,
+	})
+}
+
+func (s *postgresQuerier) InsertRecoveryCodeForUser(ctx context.Context, arg InsertRecoveryCodeForUserParams) error {
+	return s.q.InsertRecoveryCodeForUser(ctx, dbpostgres.InsertRecoveryCodeForUserParams{
+		UserID:   arg.UserID,
+		CodeHash: arg.CodeHash,
+	})
+}
+
+func (s *postgresQuerier) InsertSubscription(ctx context.Context, arg InsertSubscriptionParams) error {
+	return s.q.InsertSubscription(ctx, dbpostgres.InsertSubscriptionParams{
+		UsersIdusers: arg.UsersIdusers,
+		Pattern:      arg.Pattern,
+		Method:       arg.Method,
+	})
+}
+
+func (s *postgresQuerier) InsertUserEmail(ctx context.Context, arg InsertUserEmailParams) error {
+	return s.q.InsertUserEmail(ctx, dbpostgres.InsertUserEmailParams{
+		UserID:                arg.UserID,
+		Email:                 arg.Email,
+		VerifiedAt:            arg.VerifiedAt,
+		LastVerificationCode:  arg.LastVerificationCode,
+		VerificationExpiresAt: arg.VerificationExpiresAt,
+		NotificationPriority:  arg.NotificationPriority,
+	})
+}
+
+func (s *postgresQuerier) InsertUserLang(ctx context.Context, arg InsertUserLangParams) error {
+	return s.q.InsertUserLang(ctx, dbpostgres.InsertUserLangParams{
+		UsersIdusers: arg.UsersIdusers,
+		LanguageID:   arg.LanguageID,
+	})
+}
+
+func (s *postgresQuerier) InsertWriting(ctx context.Context, arg InsertWritingParams) (int64, error) {
+	res, err := s.q.InsertWriting(ctx, dbpostgres.InsertWritingParams{
+		WritingCategoryID: arg.WritingCategoryID,
+		Title:             arg.Title,
+		Abstract:          arg.Abstract,
+		Writing:           arg.Writing,
+		Private: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Private.Bool), Valid: arg.Private.Valid},
+		LanguageID:   arg.LanguageID,
+		Published:    arg.Published,
+		UsersIdusers: arg.UsersIdusers,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) LatestAdminUserComment(ctx context.Context, usersIdusers int32) (*AdminUserComment, error) {
+	res, err := s.q.LatestAdminUserComment(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.AdminUserComment) *AdminUserComment {
+		if v == nil {
+			return nil
+		}
+		return &AdminUserComment{
+			ID:           v.ID,
+			UsersIdusers: v.UsersIdusers,
+			Comment:      v.Comment,
+			CreatedAt:    v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) LinkerSearchFirst(ctx context.Context, arg LinkerSearchFirstParams) ([]int32, error) {
+	res, err := s.q.LinkerSearchFirst(ctx, dbpostgres.LinkerSearchFirstParams{
+		Word:     arg.Word,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) LinkerSearchNext(ctx context.Context, arg LinkerSearchNextParams) ([]int32, error) {
+	res, err := s.q.LinkerSearchNext(ctx, dbpostgres.LinkerSearchNextParams{
+		Word:     arg.Word,
+		Ids:      arg.Ids,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListAPIKeysByUser(ctx context.Context, usersIdusers int32) ([]*ApiKey, error) {
+	res, err := s.q.ListAPIKeysByUser(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ApiKey) []*ApiKey {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ApiKey, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ApiKey{
+				ID:           item.ID,
+				UsersIdusers: item.UsersIdusers,
+				ApiKey:       item.ApiKey,
+				Name:         item.Name,
+				Scopes:       item.Scopes,
+				ExpiresAt:    item.ExpiresAt,
+				LastUsedAt:   item.LastUsedAt,
+				CreatedAt:    item.CreatedAt,
+				RevokedAt:    item.RevokedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListActiveBans(ctx context.Context) ([]*BannedIp, error) {
+	res, err := s.q.ListActiveBans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.BannedIp) []*BannedIp {
+		if items == nil {
+			return nil
+		}
+		out := make([]*BannedIp, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &BannedIp{
+				ID:         item.ID,
+				IpNet:      item.IpNet,
+				Reason:     item.Reason,
+				CreatedAt:  item.CreatedAt,
+				ExpiresAt:  item.ExpiresAt,
+				CanceledAt: item.CanceledAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListAdminUserComments(ctx context.Context, usersIdusers int32) ([]*AdminUserComment, error) {
+	res, err := s.q.ListAdminUserComments(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.AdminUserComment) []*AdminUserComment {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminUserComment, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminUserComment{
+				ID:           item.ID,
+				UsersIdusers: item.UsersIdusers,
+				Comment:      item.Comment,
+				CreatedAt:    item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListBannedIps(ctx context.Context) ([]*BannedIp, error) {
+	res, err := s.q.ListBannedIps(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.BannedIp) []*BannedIp {
+		if items == nil {
+			return nil
+		}
+		out := make([]*BannedIp, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &BannedIp{
+				ID:         item.ID,
+				IpNet:      item.IpNet,
+				Reason:     item.Reason,
+				CreatedAt:  item.CreatedAt,
+				ExpiresAt:  item.ExpiresAt,
+				CanceledAt: item.CanceledAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListBlogEntriesByAuthorForLister(ctx context.Context, arg ListBlogEntriesByAuthorForListerParams) ([]*ListBlogEntriesByAuthorForListerRow, error) {
+	var conv pgConv
+	res, err := s.q.ListBlogEntriesByAuthorForLister(ctx, dbpostgres.ListBlogEntriesByAuthorForListerParams{
+		ListerID:          arg.ListerID,
+		AuthorID:          arg.AuthorID,
+		IsAdmin:           arg.IsAdmin,
+		UserID:            arg.UserID,
+		Offset:            arg.Offset,
+		Limit:             arg.Limit,
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: arg.ScheduledViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := func(items []*dbpostgres.ListBlogEntriesByAuthorForListerRow) []*ListBlogEntriesByAuthorForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListBlogEntriesByAuthorForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListBlogEntriesByAuthorForListerRow{
+				Idblogs:       item.Idblogs,
+				ForumthreadID: item.ForumthreadID,
+				UsersIdusers:  item.UsersIdusers,
+				LanguageID:    item.LanguageID,
+				Blog:          item.Blog,
+				Written:       item.Written,
+				Timezone:      item.Timezone,
+				Username:      item.Username,
+				Comments:      item.Comments,
+				IsOwner:       item.IsOwner,
+				Title:         conv.toString(item.Title),
+			}
+		}
+		return out
+	}(res)
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	return out, nil
+}
+
+func (s *postgresQuerier) ListBlogEntriesByIDsForLister(ctx context.Context, arg ListBlogEntriesByIDsForListerParams) ([]*ListBlogEntriesByIDsForListerRow, error) {
+	res, err := s.q.ListBlogEntriesByIDsForLister(ctx, dbpostgres.ListBlogEntriesByIDsForListerParams{
+		Blogids:  arg.Blogids,
+		ListerID: arg.ListerID,
+		UserID:   arg.UserID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListBlogEntriesByIDsForListerRow) []*ListBlogEntriesByIDsForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListBlogEntriesByIDsForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListBlogEntriesByIDsForListerRow{
+				Idblogs:       item.Idblogs,
+				ForumthreadID: item.ForumthreadID,
+				UsersIdusers:  item.UsersIdusers,
+				LanguageID:    item.LanguageID,
+				Blog:          item.Blog,
+				Written:       item.Written,
+				Timezone:      item.Timezone,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListBlogEntriesForLister(ctx context.Context, arg ListBlogEntriesForListerParams) ([]*ListBlogEntriesForListerRow, error) {
+	res, err := s.q.ListBlogEntriesForLister(ctx, dbpostgres.ListBlogEntriesForListerParams{
+		ListerID:          arg.ListerID,
+		IsAdmin:           arg.IsAdmin,
+		UserID:            arg.UserID,
+		Offset:            arg.Offset,
+		Limit:             arg.Limit,
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: arg.ScheduledViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListBlogEntriesForListerRow) []*ListBlogEntriesForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListBlogEntriesForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListBlogEntriesForListerRow{
+				Idblogs:       item.Idblogs,
+				ForumthreadID: item.ForumthreadID,
+				UsersIdusers:  item.UsersIdusers,
+				LanguageID:    item.LanguageID,
+				Blog:          item.Blog,
+				Written:       item.Written,
+				Timezone:      item.Timezone,
+				Username:      item.Username,
+				Comments:      item.Comments,
+				IsOwner:       item.IsOwner,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListBlogIDsBySearchWordFirstForLister(ctx context.Context, arg ListBlogIDsBySearchWordFirstForListerParams) ([]int32, error) {
+	res, err := s.q.ListBlogIDsBySearchWordFirstForLister(ctx, dbpostgres.ListBlogIDsBySearchWordFirstForListerParams{
+		Word:     arg.Word,
+		ListerID: arg.ListerID,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListBlogIDsBySearchWordNextForLister(ctx context.Context, arg ListBlogIDsBySearchWordNextForListerParams) ([]int32, error) {
+	res, err := s.q.ListBlogIDsBySearchWordNextForLister(ctx, dbpostgres.ListBlogIDsBySearchWordNextForListerParams{
+		Word:     arg.Word,
+		Ids:      arg.Ids,
+		ListerID: arg.ListerID,
+		UserID:   arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListBloggersForLister(ctx context.Context, arg ListBloggersForListerParams) ([]*ListBloggersForListerRow, error) {
+	res, err := s.q.ListBloggersForLister(ctx, dbpostgres.ListBloggersForListerParams{
+		ListerID: arg.ListerID,
+		UserID:   arg.UserID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListBloggersForListerRow) []*ListBloggersForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListBloggersForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListBloggersForListerRow{
+				Username: item.Username,
+				Count:    item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListBloggersSearchForLister(ctx context.Context, arg ListBloggersSearchForListerParams) ([]*ListBloggersSearchForListerRow, error) {
+	res, err := s.q.ListBloggersSearchForLister(ctx, dbpostgres.ListBloggersSearchForListerParams{
+		Query:    arg.Query,
+		ListerID: arg.ListerID,
+		UserID:   arg.UserID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListBloggersSearchForListerRow) []*ListBloggersSearchForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListBloggersSearchForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListBloggersSearchForListerRow{
+				Username: item.Username,
+				Count:    item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListBoardsByParentIDForLister(ctx context.Context, arg ListBoardsByParentIDForListerParams) ([]*Imageboard, error) {
+	res, err := s.q.ListBoardsByParentIDForLister(ctx, dbpostgres.ListBoardsByParentIDForListerParams{
+		ParentID:     arg.ParentID,
+		ListerUserID: arg.ListerUserID,
+		Offset:       arg.Offset,
+		Limit:        arg.Limit,
+		ListerID:     arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Imageboard) []*Imageboard {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Imageboard, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Imageboard{
+				Idimageboard:           item.Idimageboard,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Title:                  item.Title,
+				Description:            item.Description,
+				ApprovalRequired:       (item.ApprovalRequired != 0),
+				DeletedAt:              item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListBoardsForLister(ctx context.Context, arg ListBoardsForListerParams) ([]*Imageboard, error) {
+	res, err := s.q.ListBoardsForLister(ctx, dbpostgres.ListBoardsForListerParams{
+		ListerUserID: arg.ListerUserID,
+		Offset:       arg.Offset,
+		Limit:        arg.Limit,
+		ListerID:     arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Imageboard) []*Imageboard {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Imageboard, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Imageboard{
+				Idimageboard:           item.Idimageboard,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Title:                  item.Title,
+				Description:            item.Description,
+				ApprovalRequired:       (item.ApprovalRequired != 0),
+				DeletedAt:              item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListCommentIDsBySearchWordFirstForListerInRestrictedTopic(ctx context.Context, arg ListCommentIDsBySearchWordFirstForListerInRestrictedTopicParams) ([]int32, error) {
+	res, err := s.q.ListCommentIDsBySearchWordFirstForListerInRestrictedTopic(ctx, dbpostgres.ListCommentIDsBySearchWordFirstForListerInRestrictedTopicParams{
+		Word:     arg.Word,
+		Ftids:    arg.Ftids,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopic(ctx context.Context, arg ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopicParams) ([]int32, error) {
+	res, err := s.q.ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopic(ctx, dbpostgres.ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopicParams{
+		Word:     arg.Word,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListCommentIDsBySearchWordNextForListerInRestrictedTopic(ctx context.Context, arg ListCommentIDsBySearchWordNextForListerInRestrictedTopicParams) ([]int32, error) {
+	res, err := s.q.ListCommentIDsBySearchWordNextForListerInRestrictedTopic(ctx, dbpostgres.ListCommentIDsBySearchWordNextForListerInRestrictedTopicParams{
+		Word:     arg.Word,
+		Ids:      arg.Ids,
+		Ftids:    arg.Ftids,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListCommentIDsBySearchWordNextForListerNotInRestrictedTopic(ctx context.Context, arg ListCommentIDsBySearchWordNextForListerNotInRestrictedTopicParams) ([]int32, error) {
+	res, err := s.q.ListCommentIDsBySearchWordNextForListerNotInRestrictedTopic(ctx, dbpostgres.ListCommentIDsBySearchWordNextForListerNotInRestrictedTopicParams{
+		Word:     arg.Word,
+		Ids:      arg.Ids,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListContentLabelStatus(ctx context.Context, arg ListContentLabelStatusParams) ([]*ListContentLabelStatusRow, error) {
+	res, err := s.q.ListContentLabelStatus(ctx, dbpostgres.ListContentLabelStatusParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListContentLabelStatusRow) []*ListContentLabelStatusRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListContentLabelStatusRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListContentLabelStatusRow{
+				Item:   item.Item,
+				ItemID: item.ItemID,
+				Label:  item.Label,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListContentPrivateLabels(ctx context.Context, arg ListContentPrivateLabelsParams) ([]*ListContentPrivateLabelsRow, error) {
+	res, err := s.q.ListContentPrivateLabels(ctx, dbpostgres.ListContentPrivateLabelsParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		UserID: arg.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListContentPrivateLabelsRow) []*ListContentPrivateLabelsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListContentPrivateLabelsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListContentPrivateLabelsRow{
+				Item:   item.Item,
+				ItemID: item.ItemID,
+				UserID: item.UserID,
+				Label:  item.Label,
+				Invert: item.Invert,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListContentPublicLabels(ctx context.Context, arg ListContentPublicLabelsParams) ([]*ListContentPublicLabelsRow, error) {
+	res, err := s.q.ListContentPublicLabels(ctx, dbpostgres.ListContentPublicLabelsParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListContentPublicLabelsRow) []*ListContentPublicLabelsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListContentPublicLabelsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListContentPublicLabelsRow{
+				Item:   item.Item,
+				ItemID: item.ItemID,
+				Label:  item.Label,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListContentRevisionsByItem(ctx context.Context, arg ListContentRevisionsByItemParams) ([]*ListContentRevisionsByItemRow, error) {
+	res, err := s.q.ListContentRevisionsByItem(ctx, dbpostgres.ListContentRevisionsByItemParams{
+		ItemType: arg.ItemType,
+		ItemID:   arg.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListContentRevisionsByItemRow) []*ListContentRevisionsByItemRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListContentRevisionsByItemRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListContentRevisionsByItemRow{
+				ID:             item.ID,
+				ItemType:       item.ItemType,
+				ItemID:         item.ItemID,
+				UsersIdusers:   item.UsersIdusers,
+				Title:          item.Title,
+				Abstract:       item.Abstract,
+				Body:           item.Body,
+				RestoredFromID: item.RestoredFromID,
+				CreatedAt:      item.CreatedAt,
+				Timezone:       item.Timezone,
+				Username:       item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListDraftsForUser(ctx context.Context, userID int32) ([]*Draft, error) {
+	res, err := s.q.ListDraftsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Draft) []*Draft {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Draft, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Draft{
+				ID:        item.ID,
+				UserID:    item.UserID,
+				Target:    item.Target,
+				Url:       item.Url,
+				Content:   item.Content,
+				UpdatedAt: item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListDuePendingImageCacheEntries(ctx context.Context, arg ListDuePendingImageCacheEntriesParams) ([]*ImageCacheEntry, error) {
+	res, err := s.q.ListDuePendingImageCacheEntries(ctx, dbpostgres.ListDuePendingImageCacheEntriesParams{
+		RetryCount:    arg.RetryCount,
+		NextAttemptAt: arg.NextAttemptAt,
+		Limit:         arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ImageCacheEntry) []*ImageCacheEntry {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ImageCacheEntry, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ImageCacheEntry{
+				ID:               item.ID,
+				SourceUrl:        item.SourceUrl,
+				SourceKind:       item.SourceKind,
+				Status:           item.Status,
+				CreatedAt:        item.CreatedAt,
+				LastUsedAt:       item.LastUsedAt,
+				FetchedAt:        item.FetchedAt,
+				ExpiresAt:        item.ExpiresAt,
+				ContentExpiresAt: item.ContentExpiresAt,
+				ContentType:      item.ContentType,
+				SizeBytes:        item.SizeBytes,
+				Width:            item.Width,
+				Height:           item.Height,
+				Checksum:         item.Checksum,
+				ThumbnailID:      item.ThumbnailID,
+				UploadedImageID:  item.UploadedImageID,
+				ErrorMessage:     item.ErrorMessage,
+				RetryCount:       item.RetryCount,
+				LastAttemptAt:    item.LastAttemptAt,
+				NextAttemptAt:    item.NextAttemptAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListEffectiveRoleIDsByUserID(ctx context.Context, usersIdusers int32) ([]int32, error) {
+	res, err := s.q.ListEffectiveRoleIDsByUserID(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListExpiredExternalImageCacheEntries(ctx context.Context, arg ListExpiredExternalImageCacheEntriesParams) ([]*ImageCacheEntry, error) {
+	res, err := s.q.ListExpiredExternalImageCacheEntries(ctx, dbpostgres.ListExpiredExternalImageCacheEntriesParams{
+		ExpiresAt: arg.ExpiresAt,
+		Limit:     arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ImageCacheEntry) []*ImageCacheEntry {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ImageCacheEntry, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ImageCacheEntry{
+				ID:               item.ID,
+				SourceUrl:        item.SourceUrl,
+				SourceKind:       item.SourceKind,
+				Status:           item.Status,
+				CreatedAt:        item.CreatedAt,
+				LastUsedAt:       item.LastUsedAt,
+				FetchedAt:        item.FetchedAt,
+				ExpiresAt:        item.ExpiresAt,
+				ContentExpiresAt: item.ContentExpiresAt,
+				ContentType:      item.ContentType,
+				SizeBytes:        item.SizeBytes,
+				Width:            item.Width,
+				Height:           item.Height,
+				Checksum:         item.Checksum,
+				ThumbnailID:      item.ThumbnailID,
+				UploadedImageID:  item.UploadedImageID,
+				ErrorMessage:     item.ErrorMessage,
+				RetryCount:       item.RetryCount,
+				LastAttemptAt:    item.LastAttemptAt,
+				NextAttemptAt:    item.NextAttemptAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListForumPollOptionIDsForVoter(ctx context.Context, arg ListForumPollOptionIDsForVoterParams) ([]int32, error) {
+	res, err := s.q.ListForumPollOptionIDsForVoter(ctx, dbpostgres.ListForumPollOptionIDsForVoterParams{
+		PollID:  arg.PollID,
+		VoterID: arg.VoterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListForumPollOptionsWithVoteCounts(ctx context.Context, pollID int32) ([]*ListForumPollOptionsWithVoteCountsRow, error) {
+	res, err := s.q.ListForumPollOptionsWithVoteCounts(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListForumPollOptionsWithVoteCountsRow) []*ListForumPollOptionsWithVoteCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListForumPollOptionsWithVoteCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListForumPollOptionsWithVoteCountsRow{
+				ID:       item.ID,
+				PollID:   item.PollID,
+				Position: item.Position,
+				Label:    item.Label,
+				Votes:    item.Votes,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListForumPollVotersForPoll(ctx context.Context, pollID int32) ([]*ListForumPollVotersForPollRow, error) {
+	res, err := s.q.ListForumPollVotersForPoll(ctx, pollID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListForumPollVotersForPollRow) []*ListForumPollVotersForPollRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListForumPollVotersForPollRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListForumPollVotersForPollRow{
+				OptionID: item.OptionID,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListForumcategoryPath(ctx context.Context, categoryID int32) ([]*ListForumcategoryPathRow, error) {
+	res, err := s.q.ListForumcategoryPath(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListForumcategoryPathRow) []*ListForumcategoryPathRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListForumcategoryPathRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListForumcategoryPathRow{
+				Idforumcategory: item.Idforumcategory,
+				Title:           item.Title,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListGrants(ctx context.Context) ([]*Grant, error) {
+	res, err := s.q.ListGrants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Grant) []*Grant {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Grant, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Grant{
+				ID:        item.ID,
+				CreatedAt: item.CreatedAt,
+				UpdatedAt: item.UpdatedAt,
+				UserID:    item.UserID,
+				RoleID:    item.RoleID,
+				Section:   item.Section,
+				Item:      item.Item,
+				RuleType:  item.RuleType,
+				ItemID:    item.ItemID,
+				ItemRule:  item.ItemRule,
+				Action:    item.Action,
+				Extra:     item.Extra,
+				Active:    (item.Active != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListGrantsByUserID(ctx context.Context, userID sql.NullInt32) ([]*Grant, error) {
+	res, err := s.q.ListGrantsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Grant) []*Grant {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Grant, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Grant{
+				ID:        item.ID,
+				CreatedAt: item.CreatedAt,
+				UpdatedAt: item.UpdatedAt,
+				UserID:    item.UserID,
+				RoleID:    item.RoleID,
+				Section:   item.Section,
+				Item:      item.Item,
+				RuleType:  item.RuleType,
+				ItemID:    item.ItemID,
+				ItemRule:  item.ItemRule,
+				Action:    item.Action,
+				Extra:     item.Extra,
+				Active:    (item.Active != 0),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListGrantsExtended(ctx context.Context, arg ListGrantsExtendedParams) ([]*ListGrantsExtendedRow, error) {
+	res, err := s.q.ListGrantsExtended(ctx, dbpostgres.ListGrantsExtendedParams{
+		UserID:    arg.UserID,
+		Username:  arg.Username,
+		RoleID:    arg.RoleID,
+		RoleName:  arg.RoleName,
+		OnlyRoles: arg.OnlyRoles,
+		OnlyUsers: arg.OnlyUsers,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListGrantsExtendedRow) []*ListGrantsExtendedRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListGrantsExtendedRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListGrantsExtendedRow{
+				ID:        item.ID,
+				CreatedAt: item.CreatedAt,
+				UpdatedAt: item.UpdatedAt,
+				UserID:    item.UserID,
+				RoleID:    item.RoleID,
+				Section:   item.Section,
+				Item:      item.Item,
+				RuleType:  item.RuleType,
+				ItemID:    item.ItemID,
+				ItemRule:  item.ItemRule,
+				Action:    item.Action,
+				Extra:     item.Extra,
+				Active:    (item.Active != 0),
+				Username:  item.Username,
+				RoleName:  item.RoleName,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListImagePostsByBoardForLister(ctx context.Context, arg ListImagePostsByBoardForListerParams) ([]*ListImagePostsByBoardForListerRow, error) {
+	res, err := s.q.ListImagePostsByBoardForLister(ctx, dbpostgres.ListImagePostsByBoardForListerParams{
+		BoardID:      arg.BoardID,
+		ListerUserID: arg.ListerUserID,
+		Offset:       arg.Offset,
+		Limit:        arg.Limit,
+		ListerID:     arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListImagePostsByBoardForListerRow) []*ListImagePostsByBoardForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListImagePostsByBoardForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListImagePostsByBoardForListerRow{
+				Idimagepost:            item.Idimagepost,
+				ForumthreadID:          item.ForumthreadID,
+				UsersIdusers:           item.UsersIdusers,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Posted:                 item.Posted,
+				Timezone:               item.Timezone,
+				Description:            item.Description,
+				Thumbnail:              item.Thumbnail,
+				Fullimage:              item.Fullimage,
+				FileSize:               item.FileSize,
+				Approved:               (item.Approved != 0),
+				DeletedAt:              item.DeletedAt,
+				LastIndex:              item.LastIndex,
+				Username:               item.Username,
+				Comments:               item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListImagePostsByPosterForLister(ctx context.Context, arg ListImagePostsByPosterForListerParams) ([]*ListImagePostsByPosterForListerRow, error) {
+	res, err := s.q.ListImagePostsByPosterForLister(ctx, dbpostgres.ListImagePostsByPosterForListerParams{
+		PosterID:     arg.PosterID,
+		ListerUserID: arg.ListerUserID,
+		Offset:       arg.Offset,
+		Limit:        arg.Limit,
+		ListerID:     arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListImagePostsByPosterForListerRow) []*ListImagePostsByPosterForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListImagePostsByPosterForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListImagePostsByPosterForListerRow{
+				Idimagepost:            item.Idimagepost,
+				ForumthreadID:          item.ForumthreadID,
+				UsersIdusers:           item.UsersIdusers,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Posted:                 item.Posted,
+				Timezone:               item.Timezone,
+				Description:            item.Description,
+				Thumbnail:              item.Thumbnail,
+				Fullimage:              item.Fullimage,
+				FileSize:               item.FileSize,
+				Approved:               (item.Approved != 0),
+				DeletedAt:              item.DeletedAt,
+				LastIndex:              item.LastIndex,
+				Username:               item.Username,
+				Comments:               item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListImageboardPath(ctx context.Context, boardID int32) ([]*ListImageboardPathRow, error) {
+	res, err := s.q.ListImageboardPath(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListImageboardPathRow) []*ListImageboardPathRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListImageboardPathRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListImageboardPathRow{
+				Idimageboard: item.Idimageboard,
+				Title:        item.Title,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListLinkerCategoryPath(ctx context.Context, categoryID int32) ([]*ListLinkerCategoryPathRow, error) {
+	res, err := s.q.ListLinkerCategoryPath(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListLinkerCategoryPathRow) []*ListLinkerCategoryPathRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListLinkerCategoryPathRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListLinkerCategoryPathRow{
+				ID:    item.ID,
+				Title: item.Title,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListNotificationsForLister(ctx context.Context, arg ListNotificationsForListerParams) ([]*Notification, error) {
+	res, err := s.q.ListNotificationsForLister(ctx, dbpostgres.ListNotificationsForListerParams{
+		ListerID: arg.ListerID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Notification) []*Notification {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Notification, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Notification{
+				ID:           item.ID,
+				UsersIdusers: item.UsersIdusers,
+				Link:         item.Link,
+				Message:      item.Message,
+				CreatedAt:    item.CreatedAt,
+				ReadAt:       item.ReadAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListOldestUsedImageCacheEntries(ctx context.Context, limit int32) ([]*ImageCacheEntry, error) {
+	res, err := s.q.ListOldestUsedImageCacheEntries(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ImageCacheEntry) []*ImageCacheEntry {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ImageCacheEntry, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ImageCacheEntry{
+				ID:               item.ID,
+				SourceUrl:        item.SourceUrl,
+				SourceKind:       item.SourceKind,
+				Status:           item.Status,
+				CreatedAt:        item.CreatedAt,
+				LastUsedAt:       item.LastUsedAt,
+				FetchedAt:        item.FetchedAt,
+				ExpiresAt:        item.ExpiresAt,
+				ContentExpiresAt: item.ContentExpiresAt,
+				ContentType:      item.ContentType,
+				SizeBytes:        item.SizeBytes,
+				Width:            item.Width,
+				Height:           item.Height,
+				Checksum:         item.Checksum,
+				ThumbnailID:      item.ThumbnailID,
+				UploadedImageID:  item.UploadedImageID,
+				ErrorMessage:     item.ErrorMessage,
+				RetryCount:       item.RetryCount,
+				LastAttemptAt:    item.LastAttemptAt,
+				NextAttemptAt:    item.NextAttemptAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListPrivateTopicParticipantsByTopicIDForUser(ctx context.Context, arg ListPrivateTopicParticipantsByTopicIDForUserParams) ([]*ListPrivateTopicParticipantsByTopicIDForUserRow, error) {
+	res, err := s.q.ListPrivateTopicParticipantsByTopicIDForUser(ctx, dbpostgres.ListPrivateTopicParticipantsByTopicIDForUserParams{
+		TopicID:  arg.TopicID,
+		ViewerID: arg.ViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListPrivateTopicParticipantsByTopicIDForUserRow) []*ListPrivateTopicParticipantsByTopicIDForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListPrivateTopicParticipantsByTopicIDForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListPrivateTopicParticipantsByTopicIDForUserRow{
+				Idusers:  item.Idusers,
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListPrivateTopicsByUserID(ctx context.Context, userID sql.NullInt32) ([]*ListPrivateTopicsByUserIDRow, error) {
+	res, err := s.q.ListPrivateTopicsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListPrivateTopicsByUserIDRow) []*ListPrivateTopicsByUserIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListPrivateTopicsByUserIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListPrivateTopicsByUserIDRow{
+				Idforumtopic:                 item.Idforumtopic,
+				Lastposter:                   item.Lastposter,
+				ForumcategoryIdforumcategory: item.ForumcategoryIdforumcategory,
+				LanguageID:                   item.LanguageID,
+				Title:                        item.Title,
+				Description:                  item.Description,
+				Threads:                      item.Threads,
+				Comments:                     item.Comments,
+				Lastaddition:                 item.Lastaddition,
+				Handler:                      item.Handler,
+				Lastposterusername:           item.Lastposterusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListPublicWritingsByUserForLister(ctx context.Context, arg ListPublicWritingsByUserForListerParams) ([]*ListPublicWritingsByUserForListerRow, error) {
+	res, err := s.q.ListPublicWritingsByUserForLister(ctx, dbpostgres.ListPublicWritingsByUserForListerParams{
+		AuthorID:          arg.AuthorID,
+		UserID:            arg.UserID,
+		Offset:            arg.Offset,
+		Limit:             arg.Limit,
+		ListerID:          arg.ListerID,
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: arg.ScheduledViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListPublicWritingsByUserForListerRow) []*ListPublicWritingsByUserForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListPublicWritingsByUserForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListPublicWritingsByUserForListerRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListPublicWritingsInCategoryForLister(ctx context.Context, arg ListPublicWritingsInCategoryForListerParams) ([]*ListPublicWritingsInCategoryForListerRow, error) {
+	res, err := s.q.ListPublicWritingsInCategoryForLister(ctx, dbpostgres.ListPublicWritingsInCategoryForListerParams{
+		WritingCategoryID: arg.WritingCategoryID,
+		UserID:            arg.UserID,
+		Offset:            arg.Offset,
+		Limit:             arg.Limit,
+		ListerID:          arg.ListerID,
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: arg.ScheduledViewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListPublicWritingsInCategoryForListerRow) []*ListPublicWritingsInCategoryForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListPublicWritingsInCategoryForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListPublicWritingsInCategoryForListerRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListReactionCountsForViewer(ctx context.Context, arg ListReactionCountsForViewerParams) ([]*ListReactionCountsForViewerRow, error) {
+	res, err := s.q.ListReactionCountsForViewer(ctx, dbpostgres.ListReactionCountsForViewerParams{
+		ViewerID: arg.ViewerID,
+		ItemType: arg.ItemType,
+		ItemID:   arg.ItemID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListReactionCountsForViewerRow) []*ListReactionCountsForViewerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListReactionCountsForViewerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListReactionCountsForViewerRow{
+				Reaction:    item.Reaction,
+				Total:       item.Total,
+				ViewerCount: item.ViewerCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListReferencedUploadPaths(ctx context.Context) ([]sql.NullString, error) {
+	res, err := s.q.ListReferencedUploadPaths(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListSiteNewsSearchFirstForLister(ctx context.Context, arg ListSiteNewsSearchFirstForListerParams) ([]int32, error) {
+	res, err := s.q.ListSiteNewsSearchFirstForLister(ctx, dbpostgres.ListSiteNewsSearchFirstForListerParams{
+		Word:     arg.Word,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListSiteNewsSearchNextForLister(ctx context.Context, arg ListSiteNewsSearchNextForListerParams) ([]int32, error) {
+	res, err := s.q.ListSiteNewsSearchNextForLister(ctx, dbpostgres.ListSiteNewsSearchNextForListerParams{
+		Word:     arg.Word,
+		Ids:      arg.Ids,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListSubscribersForPattern(ctx context.Context, arg ListSubscribersForPatternParams) ([]int32, error) {
+	res, err := s.q.ListSubscribersForPattern(ctx, dbpostgres.ListSubscribersForPatternParams{
+		Pattern: arg.Pattern,
+		Method:  arg.Method,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListSubscribersForPatterns(ctx context.Context, arg ListSubscribersForPatternsParams) ([]int32, error) {
+	res, err := s.q.ListSubscribersForPatterns(ctx, dbpostgres.ListSubscribersForPatternsParams{
+		Patterns: arg.Patterns,
+		Method:   arg.Method,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListSubscriptionArchetypes(ctx context.Context) ([]*RoleSubscriptionArchetype, error) {
+	res, err := s.q.ListSubscriptionArchetypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.RoleSubscriptionArchetype) []*RoleSubscriptionArchetype {
+		if items == nil {
+			return nil
+		}
+		out := make([]*RoleSubscriptionArchetype, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &RoleSubscriptionArchetype{
+				ID:            item.ID,
+				RoleID:        item.RoleID,
+				ArchetypeName: item.ArchetypeName,
+				Pattern:       item.Pattern,
+				Method:        item.Method,
+				CreatedAt:     item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListSubscriptionsByUser(ctx context.Context, usersIdusers int32) ([]*ListSubscriptionsByUserRow, error) {
+	res, err := s.q.ListSubscriptionsByUser(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListSubscriptionsByUserRow) []*ListSubscriptionsByUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListSubscriptionsByUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListSubscriptionsByUserRow{
+				ID:      item.ID,
+				Pattern: item.Pattern,
+				Method:  item.Method,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListThreadImagePaths(ctx context.Context, arg ListThreadImagePathsParams) ([]sql.NullString, error) {
+	res, err := s.q.ListThreadImagePaths(ctx, dbpostgres.ListThreadImagePathsParams{
+		ThreadID: arg.ThreadID,
+		Paths: func(s []sql.NullString) []string {
+			if s == nil {
+				return nil
+			}
+			out := make([]string, len(s))
+			for i, v := range s {
+				out[i] = v.String
+			}
+			return out
+		}(arg.Paths),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListThreadSubscriptionsByUser(ctx context.Context, usersIdusers int32) ([]*ListThreadSubscriptionsByUserRow, error) {
+	res, err := s.q.ListThreadSubscriptionsByUser(ctx, usersIdusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListThreadSubscriptionsByUserRow) []*ListThreadSubscriptionsByUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListThreadSubscriptionsByUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListThreadSubscriptionsByUserRow{
+				ID:      item.ID,
+				Pattern: item.Pattern,
+				Method:  item.Method,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListUnreadNotificationsForLister(ctx context.Context, arg ListUnreadNotificationsForListerParams) ([]*Notification, error) {
+	res, err := s.q.ListUnreadNotificationsForLister(ctx, dbpostgres.ListUnreadNotificationsForListerParams{
+		ListerID: arg.ListerID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Notification) []*Notification {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Notification, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Notification{
+				ID:           item.ID,
+				UsersIdusers: item.UsersIdusers,
+				Link:         item.Link,
+				Message:      item.Message,
+				CreatedAt:    item.CreatedAt,
+				ReadAt:       item.ReadAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListUnreadPrivateThreadsForUser(ctx context.Context, arg ListUnreadPrivateThreadsForUserParams) ([]*ListUnreadPrivateThreadsForUserRow, error) {
+	var conv pgConv
+	pgArg1 := dbpostgres.ListUnreadPrivateThreadsForUserParams{
+		TopicIDNull: conv.toNullInt32(arg.TopicIDNull),
+		TopicIDVal:  arg.TopicIDVal,
+		GrantUserID: arg.GrantUserID,
+		GranteeID:   arg.GranteeID,
+		Offset:      arg.Offset,
+		Limit:       arg.Limit,
+	}
+	if conv.err != nil {
+		return nil, conv.err
+	}
+	res, err := s.q.ListUnreadPrivateThreadsForUser(ctx, pgArg1)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListUnreadPrivateThreadsForUserRow) []*ListUnreadPrivateThreadsForUserRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListUnreadPrivateThreadsForUserRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListUnreadPrivateThreadsForUserRow{
+				Idforumthread:      item.Idforumthread,
+				TopicID:            item.TopicID,
+				TopicTitle:         item.TopicTitle,
+				Lastaddition:       item.Lastaddition,
+				Lastposter:         item.Lastposter,
+				Comments:           item.Comments,
+				Lastposterusername: item.Lastposterusername,
+				Lastposterid:       item.Lastposterid,
+				Firstpostusername:  item.Firstpostusername,
+				Firstpostuserid:    item.Firstpostuserid,
+				Firstpostwritten:   item.Firstpostwritten,
+				Firstposttext:      item.Firstposttext,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListUploadedImagePathsByUser(ctx context.Context, arg ListUploadedImagePathsByUserParams) ([]sql.NullString, error) {
+	res, err := s.q.ListUploadedImagePathsByUser(ctx, dbpostgres.ListUploadedImagePathsByUserParams{
+		UserID: arg.UserID,
+		Paths: func(s []sql.NullString) []string {
+			if s == nil {
+				return nil
+			}
+			out := make([]string, len(s))
+			for i, v := range s {
+				out[i] = v.String
+			}
+			return out
+		}(arg.Paths),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListUploadedImagesByUserForLister(ctx context.Context, arg ListUploadedImagesByUserForListerParams) ([]*UploadedImage, error) {
+	res, err := s.q.ListUploadedImagesByUserForLister(ctx, dbpostgres.ListUploadedImagesByUserForListerParams{
+		UserID:        arg.UserID,
+		ListerMatchID: arg.ListerMatchID,
+		Offset:        arg.Offset,
+		Limit:         arg.Limit,
+		ListerID:      arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UploadedImage) []*UploadedImage {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UploadedImage, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UploadedImage{
+				Iduploadedimage: item.Iduploadedimage,
+				UsersIdusers:    item.UsersIdusers,
+				Path:            item.Path,
+				Width:           item.Width,
+				Height:          item.Height,
+				FileSize:        item.FileSize,
+				Uploaded:        item.Uploaded,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListUserEmailsForLister(ctx context.Context, arg ListUserEmailsForListerParams) ([]*UserEmail, error) {
+	res, err := s.q.ListUserEmailsForLister(ctx, dbpostgres.ListUserEmailsForListerParams{
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserEmail) []*UserEmail {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserEmail, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserEmail{
+				ID:                    item.ID,
+				UserID:                item.UserID,
+				Email:                 item.Email,
+				VerifiedAt:            item.VerifiedAt,
+				LastVerificationCode:  item.LastVerificationCode,
+				VerificationExpiresAt: item.VerificationExpiresAt,
+				NotificationPriority:  item.NotificationPriority,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListUsersWithRoles(ctx context.Context) ([]*ListUsersWithRolesRow, error) {
+	res, err := s.q.ListUsersWithRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListUsersWithRolesRow) []*ListUsersWithRolesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListUsersWithRolesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListUsersWithRolesRow{
+				Idusers:  item.Idusers,
+				Username: item.Username,
+				Roles:    sql.NullString{String: string(item.Roles), Valid: item.Roles != nil},
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListWritersForLister(ctx context.Context, arg ListWritersForListerParams) ([]*ListWritersForListerRow, error) {
+	res, err := s.q.ListWritersForLister(ctx, dbpostgres.ListWritersForListerParams{
+		UserID:   arg.UserID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListWritersForListerRow) []*ListWritersForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListWritersForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListWritersForListerRow{
+				Username: item.Username,
+				Count:    item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListWritersSearchForLister(ctx context.Context, arg ListWritersSearchForListerParams) ([]*ListWritersSearchForListerRow, error) {
+	res, err := s.q.ListWritersSearchForLister(ctx, dbpostgres.ListWritersSearchForListerParams{
+		Query:    arg.Query,
+		UserID:   arg.UserID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListWritersSearchForListerRow) []*ListWritersSearchForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListWritersSearchForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListWritersSearchForListerRow{
+				Username: item.Username,
+				Count:    item.Count,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListWritingCategoriesForLister(ctx context.Context, arg ListWritingCategoriesForListerParams) ([]*WritingCategory, error) {
+	res, err := s.q.ListWritingCategoriesForLister(ctx, arg.ListerID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.WritingCategory) []*WritingCategory {
+		if items == nil {
+			return nil
+		}
+		out := make([]*WritingCategory, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &WritingCategory{
+				Idwritingcategory: item.Idwritingcategory,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Description:       item.Description,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListWritingSearchFirstForLister(ctx context.Context, arg ListWritingSearchFirstForListerParams) ([]int32, error) {
+	res, err := s.q.ListWritingSearchFirstForLister(ctx, dbpostgres.ListWritingSearchFirstForListerParams{
+		Word:     arg.Word,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListWritingSearchNextForLister(ctx context.Context, arg ListWritingSearchNextForListerParams) ([]int32, error) {
+	res, err := s.q.ListWritingSearchNextForLister(ctx, dbpostgres.ListWritingSearchNextForListerParams{
+		Word:     arg.Word,
+		Ids:      arg.Ids,
+		UserID:   arg.UserID,
+		ListerID: arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) ListWritingcategoryPath(ctx context.Context, categoryID int32) ([]*ListWritingcategoryPathRow, error) {
+	res, err := s.q.ListWritingcategoryPath(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListWritingcategoryPathRow) []*ListWritingcategoryPathRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListWritingcategoryPathRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListWritingcategoryPathRow{
+				Idwritingcategory: item.Idwritingcategory,
+				Title:             item.Title,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) ListWritingsByIDsForLister(ctx context.Context, arg ListWritingsByIDsForListerParams) ([]*ListWritingsByIDsForListerRow, error) {
+	res, err := s.q.ListWritingsByIDsForLister(ctx, dbpostgres.ListWritingsByIDsForListerParams{
+		WritingIds:    arg.WritingIds,
+		ListerMatchID: arg.ListerMatchID,
+		Offset:        arg.Offset,
+		Limit:         arg.Limit,
+		ListerID:      arg.ListerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ListWritingsByIDsForListerRow) []*ListWritingsByIDsForListerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListWritingsByIDsForListerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListWritingsByIDsForListerRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Writerid:          item.Writerid,
+				Writerusername:    item.Writerusername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) RecordImageCacheFetchFailure(ctx context.Context, arg RecordImageCacheFetchFailureParams) error {
+	return s.q.RecordImageCacheFetchFailure(ctx, dbpostgres.RecordImageCacheFetchFailureParams{
+		RetryCount:    arg.RetryCount,
+		ErrorMessage:  arg.ErrorMessage,
+		LastAttemptAt: arg.LastAttemptAt,
+		RetryCount_2:  arg.RetryCount_2,
+		NextAttemptAt: arg.NextAttemptAt,
+		ID:            arg.ID,
+	})
+}
+
+func (s *postgresQuerier) RemoveContentLabelStatus(ctx context.Context, arg RemoveContentLabelStatusParams) error {
+	return s.q.RemoveContentLabelStatus(ctx, dbpostgres.RemoveContentLabelStatusParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		Label:  arg.Label,
+	})
+}
+
+func (s *postgresQuerier) RemoveContentPrivateLabel(ctx context.Context, arg RemoveContentPrivateLabelParams) error {
+	return s.q.RemoveContentPrivateLabel(ctx, dbpostgres.RemoveContentPrivateLabelParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		UserID: arg.UserID,
+		Label:  arg.Label,
+	})
+}
+
+func (s *postgresQuerier) RemoveContentPublicLabel(ctx context.Context, arg RemoveContentPublicLabelParams) error {
+	return s.q.RemoveContentPublicLabel(ctx, dbpostgres.RemoveContentPublicLabelParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		Label:  arg.Label,
+	})
+}
+
+func (s *postgresQuerier) RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) error {
+	return s.q.RevokeAPIKey(ctx, dbpostgres.RevokeAPIKeyParams{
+		ID:           arg.ID,
+		UsersIdusers: arg.UsersIdusers,
+	})
+}
+
+func (s *postgresQuerier) SearchGrants(ctx context.Context, arg SearchGrantsParams) ([]*SearchGrantsRow, error) {
+	res, err := s.q.SearchGrants(ctx, dbpostgres.SearchGrantsParams{
+		Section: arg.Section,
+		Item:    arg.Item,
+		ItemID:  arg.ItemID,
+		Active: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active.Bool), Valid: arg.Active.Valid},
+		Username: arg.Username,
+		RoleName: arg.RoleName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SearchGrantsRow) []*SearchGrantsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SearchGrantsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SearchGrantsRow{
+				ID:        item.ID,
+				CreatedAt: item.CreatedAt,
+				UpdatedAt: item.UpdatedAt,
+				UserID:    item.UserID,
+				RoleID:    item.RoleID,
+				Section:   item.Section,
+				Item:      item.Item,
+				RuleType:  item.RuleType,
+				ItemID:    item.ItemID,
+				ItemRule:  item.ItemRule,
+				Action:    item.Action,
+				Extra:     item.Extra,
+				Active:    (item.Active != 0),
+				Username:  item.Username,
+				RoleName:  item.RoleName,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SetNotificationPriorityForLister(ctx context.Context, arg SetNotificationPriorityForListerParams) error {
+	return s.q.SetNotificationPriorityForLister(ctx, dbpostgres.SetNotificationPriorityForListerParams{
+		NotificationPriority: arg.NotificationPriority,
+		ID:                   arg.ID,
+		ListerID:             arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) SetNotificationReadForLister(ctx context.Context, arg SetNotificationReadForListerParams) error {
+	return s.q.SetNotificationReadForLister(ctx, dbpostgres.SetNotificationReadForListerParams{
+		ID:       arg.ID,
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) SetNotificationUnreadForLister(ctx context.Context, arg SetNotificationUnreadForListerParams) error {
+	return s.q.SetNotificationUnreadForLister(ctx, dbpostgres.SetNotificationUnreadForListerParams{
+		ID:       arg.ID,
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) SetNotificationsReadForListerBatch(ctx context.Context, arg SetNotificationsReadForListerBatchParams) error {
+	return s.q.SetNotificationsReadForListerBatch(ctx, dbpostgres.SetNotificationsReadForListerBatchParams{
+		ListerID: arg.ListerID,
+		Ids:      arg.Ids,
+	})
+}
+
+func (s *postgresQuerier) SetPendingTOTPForUser(ctx context.Context, arg SetPendingTOTPForUserParams) error {
+	return s.q.SetPendingTOTPForUser(ctx, dbpostgres.SetPendingTOTPForUserParams{
+		UserID: arg.UserID,
+		Secret: arg.Secret,
+	})
+}
+
+func (s *postgresQuerier) SetVerificationCodeForLister(ctx context.Context, arg SetVerificationCodeForListerParams) error {
+	return s.q.SetVerificationCodeForLister(ctx, dbpostgres.SetVerificationCodeForListerParams{
+		LastVerificationCode:  arg.LastVerificationCode,
+		VerificationExpiresAt: arg.VerificationExpiresAt,
+		ID:                    arg.ID,
+		ListerID:              arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) SystemAddToBlogsSearch(ctx context.Context, arg SystemAddToBlogsSearchParams) error {
+	return s.q.SystemAddToBlogsSearch(ctx, dbpostgres.SystemAddToBlogsSearchParams{
+		BlogID:                         arg.BlogID,
+		SearchwordlistIdsearchwordlist: arg.SearchwordlistIdsearchwordlist,
+		WordCount:                      arg.WordCount,
+	})
+}
+
+func (s *postgresQuerier) SystemAddToForumCommentSearch(ctx context.Context, arg SystemAddToForumCommentSearchParams) error {
+	return s.q.SystemAddToForumCommentSearch(ctx, dbpostgres.SystemAddToForumCommentSearchParams{
+		CommentID:                      arg.CommentID,
+		SearchwordlistIdsearchwordlist: arg.SearchwordlistIdsearchwordlist,
+		WordCount:                      arg.WordCount,
+	})
+}
+
+func (s *postgresQuerier) SystemAddToForumWritingSearch(ctx context.Context, arg SystemAddToForumWritingSearchParams) error {
+	return s.q.SystemAddToForumWritingSearch(ctx, dbpostgres.SystemAddToForumWritingSearchParams{
+		WritingID:                      arg.WritingID,
+		SearchwordlistIdsearchwordlist: arg.SearchwordlistIdsearchwordlist,
+		WordCount:                      arg.WordCount,
+	})
+}
+
+func (s *postgresQuerier) SystemAddToImagePostSearch(ctx context.Context, arg SystemAddToImagePostSearchParams) error {
+	return s.q.SystemAddToImagePostSearch(ctx, dbpostgres.SystemAddToImagePostSearchParams{
+		ImagePostID:                    arg.ImagePostID,
+		SearchwordlistIdsearchwordlist: arg.SearchwordlistIdsearchwordlist,
+		WordCount:                      arg.WordCount,
+	})
+}
+
+func (s *postgresQuerier) SystemAddToLinkerSearch(ctx context.Context, arg SystemAddToLinkerSearchParams) error {
+	return s.q.SystemAddToLinkerSearch(ctx, dbpostgres.SystemAddToLinkerSearchParams{
+		LinkerID:                       arg.LinkerID,
+		SearchwordlistIdsearchwordlist: arg.SearchwordlistIdsearchwordlist,
+		WordCount:                      arg.WordCount,
+	})
+}
+
+func (s *postgresQuerier) SystemAddToSiteNewsSearch(ctx context.Context, arg SystemAddToSiteNewsSearchParams) error {
+	return s.q.SystemAddToSiteNewsSearch(ctx, dbpostgres.SystemAddToSiteNewsSearchParams{
+		SiteNewsID:                     arg.SiteNewsID,
+		SearchwordlistIdsearchwordlist: arg.SearchwordlistIdsearchwordlist,
+		WordCount:                      arg.WordCount,
+	})
+}
+
+func (s *postgresQuerier) SystemAssignBlogEntryThreadID(ctx context.Context, arg SystemAssignBlogEntryThreadIDParams) error {
+	return s.q.SystemAssignBlogEntryThreadID(ctx, dbpostgres.SystemAssignBlogEntryThreadIDParams{
+		ForumthreadID: arg.ForumthreadID,
+		Idblogs:       arg.Idblogs,
+	})
+}
+
+func (s *postgresQuerier) SystemAssignImagePostThreadID(ctx context.Context, arg SystemAssignImagePostThreadIDParams) error {
+	return s.q.SystemAssignImagePostThreadID(ctx, dbpostgres.SystemAssignImagePostThreadIDParams{
+		ForumthreadID: arg.ForumthreadID,
+		Idimagepost:   arg.Idimagepost,
+	})
+}
+
+func (s *postgresQuerier) SystemAssignLinkerThreadID(ctx context.Context, arg SystemAssignLinkerThreadIDParams) error {
+	return s.q.SystemAssignLinkerThreadID(ctx, dbpostgres.SystemAssignLinkerThreadIDParams{
+		ThreadID: arg.ThreadID,
+		ID:       arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemAssignNewsThreadID(ctx context.Context, arg SystemAssignNewsThreadIDParams) error {
+	return s.q.SystemAssignNewsThreadID(ctx, dbpostgres.SystemAssignNewsThreadIDParams{
+		ForumthreadID: arg.ForumthreadID,
+		Idsitenews:    arg.Idsitenews,
+	})
+}
+
+func (s *postgresQuerier) SystemAssignWritingThreadID(ctx context.Context, arg SystemAssignWritingThreadIDParams) error {
+	return s.q.SystemAssignWritingThreadID(ctx, dbpostgres.SystemAssignWritingThreadIDParams{
+		ForumthreadID: arg.ForumthreadID,
+		Idwriting:     arg.Idwriting,
+	})
+}
+
+func (s *postgresQuerier) SystemCheckGrant(ctx context.Context, arg SystemCheckGrantParams) (int32, error) {
+	res, err := s.q.SystemCheckGrant(ctx, dbpostgres.SystemCheckGrantParams{
+		Section:                arg.Section,
+		Item:                   arg.Item,
+		Action:                 arg.Action,
+		ItemID:                 arg.ItemID,
+		IsSpecificPrivateForum: arg.IsSpecificPrivateForum,
+		UserID:                 arg.UserID,
+		ViewerID:               arg.ViewerID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemCheckRoleGrant(ctx context.Context, arg SystemCheckRoleGrantParams) (int32, error) {
+	res, err := s.q.SystemCheckRoleGrant(ctx, dbpostgres.SystemCheckRoleGrantParams{
+		Name:   arg.Name,
+		Action: arg.Action,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemClaimActivityPubDelivery(ctx context.Context, arg SystemClaimActivityPubDeliveryParams) (int64, error) {
+	res, err := s.q.SystemClaimActivityPubDelivery(ctx, dbpostgres.SystemClaimActivityPubDeliveryParams{
+		LeaseUntil: arg.LeaseUntil,
+		ID:         arg.ID,
+		Now:        arg.Now,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemClearContentLabelStatus(ctx context.Context, arg SystemClearContentLabelStatusParams) error {
+	return s.q.SystemClearContentLabelStatus(ctx, dbpostgres.SystemClearContentLabelStatusParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+	})
+}
+
+func (s *postgresQuerier) SystemClearContentPrivateLabel(ctx context.Context, arg SystemClearContentPrivateLabelParams) error {
+	return s.q.SystemClearContentPrivateLabel(ctx, dbpostgres.SystemClearContentPrivateLabelParams{
+		Item:   arg.Item,
+		ItemID: arg.ItemID,
+		Label:  arg.Label,
+	})
+}
+
+func (s *postgresQuerier) SystemCloseForumPoll(ctx context.Context, arg SystemCloseForumPollParams) (int64, error) {
+	res, err := s.q.SystemCloseForumPoll(ctx, dbpostgres.SystemCloseForumPollParams{
+		ClosedAt: arg.ClosedAt,
+		ID:       arg.ID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemCopyPrivateThreadGrantsToThread(ctx context.Context, arg SystemCopyPrivateThreadGrantsToThreadParams) error {
+	return s.q.SystemCopyPrivateThreadGrantsToThread(ctx, dbpostgres.SystemCopyPrivateThreadGrantsToThreadParams{
+		DstThreadID: arg.DstThreadID,
+		SrcThreadID: arg.SrcThreadID,
+	})
+}
+
+func (s *postgresQuerier) SystemCopyPrivateTopicGrantsToThread(ctx context.Context, arg SystemCopyPrivateTopicGrantsToThreadParams) error {
+	return s.q.SystemCopyPrivateTopicGrantsToThread(ctx, dbpostgres.SystemCopyPrivateTopicGrantsToThreadParams{
+		ThreadID: arg.ThreadID,
+		TopicID:  arg.TopicID,
+	})
+}
+
+func (s *postgresQuerier) SystemCountActivityPubActivitiesForUser(ctx context.Context, userID int32) (int64, error) {
+	res, err := s.q.SystemCountActivityPubActivitiesForUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemCountActivityPubFollowers(ctx context.Context, userID int32) (int64, error) {
+	res, err := s.q.SystemCountActivityPubFollowers(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemCountDeadLetters(ctx context.Context) (int64, error) {
+	res, err := s.q.SystemCountDeadLetters(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemCountLanguages(ctx context.Context) (int64, error) {
+	res, err := s.q.SystemCountLanguages(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemCountPendingEmailQueue(ctx context.Context) (*SystemCountPendingEmailQueueRow, error) {
+	res, err := s.q.SystemCountPendingEmailQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemCountPendingEmailQueueRow) *SystemCountPendingEmailQueueRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemCountPendingEmailQueueRow{
+			Queued:  v.Queued,
+			Failing: v.Failing,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemCountRecentLoginAttempts(ctx context.Context, arg SystemCountRecentLoginAttemptsParams) (int64, error) {
+	res, err := s.q.SystemCountRecentLoginAttempts(ctx, dbpostgres.SystemCountRecentLoginAttemptsParams{
+		Username:  arg.Username,
+		IpAddress: arg.IpAddress,
+		CreatedAt: arg.CreatedAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemCreateGrant(ctx context.Context, arg SystemCreateGrantParams) (int64, error) {
+	res, err := s.q.SystemCreateGrant(ctx, dbpostgres.SystemCreateGrantParams{
+		UserID:   arg.UserID,
+		RoleID:   arg.RoleID,
+		Section:  arg.Section,
+		Item:     arg.Item,
+		RuleType: arg.RuleType,
+		ItemID:   arg.ItemID,
+		ItemRule: arg.ItemRule,
+		Action:   arg.Action,
+		Extra:    arg.Extra,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) SystemCreateNotification(ctx context.Context, arg SystemCreateNotificationParams) error {
+	return s.q.SystemCreateNotification(ctx, dbpostgres.SystemCreateNotificationParams{
+		RecipientID: arg.RecipientID,
+		Link:        arg.Link,
+		Message:     arg.Message,
+	})
+}
+
+func (s *postgresQuerier) SystemCreateReplyThread(ctx context.Context, arg SystemCreateReplyThreadParams) (int64, error) {
+	res, err := s.q.SystemCreateReplyThread(ctx, dbpostgres.SystemCreateReplyThreadParams{
+		TopicID:          arg.TopicID,
+		ReplyToCommentID: arg.ReplyToCommentID,
+		ReplyToThreadID:  arg.ReplyToThreadID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) SystemCreateSearchWord(ctx context.Context, word string) (int64, error) {
+	res, err := s.q.SystemCreateSearchWord(ctx, word)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) SystemCreateThread(ctx context.Context, forumtopicIdforumtopic int32) (int64, error) {
+	res, err := s.q.SystemCreateThread(ctx, forumtopicIdforumtopic)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) SystemCreateUserRole(ctx context.Context, arg SystemCreateUserRoleParams) error {
+	return s.q.SystemCreateUserRole(ctx, dbpostgres.SystemCreateUserRoleParams{
+		UsersIdusers: arg.UsersIdusers,
+		Name:         arg.Name,
+	})
+}
+
+func (s *postgresQuerier) SystemCreateUserRoleByID(ctx context.Context, arg SystemCreateUserRoleByIDParams) error {
+	return s.q.SystemCreateUserRoleByID(ctx, dbpostgres.SystemCreateUserRoleByIDParams{
+		UsersIdusers: arg.UsersIdusers,
+		RoleID:       arg.RoleID,
+	})
+}
+
+func (s *postgresQuerier) SystemDeleteActivityPubFollower(ctx context.Context, arg SystemDeleteActivityPubFollowerParams) (int64, error) {
+	res, err := s.q.SystemDeleteActivityPubFollower(ctx, dbpostgres.SystemDeleteActivityPubFollowerParams{
+		UserID:   arg.UserID,
+		ActorUrl: arg.ActorUrl,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemDeleteBlogsSearch(ctx context.Context) error {
+	return s.q.SystemDeleteBlogsSearch(ctx)
+}
+
+func (s *postgresQuerier) SystemDeleteBlogsSearchByBlogID(ctx context.Context, blogID int32) error {
+	return s.q.SystemDeleteBlogsSearchByBlogID(ctx, blogID)
+}
+
+func (s *postgresQuerier) SystemDeleteCommentsSearch(ctx context.Context) error {
+	return s.q.SystemDeleteCommentsSearch(ctx)
+}
+
+func (s *postgresQuerier) SystemDeleteCommentsSearchByCommentID(ctx context.Context, commentID int32) error {
+	return s.q.SystemDeleteCommentsSearchByCommentID(ctx, commentID)
+}
+
+func (s *postgresQuerier) SystemDeleteDeadLetter(ctx context.Context, id int32) error {
+	return s.q.SystemDeleteDeadLetter(ctx, id)
+}
+
+func (s *postgresQuerier) SystemDeleteImagePostSearch(ctx context.Context) error {
+	return s.q.SystemDeleteImagePostSearch(ctx)
+}
+
+func (s *postgresQuerier) SystemDeleteImagePostSearchByImagePostID(ctx context.Context, imagePostID int32) error {
+	return s.q.SystemDeleteImagePostSearchByImagePostID(ctx, imagePostID)
+}
+
+func (s *postgresQuerier) SystemDeleteLinkerSearch(ctx context.Context) error {
+	return s.q.SystemDeleteLinkerSearch(ctx)
+}
+
+func (s *postgresQuerier) SystemDeleteLinkerSearchByLinkerID(ctx context.Context, linkerID int32) error {
+	return s.q.SystemDeleteLinkerSearchByLinkerID(ctx, linkerID)
+}
+
+func (s *postgresQuerier) SystemDeletePasswordReset(ctx context.Context, id int32) error {
+	return s.q.SystemDeletePasswordReset(ctx, id)
+}
+
+func (s *postgresQuerier) SystemDeletePasswordResetsByUser(ctx context.Context, userID int32) (sql.Result, error) {
+	res, err := s.q.SystemDeletePasswordResetsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemDeleteScheduledPublication(ctx context.Context, id int32) (int64, error) {
+	res, err := s.q.SystemDeleteScheduledPublication(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemDeleteSessionByID(ctx context.Context, sessionID string) error {
+	return s.q.SystemDeleteSessionByID(ctx, sessionID)
+}
+
+func (s *postgresQuerier) SystemDeleteSiteNewsSearch(ctx context.Context) error {
+	return s.q.SystemDeleteSiteNewsSearch(ctx)
+}
+
+func (s *postgresQuerier) SystemDeleteSiteNewsSearchBySiteNewsID(ctx context.Context, siteNewsID int32) error {
+	return s.q.SystemDeleteSiteNewsSearchBySiteNewsID(ctx, siteNewsID)
+}
+
+func (s *postgresQuerier) SystemDeleteUninitializedThread(ctx context.Context, threadID int32) error {
+	return s.q.SystemDeleteUninitializedThread(ctx, threadID)
+}
+
+func (s *postgresQuerier) SystemDeleteUnverifiedEmailsExpiresBefore(ctx context.Context, verificationExpiresAt sql.NullTime) (sql.Result, error) {
+	res, err := s.q.SystemDeleteUnverifiedEmailsExpiresBefore(ctx, verificationExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemDeleteUserEmailsByEmailExceptID(ctx context.Context, arg SystemDeleteUserEmailsByEmailExceptIDParams) error {
+	return s.q.SystemDeleteUserEmailsByEmailExceptID(ctx, dbpostgres.SystemDeleteUserEmailsByEmailExceptIDParams{
+		Email: arg.Email,
+		ID:    arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemDeleteWritingSearch(ctx context.Context) error {
+	return s.q.SystemDeleteWritingSearch(ctx)
+}
+
+func (s *postgresQuerier) SystemDeleteWritingSearchByWritingID(ctx context.Context, writingID int32) error {
+	return s.q.SystemDeleteWritingSearchByWritingID(ctx, writingID)
+}
+
+func (s *postgresQuerier) SystemGetActivityPubActor(ctx context.Context, username sql.NullString) (*SystemGetActivityPubActorRow, error) {
+	res, err := s.q.SystemGetActivityPubActor(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetActivityPubActorRow) *SystemGetActivityPubActorRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetActivityPubActorRow{
+			Idusers:  v.Idusers,
+			Username: v.Username,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetActivityPubActorKey(ctx context.Context, userID int32) (*ActivitypubActorKey, error) {
+	res, err := s.q.SystemGetActivityPubActorKey(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ActivitypubActorKey) *ActivitypubActorKey {
+		if v == nil {
+			return nil
+		}
+		return &ActivitypubActorKey{
+			UserID:        v.UserID,
+			PublicKeyPem:  v.PublicKeyPem,
+			PrivateKeyPem: v.PrivateKeyPem,
+			CreatedAt:     v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetActivityPubBlogEntry(ctx context.Context, id int32) (*SystemGetActivityPubBlogEntryRow, error) {
+	res, err := s.q.SystemGetActivityPubBlogEntry(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetActivityPubBlogEntryRow) *SystemGetActivityPubBlogEntryRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetActivityPubBlogEntryRow{
+			Idblogs:      v.Idblogs,
+			UsersIdusers: v.UsersIdusers,
+			Username:     v.Username,
+			Blog:         v.Blog,
+			Written:      v.Written,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetActivityPubWriting(ctx context.Context, id int32) (*SystemGetActivityPubWritingRow, error) {
+	res, err := s.q.SystemGetActivityPubWriting(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetActivityPubWritingRow) *SystemGetActivityPubWritingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetActivityPubWritingRow{
+			Idwriting:    v.Idwriting,
+			UsersIdusers: v.UsersIdusers,
+			Username:     v.Username,
+			Title:        v.Title,
+			Abstract:     v.Abstract,
+			Writing:      v.Writing,
+			Published:    v.Published,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetAllBlogsForIndex(ctx context.Context) ([]*SystemGetAllBlogsForIndexRow, error) {
+	res, err := s.q.SystemGetAllBlogsForIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemGetAllBlogsForIndexRow) []*SystemGetAllBlogsForIndexRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemGetAllBlogsForIndexRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemGetAllBlogsForIndexRow{
+				Idblogs: item.Idblogs,
+				Blog:    item.Blog,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetBlogEntryByID(ctx context.Context, idblogs int32) (*SystemGetBlogEntryByIDRow, error) {
+	res, err := s.q.SystemGetBlogEntryByID(ctx, idblogs)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetBlogEntryByIDRow) *SystemGetBlogEntryByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogEntryByIDRow{
+			Idblogs:       v.Idblogs,
+			ForumthreadID: v.ForumthreadID,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetBlogEntryForPublishing(ctx context.Context, id int32) (*SystemGetBlogEntryForPublishingRow, error) {
+	res, err := s.q.SystemGetBlogEntryForPublishing(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetBlogEntryForPublishingRow) *SystemGetBlogEntryForPublishingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogEntryForPublishingRow{
+			Idblogs:       v.Idblogs,
+			ForumthreadID: v.ForumthreadID,
+			UsersIdusers:  v.UsersIdusers,
+			Blog:          v.Blog,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetBlogForArchive(ctx context.Context, id int32) (*SystemGetBlogForArchiveRow, error) {
+	res, err := s.q.SystemGetBlogForArchive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetBlogForArchiveRow) *SystemGetBlogForArchiveRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogForArchiveRow{
+			Idblogs:       v.Idblogs,
+			ForumthreadID: v.ForumthreadID,
+			UsersIdusers:  v.UsersIdusers,
+			LanguageID:    v.LanguageID,
+			Blog:          v.Blog,
+			Written:       v.Written,
+			Timezone:      v.Timezone,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetBlogForRevision(ctx context.Context, idblogs int32) (*SystemGetBlogForRevisionRow, error) {
+	res, err := s.q.SystemGetBlogForRevision(ctx, idblogs)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetBlogForRevisionRow) *SystemGetBlogForRevisionRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogForRevisionRow{
+			Idblogs:      v.Idblogs,
+			UsersIdusers: v.UsersIdusers,
+			Blog:         v.Blog,
+			Written:      v.Written,
+			Timezone:     v.Timezone,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetCommentReactionTarget(ctx context.Context, id int32) (*SystemGetCommentReactionTargetRow, error) {
+	res, err := s.q.SystemGetCommentReactionTarget(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetCommentReactionTargetRow) *SystemGetCommentReactionTargetRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetCommentReactionTargetRow{
+			Idcomments:    v.Idcomments,
+			UsersIdusers:  v.UsersIdusers,
+			ForumthreadID: v.ForumthreadID,
+			TopicID:       v.TopicID,
+			Handler:       v.Handler,
+			BlogID:        v.BlogID,
+			NewsID:        v.NewsID,
+			WritingID:     v.WritingID,
+			LinkID:        v.LinkID,
+			BoardID:       v.BoardID,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetDeadLetter(ctx context.Context, id int32) (*DeadLetter, error) {
+	res, err := s.q.SystemGetDeadLetter(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.DeadLetter) *DeadLetter {
+		if v == nil {
+			return nil
+		}
+		return &DeadLetter{
+			ID:        v.ID,
+			Message:   v.Message,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetFAQQuestions(ctx context.Context) ([]*Faq, error) {
+	res, err := s.q.SystemGetFAQQuestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Faq) []*Faq {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Faq, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Faq{
+				ID:          item.ID,
+				CategoryID:  item.CategoryID,
+				LanguageID:  item.LanguageID,
+				AuthorID:    item.AuthorID,
+				Answer:      item.Answer,
+				Question:    item.Question,
+				Description: item.Description,
+				Priority:    item.Priority,
+				DeletedAt:   item.DeletedAt,
+				UpdatedAt:   item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetForumTopicByTitle(ctx context.Context, title sql.NullString) (*Forumtopic, error) {
+	res, err := s.q.SystemGetForumTopicByTitle(ctx, title)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Forumtopic) *Forumtopic {
+		if v == nil {
+			return nil
+		}
+		return &Forumtopic{
+			Idforumtopic:                 v.Idforumtopic,
+			Lastposter:                   v.Lastposter,
+			ForumcategoryIdforumcategory: v.ForumcategoryIdforumcategory,
+			LanguageID:                   v.LanguageID,
+			Title:                        v.Title,
+			Description:                  v.Description,
+			Threads:                      v.Threads,
+			Comments:                     v.Comments,
+			Lastaddition:                 v.Lastaddition,
+			Handler:                      v.Handler,
+			DeletedAt:                    v.DeletedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetLanguageIDByName(ctx context.Context, nameof sql.NullString) (int32, error) {
+	res, err := s.q.SystemGetLanguageIDByName(ctx, nameof)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemGetLastNotificationForRecipientByMessage(ctx context.Context, arg SystemGetLastNotificationForRecipientByMessageParams) (*Notification, error) {
+	res, err := s.q.SystemGetLastNotificationForRecipientByMessage(ctx, dbpostgres.SystemGetLastNotificationForRecipientByMessageParams{
+		RecipientID: arg.RecipientID,
+		Message:     arg.Message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Notification) *Notification {
+		if v == nil {
+			return nil
+		}
+		return &Notification{
+			ID:           v.ID,
+			UsersIdusers: v.UsersIdusers,
+			Link:         v.Link,
+			Message:      v.Message,
+			CreatedAt:    v.CreatedAt,
+			ReadAt:       v.ReadAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetLatestActivityPubActivityForObject(ctx context.Context, objectUrl string) (*ActivitypubActivity, error) {
+	res, err := s.q.SystemGetLatestActivityPubActivityForObject(ctx, objectUrl)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.ActivitypubActivity) *ActivitypubActivity {
+		if v == nil {
+			return nil
+		}
+		return &ActivitypubActivity{
+			ID:           v.ID,
+			UserID:       v.UserID,
+			ActivityType: v.ActivityType,
+			ObjectUrl:    v.ObjectUrl,
+			ObjectJson:   v.ObjectJson,
+			Published:    v.Published,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetLogin(ctx context.Context, username sql.NullString) (*SystemGetLoginRow, error) {
+	res, err := s.q.SystemGetLogin(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetLoginRow) *SystemGetLoginRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetLoginRow{
+			Idusers:         v.Idusers,
+			Passwd:          v.Passwd,
+			PasswdAlgorithm: v.PasswdAlgorithm,
+			Username:        v.Username,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetNewsPostByID(ctx context.Context, idsitenews int32) (int32, error) {
+	res, err := s.q.SystemGetNewsPostByID(ctx, idsitenews)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemGetNewsPostForPublishing(ctx context.Context, id int32) (*SystemGetNewsPostForPublishingRow, error) {
+	res, err := s.q.SystemGetNewsPostForPublishing(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetNewsPostForPublishingRow) *SystemGetNewsPostForPublishingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetNewsPostForPublishingRow{
+			Idsitenews:    v.Idsitenews,
+			ForumthreadID: v.ForumthreadID,
+			UsersIdusers:  v.UsersIdusers,
+			News:          v.News,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetNewsPostForRevision(ctx context.Context, idsitenews int32) (*SystemGetNewsPostForRevisionRow, error) {
+	res, err := s.q.SystemGetNewsPostForRevision(ctx, idsitenews)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetNewsPostForRevisionRow) *SystemGetNewsPostForRevisionRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetNewsPostForRevisionRow{
+			Idsitenews:   v.Idsitenews,
+			UsersIdusers: v.UsersIdusers,
+			News:         v.News,
+			Occurred:     v.Occurred,
+			Timezone:     v.Timezone,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetSearchWordByWordLowercased(ctx context.Context, lcase string) (*Searchwordlist, error) {
+	res, err := s.q.SystemGetSearchWordByWordLowercased(ctx, lcase)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Searchwordlist) *Searchwordlist {
+		if v == nil {
+			return nil
+		}
+		return &Searchwordlist{
+			Idsearchwordlist: v.Idsearchwordlist,
+			Word:             v.Word,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetTemplateOverride(ctx context.Context, name string) (string, error) {
+	res, err := s.q.SystemGetTemplateOverride(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemGetUserByEmail(ctx context.Context, email string) (*SystemGetUserByEmailRow, error) {
+	res, err := s.q.SystemGetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetUserByEmailRow) *SystemGetUserByEmailRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetUserByEmailRow{
+			Idusers:  v.Idusers,
+			Email:    v.Email,
+			Username: v.Username,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetUserByID(ctx context.Context, idusers int32) (*SystemGetUserByIDRow, error) {
+	res, err := s.q.SystemGetUserByID(ctx, idusers)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetUserByIDRow) *SystemGetUserByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetUserByIDRow{
+			Idusers:                v.Idusers,
+			Email:                  v.Email,
+			Username:               v.Username,
+			PublicProfileEnabledAt: v.PublicProfileEnabledAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetUserByUsername(ctx context.Context, username sql.NullString) (*SystemGetUserByUsernameRow, error) {
+	res, err := s.q.SystemGetUserByUsername(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetUserByUsernameRow) *SystemGetUserByUsernameRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetUserByUsernameRow{
+			Idusers:                v.Idusers,
+			Username:               v.Username,
+			PublicProfileEnabledAt: v.PublicProfileEnabledAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetUsersByIDs(ctx context.Context, ids []int32) ([]*SystemGetUsersByIDsRow, error) {
+	res, err := s.q.SystemGetUsersByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemGetUsersByIDsRow) []*SystemGetUsersByIDsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemGetUsersByIDsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemGetUsersByIDsRow{
+				Idusers:                item.Idusers,
+				Email:                  item.Email,
+				Username:               item.Username,
+				PublicProfileEnabledAt: item.PublicProfileEnabledAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetWebhook(ctx context.Context, id int32) (*Webhook, error) {
+	res, err := s.q.SystemGetWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.Webhook) *Webhook {
+		if v == nil {
+			return nil
+		}
+		return &Webhook{
+			ID:        v.ID,
+			Name:      v.Name,
+			Url:       v.Url,
+			Secret:    v.Secret,
+			Patterns:  v.Patterns,
+			Active:    (v.Active != 0),
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetWebhookDelivery(ctx context.Context, id int32) (*WebhookDelivery, error) {
+	res, err := s.q.SystemGetWebhookDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.WebhookDelivery) *WebhookDelivery {
+		if v == nil {
+			return nil
+		}
+		return &WebhookDelivery{
+			ID:             v.ID,
+			WebhookID:      v.WebhookID,
+			Event:          v.Event,
+			Path:           v.Path,
+			Payload:        v.Payload,
+			Status:         v.Status,
+			Attempts:       v.Attempts,
+			ResponseStatus: v.ResponseStatus,
+			Error:          v.Error,
+			CreatedAt:      v.CreatedAt,
+			UpdatedAt:      v.UpdatedAt,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetWritingByID(ctx context.Context, idwriting int32) (int32, error) {
+	res, err := s.q.SystemGetWritingByID(ctx, idwriting)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemGetWritingForArchive(ctx context.Context, id int32) (*SystemGetWritingForArchiveRow, error) {
+	res, err := s.q.SystemGetWritingForArchive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetWritingForArchiveRow) *SystemGetWritingForArchiveRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetWritingForArchiveRow{
+			Idwriting:         v.Idwriting,
+			UsersIdusers:      v.UsersIdusers,
+			ForumthreadID:     v.ForumthreadID,
+			LanguageID:        v.LanguageID,
+			WritingCategoryID: v.WritingCategoryID,
+			Title:             v.Title,
+			Published:         v.Published,
+			Timezone:          v.Timezone,
+			Writing:           v.Writing,
+			Abstract:          v.Abstract,
+			Private:           sql.NullBool{Bool: v.Private.Int32 != 0, Valid: v.Private.Valid},
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetWritingForPublishing(ctx context.Context, id int32) (*SystemGetWritingForPublishingRow, error) {
+	res, err := s.q.SystemGetWritingForPublishing(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetWritingForPublishingRow) *SystemGetWritingForPublishingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetWritingForPublishingRow{
+			Idwriting:         v.Idwriting,
+			ForumthreadID:     v.ForumthreadID,
+			UsersIdusers:      v.UsersIdusers,
+			WritingCategoryID: v.WritingCategoryID,
+			Title:             v.Title,
+			Abstract:          v.Abstract,
+			Writing:           v.Writing,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemGetWritingForRevision(ctx context.Context, idwriting int32) (*SystemGetWritingForRevisionRow, error) {
+	res, err := s.q.SystemGetWritingForRevision(ctx, idwriting)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbpostgres.SystemGetWritingForRevisionRow) *SystemGetWritingForRevisionRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetWritingForRevisionRow{
+			Idwriting:    v.Idwriting,
+			UsersIdusers: v.UsersIdusers,
+			Title:        v.Title,
+			Abstract:     v.Abstract,
+			Writing:      v.Writing,
+			Published:    v.Published,
+			Timezone:     v.Timezone,
+		}
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemIncrementPendingEmailError(ctx context.Context, id int32) error {
+	return s.q.SystemIncrementPendingEmailError(ctx, id)
+}
+
+func (s *postgresQuerier) SystemInsertActivityPubActivity(ctx context.Context, arg SystemInsertActivityPubActivityParams) (int64, error) {
+	res, err := s.q.SystemInsertActivityPubActivity(ctx, dbpostgres.SystemInsertActivityPubActivityParams{
+		UserID:       arg.UserID,
+		ActivityType: arg.ActivityType,
+		ObjectUrl:    arg.ObjectUrl,
+		ObjectJson:   arg.ObjectJson,
+		Published:    arg.Published,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) SystemInsertActivityPubActorKey(ctx context.Context, arg SystemInsertActivityPubActorKeyParams) error {
+	return s.q.SystemInsertActivityPubActorKey(ctx, dbpostgres.SystemInsertActivityPubActorKeyParams{
+		UserID:        arg.UserID,
+		PublicKeyPem:  arg.PublicKeyPem,
+		PrivateKeyPem: arg.PrivateKeyPem,
+	})
+}
+
+func (s *postgresQuerier) SystemInsertActivityPubDelivery(ctx context.Context, arg SystemInsertActivityPubDeliveryParams) error {
+	return s.q.SystemInsertActivityPubDelivery(ctx, dbpostgres.SystemInsertActivityPubDeliveryParams{
+		UserID:        arg.UserID,
+		InboxUrl:      arg.InboxUrl,
+		Payload:       arg.Payload,
+		NextAttemptAt: arg.NextAttemptAt,
+	})
+}
+
+func (s *postgresQuerier) SystemInsertDeadLetter(ctx context.Context, message string) error {
+	return s.q.SystemInsertDeadLetter(ctx, message)
+}
+
+func (s *postgresQuerier) SystemInsertEmailBounce(ctx context.Context, arg SystemInsertEmailBounceParams) error {
+	return s.q.SystemInsertEmailBounce(ctx, dbpostgres.SystemInsertEmailBounceParams{
+		UserEmailID: arg.UserEmailID,
+		Kind:        arg.Kind,
+		Source:      arg.Source,
+		Detail:      arg.Detail,
+	})
+}
+
+func (s *postgresQuerier) SystemInsertLoginAttempt(ctx context.Context, arg SystemInsertLoginAttemptParams) error {
+	return s.q.SystemInsertLoginAttempt(ctx, dbpostgres.SystemInsertLoginAttemptParams{
+		Username:  arg.Username,
+		IpAddress: arg.IpAddress,
+	})
+}
+
+func (s *postgresQuerier) SystemInsertSession(ctx context.Context, arg SystemInsertSessionParams) error {
+	return s.q.SystemInsertSession(ctx, dbpostgres.SystemInsertSessionParams{
+		SessionID:    arg.SessionID,
+		UsersIdusers: arg.UsersIdusers,
+	})
+}
+
+func (s *postgresQuerier) SystemInsertUser(ctx context.Context, username sql.NullString) (int64, error) {
+	res, err := s.q.SystemInsertUser(ctx, username)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) SystemInsertWebhookDelivery(ctx context.Context, arg SystemInsertWebhookDeliveryParams) (int64, error) {
+	res, err := s.q.SystemInsertWebhookDelivery(ctx, dbpostgres.SystemInsertWebhookDeliveryParams{
+		WebhookID: arg.WebhookID,
+		Event:     arg.Event,
+		Path:      arg.Path,
+		Payload:   arg.Payload,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return int64(res), nil
+}
+
+func (s *postgresQuerier) SystemLatestDeadLetter(ctx context.Context) (interface{}, error) {
+	res, err := s.q.SystemLatestDeadLetter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemListActiveWebhooks(ctx context.Context) ([]*Webhook, error) {
+	res, err := s.q.SystemListActiveWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Webhook) []*Webhook {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Webhook, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Webhook{
+				ID:        item.ID,
+				Name:      item.Name,
+				Url:       item.Url,
+				Secret:    item.Secret,
+				Patterns:  item.Patterns,
+				Active:    (item.Active != 0),
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListActivityPubActivitiesForUser(ctx context.Context, arg SystemListActivityPubActivitiesForUserParams) ([]*ActivitypubActivity, error) {
+	res, err := s.q.SystemListActivityPubActivitiesForUser(ctx, dbpostgres.SystemListActivityPubActivitiesForUserParams{
+		UserID: arg.UserID,
+		Limit:  arg.Limit,
+		Offset: arg.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ActivitypubActivity) []*ActivitypubActivity {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ActivitypubActivity, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ActivitypubActivity{
+				ID:           item.ID,
+				UserID:       item.UserID,
+				ActivityType: item.ActivityType,
+				ObjectUrl:    item.ObjectUrl,
+				ObjectJson:   item.ObjectJson,
+				Published:    item.Published,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListActivityPubFollowers(ctx context.Context, userID int32) ([]*ActivitypubFollower, error) {
+	res, err := s.q.SystemListActivityPubFollowers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ActivitypubFollower) []*ActivitypubFollower {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ActivitypubFollower, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ActivitypubFollower{
+				ID:             item.ID,
+				UserID:         item.UserID,
+				ActorUrl:       item.ActorUrl,
+				InboxUrl:       item.InboxUrl,
+				SharedInboxUrl: item.SharedInboxUrl,
+				CreatedAt:      item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListAllUnverifiedEmails(ctx context.Context) ([]*UserEmail, error) {
+	res, err := s.q.SystemListAllUnverifiedEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserEmail) []*UserEmail {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserEmail, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserEmail{
+				ID:                    item.ID,
+				UserID:                item.UserID,
+				Email:                 item.Email,
+				VerifiedAt:            item.VerifiedAt,
+				LastVerificationCode:  item.LastVerificationCode,
+				VerificationExpiresAt: item.VerificationExpiresAt,
+				NotificationPriority:  item.NotificationPriority,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListAllUserEmails(ctx context.Context) ([]*SystemListAllUserEmailsRow, error) {
+	res, err := s.q.SystemListAllUserEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListAllUserEmailsRow) []*SystemListAllUserEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListAllUserEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListAllUserEmailsRow{
+				UserID:     item.UserID,
+				Email:      item.Email,
+				VerifiedAt: item.VerifiedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListAllUsers(ctx context.Context) ([]*SystemListAllUsersRow, error) {
+	res, err := s.q.SystemListAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListAllUsersRow) []*SystemListAllUsersRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListAllUsersRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListAllUsersRow{
+				Idusers:   item.Idusers,
+				Username:  item.Username,
+				Admin:     item.Admin,
+				CreatedAt: item.CreatedAt,
+				DeletedAt: item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListBlogsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListBlogsSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListBlogsSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListBlogsSearchMatchesByWordRow) []*SystemListBlogsSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListBlogsSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListBlogsSearchMatchesByWordRow{
+				ID:        item.ID,
+				WordCount: item.WordCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListBoardsByParentID(ctx context.Context, arg SystemListBoardsByParentIDParams) ([]*Imageboard, error) {
+	res, err := s.q.SystemListBoardsByParentID(ctx, dbpostgres.SystemListBoardsByParentIDParams{
+		ParentID: arg.ParentID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Imageboard) []*Imageboard {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Imageboard, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Imageboard{
+				Idimageboard:           item.Idimageboard,
+				ImageboardIdimageboard: item.ImageboardIdimageboard,
+				Title:                  item.Title,
+				Description:            item.Description,
+				ApprovalRequired:       (item.ApprovalRequired != 0),
+				DeletedAt:              item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListCommentsByThreadID(ctx context.Context, forumthreadID int32) ([]*SystemListCommentsByThreadIDRow, error) {
+	res, err := s.q.SystemListCommentsByThreadID(ctx, forumthreadID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListCommentsByThreadIDRow) []*SystemListCommentsByThreadIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListCommentsByThreadIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListCommentsByThreadIDRow{
+				Idcomments: item.Idcomments,
+				Text:       item.Text,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListCommentsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListCommentsSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListCommentsSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListCommentsSearchMatchesByWordRow) []*SystemListCommentsSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListCommentsSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListCommentsSearchMatchesByWordRow{
+				ID:        item.ID,
+				WordCount: item.WordCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListDeadLetters(ctx context.Context, limit int32) ([]*DeadLetter, error) {
+	res, err := s.q.SystemListDeadLetters(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.DeadLetter) []*DeadLetter {
+		if items == nil {
+			return nil
+		}
+		out := make([]*DeadLetter, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &DeadLetter{
+				ID:        item.ID,
+				Message:   item.Message,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListDueActivityPubDeliveries(ctx context.Context, arg SystemListDueActivityPubDeliveriesParams) ([]*ActivitypubDelivery, error) {
+	res, err := s.q.SystemListDueActivityPubDeliveries(ctx, dbpostgres.SystemListDueActivityPubDeliveriesParams{
+		Now:   arg.Now,
+		Limit: arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ActivitypubDelivery) []*ActivitypubDelivery {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ActivitypubDelivery, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ActivitypubDelivery{
+				ID:            item.ID,
+				UserID:        item.UserID,
+				InboxUrl:      item.InboxUrl,
+				Payload:       item.Payload,
+				Status:        item.Status,
+				Attempts:      item.Attempts,
+				NextAttemptAt: item.NextAttemptAt,
+				Error:         item.Error,
+				CreatedAt:     item.CreatedAt,
+				UpdatedAt:     item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListDueScheduledPublications(ctx context.Context, arg SystemListDueScheduledPublicationsParams) ([]*ScheduledPublication, error) {
+	res, err := s.q.SystemListDueScheduledPublications(ctx, dbpostgres.SystemListDueScheduledPublicationsParams{
+		Now:   arg.Now,
+		Limit: arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.ScheduledPublication) []*ScheduledPublication {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ScheduledPublication, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ScheduledPublication{
+				ID:        item.ID,
+				ItemType:  item.ItemType,
+				ItemID:    item.ItemID,
+				AuthorID:  item.AuthorID,
+				PublishAt: item.PublishAt,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListExpiredForumPolls(ctx context.Context, arg SystemListExpiredForumPollsParams) ([]*SystemListExpiredForumPollsRow, error) {
+	res, err := s.q.SystemListExpiredForumPolls(ctx, dbpostgres.SystemListExpiredForumPollsParams{
+		Now:   arg.Now,
+		Limit: arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListExpiredForumPollsRow) []*SystemListExpiredForumPollsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListExpiredForumPollsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListExpiredForumPollsRow{
+				ID:            item.ID,
+				ForumthreadID: item.ForumthreadID,
+				Question:      item.Question,
+				ClosesAt:      item.ClosesAt,
+				TopicID:       item.TopicID,
+				Handler:       item.Handler,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListImagePostSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListImagePostSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListImagePostSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListImagePostSearchMatchesByWordRow) []*SystemListImagePostSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListImagePostSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListImagePostSearchMatchesByWordRow{
+				ID:        item.ID,
+				WordCount: item.WordCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListLanguages(ctx context.Context) ([]*Language, error) {
+	res, err := s.q.SystemListLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.Language) []*Language {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Language, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Language{
+				ID:     item.ID,
+				Nameof: item.Nameof,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListLinkerSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListLinkerSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListLinkerSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListLinkerSearchMatchesByWordRow) []*SystemListLinkerSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListLinkerSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListLinkerSearchMatchesByWordRow{
+				ID:        item.ID,
+				WordCount: item.WordCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListPendingEmails(ctx context.Context, arg SystemListPendingEmailsParams) ([]*SystemListPendingEmailsRow, error) {
+	res, err := s.q.SystemListPendingEmails(ctx, dbpostgres.SystemListPendingEmailsParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListPendingEmailsRow) []*SystemListPendingEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListPendingEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListPendingEmailsRow{
+				ID:          item.ID,
+				ToUserID:    item.ToUserID,
+				Body:        item.Body,
+				ErrorCount:  item.ErrorCount,
+				DirectEmail: (item.DirectEmail != 0),
+				CreatedAt:   item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListPendingWebhookDeliveries(ctx context.Context, limit int32) ([]*WebhookDelivery, error) {
+	res, err := s.q.SystemListPendingWebhookDeliveries(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.WebhookDelivery) []*WebhookDelivery {
+		if items == nil {
+			return nil
+		}
+		out := make([]*WebhookDelivery, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &WebhookDelivery{
+				ID:             item.ID,
+				WebhookID:      item.WebhookID,
+				Event:          item.Event,
+				Path:           item.Path,
+				Payload:        item.Payload,
+				Status:         item.Status,
+				Attempts:       item.Attempts,
+				ResponseStatus: item.ResponseStatus,
+				Error:          item.Error,
+				CreatedAt:      item.CreatedAt,
+				UpdatedAt:      item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListPublicWritingsByAuthor(ctx context.Context, arg SystemListPublicWritingsByAuthorParams) ([]*SystemListPublicWritingsByAuthorRow, error) {
+	res, err := s.q.SystemListPublicWritingsByAuthor(ctx, dbpostgres.SystemListPublicWritingsByAuthorParams{
+		AuthorID: arg.AuthorID,
+		Offset:   arg.Offset,
+		Limit:    arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListPublicWritingsByAuthorRow) []*SystemListPublicWritingsByAuthorRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListPublicWritingsByAuthorRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListPublicWritingsByAuthorRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListPublicWritingsInCategory(ctx context.Context, arg SystemListPublicWritingsInCategoryParams) ([]*SystemListPublicWritingsInCategoryRow, error) {
+	res, err := s.q.SystemListPublicWritingsInCategory(ctx, dbpostgres.SystemListPublicWritingsInCategoryParams{
+		CategoryID: arg.CategoryID,
+		Offset:     arg.Offset,
+		Limit:      arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListPublicWritingsInCategoryRow) []*SystemListPublicWritingsInCategoryRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListPublicWritingsInCategoryRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListPublicWritingsInCategoryRow{
+				Idwriting:         item.Idwriting,
+				UsersIdusers:      item.UsersIdusers,
+				ForumthreadID:     item.ForumthreadID,
+				LanguageID:        item.LanguageID,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int32 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSiteNewsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListSiteNewsSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListSiteNewsSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSiteNewsSearchMatchesByWordRow) []*SystemListSiteNewsSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSiteNewsSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSiteNewsSearchMatchesByWordRow{
+				ID:        item.ID,
+				WordCount: item.WordCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapBlogEntries(ctx context.Context) ([]*SystemListSitemapBlogEntriesRow, error) {
+	res, err := s.q.SystemListSitemapBlogEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapBlogEntriesRow) []*SystemListSitemapBlogEntriesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapBlogEntriesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapBlogEntriesRow{
+				Idblogs: item.Idblogs,
+				Written: item.Written,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapFAQQuestions(ctx context.Context) ([]*SystemListSitemapFAQQuestionsRow, error) {
+	res, err := s.q.SystemListSitemapFAQQuestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapFAQQuestionsRow) []*SystemListSitemapFAQQuestionsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapFAQQuestionsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapFAQQuestionsRow{
+				ID:        item.ID,
+				UpdatedAt: item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapForumThreads(ctx context.Context) ([]*SystemListSitemapForumThreadsRow, error) {
+	res, err := s.q.SystemListSitemapForumThreads(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapForumThreadsRow) []*SystemListSitemapForumThreadsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapForumThreadsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapForumThreadsRow{
+				Idforumthread:          item.Idforumthread,
+				ForumtopicIdforumtopic: item.ForumtopicIdforumtopic,
+				Lastaddition:           item.Lastaddition,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapForumTopics(ctx context.Context) ([]*SystemListSitemapForumTopicsRow, error) {
+	res, err := s.q.SystemListSitemapForumTopics(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapForumTopicsRow) []*SystemListSitemapForumTopicsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapForumTopicsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapForumTopicsRow{
+				Idforumtopic: item.Idforumtopic,
+				Lastaddition: item.Lastaddition,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapImageBoards(ctx context.Context) ([]int32, error) {
+	res, err := s.q.SystemListSitemapImageBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemListSitemapImagePosts(ctx context.Context) ([]*SystemListSitemapImagePostsRow, error) {
+	res, err := s.q.SystemListSitemapImagePosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapImagePostsRow) []*SystemListSitemapImagePostsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapImagePostsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapImagePostsRow{
+				Idimageboard:  item.Idimageboard,
+				ForumthreadID: item.ForumthreadID,
+				Posted:        item.Posted,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapLinkerItems(ctx context.Context) ([]*SystemListSitemapLinkerItemsRow, error) {
+	res, err := s.q.SystemListSitemapLinkerItems(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapLinkerItemsRow) []*SystemListSitemapLinkerItemsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapLinkerItemsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapLinkerItemsRow{
+				ID:     item.ID,
+				Listed: item.Listed,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapNewsPosts(ctx context.Context) ([]*SystemListSitemapNewsPostsRow, error) {
+	res, err := s.q.SystemListSitemapNewsPosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapNewsPostsRow) []*SystemListSitemapNewsPostsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapNewsPostsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapNewsPostsRow{
+				Idsitenews: item.Idsitenews,
+				Occurred:   item.Occurred,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListSitemapWritings(ctx context.Context) ([]*SystemListSitemapWritingsRow, error) {
+	res, err := s.q.SystemListSitemapWritings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListSitemapWritingsRow) []*SystemListSitemapWritingsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListSitemapWritingsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListSitemapWritingsRow{
+				Idwriting: item.Idwriting,
+				Published: item.Published,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListUnverifiedEmailsCreatedAfter(ctx context.Context, verificationExpiresAt sql.NullTime) ([]*UserEmail, error) {
+	res, err := s.q.SystemListUnverifiedEmailsCreatedAfter(ctx, verificationExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserEmail) []*UserEmail {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserEmail, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserEmail{
+				ID:                    item.ID,
+				UserID:                item.UserID,
+				Email:                 item.Email,
+				VerifiedAt:            item.VerifiedAt,
+				LastVerificationCode:  item.LastVerificationCode,
+				VerificationExpiresAt: item.VerificationExpiresAt,
+				NotificationPriority:  item.NotificationPriority,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListUnverifiedEmailsExpiresBefore(ctx context.Context, verificationExpiresAt sql.NullTime) ([]*UserEmail, error) {
+	res, err := s.q.SystemListUnverifiedEmailsExpiresBefore(ctx, verificationExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserEmail) []*UserEmail {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserEmail, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserEmail{
+				ID:                    item.ID,
+				UserID:                item.UserID,
+				Email:                 item.Email,
+				VerifiedAt:            item.VerifiedAt,
+				LastVerificationCode:  item.LastVerificationCode,
+				VerificationExpiresAt: item.VerificationExpiresAt,
+				NotificationPriority:  item.NotificationPriority,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListUserInfo(ctx context.Context) ([]*SystemListUserInfoRow, error) {
+	res, err := s.q.SystemListUserInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListUserInfoRow) []*SystemListUserInfoRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListUserInfoRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListUserInfoRow{
+				Idusers:   item.Idusers,
+				Username:  item.Username,
+				Admin:     item.Admin,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListVerifiedEmailsByUserID(ctx context.Context, userID int32) ([]*UserEmail, error) {
+	res, err := s.q.SystemListVerifiedEmailsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.UserEmail) []*UserEmail {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserEmail, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserEmail{
+				ID:                    item.ID,
+				UserID:                item.UserID,
+				Email:                 item.Email,
+				VerifiedAt:            item.VerifiedAt,
+				LastVerificationCode:  item.LastVerificationCode,
+				VerificationExpiresAt: item.VerificationExpiresAt,
+				NotificationPriority:  item.NotificationPriority,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListWritingCategories(ctx context.Context, arg SystemListWritingCategoriesParams) ([]*WritingCategory, error) {
+	res, err := s.q.SystemListWritingCategories(ctx, dbpostgres.SystemListWritingCategoriesParams{
+		Offset: arg.Offset,
+		Limit:  arg.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.WritingCategory) []*WritingCategory {
+		if items == nil {
+			return nil
+		}
+		out := make([]*WritingCategory, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &WritingCategory{
+				Idwritingcategory: item.Idwritingcategory,
+				WritingCategoryID: item.WritingCategoryID,
+				Title:             item.Title,
+				Description:       item.Description,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemListWritingSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListWritingSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListWritingSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbpostgres.SystemListWritingSearchMatchesByWordRow) []*SystemListWritingSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListWritingSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListWritingSearchMatchesByWordRow{
+				ID:        item.ID,
+				WordCount: item.WordCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *postgresQuerier) SystemMarkPasswordResetVerified(ctx context.Context, id int32) error {
+	return s.q.SystemMarkPasswordResetVerified(ctx, id)
+}
+
+func (s *postgresQuerier) SystemMarkPendingEmailSent(ctx context.Context, id int32) error {
+	return s.q.SystemMarkPendingEmailSent(ctx, id)
+}
+
+func (s *postgresQuerier) SystemMarkUserEmailVerified(ctx context.Context, arg SystemMarkUserEmailVerifiedParams) error {
+	return s.q.SystemMarkUserEmailVerified(ctx, dbpostgres.SystemMarkUserEmailVerifiedParams{
+		VerifiedAt: arg.VerifiedAt,
+		ID:         arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemPurgeActivityPubDeliveriesBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.q.SystemPurgeActivityPubDeliveriesBefore(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemPurgeDeadLettersBefore(ctx context.Context, createdAt time.Time) error {
+	return s.q.SystemPurgeDeadLettersBefore(ctx, createdAt)
+}
+
+func (s *postgresQuerier) SystemPurgeDraftsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.q.SystemPurgeDraftsBefore(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemPurgePasswordResetsBefore(ctx context.Context, createdAt time.Time) (sql.Result, error) {
+	res, err := s.q.SystemPurgePasswordResetsBefore(ctx, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) SystemRebuildForumTopicMetaByID(ctx context.Context, idforumtopic int32) error {
+	return s.q.SystemRebuildForumTopicMetaByID(ctx, idforumtopic)
+}
+
+func (s *postgresQuerier) SystemRegisterExternalLinkClick(ctx context.Context, url string) error {
+	return s.q.SystemRegisterExternalLinkClick(ctx, url)
+}
+
+func (s *postgresQuerier) SystemSetBlogEntryWritten(ctx context.Context, arg SystemSetBlogEntryWrittenParams) error {
+	return s.q.SystemSetBlogEntryWritten(ctx, dbpostgres.SystemSetBlogEntryWrittenParams{
+		Written: arg.Written,
+		ID:      arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemSetBlogLastIndex(ctx context.Context, id int32) error {
+	return s.q.SystemSetBlogLastIndex(ctx, id)
+}
+
+func (s *postgresQuerier) SystemSetCommentLastIndex(ctx context.Context, idcomments int32) error {
+	return s.q.SystemSetCommentLastIndex(ctx, idcomments)
+}
+
+func (s *postgresQuerier) SystemSetForumTopicHandlerByID(ctx context.Context, arg SystemSetForumTopicHandlerByIDParams) error {
+	return s.q.SystemSetForumTopicHandlerByID(ctx, dbpostgres.SystemSetForumTopicHandlerByIDParams{
+		Handler: arg.Handler,
+		ID:      arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemSetImagePostLastIndex(ctx context.Context, idimagepost int32) error {
+	return s.q.SystemSetImagePostLastIndex(ctx, idimagepost)
+}
+
+func (s *postgresQuerier) SystemSetLinkerLastIndex(ctx context.Context, id int32) error {
+	return s.q.SystemSetLinkerLastIndex(ctx, id)
+}
+
+func (s *postgresQuerier) SystemSetNewsPostOccurred(ctx context.Context, arg SystemSetNewsPostOccurredParams) error {
+	return s.q.SystemSetNewsPostOccurred(ctx, dbpostgres.SystemSetNewsPostOccurredParams{
+		Occurred: arg.Occurred,
+		ID:       arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemSetSiteNewsLastIndex(ctx context.Context, idsitenews int32) error {
+	return s.q.SystemSetSiteNewsLastIndex(ctx, idsitenews)
+}
+
+func (s *postgresQuerier) SystemSetWritingLastIndex(ctx context.Context, idwriting int32) error {
+	return s.q.SystemSetWritingLastIndex(ctx, idwriting)
+}
+
+func (s *postgresQuerier) SystemSetWritingPublished(ctx context.Context, arg SystemSetWritingPublishedParams) error {
+	return s.q.SystemSetWritingPublished(ctx, dbpostgres.SystemSetWritingPublishedParams{
+		Published: arg.Published,
+		ID:        arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemSuppressEmail(ctx context.Context, arg SystemSuppressEmailParams) error {
+	return s.q.SystemSuppressEmail(ctx, dbpostgres.SystemSuppressEmailParams{
+		Email:  arg.Email,
+		UserID: arg.UserID,
+		Reason: arg.Reason,
+		Detail: arg.Detail,
+	})
+}
+
+func (s *postgresQuerier) SystemUpdateActivityPubDelivery(ctx context.Context, arg SystemUpdateActivityPubDeliveryParams) error {
+	return s.q.SystemUpdateActivityPubDelivery(ctx, dbpostgres.SystemUpdateActivityPubDeliveryParams{
+		Status:        arg.Status,
+		Attempts:      arg.Attempts,
+		NextAttemptAt: arg.NextAttemptAt,
+		Error:         arg.Error,
+		ID:            arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemUpdateDeadLetter(ctx context.Context, arg SystemUpdateDeadLetterParams) error {
+	return s.q.SystemUpdateDeadLetter(ctx, dbpostgres.SystemUpdateDeadLetterParams{
+		Message: arg.Message,
+		ID:      arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemUpdateVerificationCode(ctx context.Context, arg SystemUpdateVerificationCodeParams) error {
+	return s.q.SystemUpdateVerificationCode(ctx, dbpostgres.SystemUpdateVerificationCodeParams{
+		LastVerificationCode:  arg.LastVerificationCode,
+		VerificationExpiresAt: arg.VerificationExpiresAt,
+		ID:                    arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemUpdateWebhookDelivery(ctx context.Context, arg SystemUpdateWebhookDeliveryParams) error {
+	return s.q.SystemUpdateWebhookDelivery(ctx, dbpostgres.SystemUpdateWebhookDeliveryParams{
+		Status:         arg.Status,
+		Attempts:       arg.Attempts,
+		ResponseStatus: arg.ResponseStatus,
+		Error:          arg.Error,
+		ID:             arg.ID,
+	})
+}
+
+func (s *postgresQuerier) SystemUpsertActivityPubFollower(ctx context.Context, arg SystemUpsertActivityPubFollowerParams) error {
+	return s.q.SystemUpsertActivityPubFollower(ctx, dbpostgres.SystemUpsertActivityPubFollowerParams{
+		UserID:         arg.UserID,
+		ActorUrl:       arg.ActorUrl,
+		InboxUrl:       arg.InboxUrl,
+		SharedInboxUrl: arg.SharedInboxUrl,
+	})
+}
+
+func (s *postgresQuerier) TouchImageCacheEntry(ctx context.Context, arg TouchImageCacheEntryParams) error {
+	return s.q.TouchImageCacheEntry(ctx, dbpostgres.TouchImageCacheEntryParams{
+		LastUsedAt: arg.LastUsedAt,
+		ID:         arg.ID,
+	})
+}
+
+func (s *postgresQuerier) UpdateAPIKeyLastUsed(ctx context.Context, id int32) error {
+	return s.q.UpdateAPIKeyLastUsed(ctx, id)
+}
+
+func (s *postgresQuerier) UpdateAutoSubscribeRepliesForLister(ctx context.Context, arg UpdateAutoSubscribeRepliesForListerParams) error {
+	return s.q.UpdateAutoSubscribeRepliesForLister(ctx, dbpostgres.UpdateAutoSubscribeRepliesForListerParams{
+		AutoSubscribeReplies: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.AutoSubscribeReplies),
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateBlogEntryForWriter(ctx context.Context, arg UpdateBlogEntryForWriterParams) error {
+	return s.q.UpdateBlogEntryForWriter(ctx, dbpostgres.UpdateBlogEntryForWriterParams{
+		LanguageID:   arg.LanguageID,
+		Blog:         arg.Blog,
+		EntryID:      arg.EntryID,
+		WriterID:     arg.WriterID,
+		GrantEntryID: arg.GrantEntryID,
+		GranteeID:    arg.GranteeID,
+	})
+}
+
+func (s *postgresQuerier) UpdateBookmarksForLister(ctx context.Context, arg UpdateBookmarksForListerParams) error {
+	return s.q.UpdateBookmarksForLister(ctx, dbpostgres.UpdateBookmarksForListerParams{
+		List: func(s sql.NullString) []byte {
+			if s.Valid {
+				return []byte(s.String)
+			}
+			return nil
+		}(arg.List),
+		ListerID:  arg.ListerID,
+		GranteeID: arg.GranteeID,
+	})
+}
+
+func (s *postgresQuerier) UpdateCommentForEditor(ctx context.Context, arg UpdateCommentForEditorParams) error {
+	return s.q.UpdateCommentForEditor(ctx, dbpostgres.UpdateCommentForEditorParams{
+		LanguageID:  arg.LanguageID,
+		Text:        arg.Text,
+		CommentID:   arg.CommentID,
+		CommenterID: arg.CommenterID,
+		EditorID:    arg.EditorID,
+	})
+}
+
+func (s *postgresQuerier) UpdateCustomCssForLister(ctx context.Context, arg UpdateCustomCssForListerParams) error {
+	return s.q.UpdateCustomCssForLister(ctx, dbpostgres.UpdateCustomCssForListerParams{
+		CustomCss: arg.CustomCss,
+		ListerID:  arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateEmailForumUpdatesForLister(ctx context.Context, arg UpdateEmailForumUpdatesForListerParams) error {
+	return s.q.UpdateEmailForumUpdatesForLister(ctx, dbpostgres.UpdateEmailForumUpdatesForListerParams{
+		EmailForumUpdates: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.EmailForumUpdates.Bool), Valid: arg.EmailForumUpdates.Valid},
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateExternalLinkImageCache(ctx context.Context, arg UpdateExternalLinkImageCacheParams) error {
+	return s.q.UpdateExternalLinkImageCache(ctx, dbpostgres.UpdateExternalLinkImageCacheParams{
+		CardImageCache: arg.CardImageCache,
+		ID:             arg.ID,
+	})
+}
+
+func (s *postgresQuerier) UpdateExternalLinkMetadata(ctx context.Context, arg UpdateExternalLinkMetadataParams) error {
+	return s.q.UpdateExternalLinkMetadata(ctx, dbpostgres.UpdateExternalLinkMetadataParams{
+		CardTitle:       arg.CardTitle,
+		CardDescription: arg.CardDescription,
+		CardImage:       arg.CardImage,
+		CardDuration:    arg.CardDuration,
+		CardUploadDate:  arg.CardUploadDate,
+		CardAuthor:      arg.CardAuthor,
+		ID:              arg.ID,
+	})
+}
+
+func (s *postgresQuerier) UpdateImageSafeDimensionForLister(ctx context.Context, arg UpdateImageSafeDimensionForListerParams) error {
+	return s.q.UpdateImageSafeDimensionForLister(ctx, dbpostgres.UpdateImageSafeDimensionForListerParams{
+		ImageSafeDimension: arg.ImageSafeDimension,
+		ListerID:           arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateLastDigestSentAt(ctx context.Context, arg UpdateLastDigestSentAtParams) error {
+	return s.q.UpdateLastDigestSentAt(ctx, dbpostgres.UpdateLastDigestSentAtParams{
+		SentAt:   arg.SentAt,
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateLastMonthlyDigestSentAt(ctx context.Context, arg UpdateLastMonthlyDigestSentAtParams) error {
+	return s.q.UpdateLastMonthlyDigestSentAt(ctx, dbpostgres.UpdateLastMonthlyDigestSentAtParams{
+		SentAt:   arg.SentAt,
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateLastWeeklyDigestSentAt(ctx context.Context, arg UpdateLastWeeklyDigestSentAtParams) error {
+	return s.q.UpdateLastWeeklyDigestSentAt(ctx, dbpostgres.UpdateLastWeeklyDigestSentAtParams{
+		SentAt:   arg.SentAt,
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateMuteMentionsForLister(ctx context.Context, arg UpdateMuteMentionsForListerParams) error {
+	return s.q.UpdateMuteMentionsForLister(ctx, dbpostgres.UpdateMuteMentionsForListerParams{
+		MuteMentions: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.MuteMentions),
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateNewsPostForWriter(ctx context.Context, arg UpdateNewsPostForWriterParams) error {
+	return s.q.UpdateNewsPostForWriter(ctx, dbpostgres.UpdateNewsPostForWriterParams{
+		News:        arg.News,
+		LanguageID:  arg.LanguageID,
+		PostID:      arg.PostID,
+		WriterID:    arg.WriterID,
+		GrantPostID: arg.GrantPostID,
+		GranteeID:   arg.GranteeID,
+	})
+}
+
+func (s *postgresQuerier) UpdateNotificationDigestPreferences(ctx context.Context, arg UpdateNotificationDigestPreferencesParams) error {
+	return s.q.UpdateNotificationDigestPreferences(ctx, dbpostgres.UpdateNotificationDigestPreferencesParams{
+		DailyDigestHour: arg.DailyDigestHour,
+		DailyDigestMarkRead: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.DailyDigestMarkRead),
+		WeeklyDigestDay:   arg.WeeklyDigestDay,
+		WeeklyDigestHour:  arg.WeeklyDigestHour,
+		MonthlyDigestDay:  arg.MonthlyDigestDay,
+		MonthlyDigestHour: arg.MonthlyDigestHour,
+		ListerID:          arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdatePasskeyAfterLogin(ctx context.Context, arg UpdatePasskeyAfterLoginParams) error {
+	return s.q.UpdatePasskeyAfterLogin(ctx, dbpostgres.UpdatePasskeyAfterLoginParams{
+		SignCount:      arg.SignCount,
+		BackupEligible: arg.BackupEligible,
+		BackupState:    arg.BackupState,
+		CredentialID:   arg.CredentialID,
+	})
+}
+
+func (s *postgresQuerier) UpdatePreferenceForLister(ctx context.Context, arg UpdatePreferenceForListerParams) error {
+	return s.q.UpdatePreferenceForLister(ctx, dbpostgres.UpdatePreferenceForListerParams{
+		LanguageID: arg.LanguageID,
+		PageSize:   arg.PageSize,
+		Timezone:   arg.Timezone,
+		ListerID:   arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdatePublicProfileEnabledAtForUser(ctx context.Context, arg UpdatePublicProfileEnabledAtForUserParams) error {
+	return s.q.UpdatePublicProfileEnabledAtForUser(ctx, dbpostgres.UpdatePublicProfileEnabledAtForUserParams{
+		EnabledAt: arg.EnabledAt,
+		UserID:    arg.UserID,
+		GranteeID: arg.GranteeID,
+	})
+}
+
+func (s *postgresQuerier) UpdateSubscriptionByIDForSubscriber(ctx context.Context, arg UpdateSubscriptionByIDForSubscriberParams) error {
+	return s.q.UpdateSubscriptionByIDForSubscriber(ctx, dbpostgres.UpdateSubscriptionByIDForSubscriberParams{
+		Pattern:      arg.Pattern,
+		Method:       arg.Method,
+		SubscriberID: arg.SubscriberID,
+		ID:           arg.ID,
+	})
+}
+
+func (s *postgresQuerier) UpdateTOTPLastUsedStep(ctx context.Context, arg UpdateTOTPLastUsedStepParams) (int64, error) {
+	res, err := s.q.UpdateTOTPLastUsedStep(ctx, dbpostgres.UpdateTOTPLastUsedStepParams{
+		Step:   arg.Step,
+		UserID: arg.UserID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *postgresQuerier) UpdateTimezoneForLister(ctx context.Context, arg UpdateTimezoneForListerParams) error {
+	return s.q.UpdateTimezoneForLister(ctx, dbpostgres.UpdateTimezoneForListerParams{
+		Timezone: arg.Timezone,
+		ListerID: arg.ListerID,
+	})
+}
+
+func (s *postgresQuerier) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	return s.q.UpdateUserPassword(ctx, dbpostgres.UpdateUserPasswordParams{
+		UsersIdusers:    arg.UsersIdusers,
+		Passwd:          arg.Passwd,
+		PasswdAlgorithm: arg.PasswdAlgorithm,
+	})
+}
+
+func (s *postgresQuerier) UpdateWritingForWriter(ctx context.Context, arg UpdateWritingForWriterParams) error {
+	return s.q.UpdateWritingForWriter(ctx, dbpostgres.UpdateWritingForWriterParams{
+		Title:    arg.Title,
+		Abstract: arg.Abstract,
+		Content:  arg.Content,
+		Private: sql.NullInt32{Int32: func(b bool) int32 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Private.Bool), Valid: arg.Private.Valid},
+		LanguageID: arg.LanguageID,
+		WritingID:  arg.WritingID,
+		WriterID:   arg.WriterID,
+		GranteeID:  arg.GranteeID,
+	})
+}
+
+func (s *postgresQuerier) UpsertContentReadMarker(ctx context.Context, arg UpsertContentReadMarkerParams) error {
+	return s.q.UpsertContentReadMarker(ctx, dbpostgres.UpsertContentReadMarkerParams{
+		Item:          arg.Item,
+		ItemID:        arg.ItemID,
+		UserID:        arg.UserID,
+		LastCommentID: arg.LastCommentID,
+	})
+}
+
+func (s *postgresQuerier) UpsertDraftForUser(ctx context.Context, arg UpsertDraftForUserParams) error {
+	return s.q.UpsertDraftForUser(ctx, dbpostgres.UpsertDraftForUserParams{
+		UserID:    arg.UserID,
+		Target:    arg.Target,
+		Url:       arg.Url,
+		Content:   arg.Content,
+		UpdatedAt: arg.UpdatedAt,
+	})
+}
+
+func (s *postgresQuerier) UpsertImageCacheEntry(ctx context.Context, arg UpsertImageCacheEntryParams) error {
+	return s.q.UpsertImageCacheEntry(ctx, dbpostgres.UpsertImageCacheEntryParams{
+		ID:               arg.ID,
+		SourceUrl:        arg.SourceUrl,
+		SourceKind:       arg.SourceKind,
+		Status:           arg.Status,
+		CreatedAt:        arg.CreatedAt,
+		LastUsedAt:       arg.LastUsedAt,
+		FetchedAt:        arg.FetchedAt,
+		ExpiresAt:        arg.ExpiresAt,
+		ContentExpiresAt: arg.ContentExpiresAt,
+		ContentType:      arg.ContentType,
+		SizeBytes:        arg.SizeBytes,
+		Width:            arg.Width,
+		Height:           arg.Height,
+		Checksum:         arg.Checksum,
+		ThumbnailID:      arg.ThumbnailID,
+		UploadedImageID:  arg.UploadedImageID,
+		ErrorMessage:     arg.ErrorMessage,
+		RetryCount:       arg.RetryCount,
+		LastAttemptAt:    arg.LastAttemptAt,
+		NextAttemptAt:    arg.NextAttemptAt,
+	})
+}
+
+func (s *postgresQuerier) UpsertScheduledPublicationForAuthor(ctx context.Context, arg UpsertScheduledPublicationForAuthorParams) error {
+	return s.q.UpsertScheduledPublicationForAuthor(ctx, dbpostgres.UpsertScheduledPublicationForAuthorParams{
+		ItemType:  arg.ItemType,
+		ItemID:    arg.ItemID,
+		AuthorID:  arg.AuthorID,
+		PublishAt: arg.PublishAt,
+	})
+}
+
+func (s *postgresQuerier) UpsertSchedulerState(ctx context.Context, arg UpsertSchedulerStateParams) error {
+	return s.q.UpsertSchedulerState(ctx, dbpostgres.UpsertSchedulerStateParams{
+		TaskName:  arg.TaskName,
+		LastRunAt: arg.LastRunAt,
+		Metadata:  arg.Metadata,
+	})
+}
+
+func (s *postgresQuerier) UseRecoveryCodeForUser(ctx context.Context, arg UseRecoveryCodeForUserParams) (int64, error) {
+	res, err := s.q.UseRecoveryCodeForUser(ctx, dbpostgres.UseRecoveryCodeForUserParams{
+		UserID:   arg.UserID,
+		CodeHash: arg.CodeHash,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
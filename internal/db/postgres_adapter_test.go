@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestPostgresQuerierSkippedInsert(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+	q := NewPostgresQuerier(conn)
+
+	mock.ExpectQuery("searchwordlist").WithArgs("word").WillReturnRows(sqlmock.NewRows([]string{"idsearchwordlist"}))
+	id, err := q.SystemCreateSearchWord(context.Background(), "word")
+	if err != nil || id != 0 {
+		t.Fatalf("SystemCreateSearchWord = %d, %v; want 0, nil", id, err)
+	}
+
+	mock.ExpectQuery("external_links").WithArgs("https://example.com").WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	res, err := q.EnsureExternalLink(context.Background(), "https://example.com")
+	if err != nil {
+		t.Fatalf("EnsureExternalLink: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n != 0 {
+		t.Fatalf("RowsAffected = %d, want 0", n)
+	}
+
+	mock.ExpectQuery("external_links").WithArgs("https://example.org").WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int32(7)))
+	res, err = q.EnsureExternalLink(context.Background(), "https://example.org")
+	if err != nil {
+		t.Fatalf("EnsureExternalLink: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n != 1 {
+		t.Fatalf("RowsAffected = %d, want 1", n)
+	}
+	if id, _ := res.LastInsertId(); id != 7 {
+		t.Fatalf("LastInsertId = %d, want 7", id)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
+
+type failingValuer struct{}
+
+var errValuer = errors.New("no value")
+
+func (failingValuer) Value() (driver.Value, error) { return nil, errValuer }
+
+func TestPostgresQuerierConversionError(t *testing.T) {
+	conn, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+	q := NewPostgresQuerier(conn)
+
+	if _, err := q.AdminCountWordListByPrefix(context.Background(), failingValuer{}); !errors.Is(err, errValuer) {
+		t.Fatalf("AdminCountWordListByPrefix error = %v, want %v", err, errValuer)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("expectations: %v", err)
+	}
+}
@@ -54,7 +54,9 @@ type Querier interface {
 	AdminCreateLanguage(ctx context.Context, nameof sql.NullString) error
 	AdminCreateLinkerCategory(ctx context.Context, arg AdminCreateLinkerCategoryParams) error
 	AdminCreateLinkerItem(ctx context.Context, arg AdminCreateLinkerItemParams) error
+	AdminCreateWebhook(ctx context.Context, arg AdminCreateWebhookParams) (int64, error)
 	AdminDeleteCommentsByThread(ctx context.Context, forumthreadID int32) error
+	AdminDeleteEmailSuppression(ctx context.Context, id int32) error
 	AdminDeleteExternalLink(ctx context.Context, id int32) error
 	AdminDeleteExternalLinkByURL(ctx context.Context, url string) error
 	AdminDeleteFAQ(ctx context.Context, id int32) error
@@ -84,6 +86,8 @@ type Querier interface {
 	// Parameters:
 	//   ? - Permission ID to be deleted (int)
 	AdminDeleteUserRole(ctx context.Context, iduserRoles int32) error
+	AdminDeleteWebhook(ctx context.Context, id int32) error
+	AdminDeleteWebhookDeliveriesByWebhook(ctx context.Context, webhookID int32) error
 	// admin task
 	AdminDemoteAnnouncement(ctx context.Context, id int32) error
 	AdminForumCategoryThreadCounts(ctx context.Context) ([]*AdminForumCategoryThreadCountsRow, error)
@@ -92,8 +96,10 @@ type Querier interface {
 	AdminGetAllBlogEntriesByUser(ctx context.Context, authorID int32) ([]*AdminGetAllBlogEntriesByUserRow, error)
 	AdminGetAllCommentsByUser(ctx context.Context, userID int32) ([]*AdminGetAllCommentsByUserRow, error)
 	AdminGetAllWritingsByAuthor(ctx context.Context, authorID int32) ([]*AdminGetAllWritingsByAuthorRow, error)
+	AdminGetContentReportByID(ctx context.Context, id int32) (*ContentReport, error)
 	AdminGetDashboardStats(ctx context.Context) (*AdminGetDashboardStatsRow, error)
 	AdminGetDeactivatedCommentById(ctx context.Context, idcomments int32) (*DeactivatedComment, error)
+	AdminGetEmailSuppressionByID(ctx context.Context, id int32) (*EmailSuppression, error)
 	AdminGetExternalLinkByCacheID(ctx context.Context, arg AdminGetExternalLinkByCacheIDParams) (*ExternalLink, error)
 	AdminGetFAQActiveQuestions(ctx context.Context) ([]*Faq, error)
 	AdminGetFAQByID(ctx context.Context, id int32) (*Faq, error)
@@ -123,6 +129,7 @@ type Querier interface {
 	AdminGetThreadsStartedByUserWithTopic(ctx context.Context, usersIdusers int32) ([]*AdminGetThreadsStartedByUserWithTopicRow, error)
 	AdminGetTopicGrants(ctx context.Context, topicID sql.NullInt32) ([]*AdminGetTopicGrantsRow, error)
 	AdminGetUserEmailByID(ctx context.Context, id int32) (*UserEmail, error)
+	AdminGetWebhook(ctx context.Context, id int32) (*Webhook, error)
 	AdminGetWritingsByCategoryId(ctx context.Context, writingCategoryID int32) ([]*AdminGetWritingsByCategoryIdRow, error)
 	AdminHardDeleteComment(ctx context.Context, idcomments int32) error
 	AdminImageboardPostCounts(ctx context.Context) ([]*AdminImageboardPostCountsRow, error)
@@ -165,6 +172,7 @@ type Querier interface {
 	AdminListDeactivatedLinks(ctx context.Context, arg AdminListDeactivatedLinksParams) ([]*AdminListDeactivatedLinksRow, error)
 	AdminListDeactivatedUsers(ctx context.Context, arg AdminListDeactivatedUsersParams) ([]*AdminListDeactivatedUsersRow, error)
 	AdminListDeactivatedWritings(ctx context.Context, arg AdminListDeactivatedWritingsParams) ([]*AdminListDeactivatedWritingsRow, error)
+	AdminListEmailSuppressions(ctx context.Context, arg AdminListEmailSuppressionsParams) ([]*AdminListEmailSuppressionsRow, error)
 	AdminListExternalLinks(ctx context.Context, arg AdminListExternalLinksParams) ([]*ExternalLink, error)
 	AdminListFAQCategories(ctx context.Context) ([]*FaqCategory, error)
 	// admin task
@@ -185,6 +193,8 @@ type Querier interface {
 	AdminListOrphanComments(ctx context.Context) ([]int32, error)
 	AdminListOrphanForumThreads(ctx context.Context) ([]int32, error)
 	AdminListPasswordResets(ctx context.Context, arg AdminListPasswordResetsParams) ([]*AdminListPasswordResetsRow, error)
+	AdminListPendingContentReportReporters(ctx context.Context, arg AdminListPendingContentReportReportersParams) ([]int32, error)
+	AdminListPendingContentReports(ctx context.Context) ([]*AdminListPendingContentReportsRow, error)
 	AdminListPendingDeactivatedBlogs(ctx context.Context, arg AdminListPendingDeactivatedBlogsParams) ([]*AdminListPendingDeactivatedBlogsRow, error)
 	AdminListPendingDeactivatedComments(ctx context.Context, arg AdminListPendingDeactivatedCommentsParams) ([]*AdminListPendingDeactivatedCommentsRow, error)
 	AdminListPendingDeactivatedImageposts(ctx context.Context, arg AdminListPendingDeactivatedImagepostsParams) ([]*AdminListPendingDeactivatedImagepostsRow, error)
@@ -197,11 +207,13 @@ type Querier interface {
 	AdminListPrivateForumThreads(ctx context.Context, arg AdminListPrivateForumThreadsParams) ([]*AdminListPrivateForumThreadsRow, error)
 	AdminListPrivateForumTopics(ctx context.Context, arg AdminListPrivateForumTopicsParams) ([]*AdminListPrivateForumTopicsRow, error)
 	AdminListPrivateTopicParticipantsByTopicID(ctx context.Context, itemID sql.NullInt32) ([]*AdminListPrivateTopicParticipantsByTopicIDRow, error)
+	AdminListRecentEmailBounces(ctx context.Context, limit int32) ([]*AdminListRecentEmailBouncesRow, error)
 	AdminListRecentNotifications(ctx context.Context, limit int32) ([]*Notification, error)
 	AdminListRequestComments(ctx context.Context, requestID int32) ([]*AdminRequestComment, error)
 	AdminListRequestQueue(ctx context.Context) ([]*AdminRequestQueue, error)
 	AdminListRequestQueueByStatus(ctx context.Context, status string) ([]*AdminRequestQueue, error)
 	AdminListRequestsByUserID(ctx context.Context, usersIdusers int32) ([]*AdminRequestQueue, error)
+	AdminListResolvedContentReports(ctx context.Context, limit int32) ([]*AdminListResolvedContentReportsRow, error)
 	// admin task
 	AdminListRoles(ctx context.Context) ([]*Role, error)
 	// admin task
@@ -221,6 +233,8 @@ type Querier interface {
 	AdminListUsersByID(ctx context.Context, ids []int32) ([]*AdminListUsersByIDRow, error)
 	// admin task
 	AdminListUsersByRoleID(ctx context.Context, roleID int32) ([]*AdminListUsersByRoleIDRow, error)
+	AdminListWebhookDeliveries(ctx context.Context, arg AdminListWebhookDeliveriesParams) ([]*AdminListWebhookDeliveriesRow, error)
+	AdminListWebhooks(ctx context.Context) ([]*Webhook, error)
 	AdminMarkBlogRestored(ctx context.Context, idblogs int32) error
 	AdminMarkCommentRestored(ctx context.Context, idcomments int32) error
 	AdminMarkImagepostRestored(ctx context.Context, idimagepost int32) error
@@ -238,6 +252,7 @@ type Querier interface {
 	AdminRebuildAllForumTopicMetaColumns(ctx context.Context) error
 	AdminRecalculateAllForumThreadMetaData(ctx context.Context) error
 	AdminRecalculateForumThreadByIdMetaData(ctx context.Context, idforumthread int32) error
+	AdminRemoveLoginRolesFromUser(ctx context.Context, userID int32) error
 	AdminRenameFAQCategory(ctx context.Context, arg AdminRenameFAQCategoryParams) error
 	// AdminRenameLanguage updates the language name.
 	// Parameters:
@@ -246,14 +261,21 @@ type Querier interface {
 	AdminRenameLanguage(ctx context.Context, arg AdminRenameLanguageParams) error
 	AdminRenameLinkerCategory(ctx context.Context, arg AdminRenameLinkerCategoryParams) error
 	AdminReplaceSiteNewsURL(ctx context.Context, arg AdminReplaceSiteNewsURLParams) error
+	// Resolves every pending report on an item so duplicate reports leave the
+	// queue together.
+	AdminResolveContentReportsForItem(ctx context.Context, arg AdminResolveContentReportsForItemParams) (int64, error)
 	AdminRestoreBlog(ctx context.Context, arg AdminRestoreBlogParams) error
+	AdminRestoreBlogText(ctx context.Context, arg AdminRestoreBlogTextParams) error
 	AdminRestoreComment(ctx context.Context, arg AdminRestoreCommentParams) error
+	AdminRestoreCommentText(ctx context.Context, arg AdminRestoreCommentTextParams) error
 	AdminRestoreImagepost(ctx context.Context, arg AdminRestoreImagepostParams) error
 	AdminRestoreLink(ctx context.Context, arg AdminRestoreLinkParams) error
+	AdminRestoreNewsPostText(ctx context.Context, arg AdminRestoreNewsPostTextParams) error
 	AdminRestoreUser(ctx context.Context, idusers int32) error
 	AdminRestoreUserEmail(ctx context.Context, idusers int32) error
 	AdminRestoreUserPassword(ctx context.Context, idusers int32) error
 	AdminRestoreWriting(ctx context.Context, arg AdminRestoreWritingParams) error
+	AdminRestoreWritingText(ctx context.Context, arg AdminRestoreWritingTextParams) error
 	AdminScrubBlog(ctx context.Context, arg AdminScrubBlogParams) error
 	AdminScrubComment(ctx context.Context, arg AdminScrubCommentParams) error
 	AdminScrubImagepost(ctx context.Context, idimagepost int32) error
@@ -289,6 +311,7 @@ type Querier interface {
 	AdminUpdateUserEmailDetails(ctx context.Context, arg AdminUpdateUserEmailDetailsParams) error
 	AdminUpdateUserRole(ctx context.Context, arg AdminUpdateUserRoleParams) error
 	AdminUpdateUsernameByID(ctx context.Context, arg AdminUpdateUsernameByIDParams) error
+	AdminUpdateWebhook(ctx context.Context, arg AdminUpdateWebhookParams) error
 	AdminUpdateWritingCategory(ctx context.Context, arg AdminUpdateWritingCategoryParams) error
 	AdminUserPostCounts(ctx context.Context) ([]*AdminUserPostCountsRow, error)
 	AdminUserPostCountsByID(ctx context.Context, idusers int32) (*AdminUserPostCountsByIDRow, error)
@@ -298,14 +321,21 @@ type Querier interface {
 	AdminWritingCategoryCounts(ctx context.Context) ([]*AdminWritingCategoryCountsRow, error)
 	CheckUserHasGrant(ctx context.Context, arg CheckUserHasGrantParams) (bool, error)
 	ClearUnreadContentPrivateLabelExceptUser(ctx context.Context, arg ClearUnreadContentPrivateLabelExceptUserParams) error
+	CountContentRevisionsByItem(ctx context.Context, arg CountContentRevisionsByItemParams) (int64, error)
+	CountForumPollVoters(ctx context.Context, pollID int32) (int64, error)
+	CountPendingContentReportsForReporter(ctx context.Context, arg CountPendingContentReportsForReporterParams) (int64, error)
 	CountUnreadPrivateThreadsForUser(ctx context.Context, arg CountUnreadPrivateThreadsForUserParams) (int64, error)
+	CountUnusedRecoveryCodesForUser(ctx context.Context, usersIdusers int32) (int64, error)
 	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (int64, error)
 	CreateBlogEntryForWriter(ctx context.Context, arg CreateBlogEntryForWriterParams) (int64, error)
 	// This query adds a new entry to the "bookmarks" table for a lister.
 	CreateBookmarksForLister(ctx context.Context, arg CreateBookmarksForListerParams) error
 	CreateCommentInSectionForCommenter(ctx context.Context, arg CreateCommentInSectionForCommenterParams) (int64, error)
+	CreateContentReportForReporter(ctx context.Context, arg CreateContentReportForReporterParams) (int64, error)
 	CreateExternalLink(ctx context.Context, url string) (sql.Result, error)
 	CreateFAQQuestionForWriter(ctx context.Context, arg CreateFAQQuestionForWriterParams) error
+	CreateForumPollForCreator(ctx context.Context, arg CreateForumPollForCreatorParams) (int64, error)
+	CreateForumPollOption(ctx context.Context, arg CreateForumPollOptionParams) error
 	CreateForumTopicForPoster(ctx context.Context, arg CreateForumTopicForPosterParams) (int64, error)
 	CreateGrant(ctx context.Context, arg CreateGrantParams) error
 	CreateImagePostForPoster(ctx context.Context, arg CreateImagePostForPosterParams) (int64, error)
@@ -318,18 +348,24 @@ type Querier interface {
 	CreateUploadedImageForUploader(ctx context.Context, arg CreateUploadedImageForUploaderParams) (int64, error)
 	CreateWritingForWriter(ctx context.Context, arg CreateWritingForWriterParams) (int64, error)
 	DeactivateNewsPost(ctx context.Context, idsitenews int32) error
+	DeleteDraftForUser(ctx context.Context, arg DeleteDraftForUserParams) error
+	DeleteForumPollVotesForVoter(ctx context.Context, arg DeleteForumPollVotesForVoterParams) error
 	DeleteGrantByProperties(ctx context.Context, arg DeleteGrantByPropertiesParams) error
 	DeleteGrantsByRoleID(ctx context.Context, roleID sql.NullInt32) error
 	DeleteImageCacheEntry(ctx context.Context, id string) error
 	DeleteNotificationForLister(ctx context.Context, arg DeleteNotificationForListerParams) error
 	DeletePasskey(ctx context.Context, arg DeletePasskeyParams) error
 	DeletePendingPassword(ctx context.Context, userID int32) error
+	DeleteReactionForReactor(ctx context.Context, arg DeleteReactionForReactorParams) (int64, error)
+	DeleteRecoveryCodesForUser(ctx context.Context, usersIdusers int32) error
 	DeleteSubscriptionArchetypesByRoleAndName(ctx context.Context, arg DeleteSubscriptionArchetypesByRoleAndNameParams) error
 	DeleteSubscriptionByIDForSubscriber(ctx context.Context, arg DeleteSubscriptionByIDForSubscriberParams) error
 	DeleteSubscriptionForSubscriber(ctx context.Context, arg DeleteSubscriptionForSubscriberParams) error
+	DeleteTOTPForUser(ctx context.Context, usersIdusers int32) error
 	DeleteThreadsByTopicID(ctx context.Context, forumtopicIdforumtopic int32) error
 	DeleteUserEmailForOwner(ctx context.Context, arg DeleteUserEmailForOwnerParams) error
 	DeleteUserLanguagesForUser(ctx context.Context, userID int32) error
+	EnableTOTPForUser(ctx context.Context, arg EnableTOTPForUserParams) error
 	EnsureExternalLink(ctx context.Context, url string) (sql.Result, error)
 	GetAPIKeyByHash(ctx context.Context, apiKey string) (*ApiKey, error)
 	GetActiveAnnouncementWithNewsForLister(ctx context.Context, arg GetActiveAnnouncementWithNewsForListerParams) (*GetActiveAnnouncementWithNewsForListerRow, error)
@@ -365,7 +401,10 @@ type Querier interface {
 	GetCommentsBySectionThreadIdForUser(ctx context.Context, arg GetCommentsBySectionThreadIdForUserParams) ([]*GetCommentsBySectionThreadIdForUserRow, error)
 	GetCommentsByThreadIdForUser(ctx context.Context, arg GetCommentsByThreadIdForUserParams) ([]*GetCommentsByThreadIdForUserRow, error)
 	GetContentReadMarker(ctx context.Context, arg GetContentReadMarkerParams) (*GetContentReadMarkerRow, error)
+	GetContentRevisionForItem(ctx context.Context, arg GetContentRevisionForItemParams) (*ContentRevision, error)
 	GetDigestTimezones(ctx context.Context) ([]sql.NullString, error)
+	GetDraftForUser(ctx context.Context, arg GetDraftForUserParams) (*Draft, error)
+	GetEmailSuppressionByEmail(ctx context.Context, email string) (*EmailSuppression, error)
 	GetExternalLink(ctx context.Context, url string) (*ExternalLink, error)
 	GetExternalLinkByID(ctx context.Context, id int32) (*ExternalLink, error)
 	GetFAQAnsweredQuestions(ctx context.Context, arg GetFAQAnsweredQuestionsParams) ([]*GetFAQAnsweredQuestionsRow, error)
@@ -373,6 +412,7 @@ type Querier interface {
 	GetFAQQuestionsByCategory(ctx context.Context, arg GetFAQQuestionsByCategoryParams) ([]*GetFAQQuestionsByCategoryRow, error)
 	GetFAQRevisionsForAdmin(ctx context.Context, faqID int32) ([]*FaqRevision, error)
 	GetForumCategoryById(ctx context.Context, arg GetForumCategoryByIdParams) (*Forumcategory, error)
+	GetForumPollByThreadID(ctx context.Context, threadID int32) (*ForumPoll, error)
 	GetForumThreadIdByNewsPostId(ctx context.Context, idsitenews int32) (*GetForumThreadIdByNewsPostIdRow, error)
 	GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostText(ctx context.Context, arg GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextParams) ([]*GetForumThreadsByForumTopicIdForUserWithFirstAndLastPosterAndFirstPostTextRow, error)
 	GetForumTopicById(ctx context.Context, idforumtopic int32) (*Forumtopic, error)
@@ -407,6 +447,7 @@ type Querier interface {
 	// Parameters:
 	//   lister_id - ID of the lister to count notifications for
 	GetNotificationCountForLister(ctx context.Context, listerID int32) (int64, error)
+	// Suppressed addresses are skipped so mail fails over to the next address.
 	GetNotificationEmailByUserID(ctx context.Context, userID int32) (*UserEmail, error)
 	GetNotificationForLister(ctx context.Context, arg GetNotificationForListerParams) (*Notification, error)
 	GetPasskeyByCredentialID(ctx context.Context, credentialID []byte) (*UserPasskey, error)
@@ -428,8 +469,10 @@ type Querier interface {
 	// expression intentionally matches ListUnreadPrivateThreadsForUser.
 	GetReplyThreadsForLister(ctx context.Context, arg GetReplyThreadsForListerParams) ([]*GetReplyThreadsForListerRow, error)
 	GetRoleByName(ctx context.Context, name string) (*Role, error)
+	GetScheduledPublication(ctx context.Context, arg GetScheduledPublicationParams) (*ScheduledPublication, error)
 	GetSchedulerState(ctx context.Context, taskName string) (*SchedulerState, error)
 	GetSubscriptionArchetypesByRole(ctx context.Context, roleID int32) ([]*RoleSubscriptionArchetype, error)
+	GetTOTPForUser(ctx context.Context, usersIdusers int32) (*UserTotp, error)
 	GetThreadBySectionThreadIDForReplier(ctx context.Context, arg GetThreadBySectionThreadIDForReplierParams) (*Forumthread, error)
 	GetThreadLastPosterAndPermsForUser(ctx context.Context, arg GetThreadLastPosterAndPermsForUserParams) (*GetThreadLastPosterAndPermsForUserRow, error)
 	// GetUnreadNotificationCountForLister returns the number of unread notifications for a
@@ -466,13 +509,17 @@ type Querier interface {
 	GetWritingForListerByID(ctx context.Context, arg GetWritingForListerByIDParams) (*GetWritingForListerByIDRow, error)
 	InsertAdminUserComment(ctx context.Context, arg InsertAdminUserCommentParams) error
 	InsertAuditLog(ctx context.Context, arg InsertAuditLogParams) error
+	InsertContentRevision(ctx context.Context, arg InsertContentRevisionParams) (int64, error)
 	InsertEmailPreferenceForLister(ctx context.Context, arg InsertEmailPreferenceForListerParams) error
 	InsertFAQQuestionForWriter(ctx context.Context, arg InsertFAQQuestionForWriterParams) (sql.Result, error)
 	InsertFAQRevisionForUser(ctx context.Context, arg InsertFAQRevisionForUserParams) error
+	InsertForumPollVoteForVoter(ctx context.Context, arg InsertForumPollVoteForVoterParams) error
 	InsertPasskey(ctx context.Context, arg InsertPasskeyParams) error
 	InsertPassword(ctx context.Context, arg InsertPasswordParams) error
 	InsertPendingEmail(ctx context.Context, arg InsertPendingEmailParams) error
 	InsertPreferenceForLister(ctx context.Context, arg InsertPreferenceForListerParams) error
+	InsertReactionForReactor(ctx context.Context, arg InsertReactionForReactorParams) error
+	InsertRecoveryCodeForUser(ctx context.Context, arg InsertRecoveryCodeForUserParams) error
 	InsertSubscription(ctx context.Context, arg InsertSubscriptionParams) error
 	InsertUserEmail(ctx context.Context, arg InsertUserEmailParams) error
 	InsertUserLang(ctx context.Context, arg InsertUserLangParams) error
@@ -500,9 +547,14 @@ type Querier interface {
 	ListContentLabelStatus(ctx context.Context, arg ListContentLabelStatusParams) ([]*ListContentLabelStatusRow, error)
 	ListContentPrivateLabels(ctx context.Context, arg ListContentPrivateLabelsParams) ([]*ListContentPrivateLabelsRow, error)
 	ListContentPublicLabels(ctx context.Context, arg ListContentPublicLabelsParams) ([]*ListContentPublicLabelsRow, error)
+	ListContentRevisionsByItem(ctx context.Context, arg ListContentRevisionsByItemParams) ([]*ListContentRevisionsByItemRow, error)
+	ListDraftsForUser(ctx context.Context, userID int32) ([]*Draft, error)
 	ListDuePendingImageCacheEntries(ctx context.Context, arg ListDuePendingImageCacheEntriesParams) ([]*ImageCacheEntry, error)
 	ListEffectiveRoleIDsByUserID(ctx context.Context, usersIdusers int32) ([]int32, error)
 	ListExpiredExternalImageCacheEntries(ctx context.Context, arg ListExpiredExternalImageCacheEntriesParams) ([]*ImageCacheEntry, error)
+	ListForumPollOptionIDsForVoter(ctx context.Context, arg ListForumPollOptionIDsForVoterParams) ([]int32, error)
+	ListForumPollOptionsWithVoteCounts(ctx context.Context, pollID int32) ([]*ListForumPollOptionsWithVoteCountsRow, error)
+	ListForumPollVotersForPoll(ctx context.Context, pollID int32) ([]*ListForumPollVotersForPollRow, error)
 	ListForumcategoryPath(ctx context.Context, categoryID int32) ([]*ListForumcategoryPathRow, error)
 	ListGrants(ctx context.Context) ([]*Grant, error)
 	ListGrantsByUserID(ctx context.Context, userID sql.NullInt32) ([]*Grant, error)
@@ -517,6 +569,9 @@ type Querier interface {
 	ListPrivateTopicsByUserID(ctx context.Context, userID sql.NullInt32) ([]*ListPrivateTopicsByUserIDRow, error)
 	ListPublicWritingsByUserForLister(ctx context.Context, arg ListPublicWritingsByUserForListerParams) ([]*ListPublicWritingsByUserForListerRow, error)
 	ListPublicWritingsInCategoryForLister(ctx context.Context, arg ListPublicWritingsInCategoryForListerParams) ([]*ListPublicWritingsInCategoryForListerRow, error)
+	ListReactionCountsForViewer(ctx context.Context, arg ListReactionCountsForViewerParams) ([]*ListReactionCountsForViewerRow, error)
+	// Paths of stored uploads still referenced by uploaded images or image posts.
+	ListReferencedUploadPaths(ctx context.Context) ([]sql.NullString, error)
 	ListSiteNewsSearchFirstForLister(ctx context.Context, arg ListSiteNewsSearchFirstForListerParams) ([]int32, error)
 	ListSiteNewsSearchNextForLister(ctx context.Context, arg ListSiteNewsSearchNextForListerParams) ([]int32, error)
 	ListSubscribersForPattern(ctx context.Context, arg ListSubscribersForPatternParams) ([]int32, error)
@@ -548,6 +603,7 @@ type Querier interface {
 	SetNotificationReadForLister(ctx context.Context, arg SetNotificationReadForListerParams) error
 	SetNotificationUnreadForLister(ctx context.Context, arg SetNotificationUnreadForListerParams) error
 	SetNotificationsReadForListerBatch(ctx context.Context, arg SetNotificationsReadForListerBatchParams) error
+	SetPendingTOTPForUser(ctx context.Context, arg SetPendingTOTPForUserParams) error
 	SetVerificationCodeForLister(ctx context.Context, arg SetVerificationCodeForListerParams) error
 	SystemAddToBlogsSearch(ctx context.Context, arg SystemAddToBlogsSearchParams) error
 	SystemAddToForumCommentSearch(ctx context.Context, arg SystemAddToForumCommentSearchParams) error
@@ -562,13 +618,20 @@ type Querier interface {
 	SystemAssignWritingThreadID(ctx context.Context, arg SystemAssignWritingThreadIDParams) error
 	SystemCheckGrant(ctx context.Context, arg SystemCheckGrantParams) (int32, error)
 	SystemCheckRoleGrant(ctx context.Context, arg SystemCheckRoleGrantParams) (int32, error)
+	// Pushing next_attempt_at past the lease stops another run sending the
+	// delivery; if the sender dies it is picked up again once the lease ends.
+	SystemClaimActivityPubDelivery(ctx context.Context, arg SystemClaimActivityPubDeliveryParams) (int64, error)
 	SystemClearContentLabelStatus(ctx context.Context, arg SystemClearContentLabelStatusParams) error
 	SystemClearContentPrivateLabel(ctx context.Context, arg SystemClearContentPrivateLabelParams) error
+	SystemCloseForumPoll(ctx context.Context, arg SystemCloseForumPollParams) (int64, error)
 	SystemCopyPrivateThreadGrantsToThread(ctx context.Context, arg SystemCopyPrivateThreadGrantsToThreadParams) error
 	SystemCopyPrivateTopicGrantsToThread(ctx context.Context, arg SystemCopyPrivateTopicGrantsToThreadParams) error
+	SystemCountActivityPubActivitiesForUser(ctx context.Context, userID int32) (int64, error)
+	SystemCountActivityPubFollowers(ctx context.Context, userID int32) (int64, error)
 	SystemCountDeadLetters(ctx context.Context) (int64, error)
 	// SystemCountLanguages counts all languages.
 	SystemCountLanguages(ctx context.Context) (int64, error)
+	SystemCountPendingEmailQueue(ctx context.Context) (*SystemCountPendingEmailQueueRow, error)
 	SystemCountRecentLoginAttempts(ctx context.Context, arg SystemCountRecentLoginAttemptsParams) (int64, error)
 	SystemCreateGrant(ctx context.Context, arg SystemCreateGrantParams) (int64, error)
 	SystemCreateNotification(ctx context.Context, arg SystemCreateNotificationParams) error
@@ -585,83 +648,165 @@ type Querier interface {
 	//   ? - User ID to be associated with the permission (int)
 	//   ? - Role ID (int)
 	SystemCreateUserRoleByID(ctx context.Context, arg SystemCreateUserRoleByIDParams) error
+	SystemDeleteActivityPubFollower(ctx context.Context, arg SystemDeleteActivityPubFollowerParams) (int64, error)
 	// This query deletes all data from the "blogs_search" table.
 	SystemDeleteBlogsSearch(ctx context.Context) error
+	SystemDeleteBlogsSearchByBlogID(ctx context.Context, blogID int32) error
 	// This query deletes all data from the "comments_search" table.
 	SystemDeleteCommentsSearch(ctx context.Context) error
+	SystemDeleteCommentsSearchByCommentID(ctx context.Context, commentID int32) error
 	SystemDeleteDeadLetter(ctx context.Context, id int32) error
 	SystemDeleteImagePostSearch(ctx context.Context) error
+	SystemDeleteImagePostSearchByImagePostID(ctx context.Context, imagePostID int32) error
 	// This query deletes all data from the "linker_search" table.
 	SystemDeleteLinkerSearch(ctx context.Context) error
+	SystemDeleteLinkerSearchByLinkerID(ctx context.Context, linkerID int32) error
 	SystemDeletePasswordReset(ctx context.Context, id int32) error
 	// Delete all password reset entries for the given user and return the result
 	SystemDeletePasswordResetsByUser(ctx context.Context, userID int32) (sql.Result, error)
+	SystemDeleteScheduledPublication(ctx context.Context, id int32) (int64, error)
 	SystemDeleteSessionByID(ctx context.Context, sessionID string) error
 	// This query deletes all data from the "site_news_search" table.
 	SystemDeleteSiteNewsSearch(ctx context.Context) error
+	SystemDeleteSiteNewsSearchBySiteNewsID(ctx context.Context, siteNewsID int32) error
 	SystemDeleteUninitializedThread(ctx context.Context, threadID int32) error
 	SystemDeleteUnverifiedEmailsExpiresBefore(ctx context.Context, verificationExpiresAt sql.NullTime) (sql.Result, error)
 	SystemDeleteUserEmailsByEmailExceptID(ctx context.Context, arg SystemDeleteUserEmailsByEmailExceptIDParams) error
 	// This query deletes all data from the "writing_search" table.
 	SystemDeleteWritingSearch(ctx context.Context) error
 	SystemDeleteWritingSearchByWritingID(ctx context.Context, writingID int32) error
+	// Active users are published as ActivityPub actors.
+	SystemGetActivityPubActor(ctx context.Context, username sql.NullString) (*SystemGetActivityPubActorRow, error)
+	SystemGetActivityPubActorKey(ctx context.Context, userID int32) (*ActivitypubActorKey, error)
+	// A blog entry is federated while anonymous visitors may open it.
+	SystemGetActivityPubBlogEntry(ctx context.Context, id int32) (*SystemGetActivityPubBlogEntryRow, error)
+	// A writing is federated while it is public and anonymous visitors may open it.
+	SystemGetActivityPubWriting(ctx context.Context, id int32) (*SystemGetActivityPubWritingRow, error)
 	SystemGetAllBlogsForIndex(ctx context.Context) ([]*SystemGetAllBlogsForIndexRow, error)
 	SystemGetBlogEntryByID(ctx context.Context, idblogs int32) (*SystemGetBlogEntryByIDRow, error)
+	SystemGetBlogEntryForPublishing(ctx context.Context, id int32) (*SystemGetBlogEntryForPublishingRow, error)
+	SystemGetBlogForArchive(ctx context.Context, id int32) (*SystemGetBlogForArchiveRow, error)
+	SystemGetBlogForRevision(ctx context.Context, idblogs int32) (*SystemGetBlogForRevisionRow, error)
+	// Resolves the author, forum topic and owning section item of a comment so
+	// reactions can be permission checked and linked back to the page showing it.
+	SystemGetCommentReactionTarget(ctx context.Context, id int32) (*SystemGetCommentReactionTargetRow, error)
 	SystemGetDeadLetter(ctx context.Context, id int32) (*DeadLetter, error)
 	SystemGetFAQQuestions(ctx context.Context) ([]*Faq, error)
 	SystemGetForumTopicByTitle(ctx context.Context, title sql.NullString) (*Forumtopic, error)
 	// SystemGetLanguageIDByName resolves a language ID by name.
 	SystemGetLanguageIDByName(ctx context.Context, nameof sql.NullString) (int32, error)
 	SystemGetLastNotificationForRecipientByMessage(ctx context.Context, arg SystemGetLastNotificationForRecipientByMessageParams) (*Notification, error)
+	// The last activity sent about an object tells whether followers still see it.
+	SystemGetLatestActivityPubActivityForObject(ctx context.Context, objectUrl string) (*ActivitypubActivity, error)
 	SystemGetLogin(ctx context.Context, username sql.NullString) (*SystemGetLoginRow, error)
 	SystemGetNewsPostByID(ctx context.Context, idsitenews int32) (int32, error)
+	SystemGetNewsPostForPublishing(ctx context.Context, id int32) (*SystemGetNewsPostForPublishingRow, error)
+	SystemGetNewsPostForRevision(ctx context.Context, idsitenews int32) (*SystemGetNewsPostForRevisionRow, error)
 	SystemGetSearchWordByWordLowercased(ctx context.Context, lcase string) (*Searchwordlist, error)
 	SystemGetTemplateOverride(ctx context.Context, name string) (string, error)
 	SystemGetUserByEmail(ctx context.Context, email string) (*SystemGetUserByEmailRow, error)
+	// Suppressed addresses are skipped so mail fails over to the next address.
 	SystemGetUserByID(ctx context.Context, idusers int32) (*SystemGetUserByIDRow, error)
 	SystemGetUserByUsername(ctx context.Context, username sql.NullString) (*SystemGetUserByUsernameRow, error)
 	SystemGetUsersByIDs(ctx context.Context, ids []int32) ([]*SystemGetUsersByIDsRow, error)
+	SystemGetWebhook(ctx context.Context, id int32) (*Webhook, error)
+	SystemGetWebhookDelivery(ctx context.Context, id int32) (*WebhookDelivery, error)
 	SystemGetWritingByID(ctx context.Context, idwriting int32) (int32, error)
+	SystemGetWritingForArchive(ctx context.Context, id int32) (*SystemGetWritingForArchiveRow, error)
+	SystemGetWritingForPublishing(ctx context.Context, id int32) (*SystemGetWritingForPublishingRow, error)
+	SystemGetWritingForRevision(ctx context.Context, idwriting int32) (*SystemGetWritingForRevisionRow, error)
 	SystemIncrementPendingEmailError(ctx context.Context, id int32) error
+	SystemInsertActivityPubActivity(ctx context.Context, arg SystemInsertActivityPubActivityParams) (int64, error)
+	// A key is only stored once; a concurrent insert keeps the first key.
+	SystemInsertActivityPubActorKey(ctx context.Context, arg SystemInsertActivityPubActorKeyParams) error
+	SystemInsertActivityPubDelivery(ctx context.Context, arg SystemInsertActivityPubDeliveryParams) error
 	// System query only used internally
 	SystemInsertDeadLetter(ctx context.Context, message string) error
+	SystemInsertEmailBounce(ctx context.Context, arg SystemInsertEmailBounceParams) error
 	SystemInsertLoginAttempt(ctx context.Context, arg SystemInsertLoginAttemptParams) error
 	SystemInsertSession(ctx context.Context, arg SystemInsertSessionParams) error
 	SystemInsertUser(ctx context.Context, username sql.NullString) (int64, error)
+	SystemInsertWebhookDelivery(ctx context.Context, arg SystemInsertWebhookDeliveryParams) (int64, error)
 	SystemLatestDeadLetter(ctx context.Context) (interface{}, error)
+	SystemListActiveWebhooks(ctx context.Context) ([]*Webhook, error)
+	SystemListActivityPubActivitiesForUser(ctx context.Context, arg SystemListActivityPubActivitiesForUserParams) ([]*ActivitypubActivity, error)
+	SystemListActivityPubFollowers(ctx context.Context, userID int32) ([]*ActivitypubFollower, error)
 	SystemListAllUnverifiedEmails(ctx context.Context) ([]*UserEmail, error)
 	SystemListAllUserEmails(ctx context.Context) ([]*SystemListAllUserEmailsRow, error)
 	SystemListAllUsers(ctx context.Context) ([]*SystemListAllUsersRow, error)
+	SystemListBlogsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListBlogsSearchMatchesByWordRow, error)
 	SystemListBoardsByParentID(ctx context.Context, arg SystemListBoardsByParentIDParams) ([]*Imageboard, error)
 	SystemListCommentsByThreadID(ctx context.Context, forumthreadID int32) ([]*SystemListCommentsByThreadIDRow, error)
+	SystemListCommentsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListCommentsSearchMatchesByWordRow, error)
 	SystemListDeadLetters(ctx context.Context, limit int32) ([]*DeadLetter, error)
+	SystemListDueActivityPubDeliveries(ctx context.Context, arg SystemListDueActivityPubDeliveriesParams) ([]*ActivitypubDelivery, error)
+	SystemListDueScheduledPublications(ctx context.Context, arg SystemListDueScheduledPublicationsParams) ([]*ScheduledPublication, error)
+	// Open polls whose close time has passed along with the thread location used
+	// to notify subscribers.
+	SystemListExpiredForumPolls(ctx context.Context, arg SystemListExpiredForumPollsParams) ([]*SystemListExpiredForumPollsRow, error)
+	SystemListImagePostSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListImagePostSearchMatchesByWordRow, error)
 	// SystemListLanguages lists all languages.
 	SystemListLanguages(ctx context.Context) ([]*Language, error)
+	SystemListLinkerSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListLinkerSearchMatchesByWordRow, error)
 	SystemListPendingEmails(ctx context.Context, arg SystemListPendingEmailsParams) ([]*SystemListPendingEmailsRow, error)
+	// Deliveries not yet sent, oldest first.
+	SystemListPendingWebhookDeliveries(ctx context.Context, limit int32) ([]*WebhookDelivery, error)
 	SystemListPublicWritingsByAuthor(ctx context.Context, arg SystemListPublicWritingsByAuthorParams) ([]*SystemListPublicWritingsByAuthorRow, error)
 	SystemListPublicWritingsInCategory(ctx context.Context, arg SystemListPublicWritingsInCategoryParams) ([]*SystemListPublicWritingsInCategoryRow, error)
+	SystemListSiteNewsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListSiteNewsSearchMatchesByWordRow, error)
+	// Blog entries anonymous visitors may open, for the sitemap.
+	SystemListSitemapBlogEntries(ctx context.Context) ([]*SystemListSitemapBlogEntriesRow, error)
+	// Answered FAQ questions anonymous visitors may see, for the sitemap.
+	SystemListSitemapFAQQuestions(ctx context.Context) ([]*SystemListSitemapFAQQuestionsRow, error)
+	// Threads in public forum topics anonymous visitors may open, for the sitemap.
+	SystemListSitemapForumThreads(ctx context.Context) ([]*SystemListSitemapForumThreadsRow, error)
+	// Public forum topics anonymous visitors may open, for the sitemap.
+	SystemListSitemapForumTopics(ctx context.Context) ([]*SystemListSitemapForumTopicsRow, error)
+	// Image boards anonymous visitors may open, for the sitemap.
+	SystemListSitemapImageBoards(ctx context.Context) ([]int32, error)
+	// Approved image posts on boards anonymous visitors may open, for the sitemap.
+	SystemListSitemapImagePosts(ctx context.Context) ([]*SystemListSitemapImagePostsRow, error)
+	// Links anonymous visitors may open, for the sitemap.
+	SystemListSitemapLinkerItems(ctx context.Context) ([]*SystemListSitemapLinkerItemsRow, error)
+	// News posts anonymous visitors may open, for the sitemap.
+	SystemListSitemapNewsPosts(ctx context.Context) ([]*SystemListSitemapNewsPostsRow, error)
+	// Public writings anonymous visitors may open, for the sitemap.
+	SystemListSitemapWritings(ctx context.Context) ([]*SystemListSitemapWritingsRow, error)
 	SystemListUnverifiedEmailsCreatedAfter(ctx context.Context, verificationExpiresAt sql.NullTime) ([]*UserEmail, error)
 	SystemListUnverifiedEmailsExpiresBefore(ctx context.Context, verificationExpiresAt sql.NullTime) ([]*UserEmail, error)
 	SystemListUserInfo(ctx context.Context) ([]*SystemListUserInfoRow, error)
 	SystemListVerifiedEmailsByUserID(ctx context.Context, userID int32) ([]*UserEmail, error)
 	SystemListWritingCategories(ctx context.Context, arg SystemListWritingCategoriesParams) ([]*WritingCategory, error)
+	SystemListWritingSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListWritingSearchMatchesByWordRow, error)
 	SystemMarkPasswordResetVerified(ctx context.Context, id int32) error
 	SystemMarkPendingEmailSent(ctx context.Context, id int32) error
 	SystemMarkUserEmailVerified(ctx context.Context, arg SystemMarkUserEmailVerifiedParams) error
+	// Finished deliveries are only kept for a while for troubleshooting.
+	SystemPurgeActivityPubDeliveriesBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	SystemPurgeDeadLettersBefore(ctx context.Context, createdAt time.Time) error
+	// Drafts nobody has touched since the cutoff are assumed abandoned.
+	SystemPurgeDraftsBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	// Remove password reset entries that have expired or were already verified
 	SystemPurgePasswordResetsBefore(ctx context.Context, createdAt time.Time) (sql.Result, error)
 	SystemRebuildForumTopicMetaByID(ctx context.Context, idforumtopic int32) error
 	SystemRegisterExternalLinkClick(ctx context.Context, url string) error
+	SystemSetBlogEntryWritten(ctx context.Context, arg SystemSetBlogEntryWrittenParams) error
 	SystemSetBlogLastIndex(ctx context.Context, id int32) error
 	SystemSetCommentLastIndex(ctx context.Context, idcomments int32) error
 	SystemSetForumTopicHandlerByID(ctx context.Context, arg SystemSetForumTopicHandlerByIDParams) error
 	SystemSetImagePostLastIndex(ctx context.Context, idimagepost int32) error
 	SystemSetLinkerLastIndex(ctx context.Context, id int32) error
+	SystemSetNewsPostOccurred(ctx context.Context, arg SystemSetNewsPostOccurredParams) error
 	SystemSetSiteNewsLastIndex(ctx context.Context, idsitenews int32) error
 	SystemSetWritingLastIndex(ctx context.Context, idwriting int32) error
+	SystemSetWritingPublished(ctx context.Context, arg SystemSetWritingPublishedParams) error
+	// Suppressing an address again refreshes the reason but keeps the original time.
+	SystemSuppressEmail(ctx context.Context, arg SystemSuppressEmailParams) error
+	SystemUpdateActivityPubDelivery(ctx context.Context, arg SystemUpdateActivityPubDeliveryParams) error
 	SystemUpdateDeadLetter(ctx context.Context, arg SystemUpdateDeadLetterParams) error
 	SystemUpdateVerificationCode(ctx context.Context, arg SystemUpdateVerificationCodeParams) error
+	SystemUpdateWebhookDelivery(ctx context.Context, arg SystemUpdateWebhookDeliveryParams) error
+	SystemUpsertActivityPubFollower(ctx context.Context, arg SystemUpsertActivityPubFollowerParams) error
 	TouchImageCacheEntry(ctx context.Context, arg TouchImageCacheEntryParams) error
 	UpdateAPIKeyLastUsed(ctx context.Context, id int32) error
 	UpdateAutoSubscribeRepliesForLister(ctx context.Context, arg UpdateAutoSubscribeRepliesForListerParams) error
@@ -677,18 +822,23 @@ type Querier interface {
 	UpdateLastDigestSentAt(ctx context.Context, arg UpdateLastDigestSentAtParams) error
 	UpdateLastMonthlyDigestSentAt(ctx context.Context, arg UpdateLastMonthlyDigestSentAtParams) error
 	UpdateLastWeeklyDigestSentAt(ctx context.Context, arg UpdateLastWeeklyDigestSentAtParams) error
+	UpdateMuteMentionsForLister(ctx context.Context, arg UpdateMuteMentionsForListerParams) error
 	UpdateNewsPostForWriter(ctx context.Context, arg UpdateNewsPostForWriterParams) error
 	UpdateNotificationDigestPreferences(ctx context.Context, arg UpdateNotificationDigestPreferencesParams) error
 	UpdatePasskeyAfterLogin(ctx context.Context, arg UpdatePasskeyAfterLoginParams) error
 	UpdatePreferenceForLister(ctx context.Context, arg UpdatePreferenceForListerParams) error
 	UpdatePublicProfileEnabledAtForUser(ctx context.Context, arg UpdatePublicProfileEnabledAtForUserParams) error
 	UpdateSubscriptionByIDForSubscriber(ctx context.Context, arg UpdateSubscriptionByIDForSubscriberParams) error
+	UpdateTOTPLastUsedStep(ctx context.Context, arg UpdateTOTPLastUsedStepParams) (int64, error)
 	UpdateTimezoneForLister(ctx context.Context, arg UpdateTimezoneForListerParams) error
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
 	UpdateWritingForWriter(ctx context.Context, arg UpdateWritingForWriterParams) error
 	UpsertContentReadMarker(ctx context.Context, arg UpsertContentReadMarkerParams) error
+	UpsertDraftForUser(ctx context.Context, arg UpsertDraftForUserParams) error
 	UpsertImageCacheEntry(ctx context.Context, arg UpsertImageCacheEntryParams) error
+	UpsertScheduledPublicationForAuthor(ctx context.Context, arg UpsertScheduledPublicationForAuthorParams) error
 	UpsertSchedulerState(ctx context.Context, arg UpsertSchedulerStateParams) error
+	UseRecoveryCodeForUser(ctx context.Context, arg UseRecoveryCodeForUserParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
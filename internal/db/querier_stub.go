@@ -36,6 +36,10 @@ type QuerierStub struct {
 	Querier
 	mu sync.Mutex
 
+	// InTxCalls counts transactions; InTxErr fails them before fn runs.
+	InTxCalls int
+	InTxErr   error
+
 	AdminCountSentEmailsCalls   []AdminCountSentEmailsParams
 	AdminCountSentEmailsReturns int64
 	AdminCountSentEmailsErr     error
@@ -519,6 +523,19 @@ type QuerierStub struct {
 	GetLoginRoleForUserCalls   []int32
 	GetLoginRoleForUserFn      func(context.Context, int32) (int32, error)
 
+	GetTOTPForUserReturns *UserTotp
+	GetTOTPForUserErr     error
+	GetTOTPForUserCalls   []int32
+	GetTOTPForUserFn      func(context.Context, int32) (*UserTotp, error)
+
+	UpdateTOTPLastUsedStepReturns int64
+	UpdateTOTPLastUsedStepErr     error
+	UpdateTOTPLastUsedStepCalls   []UpdateTOTPLastUsedStepParams
+
+	UseRecoveryCodeForUserReturns int64
+	UseRecoveryCodeForUserErr     error
+	UseRecoveryCodeForUserCalls   []UseRecoveryCodeForUserParams
+
 	SystemListPendingEmailsCalls  []SystemListPendingEmailsParams
 	SystemListPendingEmailsReturn []*SystemListPendingEmailsRow
 	SystemListPendingEmailsErr    error
@@ -725,6 +742,10 @@ type QuerierStub struct {
 	CreateUploadedImageForUploaderFn     func(context.Context, CreateUploadedImageForUploaderParams) (int64, error)
 	CreateUploadedImageForUploaderResult int64
 	CreateUploadedImageForUploaderErr    error
+	ListReferencedUploadPathsCalls       int
+	ListReferencedUploadPathsFn          func(context.Context) ([]sql.NullString, error)
+	ListReferencedUploadPathsReturns     []sql.NullString
+	ListReferencedUploadPathsErr         error
 
 	ListThreadImagePathsCalls   []ListThreadImagePathsParams
 	ListThreadImagePathsFn      func(context.Context, ListThreadImagePathsParams) ([]sql.NullString, error)
@@ -1058,6 +1079,43 @@ type QuerierStub struct {
 	ListUnreadPrivateThreadsForUserReturns  []*ListUnreadPrivateThreadsForUserRow
 	ListUnreadPrivateThreadsForUserErr      error
 	ListUnreadPrivateThreadsForUserFn       func(context.Context, ListUnreadPrivateThreadsForUserParams) ([]*ListUnreadPrivateThreadsForUserRow, error)
+
+	GetForumPollByThreadIDCalls   []int32
+	GetForumPollByThreadIDReturns *ForumPoll
+	GetForumPollByThreadIDErr     error
+	GetForumPollByThreadIDFn      func(context.Context, int32) (*ForumPoll, error)
+
+	GetScheduledPublicationCalls   []GetScheduledPublicationParams
+	GetScheduledPublicationReturns *ScheduledPublication
+	GetScheduledPublicationErr     error
+
+	UpsertDraftForUserCalls []UpsertDraftForUserParams
+	DeleteDraftForUserCalls []DeleteDraftForUserParams
+
+	GetEmailSuppressionByEmailCalls     []string
+	GetEmailSuppressionByEmailReturns   *EmailSuppression
+	GetEmailSuppressionByEmailErr       error
+	SystemInsertEmailBounceCalls        []SystemInsertEmailBounceParams
+	SystemInsertEmailBounceErr          error
+	SystemSuppressEmailCalls            []SystemSuppressEmailParams
+	SystemSuppressEmailErr              error
+	AdminListEmailSuppressionsCalls     []AdminListEmailSuppressionsParams
+	AdminListEmailSuppressionsReturns   []*AdminListEmailSuppressionsRow
+	AdminListEmailSuppressionsErr       error
+	AdminListRecentEmailBouncesCalls    []int32
+	AdminListRecentEmailBouncesReturns  []*AdminListRecentEmailBouncesRow
+	AdminListRecentEmailBouncesErr      error
+	AdminGetEmailSuppressionByIDCalls   []int32
+	AdminGetEmailSuppressionByIDReturns *EmailSuppression
+	AdminGetEmailSuppressionByIDErr     error
+	AdminDeleteEmailSuppressionCalls    []int32
+	AdminDeleteEmailSuppressionErr      error
+
+	CreateForumPollForCreatorCalls   []CreateForumPollForCreatorParams
+	CreateForumPollForCreatorReturns int64
+	CreateForumPollForCreatorErr     error
+	CreateForumPollOptionCalls       []CreateForumPollOptionParams
+	CreateForumPollOptionErr         error
 }
 
 func (s *QuerierStub) ensurePublicLabelSetLocked(item string, itemID int32) map[string]struct{} {
@@ -1228,6 +1286,21 @@ func (s *QuerierStub) GetUploadedImageByPath(ctx context.Context, path sql.NullS
 	return s.GetUploadedImageByPathReturns, nil
 }
 
+// ListReferencedUploadPaths records the call and returns the configured paths.
+func (s *QuerierStub) ListReferencedUploadPaths(ctx context.Context) ([]sql.NullString, error) {
+	s.mu.Lock()
+	s.ListReferencedUploadPathsCalls++
+	fn := s.ListReferencedUploadPathsFn
+	s.mu.Unlock()
+	if fn != nil {
+		return fn(ctx)
+	}
+	if s.ListReferencedUploadPathsErr != nil {
+		return nil, s.ListReferencedUploadPathsErr
+	}
+	return s.ListReferencedUploadPathsReturns, nil
+}
+
 // CreateUploadedImageForUploader records the call and returns the configured upload ID.
 func (s *QuerierStub) CreateUploadedImageForUploader(ctx context.Context, arg CreateUploadedImageForUploaderParams) (int64, error) {
 	s.mu.Lock()
@@ -6,6 +6,19 @@ import (
 	"time"
 )
 
+// InTx implements TxQuerier by running fn against the stub itself, so the
+// queries it makes are recorded as usual.
+func (s *QuerierStub) InTx(ctx context.Context, fn func(Querier) error) error {
+	s.mu.Lock()
+	s.InTxCalls++
+	err := s.InTxErr
+	s.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return fn(s)
+}
+
 func (s *QuerierStub) AdminGetRequestByID(ctx context.Context, id int32) (*AdminRequestQueue, error) {
 	s.mu.Lock()
 	s.AdminGetRequestByIDCalls = append(s.AdminGetRequestByIDCalls, id)
@@ -788,3 +801,155 @@ func (s *QuerierStub) DeleteSubscriptionByIDForSubscriber(ctx context.Context, a
 	}
 	return err
 }
+
+func (s *QuerierStub) GetTOTPForUser(ctx context.Context, usersIdusers int32) (*UserTotp, error) {
+	s.mu.Lock()
+	s.GetTOTPForUserCalls = append(s.GetTOTPForUserCalls, usersIdusers)
+	fn := s.GetTOTPForUserFn
+	ret, err := s.GetTOTPForUserReturns, s.GetTOTPForUserErr
+	s.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, usersIdusers)
+	}
+	if ret == nil && err == nil {
+		return nil, sql.ErrNoRows
+	}
+	return ret, err
+}
+
+func (s *QuerierStub) UpdateTOTPLastUsedStep(ctx context.Context, arg UpdateTOTPLastUsedStepParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.UpdateTOTPLastUsedStepCalls = append(s.UpdateTOTPLastUsedStepCalls, arg)
+	return s.UpdateTOTPLastUsedStepReturns, s.UpdateTOTPLastUsedStepErr
+}
+
+func (s *QuerierStub) UseRecoveryCodeForUser(ctx context.Context, arg UseRecoveryCodeForUserParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.UseRecoveryCodeForUserCalls = append(s.UseRecoveryCodeForUserCalls, arg)
+	return s.UseRecoveryCodeForUserReturns, s.UseRecoveryCodeForUserErr
+}
+
+// GetForumPollByThreadID records the call and reports sql.ErrNoRows unless a
+// poll is configured, as most threads have none.
+func (s *QuerierStub) GetForumPollByThreadID(ctx context.Context, threadID int32) (*ForumPoll, error) {
+	s.mu.Lock()
+	s.GetForumPollByThreadIDCalls = append(s.GetForumPollByThreadIDCalls, threadID)
+	fn := s.GetForumPollByThreadIDFn
+	ret := s.GetForumPollByThreadIDReturns
+	err := s.GetForumPollByThreadIDErr
+	s.mu.Unlock()
+	if fn != nil {
+		return fn(ctx, threadID)
+	}
+	if ret == nil && err == nil {
+		return nil, sql.ErrNoRows
+	}
+	return ret, err
+}
+
+// GetScheduledPublication records the call and reports sql.ErrNoRows unless
+// a publication is configured, so items are live by default.
+func (s *QuerierStub) GetScheduledPublication(ctx context.Context, arg GetScheduledPublicationParams) (*ScheduledPublication, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.GetScheduledPublicationCalls = append(s.GetScheduledPublicationCalls, arg)
+	if s.GetScheduledPublicationReturns == nil && s.GetScheduledPublicationErr == nil {
+		return nil, sql.ErrNoRows
+	}
+	return s.GetScheduledPublicationReturns, s.GetScheduledPublicationErr
+}
+
+// UpsertDraftForUser records the call.
+func (s *QuerierStub) UpsertDraftForUser(ctx context.Context, arg UpsertDraftForUserParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.UpsertDraftForUserCalls = append(s.UpsertDraftForUserCalls, arg)
+	return nil
+}
+
+// DeleteDraftForUser records the call.
+func (s *QuerierStub) DeleteDraftForUser(ctx context.Context, arg DeleteDraftForUserParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.DeleteDraftForUserCalls = append(s.DeleteDraftForUserCalls, arg)
+	return nil
+}
+
+// GetEmailSuppressionByEmail records the call and reports sql.ErrNoRows
+// unless a suppression is configured, as most addresses are deliverable.
+func (s *QuerierStub) GetEmailSuppressionByEmail(ctx context.Context, email string) (*EmailSuppression, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.GetEmailSuppressionByEmailCalls = append(s.GetEmailSuppressionByEmailCalls, email)
+	if s.GetEmailSuppressionByEmailReturns == nil && s.GetEmailSuppressionByEmailErr == nil {
+		return nil, sql.ErrNoRows
+	}
+	return s.GetEmailSuppressionByEmailReturns, s.GetEmailSuppressionByEmailErr
+}
+
+// SystemInsertEmailBounce records the call.
+func (s *QuerierStub) SystemInsertEmailBounce(ctx context.Context, arg SystemInsertEmailBounceParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.SystemInsertEmailBounceCalls = append(s.SystemInsertEmailBounceCalls, arg)
+	return s.SystemInsertEmailBounceErr
+}
+
+// SystemSuppressEmail records the call.
+func (s *QuerierStub) SystemSuppressEmail(ctx context.Context, arg SystemSuppressEmailParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.SystemSuppressEmailCalls = append(s.SystemSuppressEmailCalls, arg)
+	return s.SystemSuppressEmailErr
+}
+
+// AdminListEmailSuppressions records the call and returns the configured rows.
+func (s *QuerierStub) AdminListEmailSuppressions(ctx context.Context, arg AdminListEmailSuppressionsParams) ([]*AdminListEmailSuppressionsRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.AdminListEmailSuppressionsCalls = append(s.AdminListEmailSuppressionsCalls, arg)
+	return s.AdminListEmailSuppressionsReturns, s.AdminListEmailSuppressionsErr
+}
+
+// AdminListRecentEmailBounces records the call and returns the configured rows.
+func (s *QuerierStub) AdminListRecentEmailBounces(ctx context.Context, limit int32) ([]*AdminListRecentEmailBouncesRow, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.AdminListRecentEmailBouncesCalls = append(s.AdminListRecentEmailBouncesCalls, limit)
+	return s.AdminListRecentEmailBouncesReturns, s.AdminListRecentEmailBouncesErr
+}
+
+// AdminGetEmailSuppressionByID records the call and returns the configured
+// suppression.
+func (s *QuerierStub) AdminGetEmailSuppressionByID(ctx context.Context, id int32) (*EmailSuppression, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.AdminGetEmailSuppressionByIDCalls = append(s.AdminGetEmailSuppressionByIDCalls, id)
+	return s.AdminGetEmailSuppressionByIDReturns, s.AdminGetEmailSuppressionByIDErr
+}
+
+// AdminDeleteEmailSuppression records the call.
+func (s *QuerierStub) AdminDeleteEmailSuppression(ctx context.Context, id int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.AdminDeleteEmailSuppressionCalls = append(s.AdminDeleteEmailSuppressionCalls, id)
+	return s.AdminDeleteEmailSuppressionErr
+}
+
+// CreateForumPollForCreator records the call and returns the configured ID.
+func (s *QuerierStub) CreateForumPollForCreator(ctx context.Context, arg CreateForumPollForCreatorParams) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.CreateForumPollForCreatorCalls = append(s.CreateForumPollForCreatorCalls, arg)
+	return s.CreateForumPollForCreatorReturns, s.CreateForumPollForCreatorErr
+}
+
+// CreateForumPollOption records the call.
+func (s *QuerierStub) CreateForumPollOption(ctx context.Context, arg CreateForumPollOptionParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.CreateForumPollOptionCalls = append(s.CreateForumPollOptionCalls, arg)
+	return s.CreateForumPollOptionErr
+}
@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// TxQuerier is implemented by queriers that can run a group of queries in a
+// single transaction.
+type TxQuerier interface {
+	// InTx runs fn with a querier bound to a new transaction. The
+	// transaction is committed when fn returns nil and rolled back
+	// otherwise.
+	InTx(ctx context.Context, fn func(Querier) error) error
+}
+
+// InTx runs fn in a transaction on q. It fails when q cannot start
+// transactions rather than running the queries unprotected.
+func InTx(ctx context.Context, q Querier, fn func(Querier) error) error {
+	t, ok := q.(TxQuerier)
+	if !ok {
+		return fmt.Errorf("querier %T does not support transactions", q)
+	}
+	return t.InTx(ctx, fn)
+}
+
+// runInTx begins a transaction on conn, wraps it with newQuerier and runs fn.
+func runInTx(ctx context.Context, conn DBTX, newQuerier func(DBTX) Querier, fn func(Querier) error) error {
+	txer, ok := conn.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (*sql.Tx, error)
+	})
+	if !ok {
+		return fmt.Errorf("database does not support transactions")
+	}
+	tx, err := txer.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := fn(newQuerier(tx)); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+	return nil
+}
+
+// InTx implements TxQuerier.
+func (q *Queries) InTx(ctx context.Context, fn func(Querier) error) error {
+	return runInTx(ctx, q.db, func(tx DBTX) Querier { return New(tx) }, fn)
+}
+
+// InTx implements TxQuerier.
+func (s *postgresQuerier) InTx(ctx context.Context, fn func(Querier) error) error {
+	return runInTx(ctx, s.db, NewPostgresQuerier, fn)
+}
@@ -0,0 +1,10 @@
+//go:build sqlite || sqlite3
+
+package db
+
+import "context"
+
+// InTx implements TxQuerier.
+func (s *sqliteQuerier) InTx(ctx context.Context, fn func(Querier) error) error {
+	return runInTx(ctx, s.db, NewSQLiteQuerier, fn)
+}
@@ -0,0 +1,140 @@
+-- name: SystemGetActivityPubActor :one
+-- Active users are published as ActivityPub actors.
+SELECT u.idusers, u.username
+FROM users u
+WHERE u.username = sqlc.arg(username)
+  AND u.deleted_at IS NULL;
+
+-- name: SystemGetActivityPubActorKey :one
+SELECT user_id, public_key_pem, private_key_pem, created_at
+FROM activitypub_actor_keys
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: SystemInsertActivityPubActorKey :exec
+-- A key is only stored once; a concurrent insert keeps the first key.
+INSERT IGNORE INTO activitypub_actor_keys (user_id, public_key_pem, private_key_pem)
+VALUES (sqlc.arg(user_id), sqlc.arg(public_key_pem), sqlc.arg(private_key_pem));
+
+-- name: SystemUpsertActivityPubFollower :exec
+INSERT INTO activitypub_followers (user_id, actor_url, inbox_url, shared_inbox_url)
+VALUES (sqlc.arg(user_id), sqlc.arg(actor_url), sqlc.arg(inbox_url), sqlc.narg(shared_inbox_url))
+ON DUPLICATE KEY UPDATE inbox_url = VALUES(inbox_url), shared_inbox_url = VALUES(shared_inbox_url);
+
+-- name: SystemDeleteActivityPubFollower :execrows
+DELETE FROM activitypub_followers
+WHERE user_id = sqlc.arg(user_id)
+  AND actor_url = sqlc.arg(actor_url);
+
+-- name: SystemListActivityPubFollowers :many
+SELECT id, user_id, actor_url, inbox_url, shared_inbox_url, created_at
+FROM activitypub_followers
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY id;
+
+-- name: SystemCountActivityPubFollowers :one
+SELECT COUNT(*)
+FROM activitypub_followers
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: SystemInsertActivityPubActivity :execlastid
+INSERT INTO activitypub_activities (user_id, activity_type, object_url, object_json, published)
+VALUES (sqlc.arg(user_id), sqlc.arg(activity_type), sqlc.arg(object_url), sqlc.arg(object_json), sqlc.arg(published));
+
+-- name: SystemGetLatestActivityPubActivityForObject :one
+-- The last activity sent about an object tells whether followers still see it.
+SELECT id, user_id, activity_type, object_url, object_json, published
+FROM activitypub_activities
+WHERE object_url = sqlc.arg(object_url)
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: SystemListActivityPubActivitiesForUser :many
+SELECT id, user_id, activity_type, object_url, object_json, published
+FROM activitypub_activities
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: SystemCountActivityPubActivitiesForUser :one
+SELECT COUNT(*)
+FROM activitypub_activities
+WHERE user_id = sqlc.arg(user_id);
+
+-- name: SystemInsertActivityPubDelivery :exec
+INSERT INTO activitypub_deliveries (user_id, inbox_url, payload, next_attempt_at)
+VALUES (sqlc.arg(user_id), sqlc.arg(inbox_url), sqlc.arg(payload), sqlc.arg(next_attempt_at));
+
+-- name: SystemListDueActivityPubDeliveries :many
+SELECT id, user_id, inbox_url, payload, status, attempts, next_attempt_at, error, created_at, updated_at
+FROM activitypub_deliveries
+WHERE status = 'pending'
+  AND next_attempt_at <= sqlc.arg(now)
+ORDER BY next_attempt_at, id
+LIMIT sqlc.arg(limit);
+
+-- name: SystemClaimActivityPubDelivery :execrows
+-- Pushing next_attempt_at past the lease stops another run sending the
+-- delivery; if the sender dies it is picked up again once the lease ends.
+UPDATE activitypub_deliveries
+SET next_attempt_at = sqlc.arg(lease_until)
+WHERE id = sqlc.arg(id)
+  AND status = 'pending'
+  AND next_attempt_at <= sqlc.arg(now);
+
+-- name: SystemUpdateActivityPubDelivery :exec
+UPDATE activitypub_deliveries
+SET status = sqlc.arg(status), attempts = sqlc.arg(attempts), next_attempt_at = sqlc.arg(next_attempt_at), error = sqlc.narg(error), updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id);
+
+-- name: SystemPurgeActivityPubDeliveriesBefore :execrows
+-- Finished deliveries are only kept for a while for troubleshooting.
+DELETE FROM activitypub_deliveries
+WHERE status <> 'pending'
+  AND updated_at < sqlc.arg(cutoff);
+
+-- name: SystemGetActivityPubBlogEntry :one
+-- A blog entry is federated while anonymous visitors may open it.
+SELECT b.idblogs, b.users_idusers, u.username, b.blog, b.written
+FROM blogs b
+JOIN users u ON u.idusers = b.users_idusers
+WHERE b.idblogs = sqlc.arg(id)
+  AND b.deleted_at IS NULL
+  AND u.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM scheduled_publications sp
+    WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'blogs'
+      AND (g.item = 'entry' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = b.idblogs OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  );
+
+-- name: SystemGetActivityPubWriting :one
+-- A writing is federated while it is public and anonymous visitors may open it.
+SELECT w.idwriting, w.users_idusers, u.username, w.title, w.abstract, w.writing, w.published
+FROM writing w
+JOIN users u ON u.idusers = w.users_idusers
+WHERE w.idwriting = sqlc.arg(id)
+  AND w.private = 0
+  AND w.deleted_at IS NULL
+  AND u.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM scheduled_publications sp
+    WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'writing'
+      AND (g.item = 'article' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = w.idwriting OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  );
@@ -78,6 +78,14 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    sqlc.arg(show_scheduled) = true
+    OR b.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT ? OFFSET ?;
 
@@ -119,6 +127,14 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    sqlc.arg(show_scheduled) = true
+    OR b.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT ? OFFSET ?;
 
@@ -358,5 +374,7 @@ ORDER BY b.written DESC;
 UPDATE blogs SET last_index = NOW() WHERE idblogs = sqlc.arg(id);
 
 -- name: SystemGetAllBlogsForIndex :many
-SELECT idblogs, blog FROM blogs WHERE deleted_at IS NULL;
+SELECT idblogs, blog FROM blogs
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'blog' AND sp.item_id = blogs.idblogs);
 
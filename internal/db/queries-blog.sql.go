@@ -247,17 +247,27 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    ? = true
+    OR b.users_idusers = ?
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT ? OFFSET ?
 `
 
 type ListBlogEntriesByAuthorForListerParams struct {
-	ListerID int32
-	AuthorID int32
-	IsAdmin  interface{}
-	UserID   sql.NullInt32
-	Limit    int32
-	Offset   int32
+	ListerID          int32
+	AuthorID          int32
+	IsAdmin           interface{}
+	UserID            sql.NullInt32
+	ShowScheduled     interface{}
+	ScheduledViewerID int32
+	Limit             int32
+	Offset            int32
 }
 
 type ListBlogEntriesByAuthorForListerRow struct {
@@ -284,6 +294,8 @@ func (q *Queries) ListBlogEntriesByAuthorForLister(ctx context.Context, arg List
 		arg.ListerID,
 		arg.IsAdmin,
 		arg.UserID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -456,16 +468,26 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    ? = true
+    OR b.users_idusers = ?
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT ? OFFSET ?
 `
 
 type ListBlogEntriesForListerParams struct {
-	ListerID int32
-	IsAdmin  interface{}
-	UserID   sql.NullInt32
-	Limit    int32
-	Offset   int32
+	ListerID          int32
+	IsAdmin           interface{}
+	UserID            sql.NullInt32
+	ShowScheduled     interface{}
+	ScheduledViewerID int32
+	Limit             int32
+	Offset            int32
 }
 
 type ListBlogEntriesForListerRow struct {
@@ -489,6 +511,8 @@ func (q *Queries) ListBlogEntriesForLister(ctx context.Context, arg ListBlogEntr
 		arg.ListerID,
 		arg.IsAdmin,
 		arg.UserID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -852,7 +876,9 @@ func (q *Queries) SystemAssignBlogEntryThreadID(ctx context.Context, arg SystemA
 }
 
 const systemGetAllBlogsForIndex = `-- name: SystemGetAllBlogsForIndex :many
-SELECT idblogs, blog FROM blogs WHERE deleted_at IS NULL
+SELECT idblogs, blog FROM blogs
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'blog' AND sp.item_id = blogs.idblogs)
 `
 
 type SystemGetAllBlogsForIndexRow struct {
@@ -137,6 +137,14 @@ WHERE (
       AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
 )
+  AND (
+    sqlc.arg(show_scheduled) = true
+    OR s.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'news' AND sp.item_id = s.idsiteNews
+    )
+  )
 ORDER BY s.occurred DESC
 LIMIT ? OFFSET ?;
 
@@ -156,7 +164,9 @@ UPDATE site_news SET last_index = NOW() WHERE idsiteNews = ?;
 
 
 -- name: GetAllSiteNewsForIndex :many
-SELECT idsiteNews, news FROM site_news WHERE deleted_at IS NULL;
+SELECT idsiteNews, news FROM site_news
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'news' AND sp.item_id = site_news.idsiteNews);
 
 -- name: AdminReplaceSiteNewsURL :exec
 UPDATE site_news SET news = REPLACE(news, sqlc.arg(old_url), sqlc.arg(new_url)) WHERE idsiteNews = sqlc.arg(id);
@@ -140,7 +140,9 @@ func (q *Queries) DeactivateNewsPost(ctx context.Context, idsitenews int32) erro
 }
 
 const getAllSiteNewsForIndex = `-- name: GetAllSiteNewsForIndex :many
-SELECT idsiteNews, news FROM site_news WHERE deleted_at IS NULL
+SELECT idsiteNews, news FROM site_news
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'news' AND sp.item_id = site_news.idsiteNews)
 `
 
 type GetAllSiteNewsForIndexRow struct {
@@ -383,15 +385,25 @@ WHERE (
       AND (g.user_id = ? OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
 )
+  AND (
+    ? = true
+    OR s.users_idusers = ?
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'news' AND sp.item_id = s.idsiteNews
+    )
+  )
 ORDER BY s.occurred DESC
 LIMIT ? OFFSET ?
 `
 
 type GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams struct {
-	ViewerID int32
-	UserID   sql.NullInt32
-	Limit    int32
-	Offset   int32
+	ViewerID          int32
+	UserID            sql.NullInt32
+	ShowScheduled     interface{}
+	ScheduledViewerID int32
+	Limit             int32
+	Offset            int32
 }
 
 type GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow struct {
@@ -413,6 +425,8 @@ func (q *Queries) GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending(
 		arg.ViewerID,
 		arg.ViewerID,
 		arg.UserID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 		arg.Limit,
 		arg.Offset,
 	)
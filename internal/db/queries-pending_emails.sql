@@ -148,3 +148,9 @@ WHERE pe.sent_at IS NULL AND pe.error_count > 0
   AND (sqlc.narg(language_id) IS NULL OR p.language_id = sqlc.narg(language_id))
   AND (sqlc.narg(role_name) IS NULL OR r.name = sqlc.narg(role_name))
 ORDER BY pe.id;
+
+-- name: SystemCountPendingEmailQueue :one
+SELECT COUNT(*) AS queued,
+       COUNT(CASE WHEN error_count > 0 THEN 1 END) AS failing
+FROM pending_emails
+WHERE sent_at IS NULL;
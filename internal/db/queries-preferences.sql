@@ -52,7 +52,8 @@ WHERE p.daily_digest_hour = sqlc.arg(hour)
 SELECT idpreferences, language_id, users_idusers, emailforumupdates, page_size, auto_subscribe_replies, timezone, custom_css,
        daily_digest_hour, daily_digest_mark_read, last_digest_sent_at,
        weekly_digest_day, weekly_digest_hour, last_weekly_digest_sent_at,
-       monthly_digest_day, monthly_digest_hour, last_monthly_digest_sent_at, image_safe_dimension
+       monthly_digest_day, monthly_digest_hour, last_monthly_digest_sent_at, image_safe_dimension,
+       mute_mentions
 FROM preferences
 WHERE users_idusers = sqlc.arg(lister_id);
 
@@ -160,3 +161,8 @@ WHERE p.monthly_digest_day = sqlc.arg(day)
 UPDATE preferences
 SET image_safe_dimension = sqlc.arg(image_safe_dimension)
 WHERE users_idusers = sqlc.arg(lister_id);
+
+-- name: UpdateMuteMentionsForLister :exec
+UPDATE preferences
+SET mute_mentions = sqlc.arg(mute_mentions)
+WHERE users_idusers = sqlc.arg(lister_id);
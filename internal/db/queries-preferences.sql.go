@@ -45,7 +45,8 @@ const getPreferenceForLister = `-- name: GetPreferenceForLister :one
 SELECT idpreferences, language_id, users_idusers, emailforumupdates, page_size, auto_subscribe_replies, timezone, custom_css,
        daily_digest_hour, daily_digest_mark_read, last_digest_sent_at,
        weekly_digest_day, weekly_digest_hour, last_weekly_digest_sent_at,
-       monthly_digest_day, monthly_digest_hour, last_monthly_digest_sent_at, image_safe_dimension
+       monthly_digest_day, monthly_digest_hour, last_monthly_digest_sent_at, image_safe_dimension,
+       mute_mentions
 FROM preferences
 WHERE users_idusers = ?
 `
@@ -72,6 +73,7 @@ func (q *Queries) GetPreferenceForLister(ctx context.Context, listerID int32) (*
 		&i.MonthlyDigestHour,
 		&i.LastMonthlyDigestSentAt,
 		&i.ImageSafeDimension,
+		&i.MuteMentions,
 	)
 	return &i, err
 }
@@ -575,6 +577,22 @@ func (q *Queries) UpdateLastWeeklyDigestSentAt(ctx context.Context, arg UpdateLa
 	return err
 }
 
+const updateMuteMentionsForLister = `-- name: UpdateMuteMentionsForLister :exec
+UPDATE preferences
+SET mute_mentions = ?
+WHERE users_idusers = ?
+`
+
+type UpdateMuteMentionsForListerParams struct {
+	MuteMentions bool
+	ListerID     int32
+}
+
+func (q *Queries) UpdateMuteMentionsForLister(ctx context.Context, arg UpdateMuteMentionsForListerParams) error {
+	_, err := q.db.ExecContext(ctx, updateMuteMentionsForLister, arg.MuteMentions, arg.ListerID)
+	return err
+}
+
 const updateNotificationDigestPreferences = `-- name: UpdateNotificationDigestPreferences :exec
 UPDATE preferences
 SET daily_digest_hour = ?,
@@ -534,3 +534,59 @@ ON DUPLICATE KEY UPDATE word_count=VALUES(word_count);
 
 -- name: SystemDeleteImagePostSearch :exec
 DELETE FROM imagepost_search;
+
+-- name: SystemDeleteCommentsSearchByCommentID :exec
+DELETE FROM comments_search
+WHERE comment_id = sqlc.arg(comment_id);
+
+-- name: SystemDeleteSiteNewsSearchBySiteNewsID :exec
+DELETE FROM site_news_search
+WHERE site_news_id = sqlc.arg(site_news_id);
+
+-- name: SystemDeleteBlogsSearchByBlogID :exec
+DELETE FROM blogs_search
+WHERE blog_id = sqlc.arg(blog_id);
+
+-- name: SystemDeleteLinkerSearchByLinkerID :exec
+DELETE FROM linker_search
+WHERE linker_id = sqlc.arg(linker_id);
+
+-- name: SystemDeleteImagePostSearchByImagePostID :exec
+DELETE FROM imagepost_search
+WHERE image_post_id = sqlc.arg(image_post_id);
+
+-- name: SystemListCommentsSearchMatchesByWord :many
+SELECT s.comment_id AS id, s.word_count
+FROM comments_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListSiteNewsSearchMatchesByWord :many
+SELECT s.site_news_id AS id, s.word_count
+FROM site_news_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListBlogsSearchMatchesByWord :many
+SELECT s.blog_id AS id, s.word_count
+FROM blogs_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListWritingSearchMatchesByWord :many
+SELECT s.writing_id AS id, s.word_count
+FROM writing_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListLinkerSearchMatchesByWord :many
+SELECT s.linker_id AS id, s.word_count
+FROM linker_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListImagePostSearchMatchesByWord :many
+SELECT s.image_post_id AS id, s.word_count
+FROM imagepost_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
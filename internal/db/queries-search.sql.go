@@ -1138,6 +1138,16 @@ func (q *Queries) SystemDeleteBlogsSearch(ctx context.Context) error {
 	return err
 }
 
+const systemDeleteBlogsSearchByBlogID = `-- name: SystemDeleteBlogsSearchByBlogID :exec
+DELETE FROM blogs_search
+WHERE blog_id = ?
+`
+
+func (q *Queries) SystemDeleteBlogsSearchByBlogID(ctx context.Context, blogID int32) error {
+	_, err := q.db.ExecContext(ctx, systemDeleteBlogsSearchByBlogID, blogID)
+	return err
+}
+
 const systemDeleteCommentsSearch = `-- name: SystemDeleteCommentsSearch :exec
 DELETE FROM comments_search
 `
@@ -1148,6 +1158,16 @@ func (q *Queries) SystemDeleteCommentsSearch(ctx context.Context) error {
 	return err
 }
 
+const systemDeleteCommentsSearchByCommentID = `-- name: SystemDeleteCommentsSearchByCommentID :exec
+DELETE FROM comments_search
+WHERE comment_id = ?
+`
+
+func (q *Queries) SystemDeleteCommentsSearchByCommentID(ctx context.Context, commentID int32) error {
+	_, err := q.db.ExecContext(ctx, systemDeleteCommentsSearchByCommentID, commentID)
+	return err
+}
+
 const systemDeleteImagePostSearch = `-- name: SystemDeleteImagePostSearch :exec
 DELETE FROM imagepost_search
 `
@@ -1157,6 +1177,16 @@ func (q *Queries) SystemDeleteImagePostSearch(ctx context.Context) error {
 	return err
 }
 
+const systemDeleteImagePostSearchByImagePostID = `-- name: SystemDeleteImagePostSearchByImagePostID :exec
+DELETE FROM imagepost_search
+WHERE image_post_id = ?
+`
+
+func (q *Queries) SystemDeleteImagePostSearchByImagePostID(ctx context.Context, imagePostID int32) error {
+	_, err := q.db.ExecContext(ctx, systemDeleteImagePostSearchByImagePostID, imagePostID)
+	return err
+}
+
 const systemDeleteLinkerSearch = `-- name: SystemDeleteLinkerSearch :exec
 DELETE FROM linker_search
 `
@@ -1167,6 +1197,16 @@ func (q *Queries) SystemDeleteLinkerSearch(ctx context.Context) error {
 	return err
 }
 
+const systemDeleteLinkerSearchByLinkerID = `-- name: SystemDeleteLinkerSearchByLinkerID :exec
+DELETE FROM linker_search
+WHERE linker_id = ?
+`
+
+func (q *Queries) SystemDeleteLinkerSearchByLinkerID(ctx context.Context, linkerID int32) error {
+	_, err := q.db.ExecContext(ctx, systemDeleteLinkerSearchByLinkerID, linkerID)
+	return err
+}
+
 const systemDeleteSiteNewsSearch = `-- name: SystemDeleteSiteNewsSearch :exec
 DELETE FROM site_news_search
 `
@@ -1177,6 +1217,16 @@ func (q *Queries) SystemDeleteSiteNewsSearch(ctx context.Context) error {
 	return err
 }
 
+const systemDeleteSiteNewsSearchBySiteNewsID = `-- name: SystemDeleteSiteNewsSearchBySiteNewsID :exec
+DELETE FROM site_news_search
+WHERE site_news_id = ?
+`
+
+func (q *Queries) SystemDeleteSiteNewsSearchBySiteNewsID(ctx context.Context, siteNewsID int32) error {
+	_, err := q.db.ExecContext(ctx, systemDeleteSiteNewsSearchBySiteNewsID, siteNewsID)
+	return err
+}
+
 const systemDeleteWritingSearch = `-- name: SystemDeleteWritingSearch :exec
 DELETE FROM writing_search
 `
@@ -1209,3 +1259,213 @@ func (q *Queries) SystemGetSearchWordByWordLowercased(ctx context.Context, lcase
 	err := row.Scan(&i.Idsearchwordlist, &i.Word)
 	return &i, err
 }
+
+const systemListBlogsSearchMatchesByWord = `-- name: SystemListBlogsSearchMatchesByWord :many
+SELECT s.blog_id AS id, s.word_count
+FROM blogs_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = ?
+`
+
+type SystemListBlogsSearchMatchesByWordRow struct {
+	ID        int32
+	WordCount int32
+}
+
+func (q *Queries) SystemListBlogsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListBlogsSearchMatchesByWordRow, error) {
+	rows, err := q.db.QueryContext(ctx, systemListBlogsSearchMatchesByWord, word)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SystemListBlogsSearchMatchesByWordRow
+	for rows.Next() {
+		var i SystemListBlogsSearchMatchesByWordRow
+		if err := rows.Scan(&i.ID, &i.WordCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const systemListCommentsSearchMatchesByWord = `-- name: SystemListCommentsSearchMatchesByWord :many
+SELECT s.comment_id AS id, s.word_count
+FROM comments_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = ?
+`
+
+type SystemListCommentsSearchMatchesByWordRow struct {
+	ID        int32
+	WordCount int32
+}
+
+func (q *Queries) SystemListCommentsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListCommentsSearchMatchesByWordRow, error) {
+	rows, err := q.db.QueryContext(ctx, systemListCommentsSearchMatchesByWord, word)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SystemListCommentsSearchMatchesByWordRow
+	for rows.Next() {
+		var i SystemListCommentsSearchMatchesByWordRow
+		if err := rows.Scan(&i.ID, &i.WordCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const systemListImagePostSearchMatchesByWord = `-- name: SystemListImagePostSearchMatchesByWord :many
+SELECT s.image_post_id AS id, s.word_count
+FROM imagepost_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = ?
+`
+
+type SystemListImagePostSearchMatchesByWordRow struct {
+	ID        int32
+	WordCount int32
+}
+
+func (q *Queries) SystemListImagePostSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListImagePostSearchMatchesByWordRow, error) {
+	rows, err := q.db.QueryContext(ctx, systemListImagePostSearchMatchesByWord, word)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SystemListImagePostSearchMatchesByWordRow
+	for rows.Next() {
+		var i SystemListImagePostSearchMatchesByWordRow
+		if err := rows.Scan(&i.ID, &i.WordCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const systemListLinkerSearchMatchesByWord = `-- name: SystemListLinkerSearchMatchesByWord :many
+SELECT s.linker_id AS id, s.word_count
+FROM linker_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = ?
+`
+
+type SystemListLinkerSearchMatchesByWordRow struct {
+	ID        int32
+	WordCount int32
+}
+
+func (q *Queries) SystemListLinkerSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListLinkerSearchMatchesByWordRow, error) {
+	rows, err := q.db.QueryContext(ctx, systemListLinkerSearchMatchesByWord, word)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SystemListLinkerSearchMatchesByWordRow
+	for rows.Next() {
+		var i SystemListLinkerSearchMatchesByWordRow
+		if err := rows.Scan(&i.ID, &i.WordCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const systemListSiteNewsSearchMatchesByWord = `-- name: SystemListSiteNewsSearchMatchesByWord :many
+SELECT s.site_news_id AS id, s.word_count
+FROM site_news_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = ?
+`
+
+type SystemListSiteNewsSearchMatchesByWordRow struct {
+	ID        int32
+	WordCount int32
+}
+
+func (q *Queries) SystemListSiteNewsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListSiteNewsSearchMatchesByWordRow, error) {
+	rows, err := q.db.QueryContext(ctx, systemListSiteNewsSearchMatchesByWord, word)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SystemListSiteNewsSearchMatchesByWordRow
+	for rows.Next() {
+		var i SystemListSiteNewsSearchMatchesByWordRow
+		if err := rows.Scan(&i.ID, &i.WordCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const systemListWritingSearchMatchesByWord = `-- name: SystemListWritingSearchMatchesByWord :many
+SELECT s.writing_id AS id, s.word_count
+FROM writing_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = ?
+`
+
+type SystemListWritingSearchMatchesByWordRow struct {
+	ID        int32
+	WordCount int32
+}
+
+func (q *Queries) SystemListWritingSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListWritingSearchMatchesByWordRow, error) {
+	rows, err := q.db.QueryContext(ctx, systemListWritingSearchMatchesByWord, word)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SystemListWritingSearchMatchesByWordRow
+	for rows.Next() {
+		var i SystemListWritingSearchMatchesByWordRow
+		if err := rows.Scan(&i.ID, &i.WordCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
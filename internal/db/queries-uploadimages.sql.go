@@ -154,6 +154,38 @@ func (q *Queries) GetUploadedImageByPath(ctx context.Context, path sql.NullStrin
 	return &i, err
 }
 
+const listReferencedUploadPaths = `-- name: ListReferencedUploadPaths :many
+SELECT path FROM uploaded_images WHERE path IS NOT NULL
+UNION
+SELECT fullimage FROM imagepost WHERE fullimage IS NOT NULL
+UNION
+SELECT thumbnail FROM imagepost WHERE thumbnail IS NOT NULL
+`
+
+// Paths of stored uploads still referenced by uploaded images or image posts.
+func (q *Queries) ListReferencedUploadPaths(ctx context.Context) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, listReferencedUploadPaths)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullString
+	for rows.Next() {
+		var path sql.NullString
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		items = append(items, path)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listUploadedImagePathsByUser = `-- name: ListUploadedImagePathsByUser :many
 SELECT path
 FROM uploaded_images
@@ -59,9 +59,11 @@ UPDATE user_emails SET notification_priority = sqlc.arg(notification_priority)
 WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(lister_id);
 
 -- name: GetNotificationEmailByUserID :one
+-- Suppressed addresses are skipped so mail fails over to the next address.
 SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
 FROM user_emails
 WHERE user_id = ? AND verified_at IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM email_suppressions es WHERE es.email = user_emails.email)
 ORDER BY notification_priority DESC, id
 LIMIT 1;
 
@@ -0,0 +1,50 @@
+-- name: AdminListWebhooks :many
+SELECT * FROM webhooks ORDER BY id;
+
+-- name: AdminGetWebhook :one
+SELECT * FROM webhooks WHERE id = ?;
+
+-- name: AdminCreateWebhook :execlastid
+INSERT INTO webhooks (name, url, secret, patterns, active)
+VALUES (sqlc.arg(name), sqlc.arg(url), sqlc.arg(secret), sqlc.arg(patterns), sqlc.arg(active));
+
+-- name: AdminUpdateWebhook :exec
+UPDATE webhooks
+SET name = sqlc.arg(name), url = sqlc.arg(url), secret = sqlc.arg(secret), patterns = sqlc.arg(patterns), active = sqlc.arg(active)
+WHERE id = sqlc.arg(id);
+
+-- name: AdminDeleteWebhook :exec
+DELETE FROM webhooks WHERE id = ?;
+
+-- name: SystemListActiveWebhooks :many
+SELECT * FROM webhooks WHERE active = 1 ORDER BY id;
+
+-- name: SystemGetWebhook :one
+SELECT * FROM webhooks WHERE id = ?;
+
+-- name: SystemInsertWebhookDelivery :execlastid
+INSERT INTO webhook_deliveries (webhook_id, event, path, payload)
+VALUES (sqlc.arg(webhook_id), sqlc.arg(event), sqlc.arg(path), sqlc.arg(payload));
+
+-- name: SystemGetWebhookDelivery :one
+SELECT * FROM webhook_deliveries WHERE id = ?;
+
+-- name: SystemListPendingWebhookDeliveries :many
+-- Deliveries not yet sent, oldest first.
+SELECT * FROM webhook_deliveries WHERE status = 'pending' ORDER BY id LIMIT ?;
+
+-- name: SystemUpdateWebhookDelivery :exec
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status), attempts = sqlc.arg(attempts), response_status = sqlc.narg(response_status), error = sqlc.narg(error), updated_at = CURRENT_TIMESTAMP
+WHERE id = sqlc.arg(id);
+
+-- name: AdminListWebhookDeliveries :many
+SELECT d.id, d.webhook_id, d.event, d.path, d.status, d.attempts, d.response_status, d.error, d.created_at, d.updated_at, w.name AS webhook_name
+FROM webhook_deliveries d
+LEFT JOIN webhooks w ON w.id = d.webhook_id
+WHERE (sqlc.arg(webhook_id) = 0 OR d.webhook_id = sqlc.arg(webhook_id))
+ORDER BY d.id DESC
+LIMIT ? OFFSET ?;
+
+-- name: AdminDeleteWebhookDeliveriesByWebhook :exec
+DELETE FROM webhook_deliveries WHERE webhook_id = ?;
@@ -2,6 +2,14 @@
 SELECT w.*
 FROM writing w
 WHERE w.private = 0
+AND (
+    sqlc.arg(show_scheduled) = true
+    OR w.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+)
 ORDER BY w.published DESC
 LIMIT ? OFFSET ?
 ;
@@ -53,6 +61,14 @@ WHERE w.private = 0 AND w.users_idusers = sqlc.arg(author_id)
       AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
   )
+  AND (
+    sqlc.arg(show_scheduled) = true
+    OR w.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
 ORDER BY w.published DESC
 LIMIT ? OFFSET ?;
 
@@ -98,6 +114,14 @@ WHERE w.private = 0 AND w.writing_category_id = sqlc.arg(writing_category_id)
       AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
   )
+  AND (
+    sqlc.arg(show_scheduled) = true
+    OR w.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
 ORDER BY w.published DESC
 LIMIT ? OFFSET ?
 ;
@@ -347,7 +371,9 @@ UPDATE writing SET last_index = NOW() WHERE idwriting = ?;
 
 
 -- name: GetAllWritingsForIndex :many
-SELECT idwriting, title, abstract, writing FROM writing WHERE deleted_at IS NULL;
+SELECT idwriting, title, abstract, writing FROM writing
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'writing' AND sp.item_id = writing.idwriting);
 
 -- name: GetWritingCategoryById :one
 SELECT * FROM writing_category WHERE idwritingCategory = ?;
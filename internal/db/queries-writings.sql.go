@@ -319,7 +319,9 @@ func (q *Queries) GetAllWritingsByAuthorForLister(ctx context.Context, arg GetAl
 }
 
 const getAllWritingsForIndex = `-- name: GetAllWritingsForIndex :many
-SELECT idwriting, title, abstract, writing FROM writing WHERE deleted_at IS NULL
+SELECT idwriting, title, abstract, writing FROM writing
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'writing' AND sp.item_id = writing.idwriting)
 `
 
 type GetAllWritingsForIndexRow struct {
@@ -361,17 +363,32 @@ const getPublicWritings = `-- name: GetPublicWritings :many
 SELECT w.idwriting, w.users_idusers, w.forumthread_id, w.language_id, w.writing_category_id, w.title, w.published, w.timezone, w.writing, w.abstract, w.private, w.deleted_at, w.last_index
 FROM writing w
 WHERE w.private = 0
+AND (
+    ? = true
+    OR w.users_idusers = ?
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+)
 ORDER BY w.published DESC
 LIMIT ? OFFSET ?
 `
 
 type GetPublicWritingsParams struct {
-	Limit  int32
-	Offset int32
+	ShowScheduled     interface{}
+	ScheduledViewerID int32
+	Limit             int32
+	Offset            int32
 }
 
 func (q *Queries) GetPublicWritings(ctx context.Context, arg GetPublicWritingsParams) ([]*Writing, error) {
-	rows, err := q.db.QueryContext(ctx, getPublicWritings, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, getPublicWritings,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
+		arg.Limit,
+		arg.Offset,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -559,16 +576,26 @@ WHERE w.private = 0 AND w.users_idusers = ?
       AND (g.user_id = ? OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
   )
+  AND (
+    ? = true
+    OR w.users_idusers = ?
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
 ORDER BY w.published DESC
 LIMIT ? OFFSET ?
 `
 
 type ListPublicWritingsByUserForListerParams struct {
-	ListerID int32
-	AuthorID int32
-	UserID   sql.NullInt32
-	Limit    int32
-	Offset   int32
+	ListerID          int32
+	AuthorID          int32
+	UserID            sql.NullInt32
+	ShowScheduled     interface{}
+	ScheduledViewerID int32
+	Limit             int32
+	Offset            int32
 }
 
 type ListPublicWritingsByUserForListerRow struct {
@@ -596,6 +623,8 @@ func (q *Queries) ListPublicWritingsByUserForLister(ctx context.Context, arg Lis
 		arg.ListerID,
 		arg.ListerID,
 		arg.UserID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -669,6 +698,14 @@ WHERE w.private = 0 AND w.writing_category_id = ?
       AND (g.user_id = ? OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
   )
+  AND (
+    ? = true
+    OR w.users_idusers = ?
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
 ORDER BY w.published DESC
 LIMIT ? OFFSET ?
 `
@@ -677,6 +714,8 @@ type ListPublicWritingsInCategoryForListerParams struct {
 	ListerID          int32
 	WritingCategoryID int32
 	UserID            sql.NullInt32
+	ShowScheduled     interface{}
+	ScheduledViewerID int32
 	Limit             int32
 	Offset            int32
 }
@@ -706,6 +745,8 @@ func (q *Queries) ListPublicWritingsInCategoryForLister(ctx context.Context, arg
 		arg.ListerID,
 		arg.ListerID,
 		arg.UserID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 		arg.Limit,
 		arg.Offset,
 	)
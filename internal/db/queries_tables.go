@@ -0,0 +1,209 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ColumnKind classifies column values independently of the database engine.
+type ColumnKind string
+
+const (
+	ColumnInt   ColumnKind = "int"
+	ColumnFloat ColumnKind = "float"
+	ColumnBool  ColumnKind = "bool"
+	ColumnText  ColumnKind = "text"
+	ColumnBytes ColumnKind = "bytes"
+	ColumnTime  ColumnKind = "time"
+)
+
+// TableColumn describes a stored column. Generated columns are not listed as
+// their values cannot be written.
+type TableColumn struct {
+	Name string
+	Kind ColumnKind
+}
+
+// columnKind maps an engine specific column type such as "tinytext",
+// "timestamp without time zone" or "BLOB" to a ColumnKind.
+func columnKind(dataType string) ColumnKind {
+	t := strings.ToLower(dataType)
+	switch {
+	case strings.Contains(t, "bool"):
+		return ColumnBool
+	case strings.Contains(t, "int") || t == "serial":
+		return ColumnInt
+	case strings.Contains(t, "blob") || strings.Contains(t, "binary") || t == "bytea":
+		return ColumnBytes
+	case strings.Contains(t, "float") || strings.Contains(t, "double") || strings.Contains(t, "real") ||
+		strings.Contains(t, "decimal") || strings.Contains(t, "numeric"):
+		return ColumnFloat
+	case strings.Contains(t, "date") || strings.Contains(t, "time"):
+		return ColumnTime
+	default:
+		return ColumnText
+	}
+}
+
+// tableDialect holds the SQL that differs between engines for TableQueries.
+type tableDialect struct {
+	listTables  string
+	listColumns string
+	quote       func(name string) string
+	placeholder func(n int) string
+	// resetSequences moves identity sequences past rows inserted with
+	// explicit keys. Engines that track this themselves leave it nil.
+	resetSequences func(ctx context.Context, db DBTX, table string, columns []*TableColumn) error
+}
+
+// tableQueries implements TableQueries over db for a dialect.
+type tableQueries struct {
+	db      DBTX
+	dialect *tableDialect
+}
+
+func (t tableQueries) SystemListTables(ctx context.Context) ([]string, error) {
+	rows, err := t.db.QueryContext(ctx, t.dialect.listTables)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, name)
+	}
+	return tables, rows.Err()
+}
+
+func (t tableQueries) SystemListTableColumns(ctx context.Context, table string) ([]*TableColumn, error) {
+	rows, err := t.db.QueryContext(ctx, t.dialect.listColumns, table)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	var columns []*TableColumn
+	for rows.Next() {
+		var name, dataType string
+		if err := rows.Scan(&name, &dataType); err != nil {
+			return nil, err
+		}
+		columns = append(columns, &TableColumn{Name: name, Kind: columnKind(dataType)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", table)
+	}
+	return columns, nil
+}
+
+func (t tableQueries) quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i, n := range names {
+		quoted[i] = t.dialect.quote(n)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func (t tableQueries) SystemScanTable(ctx context.Context, table string, columns []string, fn func(values []any) error) error {
+	query := fmt.Sprintf("SELECT %s FROM %s", t.quoteAll(columns), t.dialect.quote(table))
+	rows, err := t.db.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+	values := make([]any, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		if err := fn(values); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (t tableQueries) SystemDeleteTableRows(ctx context.Context, table string) error {
+	_, err := t.db.ExecContext(ctx, "DELETE FROM "+t.dialect.quote(table))
+	return err
+}
+
+func (t tableQueries) SystemInsertTableRow(ctx context.Context, table string, columns []string, values []any) error {
+	if len(columns) != len(values) {
+		return fmt.Errorf("insert %s: %d columns but %d values", table, len(columns), len(values))
+	}
+	params := make([]string, len(values))
+	for i := range params {
+		params[i] = t.dialect.placeholder(i + 1)
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", t.dialect.quote(table), t.quoteAll(columns), strings.Join(params, ", "))
+	_, err := t.db.ExecContext(ctx, query, values...)
+	return err
+}
+
+func (t tableQueries) SystemResetTableSequences(ctx context.Context, table string) error {
+	if t.dialect.resetSequences == nil {
+		return nil
+	}
+	columns, err := t.SystemListTableColumns(ctx, table)
+	if err != nil {
+		return err
+	}
+	return t.dialect.resetSequences(ctx, t.db, table, columns)
+}
+
+func quoteDouble(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func questionPlaceholder(int) string { return "?" }
+
+// mysqlTables lists tables in the current database. Generated columns have a
+// generation expression; MariaDB reports NULL rather than an empty string.
+var mysqlTables = &tableDialect{
+	listTables:  "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() AND table_type = 'BASE TABLE' ORDER BY table_name",
+	listColumns: "SELECT column_name, data_type FROM information_schema.columns WHERE table_schema = DATABASE() AND table_name = ? AND COALESCE(generation_expression, '') = '' ORDER BY ordinal_position",
+	quote: func(name string) string {
+		return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+	},
+	placeholder: questionPlaceholder,
+}
+
+func (q *Queries) tables() tableQueries { return tableQueries{db: q.db, dialect: mysqlTables} }
+
+func (q *Queries) SystemListTables(ctx context.Context) ([]string, error) {
+	return q.tables().SystemListTables(ctx)
+}
+
+func (q *Queries) SystemListTableColumns(ctx context.Context, table string) ([]*TableColumn, error) {
+	return q.tables().SystemListTableColumns(ctx, table)
+}
+
+func (q *Queries) SystemScanTable(ctx context.Context, table string, columns []string, fn func(values []any) error) error {
+	return q.tables().SystemScanTable(ctx, table, columns, fn)
+}
+
+func (q *Queries) SystemDeleteTableRows(ctx context.Context, table string) error {
+	return q.tables().SystemDeleteTableRows(ctx, table)
+}
+
+func (q *Queries) SystemInsertTableRow(ctx context.Context, table string, columns []string, values []any) error {
+	return q.tables().SystemInsertTableRow(ctx, table, columns, values)
+}
+
+func (q *Queries) SystemResetTableSequences(ctx context.Context, table string) error {
+	return q.tables().SystemResetTableSequences(ctx, table)
+}
+
+var _ TableQueries = (*Queries)(nil)
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+)
+
+// postgresTables lists tables in the current schema.
+var postgresTables = &tableDialect{
+	listTables:  "SELECT table_name FROM information_schema.tables WHERE table_schema = current_schema() AND table_type = 'BASE TABLE' ORDER BY table_name",
+	listColumns: "SELECT column_name, data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1 AND is_generated = 'NEVER' ORDER BY ordinal_position",
+	quote:       quoteDouble,
+	placeholder: func(n int) string { return "$" + strconv.Itoa(n) },
+	resetSequences: func(ctx context.Context, db DBTX, table string, columns []*TableColumn) error {
+		for _, c := range columns {
+			if c.Kind != ColumnInt {
+				continue
+			}
+			var seq sql.NullString
+			if err := db.QueryRowContext(ctx, "SELECT pg_get_serial_sequence($1, $2)", quoteDouble(table), c.Name).Scan(&seq); err != nil {
+				return err
+			}
+			if !seq.Valid {
+				continue
+			}
+			query := fmt.Sprintf("SELECT setval($1, COALESCE((SELECT MAX(%s) FROM %s), 0) + 1, false)", quoteDouble(c.Name), quoteDouble(table))
+			if _, err := db.ExecContext(ctx, query, seq.String); err != nil {
+				return fmt.Errorf("reset %s: %w", seq.String, err)
+			}
+		}
+		return nil
+	},
+}
+
+func (s *postgresQuerier) tables() tableQueries {
+	return tableQueries{db: s.db, dialect: postgresTables}
+}
+
+func (s *postgresQuerier) SystemListTables(ctx context.Context) ([]string, error) {
+	return s.tables().SystemListTables(ctx)
+}
+
+func (s *postgresQuerier) SystemListTableColumns(ctx context.Context, table string) ([]*TableColumn, error) {
+	return s.tables().SystemListTableColumns(ctx, table)
+}
+
+func (s *postgresQuerier) SystemScanTable(ctx context.Context, table string, columns []string, fn func(values []any) error) error {
+	return s.tables().SystemScanTable(ctx, table, columns, fn)
+}
+
+func (s *postgresQuerier) SystemDeleteTableRows(ctx context.Context, table string) error {
+	return s.tables().SystemDeleteTableRows(ctx, table)
+}
+
+func (s *postgresQuerier) SystemInsertTableRow(ctx context.Context, table string, columns []string, values []any) error {
+	return s.tables().SystemInsertTableRow(ctx, table, columns, values)
+}
+
+func (s *postgresQuerier) SystemResetTableSequences(ctx context.Context, table string) error {
+	return s.tables().SystemResetTableSequences(ctx, table)
+}
+
+var _ TableQueries = (*postgresQuerier)(nil)
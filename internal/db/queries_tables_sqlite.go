@@ -0,0 +1,44 @@
+//go:build sqlite || sqlite3
+
+package db
+
+import "context"
+
+// sqliteTables lists tables other than SQLite's own. pragma_table_info omits
+// generated columns.
+var sqliteTables = &tableDialect{
+	listTables:  "SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name",
+	listColumns: "SELECT name, type FROM pragma_table_info(?) ORDER BY cid",
+	quote:       quoteDouble,
+	placeholder: questionPlaceholder,
+}
+
+func (s *sqliteQuerier) tables() tableQueries {
+	return tableQueries{db: s.db, dialect: sqliteTables}
+}
+
+func (s *sqliteQuerier) SystemListTables(ctx context.Context) ([]string, error) {
+	return s.tables().SystemListTables(ctx)
+}
+
+func (s *sqliteQuerier) SystemListTableColumns(ctx context.Context, table string) ([]*TableColumn, error) {
+	return s.tables().SystemListTableColumns(ctx, table)
+}
+
+func (s *sqliteQuerier) SystemScanTable(ctx context.Context, table string, columns []string, fn func(values []any) error) error {
+	return s.tables().SystemScanTable(ctx, table, columns, fn)
+}
+
+func (s *sqliteQuerier) SystemDeleteTableRows(ctx context.Context, table string) error {
+	return s.tables().SystemDeleteTableRows(ctx, table)
+}
+
+func (s *sqliteQuerier) SystemInsertTableRow(ctx context.Context, table string, columns []string, values []any) error {
+	return s.tables().SystemInsertTableRow(ctx, table, columns, values)
+}
+
+func (s *sqliteQuerier) SystemResetTableSequences(ctx context.Context, table string) error {
+	return s.tables().SystemResetTableSequences(ctx, table)
+}
+
+var _ TableQueries = (*sqliteQuerier)(nil)
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// openReplyThreadPostgresDatabase returns a connection whose search_path is a
+// temporary schema dropped when the test finishes.
+func openReplyThreadPostgresDatabase(t *testing.T) *sql.DB {
+	t.Helper()
+	dsn := os.Getenv("GOA4WEB_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("set GOA4WEB_TEST_POSTGRES_DSN to run PostgreSQL fork query tests")
+	}
+	cfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("parse PostgreSQL DSN: %v", err)
+	}
+	schemaName := fmt.Sprintf("goa4web_forks_%d", time.Now().UnixNano())
+	adminDB := stdlib.OpenDB(*cfg.Copy())
+	if _, err := adminDB.Exec(`CREATE SCHEMA "` + schemaName + `"`); err != nil {
+		_ = adminDB.Close()
+		t.Fatalf("create temporary schema: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = adminDB.Exec(`DROP SCHEMA "` + schemaName + `" CASCADE`)
+		_ = adminDB.Close()
+	})
+	testConfig := cfg.Copy()
+	testConfig.RuntimeParams["search_path"] = schemaName
+	database := stdlib.OpenDB(*testConfig)
+	t.Cleanup(func() { _ = database.Close() })
+	return database
+}
@@ -110,12 +110,13 @@ func toNullTime(v any) sql.NullTime {
 }
 
 type sqliteQuerier struct {
-	q *dbsqlite.Queries
+	q  *dbsqlite.Queries
+	db DBTX
 }
 
 // NewSQLiteQuerier constructs a db.Querier backed by the SQLite query implementation.
 func NewSQLiteQuerier(db DBTX) Querier {
-	return &sqliteQuerier{q: dbsqlite.New(db)}
+	return &sqliteQuerier{q: dbsqlite.New(db), db: db}
 }
 
 func (s *sqliteQuerier) AddContentLabelStatus(ctx context.Context, arg AddContentLabelStatusParams) error {
@@ -538,10 +539,33 @@ func (s *sqliteQuerier) AdminCreateLinkerItem(ctx context.Context, arg AdminCrea
 	})
 }
 
+func (s *sqliteQuerier) AdminCreateWebhook(ctx context.Context, arg AdminCreateWebhookParams) (int64, error) {
+	res, err := s.q.AdminCreateWebhook(ctx, dbsqlite.AdminCreateWebhookParams{
+		Name:     arg.Name,
+		Url:      arg.Url,
+		Secret:   arg.Secret,
+		Patterns: arg.Patterns,
+		Active: func(b bool) int64 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) AdminDeleteCommentsByThread(ctx context.Context, forumthreadID int32) error {
 	return s.q.AdminDeleteCommentsByThread(ctx, int64(forumthreadID))
 }
 
+func (s *sqliteQuerier) AdminDeleteEmailSuppression(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteEmailSuppression(ctx, int64(id))
+}
+
 func (s *sqliteQuerier) AdminDeleteExternalLink(ctx context.Context, id int32) error {
 	return s.q.AdminDeleteExternalLink(ctx, int64(id))
 }
@@ -622,6 +646,14 @@ func (s *sqliteQuerier) AdminDeleteUserRole(ctx context.Context, iduserRoles int
 	return s.q.AdminDeleteUserRole(ctx, int64(iduserRoles))
 }
 
+func (s *sqliteQuerier) AdminDeleteWebhook(ctx context.Context, id int32) error {
+	return s.q.AdminDeleteWebhook(ctx, int64(id))
+}
+
+func (s *sqliteQuerier) AdminDeleteWebhookDeliveriesByWebhook(ctx context.Context, webhookID int32) error {
+	return s.q.AdminDeleteWebhookDeliveriesByWebhook(ctx, int64(webhookID))
+}
+
 func (s *sqliteQuerier) AdminDemoteAnnouncement(ctx context.Context, id int32) error {
 	return s.q.AdminDemoteAnnouncement(ctx, int64(id))
 }
@@ -802,6 +834,31 @@ func (s *sqliteQuerier) AdminGetAllWritingsByAuthor(ctx context.Context, authorI
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminGetContentReportByID(ctx context.Context, id int32) (*ContentReport, error) {
+	res, err := s.q.AdminGetContentReportByID(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.ContentReport) *ContentReport {
+		if v == nil {
+			return nil
+		}
+		return &ContentReport{
+			ID:             int32(v.ID),
+			ReporterID:     int32(v.ReporterID),
+			ItemType:       v.ItemType,
+			ItemID:         int32(v.ItemID),
+			AuthorID:       int32(v.AuthorID),
+			Reason:         v.Reason,
+			Status:         v.Status,
+			ResolutionNote: v.ResolutionNote,
+			ResolvedBy:     sql.NullInt32{Int32: int32(v.ResolvedBy.Int64), Valid: v.ResolvedBy.Valid},
+			CreatedAt:      v.CreatedAt,
+			ResolvedAt:     v.ResolvedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminGetDashboardStats(ctx context.Context) (*AdminGetDashboardStatsRow, error) {
 	res, err := s.q.AdminGetDashboardStats(ctx)
 	if err != nil {
@@ -846,6 +903,26 @@ func (s *sqliteQuerier) AdminGetDeactivatedCommentById(ctx context.Context, idco
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminGetEmailSuppressionByID(ctx context.Context, id int32) (*EmailSuppression, error) {
+	res, err := s.q.AdminGetEmailSuppressionByID(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.EmailSuppression) *EmailSuppression {
+		if v == nil {
+			return nil
+		}
+		return &EmailSuppression{
+			ID:        int32(v.ID),
+			Email:     v.Email,
+			UserID:    sql.NullInt32{Int32: int32(v.UserID.Int64), Valid: v.UserID.Valid},
+			Reason:    v.Reason,
+			Detail:    v.Detail,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminGetExternalLinkByCacheID(ctx context.Context, arg AdminGetExternalLinkByCacheIDParams) (*ExternalLink, error) {
 	res, err := s.q.AdminGetExternalLinkByCacheID(ctx, dbsqlite.AdminGetExternalLinkByCacheIDParams{
 		CardImageCache: arg.CardImageCache,
@@ -1502,6 +1579,27 @@ func (s *sqliteQuerier) AdminGetUserEmailByID(ctx context.Context, id int32) (*U
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminGetWebhook(ctx context.Context, id int32) (*Webhook, error) {
+	res, err := s.q.AdminGetWebhook(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.Webhook) *Webhook {
+		if v == nil {
+			return nil
+		}
+		return &Webhook{
+			ID:        int32(v.ID),
+			Name:      v.Name,
+			Url:       v.Url,
+			Secret:    v.Secret,
+			Patterns:  v.Patterns,
+			Active:    (v.Active != 0),
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminGetWritingsByCategoryId(ctx context.Context, writingCategoryID int32) ([]*AdminGetWritingsByCategoryIdRow, error) {
 	res, err := s.q.AdminGetWritingsByCategoryId(ctx, int64(writingCategoryID))
 	if err != nil {
@@ -2230,6 +2328,37 @@ func (s *sqliteQuerier) AdminListDeactivatedWritings(ctx context.Context, arg Ad
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminListEmailSuppressions(ctx context.Context, arg AdminListEmailSuppressionsParams) ([]*AdminListEmailSuppressionsRow, error) {
+	res, err := s.q.AdminListEmailSuppressions(ctx, dbsqlite.AdminListEmailSuppressionsParams{
+		Limit:  int64(arg.Limit),
+		Offset: int64(arg.Offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.AdminListEmailSuppressionsRow) []*AdminListEmailSuppressionsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListEmailSuppressionsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListEmailSuppressionsRow{
+				ID:        int32(item.ID),
+				Email:     item.Email,
+				UserID:    sql.NullInt32{Int32: int32(item.UserID.Int64), Valid: item.UserID.Valid},
+				Username:  item.Username,
+				Reason:    item.Reason,
+				Detail:    item.Detail,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminListExternalLinks(ctx context.Context, arg AdminListExternalLinksParams) ([]*ExternalLink, error) {
 	res, err := s.q.AdminListExternalLinks(ctx, dbsqlite.AdminListExternalLinksParams{
 		Limit:  int64(arg.Limit),
@@ -2715,6 +2844,60 @@ func (s *sqliteQuerier) AdminListPasswordResets(ctx context.Context, arg AdminLi
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminListPendingContentReportReporters(ctx context.Context, arg AdminListPendingContentReportReportersParams) ([]int32, error) {
+	res, err := s.q.AdminListPendingContentReportReporters(ctx, dbsqlite.AdminListPendingContentReportReportersParams{
+		ItemType: arg.ItemType,
+		ItemID:   int64(arg.ItemID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(s []int64) []int32 {
+		if s == nil {
+			return nil
+		}
+		out := make([]int32, len(s))
+		for i, v := range s {
+			out[i] = int32(v)
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) AdminListPendingContentReports(ctx context.Context) ([]*AdminListPendingContentReportsRow, error) {
+	res, err := s.q.AdminListPendingContentReports(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.AdminListPendingContentReportsRow) []*AdminListPendingContentReportsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListPendingContentReportsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListPendingContentReportsRow{
+				ID:               int32(item.ID),
+				ReporterID:       int32(item.ReporterID),
+				ItemType:         item.ItemType,
+				ItemID:           int32(item.ItemID),
+				AuthorID:         int32(item.AuthorID),
+				Reason:           item.Reason,
+				Status:           item.Status,
+				ResolutionNote:   item.ResolutionNote,
+				ResolvedBy:       sql.NullInt32{Int32: int32(item.ResolvedBy.Int64), Valid: item.ResolvedBy.Valid},
+				CreatedAt:        item.CreatedAt,
+				ResolvedAt:       item.ResolvedAt,
+				ReporterUsername: item.ReporterUsername,
+				AuthorUsername:   item.AuthorUsername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminListPendingDeactivatedBlogs(ctx context.Context, arg AdminListPendingDeactivatedBlogsParams) ([]*AdminListPendingDeactivatedBlogsRow, error) {
 	res, err := s.q.AdminListPendingDeactivatedBlogs(ctx, dbsqlite.AdminListPendingDeactivatedBlogsParams{
 		UsersIdusers: int64(arg.UsersIdusers),
@@ -3049,6 +3232,35 @@ func (s *sqliteQuerier) AdminListPrivateTopicParticipantsByTopicID(ctx context.C
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminListRecentEmailBounces(ctx context.Context, limit int32) ([]*AdminListRecentEmailBouncesRow, error) {
+	res, err := s.q.AdminListRecentEmailBounces(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.AdminListRecentEmailBouncesRow) []*AdminListRecentEmailBouncesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListRecentEmailBouncesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListRecentEmailBouncesRow{
+				ID:          int32(item.ID),
+				UserEmailID: int32(item.UserEmailID),
+				Email:       item.Email,
+				UserID:      int32(item.UserID),
+				Kind:        item.Kind,
+				Source:      item.Source,
+				Detail:      item.Detail,
+				CreatedAt:   item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminListRecentNotifications(ctx context.Context, limit int32) ([]*Notification, error) {
 	res, err := s.q.AdminListRecentNotifications(ctx, int64(limit))
 	if err != nil {
@@ -3194,6 +3406,40 @@ func (s *sqliteQuerier) AdminListRequestsByUserID(ctx context.Context, usersIdus
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminListResolvedContentReports(ctx context.Context, limit int32) ([]*AdminListResolvedContentReportsRow, error) {
+	res, err := s.q.AdminListResolvedContentReports(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.AdminListResolvedContentReportsRow) []*AdminListResolvedContentReportsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListResolvedContentReportsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListResolvedContentReportsRow{
+				ID:               int32(item.ID),
+				ReporterID:       int32(item.ReporterID),
+				ItemType:         item.ItemType,
+				ItemID:           int32(item.ItemID),
+				AuthorID:         int32(item.AuthorID),
+				Reason:           item.Reason,
+				Status:           item.Status,
+				ResolutionNote:   item.ResolutionNote,
+				ResolvedBy:       sql.NullInt32{Int32: int32(item.ResolvedBy.Int64), Valid: item.ResolvedBy.Valid},
+				CreatedAt:        item.CreatedAt,
+				ResolvedAt:       item.ResolvedAt,
+				ReporterUsername: item.ReporterUsername,
+				AuthorUsername:   item.AuthorUsername,
+			}
+		}
+		return out
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminListRoles(ctx context.Context) ([]*Role, error) {
 	res, err := s.q.AdminListRoles(ctx)
 	if err != nil {
@@ -3516,6 +3762,70 @@ func (s *sqliteQuerier) AdminListUsersByRoleID(ctx context.Context, roleID int32
 	}(res), nil
 }
 
+func (s *sqliteQuerier) AdminListWebhookDeliveries(ctx context.Context, arg AdminListWebhookDeliveriesParams) ([]*AdminListWebhookDeliveriesRow, error) {
+	res, err := s.q.AdminListWebhookDeliveries(ctx, dbsqlite.AdminListWebhookDeliveriesParams{
+		WebhookID: arg.WebhookID,
+		Limit:     int64(arg.Limit),
+		Offset:    int64(arg.Offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.AdminListWebhookDeliveriesRow) []*AdminListWebhookDeliveriesRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*AdminListWebhookDeliveriesRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &AdminListWebhookDeliveriesRow{
+				ID:             int32(item.ID),
+				WebhookID:      int32(item.WebhookID),
+				Event:          item.Event,
+				Path:           item.Path,
+				Status:         item.Status,
+				Attempts:       int32(item.Attempts),
+				ResponseStatus: sql.NullInt32{Int32: int32(item.ResponseStatus.Int64), Valid: item.ResponseStatus.Valid},
+				Error:          item.Error,
+				CreatedAt:      item.CreatedAt,
+				UpdatedAt:      item.UpdatedAt,
+				WebhookName:    item.WebhookName,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) AdminListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	res, err := s.q.AdminListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.Webhook) []*Webhook {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Webhook, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Webhook{
+				ID:        int32(item.ID),
+				Name:      item.Name,
+				Url:       item.Url,
+				Secret:    item.Secret,
+				Patterns:  item.Patterns,
+				Active:    (item.Active != 0),
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
 func (s *sqliteQuerier) AdminMarkBlogRestored(ctx context.Context, idblogs int32) error {
 	return s.q.AdminMarkBlogRestored(ctx, int64(idblogs))
 }
@@ -3589,6 +3899,10 @@ func (s *sqliteQuerier) AdminRecalculateForumThreadByIdMetaData(ctx context.Cont
 	return s.q.AdminRecalculateForumThreadByIdMetaData(ctx, int64(idforumthread))
 }
 
+func (s *sqliteQuerier) AdminRemoveLoginRolesFromUser(ctx context.Context, userID int32) error {
+	return s.q.AdminRemoveLoginRolesFromUser(ctx, int64(userID))
+}
+
 func (s *sqliteQuerier) AdminRenameFAQCategory(ctx context.Context, arg AdminRenameFAQCategoryParams) error {
 	return s.q.AdminRenameFAQCategory(ctx, dbsqlite.AdminRenameFAQCategoryParams{
 		Name: arg.Name,
@@ -3619,6 +3933,20 @@ func (s *sqliteQuerier) AdminReplaceSiteNewsURL(ctx context.Context, arg AdminRe
 	})
 }
 
+func (s *sqliteQuerier) AdminResolveContentReportsForItem(ctx context.Context, arg AdminResolveContentReportsForItemParams) (int64, error) {
+	res, err := s.q.AdminResolveContentReportsForItem(ctx, dbsqlite.AdminResolveContentReportsForItemParams{
+		Status:         arg.Status,
+		ResolutionNote: arg.ResolutionNote,
+		ResolvedBy:     sql.NullInt64{Int64: int64(arg.ResolvedBy.Int32), Valid: arg.ResolvedBy.Valid},
+		ItemType:       arg.ItemType,
+		ItemID:         int64(arg.ItemID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) AdminRestoreBlog(ctx context.Context, arg AdminRestoreBlogParams) error {
 	return s.q.AdminRestoreBlog(ctx, dbsqlite.AdminRestoreBlogParams{
 		Blog:    arg.Blog,
@@ -3626,6 +3954,13 @@ func (s *sqliteQuerier) AdminRestoreBlog(ctx context.Context, arg AdminRestoreBl
 	})
 }
 
+func (s *sqliteQuerier) AdminRestoreBlogText(ctx context.Context, arg AdminRestoreBlogTextParams) error {
+	return s.q.AdminRestoreBlogText(ctx, dbsqlite.AdminRestoreBlogTextParams{
+		Blog:   arg.Blog,
+		BlogID: int64(arg.BlogID),
+	})
+}
+
 func (s *sqliteQuerier) AdminRestoreComment(ctx context.Context, arg AdminRestoreCommentParams) error {
 	return s.q.AdminRestoreComment(ctx, dbsqlite.AdminRestoreCommentParams{
 		Text:       arg.Text,
@@ -3633,6 +3968,13 @@ func (s *sqliteQuerier) AdminRestoreComment(ctx context.Context, arg AdminRestor
 	})
 }
 
+func (s *sqliteQuerier) AdminRestoreCommentText(ctx context.Context, arg AdminRestoreCommentTextParams) error {
+	return s.q.AdminRestoreCommentText(ctx, dbsqlite.AdminRestoreCommentTextParams{
+		Text:      arg.Text,
+		CommentID: int64(arg.CommentID),
+	})
+}
+
 func (s *sqliteQuerier) AdminRestoreImagepost(ctx context.Context, arg AdminRestoreImagepostParams) error {
 	return s.q.AdminRestoreImagepost(ctx, dbsqlite.AdminRestoreImagepostParams{
 		Description: arg.Description,
@@ -3651,6 +3993,13 @@ func (s *sqliteQuerier) AdminRestoreLink(ctx context.Context, arg AdminRestoreLi
 	})
 }
 
+func (s *sqliteQuerier) AdminRestoreNewsPostText(ctx context.Context, arg AdminRestoreNewsPostTextParams) error {
+	return s.q.AdminRestoreNewsPostText(ctx, dbsqlite.AdminRestoreNewsPostTextParams{
+		News:   arg.News,
+		NewsID: int64(arg.NewsID),
+	})
+}
+
 func (s *sqliteQuerier) AdminRestoreUser(ctx context.Context, idusers int32) error {
 	return s.q.AdminRestoreUser(ctx, int64(idusers))
 }
@@ -3681,6 +4030,15 @@ func (s *sqliteQuerier) AdminRestoreWriting(ctx context.Context, arg AdminRestor
 	})
 }
 
+func (s *sqliteQuerier) AdminRestoreWritingText(ctx context.Context, arg AdminRestoreWritingTextParams) error {
+	return s.q.AdminRestoreWritingText(ctx, dbsqlite.AdminRestoreWritingTextParams{
+		Title:     arg.Title,
+		Abstract:  arg.Abstract,
+		Writing:   arg.Writing,
+		WritingID: int64(arg.WritingID),
+	})
+}
+
 func (s *sqliteQuerier) AdminScrubBlog(ctx context.Context, arg AdminScrubBlogParams) error {
 	return s.q.AdminScrubBlog(ctx, dbsqlite.AdminScrubBlogParams{
 		Blog:    arg.Blog,
@@ -3976,6 +4334,22 @@ func (s *sqliteQuerier) AdminUpdateUsernameByID(ctx context.Context, arg AdminUp
 	})
 }
 
+func (s *sqliteQuerier) AdminUpdateWebhook(ctx context.Context, arg AdminUpdateWebhookParams) error {
+	return s.q.AdminUpdateWebhook(ctx, dbsqlite.AdminUpdateWebhookParams{
+		Name:     arg.Name,
+		Url:      arg.Url,
+		Secret:   arg.Secret,
+		Patterns: arg.Patterns,
+		Active: func(b bool) int64 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.Active),
+		ID: int64(arg.ID),
+	})
+}
+
 func (s *sqliteQuerier) AdminUpdateWritingCategory(ctx context.Context, arg AdminUpdateWritingCategoryParams) error {
 	return s.q.AdminUpdateWritingCategory(ctx, dbsqlite.AdminUpdateWritingCategoryParams{
 		Title:             arg.Title,
@@ -4132,12 +4506,10 @@ func (s *sqliteQuerier) ClearUnreadContentPrivateLabelExceptUser(ctx context.Con
 	})
 }
 
-func (s *sqliteQuerier) CountUnreadPrivateThreadsForUser(ctx context.Context, arg CountUnreadPrivateThreadsForUserParams) (int64, error) {
-	res, err := s.q.CountUnreadPrivateThreadsForUser(ctx, dbsqlite.CountUnreadPrivateThreadsForUserParams{
-		TopicIDNull: arg.TopicIDNull,
-		TopicIDVal:  int64(arg.TopicIDVal),
-		GrantUserID: sql.NullInt64{Int64: int64(arg.GrantUserID.Int32), Valid: arg.GrantUserID.Valid},
-		GranteeID:   int64(arg.GranteeID),
+func (s *sqliteQuerier) CountContentRevisionsByItem(ctx context.Context, arg CountContentRevisionsByItemParams) (int64, error) {
+	res, err := s.q.CountContentRevisionsByItem(ctx, dbsqlite.CountContentRevisionsByItemParams{
+		ItemType: arg.ItemType,
+		ItemID:   int64(arg.ItemID),
 	})
 	if err != nil {
 		return 0, err
@@ -4145,29 +4517,19 @@ func (s *sqliteQuerier) CountUnreadPrivateThreadsForUser(ctx context.Context, ar
 	return res, nil
 }
 
-func (s *sqliteQuerier) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (int64, error) {
-	res, err := s.q.CreateAPIKey(ctx, dbsqlite.CreateAPIKeyParams{
-		UsersIdusers: int64(arg.UsersIdusers),
-		ApiKey:       arg.ApiKey,
-		Name:         arg.Name,
-		Scopes:       arg.Scopes,
-		ExpiresAt:    arg.ExpiresAt,
-	})
+func (s *sqliteQuerier) CountForumPollVoters(ctx context.Context, pollID int32) (int64, error) {
+	res, err := s.q.CountForumPollVoters(ctx, int64(pollID))
 	if err != nil {
 		return 0, err
 	}
 	return res, nil
 }
 
-func (s *sqliteQuerier) CreateBlogEntryForWriter(ctx context.Context, arg CreateBlogEntryForWriterParams) (int64, error) {
-	res, err := s.q.CreateBlogEntryForWriter(ctx, dbsqlite.CreateBlogEntryForWriterParams{
-		UsersIdusers: int64(arg.UsersIdusers),
-		LanguageID:   sql.NullInt64{Int64: int64(arg.LanguageID.Int32), Valid: arg.LanguageID.Valid},
-		Blog:         arg.Blog,
-		Written:      arg.Written,
-		Timezone:     arg.Timezone,
-		UserID:       sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
-		ListerID:     int64(arg.ListerID),
+func (s *sqliteQuerier) CountPendingContentReportsForReporter(ctx context.Context, arg CountPendingContentReportsForReporterParams) (int64, error) {
+	res, err := s.q.CountPendingContentReportsForReporter(ctx, dbsqlite.CountPendingContentReportsForReporterParams{
+		ReporterID: int64(arg.ReporterID),
+		ItemType:   arg.ItemType,
+		ItemID:     int64(arg.ItemID),
 	})
 	if err != nil {
 		return 0, err
@@ -4175,8 +4537,59 @@ func (s *sqliteQuerier) CreateBlogEntryForWriter(ctx context.Context, arg Create
 	return res, nil
 }
 
-func (s *sqliteQuerier) CreateBookmarksForLister(ctx context.Context, arg CreateBookmarksForListerParams) error {
-	return s.q.CreateBookmarksForLister(ctx, dbsqlite.CreateBookmarksForListerParams{
+func (s *sqliteQuerier) CountUnreadPrivateThreadsForUser(ctx context.Context, arg CountUnreadPrivateThreadsForUserParams) (int64, error) {
+	res, err := s.q.CountUnreadPrivateThreadsForUser(ctx, dbsqlite.CountUnreadPrivateThreadsForUserParams{
+		TopicIDNull: arg.TopicIDNull,
+		TopicIDVal:  int64(arg.TopicIDVal),
+		GrantUserID: sql.NullInt64{Int64: int64(arg.GrantUserID.Int32), Valid: arg.GrantUserID.Valid},
+		GranteeID:   int64(arg.GranteeID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) CountUnusedRecoveryCodesForUser(ctx context.Context, usersIdusers int32) (int64, error) {
+	res, err := s.q.CountUnusedRecoveryCodesForUser(ctx, int64(usersIdusers))
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (int64, error) {
+	res, err := s.q.CreateAPIKey(ctx, dbsqlite.CreateAPIKeyParams{
+		UsersIdusers: int64(arg.UsersIdusers),
+		ApiKey:       arg.ApiKey,
+		Name:         arg.Name,
+		Scopes:       arg.Scopes,
+		ExpiresAt:    arg.ExpiresAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) CreateBlogEntryForWriter(ctx context.Context, arg CreateBlogEntryForWriterParams) (int64, error) {
+	res, err := s.q.CreateBlogEntryForWriter(ctx, dbsqlite.CreateBlogEntryForWriterParams{
+		UsersIdusers: int64(arg.UsersIdusers),
+		LanguageID:   sql.NullInt64{Int64: int64(arg.LanguageID.Int32), Valid: arg.LanguageID.Valid},
+		Blog:         arg.Blog,
+		Written:      arg.Written,
+		Timezone:     arg.Timezone,
+		UserID:       sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
+		ListerID:     int64(arg.ListerID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) CreateBookmarksForLister(ctx context.Context, arg CreateBookmarksForListerParams) error {
+	return s.q.CreateBookmarksForLister(ctx, dbsqlite.CreateBookmarksForListerParams{
 		UsersIdusers: int64(arg.UsersIdusers),
 		List: func(s sql.NullString) []byte {
 			if s.Valid {
@@ -4206,6 +4619,20 @@ func (s *sqliteQuerier) CreateCommentInSectionForCommenter(ctx context.Context,
 	return res, nil
 }
 
+func (s *sqliteQuerier) CreateContentReportForReporter(ctx context.Context, arg CreateContentReportForReporterParams) (int64, error) {
+	res, err := s.q.CreateContentReportForReporter(ctx, dbsqlite.CreateContentReportForReporterParams{
+		ReporterID: int64(arg.ReporterID),
+		ItemType:   arg.ItemType,
+		ItemID:     int64(arg.ItemID),
+		AuthorID:   int64(arg.AuthorID),
+		Reason:     arg.Reason,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) CreateExternalLink(ctx context.Context, url string) (sql.Result, error) {
 	res, err := s.q.CreateExternalLink(ctx, url)
 	if err != nil {
@@ -4223,6 +4650,30 @@ func (s *sqliteQuerier) CreateFAQQuestionForWriter(ctx context.Context, arg Crea
 	})
 }
 
+func (s *sqliteQuerier) CreateForumPollForCreator(ctx context.Context, arg CreateForumPollForCreatorParams) (int64, error) {
+	res, err := s.q.CreateForumPollForCreator(ctx, dbsqlite.CreateForumPollForCreatorParams{
+		ThreadID:          int64(arg.ThreadID),
+		Question:          arg.Question,
+		MultipleChoice:    arg.MultipleChoice,
+		Anonymous:         arg.Anonymous,
+		ResultsVisibility: arg.ResultsVisibility,
+		ClosesAt:          arg.ClosesAt,
+		CreatorID:         int64(arg.CreatorID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) CreateForumPollOption(ctx context.Context, arg CreateForumPollOptionParams) error {
+	return s.q.CreateForumPollOption(ctx, dbsqlite.CreateForumPollOptionParams{
+		PollID:   int64(arg.PollID),
+		Position: int64(arg.Position),
+		Label:    arg.Label,
+	})
+}
+
 func (s *sqliteQuerier) CreateForumTopicForPoster(ctx context.Context, arg CreateForumTopicForPosterParams) (int64, error) {
 	res, err := s.q.CreateForumTopicForPoster(ctx, dbsqlite.CreateForumTopicForPosterParams{
 		ForumcategoryID: int64(arg.ForumcategoryID),
@@ -4394,6 +4845,20 @@ func (s *sqliteQuerier) DeactivateNewsPost(ctx context.Context, idsitenews int32
 	return s.q.DeactivateNewsPost(ctx, int64(idsitenews))
 }
 
+func (s *sqliteQuerier) DeleteDraftForUser(ctx context.Context, arg DeleteDraftForUserParams) error {
+	return s.q.DeleteDraftForUser(ctx, dbsqlite.DeleteDraftForUserParams{
+		UserID: int64(arg.UserID),
+		Target: arg.Target,
+	})
+}
+
+func (s *sqliteQuerier) DeleteForumPollVotesForVoter(ctx context.Context, arg DeleteForumPollVotesForVoterParams) error {
+	return s.q.DeleteForumPollVotesForVoter(ctx, dbsqlite.DeleteForumPollVotesForVoterParams{
+		PollID:  int64(arg.PollID),
+		VoterID: int64(arg.VoterID),
+	})
+}
+
 func (s *sqliteQuerier) DeleteGrantByProperties(ctx context.Context, arg DeleteGrantByPropertiesParams) error {
 	return s.q.DeleteGrantByProperties(ctx, dbsqlite.DeleteGrantByPropertiesParams{
 		RoleID:  sql.NullInt64{Int64: int64(arg.RoleID.Int32), Valid: arg.RoleID.Valid},
@@ -4429,6 +4894,23 @@ func (s *sqliteQuerier) DeletePendingPassword(ctx context.Context, userID int32)
 	return s.q.DeletePendingPassword(ctx, int64(userID))
 }
 
+func (s *sqliteQuerier) DeleteReactionForReactor(ctx context.Context, arg DeleteReactionForReactorParams) (int64, error) {
+	res, err := s.q.DeleteReactionForReactor(ctx, dbsqlite.DeleteReactionForReactorParams{
+		ItemType:  arg.ItemType,
+		ItemID:    int64(arg.ItemID),
+		ReactorID: int64(arg.ReactorID),
+		Reaction:  arg.Reaction,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) DeleteRecoveryCodesForUser(ctx context.Context, usersIdusers int32) error {
+	return s.q.DeleteRecoveryCodesForUser(ctx, int64(usersIdusers))
+}
+
 func (s *sqliteQuerier) DeleteSubscriptionArchetypesByRoleAndName(ctx context.Context, arg DeleteSubscriptionArchetypesByRoleAndNameParams) error {
 	return s.q.DeleteSubscriptionArchetypesByRoleAndName(ctx, dbsqlite.DeleteSubscriptionArchetypesByRoleAndNameParams{
 		RoleID:        int64(arg.RoleID),
@@ -4451,6 +4933,10 @@ func (s *sqliteQuerier) DeleteSubscriptionForSubscriber(ctx context.Context, arg
 	})
 }
 
+func (s *sqliteQuerier) DeleteTOTPForUser(ctx context.Context, usersIdusers int32) error {
+	return s.q.DeleteTOTPForUser(ctx, int64(usersIdusers))
+}
+
 func (s *sqliteQuerier) DeleteThreadsByTopicID(ctx context.Context, forumtopicIdforumtopic int32) error {
 	return s.q.DeleteThreadsByTopicID(ctx, int64(forumtopicIdforumtopic))
 }
@@ -4466,6 +4952,13 @@ func (s *sqliteQuerier) DeleteUserLanguagesForUser(ctx context.Context, userID i
 	return s.q.DeleteUserLanguagesForUser(ctx, int64(userID))
 }
 
+func (s *sqliteQuerier) EnableTOTPForUser(ctx context.Context, arg EnableTOTPForUserParams) error {
+	return s.q.EnableTOTPForUser(ctx, dbsqlite.EnableTOTPForUserParams{
+		Step:   arg.Step,
+		UserID: int64(arg.UserID),
+	})
+}
+
 func (s *sqliteQuerier) EnsureExternalLink(ctx context.Context, url string) (sql.Result, error) {
 	res, err := s.q.EnsureExternalLink(ctx, url)
 	if err != nil {
@@ -5397,6 +5890,34 @@ func (s *sqliteQuerier) GetContentReadMarker(ctx context.Context, arg GetContent
 	}(res), nil
 }
 
+func (s *sqliteQuerier) GetContentRevisionForItem(ctx context.Context, arg GetContentRevisionForItemParams) (*ContentRevision, error) {
+	res, err := s.q.GetContentRevisionForItem(ctx, dbsqlite.GetContentRevisionForItemParams{
+		ID:       int64(arg.ID),
+		ItemType: arg.ItemType,
+		ItemID:   int64(arg.ItemID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.ContentRevision) *ContentRevision {
+		if v == nil {
+			return nil
+		}
+		return &ContentRevision{
+			ID:             int32(v.ID),
+			ItemType:       v.ItemType,
+			ItemID:         int32(v.ItemID),
+			UsersIdusers:   int32(v.UsersIdusers),
+			Title:          v.Title,
+			Abstract:       v.Abstract,
+			Body:           v.Body,
+			RestoredFromID: sql.NullInt32{Int32: int32(v.RestoredFromID.Int64), Valid: v.RestoredFromID.Valid},
+			CreatedAt:      v.CreatedAt,
+			Timezone:       v.Timezone,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) GetDigestTimezones(ctx context.Context) ([]sql.NullString, error) {
 	res, err := s.q.GetDigestTimezones(ctx)
 	if err != nil {
@@ -5405,6 +5926,49 @@ func (s *sqliteQuerier) GetDigestTimezones(ctx context.Context) ([]sql.NullStrin
 	return res, nil
 }
 
+func (s *sqliteQuerier) GetDraftForUser(ctx context.Context, arg GetDraftForUserParams) (*Draft, error) {
+	res, err := s.q.GetDraftForUser(ctx, dbsqlite.GetDraftForUserParams{
+		UserID: int64(arg.UserID),
+		Target: arg.Target,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.Draft) *Draft {
+		if v == nil {
+			return nil
+		}
+		return &Draft{
+			ID:        int32(v.ID),
+			UserID:    int32(v.UserID),
+			Target:    v.Target,
+			Url:       v.Url,
+			Content:   v.Content,
+			UpdatedAt: v.UpdatedAt,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) GetEmailSuppressionByEmail(ctx context.Context, email string) (*EmailSuppression, error) {
+	res, err := s.q.GetEmailSuppressionByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.EmailSuppression) *EmailSuppression {
+		if v == nil {
+			return nil
+		}
+		return &EmailSuppression{
+			ID:        int32(v.ID),
+			Email:     v.Email,
+			UserID:    sql.NullInt32{Int32: int32(v.UserID.Int64), Valid: v.UserID.Valid},
+			Reason:    v.Reason,
+			Detail:    v.Detail,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) GetExternalLink(ctx context.Context, url string) (*ExternalLink, error) {
 	res, err := s.q.GetExternalLink(ctx, url)
 	if err != nil {
@@ -5592,6 +6156,30 @@ func (s *sqliteQuerier) GetForumCategoryById(ctx context.Context, arg GetForumCa
 	}(res), nil
 }
 
+func (s *sqliteQuerier) GetForumPollByThreadID(ctx context.Context, threadID int32) (*ForumPoll, error) {
+	res, err := s.q.GetForumPollByThreadID(ctx, int64(threadID))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.ForumPoll) *ForumPoll {
+		if v == nil {
+			return nil
+		}
+		return &ForumPoll{
+			ID:                int32(v.ID),
+			ForumthreadID:     int32(v.ForumthreadID),
+			Question:          v.Question,
+			MultipleChoice:    v.MultipleChoice,
+			Anonymous:         v.Anonymous,
+			ResultsVisibility: v.ResultsVisibility,
+			ClosesAt:          v.ClosesAt,
+			ClosedAt:          v.ClosedAt,
+			CreatedBy:         int32(v.CreatedBy),
+			CreatedAt:         v.CreatedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) GetForumThreadIdByNewsPostId(ctx context.Context, idsitenews int32) (*GetForumThreadIdByNewsPostIdRow, error) {
 	res, err := s.q.GetForumThreadIdByNewsPostId(ctx, int64(idsitenews))
 	if err != nil {
@@ -6405,10 +6993,12 @@ func (s *sqliteQuerier) GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCoun
 
 func (s *sqliteQuerier) GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending(ctx context.Context, arg GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams) ([]*GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow, error) {
 	res, err := s.q.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending(ctx, dbsqlite.GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams{
-		UserID:   sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
-		Offset:   int64(arg.Offset),
-		Limit:    int64(arg.Limit),
-		ViewerID: int64(arg.ViewerID),
+		UserID:            sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
+		Offset:            int64(arg.Offset),
+		Limit:             int64(arg.Limit),
+		ViewerID:          int64(arg.ViewerID),
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: int64(arg.ScheduledViewerID),
 	})
 	if err != nil {
 		return nil, err
@@ -6731,6 +7321,7 @@ func (s *sqliteQuerier) GetPreferenceForLister(ctx context.Context, listerID int
 			MonthlyDigestHour:       sql.NullInt32{Int32: int32(v.MonthlyDigestHour.Int64), Valid: v.MonthlyDigestHour.Valid},
 			LastMonthlyDigestSentAt: v.LastMonthlyDigestSentAt,
 			ImageSafeDimension:      v.ImageSafeDimension,
+			MuteMentions:            (v.MuteMentions != 0),
 		}
 	}(res), nil
 }
@@ -6774,8 +7365,10 @@ func (s *sqliteQuerier) GetPublicProfileRoleForUser(ctx context.Context, usersId
 
 func (s *sqliteQuerier) GetPublicWritings(ctx context.Context, arg GetPublicWritingsParams) ([]*Writing, error) {
 	res, err := s.q.GetPublicWritings(ctx, dbsqlite.GetPublicWritingsParams{
-		Offset: int64(arg.Offset),
-		Limit:  int64(arg.Limit),
+		Offset:            int64(arg.Offset),
+		Limit:             int64(arg.Limit),
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: int64(arg.ScheduledViewerID),
 	})
 	if err != nil {
 		return nil, err
@@ -6875,6 +7468,29 @@ func (s *sqliteQuerier) GetRoleByName(ctx context.Context, name string) (*Role,
 	}(res), nil
 }
 
+func (s *sqliteQuerier) GetScheduledPublication(ctx context.Context, arg GetScheduledPublicationParams) (*ScheduledPublication, error) {
+	res, err := s.q.GetScheduledPublication(ctx, dbsqlite.GetScheduledPublicationParams{
+		ItemType: arg.ItemType,
+		ItemID:   int64(arg.ItemID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.ScheduledPublication) *ScheduledPublication {
+		if v == nil {
+			return nil
+		}
+		return &ScheduledPublication{
+			ID:        int32(v.ID),
+			ItemType:  v.ItemType,
+			ItemID:    int32(v.ItemID),
+			AuthorID:  int32(v.AuthorID),
+			PublishAt: v.PublishAt,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) GetSchedulerState(ctx context.Context, taskName string) (*SchedulerState, error) {
 	res, err := s.q.GetSchedulerState(ctx, taskName)
 	if err != nil {
@@ -6919,6 +7535,25 @@ func (s *sqliteQuerier) GetSubscriptionArchetypesByRole(ctx context.Context, rol
 	}(res), nil
 }
 
+func (s *sqliteQuerier) GetTOTPForUser(ctx context.Context, usersIdusers int32) (*UserTotp, error) {
+	res, err := s.q.GetTOTPForUser(ctx, int64(usersIdusers))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.UserTotp) *UserTotp {
+		if v == nil {
+			return nil
+		}
+		return &UserTotp{
+			UsersIdusers: int32(v.UsersIdusers),
+			Secret:       v.Secret,
+			EnabledAt:    v.EnabledAt,
+			LastUsedStep: v.LastUsedStep,
+			CreatedAt:    v.CreatedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) GetThreadBySectionThreadIDForReplier(ctx context.Context, arg GetThreadBySectionThreadIDForReplierParams) (*Forumthread, error) {
 	res, err := s.q.GetThreadBySectionThreadIDForReplier(ctx, dbsqlite.GetThreadBySectionThreadIDForReplierParams{
 		ThreadID:       int64(arg.ThreadID),
@@ -7435,6 +8070,24 @@ func (s *sqliteQuerier) InsertAuditLog(ctx context.Context, arg InsertAuditLogPa
 	})
 }
 
+func (s *sqliteQuerier) InsertContentRevision(ctx context.Context, arg InsertContentRevisionParams) (int64, error) {
+	res, err := s.q.InsertContentRevision(ctx, dbsqlite.InsertContentRevisionParams{
+		ItemType:       arg.ItemType,
+		ItemID:         int64(arg.ItemID),
+		UsersIdusers:   int64(arg.UsersIdusers),
+		Title:          arg.Title,
+		Abstract:       arg.Abstract,
+		Body:           arg.Body,
+		RestoredFromID: sql.NullInt64{Int64: int64(arg.RestoredFromID.Int32), Valid: arg.RestoredFromID.Valid},
+		CreatedAt:      arg.CreatedAt,
+		Timezone:       arg.Timezone,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) InsertEmailPreferenceForLister(ctx context.Context, arg InsertEmailPreferenceForListerParams) error {
 	return s.q.InsertEmailPreferenceForLister(ctx, dbsqlite.InsertEmailPreferenceForListerParams{
 		EmailForumUpdates: func(b sql.NullBool) sql.NullInt64 {
@@ -7483,6 +8136,14 @@ func (s *sqliteQuerier) InsertFAQRevisionForUser(ctx context.Context, arg Insert
 	})
 }
 
+func (s *sqliteQuerier) InsertForumPollVoteForVoter(ctx context.Context, arg InsertForumPollVoteForVoterParams) error {
+	return s.q.InsertForumPollVoteForVoter(ctx, dbsqlite.InsertForumPollVoteForVoterParams{
+		PollID:   int64(arg.PollID),
+		OptionID: int64(arg.OptionID),
+		VoterID:  int64(arg.VoterID),
+	})
+}
+
 func (s *sqliteQuerier) InsertPasskey(ctx context.Context, arg InsertPasskeyParams) error {
 	return s.q.InsertPasskey(ctx, dbsqlite.InsertPasskeyParams{
 		UserID:          int64(arg.UserID),
@@ -7527,6 +8188,22 @@ func (s *sqliteQuerier) InsertPreferenceForLister(ctx context.Context, arg Inser
 	})
 }
 
+func (s *sqliteQuerier) InsertReactionForReactor(ctx context.Context, arg InsertReactionForReactorParams) error {
+	return s.q.InsertReactionForReactor(ctx, dbsqlite.InsertReactionForReactorParams{
+		ItemType:  arg.ItemType,
+		ItemID:    int64(arg.ItemID),
+		ReactorID: int64(arg.ReactorID),
+		Reaction:  arg.Reaction,
+	})
+}
+
+func (s *sqliteQuerier) InsertRecoveryCodeForUser(ctx context.Context, arg InsertRecoveryCodeForUserParams) error {
+	return s.q.InsertRecoveryCodeForUser(ctx, dbsqlite.InsertRecoveryCodeForUserParams{
+		UserID:   int64(arg.UserID),
+		CodeHash: arg.CodeHash,
+	})
+}
+
 func (s *sqliteQuerier) InsertSubscription(ctx context.Context, arg InsertSubscriptionParams) error {
 	return s.q.InsertSubscription(ctx, dbsqlite.InsertSubscriptionParams{
 		UsersIdusers: int64(arg.UsersIdusers),
@@ -7759,12 +8436,14 @@ func (s *sqliteQuerier) ListBannedIps(ctx context.Context) ([]*BannedIp, error)
 
 func (s *sqliteQuerier) ListBlogEntriesByAuthorForLister(ctx context.Context, arg ListBlogEntriesByAuthorForListerParams) ([]*ListBlogEntriesByAuthorForListerRow, error) {
 	res, err := s.q.ListBlogEntriesByAuthorForLister(ctx, dbsqlite.ListBlogEntriesByAuthorForListerParams{
-		ListerID: int64(arg.ListerID),
-		AuthorID: int64(arg.AuthorID),
-		IsAdmin:  arg.IsAdmin,
-		UserID:   sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
-		Limit:    int64(arg.Limit),
-		Offset:   int64(arg.Offset),
+		ListerID:          int64(arg.ListerID),
+		AuthorID:          int64(arg.AuthorID),
+		IsAdmin:           arg.IsAdmin,
+		UserID:            sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
+		Limit:             int64(arg.Limit),
+		Offset:            int64(arg.Offset),
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: int64(arg.ScheduledViewerID),
 	})
 	if err != nil {
 		return nil, err
@@ -7841,11 +8520,13 @@ func (s *sqliteQuerier) ListBlogEntriesByIDsForLister(ctx context.Context, arg L
 
 func (s *sqliteQuerier) ListBlogEntriesForLister(ctx context.Context, arg ListBlogEntriesForListerParams) ([]*ListBlogEntriesForListerRow, error) {
 	res, err := s.q.ListBlogEntriesForLister(ctx, dbsqlite.ListBlogEntriesForListerParams{
-		ListerID: int64(arg.ListerID),
-		IsAdmin:  arg.IsAdmin,
-		UserID:   sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
-		Limit:    int64(arg.Limit),
-		Offset:   int64(arg.Offset),
+		ListerID:          int64(arg.ListerID),
+		IsAdmin:           arg.IsAdmin,
+		UserID:            sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
+		Limit:             int64(arg.Limit),
+		Offset:            int64(arg.Offset),
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: int64(arg.ScheduledViewerID),
 	})
 	if err != nil {
 		return nil, err
@@ -8258,61 +8939,123 @@ func (s *sqliteQuerier) ListContentPublicLabels(ctx context.Context, arg ListCon
 	}(res), nil
 }
 
-func (s *sqliteQuerier) ListDuePendingImageCacheEntries(ctx context.Context, arg ListDuePendingImageCacheEntriesParams) ([]*ImageCacheEntry, error) {
-	res, err := s.q.ListDuePendingImageCacheEntries(ctx, dbsqlite.ListDuePendingImageCacheEntriesParams{
-		RetryCount:    int64(arg.RetryCount),
-		NextAttemptAt: arg.NextAttemptAt,
-		Limit:         int64(arg.Limit),
+func (s *sqliteQuerier) ListContentRevisionsByItem(ctx context.Context, arg ListContentRevisionsByItemParams) ([]*ListContentRevisionsByItemRow, error) {
+	res, err := s.q.ListContentRevisionsByItem(ctx, dbsqlite.ListContentRevisionsByItemParams{
+		ItemType: arg.ItemType,
+		ItemID:   int64(arg.ItemID),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.ImageCacheEntry) []*ImageCacheEntry {
+	return func(items []*dbsqlite.ListContentRevisionsByItemRow) []*ListContentRevisionsByItemRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*ImageCacheEntry, len(items))
+		out := make([]*ListContentRevisionsByItemRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &ImageCacheEntry{
-				ID:               item.ID,
-				SourceUrl:        item.SourceUrl,
-				SourceKind:       item.SourceKind,
-				Status:           item.Status,
-				CreatedAt:        item.CreatedAt,
-				LastUsedAt:       item.LastUsedAt,
-				FetchedAt:        item.FetchedAt,
-				ExpiresAt:        item.ExpiresAt,
-				ContentExpiresAt: item.ContentExpiresAt,
-				ContentType:      item.ContentType,
-				SizeBytes:        item.SizeBytes,
-				Width:            sql.NullInt32{Int32: int32(item.Width.Int64), Valid: item.Width.Valid},
-				Height:           sql.NullInt32{Int32: int32(item.Height.Int64), Valid: item.Height.Valid},
-				Checksum:         item.Checksum,
-				ThumbnailID:      item.ThumbnailID,
-				UploadedImageID:  sql.NullInt32{Int32: int32(item.UploadedImageID.Int64), Valid: item.UploadedImageID.Valid},
-				ErrorMessage:     item.ErrorMessage,
-				RetryCount:       int32(item.RetryCount),
-				LastAttemptAt:    item.LastAttemptAt,
-				NextAttemptAt:    item.NextAttemptAt,
+			out[i] = &ListContentRevisionsByItemRow{
+				ID:             int32(item.ID),
+				ItemType:       item.ItemType,
+				ItemID:         int32(item.ItemID),
+				UsersIdusers:   int32(item.UsersIdusers),
+				Title:          item.Title,
+				Abstract:       item.Abstract,
+				Body:           item.Body,
+				RestoredFromID: sql.NullInt32{Int32: int32(item.RestoredFromID.Int64), Valid: item.RestoredFromID.Valid},
+				CreatedAt:      item.CreatedAt,
+				Timezone:       item.Timezone,
+				Username:       item.Username,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) ListEffectiveRoleIDsByUserID(ctx context.Context, usersIdusers int32) ([]int32, error) {
-	res, err := s.q.ListEffectiveRoleIDsByUserID(ctx, int64(usersIdusers))
+func (s *sqliteQuerier) ListDraftsForUser(ctx context.Context, userID int32) ([]*Draft, error) {
+	res, err := s.q.ListDraftsForUser(ctx, int64(userID))
 	if err != nil {
 		return nil, err
 	}
-	return func(s []int64) []int32 {
-		if s == nil {
+	return func(items []*dbsqlite.Draft) []*Draft {
+		if items == nil {
 			return nil
 		}
-		out := make([]int32, len(s))
+		out := make([]*Draft, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Draft{
+				ID:        int32(item.ID),
+				UserID:    int32(item.UserID),
+				Target:    item.Target,
+				Url:       item.Url,
+				Content:   item.Content,
+				UpdatedAt: item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) ListDuePendingImageCacheEntries(ctx context.Context, arg ListDuePendingImageCacheEntriesParams) ([]*ImageCacheEntry, error) {
+	res, err := s.q.ListDuePendingImageCacheEntries(ctx, dbsqlite.ListDuePendingImageCacheEntriesParams{
+		RetryCount:    int64(arg.RetryCount),
+		NextAttemptAt: arg.NextAttemptAt,
+		Limit:         int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ImageCacheEntry) []*ImageCacheEntry {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ImageCacheEntry, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ImageCacheEntry{
+				ID:               item.ID,
+				SourceUrl:        item.SourceUrl,
+				SourceKind:       item.SourceKind,
+				Status:           item.Status,
+				CreatedAt:        item.CreatedAt,
+				LastUsedAt:       item.LastUsedAt,
+				FetchedAt:        item.FetchedAt,
+				ExpiresAt:        item.ExpiresAt,
+				ContentExpiresAt: item.ContentExpiresAt,
+				ContentType:      item.ContentType,
+				SizeBytes:        item.SizeBytes,
+				Width:            sql.NullInt32{Int32: int32(item.Width.Int64), Valid: item.Width.Valid},
+				Height:           sql.NullInt32{Int32: int32(item.Height.Int64), Valid: item.Height.Valid},
+				Checksum:         item.Checksum,
+				ThumbnailID:      item.ThumbnailID,
+				UploadedImageID:  sql.NullInt32{Int32: int32(item.UploadedImageID.Int64), Valid: item.UploadedImageID.Valid},
+				ErrorMessage:     item.ErrorMessage,
+				RetryCount:       int32(item.RetryCount),
+				LastAttemptAt:    item.LastAttemptAt,
+				NextAttemptAt:    item.NextAttemptAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) ListEffectiveRoleIDsByUserID(ctx context.Context, usersIdusers int32) ([]int32, error) {
+	res, err := s.q.ListEffectiveRoleIDsByUserID(ctx, int64(usersIdusers))
+	if err != nil {
+		return nil, err
+	}
+	return func(s []int64) []int32 {
+		if s == nil {
+			return nil
+		}
+		out := make([]int32, len(s))
 		for i, v := range s {
 			out[i] = int32(v)
 		}
@@ -8364,6 +9107,75 @@ func (s *sqliteQuerier) ListExpiredExternalImageCacheEntries(ctx context.Context
 	}(res), nil
 }
 
+func (s *sqliteQuerier) ListForumPollOptionIDsForVoter(ctx context.Context, arg ListForumPollOptionIDsForVoterParams) ([]int32, error) {
+	res, err := s.q.ListForumPollOptionIDsForVoter(ctx, dbsqlite.ListForumPollOptionIDsForVoterParams{
+		PollID:  int64(arg.PollID),
+		VoterID: int64(arg.VoterID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(s []int64) []int32 {
+		if s == nil {
+			return nil
+		}
+		out := make([]int32, len(s))
+		for i, v := range s {
+			out[i] = int32(v)
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) ListForumPollOptionsWithVoteCounts(ctx context.Context, pollID int32) ([]*ListForumPollOptionsWithVoteCountsRow, error) {
+	res, err := s.q.ListForumPollOptionsWithVoteCounts(ctx, int64(pollID))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ListForumPollOptionsWithVoteCountsRow) []*ListForumPollOptionsWithVoteCountsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListForumPollOptionsWithVoteCountsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListForumPollOptionsWithVoteCountsRow{
+				ID:       int32(item.ID),
+				PollID:   int32(item.PollID),
+				Position: int32(item.Position),
+				Label:    item.Label,
+				Votes:    item.Votes,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) ListForumPollVotersForPoll(ctx context.Context, pollID int32) ([]*ListForumPollVotersForPollRow, error) {
+	res, err := s.q.ListForumPollVotersForPoll(ctx, int64(pollID))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ListForumPollVotersForPollRow) []*ListForumPollVotersForPollRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListForumPollVotersForPollRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListForumPollVotersForPollRow{
+				OptionID: int32(item.OptionID),
+				Username: item.Username,
+			}
+		}
+		return out
+	}(res), nil
+}
+
 func (s *sqliteQuerier) ListForumcategoryPath(ctx context.Context, categoryID int32) ([]*ListForumcategoryPathRow, error) {
 	res, err := s.q.ListForumcategoryPath(ctx, int64(categoryID))
 	if err != nil {
@@ -8760,11 +9572,13 @@ func (s *sqliteQuerier) ListPrivateTopicsByUserID(ctx context.Context, userID sq
 
 func (s *sqliteQuerier) ListPublicWritingsByUserForLister(ctx context.Context, arg ListPublicWritingsByUserForListerParams) ([]*ListPublicWritingsByUserForListerRow, error) {
 	res, err := s.q.ListPublicWritingsByUserForLister(ctx, dbsqlite.ListPublicWritingsByUserForListerParams{
-		AuthorID: int64(arg.AuthorID),
-		UserID:   sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
-		Offset:   int64(arg.Offset),
-		Limit:    int64(arg.Limit),
-		ListerID: int64(arg.ListerID),
+		AuthorID:          int64(arg.AuthorID),
+		UserID:            sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
+		Offset:            int64(arg.Offset),
+		Limit:             int64(arg.Limit),
+		ListerID:          int64(arg.ListerID),
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: int64(arg.ScheduledViewerID),
 	})
 	if err != nil {
 		return nil, err
@@ -8807,6 +9621,8 @@ func (s *sqliteQuerier) ListPublicWritingsInCategoryForLister(ctx context.Contex
 		Offset:            int64(arg.Offset),
 		Limit:             int64(arg.Limit),
 		ListerID:          int64(arg.ListerID),
+		ShowScheduled:     arg.ShowScheduled,
+		ScheduledViewerID: int64(arg.ScheduledViewerID),
 	})
 	if err != nil {
 		return nil, err
@@ -8842,6 +9658,42 @@ func (s *sqliteQuerier) ListPublicWritingsInCategoryForLister(ctx context.Contex
 	}(res), nil
 }
 
+func (s *sqliteQuerier) ListReactionCountsForViewer(ctx context.Context, arg ListReactionCountsForViewerParams) ([]*ListReactionCountsForViewerRow, error) {
+	res, err := s.q.ListReactionCountsForViewer(ctx, dbsqlite.ListReactionCountsForViewerParams{
+		ViewerID: int64(arg.ViewerID),
+		ItemType: arg.ItemType,
+		ItemID:   int64(arg.ItemID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ListReactionCountsForViewerRow) []*ListReactionCountsForViewerRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ListReactionCountsForViewerRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ListReactionCountsForViewerRow{
+				Reaction:    item.Reaction,
+				Total:       item.Total,
+				ViewerCount: item.ViewerCount,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) ListReferencedUploadPaths(ctx context.Context) ([]sql.NullString, error) {
+	res, err := s.q.ListReferencedUploadPaths(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) ListSiteNewsSearchFirstForLister(ctx context.Context, arg ListSiteNewsSearchFirstForListerParams) ([]int32, error) {
 	res, err := s.q.ListSiteNewsSearchFirstForLister(ctx, dbsqlite.ListSiteNewsSearchFirstForListerParams{
 		Word:     arg.Word,
@@ -9522,6 +10374,13 @@ func (s *sqliteQuerier) SetNotificationsReadForListerBatch(ctx context.Context,
 	})
 }
 
+func (s *sqliteQuerier) SetPendingTOTPForUser(ctx context.Context, arg SetPendingTOTPForUserParams) error {
+	return s.q.SetPendingTOTPForUser(ctx, dbsqlite.SetPendingTOTPForUserParams{
+		UserID: int64(arg.UserID),
+		Secret: arg.Secret,
+	})
+}
+
 func (s *sqliteQuerier) SetVerificationCodeForLister(ctx context.Context, arg SetVerificationCodeForListerParams) error {
 	return s.q.SetVerificationCodeForLister(ctx, dbsqlite.SetVerificationCodeForListerParams{
 		LastVerificationCode:  arg.LastVerificationCode,
@@ -9640,6 +10499,18 @@ func (s *sqliteQuerier) SystemCheckRoleGrant(ctx context.Context, arg SystemChec
 	return int32(res), nil
 }
 
+func (s *sqliteQuerier) SystemClaimActivityPubDelivery(ctx context.Context, arg SystemClaimActivityPubDeliveryParams) (int64, error) {
+	res, err := s.q.SystemClaimActivityPubDelivery(ctx, dbsqlite.SystemClaimActivityPubDeliveryParams{
+		LeaseUntil: arg.LeaseUntil,
+		ID:         int64(arg.ID),
+		Now:        arg.Now,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) SystemClearContentLabelStatus(ctx context.Context, arg SystemClearContentLabelStatusParams) error {
 	return s.q.SystemClearContentLabelStatus(ctx, dbsqlite.SystemClearContentLabelStatusParams{
 		Item:   arg.Item,
@@ -9655,6 +10526,17 @@ func (s *sqliteQuerier) SystemClearContentPrivateLabel(ctx context.Context, arg
 	})
 }
 
+func (s *sqliteQuerier) SystemCloseForumPoll(ctx context.Context, arg SystemCloseForumPollParams) (int64, error) {
+	res, err := s.q.SystemCloseForumPoll(ctx, dbsqlite.SystemCloseForumPollParams{
+		ClosedAt: arg.ClosedAt,
+		ID:       int64(arg.ID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) SystemCopyPrivateThreadGrantsToThread(ctx context.Context, arg SystemCopyPrivateThreadGrantsToThreadParams) error {
 	return s.q.SystemCopyPrivateThreadGrantsToThread(ctx, dbsqlite.SystemCopyPrivateThreadGrantsToThreadParams{})
 }
@@ -9666,6 +10548,22 @@ func (s *sqliteQuerier) SystemCopyPrivateTopicGrantsToThread(ctx context.Context
 	})
 }
 
+func (s *sqliteQuerier) SystemCountActivityPubActivitiesForUser(ctx context.Context, userID int32) (int64, error) {
+	res, err := s.q.SystemCountActivityPubActivitiesForUser(ctx, int64(userID))
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) SystemCountActivityPubFollowers(ctx context.Context, userID int32) (int64, error) {
+	res, err := s.q.SystemCountActivityPubFollowers(ctx, int64(userID))
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) SystemCountDeadLetters(ctx context.Context) (int64, error) {
 	res, err := s.q.SystemCountDeadLetters(ctx)
 	if err != nil {
@@ -9682,6 +10580,22 @@ func (s *sqliteQuerier) SystemCountLanguages(ctx context.Context) (int64, error)
 	return res, nil
 }
 
+func (s *sqliteQuerier) SystemCountPendingEmailQueue(ctx context.Context) (*SystemCountPendingEmailQueueRow, error) {
+	res, err := s.q.SystemCountPendingEmailQueue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemCountPendingEmailQueueRow) *SystemCountPendingEmailQueueRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemCountPendingEmailQueueRow{
+			Queued:  v.Queued,
+			Failing: v.Failing,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) SystemCountRecentLoginAttempts(ctx context.Context, arg SystemCountRecentLoginAttemptsParams) (int64, error) {
 	res, err := s.q.SystemCountRecentLoginAttempts(ctx, dbsqlite.SystemCountRecentLoginAttemptsParams{
 		Username:  arg.Username,
@@ -9762,14 +10676,33 @@ func (s *sqliteQuerier) SystemCreateUserRoleByID(ctx context.Context, arg System
 	})
 }
 
+func (s *sqliteQuerier) SystemDeleteActivityPubFollower(ctx context.Context, arg SystemDeleteActivityPubFollowerParams) (int64, error) {
+	res, err := s.q.SystemDeleteActivityPubFollower(ctx, dbsqlite.SystemDeleteActivityPubFollowerParams{
+		UserID:   int64(arg.UserID),
+		ActorUrl: arg.ActorUrl,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) SystemDeleteBlogsSearch(ctx context.Context) error {
 	return s.q.SystemDeleteBlogsSearch(ctx)
 }
 
+func (s *sqliteQuerier) SystemDeleteBlogsSearchByBlogID(ctx context.Context, blogID int32) error {
+	return s.q.SystemDeleteBlogsSearchByBlogID(ctx, int64(blogID))
+}
+
 func (s *sqliteQuerier) SystemDeleteCommentsSearch(ctx context.Context) error {
 	return s.q.SystemDeleteCommentsSearch(ctx)
 }
 
+func (s *sqliteQuerier) SystemDeleteCommentsSearchByCommentID(ctx context.Context, commentID int32) error {
+	return s.q.SystemDeleteCommentsSearchByCommentID(ctx, int64(commentID))
+}
+
 func (s *sqliteQuerier) SystemDeleteDeadLetter(ctx context.Context, id int32) error {
 	return s.q.SystemDeleteDeadLetter(ctx, int64(id))
 }
@@ -9778,10 +10711,18 @@ func (s *sqliteQuerier) SystemDeleteImagePostSearch(ctx context.Context) error {
 	return s.q.SystemDeleteImagePostSearch(ctx)
 }
 
+func (s *sqliteQuerier) SystemDeleteImagePostSearchByImagePostID(ctx context.Context, imagePostID int32) error {
+	return s.q.SystemDeleteImagePostSearchByImagePostID(ctx, int64(imagePostID))
+}
+
 func (s *sqliteQuerier) SystemDeleteLinkerSearch(ctx context.Context) error {
 	return s.q.SystemDeleteLinkerSearch(ctx)
 }
 
+func (s *sqliteQuerier) SystemDeleteLinkerSearchByLinkerID(ctx context.Context, linkerID int32) error {
+	return s.q.SystemDeleteLinkerSearchByLinkerID(ctx, int64(linkerID))
+}
+
 func (s *sqliteQuerier) SystemDeletePasswordReset(ctx context.Context, id int32) error {
 	return s.q.SystemDeletePasswordReset(ctx, int64(id))
 }
@@ -9794,6 +10735,14 @@ func (s *sqliteQuerier) SystemDeletePasswordResetsByUser(ctx context.Context, us
 	return res, nil
 }
 
+func (s *sqliteQuerier) SystemDeleteScheduledPublication(ctx context.Context, id int32) (int64, error) {
+	res, err := s.q.SystemDeleteScheduledPublication(ctx, int64(id))
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) SystemDeleteSessionByID(ctx context.Context, sessionID string) error {
 	return s.q.SystemDeleteSessionByID(ctx, sessionID)
 }
@@ -9802,6 +10751,10 @@ func (s *sqliteQuerier) SystemDeleteSiteNewsSearch(ctx context.Context) error {
 	return s.q.SystemDeleteSiteNewsSearch(ctx)
 }
 
+func (s *sqliteQuerier) SystemDeleteSiteNewsSearchBySiteNewsID(ctx context.Context, siteNewsID int32) error {
+	return s.q.SystemDeleteSiteNewsSearchBySiteNewsID(ctx, int64(siteNewsID))
+}
+
 func (s *sqliteQuerier) SystemDeleteUninitializedThread(ctx context.Context, threadID int32) error {
 	return s.q.SystemDeleteUninitializedThread(ctx, int64(threadID))
 }
@@ -9829,69 +10782,225 @@ func (s *sqliteQuerier) SystemDeleteWritingSearchByWritingID(ctx context.Context
 	return s.q.SystemDeleteWritingSearchByWritingID(ctx, int64(writingID))
 }
 
-func (s *sqliteQuerier) SystemGetAllBlogsForIndex(ctx context.Context) ([]*SystemGetAllBlogsForIndexRow, error) {
-	res, err := s.q.SystemGetAllBlogsForIndex(ctx)
+func (s *sqliteQuerier) SystemGetActivityPubActor(ctx context.Context, username sql.NullString) (*SystemGetActivityPubActorRow, error) {
+	res, err := s.q.SystemGetActivityPubActor(ctx, username)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.SystemGetAllBlogsForIndexRow) []*SystemGetAllBlogsForIndexRow {
-		if items == nil {
+	return func(v *dbsqlite.SystemGetActivityPubActorRow) *SystemGetActivityPubActorRow {
+		if v == nil {
 			return nil
 		}
-		out := make([]*SystemGetAllBlogsForIndexRow, len(items))
-		for i, item := range items {
-			if item == nil {
-				continue
-			}
-			out[i] = &SystemGetAllBlogsForIndexRow{
-				Idblogs: int32(item.Idblogs),
-				Blog:    item.Blog,
-			}
+		return &SystemGetActivityPubActorRow{
+			Idusers:  int32(v.Idusers),
+			Username: v.Username,
 		}
-		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemGetBlogEntryByID(ctx context.Context, idblogs int32) (*SystemGetBlogEntryByIDRow, error) {
-	res, err := s.q.SystemGetBlogEntryByID(ctx, int64(idblogs))
+func (s *sqliteQuerier) SystemGetActivityPubActorKey(ctx context.Context, userID int32) (*ActivitypubActorKey, error) {
+	res, err := s.q.SystemGetActivityPubActorKey(ctx, int64(userID))
 	if err != nil {
 		return nil, err
 	}
-	return func(v *dbsqlite.SystemGetBlogEntryByIDRow) *SystemGetBlogEntryByIDRow {
+	return func(v *dbsqlite.ActivitypubActorKey) *ActivitypubActorKey {
 		if v == nil {
 			return nil
 		}
-		return &SystemGetBlogEntryByIDRow{
-			Idblogs:       int32(v.Idblogs),
-			ForumthreadID: sql.NullInt32{Int32: int32(v.ForumthreadID.Int64), Valid: v.ForumthreadID.Valid},
+		return &ActivitypubActorKey{
+			UserID:        int32(v.UserID),
+			PublicKeyPem:  v.PublicKeyPem,
+			PrivateKeyPem: v.PrivateKeyPem,
+			CreatedAt:     v.CreatedAt,
 		}
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemGetDeadLetter(ctx context.Context, id int32) (*DeadLetter, error) {
-	res, err := s.q.SystemGetDeadLetter(ctx, int64(id))
+func (s *sqliteQuerier) SystemGetActivityPubBlogEntry(ctx context.Context, id int32) (*SystemGetActivityPubBlogEntryRow, error) {
+	res, err := s.q.SystemGetActivityPubBlogEntry(ctx, int64(id))
 	if err != nil {
 		return nil, err
 	}
-	return func(v *dbsqlite.DeadLetter) *DeadLetter {
+	return func(v *dbsqlite.SystemGetActivityPubBlogEntryRow) *SystemGetActivityPubBlogEntryRow {
 		if v == nil {
 			return nil
 		}
-		return &DeadLetter{
-			ID:        int32(v.ID),
-			Message:   v.Message,
-			CreatedAt: v.CreatedAt,
+		return &SystemGetActivityPubBlogEntryRow{
+			Idblogs:      int32(v.Idblogs),
+			UsersIdusers: int32(v.UsersIdusers),
+			Username:     v.Username,
+			Blog:         v.Blog,
+			Written:      v.Written,
 		}
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemGetFAQQuestions(ctx context.Context) ([]*Faq, error) {
-	res, err := s.q.SystemGetFAQQuestions(ctx)
+func (s *sqliteQuerier) SystemGetActivityPubWriting(ctx context.Context, id int32) (*SystemGetActivityPubWritingRow, error) {
+	res, err := s.q.SystemGetActivityPubWriting(ctx, int64(id))
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.Faq) []*Faq {
-		if items == nil {
+	return func(v *dbsqlite.SystemGetActivityPubWritingRow) *SystemGetActivityPubWritingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetActivityPubWritingRow{
+			Idwriting:    int32(v.Idwriting),
+			UsersIdusers: int32(v.UsersIdusers),
+			Username:     v.Username,
+			Title:        v.Title,
+			Abstract:     v.Abstract,
+			Writing:      v.Writing,
+			Published:    v.Published,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetAllBlogsForIndex(ctx context.Context) ([]*SystemGetAllBlogsForIndexRow, error) {
+	res, err := s.q.SystemGetAllBlogsForIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemGetAllBlogsForIndexRow) []*SystemGetAllBlogsForIndexRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemGetAllBlogsForIndexRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemGetAllBlogsForIndexRow{
+				Idblogs: int32(item.Idblogs),
+				Blog:    item.Blog,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetBlogEntryByID(ctx context.Context, idblogs int32) (*SystemGetBlogEntryByIDRow, error) {
+	res, err := s.q.SystemGetBlogEntryByID(ctx, int64(idblogs))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetBlogEntryByIDRow) *SystemGetBlogEntryByIDRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogEntryByIDRow{
+			Idblogs:       int32(v.Idblogs),
+			ForumthreadID: sql.NullInt32{Int32: int32(v.ForumthreadID.Int64), Valid: v.ForumthreadID.Valid},
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetBlogEntryForPublishing(ctx context.Context, id int32) (*SystemGetBlogEntryForPublishingRow, error) {
+	res, err := s.q.SystemGetBlogEntryForPublishing(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetBlogEntryForPublishingRow) *SystemGetBlogEntryForPublishingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogEntryForPublishingRow{
+			Idblogs:       int32(v.Idblogs),
+			ForumthreadID: sql.NullInt32{Int32: int32(v.ForumthreadID.Int64), Valid: v.ForumthreadID.Valid},
+			UsersIdusers:  int32(v.UsersIdusers),
+			Blog:          v.Blog,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetBlogForArchive(ctx context.Context, id int32) (*SystemGetBlogForArchiveRow, error) {
+	res, err := s.q.SystemGetBlogForArchive(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetBlogForArchiveRow) *SystemGetBlogForArchiveRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogForArchiveRow{
+			Idblogs:       int32(v.Idblogs),
+			ForumthreadID: sql.NullInt32{Int32: int32(v.ForumthreadID.Int64), Valid: v.ForumthreadID.Valid},
+			UsersIdusers:  int32(v.UsersIdusers),
+			LanguageID:    sql.NullInt32{Int32: int32(v.LanguageID.Int64), Valid: v.LanguageID.Valid},
+			Blog:          v.Blog,
+			Written:       v.Written,
+			Timezone:      v.Timezone,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetBlogForRevision(ctx context.Context, idblogs int32) (*SystemGetBlogForRevisionRow, error) {
+	res, err := s.q.SystemGetBlogForRevision(ctx, int64(idblogs))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetBlogForRevisionRow) *SystemGetBlogForRevisionRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetBlogForRevisionRow{
+			Idblogs:      int32(v.Idblogs),
+			UsersIdusers: int32(v.UsersIdusers),
+			Blog:         v.Blog,
+			Written:      v.Written,
+			Timezone:     v.Timezone,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetCommentReactionTarget(ctx context.Context, id int32) (*SystemGetCommentReactionTargetRow, error) {
+	res, err := s.q.SystemGetCommentReactionTarget(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetCommentReactionTargetRow) *SystemGetCommentReactionTargetRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetCommentReactionTargetRow{
+			Idcomments:    int32(v.Idcomments),
+			UsersIdusers:  int32(v.UsersIdusers),
+			ForumthreadID: int32(v.ForumthreadID),
+			TopicID:       int32(v.TopicID),
+			Handler:       v.Handler,
+			BlogID:        sql.NullInt32{Int32: int32(v.BlogID.Int64), Valid: v.BlogID.Valid},
+			NewsID:        sql.NullInt32{Int32: int32(v.NewsID.Int64), Valid: v.NewsID.Valid},
+			WritingID:     sql.NullInt32{Int32: int32(v.WritingID.Int64), Valid: v.WritingID.Valid},
+			LinkID:        sql.NullInt32{Int32: int32(v.LinkID.Int64), Valid: v.LinkID.Valid},
+			BoardID:       sql.NullInt32{Int32: int32(v.BoardID.Int64), Valid: v.BoardID.Valid},
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetDeadLetter(ctx context.Context, id int32) (*DeadLetter, error) {
+	res, err := s.q.SystemGetDeadLetter(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.DeadLetter) *DeadLetter {
+		if v == nil {
+			return nil
+		}
+		return &DeadLetter{
+			ID:        int32(v.ID),
+			Message:   v.Message,
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetFAQQuestions(ctx context.Context) ([]*Faq, error) {
+	res, err := s.q.SystemGetFAQQuestions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.Faq) []*Faq {
+		if items == nil {
 			return nil
 		}
 		out := make([]*Faq, len(items))
@@ -9972,6 +11081,26 @@ func (s *sqliteQuerier) SystemGetLastNotificationForRecipientByMessage(ctx conte
 	}(res), nil
 }
 
+func (s *sqliteQuerier) SystemGetLatestActivityPubActivityForObject(ctx context.Context, objectUrl string) (*ActivitypubActivity, error) {
+	res, err := s.q.SystemGetLatestActivityPubActivityForObject(ctx, objectUrl)
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.ActivitypubActivity) *ActivitypubActivity {
+		if v == nil {
+			return nil
+		}
+		return &ActivitypubActivity{
+			ID:           int32(v.ID),
+			UserID:       int32(v.UserID),
+			ActivityType: v.ActivityType,
+			ObjectUrl:    v.ObjectUrl,
+			ObjectJson:   v.ObjectJson,
+			Published:    v.Published,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) SystemGetLogin(ctx context.Context, username sql.NullString) (*SystemGetLoginRow, error) {
 	res, err := s.q.SystemGetLogin(ctx, username)
 	if err != nil {
@@ -9998,6 +11127,43 @@ func (s *sqliteQuerier) SystemGetNewsPostByID(ctx context.Context, idsitenews in
 	return int32(res), nil
 }
 
+func (s *sqliteQuerier) SystemGetNewsPostForPublishing(ctx context.Context, id int32) (*SystemGetNewsPostForPublishingRow, error) {
+	res, err := s.q.SystemGetNewsPostForPublishing(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetNewsPostForPublishingRow) *SystemGetNewsPostForPublishingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetNewsPostForPublishingRow{
+			Idsitenews:    int32(v.Idsitenews),
+			ForumthreadID: int32(v.ForumthreadID),
+			UsersIdusers:  int32(v.UsersIdusers),
+			News:          v.News,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetNewsPostForRevision(ctx context.Context, idsitenews int32) (*SystemGetNewsPostForRevisionRow, error) {
+	res, err := s.q.SystemGetNewsPostForRevision(ctx, int64(idsitenews))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetNewsPostForRevisionRow) *SystemGetNewsPostForRevisionRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetNewsPostForRevisionRow{
+			Idsitenews:   int32(v.Idsitenews),
+			UsersIdusers: int32(v.UsersIdusers),
+			News:         v.News,
+			Occurred:     v.Occurred,
+			Timezone:     v.Timezone,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) SystemGetSearchWordByWordLowercased(ctx context.Context, lcase string) (*Searchwordlist, error) {
 	res, err := s.q.SystemGetSearchWordByWordLowercased(ctx, lcase)
 	if err != nil {
@@ -10108,6 +11274,52 @@ func (s *sqliteQuerier) SystemGetUsersByIDs(ctx context.Context, ids []int32) ([
 	}(res), nil
 }
 
+func (s *sqliteQuerier) SystemGetWebhook(ctx context.Context, id int32) (*Webhook, error) {
+	res, err := s.q.SystemGetWebhook(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.Webhook) *Webhook {
+		if v == nil {
+			return nil
+		}
+		return &Webhook{
+			ID:        int32(v.ID),
+			Name:      v.Name,
+			Url:       v.Url,
+			Secret:    v.Secret,
+			Patterns:  v.Patterns,
+			Active:    (v.Active != 0),
+			CreatedAt: v.CreatedAt,
+		}
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemGetWebhookDelivery(ctx context.Context, id int32) (*WebhookDelivery, error) {
+	res, err := s.q.SystemGetWebhookDelivery(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.WebhookDelivery) *WebhookDelivery {
+		if v == nil {
+			return nil
+		}
+		return &WebhookDelivery{
+			ID:             int32(v.ID),
+			WebhookID:      int32(v.WebhookID),
+			Event:          v.Event,
+			Path:           v.Path,
+			Payload:        v.Payload,
+			Status:         v.Status,
+			Attempts:       int32(v.Attempts),
+			ResponseStatus: sql.NullInt32{Int32: int32(v.ResponseStatus.Int64), Valid: v.ResponseStatus.Valid},
+			Error:          v.Error,
+			CreatedAt:      v.CreatedAt,
+			UpdatedAt:      v.UpdatedAt,
+		}
+	}(res), nil
+}
+
 func (s *sqliteQuerier) SystemGetWritingByID(ctx context.Context, idwriting int32) (int32, error) {
 	res, err := s.q.SystemGetWritingByID(ctx, int64(idwriting))
 	if err != nil {
@@ -10116,327 +11328,977 @@ func (s *sqliteQuerier) SystemGetWritingByID(ctx context.Context, idwriting int3
 	return int32(res), nil
 }
 
-func (s *sqliteQuerier) SystemIncrementPendingEmailError(ctx context.Context, id int32) error {
-	return s.q.SystemIncrementPendingEmailError(ctx, int64(id))
+func (s *sqliteQuerier) SystemGetWritingForArchive(ctx context.Context, id int32) (*SystemGetWritingForArchiveRow, error) {
+	res, err := s.q.SystemGetWritingForArchive(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetWritingForArchiveRow) *SystemGetWritingForArchiveRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetWritingForArchiveRow{
+			Idwriting:         int32(v.Idwriting),
+			UsersIdusers:      int32(v.UsersIdusers),
+			ForumthreadID:     int32(v.ForumthreadID),
+			LanguageID:        sql.NullInt32{Int32: int32(v.LanguageID.Int64), Valid: v.LanguageID.Valid},
+			WritingCategoryID: int32(v.WritingCategoryID),
+			Title:             v.Title,
+			Published:         v.Published,
+			Timezone:          v.Timezone,
+			Writing:           v.Writing,
+			Abstract:          v.Abstract,
+			Private:           sql.NullBool{Bool: v.Private.Int64 != 0, Valid: v.Private.Valid},
+		}
+	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemInsertDeadLetter(ctx context.Context, message string) error {
-	return s.q.SystemInsertDeadLetter(ctx, message)
+func (s *sqliteQuerier) SystemGetWritingForPublishing(ctx context.Context, id int32) (*SystemGetWritingForPublishingRow, error) {
+	res, err := s.q.SystemGetWritingForPublishing(ctx, int64(id))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetWritingForPublishingRow) *SystemGetWritingForPublishingRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetWritingForPublishingRow{
+			Idwriting:         int32(v.Idwriting),
+			ForumthreadID:     int32(v.ForumthreadID),
+			UsersIdusers:      int32(v.UsersIdusers),
+			WritingCategoryID: int32(v.WritingCategoryID),
+			Title:             v.Title,
+			Abstract:          v.Abstract,
+			Writing:           v.Writing,
+		}
+	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemInsertLoginAttempt(ctx context.Context, arg SystemInsertLoginAttemptParams) error {
-	return s.q.SystemInsertLoginAttempt(ctx, dbsqlite.SystemInsertLoginAttemptParams{
-		Username:  arg.Username,
-		IpAddress: arg.IpAddress,
-	})
+func (s *sqliteQuerier) SystemGetWritingForRevision(ctx context.Context, idwriting int32) (*SystemGetWritingForRevisionRow, error) {
+	res, err := s.q.SystemGetWritingForRevision(ctx, int64(idwriting))
+	if err != nil {
+		return nil, err
+	}
+	return func(v *dbsqlite.SystemGetWritingForRevisionRow) *SystemGetWritingForRevisionRow {
+		if v == nil {
+			return nil
+		}
+		return &SystemGetWritingForRevisionRow{
+			Idwriting:    int32(v.Idwriting),
+			UsersIdusers: int32(v.UsersIdusers),
+			Title:        v.Title,
+			Abstract:     v.Abstract,
+			Writing:      v.Writing,
+			Published:    v.Published,
+			Timezone:     v.Timezone,
+		}
+	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemInsertSession(ctx context.Context, arg SystemInsertSessionParams) error {
-	return s.q.SystemInsertSession(ctx, dbsqlite.SystemInsertSessionParams{
-		SessionID:    arg.SessionID,
-		UsersIdusers: int64(arg.UsersIdusers),
-	})
+func (s *sqliteQuerier) SystemIncrementPendingEmailError(ctx context.Context, id int32) error {
+	return s.q.SystemIncrementPendingEmailError(ctx, int64(id))
 }
 
-func (s *sqliteQuerier) SystemInsertUser(ctx context.Context, username sql.NullString) (int64, error) {
-	res, err := s.q.SystemInsertUser(ctx, username)
+func (s *sqliteQuerier) SystemInsertActivityPubActivity(ctx context.Context, arg SystemInsertActivityPubActivityParams) (int64, error) {
+	res, err := s.q.SystemInsertActivityPubActivity(ctx, dbsqlite.SystemInsertActivityPubActivityParams{
+		UserID:       int64(arg.UserID),
+		ActivityType: arg.ActivityType,
+		ObjectUrl:    arg.ObjectUrl,
+		ObjectJson:   arg.ObjectJson,
+		Published:    arg.Published,
+	})
 	if err != nil {
 		return 0, err
 	}
 	return res, nil
 }
 
-func (s *sqliteQuerier) SystemLatestDeadLetter(ctx context.Context) (interface{}, error) {
-	res, err := s.q.SystemLatestDeadLetter(ctx)
-	if err != nil {
-		return nil, err
+func (s *sqliteQuerier) SystemInsertActivityPubActorKey(ctx context.Context, arg SystemInsertActivityPubActorKeyParams) error {
+	return s.q.SystemInsertActivityPubActorKey(ctx, dbsqlite.SystemInsertActivityPubActorKeyParams{
+		UserID:        int64(arg.UserID),
+		PublicKeyPem:  arg.PublicKeyPem,
+		PrivateKeyPem: arg.PrivateKeyPem,
+	})
+}
+
+func (s *sqliteQuerier) SystemInsertActivityPubDelivery(ctx context.Context, arg SystemInsertActivityPubDeliveryParams) error {
+	return s.q.SystemInsertActivityPubDelivery(ctx, dbsqlite.SystemInsertActivityPubDeliveryParams{
+		UserID:        int64(arg.UserID),
+		InboxUrl:      arg.InboxUrl,
+		Payload:       arg.Payload,
+		NextAttemptAt: arg.NextAttemptAt,
+	})
+}
+
+func (s *sqliteQuerier) SystemInsertDeadLetter(ctx context.Context, message string) error {
+	return s.q.SystemInsertDeadLetter(ctx, message)
+}
+
+func (s *sqliteQuerier) SystemInsertEmailBounce(ctx context.Context, arg SystemInsertEmailBounceParams) error {
+	return s.q.SystemInsertEmailBounce(ctx, dbsqlite.SystemInsertEmailBounceParams{
+		UserEmailID: int64(arg.UserEmailID),
+		Kind:        arg.Kind,
+		Source:      arg.Source,
+		Detail:      arg.Detail,
+	})
+}
+
+func (s *sqliteQuerier) SystemInsertLoginAttempt(ctx context.Context, arg SystemInsertLoginAttemptParams) error {
+	return s.q.SystemInsertLoginAttempt(ctx, dbsqlite.SystemInsertLoginAttemptParams{
+		Username:  arg.Username,
+		IpAddress: arg.IpAddress,
+	})
+}
+
+func (s *sqliteQuerier) SystemInsertSession(ctx context.Context, arg SystemInsertSessionParams) error {
+	return s.q.SystemInsertSession(ctx, dbsqlite.SystemInsertSessionParams{
+		SessionID:    arg.SessionID,
+		UsersIdusers: int64(arg.UsersIdusers),
+	})
+}
+
+func (s *sqliteQuerier) SystemInsertUser(ctx context.Context, username sql.NullString) (int64, error) {
+	res, err := s.q.SystemInsertUser(ctx, username)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) SystemInsertWebhookDelivery(ctx context.Context, arg SystemInsertWebhookDeliveryParams) (int64, error) {
+	res, err := s.q.SystemInsertWebhookDelivery(ctx, dbsqlite.SystemInsertWebhookDeliveryParams{
+		WebhookID: int64(arg.WebhookID),
+		Event:     arg.Event,
+		Path:      arg.Path,
+		Payload:   arg.Payload,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
+func (s *sqliteQuerier) SystemLatestDeadLetter(ctx context.Context) (interface{}, error) {
+	res, err := s.q.SystemLatestDeadLetter(ctx)
+	if err != nil {
+		return nil, err
 	}
 	return res, nil
 }
 
-func (s *sqliteQuerier) SystemListAllUnverifiedEmails(ctx context.Context) ([]*UserEmail, error) {
-	res, err := s.q.SystemListAllUnverifiedEmails(ctx)
+func (s *sqliteQuerier) SystemListActiveWebhooks(ctx context.Context) ([]*Webhook, error) {
+	res, err := s.q.SystemListActiveWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.Webhook) []*Webhook {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Webhook, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Webhook{
+				ID:        int32(item.ID),
+				Name:      item.Name,
+				Url:       item.Url,
+				Secret:    item.Secret,
+				Patterns:  item.Patterns,
+				Active:    (item.Active != 0),
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListActivityPubActivitiesForUser(ctx context.Context, arg SystemListActivityPubActivitiesForUserParams) ([]*ActivitypubActivity, error) {
+	res, err := s.q.SystemListActivityPubActivitiesForUser(ctx, dbsqlite.SystemListActivityPubActivitiesForUserParams{
+		UserID: int64(arg.UserID),
+		Limit:  int64(arg.Limit),
+		Offset: int64(arg.Offset),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ActivitypubActivity) []*ActivitypubActivity {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ActivitypubActivity, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ActivitypubActivity{
+				ID:           int32(item.ID),
+				UserID:       int32(item.UserID),
+				ActivityType: item.ActivityType,
+				ObjectUrl:    item.ObjectUrl,
+				ObjectJson:   item.ObjectJson,
+				Published:    item.Published,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListActivityPubFollowers(ctx context.Context, userID int32) ([]*ActivitypubFollower, error) {
+	res, err := s.q.SystemListActivityPubFollowers(ctx, int64(userID))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ActivitypubFollower) []*ActivitypubFollower {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ActivitypubFollower, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ActivitypubFollower{
+				ID:             int32(item.ID),
+				UserID:         int32(item.UserID),
+				ActorUrl:       item.ActorUrl,
+				InboxUrl:       item.InboxUrl,
+				SharedInboxUrl: item.SharedInboxUrl,
+				CreatedAt:      item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListAllUnverifiedEmails(ctx context.Context) ([]*UserEmail, error) {
+	res, err := s.q.SystemListAllUnverifiedEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.UserEmail) []*UserEmail {
+		if items == nil {
+			return nil
+		}
+		out := make([]*UserEmail, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &UserEmail{
+				ID:                    int32(item.ID),
+				UserID:                int32(item.UserID),
+				Email:                 item.Email,
+				VerifiedAt:            item.VerifiedAt,
+				LastVerificationCode:  item.LastVerificationCode,
+				VerificationExpiresAt: item.VerificationExpiresAt,
+				NotificationPriority:  int32(item.NotificationPriority),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListAllUserEmails(ctx context.Context) ([]*SystemListAllUserEmailsRow, error) {
+	res, err := s.q.SystemListAllUserEmails(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListAllUserEmailsRow) []*SystemListAllUserEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListAllUserEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListAllUserEmailsRow{
+				UserID:     int32(item.UserID),
+				Email:      item.Email,
+				VerifiedAt: item.VerifiedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListAllUsers(ctx context.Context) ([]*SystemListAllUsersRow, error) {
+	res, err := s.q.SystemListAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListAllUsersRow) []*SystemListAllUsersRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListAllUsersRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListAllUsersRow{
+				Idusers:   int32(item.Idusers),
+				Username:  item.Username,
+				Admin:     item.Admin,
+				CreatedAt: item.CreatedAt,
+				DeletedAt: item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListBlogsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListBlogsSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListBlogsSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListBlogsSearchMatchesByWordRow) []*SystemListBlogsSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListBlogsSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListBlogsSearchMatchesByWordRow{
+				ID:        int32(item.ID),
+				WordCount: int32(item.WordCount),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListBoardsByParentID(ctx context.Context, arg SystemListBoardsByParentIDParams) ([]*Imageboard, error) {
+	res, err := s.q.SystemListBoardsByParentID(ctx, dbsqlite.SystemListBoardsByParentIDParams{
+		ParentID: sql.NullInt64{Int64: int64(arg.ParentID.Int32), Valid: arg.ParentID.Valid},
+		Offset:   int64(arg.Offset),
+		Limit:    int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.Imageboard) []*Imageboard {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Imageboard, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Imageboard{
+				Idimageboard:           int32(item.Idimageboard),
+				ImageboardIdimageboard: sql.NullInt32{Int32: int32(item.ImageboardIdimageboard.Int64), Valid: item.ImageboardIdimageboard.Valid},
+				Title:                  item.Title,
+				Description:            item.Description,
+				ApprovalRequired:       (item.ApprovalRequired != 0),
+				DeletedAt:              item.DeletedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListCommentsByThreadID(ctx context.Context, forumthreadID int32) ([]*SystemListCommentsByThreadIDRow, error) {
+	res, err := s.q.SystemListCommentsByThreadID(ctx, int64(forumthreadID))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListCommentsByThreadIDRow) []*SystemListCommentsByThreadIDRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListCommentsByThreadIDRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListCommentsByThreadIDRow{
+				Idcomments: int32(item.Idcomments),
+				Text:       item.Text,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListCommentsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListCommentsSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListCommentsSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListCommentsSearchMatchesByWordRow) []*SystemListCommentsSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListCommentsSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListCommentsSearchMatchesByWordRow{
+				ID:        int32(item.ID),
+				WordCount: int32(item.WordCount),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListDeadLetters(ctx context.Context, limit int32) ([]*DeadLetter, error) {
+	res, err := s.q.SystemListDeadLetters(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.DeadLetter) []*DeadLetter {
+		if items == nil {
+			return nil
+		}
+		out := make([]*DeadLetter, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &DeadLetter{
+				ID:        int32(item.ID),
+				Message:   item.Message,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListDueActivityPubDeliveries(ctx context.Context, arg SystemListDueActivityPubDeliveriesParams) ([]*ActivitypubDelivery, error) {
+	res, err := s.q.SystemListDueActivityPubDeliveries(ctx, dbsqlite.SystemListDueActivityPubDeliveriesParams{
+		Now:   arg.Now,
+		Limit: int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ActivitypubDelivery) []*ActivitypubDelivery {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ActivitypubDelivery, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ActivitypubDelivery{
+				ID:            int32(item.ID),
+				UserID:        int32(item.UserID),
+				InboxUrl:      item.InboxUrl,
+				Payload:       item.Payload,
+				Status:        item.Status,
+				Attempts:      int32(item.Attempts),
+				NextAttemptAt: item.NextAttemptAt,
+				Error:         item.Error,
+				CreatedAt:     item.CreatedAt,
+				UpdatedAt:     item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListDueScheduledPublications(ctx context.Context, arg SystemListDueScheduledPublicationsParams) ([]*ScheduledPublication, error) {
+	res, err := s.q.SystemListDueScheduledPublications(ctx, dbsqlite.SystemListDueScheduledPublicationsParams{
+		Now:   arg.Now,
+		Limit: int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.ScheduledPublication) []*ScheduledPublication {
+		if items == nil {
+			return nil
+		}
+		out := make([]*ScheduledPublication, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &ScheduledPublication{
+				ID:        int32(item.ID),
+				ItemType:  item.ItemType,
+				ItemID:    int32(item.ItemID),
+				AuthorID:  int32(item.AuthorID),
+				PublishAt: item.PublishAt,
+				CreatedAt: item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListExpiredForumPolls(ctx context.Context, arg SystemListExpiredForumPollsParams) ([]*SystemListExpiredForumPollsRow, error) {
+	res, err := s.q.SystemListExpiredForumPolls(ctx, dbsqlite.SystemListExpiredForumPollsParams{
+		Now:   arg.Now,
+		Limit: int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListExpiredForumPollsRow) []*SystemListExpiredForumPollsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListExpiredForumPollsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListExpiredForumPollsRow{
+				ID:            int32(item.ID),
+				ForumthreadID: int32(item.ForumthreadID),
+				Question:      item.Question,
+				ClosesAt:      item.ClosesAt,
+				TopicID:       int32(item.TopicID),
+				Handler:       item.Handler,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListImagePostSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListImagePostSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListImagePostSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListImagePostSearchMatchesByWordRow) []*SystemListImagePostSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListImagePostSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListImagePostSearchMatchesByWordRow{
+				ID:        int32(item.ID),
+				WordCount: int32(item.WordCount),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListLanguages(ctx context.Context) ([]*Language, error) {
+	res, err := s.q.SystemListLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.Language) []*Language {
+		if items == nil {
+			return nil
+		}
+		out := make([]*Language, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &Language{
+				ID:     int32(item.ID),
+				Nameof: item.Nameof,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListLinkerSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListLinkerSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListLinkerSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListLinkerSearchMatchesByWordRow) []*SystemListLinkerSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListLinkerSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListLinkerSearchMatchesByWordRow{
+				ID:        int32(item.ID),
+				WordCount: int32(item.WordCount),
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListPendingEmails(ctx context.Context, arg SystemListPendingEmailsParams) ([]*SystemListPendingEmailsRow, error) {
+	res, err := s.q.SystemListPendingEmails(ctx, dbsqlite.SystemListPendingEmailsParams{
+		Offset: int64(arg.Offset),
+		Limit:  int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListPendingEmailsRow) []*SystemListPendingEmailsRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListPendingEmailsRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListPendingEmailsRow{
+				ID:          int32(item.ID),
+				ToUserID:    sql.NullInt32{Int32: int32(item.ToUserID.Int64), Valid: item.ToUserID.Valid},
+				Body:        item.Body,
+				ErrorCount:  int32(item.ErrorCount),
+				DirectEmail: (item.DirectEmail != 0),
+				CreatedAt:   item.CreatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListPendingWebhookDeliveries(ctx context.Context, limit int32) ([]*WebhookDelivery, error) {
+	res, err := s.q.SystemListPendingWebhookDeliveries(ctx, int64(limit))
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.WebhookDelivery) []*WebhookDelivery {
+		if items == nil {
+			return nil
+		}
+		out := make([]*WebhookDelivery, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &WebhookDelivery{
+				ID:             int32(item.ID),
+				WebhookID:      int32(item.WebhookID),
+				Event:          item.Event,
+				Path:           item.Path,
+				Payload:        item.Payload,
+				Status:         item.Status,
+				Attempts:       int32(item.Attempts),
+				ResponseStatus: sql.NullInt32{Int32: int32(item.ResponseStatus.Int64), Valid: item.ResponseStatus.Valid},
+				Error:          item.Error,
+				CreatedAt:      item.CreatedAt,
+				UpdatedAt:      item.UpdatedAt,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListPublicWritingsByAuthor(ctx context.Context, arg SystemListPublicWritingsByAuthorParams) ([]*SystemListPublicWritingsByAuthorRow, error) {
+	res, err := s.q.SystemListPublicWritingsByAuthor(ctx, dbsqlite.SystemListPublicWritingsByAuthorParams{
+		AuthorID: int64(arg.AuthorID),
+		Offset:   int64(arg.Offset),
+		Limit:    int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListPublicWritingsByAuthorRow) []*SystemListPublicWritingsByAuthorRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListPublicWritingsByAuthorRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListPublicWritingsByAuthorRow{
+				Idwriting:         int32(item.Idwriting),
+				UsersIdusers:      int32(item.UsersIdusers),
+				ForumthreadID:     int32(item.ForumthreadID),
+				LanguageID:        sql.NullInt32{Int32: int32(item.LanguageID.Int64), Valid: item.LanguageID.Valid},
+				WritingCategoryID: int32(item.WritingCategoryID),
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int64 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListPublicWritingsInCategory(ctx context.Context, arg SystemListPublicWritingsInCategoryParams) ([]*SystemListPublicWritingsInCategoryRow, error) {
+	res, err := s.q.SystemListPublicWritingsInCategory(ctx, dbsqlite.SystemListPublicWritingsInCategoryParams{
+		CategoryID: int64(arg.CategoryID),
+		Offset:     int64(arg.Offset),
+		Limit:      int64(arg.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListPublicWritingsInCategoryRow) []*SystemListPublicWritingsInCategoryRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListPublicWritingsInCategoryRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListPublicWritingsInCategoryRow{
+				Idwriting:         int32(item.Idwriting),
+				UsersIdusers:      int32(item.UsersIdusers),
+				ForumthreadID:     int32(item.ForumthreadID),
+				LanguageID:        sql.NullInt32{Int32: int32(item.LanguageID.Int64), Valid: item.LanguageID.Valid},
+				WritingCategoryID: int32(item.WritingCategoryID),
+				Title:             item.Title,
+				Published:         item.Published,
+				Timezone:          item.Timezone,
+				Writing:           item.Writing,
+				Abstract:          item.Abstract,
+				Private:           sql.NullBool{Bool: item.Private.Int64 != 0, Valid: item.Private.Valid},
+				DeletedAt:         item.DeletedAt,
+				LastIndex:         item.LastIndex,
+				Username:          item.Username,
+				Comments:          item.Comments,
+			}
+		}
+		return out
+	}(res), nil
+}
+
+func (s *sqliteQuerier) SystemListSiteNewsSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListSiteNewsSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListSiteNewsSearchMatchesByWord(ctx, word)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.UserEmail) []*UserEmail {
+	return func(items []*dbsqlite.SystemListSiteNewsSearchMatchesByWordRow) []*SystemListSiteNewsSearchMatchesByWordRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*UserEmail, len(items))
+		out := make([]*SystemListSiteNewsSearchMatchesByWordRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &UserEmail{
-				ID:                    int32(item.ID),
-				UserID:                int32(item.UserID),
-				Email:                 item.Email,
-				VerifiedAt:            item.VerifiedAt,
-				LastVerificationCode:  item.LastVerificationCode,
-				VerificationExpiresAt: item.VerificationExpiresAt,
-				NotificationPriority:  int32(item.NotificationPriority),
+			out[i] = &SystemListSiteNewsSearchMatchesByWordRow{
+				ID:        int32(item.ID),
+				WordCount: int32(item.WordCount),
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListAllUserEmails(ctx context.Context) ([]*SystemListAllUserEmailsRow, error) {
-	res, err := s.q.SystemListAllUserEmails(ctx)
+func (s *sqliteQuerier) SystemListSitemapBlogEntries(ctx context.Context) ([]*SystemListSitemapBlogEntriesRow, error) {
+	res, err := s.q.SystemListSitemapBlogEntries(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.SystemListAllUserEmailsRow) []*SystemListAllUserEmailsRow {
+	return func(items []*dbsqlite.SystemListSitemapBlogEntriesRow) []*SystemListSitemapBlogEntriesRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*SystemListAllUserEmailsRow, len(items))
+		out := make([]*SystemListSitemapBlogEntriesRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &SystemListAllUserEmailsRow{
-				UserID:     int32(item.UserID),
-				Email:      item.Email,
-				VerifiedAt: item.VerifiedAt,
+			out[i] = &SystemListSitemapBlogEntriesRow{
+				Idblogs: int32(item.Idblogs),
+				Written: item.Written,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListAllUsers(ctx context.Context) ([]*SystemListAllUsersRow, error) {
-	res, err := s.q.SystemListAllUsers(ctx)
+func (s *sqliteQuerier) SystemListSitemapFAQQuestions(ctx context.Context) ([]*SystemListSitemapFAQQuestionsRow, error) {
+	res, err := s.q.SystemListSitemapFAQQuestions(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.SystemListAllUsersRow) []*SystemListAllUsersRow {
+	return func(items []*dbsqlite.SystemListSitemapFAQQuestionsRow) []*SystemListSitemapFAQQuestionsRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*SystemListAllUsersRow, len(items))
+		out := make([]*SystemListSitemapFAQQuestionsRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &SystemListAllUsersRow{
-				Idusers:   int32(item.Idusers),
-				Username:  item.Username,
-				Admin:     item.Admin,
-				CreatedAt: item.CreatedAt,
-				DeletedAt: item.DeletedAt,
+			out[i] = &SystemListSitemapFAQQuestionsRow{
+				ID:        int32(item.ID),
+				UpdatedAt: item.UpdatedAt,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListBoardsByParentID(ctx context.Context, arg SystemListBoardsByParentIDParams) ([]*Imageboard, error) {
-	res, err := s.q.SystemListBoardsByParentID(ctx, dbsqlite.SystemListBoardsByParentIDParams{
-		ParentID: sql.NullInt64{Int64: int64(arg.ParentID.Int32), Valid: arg.ParentID.Valid},
-		Offset:   int64(arg.Offset),
-		Limit:    int64(arg.Limit),
-	})
+func (s *sqliteQuerier) SystemListSitemapForumThreads(ctx context.Context) ([]*SystemListSitemapForumThreadsRow, error) {
+	res, err := s.q.SystemListSitemapForumThreads(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.Imageboard) []*Imageboard {
+	return func(items []*dbsqlite.SystemListSitemapForumThreadsRow) []*SystemListSitemapForumThreadsRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*Imageboard, len(items))
+		out := make([]*SystemListSitemapForumThreadsRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &Imageboard{
-				Idimageboard:           int32(item.Idimageboard),
-				ImageboardIdimageboard: sql.NullInt32{Int32: int32(item.ImageboardIdimageboard.Int64), Valid: item.ImageboardIdimageboard.Valid},
-				Title:                  item.Title,
-				Description:            item.Description,
-				ApprovalRequired:       (item.ApprovalRequired != 0),
-				DeletedAt:              item.DeletedAt,
+			out[i] = &SystemListSitemapForumThreadsRow{
+				Idforumthread:          int32(item.Idforumthread),
+				ForumtopicIdforumtopic: int32(item.ForumtopicIdforumtopic),
+				Lastaddition:           item.Lastaddition,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListCommentsByThreadID(ctx context.Context, forumthreadID int32) ([]*SystemListCommentsByThreadIDRow, error) {
-	res, err := s.q.SystemListCommentsByThreadID(ctx, int64(forumthreadID))
+func (s *sqliteQuerier) SystemListSitemapForumTopics(ctx context.Context) ([]*SystemListSitemapForumTopicsRow, error) {
+	res, err := s.q.SystemListSitemapForumTopics(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.SystemListCommentsByThreadIDRow) []*SystemListCommentsByThreadIDRow {
+	return func(items []*dbsqlite.SystemListSitemapForumTopicsRow) []*SystemListSitemapForumTopicsRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*SystemListCommentsByThreadIDRow, len(items))
+		out := make([]*SystemListSitemapForumTopicsRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &SystemListCommentsByThreadIDRow{
-				Idcomments: int32(item.Idcomments),
-				Text:       item.Text,
+			out[i] = &SystemListSitemapForumTopicsRow{
+				Idforumtopic: int32(item.Idforumtopic),
+				Lastaddition: item.Lastaddition,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListDeadLetters(ctx context.Context, limit int32) ([]*DeadLetter, error) {
-	res, err := s.q.SystemListDeadLetters(ctx, int64(limit))
+func (s *sqliteQuerier) SystemListSitemapImageBoards(ctx context.Context) ([]int32, error) {
+	res, err := s.q.SystemListSitemapImageBoards(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.DeadLetter) []*DeadLetter {
-		if items == nil {
+	return func(s []int64) []int32 {
+		if s == nil {
 			return nil
 		}
-		out := make([]*DeadLetter, len(items))
-		for i, item := range items {
-			if item == nil {
-				continue
-			}
-			out[i] = &DeadLetter{
-				ID:        int32(item.ID),
-				Message:   item.Message,
-				CreatedAt: item.CreatedAt,
-			}
+		out := make([]int32, len(s))
+		for i, v := range s {
+			out[i] = int32(v)
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListLanguages(ctx context.Context) ([]*Language, error) {
-	res, err := s.q.SystemListLanguages(ctx)
+func (s *sqliteQuerier) SystemListSitemapImagePosts(ctx context.Context) ([]*SystemListSitemapImagePostsRow, error) {
+	res, err := s.q.SystemListSitemapImagePosts(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.Language) []*Language {
+	return func(items []*dbsqlite.SystemListSitemapImagePostsRow) []*SystemListSitemapImagePostsRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*Language, len(items))
+		out := make([]*SystemListSitemapImagePostsRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &Language{
-				ID:     int32(item.ID),
-				Nameof: item.Nameof,
+			out[i] = &SystemListSitemapImagePostsRow{
+				Idimageboard:  int32(item.Idimageboard),
+				ForumthreadID: int32(item.ForumthreadID),
+				Posted:        item.Posted,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListPendingEmails(ctx context.Context, arg SystemListPendingEmailsParams) ([]*SystemListPendingEmailsRow, error) {
-	res, err := s.q.SystemListPendingEmails(ctx, dbsqlite.SystemListPendingEmailsParams{
-		Offset: int64(arg.Offset),
-		Limit:  int64(arg.Limit),
-	})
+func (s *sqliteQuerier) SystemListSitemapLinkerItems(ctx context.Context) ([]*SystemListSitemapLinkerItemsRow, error) {
+	res, err := s.q.SystemListSitemapLinkerItems(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.SystemListPendingEmailsRow) []*SystemListPendingEmailsRow {
+	return func(items []*dbsqlite.SystemListSitemapLinkerItemsRow) []*SystemListSitemapLinkerItemsRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*SystemListPendingEmailsRow, len(items))
+		out := make([]*SystemListSitemapLinkerItemsRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &SystemListPendingEmailsRow{
-				ID:          int32(item.ID),
-				ToUserID:    sql.NullInt32{Int32: int32(item.ToUserID.Int64), Valid: item.ToUserID.Valid},
-				Body:        item.Body,
-				ErrorCount:  int32(item.ErrorCount),
-				DirectEmail: (item.DirectEmail != 0),
-				CreatedAt:   item.CreatedAt,
+			out[i] = &SystemListSitemapLinkerItemsRow{
+				ID:     int32(item.ID),
+				Listed: item.Listed,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListPublicWritingsByAuthor(ctx context.Context, arg SystemListPublicWritingsByAuthorParams) ([]*SystemListPublicWritingsByAuthorRow, error) {
-	res, err := s.q.SystemListPublicWritingsByAuthor(ctx, dbsqlite.SystemListPublicWritingsByAuthorParams{
-		AuthorID: int64(arg.AuthorID),
-		Offset:   int64(arg.Offset),
-		Limit:    int64(arg.Limit),
-	})
+func (s *sqliteQuerier) SystemListSitemapNewsPosts(ctx context.Context) ([]*SystemListSitemapNewsPostsRow, error) {
+	res, err := s.q.SystemListSitemapNewsPosts(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.SystemListPublicWritingsByAuthorRow) []*SystemListPublicWritingsByAuthorRow {
+	return func(items []*dbsqlite.SystemListSitemapNewsPostsRow) []*SystemListSitemapNewsPostsRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*SystemListPublicWritingsByAuthorRow, len(items))
+		out := make([]*SystemListSitemapNewsPostsRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &SystemListPublicWritingsByAuthorRow{
-				Idwriting:         int32(item.Idwriting),
-				UsersIdusers:      int32(item.UsersIdusers),
-				ForumthreadID:     int32(item.ForumthreadID),
-				LanguageID:        sql.NullInt32{Int32: int32(item.LanguageID.Int64), Valid: item.LanguageID.Valid},
-				WritingCategoryID: int32(item.WritingCategoryID),
-				Title:             item.Title,
-				Published:         item.Published,
-				Timezone:          item.Timezone,
-				Writing:           item.Writing,
-				Abstract:          item.Abstract,
-				Private:           sql.NullBool{Bool: item.Private.Int64 != 0, Valid: item.Private.Valid},
-				DeletedAt:         item.DeletedAt,
-				LastIndex:         item.LastIndex,
-				Username:          item.Username,
-				Comments:          item.Comments,
+			out[i] = &SystemListSitemapNewsPostsRow{
+				Idsitenews: int32(item.Idsitenews),
+				Occurred:   item.Occurred,
 			}
 		}
 		return out
 	}(res), nil
 }
 
-func (s *sqliteQuerier) SystemListPublicWritingsInCategory(ctx context.Context, arg SystemListPublicWritingsInCategoryParams) ([]*SystemListPublicWritingsInCategoryRow, error) {
-	res, err := s.q.SystemListPublicWritingsInCategory(ctx, dbsqlite.SystemListPublicWritingsInCategoryParams{
-		CategoryID: int64(arg.CategoryID),
-		Offset:     int64(arg.Offset),
-		Limit:      int64(arg.Limit),
-	})
+func (s *sqliteQuerier) SystemListSitemapWritings(ctx context.Context) ([]*SystemListSitemapWritingsRow, error) {
+	res, err := s.q.SystemListSitemapWritings(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return func(items []*dbsqlite.SystemListPublicWritingsInCategoryRow) []*SystemListPublicWritingsInCategoryRow {
+	return func(items []*dbsqlite.SystemListSitemapWritingsRow) []*SystemListSitemapWritingsRow {
 		if items == nil {
 			return nil
 		}
-		out := make([]*SystemListPublicWritingsInCategoryRow, len(items))
+		out := make([]*SystemListSitemapWritingsRow, len(items))
 		for i, item := range items {
 			if item == nil {
 				continue
 			}
-			out[i] = &SystemListPublicWritingsInCategoryRow{
-				Idwriting:         int32(item.Idwriting),
-				UsersIdusers:      int32(item.UsersIdusers),
-				ForumthreadID:     int32(item.ForumthreadID),
-				LanguageID:        sql.NullInt32{Int32: int32(item.LanguageID.Int64), Valid: item.LanguageID.Valid},
-				WritingCategoryID: int32(item.WritingCategoryID),
-				Title:             item.Title,
-				Published:         item.Published,
-				Timezone:          item.Timezone,
-				Writing:           item.Writing,
-				Abstract:          item.Abstract,
-				Private:           sql.NullBool{Bool: item.Private.Int64 != 0, Valid: item.Private.Valid},
-				DeletedAt:         item.DeletedAt,
-				LastIndex:         item.LastIndex,
-				Username:          item.Username,
-				Comments:          item.Comments,
+			out[i] = &SystemListSitemapWritingsRow{
+				Idwriting: int32(item.Idwriting),
+				Published: item.Published,
 			}
 		}
 		return out
@@ -10580,6 +12442,29 @@ func (s *sqliteQuerier) SystemListWritingCategories(ctx context.Context, arg Sys
 	}(res), nil
 }
 
+func (s *sqliteQuerier) SystemListWritingSearchMatchesByWord(ctx context.Context, word sql.NullString) ([]*SystemListWritingSearchMatchesByWordRow, error) {
+	res, err := s.q.SystemListWritingSearchMatchesByWord(ctx, word)
+	if err != nil {
+		return nil, err
+	}
+	return func(items []*dbsqlite.SystemListWritingSearchMatchesByWordRow) []*SystemListWritingSearchMatchesByWordRow {
+		if items == nil {
+			return nil
+		}
+		out := make([]*SystemListWritingSearchMatchesByWordRow, len(items))
+		for i, item := range items {
+			if item == nil {
+				continue
+			}
+			out[i] = &SystemListWritingSearchMatchesByWordRow{
+				ID:        int32(item.ID),
+				WordCount: int32(item.WordCount),
+			}
+		}
+		return out
+	}(res), nil
+}
+
 func (s *sqliteQuerier) SystemMarkPasswordResetVerified(ctx context.Context, id int32) error {
 	return s.q.SystemMarkPasswordResetVerified(ctx, int64(id))
 }
@@ -10595,10 +12480,26 @@ func (s *sqliteQuerier) SystemMarkUserEmailVerified(ctx context.Context, arg Sys
 	})
 }
 
+func (s *sqliteQuerier) SystemPurgeActivityPubDeliveriesBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.q.SystemPurgeActivityPubDeliveriesBefore(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) SystemPurgeDeadLettersBefore(ctx context.Context, createdAt time.Time) error {
 	return s.q.SystemPurgeDeadLettersBefore(ctx, createdAt)
 }
 
+func (s *sqliteQuerier) SystemPurgeDraftsBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.q.SystemPurgeDraftsBefore(ctx, cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) SystemPurgePasswordResetsBefore(ctx context.Context, createdAt time.Time) (sql.Result, error) {
 	res, err := s.q.SystemPurgePasswordResetsBefore(ctx, createdAt)
 	if err != nil {
@@ -10615,6 +12516,13 @@ func (s *sqliteQuerier) SystemRegisterExternalLinkClick(ctx context.Context, url
 	return s.q.SystemRegisterExternalLinkClick(ctx, url)
 }
 
+func (s *sqliteQuerier) SystemSetBlogEntryWritten(ctx context.Context, arg SystemSetBlogEntryWrittenParams) error {
+	return s.q.SystemSetBlogEntryWritten(ctx, dbsqlite.SystemSetBlogEntryWrittenParams{
+		Written: arg.Written,
+		ID:      int64(arg.ID),
+	})
+}
+
 func (s *sqliteQuerier) SystemSetBlogLastIndex(ctx context.Context, id int32) error {
 	return s.q.SystemSetBlogLastIndex(ctx, int64(id))
 }
@@ -10638,6 +12546,13 @@ func (s *sqliteQuerier) SystemSetLinkerLastIndex(ctx context.Context, id int32)
 	return s.q.SystemSetLinkerLastIndex(ctx, int64(id))
 }
 
+func (s *sqliteQuerier) SystemSetNewsPostOccurred(ctx context.Context, arg SystemSetNewsPostOccurredParams) error {
+	return s.q.SystemSetNewsPostOccurred(ctx, dbsqlite.SystemSetNewsPostOccurredParams{
+		Occurred: arg.Occurred,
+		ID:       int64(arg.ID),
+	})
+}
+
 func (s *sqliteQuerier) SystemSetSiteNewsLastIndex(ctx context.Context, idsitenews int32) error {
 	return s.q.SystemSetSiteNewsLastIndex(ctx, int64(idsitenews))
 }
@@ -10646,6 +12561,32 @@ func (s *sqliteQuerier) SystemSetWritingLastIndex(ctx context.Context, idwriting
 	return s.q.SystemSetWritingLastIndex(ctx, int64(idwriting))
 }
 
+func (s *sqliteQuerier) SystemSetWritingPublished(ctx context.Context, arg SystemSetWritingPublishedParams) error {
+	return s.q.SystemSetWritingPublished(ctx, dbsqlite.SystemSetWritingPublishedParams{
+		Published: arg.Published,
+		ID:        int64(arg.ID),
+	})
+}
+
+func (s *sqliteQuerier) SystemSuppressEmail(ctx context.Context, arg SystemSuppressEmailParams) error {
+	return s.q.SystemSuppressEmail(ctx, dbsqlite.SystemSuppressEmailParams{
+		Email:  arg.Email,
+		UserID: sql.NullInt64{Int64: int64(arg.UserID.Int32), Valid: arg.UserID.Valid},
+		Reason: arg.Reason,
+		Detail: arg.Detail,
+	})
+}
+
+func (s *sqliteQuerier) SystemUpdateActivityPubDelivery(ctx context.Context, arg SystemUpdateActivityPubDeliveryParams) error {
+	return s.q.SystemUpdateActivityPubDelivery(ctx, dbsqlite.SystemUpdateActivityPubDeliveryParams{
+		Status:        arg.Status,
+		Attempts:      int64(arg.Attempts),
+		NextAttemptAt: arg.NextAttemptAt,
+		Error:         arg.Error,
+		ID:            int64(arg.ID),
+	})
+}
+
 func (s *sqliteQuerier) SystemUpdateDeadLetter(ctx context.Context, arg SystemUpdateDeadLetterParams) error {
 	return s.q.SystemUpdateDeadLetter(ctx, dbsqlite.SystemUpdateDeadLetterParams{
 		Message: arg.Message,
@@ -10661,6 +12602,25 @@ func (s *sqliteQuerier) SystemUpdateVerificationCode(ctx context.Context, arg Sy
 	})
 }
 
+func (s *sqliteQuerier) SystemUpdateWebhookDelivery(ctx context.Context, arg SystemUpdateWebhookDeliveryParams) error {
+	return s.q.SystemUpdateWebhookDelivery(ctx, dbsqlite.SystemUpdateWebhookDeliveryParams{
+		Status:         arg.Status,
+		Attempts:       int64(arg.Attempts),
+		ResponseStatus: sql.NullInt64{Int64: int64(arg.ResponseStatus.Int32), Valid: arg.ResponseStatus.Valid},
+		Error:          arg.Error,
+		ID:             int64(arg.ID),
+	})
+}
+
+func (s *sqliteQuerier) SystemUpsertActivityPubFollower(ctx context.Context, arg SystemUpsertActivityPubFollowerParams) error {
+	return s.q.SystemUpsertActivityPubFollower(ctx, dbsqlite.SystemUpsertActivityPubFollowerParams{
+		UserID:         int64(arg.UserID),
+		ActorUrl:       arg.ActorUrl,
+		InboxUrl:       arg.InboxUrl,
+		SharedInboxUrl: arg.SharedInboxUrl,
+	})
+}
+
 func (s *sqliteQuerier) TouchImageCacheEntry(ctx context.Context, arg TouchImageCacheEntryParams) error {
 	return s.q.TouchImageCacheEntry(ctx, dbsqlite.TouchImageCacheEntryParams{
 		LastUsedAt: arg.LastUsedAt,
@@ -10787,6 +12747,18 @@ func (s *sqliteQuerier) UpdateLastWeeklyDigestSentAt(ctx context.Context, arg Up
 	})
 }
 
+func (s *sqliteQuerier) UpdateMuteMentionsForLister(ctx context.Context, arg UpdateMuteMentionsForListerParams) error {
+	return s.q.UpdateMuteMentionsForLister(ctx, dbsqlite.UpdateMuteMentionsForListerParams{
+		MuteMentions: func(b bool) int64 {
+			if b {
+				return 1
+			}
+			return 0
+		}(arg.MuteMentions),
+		ListerID: int64(arg.ListerID),
+	})
+}
+
 func (s *sqliteQuerier) UpdateNewsPostForWriter(ctx context.Context, arg UpdateNewsPostForWriterParams) error {
 	return s.q.UpdateNewsPostForWriter(ctx, dbsqlite.UpdateNewsPostForWriterParams{
 		News:        arg.News,
@@ -10850,6 +12822,17 @@ func (s *sqliteQuerier) UpdateSubscriptionByIDForSubscriber(ctx context.Context,
 	})
 }
 
+func (s *sqliteQuerier) UpdateTOTPLastUsedStep(ctx context.Context, arg UpdateTOTPLastUsedStepParams) (int64, error) {
+	res, err := s.q.UpdateTOTPLastUsedStep(ctx, dbsqlite.UpdateTOTPLastUsedStepParams{
+		Step:   arg.Step,
+		UserID: int64(arg.UserID),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
+
 func (s *sqliteQuerier) UpdateTimezoneForLister(ctx context.Context, arg UpdateTimezoneForListerParams) error {
 	return s.q.UpdateTimezoneForLister(ctx, dbsqlite.UpdateTimezoneForListerParams{
 		Timezone: arg.Timezone,
@@ -10895,6 +12878,16 @@ func (s *sqliteQuerier) UpsertContentReadMarker(ctx context.Context, arg UpsertC
 	})
 }
 
+func (s *sqliteQuerier) UpsertDraftForUser(ctx context.Context, arg UpsertDraftForUserParams) error {
+	return s.q.UpsertDraftForUser(ctx, dbsqlite.UpsertDraftForUserParams{
+		UserID:    int64(arg.UserID),
+		Target:    arg.Target,
+		Url:       arg.Url,
+		Content:   arg.Content,
+		UpdatedAt: arg.UpdatedAt,
+	})
+}
+
 func (s *sqliteQuerier) UpsertImageCacheEntry(ctx context.Context, arg UpsertImageCacheEntryParams) error {
 	return s.q.UpsertImageCacheEntry(ctx, dbsqlite.UpsertImageCacheEntryParams{
 		ID:               arg.ID,
@@ -10920,6 +12913,15 @@ func (s *sqliteQuerier) UpsertImageCacheEntry(ctx context.Context, arg UpsertIma
 	})
 }
 
+func (s *sqliteQuerier) UpsertScheduledPublicationForAuthor(ctx context.Context, arg UpsertScheduledPublicationForAuthorParams) error {
+	return s.q.UpsertScheduledPublicationForAuthor(ctx, dbsqlite.UpsertScheduledPublicationForAuthorParams{
+		ItemType:  arg.ItemType,
+		ItemID:    int64(arg.ItemID),
+		AuthorID:  int64(arg.AuthorID),
+		PublishAt: arg.PublishAt,
+	})
+}
+
 func (s *sqliteQuerier) UpsertSchedulerState(ctx context.Context, arg UpsertSchedulerStateParams) error {
 	return s.q.UpsertSchedulerState(ctx, dbsqlite.UpsertSchedulerStateParams{
 		TaskName:  arg.TaskName,
@@ -10927,3 +12929,14 @@ func (s *sqliteQuerier) UpsertSchedulerState(ctx context.Context, arg UpsertSche
 		Metadata:  arg.Metadata,
 	})
 }
+
+func (s *sqliteQuerier) UseRecoveryCodeForUser(ctx context.Context, arg UseRecoveryCodeForUserParams) (int64, error) {
+	res, err := s.q.UseRecoveryCodeForUser(ctx, dbsqlite.UseRecoveryCodeForUserParams{
+		UserID:   int64(arg.UserID),
+		CodeHash: arg.CodeHash,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res, nil
+}
@@ -3,10 +3,12 @@ package dbdefaults
 import (
 	"github.com/arran4/goa4web/internal/dbdrivers"
 	"github.com/arran4/goa4web/internal/dbdrivers/mysql"
+	"github.com/arran4/goa4web/internal/dbdrivers/postgres"
 )
 
 // Register registers all stable database connectors.
 func Register(r *dbdrivers.Registry) {
 	mysql.Register(r)
+	postgres.Register(r)
 	registerOptional(r)
 }
@@ -0,0 +1,296 @@
+package dbops
+
+import (
+	"archive/zip"
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"strconv"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/upload"
+)
+
+const (
+	// ExportFormat identifies a site export archive.
+	ExportFormat = "goa4web-site-export"
+	// ExportFormatVersion is bumped when the archive layout changes.
+	ExportFormatVersion = 1
+	// ManifestFile is the archive member describing the export.
+	ManifestFile = "manifest.json"
+)
+
+// skippedTables hold migration bookkeeping, which belongs to the target
+// database rather than the site.
+var skippedTables = map[string]bool{
+	"goose_db_version": true,
+	"schema_version":   true,
+}
+
+// Manifest describes the contents of a site export archive. Each table is a
+// JSON Lines file with one object per row keyed by column name. Time values
+// are RFC 3339 strings in UTC and binary values are base64 encoded.
+type Manifest struct {
+	Format        string           `json:"format"`
+	FormatVersion int              `json:"format_version"`
+	SchemaVersion int64            `json:"schema_version"`
+	Driver        string           `json:"driver"`
+	Created       time.Time        `json:"created"`
+	Tables        []ManifestTable  `json:"tables"`
+	Uploads       []ManifestUpload `json:"uploads,omitempty"`
+}
+
+// ManifestTable describes one exported table.
+type ManifestTable struct {
+	Name    string           `json:"name"`
+	File    string           `json:"file"`
+	Rows    int64            `json:"rows"`
+	Columns []ManifestColumn `json:"columns"`
+}
+
+// ManifestColumn records the name and engine neutral kind of a column.
+type ManifestColumn struct {
+	Name string        `json:"name"`
+	Kind db.ColumnKind `json:"kind"`
+}
+
+// ManifestUpload describes an exported upload object.
+type ManifestUpload struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+	Size int64  `json:"size"`
+}
+
+// ExportOptions controls ExportSite.
+type ExportOptions struct {
+	// Driver and SchemaVersion describe the source database.
+	Driver        string
+	SchemaVersion int64
+	// Uploads is read for each object named in UploadNames. Objects that no
+	// longer exist are left out.
+	Uploads     upload.Provider
+	UploadNames []string
+	// Progress is called after each table and upload is written.
+	Progress func(name string, n int64)
+}
+
+// ExportSite writes every table readable through q, and the named upload
+// objects, to w as a zip archive.
+func ExportSite(ctx context.Context, q db.Querier, w io.Writer, opts ExportOptions) (*Manifest, error) {
+	tq, ok := q.(db.TableQueries)
+	if !ok {
+		return nil, fmt.Errorf("querier %T cannot export tables", q)
+	}
+	tables, err := tq.SystemListTables(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list tables: %w", err)
+	}
+	m := &Manifest{
+		Format:        ExportFormat,
+		FormatVersion: ExportFormatVersion,
+		SchemaVersion: opts.SchemaVersion,
+		Driver:        opts.Driver,
+		Created:       time.Now().UTC(),
+	}
+	zw := zip.NewWriter(w)
+	for _, table := range tables {
+		if skippedTables[table] {
+			continue
+		}
+		mt, err := exportTable(ctx, tq, zw, table, m.Created)
+		if err != nil {
+			return nil, fmt.Errorf("export %s: %w", table, err)
+		}
+		m.Tables = append(m.Tables, *mt)
+		if opts.Progress != nil {
+			opts.Progress(table, mt.Rows)
+		}
+	}
+	if opts.Uploads != nil {
+		for _, name := range opts.UploadNames {
+			mu, err := exportUpload(ctx, opts.Uploads, zw, name, m.Created)
+			if errors.Is(err, upload.ErrNotExist) {
+				continue
+			}
+			if err != nil {
+				return nil, fmt.Errorf("export upload %s: %w", name, err)
+			}
+			m.Uploads = append(m.Uploads, *mu)
+			if opts.Progress != nil {
+				opts.Progress(name, mu.Size)
+			}
+		}
+	}
+	mw, err := zw.CreateHeader(&zip.FileHeader{Name: ManifestFile, Method: zip.Deflate, Modified: m.Created})
+	if err != nil {
+		return nil, err
+	}
+	enc := json.NewEncoder(mw)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(m); err != nil {
+		return nil, fmt.Errorf("write manifest: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func exportTable(ctx context.Context, tq db.TableQueries, zw *zip.Writer, table string, modified time.Time) (*ManifestTable, error) {
+	columns, err := tq.SystemListTableColumns(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	mt := &ManifestTable{Name: table, File: "tables/" + table + ".jsonl"}
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.Name
+		mt.Columns = append(mt.Columns, ManifestColumn{Name: c.Name, Kind: c.Kind})
+	}
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: mt.File, Method: zip.Deflate, Modified: modified})
+	if err != nil {
+		return nil, err
+	}
+	bw := bufio.NewWriter(fw)
+	enc := json.NewEncoder(bw)
+	row := make(map[string]any, len(columns))
+	err = tq.SystemScanTable(ctx, table, names, func(values []any) error {
+		for i, c := range columns {
+			v, err := exportValue(c.Kind, values[i])
+			if err != nil {
+				return fmt.Errorf("column %s: %w", c.Name, err)
+			}
+			row[c.Name] = v
+		}
+		mt.Rows++
+		return enc.Encode(row)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mt, bw.Flush()
+}
+
+func exportUpload(ctx context.Context, p upload.Provider, zw *zip.Writer, name string, modified time.Time) (*ManifestUpload, error) {
+	if !fs.ValidPath(name) {
+		return nil, fmt.Errorf("invalid object name")
+	}
+	r, err := p.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = r.Close() }()
+	mu := &ManifestUpload{Name: name, File: "uploads/" + name}
+	fw, err := zw.CreateHeader(&zip.FileHeader{Name: mu.File, Method: zip.Store, Modified: modified})
+	if err != nil {
+		return nil, err
+	}
+	if mu.Size, err = io.Copy(fw, r); err != nil {
+		return nil, err
+	}
+	return mu, nil
+}
+
+// exportValue converts a scanned value to its JSON form for kind.
+func exportValue(kind db.ColumnKind, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if b, ok := v.([]byte); ok && kind != db.ColumnBytes {
+		v = string(b)
+	}
+	switch kind {
+	case db.ColumnInt:
+		switch n := v.(type) {
+		case int64:
+			return n, nil
+		case int32:
+			return int64(n), nil
+		case uint64:
+			return n, nil
+		case bool:
+			if n {
+				return 1, nil
+			}
+			return 0, nil
+		case string:
+			return strconv.ParseInt(n, 10, 64)
+		}
+	case db.ColumnFloat:
+		switch n := v.(type) {
+		case float64:
+			return n, nil
+		case float32:
+			return float64(n), nil
+		case int64:
+			return n, nil
+		case string:
+			if _, err := strconv.ParseFloat(n, 64); err != nil {
+				return nil, err
+			}
+			return json.Number(n), nil
+		}
+	case db.ColumnBool:
+		switch n := v.(type) {
+		case bool:
+			return n, nil
+		case int64:
+			return n != 0, nil
+		case string:
+			return strconv.ParseBool(n)
+		}
+	case db.ColumnTime:
+		switch n := v.(type) {
+		case time.Time:
+			return n.UTC().Format(time.RFC3339Nano), nil
+		case string:
+			t, err := parseTime(n)
+			if err != nil {
+				return nil, err
+			}
+			return t.UTC().Format(time.RFC3339Nano), nil
+		}
+	case db.ColumnBytes:
+		switch n := v.(type) {
+		case []byte:
+			return base64.StdEncoding.EncodeToString(n), nil
+		case string:
+			return base64.StdEncoding.EncodeToString([]byte(n)), nil
+		}
+	default:
+		switch n := v.(type) {
+		case string:
+			return n, nil
+		case int64, float64, bool:
+			return fmt.Sprint(n), nil
+		case time.Time:
+			return n.UTC().Format(time.RFC3339Nano), nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %T for %s column", v, kind)
+}
+
+// timeLayouts are the formats time columns are read back in when a driver
+// returns them as text.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	time.DateOnly,
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised time %q", s)
+}
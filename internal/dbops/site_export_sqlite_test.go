@@ -0,0 +1,99 @@
+//go:build sqlite || sqlite3
+
+package dbops_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/internal/app/dbstart"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/dbops"
+	"github.com/arran4/goa4web/migrations"
+	_ "modernc.org/sqlite"
+)
+
+func migratedSQLite(t *testing.T, name string) (*sql.DB, int64) {
+	t.Helper()
+	sdb, err := sql.Open("sqlite", filepath.Join(t.TempDir(), name))
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	t.Cleanup(func() { _ = sdb.Close() })
+	ctx := context.Background()
+	if err := dbstart.Apply(ctx, sdb, migrations.FS, false, "sqlite3"); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	version, err := dbstart.SchemaVersionWithDriver(ctx, sdb, "sqlite3")
+	if err != nil {
+		t.Fatalf("version: %v", err)
+	}
+	return sdb, int64(version)
+}
+
+func TestSQLiteSiteRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	src, version := migratedSQLite(t, "src.db")
+	written := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	if _, err := src.ExecContext(ctx, "INSERT INTO blogs (idblogs, users_idusers, blog, written) VALUES (41, 1, 'first', ?)", written); err != nil {
+		t.Fatalf("insert blog: %v", err)
+	}
+	if _, err := src.ExecContext(ctx, "INSERT INTO bookmarks (idbookmarks, users_idusers, list) VALUES (3, 1, ?)", []byte{0, 1, 2}); err != nil {
+		t.Fatalf("insert bookmarks: %v", err)
+	}
+
+	var buf bytes.Buffer
+	m, err := dbops.ExportSite(ctx, db.NewForDriver(src, "sqlite3"), &buf, dbops.ExportOptions{Driver: "sqlite3", SchemaVersion: version})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	for _, mt := range m.Tables {
+		if mt.Name == "goose_db_version" {
+			t.Fatal("migration bookkeeping exported")
+		}
+	}
+
+	dst, dstVersion := migratedSQLite(t, "dst.db")
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip: %v", err)
+	}
+	if _, err := dbops.ImportSite(ctx, dst, zr, dbops.ImportOptions{Driver: "sqlite3", SchemaVersion: dstVersion}); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+
+	var blog string
+	var gotWritten time.Time
+	if err := dst.QueryRowContext(ctx, "SELECT blog, written FROM blogs WHERE idblogs = 41").Scan(&blog, &gotWritten); err != nil {
+		t.Fatalf("select blog: %v", err)
+	}
+	if blog != "first" || !gotWritten.Equal(written) {
+		t.Errorf("blog %q written %v", blog, gotWritten)
+	}
+	var list []byte
+	if err := dst.QueryRowContext(ctx, "SELECT list FROM bookmarks WHERE idbookmarks = 3").Scan(&list); err != nil {
+		t.Fatalf("select bookmarks: %v", err)
+	}
+	if !bytes.Equal(list, []byte{0, 1, 2}) {
+		t.Errorf("list %v", list)
+	}
+	var srcRoles, dstRoles int
+	_ = src.QueryRowContext(ctx, "SELECT COUNT(*) FROM roles").Scan(&srcRoles)
+	_ = dst.QueryRowContext(ctx, "SELECT COUNT(*) FROM roles").Scan(&dstRoles)
+	if srcRoles != dstRoles {
+		t.Errorf("roles %d != %d", dstRoles, srcRoles)
+	}
+
+	res, err := dst.ExecContext(ctx, "INSERT INTO blogs (users_idusers, blog) VALUES (1, 'next')")
+	if err != nil {
+		t.Fatalf("insert after import: %v", err)
+	}
+	if id, _ := res.LastInsertId(); id != 42 {
+		t.Errorf("next id %d", id)
+	}
+}
@@ -0,0 +1,242 @@
+package dbops
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/upload"
+	"github.com/arran4/goa4web/migrations"
+)
+
+// memTables is an in-memory db.TableQueries.
+type memTables struct {
+	db.Querier
+	columns map[string][]*db.TableColumn
+	rows    map[string][][]any
+	reset   []string
+}
+
+func (m *memTables) SystemListTables(context.Context) ([]string, error) {
+	var names []string
+	for name := range m.columns {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (m *memTables) SystemListTableColumns(_ context.Context, table string) ([]*db.TableColumn, error) {
+	return m.columns[table], nil
+}
+
+func (m *memTables) SystemScanTable(_ context.Context, table string, _ []string, fn func([]any) error) error {
+	for _, row := range m.rows[table] {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *memTables) SystemDeleteTableRows(_ context.Context, table string) error {
+	delete(m.rows, table)
+	return nil
+}
+
+func (m *memTables) SystemInsertTableRow(_ context.Context, table string, _ []string, values []any) error {
+	m.rows[table] = append(m.rows[table], slices.Clone(values))
+	return nil
+}
+
+func (m *memTables) SystemResetTableSequences(_ context.Context, table string) error {
+	m.reset = append(m.reset, table)
+	return nil
+}
+
+type memUploads struct {
+	upload.Provider
+	files map[string][]byte
+}
+
+func (m *memUploads) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	b, ok := m.files[name]
+	if !ok {
+		return nil, fs.ErrNotExist
+	}
+	return io.NopCloser(bytes.NewReader(b)), nil
+}
+
+func (m *memUploads) WriteStream(_ context.Context, name string, r io.Reader) error {
+	b, err := io.ReadAll(r)
+	m.files[name] = b
+	return err
+}
+
+func TestExportImportTables(t *testing.T) {
+	written := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	src := &memTables{
+		columns: map[string][]*db.TableColumn{
+			"blogs": {
+				{Name: "idblogs", Kind: db.ColumnInt},
+				{Name: "blog", Kind: db.ColumnText},
+				{Name: "written", Kind: db.ColumnTime},
+			},
+			"bookmarks": {
+				{Name: "idbookmarks", Kind: db.ColumnInt},
+				{Name: "list", Kind: db.ColumnBytes},
+			},
+			"goose_db_version": {{Name: "version_id", Kind: db.ColumnInt}},
+		},
+		rows: map[string][][]any{
+			// MySQL returns text as bytes.
+			"blogs":            {{int64(1), []byte("hello\nworld"), written}, {int64(2), nil, written}},
+			"bookmarks":        {{int64(7), []byte{0, 1, 2, 255}}},
+			"goose_db_version": {{int64(106)}},
+		},
+	}
+	uploads := &memUploads{files: map[string][]byte{"ab/cd/image.jpg": []byte("jpeg")}}
+	var buf bytes.Buffer
+	m, err := ExportSite(context.Background(), src, &buf, ExportOptions{
+		Driver:        "mysql",
+		SchemaVersion: 106,
+		Uploads:       uploads,
+		UploadNames:   []string{"ab/cd/image.jpg", "gone.jpg"},
+	})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	if len(m.Tables) != 2 || m.Tables[0].Name != "blogs" || m.Tables[0].Rows != 2 {
+		t.Fatalf("tables %+v", m.Tables)
+	}
+	if len(m.Uploads) != 1 || m.Uploads[0].Size != 4 {
+		t.Fatalf("uploads %+v", m.Uploads)
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip: %v", err)
+	}
+	f, err := zr.Open("tables/blogs.jsonl")
+	if err != nil {
+		t.Fatalf("open table: %v", err)
+	}
+	line, _ := io.ReadAll(f)
+	if want := `{"blog":"hello\nworld","idblogs":1,"written":"2024-03-01T12:30:00Z"}`; !strings.HasPrefix(string(line), want+"\n") {
+		t.Errorf("blogs.jsonl = %s", line)
+	}
+	got, err := ReadManifest(zr)
+	if err != nil {
+		t.Fatalf("manifest: %v", err)
+	}
+
+	// Existing rows in the target are replaced.
+	dst := &memTables{
+		columns: map[string][]*db.TableColumn{
+			"blogs":     src.columns["blogs"],
+			"bookmarks": {{Name: "idbookmarks", Kind: db.ColumnInt}, {Name: "list", Kind: db.ColumnBytes}},
+		},
+		rows: map[string][][]any{"blogs": {{int64(99), "stale", written}}},
+	}
+	if err := ImportTables(context.Background(), dst, zr, got, nil); err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	wantBlogs := [][]any{{int64(1), "hello\nworld", written}, {int64(2), nil, written}}
+	if !reflect.DeepEqual(dst.rows["blogs"], wantBlogs) {
+		t.Errorf("blogs = %#v", dst.rows["blogs"])
+	}
+	if !reflect.DeepEqual(dst.rows["bookmarks"], [][]any{{int64(7), []byte{0, 1, 2, 255}}}) {
+		t.Errorf("bookmarks = %#v", dst.rows["bookmarks"])
+	}
+	if !reflect.DeepEqual(dst.reset, []string{"blogs", "bookmarks"}) {
+		t.Errorf("reset = %v", dst.reset)
+	}
+
+	out := &memUploads{files: map[string][]byte{}}
+	for _, u := range got.Uploads {
+		if err := importUpload(context.Background(), out, zr, u); err != nil {
+			t.Fatalf("upload: %v", err)
+		}
+	}
+	if string(out.files["ab/cd/image.jpg"]) != "jpeg" {
+		t.Errorf("uploads = %v", out.files)
+	}
+}
+
+func TestImportTablesUnknownColumn(t *testing.T) {
+	src := &memTables{
+		columns: map[string][]*db.TableColumn{"faq": {{Name: "id", Kind: db.ColumnInt}, {Name: "legacy", Kind: db.ColumnText}}},
+		rows:    map[string][][]any{"faq": {{int64(1), "x"}}},
+	}
+	var buf bytes.Buffer
+	m, err := ExportSite(context.Background(), src, &buf, ExportOptions{SchemaVersion: 106})
+	if err != nil {
+		t.Fatalf("export: %v", err)
+	}
+	zr, _ := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	dst := &memTables{
+		columns: map[string][]*db.TableColumn{"faq": {{Name: "id", Kind: db.ColumnInt}}},
+		rows:    map[string][][]any{"faq": {{int64(5)}}},
+	}
+	if err := ImportTables(context.Background(), dst, zr, m, nil); err == nil || !strings.Contains(err.Error(), "legacy") {
+		t.Fatalf("err = %v", err)
+	}
+	if len(dst.rows["faq"]) != 1 {
+		t.Error("rows cleared before the column check")
+	}
+}
+
+func TestImportValueAcrossEngines(t *testing.T) {
+	when := time.Date(2024, 3, 1, 12, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		from, to db.ColumnKind
+		in       any
+		want     any
+	}{
+		{"mysql tinyint to postgres bool", db.ColumnInt, db.ColumnBool, json.Number("1"), true},
+		{"postgres bool to mysql tinyint", db.ColumnBool, db.ColumnInt, false, int64(0)},
+		{"sqlite text time", db.ColumnTime, db.ColumnTime, "2024-03-01T12:30:00Z", when},
+		{"blob to text", db.ColumnBytes, db.ColumnText, "aGk=", "hi"},
+		{"float", db.ColumnFloat, db.ColumnFloat, json.Number("1.5"), 1.5},
+		{"null", db.ColumnText, db.ColumnText, nil, nil},
+	}
+	for _, tt := range tests {
+		got, err := importValue(tt.from, tt.to, tt.in)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: got %#v want %#v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := importValue(db.ColumnText, db.ColumnInt, "abc"); err == nil {
+		t.Error("expected error for non-numeric int")
+	}
+}
+
+func TestCheckSchemaVersion(t *testing.T) {
+	latest, err := migrations.LatestVersion(migrations.ForDriver("sqlite"))
+	if err != nil {
+		t.Fatalf("latest: %v", err)
+	}
+	if err := CheckSchemaVersion("sqlite", latest, latest); err != nil {
+		t.Errorf("current archive: %v", err)
+	}
+	if err := CheckSchemaVersion("sqlite", latest-1, latest); err == nil {
+		t.Error("expected error for an archive from an older schema")
+	}
+	if err := CheckSchemaVersion("sqlite", latest, latest-1); err == nil || !strings.Contains(err.Error(), "db migrate") {
+		t.Errorf("expected migrate hint, got %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package dbops
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/upload"
+	"github.com/arran4/goa4web/migrations"
+)
+
+// maxImportLine bounds a single row in an archive table file.
+const maxImportLine = 64 << 20
+
+// ImportOptions controls ImportSite.
+type ImportOptions struct {
+	// Driver names the target database driver.
+	Driver string
+	// SchemaVersion is the target database's current migration version.
+	SchemaVersion int64
+	// Uploads receives the archived upload objects when set.
+	Uploads upload.Provider
+	// Progress is called after each table and upload is loaded.
+	Progress func(name string, n int64)
+}
+
+// ReadManifest opens the manifest of an export archive and checks its format.
+func ReadManifest(zr *zip.Reader) (*Manifest, error) {
+	f, err := zr.Open(ManifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("open manifest: %w", err)
+	}
+	defer func() { _ = f.Close() }()
+	var m Manifest
+	if err := json.NewDecoder(f).Decode(&m); err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+	if m.Format != ExportFormat {
+		return nil, fmt.Errorf("not a site export: format %q", m.Format)
+	}
+	if m.FormatVersion != ExportFormatVersion {
+		return nil, fmt.Errorf("unsupported export format version %d", m.FormatVersion)
+	}
+	return &m, nil
+}
+
+// CheckSchemaVersion confirms an archive made at version can be loaded into a
+// database of driver currently at current. Both must match the latest
+// migration embedded for driver.
+func CheckSchemaVersion(driver string, version, current int64) error {
+	latest, err := migrations.LatestVersion(migrations.ForDriver(driver))
+	if err != nil {
+		return fmt.Errorf("%s migrations: %w", driver, err)
+	}
+	if version != latest {
+		return fmt.Errorf("archive schema version %d does not match migration version %d", version, latest)
+	}
+	if current != latest {
+		return fmt.Errorf("database schema version %d does not match migration version %d; run db migrate first", current, latest)
+	}
+	return nil
+}
+
+// ImportSite replaces the contents of each table in the archive with its
+// rows. The tables are loaded in a single transaction on sdb and the upload
+// objects are written once it commits.
+func ImportSite(ctx context.Context, sdb *sql.DB, zr *zip.Reader, opts ImportOptions) (*Manifest, error) {
+	m, err := ReadManifest(zr)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckSchemaVersion(opts.Driver, m.SchemaVersion, opts.SchemaVersion); err != nil {
+		return nil, err
+	}
+	tx, err := sdb.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+	if err := ImportTables(ctx, db.NewForDriver(tx, opts.Driver), zr, m, opts.Progress); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	if opts.Uploads == nil {
+		return m, nil
+	}
+	for _, u := range m.Uploads {
+		if err := importUpload(ctx, opts.Uploads, zr, u); err != nil {
+			return nil, fmt.Errorf("import upload %s: %w", u.Name, err)
+		}
+		if opts.Progress != nil {
+			opts.Progress(u.Name, u.Size)
+		}
+	}
+	return m, nil
+}
+
+// ImportTables loads the tables listed in m through q. Every table and column
+// in the archive must exist in the target.
+func ImportTables(ctx context.Context, q db.Querier, zr *zip.Reader, m *Manifest, progress func(name string, n int64)) error {
+	tq, ok := q.(db.TableQueries)
+	if !ok {
+		return fmt.Errorf("querier %T cannot import tables", q)
+	}
+	tables, err := tq.SystemListTables(ctx)
+	if err != nil {
+		return fmt.Errorf("list tables: %w", err)
+	}
+	for _, mt := range m.Tables {
+		if !slices.Contains(tables, mt.Name) {
+			return fmt.Errorf("table %s does not exist", mt.Name)
+		}
+		n, err := importTable(ctx, tq, zr, mt)
+		if err != nil {
+			return fmt.Errorf("import %s: %w", mt.Name, err)
+		}
+		if progress != nil {
+			progress(mt.Name, n)
+		}
+	}
+	return nil
+}
+
+func importTable(ctx context.Context, tq db.TableQueries, zr *zip.Reader, mt ManifestTable) (int64, error) {
+	target, err := tq.SystemListTableColumns(ctx, mt.Name)
+	if err != nil {
+		return 0, err
+	}
+	kinds := make(map[string]db.ColumnKind, len(target))
+	for _, c := range target {
+		kinds[c.Name] = c.Kind
+	}
+	names := make([]string, len(mt.Columns))
+	for i, c := range mt.Columns {
+		if _, ok := kinds[c.Name]; !ok {
+			return 0, fmt.Errorf("column %s does not exist", c.Name)
+		}
+		names[i] = c.Name
+	}
+	f, err := zr.Open(mt.File)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = f.Close() }()
+	if err := tq.SystemDeleteTableRows(ctx, mt.Name); err != nil {
+		return 0, fmt.Errorf("clear: %w", err)
+	}
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), maxImportLine)
+	values := make([]any, len(mt.Columns))
+	var n int64
+	for sc.Scan() {
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+		n++
+		row, err := decodeRow(sc.Bytes())
+		if err != nil {
+			return n, fmt.Errorf("row %d: %w", n, err)
+		}
+		for i, c := range mt.Columns {
+			if values[i], err = importValue(c.Kind, kinds[c.Name], row[c.Name]); err != nil {
+				return n, fmt.Errorf("row %d column %s: %w", n, c.Name, err)
+			}
+		}
+		if err := tq.SystemInsertTableRow(ctx, mt.Name, names, values); err != nil {
+			return n, fmt.Errorf("row %d: %w", n, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return n, err
+	}
+	if n != mt.Rows {
+		return n, fmt.Errorf("read %d rows, manifest lists %d", n, mt.Rows)
+	}
+	if err := tq.SystemResetTableSequences(ctx, mt.Name); err != nil {
+		return n, fmt.Errorf("reset sequences: %w", err)
+	}
+	return n, nil
+}
+
+func decodeRow(line []byte) (map[string]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	var row map[string]any
+	if err := dec.Decode(&row); err != nil {
+		return nil, err
+	}
+	return row, nil
+}
+
+func importUpload(ctx context.Context, p upload.Provider, zr *zip.Reader, u ManifestUpload) error {
+	if !fs.ValidPath(u.Name) {
+		return fmt.Errorf("invalid object name")
+	}
+	f, err := zr.Open(u.File)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+	return p.WriteStream(ctx, u.Name, f)
+}
+
+// importValue converts an archived value of kind from to a value for a
+// column of kind to in the target database.
+func importValue(from, to db.ColumnKind, v any) (any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if from == db.ColumnBytes {
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %T for bytes", v)
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, err
+		}
+		if to == db.ColumnBytes {
+			return b, nil
+		}
+		v = string(b)
+	}
+	switch to {
+	case db.ColumnInt:
+		switch n := v.(type) {
+		case json.Number:
+			if i, err := n.Int64(); err == nil {
+				return i, nil
+			}
+			f, err := n.Float64()
+			return int64(f), err
+		case bool:
+			if n {
+				return int64(1), nil
+			}
+			return int64(0), nil
+		case string:
+			return strconv.ParseInt(n, 10, 64)
+		}
+	case db.ColumnFloat:
+		switch n := v.(type) {
+		case json.Number:
+			return n.Float64()
+		case string:
+			return strconv.ParseFloat(n, 64)
+		}
+	case db.ColumnBool:
+		switch n := v.(type) {
+		case bool:
+			return n, nil
+		case json.Number:
+			i, err := n.Int64()
+			return i != 0, err
+		case string:
+			return strconv.ParseBool(n)
+		}
+	case db.ColumnTime:
+		if s, ok := v.(string); ok {
+			t, err := parseTime(s)
+			if err != nil {
+				return nil, err
+			}
+			return t.UTC(), nil
+		}
+	case db.ColumnBytes:
+		if s, ok := v.(string); ok {
+			return []byte(s), nil
+		}
+	default:
+		switch n := v.(type) {
+		case string:
+			return n, nil
+		case json.Number:
+			return n.String(), nil
+		case bool:
+			return strconv.FormatBool(n), nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %T for %s column", v, to)
+}
@@ -0,0 +1,9 @@
+-- name: AdminInsertRequestComment :exec
+INSERT INTO admin_request_comments (request_id, comment)
+VALUES ($1, $2);
+
+-- name: AdminListRequestComments :many
+SELECT id, request_id, comment, created_at
+FROM admin_request_comments
+WHERE request_id = $1
+ORDER BY id DESC;
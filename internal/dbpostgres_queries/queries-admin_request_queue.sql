@@ -0,0 +1,54 @@
+-- name: AdminInsertRequestQueue :one
+INSERT INTO admin_request_queue (users_idusers, change_table, change_field, change_row_id, change_value, contact_options)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: AdminListRequestQueue :many
+SELECT id, users_idusers, change_table, change_field, change_row_id, change_value, contact_options, status, created_at, acted_at
+FROM admin_request_queue
+WHERE status = 'pending'
+ORDER BY created_at ASC;
+
+-- name: AdminListRequestQueueByStatus :many
+SELECT id, users_idusers, change_table, change_field, change_row_id, change_value, contact_options, status, created_at, acted_at
+FROM admin_request_queue
+WHERE status = $1
+ORDER BY created_at ASC;
+
+-- name: AdminGetRequest :one
+SELECT id, users_idusers, change_table, change_field, change_row_id, change_value, contact_options, status, created_at, acted_at
+FROM admin_request_queue
+WHERE id = $1;
+
+-- name: AdminGetRequestByID :one
+SELECT id, users_idusers, change_table, change_field, change_row_id, change_value, contact_options, status, created_at, acted_at
+FROM admin_request_queue
+WHERE id = $1;
+
+-- name: AdminUpdateRequestStatus :exec
+UPDATE admin_request_queue
+SET status = $1, acted_at = CURRENT_TIMESTAMP
+WHERE id = $2;
+
+-- name: AdminListRequestsByUserID :many
+SELECT id, users_idusers, change_table, change_field, change_row_id, change_value, contact_options, status, created_at, acted_at
+FROM admin_request_queue
+WHERE users_idusers = $1
+ORDER BY created_at DESC;
+
+-- name: AdminUpdateRequestStatusByTableAndRow :exec
+UPDATE admin_request_queue
+SET status = $1, acted_at = CURRENT_TIMESTAMP
+WHERE change_table = $2 AND change_row_id = $3 AND status = 'pending';
+
+-- name: AdminListPendingRequests :many
+SELECT id, users_idusers, change_table, change_field, change_row_id, change_value, contact_options, status, created_at, acted_at
+FROM admin_request_queue
+WHERE status = 'pending'
+ORDER BY created_at ASC;
+
+-- name: AdminListArchivedRequests :many
+SELECT id, users_idusers, change_table, change_field, change_row_id, change_value, contact_options, status, created_at, acted_at
+FROM admin_request_queue
+WHERE status != 'pending'
+ORDER BY acted_at DESC;
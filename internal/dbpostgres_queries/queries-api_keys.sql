@@ -0,0 +1,16 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (users_idusers, api_key, name, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: GetAPIKeyByHash :one
+SELECT * FROM api_keys WHERE api_key = $1 AND revoked_at IS NULL AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP);
+
+-- name: ListAPIKeysByUser :many
+SELECT * FROM api_keys WHERE users_idusers = $1 ORDER BY created_at DESC;
+
+-- name: UpdateAPIKeyLastUsed :exec
+UPDATE api_keys SET last_used_at = CURRENT_TIMESTAMP WHERE id = $1;
+
+-- name: RevokeAPIKey :exec
+UPDATE api_keys SET revoked_at = CURRENT_TIMESTAMP WHERE id = $1 AND users_idusers = $2;
@@ -0,0 +1,26 @@
+-- name: InsertAuditLog :exec
+INSERT INTO audit_log (users_idusers, action, path, details, data) VALUES ($1, $2, $3, $4, $5);
+
+-- name: AdminListAuditLogs :many
+SELECT a.id, a.users_idusers, a.action, a.path, a.details, a.data, a.created_at, u.username
+FROM audit_log a
+LEFT JOIN users u ON a.users_idusers = idusers
+WHERE u.username ILIKE sqlc.arg(username)
+  AND a.action ILIKE sqlc.arg(action)
+  AND a.path ILIKE sqlc.arg(section)
+  AND (sqlc.narg(start_time)::timestamp IS NULL OR a.created_at >= sqlc.narg(start_time)::timestamp)
+  AND (sqlc.narg(end_time)::timestamp IS NULL OR a.created_at <= sqlc.narg(end_time)::timestamp)
+ORDER BY a.id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: AdminAuditLogActionSummary :many
+SELECT a.action, COUNT(*) AS total
+FROM audit_log a
+LEFT JOIN users u ON a.users_idusers = idusers
+WHERE u.username ILIKE sqlc.arg(username)
+  AND a.action ILIKE sqlc.arg(action)
+  AND a.path ILIKE sqlc.arg(section)
+  AND (sqlc.narg(start_time)::timestamp IS NULL OR a.created_at >= sqlc.narg(start_time)::timestamp)
+  AND (sqlc.narg(end_time)::timestamp IS NULL OR a.created_at <= sqlc.narg(end_time)::timestamp)
+GROUP BY a.action
+ORDER BY total DESC, a.action ASC;
@@ -0,0 +1,16 @@
+-- name: AdminInsertBannedIp :exec
+INSERT INTO banned_ips (ip_net, reason, expires_at)
+VALUES ($1, $2, $3);
+
+-- name: AdminUpdateBannedIp :exec
+UPDATE banned_ips SET reason = $1, expires_at = $2 WHERE id = $3;
+
+-- name: AdminCancelBannedIp :exec
+UPDATE banned_ips SET canceled_at = CURRENT_TIMESTAMP WHERE ip_net = $1 AND canceled_at IS NULL;
+
+
+-- name: ListActiveBans :many
+SELECT * FROM banned_ips WHERE canceled_at IS NULL AND (expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP);
+
+-- name: ListBannedIps :many
+SELECT * FROM banned_ips ORDER BY created_at DESC;
@@ -0,0 +1,8 @@
+-- name: AdminHardDeleteComment :exec
+DELETE FROM comments WHERE idcomments = $1;
+
+-- name: AdminDeleteCommentsByThread :exec
+DELETE FROM comments WHERE forumthread_id = $1;
+
+-- name: AdminListBadComments :many
+SELECT * FROM comments WHERE text IS NULL OR text = '';
@@ -0,0 +1,26 @@
+-- System query only used internally
+-- name: SystemInsertDeadLetter :exec
+INSERT INTO dead_letters (message) VALUES ($1);
+
+-- name: SystemListDeadLetters :many
+SELECT id, message, created_at FROM dead_letters
+ORDER BY id DESC
+LIMIT $1;
+
+-- name: SystemDeleteDeadLetter :exec
+DELETE FROM dead_letters WHERE id = $1;
+
+-- name: SystemPurgeDeadLettersBefore :exec
+DELETE FROM dead_letters WHERE created_at < $1;
+
+-- name: SystemCountDeadLetters :one
+SELECT COUNT(*) FROM dead_letters;
+
+-- name: SystemLatestDeadLetter :one
+SELECT MAX(created_at) FROM dead_letters;
+
+-- name: SystemGetDeadLetter :one
+SELECT id, message, created_at FROM dead_letters WHERE id = $1;
+
+-- name: SystemUpdateDeadLetter :exec
+UPDATE dead_letters SET message = $1 WHERE id = $2;
@@ -0,0 +1,49 @@
+-- name: SystemRegisterExternalLinkClick :exec
+INSERT INTO external_links (url, clicks)
+VALUES ($1, 1)
+ON CONFLICT (url_hash) DO UPDATE SET clicks = external_links.clicks + 1;
+
+-- name: GetExternalLink :one
+SELECT * FROM external_links WHERE url_hash = sha256(convert_to(sqlc.arg(url), 'UTF8')) LIMIT 1;
+
+-- name: GetExternalLinkByID :one
+SELECT * FROM external_links WHERE id = $1 LIMIT 1;
+
+-- name: AdminListExternalLinks :many
+SELECT * FROM external_links
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+
+
+-- name: AdminDeleteExternalLink :exec
+DELETE FROM external_links WHERE id = $1;
+
+-- name: AdminClearExternalLinkCache :exec
+UPDATE external_links SET card_image_cache = NULL, favicon_cache = NULL, updated_at = CURRENT_TIMESTAMP, updated_by = $1 WHERE id = $2;
+
+-- name: AdminDeleteExternalLinkByURL :exec
+DELETE FROM external_links WHERE url_hash = sha256(convert_to(sqlc.arg(url), 'UTF8'));
+
+-- name: UpdateExternalLinkMetadata :exec
+UPDATE external_links
+SET card_title = $1, card_description = $2, card_image = $3, card_duration = $4, card_upload_date = $5, card_author = $6, updated_at = CURRENT_TIMESTAMP
+WHERE id = $7;
+
+-- name: UpdateExternalLinkImageCache :exec
+UPDATE external_links
+SET card_image_cache = $1, updated_at = CURRENT_TIMESTAMP
+WHERE id = $2;
+
+-- name: CreateExternalLink :one
+INSERT INTO external_links (url, clicks)
+VALUES ($1, 0)
+RETURNING id;
+
+-- name: EnsureExternalLink :one
+INSERT INTO external_links (url, clicks)
+VALUES ($1, 0)
+ON CONFLICT (url_hash) DO UPDATE SET url = excluded.url
+RETURNING id;
+
+-- name: AdminGetExternalLinkByCacheID :one
+SELECT * FROM external_links WHERE card_image_cache = $1 OR favicon_cache = $2 LIMIT 1;
@@ -0,0 +1,110 @@
+-- name: GetImageCacheEntry :one
+SELECT *
+FROM image_cache_entries
+WHERE id = $1
+LIMIT 1;
+
+-- name: UpsertImageCacheEntry :exec
+INSERT INTO image_cache_entries (
+  id,
+  source_url,
+  source_kind,
+  status,
+  created_at,
+  last_used_at,
+  fetched_at,
+  expires_at,
+  content_expires_at,
+  content_type,
+  size_bytes,
+  width,
+  height,
+  checksum,
+  thumbnail_id,
+  uploaded_image_id,
+  error_message,
+  retry_count,
+  last_attempt_at,
+  next_attempt_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20)
+ON CONFLICT(source_url, source_kind) DO UPDATE SET
+  source_url = excluded.source_url,
+  source_kind = excluded.source_kind,
+  status = excluded.status,
+  last_used_at = excluded.last_used_at,
+  fetched_at = excluded.fetched_at,
+  expires_at = excluded.expires_at,
+  content_expires_at = excluded.content_expires_at,
+  content_type = excluded.content_type,
+  size_bytes = excluded.size_bytes,
+  width = excluded.width,
+  height = excluded.height,
+  checksum = excluded.checksum,
+  thumbnail_id = excluded.thumbnail_id,
+  uploaded_image_id = excluded.uploaded_image_id,
+  error_message = excluded.error_message,
+  retry_count = excluded.retry_count,
+  last_attempt_at = excluded.last_attempt_at,
+  next_attempt_at = excluded.next_attempt_at;
+
+-- name: CreatePendingImageCacheEntry :exec
+INSERT INTO image_cache_entries (
+  id,
+  source_url,
+  source_kind,
+  status,
+  created_at,
+  last_used_at,
+  retry_count,
+  next_attempt_at
+) VALUES ($1, $2, $3, 'pending', $4, $5, 0, $6)
+ON CONFLICT(source_url, source_kind) DO UPDATE SET
+  source_url = excluded.source_url,
+  source_kind = excluded.source_kind,
+  last_used_at = excluded.last_used_at,
+  next_attempt_at = excluded.next_attempt_at;
+
+-- name: RecordImageCacheFetchFailure :exec
+UPDATE image_cache_entries
+SET status = CASE WHEN retry_count + 1 >= $1 THEN 'failed' ELSE 'pending' END,
+    error_message = $2,
+    retry_count = retry_count + 1,
+    last_attempt_at = $3,
+    next_attempt_at = CASE WHEN retry_count + 1 >= $4 THEN NULL ELSE $5 END
+WHERE id = $6;
+
+-- name: TouchImageCacheEntry :exec
+UPDATE image_cache_entries
+SET last_used_at = $1
+WHERE id = $2;
+
+-- name: DeleteImageCacheEntry :exec
+DELETE FROM image_cache_entries
+WHERE id = $1;
+
+-- name: ListDuePendingImageCacheEntries :many
+SELECT *
+FROM image_cache_entries
+WHERE source_kind = 'remote'
+  AND status = 'pending'
+  AND retry_count < $1
+  AND (next_attempt_at IS NULL OR next_attempt_at <= $2)
+ORDER BY created_at ASC
+LIMIT $3;
+
+-- name: ListExpiredExternalImageCacheEntries :many
+SELECT *
+FROM image_cache_entries
+WHERE source_kind = 'remote'
+  AND status = 'ready'
+  AND expires_at IS NOT NULL
+  AND expires_at <= $1
+ORDER BY expires_at ASC
+LIMIT $2;
+
+-- name: ListOldestUsedImageCacheEntries :many
+SELECT *
+FROM image_cache_entries
+WHERE status = 'ready'
+ORDER BY COALESCE(last_used_at, created_at) ASC
+LIMIT $1;
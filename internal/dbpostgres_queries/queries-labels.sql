@@ -0,0 +1,56 @@
+-- name: AddContentPublicLabel :exec
+INSERT INTO content_public_labels (
+    item, item_id, label
+) VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveContentPublicLabel :exec
+DELETE FROM content_public_labels
+WHERE item = $1 AND item_id = $2 AND label = $3;
+
+-- name: ListContentPublicLabels :many
+SELECT item, item_id, label
+FROM content_public_labels
+WHERE item = $1 AND item_id = $2;
+
+-- name: AddContentPrivateLabel :exec
+INSERT INTO content_private_labels (
+    item, item_id, user_id, label, invert
+) VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveContentPrivateLabel :exec
+DELETE FROM content_private_labels
+WHERE item = $1 AND item_id = $2 AND user_id = $3 AND label = $4;
+
+-- name: ListContentPrivateLabels :many
+SELECT item, item_id, user_id, label, invert
+FROM content_private_labels
+WHERE item = $1 AND item_id = $2 AND user_id = $3;
+
+-- name: SystemClearContentPrivateLabel :exec
+DELETE FROM content_private_labels
+WHERE item = $1 AND item_id = $2 AND label = $3;
+
+-- name: ClearUnreadContentPrivateLabelExceptUser :exec
+DELETE FROM content_private_labels
+WHERE item = $1 AND item_id = $2 AND label = 'unread' AND invert = true AND user_id <> $3;
+
+-- name: AddContentLabelStatus :exec
+INSERT INTO content_label_status (
+    item, item_id, label
+) VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveContentLabelStatus :exec
+DELETE FROM content_label_status
+WHERE item = $1 AND item_id = $2 AND label = $3;
+
+-- name: ListContentLabelStatus :many
+SELECT item, item_id, label
+FROM content_label_status
+WHERE item = $1 AND item_id = $2;
+
+-- name: SystemClearContentLabelStatus :exec
+DELETE FROM content_label_status
+WHERE item = $1 AND item_id = $2;
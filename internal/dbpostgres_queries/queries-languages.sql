@@ -0,0 +1,51 @@
+-- AdminRenameLanguage updates the language name.
+-- Parameters:
+--   ? - New name for the language (string)
+--   ? - Language ID to be updated (int)
+-- name: AdminRenameLanguage :exec
+UPDATE language
+SET nameof = $1
+WHERE id = $2;
+
+-- AdminDeleteLanguage removes a language entry.
+-- Parameters:
+--   ? - Language ID to be deleted (int)
+-- name: AdminDeleteLanguage :exec
+DELETE FROM language
+WHERE id = $1;
+
+-- AdminCreateLanguage adds a new language.
+-- Parameters:
+--   ? - Name of the new language (string)
+-- name: AdminCreateLanguage :exec
+INSERT INTO language (nameof)
+VALUES ($1);
+
+-- AdminInsertLanguage adds a new language returning a result.
+-- name: AdminInsertLanguage :one
+INSERT INTO language (nameof)
+VALUES ($1)
+RETURNING id;
+
+-- SystemListLanguages lists all languages.
+-- name: SystemListLanguages :many
+SELECT *
+FROM language;
+
+-- SystemGetLanguageIDByName resolves a language ID by name.
+-- name: SystemGetLanguageIDByName :one
+SELECT id FROM language WHERE nameof = $1;
+
+
+-- SystemCountLanguages counts all languages.
+-- name: SystemCountLanguages :one
+SELECT COUNT(*) FROM language;
+
+-- AdminLanguageUsageCounts returns counts of content referencing a language.
+-- name: AdminLanguageUsageCounts :one
+SELECT
+    (SELECT COUNT(*) FROM comments WHERE comments.language_id = sqlc.arg(lang_id)) AS comments,
+    (SELECT COUNT(*) FROM writing WHERE writing.language_id = sqlc.arg(lang_id)) AS writings,
+    (SELECT COUNT(*) FROM blogs WHERE blogs.language_id = sqlc.arg(lang_id)) AS blogs,
+    (SELECT COUNT(*) FROM site_news WHERE site_news.language_id = sqlc.arg(lang_id)) AS news,
+    (SELECT COUNT(*) FROM linker WHERE linker.language_id = sqlc.arg(lang_id)) AS links;
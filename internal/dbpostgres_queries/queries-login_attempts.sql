@@ -0,0 +1,13 @@
+-- name: SystemInsertLoginAttempt :exec
+INSERT INTO login_attempts (username, ip_address)
+VALUES ($1, $2);
+
+-- name: AdminListLoginAttempts :many
+SELECT id, username, ip_address, created_at
+FROM login_attempts
+ORDER BY id DESC;
+
+
+-- name: SystemCountRecentLoginAttempts :one
+SELECT COUNT(*) FROM login_attempts
+WHERE (username = $1 OR ip_address = $2) AND created_at > $3;
@@ -0,0 +1,168 @@
+-- name: CreateNewsPostForWriter :one
+INSERT INTO site_news (news, users_idusers, occurred, timezone, language_id)
+SELECT sqlc.arg(news), sqlc.arg(writer_id), sqlc.arg(occurred), sqlc.arg(timezone), sqlc.arg(language_id)
+WHERE EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='news'
+      AND (g.item='post' OR g.item IS NULL)
+      AND g.action='post'
+      AND g.active=1
+      AND (g.item_id = 0 OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(grantee_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (
+          SELECT ur.role_id FROM user_roles ur WHERE ur.site_news.users_idusers = sqlc.arg(writer_id)
+      ))
+)
+RETURNING idsiteNews;
+
+-- name: UpdateNewsPostForWriter :exec
+UPDATE site_news SET news = sqlc.arg(news), language_id = sqlc.arg(language_id)
+WHERE idsitenews = sqlc.arg(post_id)
+  AND site_news.users_idusers = sqlc.arg(writer_id)
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='news'
+      AND (g.item='post' OR g.item IS NULL)
+      AND g.action='post'
+      AND g.active=1
+      AND (g.item_id = sqlc.arg(grant_post_id) OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(grantee_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (
+          SELECT ur.role_id FROM user_roles ur WHERE ur.site_news.users_idusers = sqlc.arg(writer_id)
+      ))
+  );
+
+-- name: DeactivateNewsPost :exec
+UPDATE site_news SET deleted_at = CURRENT_TIMESTAMP WHERE idsiteNews = $1;
+
+-- name: GetForumThreadIdByNewsPostId :one
+SELECT s.forumthread_id, idusers
+FROM site_news s
+LEFT JOIN users u ON users_idusers = idusers
+WHERE idsitenews = $1;
+
+-- name: SystemAssignNewsThreadID :exec
+UPDATE site_news SET forumthread_id = $1 WHERE idsiteNews = $2;
+
+-- name: SystemGetNewsPostByID :one
+SELECT forumthread_id
+FROM site_news
+WHERE idsiteNews = $1;
+
+-- name: GetNewsPostByIdWithWriterIdAndThreadCommentCount :one
+WITH role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(viewer_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT u.username AS writerName, idusers as writerId, idsitenews, s.forumthread_id, s.language_id, users_idusers, s.news, s.occurred, s.timezone, th.comments as Comments
+FROM site_news s
+LEFT JOIN users u ON users_idusers = idusers
+LEFT JOIN forumthread th ON s.forumthread_id = th.idforumthread
+WHERE idsitenews = sqlc.arg(id) AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='news'
+      AND (g.item='post' OR g.item IS NULL)
+      AND g.action='view'
+      AND g.active=1
+      AND (g.item_id = idsitenews OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+)
+LIMIT 1;
+
+
+-- name: GetNewsPostsByIdsForUserWithWriterIdAndThreadCommentCount :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(viewer_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(viewer_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT u.username AS writerName, idusers as writerId, idsitenews, s.forumthread_id, s.language_id, users_idusers, s.news, s.occurred, s.timezone, th.comments as Comments
+FROM site_news s
+LEFT JOIN users u ON users_idusers = idusers
+LEFT JOIN forumthread th ON s.forumthread_id = th.idforumthread
+WHERE s.Idsitenews = ANY(sqlc.arg(newsIds)::int[])
+  AND (
+      (SELECT COUNT(*) FROM user_lang) = 0
+      OR s.language_id = 0
+      OR s.language_id IS NULL
+      OR language_id IN (SELECT language_id FROM user_lang)
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='news'
+      AND (g.item='post' OR g.item IS NULL)
+      AND g.action='view'
+      AND g.active=1
+      AND (g.item_id = idsitenews OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+)
+ORDER BY s.occurred DESC;
+
+-- name: GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(viewer_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(viewer_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT u.username AS writerName, idusers as writerId, idsitenews, s.forumthread_id, s.language_id, users_idusers, s.news, s.occurred, s.timezone, th.comments as Comments
+FROM site_news s
+LEFT JOIN users u ON users_idusers = idusers
+LEFT JOIN forumthread th ON s.forumthread_id = th.idforumthread
+WHERE (
+    (SELECT COUNT(*) FROM user_lang) = 0
+    OR s.language_id = 0
+    OR s.language_id IS NULL
+    OR language_id IN (SELECT language_id FROM user_lang)
+)
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='news'
+      AND (g.item='post' OR g.item IS NULL)
+      AND g.action='see'
+      AND g.active=1
+      AND (g.item_id = idsitenews OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+)
+  AND (
+    sqlc.arg(show_scheduled) = true
+    OR s.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'news' AND sp.item_id = s.idsitenews
+    )
+  )
+ORDER BY s.occurred DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+
+-- name: AdminListNewsPostsWithWriterUsernameAndThreadCommentCountDescending :many
+SELECT u.username AS writerName, idusers as writerId, idsitenews, s.forumthread_id, s.language_id, users_idusers,
+s.news, s.occurred, s.timezone, th.comments as Comments
+FROM site_news s
+LEFT JOIN users u ON users_idusers = idusers
+LEFT JOIN forumthread th ON s.forumthread_id = th.idforumthread
+ORDER BY s.occurred DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+
+-- name: SystemSetSiteNewsLastIndex :exec
+UPDATE site_news SET last_index = CURRENT_TIMESTAMP WHERE idsiteNews = $1;
+
+
+-- name: GetAllSiteNewsForIndex :many
+SELECT idsiteNews, news FROM site_news
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'news' AND sp.item_id = site_news.idsiteNews);
+
+-- name: AdminReplaceSiteNewsURL :exec
+UPDATE site_news SET news = REPLACE(news, sqlc.arg(old_url), sqlc.arg(new_url)) WHERE idsiteNews = sqlc.arg(id);
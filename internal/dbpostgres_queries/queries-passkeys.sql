@@ -0,0 +1,23 @@
+-- name: InsertPasskey :exec
+INSERT INTO user_passkeys (
+    user_id, name, backup_eligible, backup_state, credential_id, public_key, attestation_type, aaguid, sign_count
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+);
+
+-- name: GetPasskeysByUserID :many
+SELECT * FROM user_passkeys WHERE user_id = $1;
+
+-- name: GetPasskeyByCredentialID :one
+SELECT * FROM user_passkeys WHERE credential_id = $1;
+
+-- name: UpdatePasskeyAfterLogin :exec
+UPDATE user_passkeys
+SET sign_count = $1,
+    backup_eligible = COALESCE(backup_eligible, $2),
+    backup_state = $3,
+    last_used_at = CURRENT_TIMESTAMP
+WHERE credential_id = $4;
+
+-- name: DeletePasskey :exec
+DELETE FROM user_passkeys WHERE id = $1 AND user_id = $2;
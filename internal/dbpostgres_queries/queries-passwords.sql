@@ -0,0 +1,15 @@
+-- name: InsertPassword :exec
+INSERT INTO passwords (users_idusers, passwd, passwd_algorithm)
+VALUES ($1, $2, $3);
+
+-- name: GetPendingPassword :one
+SELECT * FROM pending_passwords WHERE user_id = $1;
+
+-- name: GetPendingPasswordByCode :one
+SELECT * FROM pending_passwords WHERE verification_code = $1;
+
+-- name: UpdateUserPassword :exec
+INSERT INTO passwords (users_idusers, passwd, passwd_algorithm) VALUES ($1, $2, $3) ON CONFLICT(users_idusers) DO UPDATE SET passwd = excluded.passwd, passwd_algorithm = excluded.passwd_algorithm;
+
+-- name: DeletePendingPassword :exec
+DELETE FROM pending_passwords WHERE user_id = $1;
@@ -0,0 +1,156 @@
+-- name: InsertPendingEmail :exec
+INSERT INTO pending_emails (to_user_id, body, direct_email)
+VALUES ($1, $2, $3);
+
+-- name: SystemListPendingEmails :many
+SELECT id, to_user_id, body, error_count, direct_email, created_at
+FROM pending_emails
+WHERE sent_at IS NULL
+ORDER BY id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: SystemMarkPendingEmailSent :exec
+UPDATE pending_emails SET sent_at = CURRENT_TIMESTAMP WHERE id = $1;
+
+-- name: AdminListUnsentPendingEmails :many
+-- admin task
+SELECT pe.id, pe.to_user_id, pe.body, pe.error_count, pe.created_at, pe.direct_email
+FROM pending_emails pe
+LEFT JOIN preferences p ON pe.to_user_id = p.users_idusers
+LEFT JOIN user_roles ur ON pe.to_user_id = ur.users_idusers
+LEFT JOIN roles r ON ur.role_id = r.id
+WHERE pe.sent_at IS NULL
+  AND (sqlc.narg(status)::text IS NULL
+    OR (sqlc.narg(status)::text = 'pending' AND pe.error_count = 0)
+    OR (sqlc.narg(status)::text = 'failed' AND pe.error_count > 0))
+  AND (sqlc.narg(provider)::text IS NULL
+    OR (sqlc.narg(provider)::text = 'direct' AND pe.direct_email = 1)
+    OR (sqlc.narg(provider)::text = 'user' AND pe.direct_email = 0 AND pe.to_user_id IS NOT NULL AND pe.to_user_id <> 0)
+    OR (sqlc.narg(provider)::text = 'userless' AND pe.direct_email = 0 AND (pe.to_user_id IS NULL OR pe.to_user_id = 0)))
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR pe.created_at <= sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(language_id)::integer IS NULL OR p.language_id = sqlc.narg(language_id)::integer)
+  AND (sqlc.narg(role_name)::text IS NULL OR r.name = sqlc.narg(role_name)::text)
+ORDER BY pe.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: AdminCountUnsentPendingEmails :one
+-- admin task
+SELECT COUNT(*)
+FROM pending_emails pe
+LEFT JOIN preferences p ON pe.to_user_id = p.users_idusers
+LEFT JOIN user_roles ur ON pe.to_user_id = ur.users_idusers
+LEFT JOIN roles r ON ur.role_id = r.id
+WHERE pe.sent_at IS NULL
+  AND (sqlc.narg(status)::text IS NULL
+    OR (sqlc.narg(status)::text = 'pending' AND pe.error_count = 0)
+    OR (sqlc.narg(status)::text = 'failed' AND pe.error_count > 0))
+  AND (sqlc.narg(provider)::text IS NULL
+    OR (sqlc.narg(provider)::text = 'direct' AND pe.direct_email = 1)
+    OR (sqlc.narg(provider)::text = 'user' AND pe.direct_email = 0 AND pe.to_user_id IS NOT NULL AND pe.to_user_id <> 0)
+    OR (sqlc.narg(provider)::text = 'userless' AND pe.direct_email = 0 AND (pe.to_user_id IS NULL OR pe.to_user_id = 0)))
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR pe.created_at <= sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(language_id)::integer IS NULL OR p.language_id = sqlc.narg(language_id)::integer)
+  AND (sqlc.narg(role_name)::text IS NULL OR r.name = sqlc.narg(role_name)::text);
+
+-- name: AdminGetPendingEmailByID :one
+-- admin task
+SELECT id, to_user_id, body, error_count, direct_email
+FROM pending_emails
+WHERE id = $1;
+
+-- name: AdminDeletePendingEmail :exec
+-- admin task
+DELETE FROM pending_emails WHERE id = $1;
+
+-- name: SystemIncrementPendingEmailError :exec
+UPDATE pending_emails SET error_count = error_count + 1 WHERE id = $1;
+
+-- name: GetPendingEmailErrorCount :one
+SELECT error_count FROM pending_emails WHERE id = $1;
+
+-- name: AdminListSentEmails :many
+-- admin task
+SELECT pe.id, pe.to_user_id, pe.body, pe.error_count, pe.created_at, pe.sent_at, pe.direct_email
+FROM pending_emails pe
+LEFT JOIN preferences p ON pe.to_user_id = p.users_idusers
+LEFT JOIN user_roles ur ON pe.to_user_id = ur.users_idusers
+LEFT JOIN roles r ON ur.role_id = r.id
+WHERE pe.sent_at IS NOT NULL
+  AND (sqlc.narg(provider)::text IS NULL
+    OR (sqlc.narg(provider)::text = 'direct' AND pe.direct_email = 1)
+    OR (sqlc.narg(provider)::text = 'user' AND pe.direct_email = 0 AND pe.to_user_id IS NOT NULL AND pe.to_user_id <> 0)
+    OR (sqlc.narg(provider)::text = 'userless' AND pe.direct_email = 0 AND (pe.to_user_id IS NULL OR pe.to_user_id = 0)))
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR pe.sent_at <= sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(language_id)::integer IS NULL OR p.language_id = sqlc.narg(language_id)::integer)
+  AND (sqlc.narg(role_name)::text IS NULL OR r.name = sqlc.narg(role_name)::text)
+ORDER BY pe.sent_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: AdminCountSentEmails :one
+-- admin task
+SELECT COUNT(*)
+FROM pending_emails pe
+LEFT JOIN preferences p ON pe.to_user_id = p.users_idusers
+LEFT JOIN user_roles ur ON pe.to_user_id = ur.users_idusers
+LEFT JOIN roles r ON ur.role_id = r.id
+WHERE pe.sent_at IS NOT NULL
+  AND (sqlc.narg(provider)::text IS NULL
+    OR (sqlc.narg(provider)::text = 'direct' AND pe.direct_email = 1)
+    OR (sqlc.narg(provider)::text = 'user' AND pe.direct_email = 0 AND pe.to_user_id IS NOT NULL AND pe.to_user_id <> 0)
+    OR (sqlc.narg(provider)::text = 'userless' AND pe.direct_email = 0 AND (pe.to_user_id IS NULL OR pe.to_user_id = 0)))
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR pe.sent_at <= sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(language_id)::integer IS NULL OR p.language_id = sqlc.narg(language_id)::integer)
+  AND (sqlc.narg(role_name)::text IS NULL OR r.name = sqlc.narg(role_name)::text);
+
+-- name: AdminListSentEmailIDs :many
+-- admin task
+SELECT pe.id
+FROM pending_emails pe
+LEFT JOIN preferences p ON pe.to_user_id = p.users_idusers
+LEFT JOIN user_roles ur ON pe.to_user_id = ur.users_idusers
+LEFT JOIN roles r ON ur.role_id = r.id
+WHERE pe.sent_at IS NOT NULL
+  AND (sqlc.narg(provider)::text IS NULL
+    OR (sqlc.narg(provider)::text = 'direct' AND pe.direct_email = 1)
+    OR (sqlc.narg(provider)::text = 'user' AND pe.direct_email = 0 AND pe.to_user_id IS NOT NULL AND pe.to_user_id <> 0)
+    OR (sqlc.narg(provider)::text = 'userless' AND pe.direct_email = 0 AND (pe.to_user_id IS NULL OR pe.to_user_id = 0)))
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR pe.sent_at <= sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(language_id)::integer IS NULL OR p.language_id = sqlc.narg(language_id)::integer)
+  AND (sqlc.narg(role_name)::text IS NULL OR r.name = sqlc.narg(role_name)::text)
+ORDER BY pe.sent_at DESC;
+
+-- name: AdminListFailedEmails :many
+-- admin task
+SELECT pe.id, pe.to_user_id, pe.body, pe.error_count, pe.created_at, pe.direct_email
+FROM pending_emails pe
+LEFT JOIN preferences p ON pe.to_user_id = p.users_idusers
+LEFT JOIN user_roles ur ON pe.to_user_id = ur.users_idusers
+LEFT JOIN roles r ON ur.role_id = r.id
+WHERE pe.sent_at IS NULL AND pe.error_count > 0
+  AND (sqlc.narg(language_id)::integer IS NULL OR p.language_id = sqlc.narg(language_id)::integer)
+  AND (sqlc.narg(role_name)::text IS NULL OR r.name = sqlc.narg(role_name)::text)
+ORDER BY pe.id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: AdminListFailedEmailIDs :many
+-- admin task
+SELECT pe.id
+FROM pending_emails pe
+LEFT JOIN preferences p ON pe.to_user_id = p.users_idusers
+LEFT JOIN user_roles ur ON pe.to_user_id = ur.users_idusers
+LEFT JOIN roles r ON ur.role_id = r.id
+WHERE pe.sent_at IS NULL AND pe.error_count > 0
+  AND (sqlc.narg(provider)::text IS NULL
+    OR (sqlc.narg(provider)::text = 'direct' AND pe.direct_email = 1)
+    OR (sqlc.narg(provider)::text = 'user' AND pe.direct_email = 0 AND pe.to_user_id IS NOT NULL AND pe.to_user_id <> 0)
+    OR (sqlc.narg(provider)::text = 'userless' AND pe.direct_email = 0 AND (pe.to_user_id IS NULL OR pe.to_user_id = 0)))
+  AND (sqlc.narg(created_before)::timestamp IS NULL OR pe.created_at <= sqlc.narg(created_before)::timestamp)
+  AND (sqlc.narg(language_id)::integer IS NULL OR p.language_id = sqlc.narg(language_id)::integer)
+  AND (sqlc.narg(role_name)::text IS NULL OR r.name = sqlc.narg(role_name)::text)
+ORDER BY pe.id;
+
+-- name: SystemCountPendingEmailQueue :one
+SELECT COUNT(*) AS queued,
+       COUNT(CASE WHEN error_count > 0 THEN 1 END) AS failing
+FROM pending_emails
+WHERE sent_at IS NULL;
@@ -0,0 +1,38 @@
+-- name: DeleteReactionForReactor :execrows
+DELETE FROM reactions
+WHERE item_type = sqlc.arg(item_type)
+  AND item_id = sqlc.arg(item_id)
+  AND users_idusers = sqlc.arg(reactor_id)
+  AND reaction = sqlc.arg(reaction);
+
+-- name: InsertReactionForReactor :exec
+INSERT INTO reactions (item_type, item_id, users_idusers, reaction)
+VALUES (sqlc.arg(item_type), sqlc.arg(item_id), sqlc.arg(reactor_id), sqlc.arg(reaction));
+
+-- name: ListReactionCountsForViewer :many
+SELECT reaction,
+       COUNT(*) AS total,
+       COUNT(CASE WHEN users_idusers = sqlc.arg(viewer_id) THEN 1 END) AS viewer_count
+FROM reactions
+WHERE item_type = sqlc.arg(item_type)
+  AND item_id = sqlc.arg(item_id)
+GROUP BY reaction
+ORDER BY reaction;
+
+-- name: SystemGetCommentReactionTarget :one
+-- Resolves the author, forum topic and owning section item of a comment so
+-- reactions can be permission checked and linked back to the page showing it.
+SELECT c.idcomments, c.users_idusers, c.forumthread_id,
+       t.idforumtopic AS topic_id, t.handler,
+       b.idblogs AS blog_id, n.idsiteNews AS news_id, w.idwriting AS writing_id,
+       l.id AS link_id, i.imageboard_idimageboard AS board_id
+FROM comments c
+JOIN forumthread th ON th.idforumthread = c.forumthread_id
+JOIN forumtopic t ON t.idforumtopic = th.forumtopic_idforumtopic
+LEFT JOIN blogs b ON b.forumthread_id = c.forumthread_id
+LEFT JOIN site_news n ON n.forumthread_id = c.forumthread_id
+LEFT JOIN writing w ON w.forumthread_id = c.forumthread_id
+LEFT JOIN linker l ON l.thread_id = c.forumthread_id
+LEFT JOIN imagepost i ON i.forumthread_id = c.forumthread_id
+WHERE c.idcomments = sqlc.arg(id)
+LIMIT 1;
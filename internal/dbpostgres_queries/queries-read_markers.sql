@@ -0,0 +1,10 @@
+-- name: UpsertContentReadMarker :exec
+INSERT INTO content_read_markers (
+    item, item_id, user_id, last_comment_id
+) VALUES ($1, $2, $3, $4)
+ON CONFLICT(user_id, content_id, content_type) DO UPDATE SET last_comment_id = excluded.last_comment_id;
+
+-- name: GetContentReadMarker :one
+SELECT item, item_id, user_id, last_comment_id
+FROM content_read_markers
+WHERE item = $1 AND item_id = $2 AND user_id = $3;
@@ -0,0 +1,68 @@
+-- name: CreateContentReportForReporter :one
+INSERT INTO content_reports (reporter_id, item_type, item_id, author_id, reason)
+VALUES (sqlc.arg(reporter_id), sqlc.arg(item_type), sqlc.arg(item_id), sqlc.arg(author_id), sqlc.arg(reason))
+RETURNING id;
+
+-- name: CountPendingContentReportsForReporter :one
+SELECT COUNT(*)
+FROM content_reports
+WHERE reporter_id = sqlc.arg(reporter_id)
+  AND item_type = sqlc.arg(item_type)
+  AND item_id = sqlc.arg(item_id)
+  AND status = 'pending';
+
+-- name: AdminListPendingContentReports :many
+SELECT r.*, rep.username AS reporter_username, a.username AS author_username
+FROM content_reports r
+LEFT JOIN users rep ON rep.idusers = r.reporter_id
+LEFT JOIN users a ON a.idusers = r.author_id
+WHERE r.status = 'pending'
+ORDER BY r.created_at ASC, r.id ASC;
+
+-- name: AdminListResolvedContentReports :many
+SELECT r.*, rep.username AS reporter_username, a.username AS author_username
+FROM content_reports r
+LEFT JOIN users rep ON rep.idusers = r.reporter_id
+LEFT JOIN users a ON a.idusers = r.author_id
+WHERE r.status <> 'pending'
+ORDER BY r.resolved_at DESC, r.id DESC
+LIMIT $1;
+
+-- name: AdminGetContentReportByID :one
+SELECT *
+FROM content_reports
+WHERE id = sqlc.arg(id);
+
+-- name: AdminListPendingContentReportReporters :many
+SELECT DISTINCT reporter_id
+FROM content_reports
+WHERE item_type = sqlc.arg(item_type)
+  AND item_id = sqlc.arg(item_id)
+  AND status = 'pending';
+
+-- name: AdminResolveContentReportsForItem :execrows
+-- Resolves every pending report on an item so duplicate reports leave the
+-- queue together.
+UPDATE content_reports
+SET status = sqlc.arg(status),
+    resolution_note = sqlc.arg(resolution_note),
+    resolved_by = sqlc.arg(resolved_by),
+    resolved_at = CURRENT_TIMESTAMP
+WHERE item_type = sqlc.arg(item_type)
+  AND item_id = sqlc.arg(item_id)
+  AND status = 'pending';
+
+-- name: AdminRemoveLoginRolesFromUser :exec
+DELETE FROM user_roles
+WHERE users_idusers = sqlc.arg(user_id)
+  AND role_id IN (SELECT id FROM roles WHERE can_login = 1);
+
+-- name: SystemGetBlogForArchive :one
+SELECT idblogs, forumthread_id, users_idusers, language_id, blog, written, timezone
+FROM blogs
+WHERE idblogs = sqlc.arg(id);
+
+-- name: SystemGetWritingForArchive :one
+SELECT idwriting, users_idusers, forumthread_id, language_id, writing_category_id, title, published, timezone, writing, abstract, private
+FROM writing
+WHERE idwriting = sqlc.arg(id);
@@ -0,0 +1,46 @@
+-- name: InsertContentRevision :one
+INSERT INTO content_revisions (item_type, item_id, users_idusers, title, abstract, body, restored_from_id, created_at, timezone)
+VALUES (sqlc.arg(item_type), sqlc.arg(item_id), sqlc.arg(users_idusers), sqlc.narg(title), sqlc.narg(abstract), sqlc.narg(body), sqlc.narg(restored_from_id), sqlc.arg(created_at), sqlc.narg(timezone))
+RETURNING id;
+
+-- name: CountContentRevisionsByItem :one
+SELECT COUNT(*) FROM content_revisions WHERE item_type = sqlc.arg(item_type) AND item_id = sqlc.arg(item_id);
+
+-- name: ListContentRevisionsByItem :many
+SELECT r.*, u.username
+FROM content_revisions r
+LEFT JOIN users u ON u.idusers = r.users_idusers
+WHERE r.item_type = sqlc.arg(item_type) AND r.item_id = sqlc.arg(item_id)
+ORDER BY r.id DESC;
+
+-- name: GetContentRevisionForItem :one
+SELECT *
+FROM content_revisions
+WHERE id = sqlc.arg(id) AND item_type = sqlc.arg(item_type) AND item_id = sqlc.arg(item_id);
+
+-- name: SystemGetBlogForRevision :one
+SELECT idblogs, users_idusers, blog, written, timezone
+FROM blogs
+WHERE idblogs = $1;
+
+-- name: SystemGetNewsPostForRevision :one
+SELECT idsiteNews, users_idusers, news, occurred, timezone
+FROM site_news
+WHERE idsiteNews = $1;
+
+-- name: SystemGetWritingForRevision :one
+SELECT idwriting, users_idusers, title, abstract, writing, published, timezone
+FROM writing
+WHERE idwriting = $1;
+
+-- name: AdminRestoreCommentText :exec
+UPDATE comments SET text = sqlc.arg(text) WHERE idcomments = sqlc.arg(comment_id);
+
+-- name: AdminRestoreBlogText :exec
+UPDATE blogs SET blog = sqlc.arg(blog) WHERE idblogs = sqlc.arg(blog_id);
+
+-- name: AdminRestoreNewsPostText :exec
+UPDATE site_news SET news = sqlc.arg(news) WHERE idsiteNews = sqlc.arg(news_id);
+
+-- name: AdminRestoreWritingText :exec
+UPDATE writing SET title = sqlc.arg(title), abstract = sqlc.arg(abstract), writing = sqlc.arg(writing) WHERE idwriting = sqlc.arg(writing_id);
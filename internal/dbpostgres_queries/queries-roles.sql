@@ -0,0 +1,56 @@
+-- name: AdminListRoles :many
+-- admin task
+SELECT id, name, can_login, is_admin, private_labels, public_profile_allowed_at FROM roles ORDER BY id;
+
+-- name: AdminListRolesWithUsers :many
+-- admin task
+SELECT r.id, r.name, string_agg(u.username, ',') AS users
+FROM roles r
+LEFT JOIN user_roles ur ON ur.role_id = r.id
+LEFT JOIN users u ON idusers = ur.users_idusers
+GROUP BY r.id
+ORDER BY r.id;
+
+-- name: AdminUpdateRolePublicProfileAllowed :exec
+-- admin task
+UPDATE roles SET public_profile_allowed_at = $1 WHERE id = $2;
+
+-- name: AdminGetRoleByID :one
+-- admin task
+SELECT id, name, can_login, is_admin, private_labels, public_profile_allowed_at FROM roles WHERE id = $1;
+
+-- name: AdminListUsersByRoleID :many
+-- admin task
+SELECT ur.iduser_roles, idusers, u.username
+FROM users u
+JOIN user_roles ur ON ur.users_idusers = idusers
+WHERE ur.role_id = $1
+ORDER BY u.username;
+
+-- name: AdminListGrantsByRoleID :many
+-- admin task
+SELECT * FROM grants WHERE role_id = $1 ORDER BY id;
+
+-- name: AdminUpdateRole :exec
+-- admin task
+UPDATE roles SET name = $1, can_login = $2, is_admin = $3, private_labels = $4, public_profile_allowed_at = $5 WHERE id = $6;
+
+-- name: GetRoleByName :one
+SELECT id, name, can_login, is_admin, private_labels, public_profile_allowed_at FROM roles WHERE name = $1;
+
+-- name: GetGrantsByRoleID :many
+SELECT * FROM grants WHERE role_id = $1;
+
+-- name: CreateGrant :exec
+INSERT INTO grants (role_id, section, item, rule_type, item_id, item_rule, action, extra, active)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: DeleteGrantsByRoleID :exec
+DELETE FROM grants WHERE role_id = $1;
+
+-- name: DeleteGrantByProperties :exec
+DELETE FROM grants
+WHERE role_id = $1
+  AND section = $2
+  AND item = $3
+  AND action = $4;
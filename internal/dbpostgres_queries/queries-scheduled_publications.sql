@@ -0,0 +1,54 @@
+-- name: UpsertScheduledPublicationForAuthor :exec
+INSERT INTO scheduled_publications (item_type, item_id, author_id, publish_at)
+VALUES (sqlc.arg(item_type), sqlc.arg(item_id), sqlc.arg(author_id), sqlc.arg(publish_at))
+ON CONFLICT(item_type, item_id) DO UPDATE SET publish_at = excluded.publish_at;
+
+-- name: GetScheduledPublication :one
+SELECT *
+FROM scheduled_publications
+WHERE item_type = sqlc.arg(item_type)
+  AND item_id = sqlc.arg(item_id);
+
+-- name: SystemListDueScheduledPublications :many
+SELECT *
+FROM scheduled_publications
+WHERE publish_at <= sqlc.arg(now)
+ORDER BY publish_at, id
+LIMIT sqlc.arg('limit');
+
+-- name: SystemDeleteScheduledPublication :execrows
+DELETE FROM scheduled_publications
+WHERE id = sqlc.arg(id);
+
+-- name: SystemGetNewsPostForPublishing :one
+SELECT idsiteNews, forumthread_id, users_idusers, news
+FROM site_news
+WHERE idsiteNews = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
+-- name: SystemGetBlogEntryForPublishing :one
+SELECT idblogs, forumthread_id, users_idusers, blog
+FROM blogs
+WHERE idblogs = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
+-- name: SystemGetWritingForPublishing :one
+SELECT idwriting, forumthread_id, users_idusers, writing_category_id, title, abstract, writing
+FROM writing
+WHERE idwriting = sqlc.arg(id)
+  AND deleted_at IS NULL;
+
+-- name: SystemSetNewsPostOccurred :exec
+UPDATE site_news
+SET occurred = sqlc.arg(occurred)
+WHERE idsiteNews = sqlc.arg(id);
+
+-- name: SystemSetBlogEntryWritten :exec
+UPDATE blogs
+SET written = sqlc.arg(written)
+WHERE idblogs = sqlc.arg(id);
+
+-- name: SystemSetWritingPublished :exec
+UPDATE writing
+SET published = sqlc.arg(published)
+WHERE idwriting = sqlc.arg(id);
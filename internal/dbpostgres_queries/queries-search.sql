@@ -0,0 +1,553 @@
+-- name: AdminCompleteWordList :many
+-- This query selects all words from the "searchwordlist" table and prints them.
+SELECT word
+FROM searchwordlist;
+
+-- name: AdminWordListWithCounts :many
+-- Show each search word with total usage counts across all search tables.
+SELECT swl.word,
+       (SELECT COALESCE(SUM(cs.word_count),0) FROM comments_search cs WHERE cs.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ns.word_count),0) FROM site_news_search ns WHERE ns.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(bs.word_count),0) FROM blogs_search bs WHERE bs.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ls.word_count),0) FROM linker_search ls WHERE ls.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ws.word_count),0) FROM writing_search ws WHERE ws.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ips.word_count),0) FROM imagepost_search ips WHERE ips.searchwordlist_idsearchwordlist=swl.idsearchwordlist) AS count
+FROM searchwordlist swl
+ORDER BY swl.word
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: AdminCountWordList :one
+SELECT COUNT(*)
+FROM searchwordlist;
+
+-- name: AdminCountWordListByPrefix :one
+SELECT COUNT(*)
+FROM searchwordlist
+WHERE word ILIKE (sqlc.arg(prefix)::text || '%');
+
+-- name: AdminWordListWithCountsByPrefix :many
+SELECT swl.word,
+       (SELECT COALESCE(SUM(cs.word_count),0) FROM comments_search cs WHERE cs.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ns.word_count),0) FROM site_news_search ns WHERE ns.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(bs.word_count),0) FROM blogs_search bs WHERE bs.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ls.word_count),0) FROM linker_search ls WHERE ls.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ws.word_count),0) FROM writing_search ws WHERE ws.searchwordlist_idsearchwordlist=swl.idsearchwordlist)
+       + (SELECT COALESCE(SUM(ips.word_count),0) FROM imagepost_search ips WHERE ips.searchwordlist_idsearchwordlist=swl.idsearchwordlist) AS count
+FROM searchwordlist swl
+WHERE swl.word ILIKE (sqlc.arg(prefix)::text || '%')
+ORDER BY swl.word
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+
+
+
+
+
+
+-- name: SystemDeleteCommentsSearch :exec
+-- This query deletes all data from the "comments_search" table.
+DELETE FROM comments_search;
+
+
+-- name: SystemDeleteSiteNewsSearch :exec
+-- This query deletes all data from the "site_news_search" table.
+DELETE FROM site_news_search;
+
+
+-- name: SystemDeleteBlogsSearch :exec
+-- This query deletes all data from the "blogs_search" table.
+DELETE FROM blogs_search;
+
+
+-- name: SystemDeleteWritingSearch :exec
+-- This query deletes all data from the "writing_search" table.
+DELETE FROM writing_search;
+
+
+-- name: SystemDeleteLinkerSearch :exec
+-- This query deletes all data from the "linker_search" table.
+DELETE FROM linker_search;
+
+-- name: SystemGetSearchWordByWordLowercased :one
+SELECT *
+FROM searchwordlist
+WHERE word = lower($1);
+
+-- name: SystemCreateSearchWord :one
+INSERT INTO searchwordlist (word)
+VALUES (lower(sqlc.arg(word)))
+ON CONFLICT(word) DO UPDATE SET idsearchwordlist=excluded.idsearchwordlist
+RETURNING idsearchwordlist;
+
+-- name: SystemAddToForumCommentSearch :exec
+INSERT INTO comments_search
+(comment_id, searchwordlist_idsearchwordlist, word_count)
+VALUES ($1, $2, $3)
+ON CONFLICT DO UPDATE SET word_count=excluded.word_count;
+
+
+-- name: ListCommentIDsBySearchWordFirstForListerNotInRestrictedTopic :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.comment_id
+FROM comments_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist=cs.searchwordlist_idsearchwordlist
+LEFT JOIN comments c ON c.idcomments=cs.comment_id
+LEFT JOIN forumthread fth ON fth.idforumthread=c.forumthread_id
+LEFT JOIN forumtopic ft ON ft.idforumtopic=fth.forumtopic_idforumtopic
+WHERE swl.word=sqlc.arg(word)
+  AND ft.forumcategory_idforumcategory!=0
+  AND (
+      c.language_id = 0
+      OR c.language_id IS NULL
+      OR c.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE ((ft.handler = 'private' AND g.section = 'privateforum') OR (ft.handler <> 'private' AND g.section = 'forum'))
+        AND (g.item='topic' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND ((ft.handler = 'private' AND g.item_id = ft.idforumtopic) OR (ft.handler <> 'private' AND (g.item_id = ft.idforumtopic OR g.item_id IS NULL)))
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+  AND (
+      ft.handler IS NULL
+      OR ft.handler != 'private'
+      OR EXISTS (
+          SELECT 1 FROM grants thread_grant
+          WHERE thread_grant.section = 'privateforum_thread'
+            AND thread_grant.item = 'thread'
+            AND thread_grant.action = 'view'
+            AND thread_grant.active = 1
+            AND thread_grant.item_id = fth.idforumthread
+            AND (thread_grant.user_id = sqlc.arg(user_id) OR thread_grant.user_id IS NULL)
+            AND (thread_grant.role_id IS NULL OR thread_grant.role_id IN (SELECT id FROM role_ids))
+      )
+  );
+
+-- name: ListCommentIDsBySearchWordNextForListerNotInRestrictedTopic :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.comment_id
+FROM comments_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist=cs.searchwordlist_idsearchwordlist
+LEFT JOIN comments c ON c.idcomments=cs.comment_id
+LEFT JOIN forumthread fth ON fth.idforumthread=c.forumthread_id
+LEFT JOIN forumtopic ft ON ft.idforumtopic=fth.forumtopic_idforumtopic
+WHERE swl.word=sqlc.arg(word)
+  AND cs.comment_id = ANY(sqlc.arg(ids)::int[])
+  AND ft.forumcategory_idforumcategory!=0
+  AND (
+      c.language_id = 0
+      OR c.language_id IS NULL
+      OR c.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE ((ft.handler = 'private' AND g.section = 'privateforum') OR (ft.handler <> 'private' AND g.section = 'forum'))
+        AND (g.item='topic' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND ((ft.handler = 'private' AND g.item_id = ft.idforumtopic) OR (ft.handler <> 'private' AND (g.item_id = ft.idforumtopic OR g.item_id IS NULL)))
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+  AND (
+      ft.handler IS NULL
+      OR ft.handler != 'private'
+      OR EXISTS (
+          SELECT 1 FROM grants thread_grant
+          WHERE thread_grant.section = 'privateforum_thread'
+            AND thread_grant.item = 'thread'
+            AND thread_grant.action = 'view'
+            AND thread_grant.active = 1
+            AND thread_grant.item_id = fth.idforumthread
+            AND (thread_grant.user_id = sqlc.arg(user_id) OR thread_grant.user_id IS NULL)
+            AND (thread_grant.role_id IS NULL OR thread_grant.role_id IN (SELECT id FROM role_ids))
+      )
+  );
+
+-- name: ListCommentIDsBySearchWordFirstForListerInRestrictedTopic :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.comment_id
+FROM comments_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist=cs.searchwordlist_idsearchwordlist
+LEFT JOIN comments c ON c.idcomments=cs.comment_id
+LEFT JOIN forumthread fth ON fth.idforumthread=c.forumthread_id
+LEFT JOIN forumtopic ft ON ft.idforumtopic=fth.forumtopic_idforumtopic
+WHERE swl.word=sqlc.arg(word)
+  AND fth.forumtopic_idforumtopic = ANY(sqlc.arg(ftids)::int[])
+  AND (
+      c.language_id = 0
+      OR c.language_id IS NULL
+      OR c.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE ((ft.handler = 'private' AND g.section = 'privateforum') OR (ft.handler <> 'private' AND g.section = 'forum'))
+        AND (g.item='topic' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND ((ft.handler = 'private' AND g.item_id = ft.idforumtopic) OR (ft.handler <> 'private' AND (g.item_id = ft.idforumtopic OR g.item_id IS NULL)))
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+  AND (
+      ft.handler IS NULL
+      OR ft.handler != 'private'
+      OR EXISTS (
+          SELECT 1 FROM grants thread_grant
+          WHERE thread_grant.section = 'privateforum_thread'
+            AND thread_grant.item = 'thread'
+            AND thread_grant.action = 'view'
+            AND thread_grant.active = 1
+            AND thread_grant.item_id = fth.idforumthread
+            AND (thread_grant.user_id = sqlc.arg(user_id) OR thread_grant.user_id IS NULL)
+            AND (thread_grant.role_id IS NULL OR thread_grant.role_id IN (SELECT id FROM role_ids))
+      )
+  );
+
+-- name: ListCommentIDsBySearchWordNextForListerInRestrictedTopic :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.comment_id
+FROM comments_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist=cs.searchwordlist_idsearchwordlist
+LEFT JOIN comments c ON c.idcomments=cs.comment_id
+LEFT JOIN forumthread fth ON fth.idforumthread=c.forumthread_id
+LEFT JOIN forumtopic ft ON ft.idforumtopic=fth.forumtopic_idforumtopic
+WHERE swl.word=sqlc.arg(word)
+  AND cs.comment_id = ANY(sqlc.arg(ids)::int[])
+  AND fth.forumtopic_idforumtopic = ANY(sqlc.arg(ftids)::int[])
+  AND (
+      c.language_id = 0
+      OR c.language_id IS NULL
+      OR c.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE ((ft.handler = 'private' AND g.section = 'privateforum') OR (ft.handler <> 'private' AND g.section = 'forum'))
+        AND (g.item='topic' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND ((ft.handler = 'private' AND g.item_id = ft.idforumtopic) OR (ft.handler <> 'private' AND (g.item_id = ft.idforumtopic OR g.item_id IS NULL)))
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+  AND (
+      ft.handler IS NULL
+      OR ft.handler != 'private'
+      OR EXISTS (
+          SELECT 1 FROM grants thread_grant
+          WHERE thread_grant.section = 'privateforum_thread'
+            AND thread_grant.item = 'thread'
+            AND thread_grant.action = 'view'
+            AND thread_grant.active = 1
+            AND thread_grant.item_id = fth.idforumthread
+            AND (thread_grant.user_id = sqlc.arg(user_id) OR thread_grant.user_id IS NULL)
+            AND (thread_grant.role_id IS NULL OR thread_grant.role_id IN (SELECT id FROM role_ids))
+      )
+  );
+-- name: SystemAddToForumWritingSearch :exec
+INSERT INTO writing_search
+(writing_id, searchwordlist_idsearchwordlist, word_count)
+VALUES ($1, $2, $3)
+ON CONFLICT DO UPDATE SET word_count=excluded.word_count;
+
+-- name: SystemAddToLinkerSearch :exec
+INSERT INTO linker_search
+(linker_id, searchwordlist_idsearchwordlist, word_count)
+VALUES ($1, $2, $3)
+ON CONFLICT DO UPDATE SET word_count=excluded.word_count;
+-- name: SystemAddToBlogsSearch :exec
+INSERT INTO blogs_search
+(blog_id, searchwordlist_idsearchwordlist, word_count)
+VALUES ($1, $2, $3)
+ON CONFLICT DO UPDATE SET word_count=excluded.word_count;
+
+-- name: SystemAddToSiteNewsSearch :exec
+INSERT INTO site_news_search
+(site_news_id, searchwordlist_idsearchwordlist, word_count)
+VALUES ($1, $2, $3)
+ON CONFLICT DO UPDATE SET word_count=excluded.word_count;
+
+
+
+-- name: SystemDeleteWritingSearchByWritingID :exec
+DELETE FROM writing_search
+WHERE writing_id = sqlc.arg(writing_id);
+-- name: ListWritingSearchFirstForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.writing_id
+FROM writing_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist = cs.searchwordlist_idsearchwordlist
+JOIN writing w ON idwriting = cs.writing_id
+WHERE swl.word = sqlc.arg(word)
+  AND (
+      w.language_id = 0
+      OR w.language_id IS NULL
+      OR w.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE g.section='writing'
+        AND (g.item='article' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND (g.item_id = idwriting OR g.item_id IS NULL)
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  );
+
+-- name: ListWritingSearchNextForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.writing_id
+FROM writing_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist = cs.searchwordlist_idsearchwordlist
+JOIN writing w ON idwriting = cs.writing_id
+WHERE swl.word = sqlc.arg(word)
+  AND cs.writing_id = ANY(sqlc.arg(ids)::int[])
+  AND (
+      w.language_id = 0
+      OR w.language_id IS NULL
+      OR w.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE g.section='writing'
+        AND (g.item='article' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND (g.item_id = idwriting OR g.item_id IS NULL)
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  );
+
+-- name: ListSiteNewsSearchFirstForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.site_news_id
+FROM site_news_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist = cs.searchwordlist_idsearchwordlist
+JOIN site_news sn ON sn.idsiteNews = cs.site_news_id
+WHERE swl.word = sqlc.arg(word)
+  AND (
+      sn.language_id = 0
+      OR sn.language_id IS NULL
+      OR sn.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE g.section='news'
+        AND (g.item='post' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND (g.item_id = sn.idsiteNews OR g.item_id IS NULL)
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  );
+
+-- name: ListSiteNewsSearchNextForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.site_news_id
+FROM site_news_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist = cs.searchwordlist_idsearchwordlist
+JOIN site_news sn ON sn.idsiteNews = cs.site_news_id
+WHERE swl.word = sqlc.arg(word)
+  AND cs.site_news_id = ANY(sqlc.arg(ids)::int[])
+  AND (
+      sn.language_id = 0
+      OR sn.language_id IS NULL
+      OR sn.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE g.section='news'
+        AND (g.item='post' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND (g.item_id = sn.idsiteNews OR g.item_id IS NULL)
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  );
+
+
+
+-- name: LinkerSearchFirst :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.linker_id
+FROM linker_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist = cs.searchwordlist_idsearchwordlist
+JOIN linker l ON l.id = cs.linker_id
+WHERE swl.word = sqlc.arg(word)
+  AND (
+      l.language_id = 0
+      OR l.language_id IS NULL
+      OR l.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE g.section='linker'
+        AND (g.item='link' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND (g.item_id = l.id OR g.item_id IS NULL)
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  );
+
+-- name: LinkerSearchNext :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT DISTINCT cs.linker_id
+FROM linker_search cs
+LEFT JOIN searchwordlist swl ON swl.idsearchwordlist = cs.searchwordlist_idsearchwordlist
+JOIN linker l ON l.id = cs.linker_id
+WHERE swl.word = sqlc.arg(word)
+  AND cs.linker_id = ANY(sqlc.arg(ids)::int[])
+  AND (
+      l.language_id = 0
+      OR l.language_id IS NULL
+      OR l.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+      SELECT 1 FROM grants g
+      WHERE g.section='linker'
+        AND (g.item='link' OR g.item IS NULL)
+        AND g.action='see'
+        AND g.active=1
+        AND (g.item_id = l.id OR g.item_id IS NULL)
+        AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+        AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  );
+-- name: SystemAddToImagePostSearch :exec
+INSERT INTO imagepost_search
+(image_post_id, searchwordlist_idsearchwordlist, word_count)
+VALUES ($1, $2, $3)
+ON CONFLICT DO UPDATE SET word_count=excluded.word_count;
+
+-- name: SystemDeleteImagePostSearch :exec
+DELETE FROM imagepost_search;
+
+-- name: SystemDeleteCommentsSearchByCommentID :exec
+DELETE FROM comments_search
+WHERE comment_id = sqlc.arg(comment_id);
+
+-- name: SystemDeleteSiteNewsSearchBySiteNewsID :exec
+DELETE FROM site_news_search
+WHERE site_news_id = sqlc.arg(site_news_id);
+
+-- name: SystemDeleteBlogsSearchByBlogID :exec
+DELETE FROM blogs_search
+WHERE blog_id = sqlc.arg(blog_id);
+
+-- name: SystemDeleteLinkerSearchByLinkerID :exec
+DELETE FROM linker_search
+WHERE linker_id = sqlc.arg(linker_id);
+
+-- name: SystemDeleteImagePostSearchByImagePostID :exec
+DELETE FROM imagepost_search
+WHERE image_post_id = sqlc.arg(image_post_id);
+
+-- name: SystemListCommentsSearchMatchesByWord :many
+SELECT s.comment_id AS id, s.word_count
+FROM comments_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListSiteNewsSearchMatchesByWord :many
+SELECT s.site_news_id AS id, s.word_count
+FROM site_news_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListBlogsSearchMatchesByWord :many
+SELECT s.blog_id AS id, s.word_count
+FROM blogs_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListWritingSearchMatchesByWord :many
+SELECT s.writing_id AS id, s.word_count
+FROM writing_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListLinkerSearchMatchesByWord :many
+SELECT s.linker_id AS id, s.word_count
+FROM linker_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
+
+-- name: SystemListImagePostSearchMatchesByWord :many
+SELECT s.image_post_id AS id, s.word_count
+FROM imagepost_search s
+JOIN searchwordlist swl ON swl.idsearchwordlist = s.searchwordlist_idsearchwordlist
+WHERE swl.word = sqlc.arg(word);
@@ -0,0 +1,15 @@
+-- name: CreateSubscriptionArchetype :exec
+INSERT INTO role_subscription_archetypes (role_id, archetype_name, pattern, method)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetSubscriptionArchetypesByRole :many
+SELECT * FROM role_subscription_archetypes
+WHERE role_id = $1;
+
+-- name: DeleteSubscriptionArchetypesByRoleAndName :exec
+DELETE FROM role_subscription_archetypes
+WHERE role_id = $1 AND archetype_name = $2;
+
+-- name: ListSubscriptionArchetypes :many
+SELECT * FROM role_subscription_archetypes
+ORDER BY role_id, archetype_name;
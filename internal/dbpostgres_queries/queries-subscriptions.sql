@@ -0,0 +1,35 @@
+-- name: InsertSubscription :exec
+INSERT INTO subscriptions (users_idusers, pattern, method)
+VALUES ($1, $2, $3);
+
+-- name: DeleteSubscriptionForSubscriber :exec
+DELETE FROM subscriptions
+WHERE users_idusers = sqlc.arg(subscriber_id) AND pattern = sqlc.arg(pattern) AND method = sqlc.arg(method);
+
+-- name: ListSubscribersForPattern :many
+SELECT users_idusers FROM subscriptions
+WHERE pattern = $1 AND method = $2;
+
+-- name: ListSubscribersForPatterns :many
+SELECT DISTINCT users_idusers FROM subscriptions
+WHERE pattern = ANY(sqlc.arg(patterns)::text[]) AND method = sqlc.arg(method);
+
+-- name: ListSubscriptionsByUser :many
+SELECT id, pattern, method FROM subscriptions
+WHERE users_idusers = $1
+ORDER BY id;
+
+-- name: ListThreadSubscriptionsByUser :many
+SELECT id, pattern, method FROM subscriptions
+WHERE users_idusers = $1
+  AND pattern ILIKE 'reply:/forum/topic/%/thread/%'
+  AND pattern NOT ILIKE '%/topic/*/%'
+  AND pattern NOT ILIKE '%/thread/*%'
+ORDER BY id;
+
+-- name: DeleteSubscriptionByIDForSubscriber :exec
+DELETE FROM subscriptions WHERE users_idusers = sqlc.arg(subscriber_id) AND id = sqlc.arg(id);
+
+-- name: UpdateSubscriptionByIDForSubscriber :exec
+UPDATE subscriptions SET pattern = sqlc.arg(pattern), method = sqlc.arg(method)
+WHERE users_idusers = sqlc.arg(subscriber_id) AND id = sqlc.arg(id);
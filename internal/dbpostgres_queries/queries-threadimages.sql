@@ -0,0 +1,14 @@
+-- name: ListThreadImagePaths :many
+SELECT path
+FROM thread_images
+WHERE forumthread_id = sqlc.arg(thread_id)
+  AND path = ANY(sqlc.arg(paths)::text[]);
+
+-- name: CreateThreadImage :exec
+INSERT INTO thread_images (forumthread_id, path, created_at)
+SELECT sqlc.arg(thread_id), sqlc.arg(path), CURRENT_TIMESTAMP
+WHERE NOT EXISTS (
+    SELECT 1 FROM thread_images ti
+    WHERE ti.forumthread_id = sqlc.arg(thread_id)
+      AND ti.path = sqlc.arg(path)
+);
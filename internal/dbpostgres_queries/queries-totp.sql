@@ -0,0 +1,34 @@
+-- name: GetTOTPForUser :one
+SELECT * FROM user_totp WHERE users_idusers = $1;
+
+-- name: SetPendingTOTPForUser :exec
+INSERT INTO user_totp (users_idusers, secret, enabled_at, last_used_step)
+VALUES (sqlc.arg(user_id), sqlc.arg(secret), NULL, 0)
+ON CONFLICT (users_idusers) DO UPDATE SET secret = excluded.secret, enabled_at = NULL, last_used_step = 0;
+
+-- name: EnableTOTPForUser :exec
+UPDATE user_totp
+SET enabled_at = CURRENT_TIMESTAMP, last_used_step = sqlc.arg(step)
+WHERE users_idusers = sqlc.arg(user_id);
+
+-- name: UpdateTOTPLastUsedStep :execrows
+UPDATE user_totp
+SET last_used_step = sqlc.arg(step)
+WHERE users_idusers = sqlc.arg(user_id) AND last_used_step < sqlc.arg(step);
+
+-- name: DeleteTOTPForUser :exec
+DELETE FROM user_totp WHERE users_idusers = $1;
+
+-- name: InsertRecoveryCodeForUser :exec
+INSERT INTO user_recovery_codes (users_idusers, code_hash) VALUES (sqlc.arg(user_id), sqlc.arg(code_hash));
+
+-- name: UseRecoveryCodeForUser :execrows
+UPDATE user_recovery_codes
+SET used_at = CURRENT_TIMESTAMP
+WHERE users_idusers = sqlc.arg(user_id) AND code_hash = sqlc.arg(code_hash) AND used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodesForUser :one
+SELECT COUNT(*) FROM user_recovery_codes WHERE users_idusers = $1 AND used_at IS NULL;
+
+-- name: DeleteRecoveryCodesForUser :exec
+DELETE FROM user_recovery_codes WHERE users_idusers = $1;
@@ -0,0 +1,135 @@
+-- name: InsertUserEmail :exec
+INSERT INTO user_emails (user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListUserEmailsForLister :many
+WITH role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT ue.id, ue.user_id, ue.email, ue.verified_at, ue.last_verification_code, ue.verification_expires_at, ue.notification_priority
+FROM user_emails ue
+WHERE ue.user_id = sqlc.arg(user_id)
+  AND (
+      sqlc.arg(lister_id) = ue.user_id
+      OR EXISTS (
+          SELECT 1
+          FROM role_ids ri
+          JOIN roles r ON r.id = ri.id
+          WHERE r.is_admin = 1
+      )
+  );
+
+-- name: AdminListUserEmails :many
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY notification_priority DESC, id;
+
+-- name: SystemListVerifiedEmailsByUserID :many
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE user_id = sqlc.arg(user_id) AND verified_at IS NOT NULL
+ORDER BY notification_priority DESC, id;
+
+-- name: SystemListAllUserEmails :many
+SELECT user_id, email, verified_at
+FROM user_emails
+ORDER BY user_id, email;
+
+-- name: GetUserEmailByEmail :one
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE email = $1;
+
+-- name: GetUserEmailByID :one
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE id = $1;
+
+-- name: SystemMarkUserEmailVerified :exec
+UPDATE user_emails
+SET verified_at = $1, last_verification_code = NULL, verification_expires_at = NULL
+WHERE id = $2;
+
+
+-- name: SetNotificationPriorityForLister :exec
+UPDATE user_emails SET notification_priority = sqlc.arg(notification_priority)
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(lister_id);
+
+-- name: GetNotificationEmailByUserID :one
+-- Suppressed addresses are skipped so mail fails over to the next address.
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE user_id = $1 AND verified_at IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM email_suppressions es WHERE es.email = user_emails.email)
+ORDER BY notification_priority DESC, id
+LIMIT 1;
+
+-- name: DeleteUserEmailForOwner :exec
+DELETE FROM user_emails WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(owner_id);
+
+-- name: SetVerificationCodeForLister :exec
+UPDATE user_emails
+SET last_verification_code = sqlc.arg(last_verification_code),
+    verification_expires_at = sqlc.arg(verification_expires_at)
+WHERE id = sqlc.arg(id) AND user_id = sqlc.arg(lister_id);
+
+-- name: GetUserEmailByCode :one
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE last_verification_code = $1;
+
+-- name: GetMaxNotificationPriority :one
+SELECT COALESCE(MAX(notification_priority),0) AS maxp FROM user_emails WHERE user_id = $1;
+
+-- name: SystemDeleteUserEmailsByEmailExceptID :exec
+DELETE FROM user_emails WHERE email = sqlc.arg(email) AND id != sqlc.arg(id);
+
+-- name: SystemListUnverifiedEmailsCreatedAfter :many
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE verified_at IS NULL
+  AND verification_expires_at > $1
+ORDER BY id;
+
+-- name: SystemListAllUnverifiedEmails :many
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE verified_at IS NULL
+ORDER BY id;
+
+-- name: SystemDeleteUnverifiedEmailsExpiresBefore :execresult
+DELETE FROM user_emails
+WHERE verified_at IS NULL
+  AND verification_expires_at < $1;
+
+-- name: SystemListUnverifiedEmailsExpiresBefore :many
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE verified_at IS NULL
+  AND verification_expires_at < $1
+ORDER BY id;
+
+-- name: SystemUpdateVerificationCode :exec
+UPDATE user_emails
+SET last_verification_code = $1, verification_expires_at = $2
+WHERE id = $3;
+
+-- name: AdminAddUserEmail :exec
+INSERT INTO user_emails (user_id, email, verified_at, notification_priority)
+VALUES ($1, $2, $3, $4);
+
+-- name: AdminDeleteUserEmail :exec
+DELETE FROM user_emails WHERE id = $1;
+
+-- name: AdminUpdateUserEmailDetails :exec
+UPDATE user_emails
+SET email = $1, verified_at = $2, notification_priority = $3
+WHERE id = $4;
+
+-- name: AdminGetUserEmailByID :one
+SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
+FROM user_emails
+WHERE id = $1;
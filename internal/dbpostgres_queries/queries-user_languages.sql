@@ -0,0 +1,11 @@
+-- name: GetUserLanguages :many
+SELECT iduserlang, users_idusers, language_id
+FROM user_language
+WHERE users_idusers = $1;
+
+-- name: DeleteUserLanguagesForUser :exec
+DELETE FROM user_language WHERE users_idusers = $1;
+
+-- name: InsertUserLang :exec
+INSERT INTO user_language (users_idusers, language_id)
+VALUES ($1, $2);
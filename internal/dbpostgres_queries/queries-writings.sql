@@ -0,0 +1,381 @@
+-- name: GetPublicWritings :many
+SELECT w.*
+FROM writing w
+WHERE w.private = 0
+AND (
+    sqlc.arg(show_scheduled) = true
+    OR w.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+)
+ORDER BY w.published DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset')
+;
+
+-- name: SystemListPublicWritingsByAuthor :many
+SELECT w.*, u.username,
+    (SELECT COUNT(*) FROM comments c WHERE c.forumthread_id=w.forumthread_id AND w.forumthread_id IS NOT NULL) AS Comments
+FROM writing w
+LEFT JOIN users u ON users_idusers = idusers
+WHERE w.private = 0 AND writing.users_idusers = sqlc.arg(author_id)
+ORDER BY w.published DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: SystemGetWritingByID :one
+SELECT forumthread_id
+FROM writing
+WHERE idwriting = $1;
+
+-- name: ListPublicWritingsByUserForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT w.*, u.username,
+    (SELECT COUNT(*) FROM comments c WHERE c.forumthread_id=w.forumthread_id AND w.forumthread_id IS NOT NULL) AS Comments
+FROM writing w
+LEFT JOIN users u ON users_idusers = idusers
+WHERE w.private = 0 AND writing.users_idusers = sqlc.arg(author_id)
+  AND (
+    w.language_id = 0
+    OR w.language_id IS NULL
+    OR w.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='article' OR g.item IS NULL)
+      AND g.action='see'
+      AND g.active=1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+  AND (
+    sqlc.arg(show_scheduled) = true
+    OR w.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
+ORDER BY w.published DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: SystemListPublicWritingsInCategory :many
+SELECT w.*, u.Username,
+    (SELECT COUNT(*) FROM comments c WHERE c.forumthread_id=w.forumthread_id AND w.forumthread_id IS NOT NULL) as Comments
+FROM writing w
+LEFT JOIN users u ON w.Users_Idusers = idusers
+WHERE w.private = 0 AND w.writing_category_id = sqlc.arg(category_id)
+ORDER BY w.published DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListPublicWritingsInCategoryForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT w.*, u.Username,
+    (SELECT COUNT(*) FROM comments c WHERE c.forumthread_id=w.forumthread_id AND w.forumthread_id IS NOT NULL) as Comments
+FROM writing w
+LEFT JOIN users u ON w.Users_Idusers=idusers
+WHERE w.private = 0 AND w.writing_category_id = sqlc.arg(writing_category_id)
+  AND (
+    w.language_id = 0
+    OR w.language_id IS NULL
+    OR w.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='article' OR g.item IS NULL)
+      AND g.action='see'
+      AND g.active=1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+  AND (
+    sqlc.arg(show_scheduled) = true
+    OR w.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
+ORDER BY w.published DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset')
+;
+
+-- name: UpdateWritingForWriter :exec
+UPDATE writing SET title = sqlc.arg(title),
+    abstract = sqlc.arg(abstract),
+    writing = sqlc.arg(content),
+    private = sqlc.arg(private),
+    language_id = sqlc.arg(language_id)
+WHERE idwriting = sqlc.arg(writing_id)
+  AND writing.users_idusers = sqlc.arg(writer_id)
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='article' OR g.item IS NULL)
+      AND g.action='post'
+      AND g.active=1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(grantee_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (
+          SELECT ur.role_id FROM user_roles ur WHERE ur.writing.users_idusers = sqlc.arg(writer_id)
+      ))
+  );
+
+-- name: InsertWriting :one
+INSERT INTO writing (writing_category_id, title, abstract, writing, private, language_id, published, users_idusers)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING idwriting;
+
+-- name: CreateWritingForWriter :one
+INSERT INTO writing (writing_category_id, title, abstract, writing, private, language_id, published, timezone, users_idusers)
+SELECT sqlc.arg(writing_category_id), sqlc.arg(title), sqlc.arg(abstract), sqlc.arg(writing), sqlc.arg(private), sqlc.arg(language_id), sqlc.arg(published), sqlc.arg(timezone), sqlc.arg(writer_id)
+WHERE EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='category' OR g.item IS NULL)
+      AND g.action='post'
+      AND g.active=1
+      AND (g.item_id = sqlc.arg(grant_category_id) OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(grantee_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (
+          SELECT ur.role_id FROM user_roles ur WHERE ur.writing.users_idusers = sqlc.arg(writer_id)
+      ))
+  )
+RETURNING idwriting;
+
+-- name: GetWritingForListerByID :one
+WITH role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT w.*, idusers AS WriterId, u.Username AS WriterUsername
+FROM writing w
+JOIN users u ON users_idusers = idusers
+WHERE idwriting = sqlc.arg(idwriting)
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='article' OR g.item IS NULL)
+      AND g.action='view'
+      AND g.active=1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(lister_match_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+ORDER BY w.published DESC
+;
+
+-- name: ListWritingsByIDsForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT w.*, idusers AS WriterId, u.username AS WriterUsername
+FROM writing w
+JOIN users u ON users_idusers = idusers
+WHERE idwriting = ANY(sqlc.arg(writing_ids)::int[])
+  AND (
+    w.language_id = 0
+    OR w.language_id IS NULL
+    OR w.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='article' OR g.item IS NULL)
+      AND g.action='view'
+      AND g.active=1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(lister_match_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+ORDER BY w.published DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: AdminInsertWritingCategory :exec
+INSERT INTO writing_category (writing_category_id, title, description)
+VALUES ($1, $2, $3);
+
+-- name: AdminUpdateWritingCategory :exec
+UPDATE writing_category
+SET title = $1, description = $2, writing_category_id = $3
+WHERE idwritingCategory = $4;
+
+-- name: SystemListWritingCategories :many
+SELECT wc.*
+FROM writing_category wc
+ORDER BY wc.idwritingcategory
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListWritingCategoriesForLister :many
+WITH role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT wc.*
+FROM writing_category wc
+WHERE EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='category' OR g.item IS NULL)
+      AND g.action='see'
+      AND g.active=1
+      AND (g.item_id = wc.idwritingcategory OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(lister_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+);
+
+
+-- name: SystemAssignWritingThreadID :exec
+UPDATE writing SET forumthread_id = $1 WHERE idwriting = $2;
+
+
+-- name: GetAllWritingsByAuthorForLister :many
+WITH role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT w.*, u.username,
+    (SELECT COUNT(*) FROM comments c WHERE c.forumthread_id=w.forumthread_id AND w.forumthread_id IS NOT NULL) AS Comments
+FROM writing w
+LEFT JOIN users u ON users_idusers = idusers
+WHERE writing.users_idusers = sqlc.arg(author_id)
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section='writing'
+      AND (g.item='article' OR g.item IS NULL)
+      AND g.action='view'
+      AND g.active=1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(lister_match_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+ORDER BY w.published DESC;
+
+-- name: AdminGetAllWritingsByAuthor :many
+SELECT w.*, u.username,
+    (SELECT COUNT(*) FROM comments c WHERE c.forumthread_id=w.forumthread_id AND w.forumthread_id IS NOT NULL) AS Comments
+FROM writing w
+LEFT JOIN users u ON users_idusers = idusers
+WHERE writing.users_idusers = sqlc.arg(author_id)
+ORDER BY w.published DESC;
+-- name: ListWritersForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT u.username, COUNT(idwriting) AS count
+FROM writing w
+JOIN users u ON users_idusers = idusers
+WHERE (
+    w.language_id = 0
+    OR w.language_id IS NULL
+    OR w.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+)
+AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'writing'
+      AND (g.item = 'article' OR g.item IS NULL)
+      AND g.action = 'see'
+      AND g.active = 1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+)
+GROUP BY idusers
+ORDER BY u.username
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListWritersSearchForLister :many
+WITH user_lang AS (
+    SELECT ul.language_id FROM user_language ul WHERE ul.users_idusers = sqlc.arg(lister_id)
+),
+role_ids AS (
+    SELECT DISTINCT ur.role_id AS id FROM user_roles ur WHERE ur.users_idusers = sqlc.arg(lister_id)
+    UNION
+    SELECT id FROM roles WHERE name = 'anyone'
+)
+SELECT u.username, COUNT(idwriting) AS count
+FROM writing w
+JOIN users u ON users_idusers = idusers
+WHERE (LOWER(u.username) ILIKE LOWER(sqlc.arg(query)) OR LOWER((SELECT email FROM user_emails ue WHERE ue.user_id = idusers AND ue.verified_at IS NOT NULL ORDER BY ue.notification_priority DESC, ue.id LIMIT 1)) ILIKE LOWER(sqlc.arg(query)))
+  AND (
+    w.language_id = 0
+    OR w.language_id IS NULL
+    OR w.language_id IN (SELECT language_id FROM user_lang) OR (SELECT COUNT(*) FROM user_lang) = 0
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'writing'
+      AND (g.item = 'article' OR g.item IS NULL)
+      AND g.action = 'see'
+      AND g.active = 1
+      AND (g.item_id = idwriting OR g.item_id IS NULL)
+      AND (g.user_id = sqlc.arg(user_id) OR g.user_id IS NULL)
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
+  )
+GROUP BY idusers
+ORDER BY u.username
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: SystemSetWritingLastIndex :exec
+UPDATE writing SET last_index = CURRENT_TIMESTAMP WHERE idwriting = $1;
+
+
+-- name: GetAllWritingsForIndex :many
+SELECT idwriting, title, abstract, writing FROM writing
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'writing' AND sp.item_id = writing.idwriting);
+
+-- name: GetWritingCategoryById :one
+SELECT * FROM writing_category WHERE idwritingCategory = $1;
+
+-- name: AdminGetWritingsByCategoryId :many
+SELECT w.*, u.username
+FROM writing w
+LEFT JOIN users u ON users_idusers = idusers
+WHERE w.writing_category_id = $1
+ORDER BY w.published DESC;
+
+-- name: ListWritingcategoryPath :many
+WITH RECURSIVE category_path AS (
+    SELECT wc.idwritingcategory, wc.writing_category_id AS parent_id, wc.title, 0 AS depth
+    FROM writing_category wc
+    WHERE wc.idwritingcategory = sqlc.arg(category_id)
+    UNION ALL
+    SELECT c.idwritingcategory, c.writing_category_id, c.title, p.depth + 1
+    FROM writing_category c
+    JOIN category_path p ON p.parent_id = c.idwritingcategory
+)
+SELECT category_path.idwritingcategory, category_path.title
+FROM category_path
+ORDER BY category_path.depth DESC;
@@ -9,6 +9,44 @@ import (
 	"time"
 )
 
+type ActivitypubActivity struct {
+	ID           int64
+	UserID       int64
+	ActivityType string
+	ObjectUrl    string
+	ObjectJson   string
+	Published    time.Time
+}
+
+type ActivitypubActorKey struct {
+	UserID        int64
+	PublicKeyPem  string
+	PrivateKeyPem string
+	CreatedAt     time.Time
+}
+
+type ActivitypubDelivery struct {
+	ID            int64
+	UserID        int64
+	InboxUrl      string
+	Payload       string
+	Status        string
+	Attempts      int64
+	NextAttemptAt time.Time
+	Error         sql.NullString
+	CreatedAt     time.Time
+	UpdatedAt     sql.NullTime
+}
+
+type ActivitypubFollower struct {
+	ID             int64
+	UserID         int64
+	ActorUrl       string
+	InboxUrl       string
+	SharedInboxUrl sql.NullString
+	CreatedAt      time.Time
+}
+
 type AdminRequestComment struct {
 	ID        int64
 	RequestID int64
@@ -140,6 +178,33 @@ type ContentReadMarker struct {
 	LastCommentID int64
 }
 
+type ContentReport struct {
+	ID             int64
+	ReporterID     int64
+	ItemType       string
+	ItemID         int64
+	AuthorID       int64
+	Reason         string
+	Status         string
+	ResolutionNote sql.NullString
+	ResolvedBy     sql.NullInt64
+	CreatedAt      time.Time
+	ResolvedAt     sql.NullTime
+}
+
+type ContentRevision struct {
+	ID             int64
+	ItemType       string
+	ItemID         int64
+	UsersIdusers   int64
+	Title          sql.NullString
+	Abstract       sql.NullString
+	Body           sql.NullString
+	RestoredFromID sql.NullInt64
+	CreatedAt      time.Time
+	Timezone       sql.NullString
+}
+
 type DeactivatedBlog struct {
 	Idblogs       int64
 	ForumthreadID int64
@@ -227,6 +292,33 @@ type DeadLetter struct {
 	CreatedAt time.Time
 }
 
+type Draft struct {
+	ID        int64
+	UserID    int64
+	Target    string
+	Url       string
+	Content   string
+	UpdatedAt time.Time
+}
+
+type EmailBounce struct {
+	ID          int64
+	UserEmailID int64
+	Kind        string
+	Source      string
+	Detail      sql.NullString
+	CreatedAt   time.Time
+}
+
+type EmailSuppression struct {
+	ID        int64
+	Email     string
+	UserID    sql.NullInt64
+	Reason    string
+	Detail    sql.NullString
+	CreatedAt time.Time
+}
+
 type ExternalLink struct {
 	ID              int64
 	Url             string
@@ -277,6 +369,34 @@ type FaqRevision struct {
 	Timezone     sql.NullString
 }
 
+type ForumPoll struct {
+	ID                int64
+	ForumthreadID     int64
+	Question          string
+	MultipleChoice    bool
+	Anonymous         bool
+	ResultsVisibility string
+	ClosesAt          sql.NullTime
+	ClosedAt          sql.NullTime
+	CreatedBy         int64
+	CreatedAt         time.Time
+}
+
+type ForumPollOption struct {
+	ID       int64
+	PollID   int64
+	Position int64
+	Label    string
+}
+
+type ForumPollVote struct {
+	ID           int64
+	PollID       int64
+	OptionID     int64
+	UsersIdusers int64
+	CreatedAt    time.Time
+}
+
 type Forumcategory struct {
 	Idforumcategory              int64
 	ForumcategoryIdforumcategory int64
@@ -497,6 +617,16 @@ type Preference struct {
 	MonthlyDigestHour       sql.NullInt64
 	LastMonthlyDigestSentAt sql.NullTime
 	ImageSafeDimension      sql.NullString
+	MuteMentions            int64
+}
+
+type Reaction struct {
+	ID           int64
+	ItemType     string
+	ItemID       int64
+	UsersIdusers int64
+	Reaction     string
+	CreatedAt    time.Time
 }
 
 type Role struct {
@@ -517,6 +647,15 @@ type RoleSubscriptionArchetype struct {
 	CreatedAt     time.Time
 }
 
+type ScheduledPublication struct {
+	ID        int64
+	ItemType  string
+	ItemID    int64
+	AuthorID  int64
+	PublishAt time.Time
+	CreatedAt time.Time
+}
+
 type SchedulerState struct {
 	TaskName  string
 	LastRunAt sql.NullTime
@@ -635,12 +774,52 @@ type UserPasskey struct {
 	ExpiresAt       sql.NullTime
 }
 
+type UserRecoveryCode struct {
+	ID           int64
+	UsersIdusers int64
+	CodeHash     string
+	UsedAt       sql.NullTime
+	CreatedAt    time.Time
+}
+
 type UserRole struct {
 	IduserRoles  int64
 	UsersIdusers int64
 	RoleID       int64
 }
 
+type UserTotp struct {
+	UsersIdusers int64
+	Secret       string
+	EnabledAt    sql.NullTime
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+type Webhook struct {
+	ID        int64
+	Name      string
+	Url       string
+	Secret    string
+	Patterns  string
+	Active    int64
+	CreatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	Event          string
+	Path           string
+	Payload        string
+	Status         string
+	Attempts       int64
+	ResponseStatus sql.NullInt64
+	Error          sql.NullString
+	CreatedAt      time.Time
+	UpdatedAt      sql.NullTime
+}
+
 type Writing struct {
 	Idwriting         int64
 	UsersIdusers      int64
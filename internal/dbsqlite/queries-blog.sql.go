@@ -254,19 +254,29 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    ?9 = true
+    OR b.users_idusers = ?10
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT ?8 OFFSET ?7
 `
 
 type ListBlogEntriesByAuthorForListerParams struct {
-	UsersIdusers   int64
-	ListerID       int64
-	AuthorID       int64
-	UsersIdusers_2 int64
-	IsAdmin        interface{}
-	UserID         sql.NullInt64
-	Limit          int64
-	Offset         int64
+	UsersIdusers      int64
+	ListerID          int64
+	AuthorID          int64
+	UsersIdusers_2    int64
+	IsAdmin           interface{}
+	UserID            sql.NullInt64
+	Limit             int64
+	Offset            int64
+	ShowScheduled     interface{}
+	ScheduledViewerID int64
 }
 
 type ListBlogEntriesByAuthorForListerRow struct {
@@ -293,6 +303,8 @@ func (q *Queries) ListBlogEntriesByAuthorForLister(ctx context.Context, arg List
 		arg.UserID,
 		arg.Limit,
 		arg.Offset,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 	)
 	if err != nil {
 		return nil, err
@@ -471,18 +483,28 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    ?8 = true
+    OR b.users_idusers = ?9
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT ?7 OFFSET ?6
 `
 
 type ListBlogEntriesForListerParams struct {
-	UsersIdusers   int64
-	ListerID       int64
-	UsersIdusers_2 int64
-	IsAdmin        interface{}
-	UserID         sql.NullInt64
-	Limit          int64
-	Offset         int64
+	UsersIdusers      int64
+	ListerID          int64
+	UsersIdusers_2    int64
+	IsAdmin           interface{}
+	UserID            sql.NullInt64
+	Limit             int64
+	Offset            int64
+	ShowScheduled     interface{}
+	ScheduledViewerID int64
 }
 
 type ListBlogEntriesForListerRow struct {
@@ -507,6 +529,8 @@ func (q *Queries) ListBlogEntriesForLister(ctx context.Context, arg ListBlogEntr
 		arg.UserID,
 		arg.Limit,
 		arg.Offset,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 	)
 	if err != nil {
 		return nil, err
@@ -887,7 +911,9 @@ func (q *Queries) SystemAssignBlogEntryThreadID(ctx context.Context, arg SystemA
 }
 
 const systemGetAllBlogsForIndex = `-- name: SystemGetAllBlogsForIndex :many
-SELECT idblogs, blog FROM blogs WHERE deleted_at IS NULL
+SELECT idblogs, blog FROM blogs
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'blog' AND sp.item_id = blogs.idblogs)
 `
 
 type SystemGetAllBlogsForIndexRow struct {
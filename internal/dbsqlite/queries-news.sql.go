@@ -139,7 +139,9 @@ func (q *Queries) DeactivateNewsPost(ctx context.Context, idsitenews int64) erro
 }
 
 const getAllSiteNewsForIndex = `-- name: GetAllSiteNewsForIndex :many
-SELECT idsiteNews, news FROM site_news WHERE deleted_at IS NULL
+SELECT idsiteNews, news FROM site_news
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'news' AND sp.item_id = site_news.idsiteNews)
 `
 
 type GetAllSiteNewsForIndexRow struct {
@@ -376,15 +378,25 @@ WHERE (
       AND (g.user_id = ?1 OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
 )
+  AND (
+    ?5 = true
+    OR s.users_idusers = ?6
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'news' AND sp.item_id = s.idsiteNews
+    )
+  )
 ORDER BY s.occurred DESC
 LIMIT ?3 OFFSET ?2
 `
 
 type GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingParams struct {
-	UserID   sql.NullInt64
-	Offset   int64
-	Limit    int64
-	ViewerID int64
+	UserID            sql.NullInt64
+	Offset            int64
+	Limit             int64
+	ViewerID          int64
+	ShowScheduled     interface{}
+	ScheduledViewerID int64
 }
 
 type GetNewsPostsWithWriterUsernameAndThreadCommentCountDescendingRow struct {
@@ -406,6 +418,8 @@ func (q *Queries) GetNewsPostsWithWriterUsernameAndThreadCommentCountDescending(
 		arg.Offset,
 		arg.Limit,
 		arg.ViewerID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 	)
 	if err != nil {
 		return nil, err
@@ -479,6 +479,25 @@ func (q *Queries) InsertPendingEmail(ctx context.Context, arg InsertPendingEmail
 	return err
 }
 
+const systemCountPendingEmailQueue = `-- name: SystemCountPendingEmailQueue :one
+SELECT COUNT(*) AS queued,
+       COUNT(CASE WHEN error_count > 0 THEN 1 END) AS failing
+FROM pending_emails
+WHERE sent_at IS NULL
+`
+
+type SystemCountPendingEmailQueueRow struct {
+	Queued  int64
+	Failing int64
+}
+
+func (q *Queries) SystemCountPendingEmailQueue(ctx context.Context) (*SystemCountPendingEmailQueueRow, error) {
+	row := q.db.QueryRowContext(ctx, systemCountPendingEmailQueue)
+	var i SystemCountPendingEmailQueueRow
+	err := row.Scan(&i.Queued, &i.Failing)
+	return &i, err
+}
+
 const systemIncrementPendingEmailError = `-- name: SystemIncrementPendingEmailError :exec
 UPDATE pending_emails SET error_count = error_count + 1 WHERE id = ?
 `
@@ -149,13 +149,16 @@ func (q *Queries) GetMaxNotificationPriority(ctx context.Context, userID int64)
 }
 
 const getNotificationEmailByUserID = `-- name: GetNotificationEmailByUserID :one
+-- Suppressed addresses are skipped so mail fails over to the next address.
 SELECT id, user_id, email, verified_at, last_verification_code, verification_expires_at, notification_priority
 FROM user_emails
 WHERE user_id = ? AND verified_at IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM email_suppressions es WHERE es.email = user_emails.email)
 ORDER BY notification_priority DESC, id
 LIMIT 1
 `
 
+// Suppressed addresses are skipped so mail fails over to the next address.
 func (q *Queries) GetNotificationEmailByUserID(ctx context.Context, userID int64) (*UserEmail, error) {
 	row := q.db.QueryRowContext(ctx, getNotificationEmailByUserID, userID)
 	var i UserEmail
@@ -344,11 +344,13 @@ func (q *Queries) SystemGetUserByEmail(ctx context.Context, email string) (*Syst
 }
 
 const systemGetUserByID = `-- name: SystemGetUserByID :one
+-- Suppressed addresses are skipped so mail fails over to the next address.
 SELECT idusers, ue.email, u.username, u.public_profile_enabled_at
 FROM users u
 LEFT JOIN user_emails ue ON ue.id = (
         SELECT id FROM user_emails ue2
         WHERE ue2.user_id = idusers AND ue2.verified_at IS NOT NULL
+          AND NOT EXISTS (SELECT 1 FROM email_suppressions es WHERE es.email = ue2.email)
         ORDER BY ue2.notification_priority DESC, ue2.id LIMIT 1
 )
 WHERE idusers = ?
@@ -361,6 +363,7 @@ type SystemGetUserByIDRow struct {
 	PublicProfileEnabledAt sql.NullTime
 }
 
+// Suppressed addresses are skipped so mail fails over to the next address.
 func (q *Queries) SystemGetUserByID(ctx context.Context, idusers int64) (*SystemGetUserByIDRow, error) {
 	row := q.db.QueryRowContext(ctx, systemGetUserByID, idusers)
 	var i SystemGetUserByIDRow
@@ -318,7 +318,9 @@ func (q *Queries) GetAllWritingsByAuthorForLister(ctx context.Context, arg GetAl
 }
 
 const getAllWritingsForIndex = `-- name: GetAllWritingsForIndex :many
-SELECT idwriting, title, abstract, writing FROM writing WHERE deleted_at IS NULL
+SELECT idwriting, title, abstract, writing FROM writing
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'writing' AND sp.item_id = writing.idwriting)
 `
 
 type GetAllWritingsForIndexRow struct {
@@ -360,17 +362,32 @@ const getPublicWritings = `-- name: GetPublicWritings :many
 SELECT w.idwriting, w.users_idusers, w.forumthread_id, w.language_id, w.writing_category_id, w.title, w.published, w.timezone, w.writing, w.abstract, w.private, w.deleted_at, w.last_index
 FROM writing w
 WHERE w.private = 0
+AND (
+    ?3 = true
+    OR w.users_idusers = ?4
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+)
 ORDER BY w.published DESC
 LIMIT ?2 OFFSET ?1
 `
 
 type GetPublicWritingsParams struct {
-	Offset int64
-	Limit  int64
+	Offset            int64
+	Limit             int64
+	ShowScheduled     interface{}
+	ScheduledViewerID int64
 }
 
 func (q *Queries) GetPublicWritings(ctx context.Context, arg GetPublicWritingsParams) ([]*Writing, error) {
-	rows, err := q.db.QueryContext(ctx, getPublicWritings, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, getPublicWritings,
+		arg.Offset,
+		arg.Limit,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -554,16 +571,26 @@ WHERE w.private = 0 AND writing.users_idusers = ?1
       AND (g.user_id = ?2 OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
   )
+  AND (
+    ?6 = true
+    OR w.users_idusers = ?7
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
 ORDER BY w.published DESC
 LIMIT ?4 OFFSET ?3
 `
 
 type ListPublicWritingsByUserForListerParams struct {
-	AuthorID int64
-	UserID   sql.NullInt64
-	Offset   int64
-	Limit    int64
-	ListerID int64
+	AuthorID          int64
+	UserID            sql.NullInt64
+	Offset            int64
+	Limit             int64
+	ListerID          int64
+	ShowScheduled     interface{}
+	ScheduledViewerID int64
 }
 
 type ListPublicWritingsByUserForListerRow struct {
@@ -591,6 +618,8 @@ func (q *Queries) ListPublicWritingsByUserForLister(ctx context.Context, arg Lis
 		arg.Offset,
 		arg.Limit,
 		arg.ListerID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 	)
 	if err != nil {
 		return nil, err
@@ -658,6 +687,14 @@ WHERE w.private = 0 AND w.writing_category_id = ?1
       AND (g.user_id = ?2 OR g.user_id IS NULL)
       AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
   )
+  AND (
+    ?6 = true
+    OR w.users_idusers = ?7
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+    )
+  )
 ORDER BY w.published DESC
 LIMIT ?4 OFFSET ?3
 `
@@ -668,6 +705,8 @@ type ListPublicWritingsInCategoryForListerParams struct {
 	Offset            int64
 	Limit             int64
 	ListerID          int64
+	ShowScheduled     interface{}
+	ScheduledViewerID int64
 }
 
 type ListPublicWritingsInCategoryForListerRow struct {
@@ -695,6 +734,8 @@ func (q *Queries) ListPublicWritingsInCategoryForLister(ctx context.Context, arg
 		arg.Offset,
 		arg.Limit,
 		arg.ListerID,
+		arg.ShowScheduled,
+		arg.ScheduledViewerID,
 	)
 	if err != nil {
 		return nil, err
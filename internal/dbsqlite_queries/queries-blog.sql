@@ -81,6 +81,14 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    sqlc.arg(show_scheduled) = true
+    OR b.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
 
@@ -125,6 +133,14 @@ AND (
           AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM role_ids))
     )
 )
+AND (
+    sqlc.arg(show_scheduled) = true
+    OR b.users_idusers = sqlc.arg(scheduled_viewer_id)
+    OR NOT EXISTS (
+        SELECT 1 FROM scheduled_publications sp
+        WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+    )
+)
 ORDER BY b.written DESC
 LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
 
@@ -382,4 +398,6 @@ ORDER BY b.written DESC;
 UPDATE blogs SET last_index = CURRENT_TIMESTAMP WHERE idblogs = sqlc.arg(id);
 
 -- name: SystemGetAllBlogsForIndex :many
-SELECT idblogs, blog FROM blogs WHERE deleted_at IS NULL;
+SELECT idblogs, blog FROM blogs
+WHERE deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM scheduled_publications sp WHERE sp.item_type = 'blog' AND sp.item_id = blogs.idblogs);
@@ -0,0 +1,26 @@
+-- name: UpsertDraftForUser :exec
+INSERT INTO drafts (user_id, target, url, content, updated_at)
+VALUES (sqlc.arg(user_id), sqlc.arg(target), sqlc.arg(url), sqlc.arg(content), sqlc.arg(updated_at))
+ON CONFLICT(user_id, target) DO UPDATE SET url = excluded.url, content = excluded.content, updated_at = excluded.updated_at;
+
+-- name: GetDraftForUser :one
+SELECT *
+FROM drafts
+WHERE user_id = sqlc.arg(user_id)
+  AND target = sqlc.arg(target);
+
+-- name: ListDraftsForUser :many
+SELECT *
+FROM drafts
+WHERE user_id = sqlc.arg(user_id)
+ORDER BY updated_at DESC, id DESC;
+
+-- name: DeleteDraftForUser :exec
+DELETE FROM drafts
+WHERE user_id = sqlc.arg(user_id)
+  AND target = sqlc.arg(target);
+
+-- name: SystemPurgeDraftsBefore :execrows
+-- Drafts nobody has touched since the cutoff are assumed abandoned.
+DELETE FROM drafts
+WHERE updated_at < sqlc.arg(cutoff);
@@ -0,0 +1,37 @@
+-- name: SystemInsertEmailBounce :exec
+INSERT INTO email_bounces (user_email_id, kind, source, detail)
+VALUES (sqlc.arg(user_email_id), sqlc.arg(kind), sqlc.arg(source), sqlc.narg(detail));
+
+-- name: SystemSuppressEmail :exec
+-- Suppressing an address again refreshes the reason but keeps the original time.
+INSERT INTO email_suppressions (email, user_id, reason, detail)
+VALUES (sqlc.arg(email), sqlc.narg(user_id), sqlc.arg(reason), sqlc.narg(detail))
+ON CONFLICT(email) DO UPDATE SET reason = excluded.reason, detail = excluded.detail;
+
+-- name: GetEmailSuppressionByEmail :one
+SELECT id, email, user_id, reason, detail, created_at
+FROM email_suppressions
+WHERE email = sqlc.arg(email);
+
+-- name: AdminListEmailSuppressions :many
+SELECT s.id, s.email, s.user_id, u.username, s.reason, s.detail, s.created_at
+FROM email_suppressions s
+LEFT JOIN users u ON u.idusers = s.user_id
+ORDER BY s.created_at DESC, s.id DESC
+LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: AdminGetEmailSuppressionByID :one
+SELECT id, email, user_id, reason, detail, created_at
+FROM email_suppressions
+WHERE id = sqlc.arg(id);
+
+-- name: AdminDeleteEmailSuppression :exec
+DELETE FROM email_suppressions
+WHERE id = sqlc.arg(id);
+
+-- name: AdminListRecentEmailBounces :many
+SELECT b.id, b.user_email_id, ue.email, ue.user_id, b.kind, b.source, b.detail, b.created_at
+FROM email_bounces b
+JOIN user_emails ue ON ue.id = b.user_email_id
+ORDER BY b.id DESC
+LIMIT sqlc.arg(limit);
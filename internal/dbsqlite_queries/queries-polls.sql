@@ -0,0 +1,67 @@
+-- name: CreateForumPollForCreator :execlastid
+INSERT INTO forum_polls (forumthread_id, question, multiple_choice, anonymous, results_visibility, closes_at, created_by)
+VALUES (sqlc.arg(thread_id), sqlc.arg(question), sqlc.arg(multiple_choice), sqlc.arg(anonymous), sqlc.arg(results_visibility), sqlc.arg(closes_at), sqlc.arg(creator_id));
+
+-- name: CreateForumPollOption :exec
+INSERT INTO forum_poll_options (poll_id, position, label)
+VALUES (sqlc.arg(poll_id), sqlc.arg(position), sqlc.arg(label));
+
+-- name: GetForumPollByThreadID :one
+SELECT *
+FROM forum_polls
+WHERE forumthread_id = sqlc.arg(thread_id);
+
+-- name: ListForumPollOptionsWithVoteCounts :many
+SELECT o.id, o.poll_id, o.position, o.label, COUNT(v.id) AS votes
+FROM forum_poll_options o
+LEFT JOIN forum_poll_votes v ON v.option_id = o.id
+WHERE o.poll_id = sqlc.arg(poll_id)
+GROUP BY o.id, o.poll_id, o.position, o.label
+ORDER BY o.position, o.id;
+
+-- name: CountForumPollVoters :one
+SELECT COUNT(DISTINCT users_idusers)
+FROM forum_poll_votes
+WHERE poll_id = sqlc.arg(poll_id);
+
+-- name: ListForumPollVotersForPoll :many
+SELECT v.option_id, u.username
+FROM forum_poll_votes v
+JOIN users u ON u.idusers = v.users_idusers
+WHERE v.poll_id = sqlc.arg(poll_id)
+ORDER BY v.option_id, u.username;
+
+-- name: ListForumPollOptionIDsForVoter :many
+SELECT option_id
+FROM forum_poll_votes
+WHERE poll_id = sqlc.arg(poll_id)
+  AND users_idusers = sqlc.arg(voter_id);
+
+-- name: DeleteForumPollVotesForVoter :exec
+DELETE FROM forum_poll_votes
+WHERE poll_id = sqlc.arg(poll_id)
+  AND users_idusers = sqlc.arg(voter_id);
+
+-- name: InsertForumPollVoteForVoter :exec
+INSERT INTO forum_poll_votes (poll_id, option_id, users_idusers)
+VALUES (sqlc.arg(poll_id), sqlc.arg(option_id), sqlc.arg(voter_id));
+
+-- name: SystemListExpiredForumPolls :many
+-- Open polls whose close time has passed along with the thread location used
+-- to notify subscribers.
+SELECT p.id, p.forumthread_id, p.question, p.closes_at,
+       t.idforumtopic AS topic_id, t.handler
+FROM forum_polls p
+JOIN forumthread th ON th.idforumthread = p.forumthread_id
+JOIN forumtopic t ON t.idforumtopic = th.forumtopic_idforumtopic
+WHERE p.closed_at IS NULL
+  AND p.closes_at IS NOT NULL
+  AND p.closes_at <= sqlc.arg(now)
+ORDER BY p.closes_at, p.id
+LIMIT ?;
+
+-- name: SystemCloseForumPoll :execrows
+UPDATE forum_polls
+SET closed_at = sqlc.arg(closed_at)
+WHERE id = sqlc.arg(id)
+  AND closed_at IS NULL;
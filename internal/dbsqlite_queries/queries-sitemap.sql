@@ -0,0 +1,176 @@
+-- name: SystemListSitemapNewsPosts :many
+-- News posts anonymous visitors may open, for the sitemap.
+SELECT s.idsiteNews, s.occurred
+FROM site_news s
+WHERE s.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM scheduled_publications sp
+    WHERE sp.item_type = 'news' AND sp.item_id = s.idsiteNews
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'news'
+      AND (g.item = 'post' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = s.idsiteNews OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY s.idsiteNews;
+
+-- name: SystemListSitemapBlogEntries :many
+-- Blog entries anonymous visitors may open, for the sitemap.
+SELECT b.idblogs, b.written
+FROM blogs b
+WHERE b.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM scheduled_publications sp
+    WHERE sp.item_type = 'blog' AND sp.item_id = b.idblogs
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'blogs'
+      AND (g.item = 'entry' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = b.idblogs OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY b.idblogs;
+
+-- name: SystemListSitemapWritings :many
+-- Public writings anonymous visitors may open, for the sitemap.
+SELECT w.idwriting, w.published
+FROM writing w
+WHERE w.private = 0
+  AND w.deleted_at IS NULL
+  AND NOT EXISTS (
+    SELECT 1 FROM scheduled_publications sp
+    WHERE sp.item_type = 'writing' AND sp.item_id = w.idwriting
+  )
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'writing'
+      AND (g.item = 'article' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = w.idwriting OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY w.idwriting;
+
+-- name: SystemListSitemapForumTopics :many
+-- Public forum topics anonymous visitors may open, for the sitemap.
+SELECT t.idforumtopic, t.lastaddition
+FROM forumtopic t
+WHERE t.handler <> 'private'
+  AND t.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'forum'
+      AND (g.item = 'topic' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = t.idforumtopic OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY t.idforumtopic;
+
+-- name: SystemListSitemapForumThreads :many
+-- Threads in public forum topics anonymous visitors may open, for the sitemap.
+SELECT th.idforumthread, th.forumtopic_idforumtopic, th.lastaddition
+FROM forumthread th
+JOIN forumtopic t ON th.forumtopic_idforumtopic = t.idforumtopic
+WHERE t.handler <> 'private'
+  AND t.deleted_at IS NULL
+  AND th.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'forum'
+      AND (g.item = 'topic' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = t.idforumtopic OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY th.idforumthread;
+
+-- name: SystemListSitemapFAQQuestions :many
+-- Answered FAQ questions anonymous visitors may see, for the sitemap.
+SELECT f.id, f.updated_at
+FROM faq f
+JOIN faq_categories c ON c.id = f.category_id
+WHERE f.answer IS NOT NULL
+  AND f.deleted_at IS NULL
+  AND c.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'faq'
+      AND (g.item = 'question/answer' OR g.item IS NULL)
+      AND g.action = 'see'
+      AND g.active = 1
+      AND (g.item_id = f.id OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY f.id;
+
+-- name: SystemListSitemapImageBoards :many
+-- Image boards anonymous visitors may open, for the sitemap.
+SELECT b.idimageboard
+FROM imageboard b
+WHERE b.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'imagebbs'
+      AND (g.item = 'board' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = b.idimageboard OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY b.idimageboard;
+
+-- name: SystemListSitemapImagePosts :many
+-- Approved image posts on boards anonymous visitors may open, for the sitemap.
+SELECT b.idimageboard, p.forumthread_id, p.posted
+FROM imagepost p
+JOIN imageboard b ON b.idimageboard = p.imageboard_idimageboard
+WHERE p.approved = 1
+  AND p.deleted_at IS NULL
+  AND p.forumthread_id <> 0
+  AND b.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'imagebbs'
+      AND (g.item = 'board' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = b.idimageboard OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY p.idimagepost;
+
+-- name: SystemListSitemapLinkerItems :many
+-- Links anonymous visitors may open, for the sitemap.
+SELECT l.id, l.listed
+FROM linker l
+WHERE l.deleted_at IS NULL
+  AND EXISTS (
+    SELECT 1 FROM grants g
+    WHERE g.section = 'linker'
+      AND (g.item = 'link' OR g.item IS NULL)
+      AND g.action = 'view'
+      AND g.active = 1
+      AND (g.item_id = l.id OR g.item_id IS NULL)
+      AND g.user_id IS NULL
+      AND (g.role_id IS NULL OR g.role_id IN (SELECT id FROM roles WHERE name = 'anyone'))
+  )
+ORDER BY l.id;
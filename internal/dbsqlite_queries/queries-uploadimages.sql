@@ -51,3 +51,11 @@ WHERE iduploadedimage = ?;
 
 -- name: AdminListAllUploadedImages :many
 SELECT iduploadedimage, path FROM uploaded_images;
+
+-- name: ListReferencedUploadPaths :many
+-- Paths of stored uploads still referenced by uploaded images or image posts.
+SELECT path FROM uploaded_images WHERE path IS NOT NULL
+UNION
+SELECT fullimage FROM imagepost WHERE fullimage IS NOT NULL
+UNION
+SELECT thumbnail FROM imagepost WHERE thumbnail IS NOT NULL;
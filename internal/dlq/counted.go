@@ -0,0 +1,24 @@
+package dlq
+
+import (
+	"context"
+
+	"github.com/arran4/goa4web/internal/metrics"
+)
+
+// CountedDLQ wraps a DLQ and counts the messages recorded through it.
+type CountedDLQ struct{ DLQ }
+
+// NewCounted returns d wrapped so each Record call is reflected in metrics.
+func NewCounted(d DLQ) CountedDLQ { return CountedDLQ{DLQ: d} }
+
+// Record writes the message to the wrapped DLQ.
+func (c CountedDLQ) Record(ctx context.Context, msg string) error {
+	err := c.DLQ.Record(ctx, msg)
+	if err != nil {
+		metrics.DLQRecords.Inc("error")
+	} else {
+		metrics.DLQRecords.Inc("ok")
+	}
+	return err
+}
@@ -0,0 +1,48 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/internal/email/dkim"
+)
+
+// DKIMProvider signs each message with DKIM before passing it to Provider.
+type DKIMProvider struct {
+	Provider
+	Signer *dkim.Signer
+}
+
+// Send signs rawEmailMessage and sends it with the wrapped provider.
+func (p *DKIMProvider) Send(ctx context.Context, to mail.Address, rawEmailMessage []byte) error {
+	signed, err := p.Signer.Sign(rawEmailMessage)
+	if err != nil {
+		return fmt.Errorf("dkim sign: %w", err)
+	}
+	return p.Provider.Send(ctx, to, signed)
+}
+
+// Unwrap returns the provider messages are sent with.
+func (p *DKIMProvider) Unwrap() Provider { return p.Provider }
+
+// withDKIM wraps p in a DKIMProvider when cfg enables DKIM signing.
+func withDKIM(p Provider, cfg *config.RuntimeConfig) (Provider, error) {
+	if cfg.EmailDKIMDomain == "" {
+		return p, nil
+	}
+	key, err := config.LoadEmailDKIMKey(core.OSFS{}, cfg.EmailDKIMKey, cfg.EmailDKIMKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("load dkim key: %w", err)
+	}
+	if key == "" {
+		return nil, fmt.Errorf("dkim domain %q set but no key found; run `goa4web email dkim` to create one", cfg.EmailDKIMDomain)
+	}
+	s, err := dkim.NewSigner(cfg.EmailDKIMDomain, cfg.EmailDKIMSelector, []byte(key))
+	if err != nil {
+		return nil, err
+	}
+	return &DKIMProvider{Provider: p, Signer: s}, nil
+}
@@ -0,0 +1,277 @@
+// Package dkim signs outbound email with DKIM (RFC 6376) using RSA-SHA256 or
+// Ed25519-SHA256 (RFC 8463) and relaxed/relaxed canonicalisation.
+package dkim
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultHeaders lists the headers signed when present. From is always
+// signed as RFC 6376 requires.
+var DefaultHeaders = []string{
+	"From", "Reply-To", "Subject", "Date", "To", "Cc",
+	"Message-ID", "In-Reply-To", "References",
+	"MIME-Version", "Content-Type", "Content-Transfer-Encoding",
+	"List-Unsubscribe", "List-Unsubscribe-Post",
+}
+
+// ErrNoHeaderEnd reports a message without a blank line ending its header.
+var ErrNoHeaderEnd = errors.New("dkim: message has no header terminator")
+
+// Signer adds DKIM-Signature headers to messages.
+type Signer struct {
+	// Domain is the signing domain (d=).
+	Domain string
+	// Selector names the DNS record holding the public key (s=).
+	Selector string
+	// Key is an *rsa.PrivateKey or ed25519.PrivateKey.
+	Key crypto.Signer
+	// Headers lists the header fields to sign. DefaultHeaders is used when
+	// empty.
+	Headers []string
+	// Now returns the signing time. time.Now is used when nil.
+	Now func() time.Time
+}
+
+// NewSigner returns a Signer for domain and selector using the PEM encoded
+// private key in keyPEM.
+func NewSigner(domain, selector string, keyPEM []byte) (*Signer, error) {
+	if domain == "" || selector == "" {
+		return nil, fmt.Errorf("dkim: domain and selector are required")
+	}
+	key, err := ParsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{Domain: domain, Selector: selector, Key: key}, nil
+}
+
+// ParsePrivateKey decodes a PEM encoded RSA or Ed25519 private key in PKCS#8
+// or, for RSA, PKCS#1 form.
+func ParsePrivateKey(keyPEM []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, fmt.Errorf("dkim: no PEM key found")
+	}
+	if k, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return k, nil
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("dkim: parse private key: %w", err)
+	}
+	switch k := k.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case ed25519.PrivateKey:
+		return k, nil
+	}
+	return nil, fmt.Errorf("dkim: unsupported key type %T", k)
+}
+
+// algorithm returns the a= tag for the key.
+func algorithm(key crypto.Signer) (string, error) {
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		return "rsa-sha256", nil
+	case ed25519.PrivateKey:
+		return "ed25519-sha256", nil
+	}
+	return "", fmt.Errorf("dkim: unsupported key type %T", key)
+}
+
+// Sign returns msg with a DKIM-Signature header prepended. Line endings are
+// normalised to CRLF so the signed form is the form sent.
+func (s *Signer) Sign(msg []byte) ([]byte, error) {
+	alg, err := algorithm(s.Key)
+	if err != nil {
+		return nil, err
+	}
+	msg = normalizeNewlines(msg)
+	end := bytes.Index(msg, []byte("\r\n\r\n"))
+	if end < 0 {
+		return nil, ErrNoHeaderEnd
+	}
+	fields := splitHeader(msg[:end+2])
+	body := msg[end+4:]
+
+	bodyHash := sha256.Sum256(canonicalBody(body))
+
+	if _, ok := lastField(fields, "From"); !ok {
+		return nil, fmt.Errorf("dkim: message has no From header")
+	}
+	names := s.Headers
+	if len(names) == 0 {
+		names = DefaultHeaders
+	}
+	if !containsFold(names, "From") {
+		names = append([]string{"From"}, names...)
+	}
+	var signed []string
+	var hashed bytes.Buffer
+	for _, name := range names {
+		f, ok := lastField(fields, name)
+		if !ok {
+			continue
+		}
+		signed = append(signed, strings.ToLower(name))
+		hashed.WriteString(canonicalHeader(f))
+	}
+
+	now := time.Now
+	if s.Now != nil {
+		now = s.Now
+	}
+	value := fmt.Sprintf("v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s; t=%d; h=%s; bh=%s; b=",
+		alg, s.Domain, s.Selector, now().Unix(), strings.Join(signed, ":"),
+		base64.StdEncoding.EncodeToString(bodyHash[:]))
+	// The signature header itself is hashed last, without a trailing CRLF
+	// and with an empty b= tag.
+	hashed.WriteString(strings.TrimSuffix(canonicalHeader("DKIM-Signature: "+value), "\r\n"))
+	digest := sha256.Sum256(hashed.Bytes())
+
+	var sig []byte
+	switch key := s.Key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	case ed25519.PrivateKey:
+		// RFC 8463 signs the SHA-256 hash with pure Ed25519.
+		sig = ed25519.Sign(key, digest[:])
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dkim: sign: %w", err)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("DKIM-Signature: ")
+	out.WriteString(value)
+	out.WriteString(fold(base64.StdEncoding.EncodeToString(sig)))
+	out.WriteString("\r\n")
+	out.Write(msg)
+	return out.Bytes(), nil
+}
+
+// fold breaks the signature into lines verifiers rejoin by ignoring
+// whitespace in b=.
+func fold(s string) string {
+	const width = 72
+	var b strings.Builder
+	for len(s) > width {
+		b.WriteString(s[:width])
+		b.WriteString("\r\n\t")
+		s = s[width:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+// normalizeNewlines converts bare LF and CR line endings to CRLF.
+func normalizeNewlines(msg []byte) []byte {
+	var b bytes.Buffer
+	b.Grow(len(msg) + len(msg)/40)
+	for i := 0; i < len(msg); i++ {
+		c := msg[i]
+		switch {
+		case c == '\r' && i+1 < len(msg) && msg[i+1] == '\n':
+			b.WriteString("\r\n")
+			i++
+		case c == '\r' || c == '\n':
+			b.WriteString("\r\n")
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.Bytes()
+}
+
+// splitHeader returns the header fields of hdr, each with its folded
+// continuation lines and trailing CRLF.
+func splitHeader(hdr []byte) []string {
+	var fields []string
+	for _, line := range strings.SplitAfter(string(hdr), "\r\n") {
+		if line == "" {
+			continue
+		}
+		if (line[0] == ' ' || line[0] == '\t') && len(fields) > 0 {
+			fields[len(fields)-1] += line
+			continue
+		}
+		fields = append(fields, line)
+	}
+	return fields
+}
+
+// lastField returns the bottom-most field called name.
+func lastField(fields []string, name string) (string, bool) {
+	for i := len(fields) - 1; i >= 0; i-- {
+		k, _, ok := strings.Cut(fields[i], ":")
+		if ok && strings.EqualFold(strings.TrimRight(k, " \t"), name) {
+			return fields[i], true
+		}
+	}
+	return "", false
+}
+
+// canonicalHeader applies the relaxed header canonicalisation of RFC 6376
+// section 3.4.2.
+func canonicalHeader(field string) string {
+	k, v, _ := strings.Cut(field, ":")
+	k = strings.ToLower(strings.TrimRight(k, " \t"))
+	v = strings.ReplaceAll(v, "\r\n", "")
+	v = strings.Join(strings.FieldsFunc(v, isWSP), " ")
+	return k + ":" + v + "\r\n"
+}
+
+// canonicalBody applies the relaxed body canonicalisation of RFC 6376
+// section 3.4.4.
+func canonicalBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for i, l := range lines {
+		l = strings.TrimRightFunc(l, isWSP)
+		var b strings.Builder
+		inWSP := false
+		// Bytes are copied rather than runes so 8bit bodies hash unchanged.
+		for j := 0; j < len(l); j++ {
+			if l[j] == ' ' || l[j] == '\t' {
+				inWSP = true
+				continue
+			}
+			if inWSP {
+				b.WriteByte(' ')
+				inWSP = false
+			}
+			b.WriteByte(l[j])
+		}
+		lines[i] = b.String()
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWSP(r rune) bool { return r == ' ' || r == '\t' }
@@ -0,0 +1,214 @@
+package dkim
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+)
+
+// verify checks the first DKIM-Signature of msg against pub the way a
+// receiving server would.
+func verify(t *testing.T, msg []byte, pub crypto.PublicKey) {
+	t.Helper()
+	end := bytes.Index(msg, []byte("\r\n\r\n"))
+	if end < 0 {
+		t.Fatal("no header end")
+	}
+	fields := splitHeader(msg[:end+2])
+	sigField := fields[0]
+	if !strings.HasPrefix(sigField, "DKIM-Signature:") {
+		t.Fatalf("first field %q", sigField)
+	}
+	tags := map[string]string{}
+	_, v, _ := strings.Cut(sigField, ":")
+	for _, tag := range strings.Split(v, ";") {
+		k, val, _ := strings.Cut(strings.TrimSpace(tag), "=")
+		tags[k] = strings.Join(strings.Fields(val), "")
+	}
+	if tags["c"] != "relaxed/relaxed" {
+		t.Fatalf("c=%q", tags["c"])
+	}
+
+	bh := sha256.Sum256(canonicalBody(msg[end+4:]))
+	if got := base64.StdEncoding.EncodeToString(bh[:]); got != tags["bh"] {
+		t.Fatalf("body hash %s want %s", got, tags["bh"])
+	}
+
+	var hashed bytes.Buffer
+	for _, name := range strings.Split(tags["h"], ":") {
+		f, ok := lastField(fields[1:], name)
+		if !ok {
+			t.Fatalf("signed header %q missing", name)
+		}
+		hashed.WriteString(canonicalHeader(f))
+	}
+	empty := sigField[:strings.LastIndex(sigField, "; b=")+len("; b=")]
+	hashed.WriteString(strings.TrimSuffix(canonicalHeader(empty), "\r\n"))
+	digest := sha256.Sum256(hashed.Bytes())
+
+	sig, err := base64.StdEncoding.DecodeString(tags["b"])
+	if err != nil {
+		t.Fatalf("decode b: %v", err)
+	}
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		if tags["a"] != "rsa-sha256" {
+			t.Fatalf("a=%q", tags["a"])
+		}
+		if err := rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig); err != nil {
+			t.Fatalf("rsa verify: %v", err)
+		}
+	case ed25519.PublicKey:
+		if tags["a"] != "ed25519-sha256" {
+			t.Fatalf("a=%q", tags["a"])
+		}
+		if !ed25519.Verify(pub, digest[:], sig) {
+			t.Fatal("ed25519 verify failed")
+		}
+	}
+}
+
+const testMessage = "From: Site <noreply@example.com>\r\n" +
+	"To: user@example.org\r\n" +
+	"Subject: Hello\r\n" +
+	"Message-ID: <1.forum.topic.1@example.com>\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: text/plain; charset=utf-8\r\n" +
+	"\r\n" +
+	"Hi there.\r\n\r\nA reply was posted.  \r\n\r\n\r\n"
+
+func TestSignVerifies(t *testing.T) {
+	for _, alg := range []string{AlgorithmRSA, AlgorithmEd25519} {
+		t.Run(alg, func(t *testing.T) {
+			keyPEM, key, err := GenerateKey(alg, 1024)
+			if err != nil {
+				t.Fatalf("GenerateKey: %v", err)
+			}
+			s, err := NewSigner("example.com", "sel", keyPEM)
+			if err != nil {
+				t.Fatalf("NewSigner: %v", err)
+			}
+			s.Now = func() time.Time { return time.Unix(1700000000, 0) }
+			signed, err := s.Sign([]byte(testMessage))
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+			verify(t, signed, key.Public())
+			if !bytes.HasSuffix(signed, []byte(testMessage)) {
+				t.Fatal("message altered by signing")
+			}
+			hdr := string(signed[:bytes.IndexByte(signed, '\n')])
+			for _, want := range []string{"d=example.com;", "s=sel;", "t=1700000000;", "h=from:subject:to:message-id:mime-version:content-type;"} {
+				if !strings.Contains(hdr, want) {
+					t.Errorf("signature %q missing %q", hdr, want)
+				}
+			}
+
+			// Relaxed canonicalisation tolerates refolding and whitespace
+			// changes made in transit.
+			relayed := strings.Replace(string(signed), "Subject: Hello", "subject:   Hello ", 1)
+			relayed = strings.Replace(relayed, "A reply was posted.", "A reply  was\tposted.", 1)
+			verify(t, []byte(relayed), key.Public())
+		})
+	}
+}
+
+func TestSignNormalisesNewlines(t *testing.T) {
+	keyPEM, key, err := GenerateKey(AlgorithmEd25519, 0)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	s, err := NewSigner("example.com", "sel", keyPEM)
+	if err != nil {
+		t.Fatalf("NewSigner: %v", err)
+	}
+	signed, err := s.Sign([]byte(strings.ReplaceAll(testMessage, "\r\n", "\n")))
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+	verify(t, signed, key.Public())
+	if bytes.Contains(bytes.ReplaceAll(signed, []byte("\r\n"), nil), []byte("\n")) {
+		t.Fatal("bare LF left in signed message")
+	}
+}
+
+func TestSignRequiresFrom(t *testing.T) {
+	keyPEM, _, _ := GenerateKey(AlgorithmEd25519, 0)
+	s, _ := NewSigner("example.com", "sel", keyPEM)
+	if _, err := s.Sign([]byte("To: a@example.com\r\n\r\nbody")); err == nil {
+		t.Fatal("expected error without From")
+	}
+	if _, err := s.Sign([]byte("From: a@example.com")); err != ErrNoHeaderEnd {
+		t.Fatalf("err=%v want ErrNoHeaderEnd", err)
+	}
+}
+
+// TestCanonicalBodyRFC8463 checks the body hash of the example message in
+// RFC 8463 appendix A.
+func TestCanonicalBodyRFC8463(t *testing.T) {
+	body := "Hi.\r\n\r\nWe lost the game.  Are you hungry yet?\r\n\r\nJoe.\r\n"
+	sum := sha256.Sum256(canonicalBody([]byte(body)))
+	if got, want := base64.StdEncoding.EncodeToString(sum[:]), "2jUSOH9NhtVGCQWNr9BrIAPreKQjO6Sn7XIkfJVOzv8="; got != want {
+		t.Fatalf("bh=%s want %s", got, want)
+	}
+	if got := canonicalBody([]byte("\r\n\r\n")); len(got) != 0 {
+		t.Fatalf("empty body canonicalised to %q", got)
+	}
+}
+
+func TestCanonicalHeader(t *testing.T) {
+	got := canonicalHeader("Subject :  Hello\r\n\t  world  \r\n")
+	if want := "subject:Hello world\r\n"; got != want {
+		t.Fatalf("got %q want %q", got, want)
+	}
+}
+
+func TestTXTRecord(t *testing.T) {
+	_, key, err := GenerateKey(AlgorithmEd25519, 0)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	rec, err := TXTRecord(key)
+	if err != nil {
+		t.Fatalf("TXTRecord: %v", err)
+	}
+	p := strings.TrimPrefix(rec, "v=DKIM1; k=ed25519; p=")
+	raw, err := base64.StdEncoding.DecodeString(p)
+	if err != nil || len(raw) != ed25519.PublicKeySize {
+		t.Fatalf("record %q", rec)
+	}
+
+	_, key, err = GenerateKey(AlgorithmRSA, 1024)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	rec, err = TXTRecord(key)
+	if err != nil || !strings.HasPrefix(rec, "v=DKIM1; k=rsa; p=MI") {
+		t.Fatalf("record %q err %v", rec, err)
+	}
+	if got := RecordName("sel", "example.com."); got != "sel._domainkey.example.com" {
+		t.Fatalf("RecordName=%q", got)
+	}
+}
+
+func TestParsePrivateKeyPKCS1(t *testing.T) {
+	_, key, err := GenerateKey(AlgorithmRSA, 1024)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key.(*rsa.PrivateKey))})
+	if _, err := ParsePrivateKey(pkcs1); err != nil {
+		t.Fatalf("ParsePrivateKey: %v", err)
+	}
+	if _, err := ParsePrivateKey([]byte("not a key")); err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,72 @@
+package dkim
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"strings"
+)
+
+// Key algorithms accepted by GenerateKey.
+const (
+	AlgorithmRSA     = "rsa"
+	AlgorithmEd25519 = "ed25519"
+)
+
+// GenerateKey creates a private key for algorithm and returns it PEM encoded
+// in PKCS#8 form. bits sets the RSA key size and is ignored for Ed25519.
+func GenerateKey(algorithm string, bits int) ([]byte, crypto.Signer, error) {
+	var key crypto.Signer
+	switch strings.ToLower(algorithm) {
+	case AlgorithmRSA:
+		if bits < 1024 {
+			return nil, nil, fmt.Errorf("dkim: rsa keys need at least 1024 bits")
+		}
+		k, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, nil, err
+		}
+		key = k
+	case AlgorithmEd25519:
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, nil, err
+		}
+		key = k
+	default:
+		return nil, nil, fmt.Errorf("dkim: unknown algorithm %q", algorithm)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), key, nil
+}
+
+// TXTRecord returns the DNS TXT record value publishing the public half of
+// key, to be served at <selector>._domainkey.<domain>.
+func TXTRecord(key crypto.Signer) (string, error) {
+	switch pub := key.Public().(type) {
+	case *rsa.PublicKey:
+		der, err := x509.MarshalPKIXPublicKey(pub)
+		if err != nil {
+			return "", err
+		}
+		return "v=DKIM1; k=rsa; p=" + base64.StdEncoding.EncodeToString(der), nil
+	case ed25519.PublicKey:
+		// RFC 8463 publishes the raw 32 byte key rather than a SPKI
+		// structure.
+		return "v=DKIM1; k=ed25519; p=" + base64.StdEncoding.EncodeToString(pub), nil
+	}
+	return "", fmt.Errorf("dkim: unsupported key type %T", key)
+}
+
+// RecordName returns the DNS name the TXT record for selector is served at.
+func RecordName(selector, domain string) string {
+	return selector + "._domainkey." + strings.TrimSuffix(domain, ".")
+}
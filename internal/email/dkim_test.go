@@ -0,0 +1,65 @@
+package email_test
+
+import (
+	"bytes"
+	"context"
+	"net/mail"
+	"path/filepath"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/email"
+	"github.com/arran4/goa4web/internal/email/dkim"
+	"github.com/arran4/goa4web/internal/email/mock"
+)
+
+func TestProviderFromConfigSignsWithDKIM(t *testing.T) {
+	keyPEM, _, err := dkim.GenerateKey(dkim.AlgorithmEd25519, 0)
+	if err != nil {
+		t.Fatalf("GenerateKey: %v", err)
+	}
+	sent := &mock.Provider{}
+	reg := email.NewRegistry()
+	reg.RegisterProvider("mock", func(*config.RuntimeConfig) (email.Provider, error) { return sent, nil })
+	cfg := &config.RuntimeConfig{
+		EmailProvider:     "mock",
+		EmailDKIMDomain:   "example.com",
+		EmailDKIMSelector: "sel",
+		EmailDKIMKey:      string(keyPEM),
+	}
+	p, err := reg.ProviderFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("ProviderFromConfig: %v", err)
+	}
+	if _, ok := p.(*email.DKIMProvider); !ok {
+		t.Fatalf("provider %T not DKIM wrapped", p)
+	}
+	to := mail.Address{Address: "to@example.org"}
+	raw, err := email.BuildMessage(mail.Address{Address: "from@example.com"}, to, "Hi", "body", "")
+	if err != nil {
+		t.Fatalf("BuildMessage: %v", err)
+	}
+	if err := p.Send(context.Background(), to, raw); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if len(sent.Messages) != 1 || !bytes.HasPrefix(sent.Messages[0].Raw, []byte("DKIM-Signature: v=1; a=ed25519-sha256;")) {
+		t.Fatalf("unsigned message: %+v", sent.Messages)
+	}
+	if sent.Messages[0].Subject != "Hi" {
+		t.Fatalf("subject %q", sent.Messages[0].Subject)
+	}
+}
+
+func TestProviderFromConfigDKIMMissingKey(t *testing.T) {
+	reg := email.NewRegistry()
+	reg.RegisterProvider("mock", func(*config.RuntimeConfig) (email.Provider, error) { return &mock.Provider{}, nil })
+	cfg := &config.RuntimeConfig{
+		EmailProvider:     "mock",
+		EmailDKIMDomain:   "example.com",
+		EmailDKIMSelector: "sel",
+		EmailDKIMKeyFile:  filepath.Join(t.TempDir(), "missing.pem"),
+	}
+	if _, err := reg.ProviderFromConfig(cfg); err == nil {
+		t.Fatal("expected error for missing key")
+	}
+}
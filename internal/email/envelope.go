@@ -0,0 +1,20 @@
+package email
+
+import "context"
+
+type envelopeFromKey struct{}
+
+// WithEnvelopeFrom returns a context asking providers to send with addr as the
+// envelope sender, so bounces are returned to it rather than the From address.
+func WithEnvelopeFrom(ctx context.Context, addr string) context.Context {
+	return context.WithValue(ctx, envelopeFromKey{}, addr)
+}
+
+// EnvelopeFrom returns the envelope sender set by WithEnvelopeFrom, or def when
+// there is none.
+func EnvelopeFrom(ctx context.Context, def string) string {
+	if addr, ok := ctx.Value(envelopeFromKey{}).(string); ok && addr != "" {
+		return addr
+	}
+	return def
+}
@@ -0,0 +1,337 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNoProviderAvailable is returned when every provider in a failover
+	// list is skipped because its circuit is open.
+	ErrNoProviderAvailable = errors.New("no email provider available")
+	// ErrRateLimited is returned when every available provider is at its
+	// send rate limit for longer than a send is willing to wait.
+	ErrRateLimited = errors.New("email providers rate limited")
+)
+
+// maxRateLimitWait bounds how long Send waits for a throttled provider.
+const maxRateLimitWait = time.Minute
+
+// Circuit states reported by ProviderHealth.
+const (
+	StateHealthy  = "healthy"
+	StateDegraded = "degraded"
+	StateOpen     = "open"
+	StateHalfOpen = "half-open"
+)
+
+// RateLimit caps how many messages a provider sends per interval.
+type RateLimit struct {
+	Count int
+	Per   time.Duration
+}
+
+// String formats l the way ParseRateLimits reads it.
+func (l RateLimit) String() string {
+	if l.Count <= 0 {
+		return ""
+	}
+	switch l.Per {
+	case time.Minute:
+		return fmt.Sprintf("%d/m", l.Count)
+	case time.Hour:
+		return fmt.Sprintf("%d/h", l.Count)
+	}
+	return fmt.Sprintf("%d/s", l.Count)
+}
+
+// ParseRateLimits parses a list of name=count[/s|/m|/h] pairs separated by
+// commas. A count without a unit is per second.
+func ParseRateLimits(s string) (map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, rate, ok := strings.Cut(part, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !ok || name == "" {
+			return nil, fmt.Errorf("rate limit %q: want name=count", part)
+		}
+		count, unit, _ := strings.Cut(strings.TrimSpace(rate), "/")
+		l := RateLimit{Per: time.Second}
+		switch strings.ToLower(strings.TrimSpace(unit)) {
+		case "", "s":
+		case "m":
+			l.Per = time.Minute
+		case "h":
+			l.Per = time.Hour
+		default:
+			return nil, fmt.Errorf("rate limit %q: unknown unit %q", part, unit)
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("rate limit %q: count must be a positive number", part)
+		}
+		l.Count = n
+		limits[name] = l
+	}
+	return limits, nil
+}
+
+// ParseProviderList splits a comma separated EMAIL_PROVIDER value into
+// lower case provider names, dropping blanks and repeats.
+func ParseProviderList(s string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, n := range strings.Split(s, ",") {
+		n = strings.ToLower(strings.TrimSpace(n))
+		if n == "" || seen[n] {
+			continue
+		}
+		seen[n] = true
+		names = append(names, n)
+	}
+	return names
+}
+
+// ProviderHealth describes the send history and circuit state of one
+// provider.
+type ProviderHealth struct {
+	Name                string
+	State               string
+	RateLimit           string
+	ConsecutiveFailures int
+	Sent                int64
+	Failed              int64
+	Throttled           int64
+	LastError           string
+	LastErrorAt         time.Time
+	OpenUntil           time.Time
+}
+
+// policy is the rate limit and circuit breaker configuration a provider was
+// built with.
+type policy struct {
+	limit     RateLimit
+	threshold int
+	cooldown  time.Duration
+}
+
+// providerState holds the token bucket and circuit of one provider. It is
+// kept by the Registry so it is shared by every provider built from the same
+// factory, while the policy travels with each built provider.
+type providerState struct {
+	mu sync.Mutex
+	// policy is the one most recently applied, kept for reporting.
+	policy policy
+
+	tokens   float64
+	refilled time.Time
+
+	failures  int
+	openUntil time.Time
+	probing   bool
+
+	sent, failed, throttled int64
+	lastErr                 string
+	lastErrAt               time.Time
+}
+
+// apply switches the state to p. The token bucket starts full whenever the
+// rate changes.
+func (s *providerState) apply(p policy) {
+	if p.limit != s.policy.limit {
+		s.tokens = float64(p.limit.Count)
+		s.refilled = time.Time{}
+	}
+	s.policy = p
+}
+
+// open reports whether the circuit has tripped.
+func (s *providerState) open() bool {
+	return s.policy.threshold > 0 && s.failures >= s.policy.threshold
+}
+
+// admit reserves a send at now under p. When the provider is throttled retry
+// is the time until the next token is available; it is zero when the circuit
+// is open.
+func (s *providerState) admit(p policy, now time.Time) (ok bool, retry time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.apply(p)
+	halfOpen := false
+	if s.open() {
+		if now.Before(s.openUntil) || s.probing {
+			return false, 0
+		}
+		halfOpen = true
+	}
+	if l := p.limit; l.Count > 0 {
+		capacity := float64(l.Count)
+		rate := capacity / l.Per.Seconds()
+		if !s.refilled.IsZero() {
+			s.tokens += now.Sub(s.refilled).Seconds() * rate
+		}
+		if s.tokens > capacity {
+			s.tokens = capacity
+		}
+		s.refilled = now
+		if s.tokens < 1 {
+			s.throttled++
+			return false, time.Duration((1 - s.tokens) / rate * float64(time.Second))
+		}
+		s.tokens--
+	}
+	if halfOpen {
+		s.probing = true
+	}
+	return true, 0
+}
+
+// record notes the outcome of an admitted send.
+func (s *providerState) record(now time.Time, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.probing = false
+	if err == nil {
+		s.sent++
+		s.failures = 0
+		return
+	}
+	s.failed++
+	s.failures++
+	s.lastErr = err.Error()
+	s.lastErrAt = now
+	if s.open() {
+		s.openUntil = now.Add(s.policy.cooldown)
+	}
+}
+
+// release returns an admitted send that was abandoned before it completed.
+func (s *providerState) release() {
+	s.mu.Lock()
+	s.probing = false
+	s.mu.Unlock()
+}
+
+// health summarises the state as seen at now.
+func (s *providerState) health(name string, now time.Time) ProviderHealth {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	h := ProviderHealth{
+		Name:                name,
+		State:               StateHealthy,
+		RateLimit:           s.policy.limit.String(),
+		ConsecutiveFailures: s.failures,
+		Sent:                s.sent,
+		Failed:              s.failed,
+		Throttled:           s.throttled,
+		LastError:           s.lastErr,
+		LastErrorAt:         s.lastErrAt,
+	}
+	switch {
+	case s.open() && now.Before(s.openUntil):
+		h.State = StateOpen
+		h.OpenUntil = s.openUntil
+	case s.open():
+		h.State = StateHalfOpen
+	case s.failures > 0:
+		h.State = StateDegraded
+	}
+	return h
+}
+
+// failoverMember is one provider in a FailoverProvider.
+type failoverMember struct {
+	name string
+	Provider
+	policy policy
+	state  *providerState
+}
+
+// FailoverProvider sends each message with the first provider in its list
+// that is neither rate limited nor tripped, moving down the list when a send
+// fails.
+type FailoverProvider struct {
+	members []failoverMember
+	now     func() time.Time
+}
+
+// Names returns the member provider names in order of preference.
+func (f *FailoverProvider) Names() []string {
+	names := make([]string, len(f.members))
+	for i, m := range f.members {
+		names[i] = m.name
+	}
+	return names
+}
+
+// Send tries each member once in order. When the untried members are all
+// throttled it waits for the first one to have capacity again.
+func (f *FailoverProvider) Send(ctx context.Context, to mail.Address, rawEmailMessage []byte) error {
+	tried := make([]bool, len(f.members))
+	var errs []error
+	for {
+		var wait time.Duration
+		for i, m := range f.members {
+			if tried[i] {
+				continue
+			}
+			ok, retry := m.state.admit(m.policy, f.now())
+			if !ok {
+				if retry > 0 && (wait == 0 || retry < wait) {
+					wait = retry
+				}
+				continue
+			}
+			tried[i] = true
+			err := m.Send(ctx, to, rawEmailMessage)
+			if err != nil && ctx.Err() != nil {
+				m.state.release()
+				return err
+			}
+			m.state.record(f.now(), err)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", m.name, err))
+		}
+		if wait == 0 {
+			break
+		}
+		if wait > maxRateLimitWait {
+			errs = append(errs, ErrRateLimited)
+			break
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+	if len(errs) == 0 {
+		return ErrNoProviderAvailable
+	}
+	return fmt.Errorf("all email providers failed: %w", errors.Join(errs...))
+}
+
+// TestConfig checks the configuration of every member.
+func (f *FailoverProvider) TestConfig(ctx context.Context) error {
+	var errs []error
+	for _, m := range f.members {
+		if err := m.TestConfig(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", m.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
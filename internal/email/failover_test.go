@@ -0,0 +1,205 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+)
+
+type fakeProvider struct {
+	err   error
+	sends int
+}
+
+func (p *fakeProvider) Send(context.Context, mail.Address, []byte) error {
+	p.sends++
+	return p.err
+}
+
+func (p *fakeProvider) TestConfig(context.Context) error { return p.err }
+
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time { return c.t }
+
+func newFailoverRegistry(providers map[string]*fakeProvider) *Registry {
+	r := NewRegistry()
+	for name, p := range providers {
+		r.RegisterProvider(name, func(*config.RuntimeConfig) (Provider, error) { return p, nil })
+	}
+	return r
+}
+
+func failoverFromConfig(t *testing.T, r *Registry, cfg *config.RuntimeConfig, clock *fakeClock) *FailoverProvider {
+	t.Helper()
+	p, err := r.ProviderFromConfig(cfg)
+	if err != nil {
+		t.Fatalf("ProviderFromConfig: %v", err)
+	}
+	f, ok := p.(*FailoverProvider)
+	if !ok {
+		t.Fatalf("provider %T is not a FailoverProvider", p)
+	}
+	f.now = clock.now
+	return f
+}
+
+func TestParseRateLimits(t *testing.T) {
+	got, err := ParseRateLimits(" SES=14/s, smtp=100/m,jmap=5000/h,log=3 ")
+	if err != nil {
+		t.Fatalf("ParseRateLimits: %v", err)
+	}
+	want := map[string]RateLimit{
+		"ses":  {14, time.Second},
+		"smtp": {100, time.Minute},
+		"jmap": {5000, time.Hour},
+		"log":  {3, time.Second},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("limits %+v", got)
+	}
+	for n, l := range want {
+		if got[n] != l {
+			t.Errorf("%s = %+v want %+v", n, got[n], l)
+		}
+	}
+	if got["smtp"].String() != "100/m" {
+		t.Errorf("String() = %q", got["smtp"].String())
+	}
+	for _, bad := range []string{"ses", "ses=0", "ses=x/s", "ses=1/d", "=1"} {
+		if _, err := ParseRateLimits(bad); err == nil {
+			t.Errorf("%q accepted", bad)
+		}
+	}
+}
+
+func TestProviderFromConfigSingleProviderUnwrapped(t *testing.T) {
+	fp := &fakeProvider{}
+	r := newFailoverRegistry(map[string]*fakeProvider{"a": fp})
+	p, err := r.ProviderFromConfig(&config.RuntimeConfig{EmailProvider: "A"})
+	if err != nil || p != fp {
+		t.Fatalf("provider %T err %v", p, err)
+	}
+	if hs := r.ProviderHealth(); len(hs) != 0 {
+		t.Fatalf("health %+v", hs)
+	}
+	if _, err := r.ProviderFromConfig(&config.RuntimeConfig{EmailProvider: "a,missing"}); err == nil {
+		t.Fatal("unknown member accepted")
+	}
+}
+
+func TestFailoverProviderFailsOver(t *testing.T) {
+	a := &fakeProvider{err: errors.New("throttling")}
+	b := &fakeProvider{}
+	r := newFailoverRegistry(map[string]*fakeProvider{"a": a, "b": b})
+	cfg := &config.RuntimeConfig{EmailProvider: "a, b", EmailProviderFailureThreshold: 2, EmailProviderCooldown: 60}
+	clock := &fakeClock{t: time.Now()}
+	f := failoverFromConfig(t, r, cfg, clock)
+	to := mail.Address{Address: "to@example.org"}
+
+	for i := 0; i < 3; i++ {
+		if err := f.Send(context.Background(), to, []byte("msg")); err != nil {
+			t.Fatalf("Send %d: %v", i, err)
+		}
+	}
+	if a.sends != 2 || b.sends != 3 {
+		t.Fatalf("sends a=%d b=%d", a.sends, b.sends)
+	}
+	hs := r.ProviderHealth()
+	if len(hs) != 2 || hs[0].Name != "a" || hs[0].State != StateOpen || hs[0].Failed != 2 || hs[0].LastError != "throttling" {
+		t.Fatalf("health %+v", hs)
+	}
+	if hs[1].State != StateHealthy || hs[1].Sent != 3 {
+		t.Fatalf("health %+v", hs[1])
+	}
+
+	// After the cooldown a single probe decides whether a rejoins.
+	clock.t = clock.t.Add(61 * time.Second)
+	a.err = nil
+	if err := f.Send(context.Background(), to, []byte("msg")); err != nil {
+		t.Fatalf("probe send: %v", err)
+	}
+	if a.sends != 3 || b.sends != 3 {
+		t.Fatalf("probe sends a=%d b=%d", a.sends, b.sends)
+	}
+	if st := r.ProviderHealth()[0]; st.State != StateHealthy || st.ConsecutiveFailures != 0 {
+		t.Fatalf("after probe %+v", st)
+	}
+}
+
+func TestFailoverProviderAllFailing(t *testing.T) {
+	a := &fakeProvider{err: errors.New("down")}
+	r := newFailoverRegistry(map[string]*fakeProvider{"a": a, "b": {err: errors.New("also down")}})
+	cfg := &config.RuntimeConfig{EmailProvider: "a,b", EmailProviderFailureThreshold: 1, EmailProviderCooldown: 60}
+	f := failoverFromConfig(t, r, cfg, &fakeClock{t: time.Now()})
+	to := mail.Address{Address: "to@example.org"}
+
+	err := f.Send(context.Background(), to, []byte("msg"))
+	if err == nil || errors.Is(err, ErrNoProviderAvailable) {
+		t.Fatalf("first send err %v", err)
+	}
+	if err := f.Send(context.Background(), to, []byte("msg")); !errors.Is(err, ErrNoProviderAvailable) {
+		t.Fatalf("second send err %v", err)
+	}
+	if a.sends != 1 {
+		t.Fatalf("open provider sent %d times", a.sends)
+	}
+	if err := f.TestConfig(context.Background()); err == nil {
+		t.Fatal("TestConfig passed")
+	}
+}
+
+func TestFailoverProviderRateLimit(t *testing.T) {
+	a := &fakeProvider{}
+	b := &fakeProvider{}
+	r := newFailoverRegistry(map[string]*fakeProvider{"a": a, "b": b})
+	cfg := &config.RuntimeConfig{EmailProvider: "a,b", EmailProviderRateLimits: "a=2/h,b=1/h", EmailProviderFailureThreshold: 5}
+	clock := &fakeClock{t: time.Now()}
+	f := failoverFromConfig(t, r, cfg, clock)
+	to := mail.Address{Address: "to@example.org"}
+
+	for i := 0; i < 3; i++ {
+		if err := f.Send(context.Background(), to, []byte("msg")); err != nil {
+			t.Fatalf("Send %d: %v", i, err)
+		}
+	}
+	if a.sends != 2 || b.sends != 1 {
+		t.Fatalf("sends a=%d b=%d", a.sends, b.sends)
+	}
+	if err := f.Send(context.Background(), to, []byte("msg")); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("throttled send err %v", err)
+	}
+	if h := r.ProviderHealth(); h[0].RateLimit != "2/h" || h[0].Throttled == 0 {
+		t.Fatalf("health %+v", h)
+	}
+
+	// Half an hour refills one of a's tokens.
+	clock.t = clock.t.Add(30 * time.Minute)
+	if err := f.Send(context.Background(), to, []byte("msg")); err != nil || a.sends != 3 {
+		t.Fatalf("refilled send err %v sends %d", err, a.sends)
+	}
+}
+
+func TestFailoverProviderWaitsForCapacity(t *testing.T) {
+	a := &fakeProvider{}
+	r := newFailoverRegistry(map[string]*fakeProvider{"a": a})
+	cfg := &config.RuntimeConfig{EmailProvider: "a", EmailProviderRateLimits: "a=1/m"}
+	f := failoverFromConfig(t, r, cfg, &fakeClock{t: time.Now()})
+	to := mail.Address{Address: "to@example.org"}
+
+	if err := f.Send(context.Background(), to, []byte("msg")); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := f.Send(ctx, to, []byte("msg")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("waiting send err %v", err)
+	}
+	if a.sends != 1 {
+		t.Fatalf("sends %d", a.sends)
+	}
+}
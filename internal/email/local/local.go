@@ -29,7 +29,11 @@ func (Provider) Send(ctx context.Context, to mail.Address, rawEmailMessage []byt
 	if err != nil || parsed.Address != addr {
 		return fmt.Errorf("invalid recipient address: %w", err)
 	}
-	cmd := exec.CommandContext(ctx, "sendmail", addr)
+	args := []string{addr}
+	if from := email.EnvelopeFrom(ctx, ""); from != "" {
+		args = append([]string{"-f", from}, args...)
+	}
+	cmd := exec.CommandContext(ctx, "sendmail", args...)
 	cmd.Stdin = bytes.NewReader(rawEmailMessage)
 	return cmd.Run()
 }
@@ -23,6 +23,12 @@ func SetDefaultFromName(addr string) {
 
 // BuildMessage constructs a MIME email message with optional HTML content.
 func BuildMessage(from, to mail.Address, subject, textBody, htmlBody string) ([]byte, error) {
+	return BuildMessageWithHeaders(from, to, subject, textBody, htmlBody, nil)
+}
+
+// BuildMessageWithHeaders constructs a message like BuildMessage and adds the
+// headers in extra, such as Reply-To.
+func BuildMessageWithHeaders(from, to mail.Address, subject, textBody, htmlBody string, extra textproto.MIMEHeader) ([]byte, error) {
 	if from.Name == "" {
 		from.Name = DefaultFromName
 	}
@@ -32,6 +38,9 @@ func BuildMessage(from, to mail.Address, subject, textBody, htmlBody string) ([]
 	hdr.Set("To", to.String())
 	hdr.Set("Subject", mime.QEncoding.Encode("utf-8", subject))
 	hdr.Set("MIME-Version", "1.0")
+	for k, v := range extra {
+		hdr[textproto.CanonicalMIMEHeaderKey(k)] = v
+	}
 
 	if htmlBody != "" {
 		w := multipart.NewWriter(&msg)
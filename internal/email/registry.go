@@ -3,9 +3,11 @@ package email
 import (
 	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/arran4/goa4web/config"
 )
@@ -13,14 +15,21 @@ import (
 // ProviderFactory creates a mail provider from cfg.
 type ProviderFactory func(*config.RuntimeConfig) (Provider, error)
 
-// Registry stores email provider factories.
+// Registry stores email provider factories along with the rate limit and
+// circuit state of the providers built from them.
 type Registry struct {
 	mu        sync.RWMutex
 	providers map[string]ProviderFactory
+	states    map[string]*providerState
 }
 
 // NewRegistry returns an empty Registry.
-func NewRegistry() *Registry { return &Registry{providers: make(map[string]ProviderFactory)} }
+func NewRegistry() *Registry {
+	return &Registry{
+		providers: make(map[string]ProviderFactory),
+		states:    make(map[string]*providerState),
+	}
+}
 
 // RegisterProvider registers factory under name.
 func (r *Registry) RegisterProvider(name string, factory ProviderFactory) {
@@ -41,16 +50,83 @@ func (r *Registry) providerFactory(name string) ProviderFactory {
 	return f
 }
 
-// ProviderFromConfig returns a provider configured from cfg.
+// ProviderFromConfig returns a provider configured from cfg. A list of
+// providers, or a provider with a rate limit, is wrapped in a
+// FailoverProvider. Messages are DKIM signed when cfg sets EmailDKIMDomain.
 func (r *Registry) ProviderFromConfig(cfg *config.RuntimeConfig) (Provider, error) {
-	mode := strings.ToLower(cfg.EmailProvider)
-	if f := r.providerFactory(mode); f != nil {
-		return f(cfg)
+	names := ParseProviderList(cfg.EmailProvider)
+	if len(names) == 0 {
+		return nil, nil
+	}
+	limits, err := ParseRateLimits(cfg.EmailProviderRateLimits)
+	if err != nil {
+		return nil, fmt.Errorf("email disabled: %w", err)
+	}
+	for n := range limits {
+		if !slices.Contains(names, n) {
+			log.Printf("email: rate limit set for unused provider %s", n)
+		}
+	}
+	members := make([]failoverMember, 0, len(names))
+	for _, n := range names {
+		f := r.providerFactory(n)
+		if f == nil {
+			return nil, fmt.Errorf("email disabled: unknown provider %q", n)
+		}
+		p, err := f(cfg)
+		if len(names) == 1 && (err != nil || p == nil) {
+			return p, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("email provider %s: %w", n, err)
+		}
+		if p == nil {
+			return nil, fmt.Errorf("email disabled: provider %q is not configured", n)
+		}
+		members = append(members, failoverMember{name: n, Provider: p})
+	}
+	if len(members) == 1 && limits[names[0]].Count == 0 {
+		return withDKIM(members[0].Provider, cfg)
+	}
+	for i := range members {
+		m := &members[i]
+		m.policy = policy{
+			limit:     limits[m.name],
+			threshold: cfg.EmailProviderFailureThreshold,
+			cooldown:  time.Duration(cfg.EmailProviderCooldown) * time.Second,
+		}
+		m.state = r.providerState(m.name, m.policy)
+	}
+	var p Provider = &FailoverProvider{members: members, now: time.Now}
+	return withDKIM(p, cfg)
+}
+
+// providerState returns the shared state for name, starting it under p when
+// the provider has not been built before.
+func (r *Registry) providerState(name string, p policy) *providerState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.states[name]
+	if !ok {
+		s = &providerState{}
+		s.apply(p)
+		r.states[name] = s
 	}
-	if mode != "" {
-		return nil, fmt.Errorf("email disabled: unknown provider %q", mode)
+	return s
+}
+
+// ProviderHealth reports the state of every provider that has been part of
+// a failover list, sorted by name.
+func (r *Registry) ProviderHealth() []ProviderHealth {
+	now := time.Now()
+	r.mu.RLock()
+	hs := make([]ProviderHealth, 0, len(r.states))
+	for n, s := range r.states {
+		hs = append(hs, s.health(n, now))
 	}
-	return nil, nil
+	r.mu.RUnlock()
+	sort.Slice(hs, func(i, j int) bool { return hs[i].Name < hs[j].Name })
+	return hs
 }
 
 // ProviderNames returns registered provider names in sorted order.
@@ -105,7 +105,7 @@ func (s Provider) Send(ctx context.Context, to mail.Address, rawEmailMessage []b
 			return fmt.Errorf("smtp auth: %w", err)
 		}
 	}
-	if err = c.Mail(s.From); err != nil {
+	if err = c.Mail(email.EnvelopeFrom(ctx, s.From)); err != nil {
 		return fmt.Errorf("smtp from: %w", err)
 	}
 	if err = c.Rcpt(to.Address); err != nil {
@@ -0,0 +1,176 @@
+// Package emailbounce records delivery failures and spam complaints reported
+// for outgoing mail and suppresses addresses that should not be mailed again.
+package emailbounce
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/emailreply"
+)
+
+// Kind classifies a delivery event.
+type Kind string
+
+const (
+	// KindHard is a permanent failure such as an unknown mailbox.
+	KindHard Kind = "hard_bounce"
+	// KindSoft is a temporary failure such as a full mailbox.
+	KindSoft Kind = "soft_bounce"
+	// KindComplaint is a recipient marking a message as spam.
+	KindComplaint Kind = "complaint"
+)
+
+// Suppresses reports whether events of kind k stop further mail to the
+// address.
+func (k Kind) Suppresses() bool {
+	return k == KindHard || k == KindComplaint
+}
+
+// Sources of delivery events.
+const (
+	SourceMail     = "mail"
+	SourceSES      = "ses"
+	SourceSendGrid = "sendgrid"
+)
+
+// maxDetail bounds the diagnostic text stored with an event.
+const maxDetail = 1000
+
+// Event is a bounce or complaint reported for one recipient.
+type Event struct {
+	Address string
+	Kind    Kind
+	// Detail is the diagnostic text supplied by the reporting server.
+	Detail string
+}
+
+// Processor records events against the user_emails rows they refer to.
+type Processor struct {
+	Queries db.Querier
+	// Key and Domain verify the return paths reports are delivered to. Mailed
+	// reports are only acted on for the recipient their return path names.
+	Key    string
+	Domain string
+}
+
+// Record stores ev and suppresses the address for hard bounces and
+// complaints. Events for addresses that do not belong to a user are ignored.
+func (p *Processor) Record(ctx context.Context, source string, ev Event) error {
+	addr := strings.TrimSpace(ev.Address)
+	if addr == "" {
+		return nil
+	}
+	ue, err := p.Queries.GetUserEmailByEmail(ctx, addr)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Printf("email %s from %s for unknown address %s", ev.Kind, source, addr)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get user email: %w", err)
+	}
+	detail := sql.NullString{String: truncate(ev.Detail, maxDetail), Valid: ev.Detail != ""}
+	if err := p.Queries.SystemInsertEmailBounce(ctx, db.SystemInsertEmailBounceParams{
+		UserEmailID: ue.ID,
+		Kind:        string(ev.Kind),
+		Source:      source,
+		Detail:      detail,
+	}); err != nil {
+		return fmt.Errorf("insert bounce: %w", err)
+	}
+	if !ev.Kind.Suppresses() {
+		return nil
+	}
+	if err := p.Queries.SystemSuppressEmail(ctx, db.SystemSuppressEmailParams{
+		Email:  ue.Email,
+		UserID: sql.NullInt32{Int32: ue.UserID, Valid: true},
+		Reason: string(ev.Kind),
+		Detail: detail,
+	}); err != nil {
+		return fmt.Errorf("suppress email: %w", err)
+	}
+	log.Printf("suppressed email %s for user %d after %s from %s", ue.Email, ue.UserID, ev.Kind, source)
+	return nil
+}
+
+// RecordAll stores each event, stopping at the first error.
+func (p *Processor) RecordAll(ctx context.Context, source string, evs []Event) error {
+	for _, ev := range evs {
+		if err := p.Record(ctx, source, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Accepts reports whether rcpt is a return path issued by ReturnPath.
+func (p *Processor) Accepts(rcpt string) bool {
+	_, err := ParseReturnPath(p.Key, p.Domain, rcpt)
+	return err == nil
+}
+
+// Deliver records the events in a report delivered over LMTP to the return
+// path rcpt.
+func (p *Processor) Deliver(ctx context.Context, rcpt string, msg []byte) error {
+	addr, err := ParseReturnPath(p.Key, p.Domain, rcpt)
+	if err != nil {
+		return fmt.Errorf("%w: %v", emailreply.ErrInvalidAddress, err)
+	}
+	rep, err := parseReport(msg)
+	if errors.Is(err, ErrNotReport) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return p.recordFor(ctx, addr, rep.events)
+}
+
+// DeliverMessage records the events in a delivery status or feedback report.
+// The return path is read from the headers of the report or of the returned
+// message. Other messages, such as auto-replies, are discarded.
+func (p *Processor) DeliverMessage(ctx context.Context, msg []byte) error {
+	rep, err := parseReport(msg)
+	if errors.Is(err, ErrNotReport) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	addr, ok := p.findReturnPath(rep.header)
+	if !ok {
+		addr, ok = p.findReturnPath(rep.original)
+	}
+	if !ok {
+		return fmt.Errorf("%w: %v", emailreply.ErrInvalidAddress, ErrInvalidReturnPath)
+	}
+	return p.recordFor(ctx, addr, rep.events)
+}
+
+// recordFor records the events about addr, the recipient a report's return
+// path was issued for. Events naming anyone else are ignored so a report
+// cannot suppress addresses the site never mailed it about.
+func (p *Processor) recordFor(ctx context.Context, addr string, evs []Event) error {
+	for _, ev := range evs {
+		if !strings.EqualFold(strings.TrimSpace(ev.Address), addr) {
+			log.Printf("email %s for %s ignored: report returned mail for %s", ev.Kind, ev.Address, addr)
+			continue
+		}
+		if err := p.Record(ctx, SourceMail, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
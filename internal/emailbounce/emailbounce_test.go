@@ -0,0 +1,273 @@
+package emailbounce
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/email"
+	"github.com/arran4/goa4web/internal/emailreply"
+)
+
+const dsn = "From: MAILER-DAEMON@mx.example.org\r\n" +
+	"To: noreply@example.com\r\n" +
+	"Subject: Undelivered Mail Returned to Sender\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/report; report-type=delivery-status; boundary=\"b1\"\r\n" +
+	"\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"\r\n" +
+	"Your message could not be delivered.\r\n" +
+	"--b1\r\n" +
+	"Content-Type: message/delivery-status\r\n" +
+	"\r\n" +
+	"Reporting-MTA: dns; mx.example.org\r\n" +
+	"Arrival-Date: Sat, 17 Oct 2026 10:00:00 +0000\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; gone@example.org\r\n" +
+	"Original-Recipient: rfc822;Gone@example.org\r\n" +
+	"Action: failed\r\n" +
+	"Status: 5.1.1\r\n" +
+	"Diagnostic-Code: smtp; 550 5.1.1 <gone@example.org>: Recipient address rejected\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; full@example.org\r\n" +
+	"Action: failed\r\n" +
+	"Status: 5.2.2\r\n" +
+	"\r\n" +
+	"Final-Recipient: rfc822; ok@example.org\r\n" +
+	"Action: delivered\r\n" +
+	"Status: 2.0.0\r\n" +
+	"--b1\r\n" +
+	"Content-Type: text/rfc822-headers\r\n" +
+	"\r\n" +
+	"To: gone@example.org\r\n" +
+	"Subject: New reply\r\n" +
+	"--b1--\r\n"
+
+const arf = "From: abuse@isp.example\r\n" +
+	"To: noreply@example.com\r\n" +
+	"Subject: FW: New reply\r\n" +
+	"MIME-Version: 1.0\r\n" +
+	"Content-Type: multipart/report; report-type=feedback-report; boundary=\"b2\"\r\n" +
+	"\r\n" +
+	"--b2\r\n" +
+	"Content-Type: text/plain\r\n" +
+	"\r\n" +
+	"This is an abuse report.\r\n" +
+	"--b2\r\n" +
+	"Content-Type: message/feedback-report\r\n" +
+	"\r\n" +
+	"Feedback-Type: abuse\r\n" +
+	"User-Agent: ISP-FBL/1.0\r\n" +
+	"Version: 1\r\n" +
+	"--b2\r\n" +
+	"Content-Type: message/rfc822\r\n" +
+	"\r\n" +
+	"From: noreply@example.com\r\n" +
+	"To: \"Bob\" <bob@isp.example>\r\n" +
+	"Subject: New reply\r\n" +
+	"\r\n" +
+	"body\r\n" +
+	"--b2--\r\n"
+
+func TestParseReportDSN(t *testing.T) {
+	evs, err := ParseReport([]byte(dsn))
+	if err != nil {
+		t.Fatalf("ParseReport: %v", err)
+	}
+	want := []Event{
+		{Address: "Gone@example.org", Kind: KindHard, Detail: "550 5.1.1 <gone@example.org>: Recipient address rejected"},
+		{Address: "full@example.org", Kind: KindSoft, Detail: "5.2.2"},
+	}
+	if len(evs) != len(want) {
+		t.Fatalf("events %+v", evs)
+	}
+	for i := range want {
+		if evs[i] != want[i] {
+			t.Errorf("event %d = %+v want %+v", i, evs[i], want[i])
+		}
+	}
+}
+
+func TestParseReportFeedback(t *testing.T) {
+	evs, err := ParseReport([]byte(arf))
+	if err != nil {
+		t.Fatalf("ParseReport: %v", err)
+	}
+	if len(evs) != 1 || evs[0] != (Event{Address: "bob@isp.example", Kind: KindComplaint, Detail: "abuse"}) {
+		t.Fatalf("events %+v", evs)
+	}
+
+	withRcpt := strings.Replace(arf, "Version: 1\r\n", "Version: 1\r\nOriginal-Rcpt-To: <carol@isp.example>\r\n", 1)
+	evs, err = ParseReport([]byte(withRcpt))
+	if err != nil || len(evs) != 1 || evs[0].Address != "carol@isp.example" {
+		t.Fatalf("events %+v err %v", evs, err)
+	}
+
+	notSpam := strings.Replace(arf, "Feedback-Type: abuse", "Feedback-Type: not-spam", 1)
+	if evs, err := ParseReport([]byte(notSpam)); err != nil || len(evs) != 0 {
+		t.Fatalf("not-spam events %+v err %v", evs, err)
+	}
+}
+
+func TestParseReportNotReport(t *testing.T) {
+	msg := "From: a@example.org\r\nSubject: Out of office\r\nContent-Type: text/plain\r\n\r\nAway.\r\n"
+	if _, err := ParseReport([]byte(msg)); !errors.Is(err, ErrNotReport) {
+		t.Fatalf("err=%v want ErrNotReport", err)
+	}
+	if _, err := ParseReport([]byte("no header end")); !emailreply.IsPermanent(err) {
+		t.Fatalf("err=%v want permanent", err)
+	}
+}
+
+func TestProcessorRecord(t *testing.T) {
+	q := &db.QuerierStub{
+		GetUserEmailByEmailFn: func(_ context.Context, email string) (*db.UserEmail, error) {
+			if email != "gone@example.org" {
+				return nil, sql.ErrNoRows
+			}
+			return &db.UserEmail{ID: 7, UserID: 3, Email: "gone@example.org"}, nil
+		},
+	}
+	p := &Processor{Queries: q}
+	ctx := context.Background()
+
+	if err := p.Record(ctx, SourceSES, Event{Address: "gone@example.org", Kind: KindSoft, Detail: "mailbox full"}); err != nil {
+		t.Fatalf("Record soft: %v", err)
+	}
+	if len(q.SystemInsertEmailBounceCalls) != 1 || len(q.SystemSuppressEmailCalls) != 0 {
+		t.Fatalf("soft bounce calls: %+v %+v", q.SystemInsertEmailBounceCalls, q.SystemSuppressEmailCalls)
+	}
+	got := q.SystemInsertEmailBounceCalls[0]
+	if got.UserEmailID != 7 || got.Kind != "soft_bounce" || got.Source != "ses" || got.Detail.String != "mailbox full" {
+		t.Fatalf("bounce %+v", got)
+	}
+
+	if err := p.Record(ctx, SourceMail, Event{Address: "gone@example.org", Kind: KindHard}); err != nil {
+		t.Fatalf("Record hard: %v", err)
+	}
+	if len(q.SystemSuppressEmailCalls) != 1 {
+		t.Fatalf("suppress calls %+v", q.SystemSuppressEmailCalls)
+	}
+	s := q.SystemSuppressEmailCalls[0]
+	if s.Email != "gone@example.org" || s.UserID.Int32 != 3 || s.Reason != "hard_bounce" || s.Detail.Valid {
+		t.Fatalf("suppression %+v", s)
+	}
+
+	if err := p.Record(ctx, SourceMail, Event{Address: "stranger@example.org", Kind: KindComplaint}); err != nil {
+		t.Fatalf("Record unknown: %v", err)
+	}
+	if len(q.SystemInsertEmailBounceCalls) != 2 || len(q.SystemSuppressEmailCalls) != 1 {
+		t.Fatal("unknown address recorded")
+	}
+}
+
+func TestReturnPath(t *testing.T) {
+	rp := ReturnPath("secret", "bounces.example.com", "Gone@Example.org")
+	if !strings.HasPrefix(rp, "bounce+") || !strings.HasSuffix(rp, "@bounces.example.com") || rp != strings.ToLower(rp) {
+		t.Fatalf("return path %q", rp)
+	}
+	for _, a := range []string{rp, strings.ToUpper(rp), "<" + rp + ">"} {
+		if got, err := ParseReturnPath("secret", "BOUNCES.example.com", a); err != nil || got != "gone@example.org" {
+			t.Fatalf("ParseReturnPath(%q)=%q %v", a, got, err)
+		}
+	}
+	local, _, _ := strings.Cut(rp, "@")
+	payload, sig, _ := strings.Cut(local, ".")
+	other := ReturnPath("secret", "bounces.example.com", "victim@example.org")
+	otherLocal, _, _ := strings.Cut(other, "@")
+	otherPayload, _, _ := strings.Cut(otherLocal, ".")
+	tests := map[string]struct{ key, addr string }{
+		"wrong key":     {"other", rp},
+		"wrong domain":  {"secret", local + "@example.com"},
+		"other payload": {"secret", otherPayload + "." + sig + "@bounces.example.com"},
+		"no signature":  {"secret", payload + "@bounces.example.com"},
+		"plain address": {"secret", "noreply@bounces.example.com"},
+	}
+	for name, tc := range tests {
+		if _, err := ParseReturnPath(tc.key, "bounces.example.com", tc.addr); !errors.Is(err, ErrInvalidReturnPath) {
+			t.Errorf("%s: ParseReturnPath(%q) err=%v", name, tc.addr, err)
+		}
+	}
+}
+
+func TestReturnPathProvider(t *testing.T) {
+	var from string
+	p := &ReturnPathProvider{Provider: providerFunc(func(ctx context.Context) { from = email.EnvelopeFrom(ctx, "noreply@example.com") }), Key: "secret", Domain: "bounces.example.com"}
+	if err := p.Send(context.Background(), mail.Address{Address: "bob@example.org"}, nil); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	if rcpt, err := ParseReturnPath("secret", "bounces.example.com", from); err != nil || rcpt != "bob@example.org" {
+		t.Fatalf("envelope from %q: %q %v", from, rcpt, err)
+	}
+}
+
+type providerFunc func(ctx context.Context)
+
+func (f providerFunc) Send(ctx context.Context, _ mail.Address, _ []byte) error {
+	f(ctx)
+	return nil
+}
+
+func (providerFunc) TestConfig(context.Context) error { return nil }
+
+func newDeliverTest() (*Processor, *db.QuerierStub) {
+	q := &db.QuerierStub{
+		GetUserEmailByEmailFn: func(_ context.Context, email string) (*db.UserEmail, error) {
+			return &db.UserEmail{ID: 1, UserID: 1, Email: email}, nil
+		},
+	}
+	return &Processor{Queries: q, Key: "secret", Domain: "bounces.example.com"}, q
+}
+
+func TestProcessorDeliverMessage(t *testing.T) {
+	p, q := newDeliverTest()
+	ctx := context.Background()
+	// Only the recipient the return path was issued for is acted on.
+	rp := ReturnPath(p.Key, p.Domain, "gone@example.org")
+	msg := strings.Replace(dsn, "To: noreply@example.com", "Delivered-To: "+rp+"\r\nTo: noreply@example.com", 1)
+	if err := p.DeliverMessage(ctx, []byte(msg)); err != nil {
+		t.Fatalf("DeliverMessage: %v", err)
+	}
+	if len(q.SystemInsertEmailBounceCalls) != 1 || len(q.SystemSuppressEmailCalls) != 1 || q.SystemSuppressEmailCalls[0].Email != "Gone@example.org" {
+		t.Fatalf("calls %+v %+v", q.SystemInsertEmailBounceCalls, q.SystemSuppressEmailCalls)
+	}
+	if err := p.DeliverMessage(ctx, []byte(dsn)); !emailreply.IsPermanent(err) {
+		t.Fatalf("report without return path err=%v want permanent", err)
+	}
+	if len(q.SystemInsertEmailBounceCalls) != 1 {
+		t.Fatal("report without return path recorded")
+	}
+	if err := p.DeliverMessage(ctx, []byte("Subject: hi\r\n\r\nhello\r\n")); err != nil {
+		t.Fatalf("non-report: %v", err)
+	}
+
+	// Feedback loops deliver to a fixed address; the returned message keeps
+	// the return path it was sent with.
+	withReturnPath := strings.Replace(arf, "From: noreply@example.com\r\nTo:", "Return-Path: <"+ReturnPath(p.Key, p.Domain, "bob@isp.example")+">\r\nFrom: noreply@example.com\r\nTo:", 1)
+	if err := p.DeliverMessage(ctx, []byte(withReturnPath)); err != nil {
+		t.Fatalf("DeliverMessage feedback: %v", err)
+	}
+	if len(q.SystemSuppressEmailCalls) != 2 || q.SystemSuppressEmailCalls[1].Email != "bob@isp.example" {
+		t.Fatalf("suppress calls %+v", q.SystemSuppressEmailCalls)
+	}
+}
+
+func TestProcessorDeliver(t *testing.T) {
+	p, q := newDeliverTest()
+	rp := ReturnPath(p.Key, p.Domain, "full@example.org")
+	if p.Accepts("noreply@bounces.example.com") || !p.Accepts(rp) {
+		t.Fatal("Accepts does not check the return path")
+	}
+	if err := p.Deliver(context.Background(), rp, []byte(dsn)); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	if len(q.SystemInsertEmailBounceCalls) != 1 || q.SystemInsertEmailBounceCalls[0].Kind != string(KindSoft) || len(q.SystemSuppressEmailCalls) != 0 {
+		t.Fatalf("calls %+v %+v", q.SystemInsertEmailBounceCalls, q.SystemSuppressEmailCalls)
+	}
+}
@@ -0,0 +1,194 @@
+package emailbounce
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/emailreply"
+)
+
+// ErrNotReport reports a message that is neither a delivery status
+// notification nor a feedback report.
+var ErrNotReport = errors.New("not a delivery report")
+
+// ParseReport extracts events from a delivery status notification (RFC 3464)
+// or an abuse feedback report (RFC 5965).
+func ParseReport(msg []byte) ([]Event, error) {
+	rep, err := parseReport(msg)
+	if err != nil {
+		return nil, err
+	}
+	return rep.events, nil
+}
+
+// report is a parsed delivery status or feedback report.
+type report struct {
+	events []Event
+	// header is the header of the report itself.
+	header mail.Header
+	// original is the header of the returned message, when included.
+	original mail.Header
+}
+
+func parseReport(msg []byte) (*report, error) {
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", emailreply.ErrMalformed, err)
+	}
+	mt, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	if err != nil || mt != "multipart/report" || params["boundary"] == "" {
+		return nil, ErrNotReport
+	}
+	var status, feedback []byte
+	var original textproto.MIMEHeader
+	mr := multipart.NewReader(m.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", emailreply.ErrMalformed, err)
+		}
+		ct, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		switch ct {
+		case "message/delivery-status", "message/global-delivery-status":
+			status, err = io.ReadAll(p)
+		case "message/feedback-report":
+			feedback, err = io.ReadAll(p)
+		case "message/rfc822", "message/global", "text/rfc822-headers", "message/global-headers":
+			original, err = readFields(p)
+		}
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("%w: %v", emailreply.ErrMalformed, err)
+		}
+	}
+	rep := &report{header: m.Header, original: mail.Header(original)}
+	switch {
+	case status != nil:
+		rep.events, err = parseDeliveryStatus(status)
+	case feedback != nil:
+		rep.events, err = parseFeedback(feedback, original)
+	default:
+		return nil, ErrNotReport
+	}
+	if err != nil {
+		return nil, err
+	}
+	return rep, nil
+}
+
+// readFields reads one block of header fields from r.
+func readFields(r io.Reader) (textproto.MIMEHeader, error) {
+	return textproto.NewReader(bufio.NewReader(r)).ReadMIMEHeader()
+}
+
+// parseDeliveryStatus reads the per-message fields followed by one block of
+// fields for each recipient.
+func parseDeliveryStatus(b []byte) ([]Event, error) {
+	tr := textproto.NewReader(bufio.NewReader(bytes.NewReader(b)))
+	if _, err := tr.ReadMIMEHeader(); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%w: %v", emailreply.ErrMalformed, err)
+	}
+	var evs []Event
+	for {
+		h, err := tr.ReadMIMEHeader()
+		if ev, ok := recipientEvent(h); ok {
+			evs = append(evs, ev)
+		}
+		if err == io.EOF {
+			return evs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", emailreply.ErrMalformed, err)
+		}
+	}
+}
+
+// recipientEvent converts the fields reported for one recipient. Only failed
+// and delayed deliveries produce events.
+func recipientEvent(h textproto.MIMEHeader) (Event, bool) {
+	status := strings.TrimSpace(h.Get("Status"))
+	var kind Kind
+	switch strings.ToLower(strings.TrimSpace(h.Get("Action"))) {
+	case "failed":
+		kind = KindSoft
+		if permanentStatus(status) {
+			kind = KindHard
+		}
+	case "delayed":
+		kind = KindSoft
+	default:
+		return Event{}, false
+	}
+	addr := typedValue(h.Get("Original-Recipient"))
+	if addr == "" {
+		addr = typedValue(h.Get("Final-Recipient"))
+	}
+	if addr == "" {
+		return Event{}, false
+	}
+	detail := typedValue(h.Get("Diagnostic-Code"))
+	if detail == "" {
+		detail = status
+	}
+	return Event{Address: addr, Kind: kind, Detail: detail}, true
+}
+
+// permanentStatus reports whether an enhanced status code (RFC 3463) says the
+// address itself is unusable: a bad destination address or a disabled
+// mailbox. Other permanent failures, such as content rejections, may succeed
+// for a different message.
+func permanentStatus(status string) bool {
+	return strings.HasPrefix(status, "5.1.") || status == "5.2.1"
+}
+
+// typedValue strips the type prefix from fields such as
+// "Final-Recipient: rfc822; user@example.com".
+func typedValue(v string) string {
+	if _, after, ok := strings.Cut(v, ";"); ok {
+		v = after
+	}
+	return strings.Trim(strings.TrimSpace(v), "<>")
+}
+
+// parseFeedback reads a feedback report. The recipient comes from
+// Original-Rcpt-To when the reporter includes it and otherwise from the To
+// header of the returned message.
+func parseFeedback(b []byte, original textproto.MIMEHeader) ([]Event, error) {
+	h, err := readFields(bytes.NewReader(b))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("%w: %v", emailreply.ErrMalformed, err)
+	}
+	ft := strings.ToLower(strings.TrimSpace(h.Get("Feedback-Type")))
+	if ft == "not-spam" {
+		return nil, nil
+	}
+	addrs := h.Values("Original-Rcpt-To")
+	if len(addrs) == 0 && original != nil {
+		list, err := mail.ParseAddressList(original.Get("To"))
+		if err == nil {
+			for _, a := range list {
+				addrs = append(addrs, a.Address)
+			}
+		}
+	}
+	var evs []Event
+	for _, a := range addrs {
+		if a = typedValue(a); a != "" {
+			evs = append(evs, Event{Address: a, Kind: KindComplaint, Detail: ft})
+		}
+	}
+	return evs, nil
+}
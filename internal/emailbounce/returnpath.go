@@ -0,0 +1,108 @@
+package emailbounce
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/email"
+	"github.com/arran4/goa4web/internal/sign"
+)
+
+// returnPathPrefix starts the local part of every return path.
+const returnPathPrefix = "bounce+"
+
+// returnPathSigLength is the number of hex characters of the signature kept in
+// a return path.
+const returnPathSigLength = 32
+
+// ErrInvalidReturnPath reports a recipient that is not a return path issued
+// by ReturnPath.
+var ErrInvalidReturnPath = errors.New("invalid return path")
+
+// returnPathEncoding is lower cased on output and upper cased on input so
+// return paths survive mail systems that fold the case of local parts.
+var returnPathEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func returnPathSig(key, rcpt string) string {
+	return sign.Sign("emailbounce:"+strings.ToLower(rcpt), key)[:returnPathSigLength]
+}
+
+// ReturnPath returns the envelope sender for mail to rcpt. It names rcpt and
+// is signed, so reports delivered to it can only concern mail sent to rcpt.
+func ReturnPath(key, domain, rcpt string) string {
+	rcpt = strings.ToLower(rcpt)
+	payload := strings.ToLower(returnPathEncoding.EncodeToString([]byte(rcpt)))
+	return fmt.Sprintf("%s%s.%s@%s", returnPathPrefix, payload, returnPathSig(key, rcpt), domain)
+}
+
+// ParseReturnPath verifies addr, which must be in domain, and returns the
+// recipient it was issued for.
+func ParseReturnPath(key, domain, addr string) (string, error) {
+	addr = strings.Trim(strings.TrimSpace(addr), "<>")
+	local, host, ok := strings.Cut(addr, "@")
+	if !ok || domain == "" || !strings.EqualFold(host, domain) {
+		return "", ErrInvalidReturnPath
+	}
+	if len(local) < len(returnPathPrefix) || !strings.EqualFold(local[:len(returnPathPrefix)], returnPathPrefix) {
+		return "", ErrInvalidReturnPath
+	}
+	payload, sig, ok := strings.Cut(local[len(returnPathPrefix):], ".")
+	if !ok {
+		return "", ErrInvalidReturnPath
+	}
+	b, err := returnPathEncoding.DecodeString(strings.ToUpper(payload))
+	if err != nil || len(b) == 0 {
+		return "", ErrInvalidReturnPath
+	}
+	rcpt := string(b)
+	if !hmac.Equal([]byte(returnPathSig(key, rcpt)), []byte(strings.ToLower(sig))) {
+		return "", fmt.Errorf("%w: signature mismatch", ErrInvalidReturnPath)
+	}
+	return rcpt, nil
+}
+
+// returnPathHeaders are searched, in order, for a return path. The first
+// four name the mailbox a report was delivered to; Return-Path is also read
+// from the copy of the original message a report carries.
+var returnPathHeaders = []string{"Delivered-To", "X-Original-To", "Envelope-To", "To", "Return-Path"}
+
+// findReturnPath returns the recipient named by the first valid return path
+// in hdr.
+func (p *Processor) findReturnPath(hdr mail.Header) (string, bool) {
+	for _, h := range returnPathHeaders {
+		for _, v := range hdr[h] {
+			list, err := mail.ParseAddressList(v)
+			if err != nil {
+				continue
+			}
+			for _, a := range list {
+				if rcpt, err := ParseReturnPath(p.Key, p.Domain, a.Address); err == nil {
+					return rcpt, true
+				}
+			}
+		}
+	}
+	return "", false
+}
+
+// ReturnPathProvider sends each message with the recipient's ReturnPath as
+// the envelope sender.
+type ReturnPathProvider struct {
+	email.Provider
+	Key    string
+	Domain string
+}
+
+// Send sends rawEmailMessage with the wrapped provider.
+func (p *ReturnPathProvider) Send(ctx context.Context, to mail.Address, rawEmailMessage []byte) error {
+	ctx = email.WithEnvelopeFrom(ctx, ReturnPath(p.Key, p.Domain, to.Address))
+	return p.Provider.Send(ctx, to, rawEmailMessage)
+}
+
+// Unwrap returns the provider messages are sent with.
+func (p *ReturnPathProvider) Unwrap() email.Provider { return p.Provider }
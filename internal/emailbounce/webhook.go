@@ -0,0 +1,196 @@
+package emailbounce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/sign"
+)
+
+// webhookSignPrefix separates webhook tokens from other values signed with
+// the link key.
+const webhookSignPrefix = "email-events:"
+
+// WebhookToken returns the path token that authenticates event webhooks from
+// provider.
+func WebhookToken(key, provider string) string {
+	return sign.Sign(webhookSignPrefix+provider, key, sign.WithOutNonce())
+}
+
+// VerifyWebhookToken reports whether token was issued for provider.
+func VerifyWebhookToken(key, provider, token string) bool {
+	if key == "" || token == "" {
+		return false
+	}
+	return sign.Verify(webhookSignPrefix+provider, token, key, sign.WithOutNonce()) == nil
+}
+
+// snsEnvelope is the outer message SNS posts to HTTP subscribers.
+type snsEnvelope struct {
+	Type         string
+	Message      string
+	SubscribeURL string
+}
+
+// sesNotification covers both SES notifications and event publishing
+// records, which name the type differently.
+type sesNotification struct {
+	NotificationType string `json:"notificationType"`
+	EventType        string `json:"eventType"`
+	Bounce           struct {
+		BounceType        string `json:"bounceType"`
+		BounceSubType     string `json:"bounceSubType"`
+		BouncedRecipients []struct {
+			EmailAddress   string `json:"emailAddress"`
+			DiagnosticCode string `json:"diagnosticCode"`
+		} `json:"bouncedRecipients"`
+	} `json:"bounce"`
+	Complaint struct {
+		ComplaintFeedbackType string `json:"complaintFeedbackType"`
+		ComplainedRecipients  []struct {
+			EmailAddress string `json:"emailAddress"`
+		} `json:"complainedRecipients"`
+	} `json:"complaint"`
+}
+
+// ParseSES extracts events from an SES notification, either wrapped in an
+// SNS envelope or posted raw. A subscription confirmation yields no events
+// and the URL to visit to confirm it.
+func ParseSES(body []byte) (evs []Event, subscribeURL string, err error) {
+	var env snsEnvelope
+	if err := json.Unmarshal(body, &env); err != nil {
+		return nil, "", fmt.Errorf("decode sns: %w", err)
+	}
+	switch env.Type {
+	case "SubscriptionConfirmation":
+		return nil, env.SubscribeURL, nil
+	case "Notification":
+		body = []byte(env.Message)
+	case "":
+	default:
+		return nil, "", nil
+	}
+	var n sesNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, "", fmt.Errorf("decode ses: %w", err)
+	}
+	typ := n.NotificationType
+	if typ == "" {
+		typ = n.EventType
+	}
+	switch typ {
+	case "Bounce":
+		kind := KindSoft
+		if n.Bounce.BounceType == "Permanent" {
+			kind = KindHard
+		}
+		for _, r := range n.Bounce.BouncedRecipients {
+			detail := r.DiagnosticCode
+			if detail == "" {
+				detail = n.Bounce.BounceType + "/" + n.Bounce.BounceSubType
+			}
+			evs = append(evs, Event{Address: r.EmailAddress, Kind: kind, Detail: detail})
+		}
+	case "Complaint":
+		ft := n.Complaint.ComplaintFeedbackType
+		if ft == "not-spam" {
+			return nil, "", nil
+		}
+		for _, r := range n.Complaint.ComplainedRecipients {
+			evs = append(evs, Event{Address: r.EmailAddress, Kind: KindComplaint, Detail: ft})
+		}
+	}
+	return evs, "", nil
+}
+
+// snsHost matches the hosts SNS sends subscription confirmation links for.
+var snsHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// ConfirmSubscription visits an SNS subscription confirmation link. Links to
+// any host other than an SNS endpoint are refused so a forged confirmation
+// cannot make the server fetch arbitrary URLs.
+func ConfirmSubscription(ctx context.Context, client *http.Client, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("parse subscribe url: %w", err)
+	}
+	if u.Scheme != "https" || !snsHost.MatchString(u.Hostname()) || u.Port() != "" {
+		return fmt.Errorf("subscribe url host %q is not an sns endpoint", u.Host)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("confirm subscription: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("confirm subscription: %s", resp.Status)
+	}
+	return nil
+}
+
+// sendGridEvent is one entry of a SendGrid event webhook post.
+type sendGridEvent struct {
+	Email    string `json:"email"`
+	Event    string `json:"event"`
+	Type     string `json:"type"`
+	Reason   string `json:"reason"`
+	Status   string `json:"status"`
+	Response string `json:"response"`
+}
+
+// ParseSendGrid extracts events from a SendGrid event webhook post. Delivery,
+// open and click events are ignored.
+func ParseSendGrid(body []byte) ([]Event, error) {
+	var in []sendGridEvent
+	if err := json.Unmarshal(body, &in); err != nil {
+		return nil, fmt.Errorf("decode sendgrid: %w", err)
+	}
+	var evs []Event
+	for _, e := range in {
+		var kind Kind
+		detail := e.Reason
+		switch e.Event {
+		case "bounce":
+			kind = KindHard
+			if e.Type == "blocked" {
+				kind = KindSoft
+			}
+		case "deferred":
+			kind = KindSoft
+			detail = e.Response
+		case "spamreport":
+			kind = KindComplaint
+		case "dropped":
+			reason := strings.ToLower(e.Reason)
+			switch {
+			case strings.Contains(reason, "spam"):
+				kind = KindComplaint
+			case strings.Contains(reason, "bounce"), strings.Contains(reason, "invalid"):
+				kind = KindHard
+			default:
+				continue
+			}
+		default:
+			continue
+		}
+		if detail == "" {
+			detail = e.Status
+		}
+		evs = append(evs, Event{Address: e.Email, Kind: kind, Detail: detail})
+	}
+	return evs, nil
+}
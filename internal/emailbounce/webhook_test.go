@@ -0,0 +1,134 @@
+package emailbounce
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestWebhookToken(t *testing.T) {
+	tok := WebhookToken("k", SourceSES)
+	if !VerifyWebhookToken("k", SourceSES, tok) {
+		t.Fatal("token rejected")
+	}
+	if VerifyWebhookToken("k", SourceSendGrid, tok) {
+		t.Fatal("token accepted for another provider")
+	}
+	if VerifyWebhookToken("other", SourceSES, tok) || VerifyWebhookToken("", SourceSES, tok) {
+		t.Fatal("token accepted with wrong key")
+	}
+}
+
+func snsNotification(t *testing.T, msg string) []byte {
+	t.Helper()
+	b, err := json.Marshal(map[string]string{"Type": "Notification", "Message": msg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestParseSES(t *testing.T) {
+	bounce := `{"notificationType":"Bounce","bounce":{"bounceType":"Permanent","bounceSubType":"General",` +
+		`"bouncedRecipients":[{"emailAddress":"gone@example.org","diagnosticCode":"smtp; 550 5.1.1 user unknown"}]}}`
+	evs, sub, err := ParseSES(snsNotification(t, bounce))
+	if err != nil || sub != "" {
+		t.Fatalf("ParseSES: %v %q", err, sub)
+	}
+	if len(evs) != 1 || evs[0] != (Event{Address: "gone@example.org", Kind: KindHard, Detail: "smtp; 550 5.1.1 user unknown"}) {
+		t.Fatalf("events %+v", evs)
+	}
+
+	transient := `{"eventType":"Bounce","bounce":{"bounceType":"Transient","bounceSubType":"MailboxFull",` +
+		`"bouncedRecipients":[{"emailAddress":"full@example.org"}]}}`
+	evs, _, err = ParseSES([]byte(transient))
+	if err != nil || len(evs) != 1 || evs[0].Kind != KindSoft || evs[0].Detail != "Transient/MailboxFull" {
+		t.Fatalf("events %+v err %v", evs, err)
+	}
+
+	complaint := `{"notificationType":"Complaint","complaint":{"complaintFeedbackType":"abuse",` +
+		`"complainedRecipients":[{"emailAddress":"a@example.org"},{"emailAddress":"b@example.org"}]}}`
+	evs, _, err = ParseSES(snsNotification(t, complaint))
+	if err != nil || len(evs) != 2 || evs[1] != (Event{Address: "b@example.org", Kind: KindComplaint, Detail: "abuse"}) {
+		t.Fatalf("events %+v err %v", evs, err)
+	}
+
+	delivery := `{"notificationType":"Delivery","delivery":{"recipients":["a@example.org"]}}`
+	if evs, _, err := ParseSES(snsNotification(t, delivery)); err != nil || len(evs) != 0 {
+		t.Fatalf("delivery events %+v err %v", evs, err)
+	}
+
+	confirm := `{"Type":"SubscriptionConfirmation","SubscribeURL":"https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription"}`
+	evs, sub, err = ParseSES([]byte(confirm))
+	if err != nil || len(evs) != 0 || sub != "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription" {
+		t.Fatalf("confirmation %+v %q %v", evs, sub, err)
+	}
+
+	if _, _, err := ParseSES([]byte("not json")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
+
+func TestConfirmSubscription(t *testing.T) {
+	var visited string
+	client := &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		visited = r.URL.String()
+		return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Body: http.NoBody}, nil
+	})}
+	ctx := context.Background()
+	good := "https://sns.eu-west-1.amazonaws.com/?Action=ConfirmSubscription&Token=x"
+	if err := ConfirmSubscription(ctx, client, good); err != nil {
+		t.Fatalf("ConfirmSubscription: %v", err)
+	}
+	if visited != good {
+		t.Fatalf("visited %q", visited)
+	}
+	for _, bad := range []string{
+		"http://sns.eu-west-1.amazonaws.com/",
+		"https://evil.example.com/",
+		"https://sns.eu-west-1.amazonaws.com.evil.example/",
+		"https://sns.eu-west-1.amazonaws.com:8443/",
+		"https://127.0.0.1/",
+	} {
+		visited = ""
+		if err := ConfirmSubscription(ctx, client, bad); err == nil || visited != "" {
+			t.Errorf("%s accepted", bad)
+		}
+	}
+}
+
+func TestParseSendGrid(t *testing.T) {
+	body := `[
+		{"email":"gone@example.org","event":"bounce","type":"bounce","reason":"550 5.1.1 unknown user","status":"5.1.1"},
+		{"email":"blocked@example.org","event":"bounce","type":"blocked","reason":"550 blocked"},
+		{"email":"late@example.org","event":"deferred","response":"421 try later"},
+		{"email":"spam@example.org","event":"spamreport"},
+		{"email":"dropped@example.org","event":"dropped","reason":"Bounced Address"},
+		{"email":"unsub@example.org","event":"dropped","reason":"Unsubscribed Address"},
+		{"email":"ok@example.org","event":"delivered"}
+	]`
+	evs, err := ParseSendGrid([]byte(body))
+	if err != nil {
+		t.Fatalf("ParseSendGrid: %v", err)
+	}
+	want := []Event{
+		{Address: "gone@example.org", Kind: KindHard, Detail: "550 5.1.1 unknown user"},
+		{Address: "blocked@example.org", Kind: KindSoft, Detail: "550 blocked"},
+		{Address: "late@example.org", Kind: KindSoft, Detail: "421 try later"},
+		{Address: "spam@example.org", Kind: KindComplaint},
+		{Address: "dropped@example.org", Kind: KindHard, Detail: "Bounced Address"},
+	}
+	if len(evs) != len(want) {
+		t.Fatalf("events %+v", evs)
+	}
+	for i := range want {
+		if evs[i] != want[i] {
+			t.Errorf("event %d = %+v want %+v", i, evs[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package emailreply
+
+import (
+	"crypto/hmac"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/internal/sign"
+)
+
+// localPrefix starts the local part of every reply address.
+const localPrefix = "reply+"
+
+// sigLength is the number of hex characters of the signature kept in an
+// address. Half an HMAC-SHA256 keeps addresses short enough for mail clients
+// while leaving 128 bits to guess.
+const sigLength = 32
+
+// DefaultLifetime is how long a reply address is accepted after it is issued.
+const DefaultLifetime = 30 * 24 * time.Hour
+
+// ErrInvalidAddress reports a recipient that is not a valid reply address.
+var ErrInvalidAddress = errors.New("invalid reply address")
+
+// encoding is lower cased on output and upper cased on input so addresses
+// survive mail systems that fold the case of local parts.
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Target identifies who is replying and the form the reply is posted to.
+type Target struct {
+	UserID int32
+	// Task names the form task, such as "Reply".
+	Task string
+	// Path is the URL path the form is posted to.
+	Path string
+	// Expires is when the address stops being accepted. It is kept to the
+	// second.
+	Expires time.Time
+}
+
+func (t Target) signature(key string) string {
+	data := fmt.Sprintf("emailreply:%d:%s:%s", t.UserID, t.Task, t.Path)
+	return sign.Sign(data, key, sign.WithExpiry(t.Expires))[:sigLength]
+}
+
+// Address returns the signed reply address for t in domain.
+func Address(key, domain string, t Target) string {
+	payload := strings.ToLower(encoding.EncodeToString([]byte(t.Task + " " + t.Path)))
+	expires := strconv.FormatInt(t.Expires.Unix(), 36)
+	return fmt.Sprintf("%s%d.%s.%s.%s@%s", localPrefix, t.UserID, payload, expires, t.signature(key), domain)
+}
+
+// ParseAddress verifies addr, which must be in domain and not yet expired, and
+// returns its target.
+func ParseAddress(key, domain, addr string) (Target, error) {
+	addr = strings.Trim(strings.TrimSpace(addr), "<>")
+	local, host, ok := strings.Cut(addr, "@")
+	if !ok || !strings.EqualFold(host, domain) {
+		return Target{}, ErrInvalidAddress
+	}
+	if len(local) < len(localPrefix) || !strings.EqualFold(local[:len(localPrefix)], localPrefix) {
+		return Target{}, ErrInvalidAddress
+	}
+	parts := strings.Split(local[len(localPrefix):], ".")
+	if len(parts) != 4 {
+		return Target{}, ErrInvalidAddress
+	}
+	uid, err := strconv.ParseInt(parts[0], 10, 32)
+	if err != nil || uid <= 0 {
+		return Target{}, ErrInvalidAddress
+	}
+	payload, err := encoding.DecodeString(strings.ToUpper(parts[1]))
+	if err != nil {
+		return Target{}, ErrInvalidAddress
+	}
+	task, path, ok := strings.Cut(string(payload), " ")
+	if !ok || task == "" || !strings.HasPrefix(path, "/") {
+		return Target{}, ErrInvalidAddress
+	}
+	expires, err := strconv.ParseInt(strings.ToLower(parts[2]), 36, 64)
+	if err != nil {
+		return Target{}, ErrInvalidAddress
+	}
+	t := Target{UserID: int32(uid), Task: task, Path: path, Expires: time.Unix(expires, 0)}
+	if !hmac.Equal([]byte(t.signature(key)), []byte(strings.ToLower(parts[3]))) {
+		return Target{}, fmt.Errorf("%w: signature mismatch", ErrInvalidAddress)
+	}
+	if time.Now().After(t.Expires) {
+		return Target{}, fmt.Errorf("%w: expired at %v", ErrInvalidAddress, t.Expires)
+	}
+	return t, nil
+}
@@ -0,0 +1,61 @@
+package emailreply
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAddressRoundTrip(t *testing.T) {
+	want := Target{UserID: 42, Task: "Reply", Path: "/private/topic/3/thread/17/reply", Expires: time.Unix(time.Now().Add(time.Hour).Unix(), 0)}
+	addr := Address("secret", "reply.example.com", want)
+	if !strings.HasPrefix(addr, "reply+42.") || !strings.HasSuffix(addr, "@reply.example.com") {
+		t.Fatalf("address %q", addr)
+	}
+	if addr != strings.ToLower(addr) {
+		t.Fatalf("address %q is not lower case", addr)
+	}
+	for _, a := range []string{addr, strings.ToUpper(addr), "<" + addr + ">"} {
+		got, err := ParseAddress("secret", "REPLY.example.com", a)
+		if err != nil {
+			t.Fatalf("ParseAddress(%q): %v", a, err)
+		}
+		if got != want {
+			t.Fatalf("ParseAddress(%q)=%+v want %+v", a, got, want)
+		}
+	}
+}
+
+func TestParseAddressRejects(t *testing.T) {
+	expires := time.Now().Add(time.Hour)
+	addr := Address("secret", "reply.example.com", Target{UserID: 42, Task: "Reply", Path: "/forum/topic/1/thread/2/reply", Expires: expires})
+	local, _, _ := strings.Cut(addr, "@")
+	parts := strings.Split(local, ".")
+	other := Address("secret", "reply.example.com", Target{UserID: 43, Task: "Reply", Path: "/forum/topic/1/thread/2/reply", Expires: expires})
+	otherLocal, _, _ := strings.Cut(other, "@")
+	otherParts := strings.Split(otherLocal, ".")
+	later := strconv.FormatInt(expires.Add(24*time.Hour).Unix(), 36)
+	expired := Address("secret", "reply.example.com", Target{UserID: 42, Task: "Reply", Path: "/forum/topic/1/thread/2/reply", Expires: time.Now().Add(-time.Minute)})
+	tests := map[string]string{
+		"wrong key":       "",
+		"wrong domain":    local + "@example.com",
+		"other user":      "reply+43." + parts[1] + "." + parts[2] + "." + parts[3] + "@reply.example.com",
+		"swapped sig":     parts[0] + "." + parts[1] + "." + parts[2] + "." + otherParts[3] + "@reply.example.com",
+		"extended expiry": parts[0] + "." + parts[1] + "." + later + "." + parts[3] + "@reply.example.com",
+		"expired":         expired,
+		"no prefix":       strings.TrimPrefix(addr, "reply+"),
+		"bad payload":     parts[0] + ".!!." + parts[2] + "." + parts[3] + "@reply.example.com",
+		"missing part":    parts[0] + "." + parts[1] + "." + parts[3] + "@reply.example.com",
+	}
+	for name, a := range tests {
+		key := "secret"
+		if a == "" {
+			a, key = addr, "other"
+		}
+		if _, err := ParseAddress(key, "reply.example.com", a); !errors.Is(err, ErrInvalidAddress) {
+			t.Errorf("%s: ParseAddress(%q) err=%v want ErrInvalidAddress", name, a, err)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package emailreply
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+)
+
+// maxPartSize bounds how much of a single MIME part is read.
+const maxPartSize = 1 << 20
+
+var (
+	// ErrNoText reports a message without a usable text body.
+	ErrNoText = errors.New("no reply text")
+	// ErrMalformed reports a message that cannot be parsed.
+	ErrMalformed = errors.New("malformed message")
+)
+
+// Reply is the part of an inbound message that is posted as a comment.
+type Reply struct {
+	From      string
+	MessageID string
+	Text      string
+}
+
+// ParseReply reads an email message and returns the new text written by the
+// sender, without quoted text or signatures.
+func ParseReply(r io.Reader) (*Reply, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	reply := &Reply{MessageID: strings.TrimSpace(msg.Header.Get("Message-Id"))}
+	if from, err := msg.Header.AddressList("From"); err == nil && len(from) > 0 {
+		reply.From = from[0].Address
+	}
+	body, isHTML, err := textBody(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return nil, err
+	}
+	if isHTML {
+		body = htmlToText(body)
+	}
+	reply.Text = StripQuoted(body)
+	if reply.Text == "" {
+		return nil, ErrNoText
+	}
+	return reply, nil
+}
+
+// textBody finds the text/plain part of a message, falling back to text/html.
+func textBody(contentType, encoding string, body io.Reader) (text string, isHTML bool, err error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		mr := multipart.NewReader(body, params["boundary"])
+		var htmlText string
+		for {
+			part, err := mr.NextRawPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return "", false, fmt.Errorf("%w: read part: %v", ErrMalformed, err)
+			}
+			if strings.HasPrefix(part.Header.Get("Content-Disposition"), "attachment") {
+				continue
+			}
+			t, h, err := textBody(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if errors.Is(err, ErrNoText) {
+				continue
+			}
+			if err != nil {
+				return "", false, err
+			}
+			if !h {
+				return t, false, nil
+			}
+			if htmlText == "" {
+				htmlText = t
+			}
+		}
+		if htmlText != "" {
+			return htmlText, true, nil
+		}
+		return "", false, ErrNoText
+	case mediaType == "text/plain", mediaType == "text/html":
+		b, err := io.ReadAll(io.LimitReader(decodeTransfer(encoding, body), maxPartSize))
+		if err != nil {
+			return "", false, fmt.Errorf("%w: read body: %v", ErrMalformed, err)
+		}
+		if cs := strings.ToLower(params["charset"]); cs != "" && cs != "utf-8" && cs != "us-ascii" {
+			b = decodeLatin1(b)
+		}
+		return string(b), mediaType == "text/html", nil
+	}
+	return "", false, ErrNoText
+}
+
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, &newlineStripper{r: r})
+	}
+	return r
+}
+
+// newlineStripper drops line breaks so base64 bodies can be streamed.
+type newlineStripper struct{ r io.Reader }
+
+func (n *newlineStripper) Read(p []byte) (int, error) {
+	for {
+		c, err := n.r.Read(p)
+		j := 0
+		for _, b := range p[:c] {
+			if b != '\r' && b != '\n' {
+				p[j] = b
+				j++
+			}
+		}
+		if j > 0 || err != nil {
+			return j, err
+		}
+	}
+}
+
+// decodeLatin1 treats b as ISO-8859-1, the usual legacy charset of replies.
+func decodeLatin1(b []byte) []byte {
+	var buf bytes.Buffer
+	for _, c := range b {
+		buf.WriteRune(rune(c))
+	}
+	return buf.Bytes()
+}
+
+var (
+	htmlBreak = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</div>|</li>`)
+	htmlQuote = regexp.MustCompile(`(?is)<blockquote.*?</blockquote>`)
+	htmlTag   = regexp.MustCompile(`(?s)<[^>]*>`)
+)
+
+// htmlToText reduces an HTML body to its text, dropping quoted blocks.
+func htmlToText(s string) string {
+	s = htmlQuote.ReplaceAllString(s, "")
+	s = htmlBreak.ReplaceAllString(s, "\n")
+	s = htmlTag.ReplaceAllString(s, "")
+	return html.UnescapeString(s)
+}
+
+// separators start a forwarded or quoted original in Outlook style clients.
+var separators = []string{
+	"-----original message-----",
+	"________________________________",
+}
+
+// StripQuoted returns the new text of a plain text reply. Quoted lines, the
+// original message and signatures are removed.
+func StripQuoted(body string) string {
+	var lines []string
+	sc := bufio.NewScanner(strings.NewReader(strings.ReplaceAll(body, "\r\n", "\n")))
+	sc.Buffer(make([]byte, 0, 64*1024), maxPartSize)
+scan:
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), " \t")
+		trimmed := strings.TrimSpace(line)
+		lower := strings.ToLower(trimmed)
+		switch {
+		case line == "--", strings.HasPrefix(lower, "sent from my "):
+			break scan
+		case strings.HasPrefix(trimmed, ">"):
+			continue
+		// Clients introduce the quote with a line such as "On Mon, 1 Jan
+		// 2024, Alice <a@example.com> wrote:", which may wrap.
+		case strings.HasSuffix(lower, "wrote:") && strings.HasPrefix(lower, "on "):
+			break scan
+		case strings.HasSuffix(lower, "wrote:") && endsAttribution(lines):
+			lines = lines[:len(lines)-1]
+			break scan
+		}
+		for _, sep := range separators {
+			if strings.HasPrefix(lower, sep) {
+				break scan
+			}
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// endsAttribution reports whether an attribution wrapped onto a second line
+// started on the last line kept.
+func endsAttribution(lines []string) bool {
+	if len(lines) == 0 {
+		return false
+	}
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(lines[len(lines)-1])), "on ")
+}
@@ -0,0 +1,100 @@
+package emailreply
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStripQuoted(t *testing.T) {
+	tests := map[string]struct {
+		body, want string
+	}{
+		"gmail": {
+			body: "Sounds good to me.\n\nOn Mon, 1 Jan 2024 at 10:00, Alice <a@example.com> wrote:\n> Shall we meet?\n> Alice\n",
+			want: "Sounds good to me.",
+		},
+		"wrapped attribution": {
+			body: "Yes.\nOn Mon, 1 Jan 2024 at 10:00, Alice\n<a@example.com> wrote:\n> Shall we meet?",
+			want: "Yes.",
+		},
+		"interleaved": {
+			body: "> first question\nFirst answer\n> second question\nSecond answer",
+			want: "First answer\nSecond answer",
+		},
+		"signature": {
+			body: "Thanks!\n-- \nBob\nExample Corp",
+			want: "Thanks!",
+		},
+		"mobile": {
+			body: "On my way\n\nSent from my phone",
+			want: "On my way",
+		},
+		"outlook": {
+			body: "Agreed.\r\n\r\n-----Original Message-----\r\nFrom: Alice\r\nSubject: Plan\r\n",
+			want: "Agreed.",
+		},
+		"multi line": {
+			body: "Line one\n\nLine two\n",
+			want: "Line one\n\nLine two",
+		},
+	}
+	for name, tt := range tests {
+		if got := StripQuoted(tt.body); got != tt.want {
+			t.Errorf("%s: got %q want %q", name, got, tt.want)
+		}
+	}
+}
+
+func TestParseReplyMultipart(t *testing.T) {
+	msg := strings.Join([]string{
+		"From: Bob <bob@example.com>",
+		"To: reply+1.abc.def@reply.example.com",
+		"Message-ID: <1@example.com>",
+		"Content-Type: multipart/alternative; boundary=b1",
+		"",
+		"--b1",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"Caf=C3=A9 at noon?",
+		"",
+		"On Tue, Alice wrote:",
+		"> lunch?",
+		"--b1",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<p>Caf&eacute; at noon?</p><blockquote>lunch?</blockquote>",
+		"--b1--",
+		"",
+	}, "\r\n")
+	r, err := ParseReply(strings.NewReader(msg))
+	if err != nil {
+		t.Fatalf("ParseReply: %v", err)
+	}
+	if r.Text != "Café at noon?" {
+		t.Fatalf("text %q", r.Text)
+	}
+	if r.From != "bob@example.com" || r.MessageID != "<1@example.com>" {
+		t.Fatalf("from %q message id %q", r.From, r.MessageID)
+	}
+}
+
+func TestParseReplyHTMLOnly(t *testing.T) {
+	msg := "From: bob@example.com\r\nContent-Type: text/html\r\nContent-Transfer-Encoding: base64\r\n\r\n" +
+		"PHA+U2VlIHlvdSB0aGVyZSAmYW1wOyB0aGVuLjwvcD48YmxvY2txdW90ZT5v\r\ncmlnaW5hbDwvYmxvY2txdW90ZT4=\r\n"
+	r, err := ParseReply(strings.NewReader(msg))
+	if err != nil {
+		t.Fatalf("ParseReply: %v", err)
+	}
+	if r.Text != "See you there & then." {
+		t.Fatalf("text %q", r.Text)
+	}
+}
+
+func TestParseReplyOnlyQuoted(t *testing.T) {
+	msg := "From: bob@example.com\r\n\r\n> nothing new\r\n"
+	if _, err := ParseReply(strings.NewReader(msg)); !errors.Is(err, ErrNoText) {
+		t.Fatalf("err=%v want ErrNoText", err)
+	}
+}
@@ -0,0 +1,169 @@
+package emailreply
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/gorilla/sessions"
+
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// ErrRejected reports a reply the site did not accept, for instance because
+// the user may no longer post in the thread.
+var ErrRejected = errors.New("reply rejected")
+
+// Ingester posts email replies as comments. Each reply is submitted to
+// Handler as the same form post the web reply form makes, so the task's grant
+// checks, search indexing and notifications all apply.
+type Ingester struct {
+	// Key signs reply addresses.
+	Key string
+	// Domain is the domain of reply addresses.
+	Domain string
+	// Handler serves the site. It must populate CoreData and record task
+	// events but should not enforce CSRF tokens.
+	Handler http.Handler
+	// Queries lists the verified addresses of the user a reply address was
+	// issued to. Replies from any other sender are rejected.
+	Queries db.Querier
+}
+
+// Accepts reports whether rcpt is a valid reply address.
+func (in *Ingester) Accepts(rcpt string) bool {
+	_, err := ParseAddress(in.Key, in.Domain, rcpt)
+	return err == nil
+}
+
+// Deliver posts the reply in msg on behalf of the user rcpt was issued to. The
+// message must come from one of that user's verified addresses.
+func (in *Ingester) Deliver(ctx context.Context, rcpt string, msg []byte) error {
+	t, err := ParseAddress(in.Key, in.Domain, rcpt)
+	if err != nil {
+		return err
+	}
+	reply, err := ParseReply(bytes.NewReader(msg))
+	if err != nil {
+		return err
+	}
+	if err := in.checkSender(ctx, t.UserID, reply.From); err != nil {
+		return err
+	}
+	return in.Post(ctx, t, reply.Text)
+}
+
+// checkSender reports ErrRejected unless from is a verified address of userID.
+func (in *Ingester) checkSender(ctx context.Context, userID int32, from string) error {
+	if in.Queries == nil {
+		return fmt.Errorf("%w: no queries to check the sender", ErrRejected)
+	}
+	emails, err := in.Queries.SystemListVerifiedEmailsByUserID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("list verified emails: %w", err)
+	}
+	for _, e := range emails {
+		if from != "" && strings.EqualFold(e.Email, from) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q is not a verified address of user %d", ErrRejected, from, userID)
+}
+
+// recipientHeaders are searched, in order, for a reply address when a
+// message has no envelope, such as one read from a maildir.
+var recipientHeaders = []string{"Delivered-To", "X-Original-To", "Envelope-To", "To", "Cc"}
+
+// DeliverMessage posts the reply in msg to the first reply address found in
+// its headers.
+func (in *Ingester) DeliverMessage(ctx context.Context, msg []byte) error {
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	for _, h := range recipientHeaders {
+		for _, v := range m.Header[h] {
+			list, err := mail.ParseAddressList(v)
+			if err != nil {
+				continue
+			}
+			for _, a := range list {
+				if in.Accepts(a.Address) {
+					return in.Deliver(ctx, a.Address, msg)
+				}
+			}
+		}
+	}
+	return ErrInvalidAddress
+}
+
+// IsPermanent reports whether err means the reply can never be posted, as
+// opposed to a failure worth retrying later.
+func IsPermanent(err error) bool {
+	return errors.Is(err, ErrInvalidAddress) || errors.Is(err, ErrNoText) ||
+		errors.Is(err, ErrRejected) || errors.Is(err, ErrMalformed)
+}
+
+// Post submits text to the reply form of t acting as t.UserID.
+func (in *Ingester) Post(ctx context.Context, t Target, text string) error {
+	form := url.Values{}
+	form.Set("task", t.Task)
+	form.Set("replytext", text)
+	form.Set("language", "0")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.Path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.RemoteAddr = "127.0.0.1:0"
+
+	// Tasks read the user from the session so provide a request scoped
+	// session that is never persisted as a cookie.
+	session := sessions.NewSession(core.Store, core.SessionName)
+	session.Options = &sessions.Options{Path: "/", MaxAge: -1}
+	session.Values["UID"] = t.UserID
+	req = req.WithContext(context.WithValue(req.Context(), core.ContextValues("session"), session))
+
+	rw := &responseRecorder{header: http.Header{}, status: http.StatusOK}
+	in.Handler.ServeHTTP(rw, req)
+	// Reply tasks redirect to the new comment once it is saved and render
+	// the form again or an error page otherwise.
+	switch {
+	case rw.status >= http.StatusInternalServerError:
+		return fmt.Errorf("%s returned %d", t.Path, rw.status)
+	case rw.status != http.StatusSeeOther:
+		return fmt.Errorf("%w: %s returned %d", ErrRejected, t.Path, rw.status)
+	}
+	log.Printf("email reply from user %d posted to %s", t.UserID, rw.header.Get("Location"))
+	return nil
+}
+
+// responseRecorder keeps the status and headers of a response and discards
+// the body.
+type responseRecorder struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+}
+
+func (r *responseRecorder) Header() http.Header { return r.header }
+
+func (r *responseRecorder) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+	r.status = code
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.WriteHeader(http.StatusOK)
+	return len(b), nil
+}
@@ -0,0 +1,193 @@
+package emailreply
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/sessions"
+
+	"github.com/arran4/goa4web/core"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// postedReply records a form post received by replyHandler.
+type postedReply struct {
+	uid  int32
+	path string
+	task string
+	text string
+}
+
+// replyHandler stands in for the site. It redirects like a reply task unless
+// the reply text is "reject".
+type replyHandler struct {
+	mu    sync.Mutex
+	posts []postedReply
+}
+
+func (h *replyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sess, err := core.GetSession(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	uid, _ := sess.Values["UID"].(int32)
+	if r.PostFormValue("replytext") == "reject" {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	h.mu.Lock()
+	h.posts = append(h.posts, postedReply{uid: uid, path: r.URL.Path, task: r.PostFormValue("task"), text: r.PostFormValue("replytext")})
+	h.mu.Unlock()
+	http.Redirect(w, r, r.URL.Path+"#c1", http.StatusSeeOther)
+}
+
+func newTestIngester(t *testing.T) (*Ingester, *replyHandler) {
+	t.Helper()
+	store := core.Store
+	core.Store = sessions.NewCookieStore([]byte("test"))
+	t.Cleanup(func() { core.Store = store })
+	h := &replyHandler{}
+	q := &db.QuerierStub{SystemListVerifiedEmailsByUserIDReturn: []*db.UserEmail{{Email: "Bob@Example.com"}}}
+	return &Ingester{Key: "secret", Domain: "reply.example.com", Handler: h, Queries: q}, h
+}
+
+func replyMessage(to, text string) []byte {
+	return []byte(fmt.Sprintf("From: bob@example.com\r\nTo: %s\r\nSubject: Re: hello\r\n\r\n%s\r\n\r\nOn Mon, Alice wrote:\r\n> hello\r\n", to, text))
+}
+
+func TestIngesterDeliver(t *testing.T) {
+	in, h := newTestIngester(t)
+	addr := Address(in.Key, in.Domain, Target{UserID: 5, Task: "Reply", Path: "/forum/topic/1/thread/2/reply", Expires: time.Now().Add(time.Hour)})
+	if err := in.Deliver(context.Background(), addr, replyMessage(addr, "Count me in")); err != nil {
+		t.Fatalf("Deliver: %v", err)
+	}
+	want := postedReply{uid: 5, path: "/forum/topic/1/thread/2/reply", task: "Reply", text: "Count me in"}
+	if len(h.posts) != 1 || h.posts[0] != want {
+		t.Fatalf("posts=%+v want %+v", h.posts, want)
+	}
+	err := in.Deliver(context.Background(), addr, replyMessage(addr, "reject"))
+	if !IsPermanent(err) {
+		t.Fatalf("rejected reply err=%v want permanent", err)
+	}
+	forged := bytes.Replace(replyMessage(addr, "Forged"), []byte("From: bob@example.com"), []byte("From: mallory@example.com"), 1)
+	if err := in.Deliver(context.Background(), addr, forged); !errors.Is(err, ErrRejected) {
+		t.Fatalf("reply from unverified sender err=%v want ErrRejected", err)
+	}
+	if len(h.posts) != 1 {
+		t.Fatalf("posts=%+v", h.posts)
+	}
+}
+
+func TestServerLMTP(t *testing.T) {
+	in, h := newTestIngester(t)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = (&Server{Mailbox: in, Domain: in.Domain}).Serve(ctx, ln) }()
+
+	c, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer func() { _ = c.Close() }()
+	tc := textproto.NewConn(c)
+	expect := func(code int) {
+		t.Helper()
+		if _, _, err := tc.ReadResponse(code); err != nil {
+			t.Fatalf("response: %v", err)
+		}
+	}
+	send := func(format string, args ...any) {
+		t.Helper()
+		if err := tc.PrintfLine(format, args...); err != nil {
+			t.Fatalf("send: %v", err)
+		}
+	}
+	first := Address(in.Key, in.Domain, Target{UserID: 5, Task: "Reply", Path: "/news/news/3", Expires: time.Now().Add(time.Hour)})
+	second := Address(in.Key, in.Domain, Target{UserID: 6, Task: "Reply", Path: "/news/news/3", Expires: time.Now().Add(time.Hour)})
+
+	expect(220)
+	send("LHLO mx.example.com")
+	expect(250)
+	send("MAIL FROM:<bob@example.com>")
+	expect(250)
+	send("RCPT TO:<someone@example.com>")
+	expect(550)
+	send("RCPT TO:<%s>", first)
+	expect(250)
+	send("RCPT TO:<%s> NOTIFY=NEVER", second)
+	expect(250)
+	send("DATA")
+	expect(354)
+	w := tc.DotWriter()
+	_, _ = w.Write(replyMessage(first, ".Dotted reply"))
+	_ = w.Close()
+	// LMTP answers once for each accepted recipient.
+	expect(250)
+	expect(250)
+	send("QUIT")
+	expect(221)
+
+	if len(h.posts) != 2 {
+		t.Fatalf("posts=%+v", h.posts)
+	}
+	for i, uid := range []int32{5, 6} {
+		if h.posts[i].uid != uid || h.posts[i].text != ".Dotted reply" {
+			t.Errorf("post %d=%+v", i, h.posts[i])
+		}
+	}
+}
+
+func TestProcessMaildir(t *testing.T) {
+	in, h := newTestIngester(t)
+	dir := t.TempDir()
+	for _, sub := range []string{"new", "cur", "tmp"} {
+		if err := os.Mkdir(filepath.Join(dir, sub), 0o700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addr := Address(in.Key, in.Domain, Target{UserID: 9, Task: "Reply", Path: "/writings/article/4", Expires: time.Now().Add(time.Hour)})
+	files := map[string][]byte{
+		"1.ok":       replyMessage("Bob <"+addr+">, other@example.com", "Nice article"),
+		"2.rejected": replyMessage(addr, "reject"),
+		"3.unknown":  replyMessage("nobody@reply.example.com", "Hello"),
+	}
+	for name, msg := range files {
+		if err := os.WriteFile(filepath.Join(dir, "new", name), msg, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := in.ProcessMaildir(context.Background(), dir); err != nil {
+		t.Fatalf("ProcessMaildir: %v", err)
+	}
+	if len(h.posts) != 1 || h.posts[0].uid != 9 || h.posts[0].text != "Nice article" {
+		t.Fatalf("posts=%+v", h.posts)
+	}
+	entries, _ := os.ReadDir(filepath.Join(dir, "cur"))
+	var got []string
+	for _, e := range entries {
+		got = append(got, e.Name())
+	}
+	want := "1.ok:2,S 2.rejected:2,T 3.unknown:2,T"
+	if strings.Join(got, " ") != want {
+		t.Fatalf("cur=%v want %s", got, want)
+	}
+	if left, _ := os.ReadDir(filepath.Join(dir, "new")); len(left) != 0 {
+		t.Fatalf("new still has %d messages", len(left))
+	}
+}
@@ -0,0 +1,221 @@
+package emailreply
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMaxSize bounds the size of messages accepted by Server.
+	DefaultMaxSize = 10 << 20
+	// commandTimeout limits how long a client may stay idle.
+	commandTimeout = 5 * time.Minute
+)
+
+// Mailbox receives the messages accepted by Server.
+type Mailbox interface {
+	// Accepts reports whether mail for rcpt is taken.
+	Accepts(rcpt string) bool
+	// Deliver handles msg sent to rcpt.
+	Deliver(ctx context.Context, rcpt string, msg []byte) error
+}
+
+// Server receives mail for a Mailbox over LMTP or SMTP. Clients that greet
+// with LHLO are treated as LMTP and get one status per recipient after DATA;
+// EHLO and HELO clients get a single status. Recipients the mailbox does not
+// accept are refused, so the listener cannot be used as a relay.
+type Server struct {
+	Mailbox Mailbox
+	// Domain is announced in the greeting. It defaults to localhost.
+	Domain string
+	// MaxSize bounds accepted messages in bytes. Zero uses DefaultMaxSize.
+	MaxSize int64
+}
+
+// ListenAndServe accepts connections on addr until ctx is cancelled.
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("listen %s: %w", addr, err)
+	}
+	log.Printf("LMTP listener on %s", ln.Addr())
+	return s.Serve(ctx, ln)
+}
+
+// Serve accepts connections on ln until ctx is cancelled.
+func (s *Server) Serve(ctx context.Context, ln net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		_ = ln.Close()
+	}()
+	for {
+		c, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go s.serveConn(ctx, c)
+	}
+}
+
+// session holds the state of one client connection.
+type session struct {
+	lmtp  bool
+	rcpts []string
+}
+
+func (s *Server) serveConn(ctx context.Context, c net.Conn) {
+	defer func() { _ = c.Close() }()
+	tc := textproto.NewConn(c)
+	domain := s.Domain
+	if domain == "" {
+		domain = "localhost"
+	}
+	_ = tc.PrintfLine("220 %s goa4web mail service ready", domain)
+	var sess session
+	for {
+		_ = c.SetDeadline(time.Now().Add(commandTimeout))
+		line, err := tc.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "LHLO", "EHLO":
+			sess = session{lmtp: strings.EqualFold(verb, "LHLO")}
+			_ = tc.PrintfLine("250-%s", domain)
+			_ = tc.PrintfLine("250-PIPELINING")
+			_ = tc.PrintfLine("250-8BITMIME")
+			_ = tc.PrintfLine("250-ENHANCEDSTATUSCODES")
+			_ = tc.PrintfLine("250 SIZE %d", s.maxSize())
+		case "HELO":
+			sess = session{}
+			_ = tc.PrintfLine("250 %s", domain)
+		case "MAIL":
+			sess.rcpts = nil
+			_ = tc.PrintfLine("250 2.1.0 OK")
+		case "RCPT":
+			rcpt, ok := pathArg(arg, "TO:")
+			switch {
+			case !ok:
+				_ = tc.PrintfLine("501 5.5.4 Syntax: RCPT TO:<address>")
+			case !s.Mailbox.Accepts(rcpt):
+				_ = tc.PrintfLine("550 5.1.1 Unknown recipient")
+			default:
+				sess.rcpts = append(sess.rcpts, rcpt)
+				_ = tc.PrintfLine("250 2.1.5 OK")
+			}
+		case "DATA":
+			if len(sess.rcpts) == 0 {
+				_ = tc.PrintfLine("503 5.5.1 No valid recipients")
+				continue
+			}
+			_ = tc.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			msg, err := readData(tc.DotReader(), s.maxSize())
+			if errors.Is(err, errTooLarge) {
+				_ = tc.PrintfLine("552 5.3.4 Message too large")
+				sess.rcpts = nil
+				continue
+			}
+			if err != nil {
+				return
+			}
+			s.deliver(ctx, tc, &sess, msg)
+			sess.rcpts = nil
+		case "RSET":
+			sess.rcpts = nil
+			_ = tc.PrintfLine("250 2.0.0 OK")
+		case "NOOP":
+			_ = tc.PrintfLine("250 2.0.0 OK")
+		case "VRFY":
+			_ = tc.PrintfLine("252 2.5.0 Cannot verify")
+		case "QUIT":
+			_ = tc.PrintfLine("221 2.0.0 Bye")
+			return
+		default:
+			_ = tc.PrintfLine("500 5.5.2 Command not recognised")
+		}
+	}
+}
+
+// deliver hands msg over for each recipient and writes the statuses the
+// protocol expects.
+func (s *Server) deliver(ctx context.Context, tc *textproto.Conn, sess *session, msg []byte) {
+	var first string
+	for _, rcpt := range sess.rcpts {
+		status := deliveryStatus(s.Mailbox.Deliver(ctx, rcpt, msg))
+		if sess.lmtp {
+			_ = tc.PrintfLine("%s", status)
+		} else if first == "" || strings.HasPrefix(first, "250") {
+			first = status
+		}
+	}
+	if !sess.lmtp {
+		_ = tc.PrintfLine("%s", first)
+	}
+}
+
+func deliveryStatus(err error) string {
+	switch {
+	case err == nil:
+		return "250 2.0.0 Delivered"
+	case errors.Is(err, ErrNoText):
+		return "550 5.6.0 No reply text found"
+	case IsPermanent(err):
+		log.Printf("inbound mail rejected: %v", err)
+		return "550 5.7.1 Message not accepted"
+	default:
+		log.Printf("inbound mail: %v", err)
+		return "451 4.3.0 Temporary failure, try again later"
+	}
+}
+
+// pathArg extracts the address from a "TO:<addr>" style argument.
+func pathArg(arg, prefix string) (string, bool) {
+	arg = strings.TrimSpace(arg)
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", false
+	}
+	addr := strings.TrimSpace(arg[len(prefix):])
+	if i := strings.IndexByte(addr, ' '); i >= 0 {
+		addr = addr[:i]
+	}
+	addr = strings.TrimSuffix(strings.TrimPrefix(addr, "<"), ">")
+	return addr, addr != ""
+}
+
+var errTooLarge = errors.New("message too large")
+
+// readData reads a message body, draining it when it exceeds max so the
+// connection stays in sync.
+func readData(r io.Reader, max int64) ([]byte, error) {
+	br := bufio.NewReader(r)
+	msg, err := io.ReadAll(io.LimitReader(br, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(msg)) > max {
+		if _, err := io.Copy(io.Discard, br); err != nil {
+			return nil, err
+		}
+		return nil, errTooLarge
+	}
+	return msg, nil
+}
+
+func (s *Server) maxSize() int64 {
+	if s.MaxSize > 0 {
+		return s.MaxSize
+	}
+	return DefaultMaxSize
+}
@@ -0,0 +1,79 @@
+package emailreply
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// MessageFunc handles one message read from a maildir. Errors matching
+// IsPermanent mark the message as rejected; others leave it for a retry.
+type MessageFunc func(ctx context.Context, msg []byte) error
+
+// PollMaildir processes dir every interval until ctx is cancelled.
+func (in *Ingester) PollMaildir(ctx context.Context, dir string, interval time.Duration) {
+	PollMaildir(ctx, dir, interval, in.DeliverMessage)
+}
+
+// ProcessMaildir posts each message in the new folder of dir.
+func (in *Ingester) ProcessMaildir(ctx context.Context, dir string) error {
+	return ProcessMaildir(ctx, dir, in.DeliverMessage)
+}
+
+// PollMaildir passes the messages arriving in dir to fn every interval until
+// ctx is cancelled.
+func PollMaildir(ctx context.Context, dir string, interval time.Duration, fn MessageFunc) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if err := ProcessMaildir(ctx, dir, fn); err != nil {
+			log.Printf("maildir %s: %v", dir, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// ProcessMaildir passes each message in the new folder of dir to fn. Handled
+// messages are moved to cur, flagged seen when accepted and trashed when
+// rejected. Messages that fail temporarily stay in new for the next pass.
+func ProcessMaildir(ctx context.Context, dir string, fn MessageFunc) error {
+	entries, err := os.ReadDir(filepath.Join(dir, "new"))
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		src := filepath.Join(dir, "new", e.Name())
+		msg, err := os.ReadFile(src)
+		if err != nil {
+			return err
+		}
+		flag := "S"
+		if err := fn(ctx, msg); err != nil {
+			if !IsPermanent(err) {
+				log.Printf("maildir %s: %v", e.Name(), err)
+				continue
+			}
+			log.Printf("maildir %s rejected: %v", e.Name(), err)
+			flag = "T"
+		}
+		dst := filepath.Join(dir, "cur", e.Name()+":2,"+flag)
+		if err := os.Rename(src, dst); err != nil {
+			return fmt.Errorf("move %s: %w", e.Name(), err)
+		}
+	}
+	return nil
+}
@@ -3,10 +3,12 @@ package eventbus
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"sync"
 	"time"
 
+	"github.com/arran4/goa4web/internal/metrics"
 	"github.com/arran4/goa4web/internal/tasks"
 )
 
@@ -21,8 +23,25 @@ const (
 	EmailQueueMessageType
 	// DigestRunMessageType identifies a scheduled digest run.
 	DigestRunMessageType
+	// WebhookRedeliverMessageType identifies a request to resend a webhook delivery.
+	WebhookRedeliverMessageType
 )
 
+// String returns the name used for the message type in logs and metrics.
+func (t MessageType) String() string {
+	switch t {
+	case TaskMessageType:
+		return "task"
+	case EmailQueueMessageType:
+		return "email_queue"
+	case DigestRunMessageType:
+		return "digest_run"
+	case WebhookRedeliverMessageType:
+		return "webhook_redeliver"
+	}
+	return fmt.Sprintf("unknown_%d", int(t))
+}
+
 // Message represents an item sent over the event bus.
 type Message interface {
 	Type() MessageType
@@ -76,6 +95,14 @@ type DigestRunEvent struct {
 // Type implements the Message interface.
 func (DigestRunEvent) Type() MessageType { return DigestRunMessageType }
 
+// WebhookRedeliverEvent asks the webhook worker to resend a recorded delivery.
+type WebhookRedeliverEvent struct {
+	DeliveryID int32
+}
+
+// Type implements the Message interface.
+func (WebhookRedeliverEvent) Type() MessageType { return WebhookRedeliverMessageType }
+
 // Subscription represents an active subscription to the event bus.
 type Subscription struct {
 	bus       *Bus
@@ -320,6 +347,7 @@ func (b *Bus) Publish(msg Message) error {
 		return ErrBusClosed
 	}
 	syncPub := b.SyncPublish
+	metrics.EventBusPublished.Inc(msg.Type().String())
 
 	var matching []*Subscription
 	for _, s := range b.subscribers {
@@ -366,6 +394,7 @@ func (b *Bus) Publish(msg Message) error {
 
 		if err := s.deliver(env); err != nil {
 			ack()
+			metrics.EventBusDropped.Inc(msg.Type().String())
 			if s.reliable && firstErr == nil {
 				firstErr = err
 			}
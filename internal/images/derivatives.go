@@ -0,0 +1,65 @@
+package images
+
+import (
+	"fmt"
+	"image"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// derivativeMarker separates an image ID from a derivative's width.
+const derivativeMarker = "_w"
+
+// DerivativeName returns the cache name of the width-bounded derivative of
+// imageID, for example "abcd_w640.jpg" for "abcd.jpg".
+func DerivativeName(imageID string, width int) string {
+	ext := filepath.Ext(imageID)
+	return strings.TrimSuffix(imageID, ext) + derivativeMarker + strconv.Itoa(width) + ext
+}
+
+// ParseDerivativeName reverses DerivativeName, returning the source image ID
+// and the derivative width.
+func ParseDerivativeName(name string) (imageID string, width int, ok bool) {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	i := strings.LastIndex(base, derivativeMarker)
+	if i <= 0 {
+		return "", 0, false
+	}
+	width, err := strconv.Atoi(base[i+len(derivativeMarker):])
+	if err != nil || width <= 0 {
+		return "", 0, false
+	}
+	return base[:i] + ext, width, true
+}
+
+// DerivativeWidths returns the widths narrower than sourceWidth in
+// ascending order without duplicates. Derivatives are never upscaled.
+func DerivativeWidths(sourceWidth int, widths []int) []int {
+	var out []int
+	for _, w := range widths {
+		if w > 0 && w < sourceWidth && !slices.Contains(out, w) {
+			out = append(out, w)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// GenerateDerivative scales srcImage to width pixels wide, keeping its
+// aspect ratio, using the named thumbnail generator. It returns the encoded
+// image and its height.
+func GenerateDerivative(srcImage image.Image, ext, generatorName string, width int) ([]byte, int, error) {
+	b := srcImage.Bounds()
+	if width <= 0 || b.Dx() <= 0 || b.Dy() <= 0 {
+		return nil, 0, fmt.Errorf("invalid derivative width %d for %dx%d image", width, b.Dx(), b.Dy())
+	}
+	height := max(1, (b.Dy()*width+b.Dx()/2)/b.Dx())
+	data, err := GetThumbnailGenerator(generatorName).Resize(srcImage, ext, width, height)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, height, nil
+}
@@ -0,0 +1,51 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"reflect"
+	"testing"
+)
+
+func TestDerivativeName(t *testing.T) {
+	name := DerivativeName("abcd.jpg", 640)
+	if name != "abcd_w640.jpg" {
+		t.Fatalf("DerivativeName = %q", name)
+	}
+	id, width, ok := ParseDerivativeName(name)
+	if !ok || id != "abcd.jpg" || width != 640 {
+		t.Fatalf("ParseDerivativeName(%q) = %q, %d, %t", name, id, width, ok)
+	}
+	for _, bad := range []string{"abcd.jpg", "_w640.jpg", "abcd_w0.jpg", "abcd_wide.jpg"} {
+		if _, _, ok := ParseDerivativeName(bad); ok {
+			t.Errorf("ParseDerivativeName(%q) accepted", bad)
+		}
+	}
+}
+
+func TestDerivativeWidths(t *testing.T) {
+	got := DerivativeWidths(1000, []int{1280, 640, 320, 640, 0, 1000})
+	if want := []int{320, 640}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("DerivativeWidths = %v, want %v", got, want)
+	}
+}
+
+func TestGenerateDerivative(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 400, 300))
+	for _, gen := range []string{"bild", "draw"} {
+		data, height, err := GenerateDerivative(src, ".png", gen, 200)
+		if err != nil {
+			t.Fatalf("%s: %v", gen, err)
+		}
+		if height != 150 {
+			t.Errorf("%s: height = %d, want 150", gen, height)
+		}
+		img, _, err := image.Decode(bytes.NewReader(data))
+		if err != nil {
+			t.Fatalf("%s: decode: %v", gen, err)
+		}
+		if b := img.Bounds(); b.Dx() != 200 || b.Dy() != 150 {
+			t.Errorf("%s: size %dx%d, want 200x150", gen, b.Dx(), b.Dy())
+		}
+	}
+}
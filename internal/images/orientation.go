@@ -0,0 +1,136 @@
+package images
+
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+// exifOrientationTag is the TIFF tag holding the EXIF orientation.
+const exifOrientationTag = 0x0112
+
+// JPEGOrientation returns the EXIF orientation, 1 to 8, recorded in the JPEG
+// header data. It returns 1, meaning upright, when data is not a JPEG or has
+// no orientation tag. Only the leading segments are inspected so data may be
+// a prefix of the file.
+func JPEGOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	i := 2
+	for i+4 <= len(data) {
+		if data[i] != 0xFF {
+			return 1
+		}
+		marker := data[i+1]
+		switch {
+		case marker == 0xFF:
+			i++
+			continue
+		case marker == 0xD9 || marker == 0xDA:
+			// End of image or start of scan: no more metadata segments.
+			return 1
+		case marker == 0x01 || (marker >= 0xD0 && marker <= 0xD7):
+			i += 2
+			continue
+		}
+		size := int(data[i+2])<<8 | int(data[i+3])
+		if size < 2 || i+2+size > len(data) {
+			return 1
+		}
+		seg := data[i+4 : i+2+size]
+		if marker == 0xE1 && len(seg) >= 6 && string(seg[:6]) == "Exif\x00\x00" {
+			if o := tiffOrientation(seg[6:]); o != 0 {
+				return o
+			}
+		}
+		i += 2 + size
+	}
+	return 1
+}
+
+// tiffOrientation reads the orientation tag from the first IFD of a TIFF
+// structure, returning 0 when it is absent or malformed.
+func tiffOrientation(b []byte) int {
+	if len(b) < 8 {
+		return 0
+	}
+	var bo binary.ByteOrder
+	switch string(b[:2]) {
+	case "II":
+		bo = binary.LittleEndian
+	case "MM":
+		bo = binary.BigEndian
+	default:
+		return 0
+	}
+	if bo.Uint16(b[2:4]) != 42 {
+		return 0
+	}
+	off := int(bo.Uint32(b[4:8]))
+	if off < 8 || off+2 > len(b) {
+		return 0
+	}
+	n := int(bo.Uint16(b[off:]))
+	for k := 0; k < n; k++ {
+		e := off + 2 + k*12
+		if e+12 > len(b) {
+			return 0
+		}
+		if bo.Uint16(b[e:]) != exifOrientationTag {
+			continue
+		}
+		// The tag is a single SHORT stored at the start of the value field.
+		if bo.Uint16(b[e+2:]) != 3 {
+			return 0
+		}
+		if v := int(bo.Uint16(b[e+8:])); v >= 1 && v <= 8 {
+			return v
+		}
+		return 0
+	}
+	return 0
+}
+
+// ApplyOrientation returns img rotated and flipped so that an image tagged
+// with the given EXIF orientation displays upright. Orientation 1 and
+// unknown values return img unchanged.
+func ApplyOrientation(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	b := img.Bounds()
+	src := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(src, src.Bounds(), img, b.Min, draw.Src)
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+			si := src.PixOffset(sx, sy)
+			di := dst.PixOffset(x, y)
+			copy(dst.Pix[di:di+4], src.Pix[si:si+4])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,80 @@
+package images
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// exifJPEG returns a JPEG whose APP1 segment records the given orientation.
+func exifJPEG(t *testing.T, img image.Image, orientation uint16) []byte {
+	t.Helper()
+	var tiff bytes.Buffer
+	tiff.WriteString("MM")
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(42))
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(8))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(1))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(exifOrientationTag))
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(3))
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(1))
+	_ = binary.Write(&tiff, binary.BigEndian, orientation)
+	_ = binary.Write(&tiff, binary.BigEndian, uint16(0))
+	_ = binary.Write(&tiff, binary.BigEndian, uint32(0))
+	seg := append([]byte("Exif\x00\x00"), tiff.Bytes()...)
+
+	var enc bytes.Buffer
+	if err := jpeg.Encode(&enc, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatalf("encode: %v", err)
+	}
+	body := enc.Bytes()
+	out := []byte{0xFF, 0xD8, 0xFF, 0xE1, byte((len(seg) + 2) >> 8), byte(len(seg) + 2)}
+	out = append(out, seg...)
+	return append(out, body[2:]...)
+}
+
+func TestJPEGOrientation(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	for o := uint16(1); o <= 8; o++ {
+		if got := JPEGOrientation(exifJPEG(t, img, o)); got != int(o) {
+			t.Errorf("orientation %d: got %d", o, got)
+		}
+	}
+	if got := JPEGOrientation([]byte("not a jpeg")); got != 1 {
+		t.Errorf("non-jpeg orientation = %d, want 1", got)
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 3, 2))
+	red := color.RGBA{255, 0, 0, 255}
+	src.Set(0, 0, red)
+
+	cases := []struct {
+		orientation int
+		w, h        int
+		x, y        int
+	}{
+		{1, 3, 2, 0, 0},
+		{2, 3, 2, 2, 0},
+		{3, 3, 2, 2, 1},
+		{4, 3, 2, 0, 1},
+		{5, 2, 3, 0, 0},
+		{6, 2, 3, 1, 0},
+		{7, 2, 3, 1, 2},
+		{8, 2, 3, 0, 2},
+	}
+	for _, tc := range cases {
+		got := ApplyOrientation(src, tc.orientation)
+		b := got.Bounds()
+		if b.Dx() != tc.w || b.Dy() != tc.h {
+			t.Errorf("orientation %d: size %dx%d, want %dx%d", tc.orientation, b.Dx(), b.Dy(), tc.w, tc.h)
+			continue
+		}
+		if c := color.RGBAModel.Convert(got.At(tc.x, tc.y)); c != red {
+			t.Errorf("orientation %d: pixel (%d,%d) = %v, want red", tc.orientation, tc.x, tc.y, c)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package images
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"io"
+	"path/filepath"
+	"strings"
+
+	// Register the WebP decoder with image.Decode.
+	_ "golang.org/x/image/webp"
+)
+
+// UploadJPEGQuality is the quality used when re-encoding JPEG uploads.
+const UploadJPEGQuality = 90
+
+// exifPeekSize bounds how much of an upload is inspected for EXIF data. An
+// APP1 segment is at most 64KiB and sits near the start of the file.
+const exifPeekSize = 128 << 10
+
+// uploadOnlyExtensions are accepted on upload but stored in another format.
+var uploadOnlyExtensions = map[string]string{
+	".webp": ".png",
+}
+
+// CleanUploadExtension is CleanExtension extended with the formats that are
+// only accepted as uploads, such as WebP.
+func CleanUploadExtension(name string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(name))
+	if _, ok := uploadOnlyExtensions[ext]; ok {
+		return ext, nil
+	}
+	return CleanExtension(name)
+}
+
+// StoredExtension returns the extension an upload with ext is stored under.
+// There is no WebP encoder available, so WebP uploads are stored as PNG.
+func StoredExtension(ext string) string {
+	ext = strings.ToLower(ext)
+	if stored, ok := uploadOnlyExtensions[ext]; ok {
+		return stored
+	}
+	return ext
+}
+
+// KeepsOriginal reports whether uploads with ext are stored byte for byte.
+// GIFs are kept so animations survive; the format carries no EXIF data.
+func KeepsOriginal(ext string) bool {
+	return strings.ToLower(ext) == ".gif"
+}
+
+// Decoding limits. A small file can declare a huge canvas, so the header is
+// checked before any pixels are allocated.
+const (
+	// MaxDimension bounds the width and height of a decoded image.
+	MaxDimension = 16384
+	// MaxPixels bounds the area of a decoded image.
+	MaxPixels = 50_000_000
+)
+
+// ErrImageTooLarge is returned when an image exceeds MaxDimension or
+// MaxPixels.
+var ErrImageTooLarge = errors.New("image dimensions too large")
+
+// DecodeUpright decodes an image from r and rotates or flips it according to
+// any EXIF orientation tag in a JPEG header. The dimensions are read first
+// and images over MaxDimension or MaxPixels are rejected without decoding.
+func DecodeUpright(r io.Reader) (image.Image, error) {
+	br := bufio.NewReaderSize(r, exifPeekSize)
+	head, _ := br.Peek(exifPeekSize)
+	orientation := JPEGOrientation(head)
+	var header bytes.Buffer
+	cfg, _, err := image.DecodeConfig(io.TeeReader(br, &header))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width > MaxDimension || cfg.Height > MaxDimension || int64(cfg.Width)*int64(cfg.Height) > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrImageTooLarge, cfg.Width, cfg.Height)
+	}
+	img, _, err := image.Decode(io.MultiReader(&header, br))
+	if err != nil {
+		return nil, err
+	}
+	return ApplyOrientation(img, orientation), nil
+}
+
+// EncodeUpload writes img in the format named by ext. JPEGs use
+// UploadJPEGQuality. The encoders write pixel data only, so no metadata from
+// the original file survives.
+func EncodeUpload(w io.Writer, img image.Image, ext string) error {
+	switch strings.ToLower(ext) {
+	case ".jpg", ".jpeg":
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: UploadJPEGQuality})
+	}
+	enc, err := EncoderByExtension(ext)
+	if err != nil {
+		return err
+	}
+	return enc(w, img)
+}
+
+// UploadEncoder streams img encoded by EncodeUpload. Encoding runs in the
+// background as the encoder is read, so the whole file is never held in
+// memory.
+type UploadEncoder struct {
+	pr *io.PipeReader
+	n  int64
+}
+
+// NewUploadEncoder starts encoding img as ext. Callers must Close the encoder
+// so the background encoding stops if the output is not read to the end.
+func NewUploadEncoder(img image.Image, ext string) *UploadEncoder {
+	pr, pw := io.Pipe()
+	go func() { _ = pw.CloseWithError(EncodeUpload(pw, img, ext)) }()
+	return &UploadEncoder{pr: pr}
+}
+
+// Read implements io.Reader. Encoding errors are returned from Read.
+func (e *UploadEncoder) Read(p []byte) (int, error) {
+	n, err := e.pr.Read(p)
+	e.n += int64(n)
+	return n, err
+}
+
+// Size returns the number of encoded bytes read so far.
+func (e *UploadEncoder) Size() int64 { return e.n }
+
+// Close stops the encoder.
+func (e *UploadEncoder) Close() error { return e.pr.Close() }
+
+// PreparedUpload is an uploaded image ready to be stored.
+type PreparedUpload struct {
+	// Image is the decoded, upright image.
+	Image image.Image
+	// Ext is the extension Data is encoded as.
+	Ext string
+	// Data holds the bytes to store.
+	Data []byte
+}
+
+// PrepareUpload decodes an uploaded image, turns it upright and re-encodes
+// it so that EXIF, XMP and other embedded metadata, including GPS positions,
+// is not stored. ext is the cleaned extension of the upload. Formats for
+// which KeepsOriginal is true are decoded but stored unchanged.
+func PrepareUpload(data []byte, ext string) (*PreparedUpload, error) {
+	img, err := DecodeUpright(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+	stored := StoredExtension(ext)
+	if KeepsOriginal(ext) {
+		return &PreparedUpload{Image: img, Ext: stored, Data: data}, nil
+	}
+	var buf bytes.Buffer
+	if err := EncodeUpload(&buf, img, stored); err != nil {
+		return nil, fmt.Errorf("encode image: %w", err)
+	}
+	return &PreparedUpload{Image: img, Ext: stored, Data: buf.Bytes()}, nil
+}
@@ -0,0 +1,66 @@
+package images
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"io"
+	"testing"
+)
+
+func TestPrepareUploadStripsEXIF(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	data := exifJPEG(t, src, 6)
+
+	prepared, err := PrepareUpload(data, ".jpg")
+	if err != nil {
+		t.Fatalf("PrepareUpload: %v", err)
+	}
+	if b := prepared.Image.Bounds(); b.Dx() != 2 || b.Dy() != 4 {
+		t.Errorf("prepared size %dx%d, want 2x4", b.Dx(), b.Dy())
+	}
+	if bytes.Contains(prepared.Data, []byte("Exif\x00\x00")) {
+		t.Error("prepared data still carries EXIF")
+	}
+	if JPEGOrientation(prepared.Data) != 1 {
+		t.Error("prepared data still carries an orientation")
+	}
+}
+
+func TestDecodeUprightRejectsHugeCanvas(t *testing.T) {
+	// A GIF header declaring a 65535x65535 screen with no image data.
+	header := []byte("GIF89a\xff\xff\xff\xff\x00\x00\x00")
+	if _, err := DecodeUpright(bytes.NewReader(header)); !errors.Is(err, ErrImageTooLarge) {
+		t.Fatalf("err %v", err)
+	}
+}
+
+func TestUploadEncoderStreams(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 3, 5))
+	enc := NewUploadEncoder(src, ".png")
+	defer func() { _ = enc.Close() }()
+	data, err := io.ReadAll(enc)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if enc.Size() != int64(len(data)) {
+		t.Errorf("size %d, read %d", enc.Size(), len(data))
+	}
+	img, err := DecodeUpright(bytes.NewReader(data))
+	if err != nil || img.Bounds().Dx() != 3 || img.Bounds().Dy() != 5 {
+		t.Fatalf("decoded %v err %v", img, err)
+	}
+}
+
+func TestUploadExtensions(t *testing.T) {
+	ext, err := CleanUploadExtension("photo.WEBP")
+	if err != nil || ext != ".webp" {
+		t.Fatalf("CleanUploadExtension = %q, %v", ext, err)
+	}
+	if got := StoredExtension(ext); got != ".png" {
+		t.Errorf("StoredExtension(.webp) = %q, want .png", got)
+	}
+	if _, err := CleanUploadExtension("photo.bmp"); err == nil {
+		t.Error("CleanUploadExtension accepted .bmp")
+	}
+}
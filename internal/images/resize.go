@@ -1,15 +1,11 @@
 package images
 
 import (
-	"bytes"
 	"fmt"
 	"image"
 	"math"
 	"strconv"
 	"strings"
-
-	"github.com/anthonynsimon/bild/transform"
-	"golang.org/x/image/draw"
 )
 
 // ParseDimension parses a string like "1024x768" into width and height.
@@ -46,15 +42,7 @@ func GenerateSafeSize(srcImage image.Image, ext string, generatorName string, ma
 
 	if w <= maxWidth && h <= maxHeight {
 		// Image is already safe size, return original encoded
-		var buf bytes.Buffer
-		enc, err := EncoderByExtension(ext)
-		if err != nil {
-			return nil, fmt.Errorf("encoder ext %w", err)
-		}
-		if err := enc(&buf, srcImage); err != nil {
-			return nil, fmt.Errorf("thumb encode %w", err)
-		}
-		return buf.Bytes(), nil
+		return encodeImage(srcImage, ext)
 	}
 
 	// Calculate new dimensions preserving aspect ratio
@@ -67,31 +55,5 @@ func GenerateSafeSize(srcImage image.Image, ext string, generatorName string, ma
 	if newH < 1 {
 		newH = 1
 	}
-
-	if generatorName == "draw" {
-		thumb := image.NewRGBA(image.Rect(0, 0, newW, newH))
-		draw.CatmullRom.Scale(thumb, thumb.Bounds(), srcImage, bounds, draw.Over, nil)
-
-		var tbuf bytes.Buffer
-		enc, err := EncoderByExtension(ext)
-		if err != nil {
-			return nil, fmt.Errorf("encoder ext %w", err)
-		}
-		if err := enc(&tbuf, thumb); err != nil {
-			return nil, fmt.Errorf("thumb encode %w", err)
-		}
-		return tbuf.Bytes(), nil
-	}
-
-	// Default to bild
-	thumb := transform.Resize(srcImage, newW, newH, transform.Linear)
-	var tbuf bytes.Buffer
-	enc, err := EncoderByExtension(ext)
-	if err != nil {
-		return nil, fmt.Errorf("encoder ext %w", err)
-	}
-	if err := enc(&tbuf, thumb); err != nil {
-		return nil, fmt.Errorf("thumb encode %w", err)
-	}
-	return tbuf.Bytes(), nil
+	return GetThumbnailGenerator(generatorName).Resize(srcImage, ext, newW, newH)
 }
@@ -9,9 +9,13 @@ import (
 	"golang.org/x/image/draw"
 )
 
-// ThumbnailGenerator represents a strategy for generating thumbnails.
+// ThumbnailGenerator represents a strategy for generating thumbnails and
+// other scaled copies of an image.
 type ThumbnailGenerator interface {
+	// Generate returns a center-cropped square thumbnail of size pixels.
 	Generate(srcImage image.Image, ext string, size int) ([]byte, error)
+	// Resize scales the whole image to exactly width by height pixels.
+	Resize(srcImage image.Image, ext string, width, height int) ([]byte, error)
 }
 
 // thumbnailGenerators holds the registered thumbnail generator implementations.
@@ -120,3 +124,25 @@ func (g *DrawThumbnailGenerator) Generate(srcImage image.Image, ext string, size
 	}
 	return tbuf.Bytes(), nil
 }
+
+func (g *BildThumbnailGenerator) Resize(srcImage image.Image, ext string, width, height int) ([]byte, error) {
+	return encodeImage(transform.Resize(srcImage, width, height, transform.Linear), ext)
+}
+
+func (g *DrawThumbnailGenerator) Resize(srcImage image.Image, ext string, width, height int) ([]byte, error) {
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), srcImage, srcImage.Bounds(), draw.Over, nil)
+	return encodeImage(dst, ext)
+}
+
+func encodeImage(img image.Image, ext string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc, err := EncoderByExtension(ext)
+	if err != nil {
+		return nil, fmt.Errorf("encoder ext %w", err)
+	}
+	if err := enc(&buf, img); err != nil {
+		return nil, fmt.Errorf("thumb encode %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,342 @@
+// Package metrics records server metrics and renders them in the Prometheus
+// text exposition format.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the histogram bounds, in seconds, used for latencies.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Label is a name/value pair attached to a sample.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Collector adds samples computed at scrape time, such as queue depths or
+// connection pool figures.
+type Collector func(ctx context.Context, e *Emitter)
+
+type family interface {
+	name() string
+	write(w io.Writer)
+}
+
+// Registry holds metric families and collectors.
+type Registry struct {
+	mu         sync.Mutex
+	families   map[string]family
+	collectors []Collector
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry { return &Registry{families: map[string]family{}} }
+
+// Default is the registry used by the package level metrics.
+var Default = NewRegistry()
+
+func (r *Registry) register(f family) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.families[f.name()]; ok {
+		panic(fmt.Sprintf("metrics: %s registered twice", f.name()))
+	}
+	r.families[f.name()] = f
+}
+
+// RegisterCollector adds c to the collectors run on every scrape.
+func (r *Registry) RegisterCollector(c Collector) {
+	r.mu.Lock()
+	r.collectors = append(r.collectors, c)
+	r.mu.Unlock()
+}
+
+// Write renders every family followed by the collector output. Extra
+// collectors are run for this scrape only.
+func (r *Registry) Write(ctx context.Context, w io.Writer, extra ...Collector) error {
+	r.mu.Lock()
+	fams := make([]family, 0, len(r.families))
+	for _, f := range r.families {
+		fams = append(fams, f)
+	}
+	collectors := append(append([]Collector{}, r.collectors...), extra...)
+	r.mu.Unlock()
+
+	e := &Emitter{families: map[string]*emitted{}}
+	for _, c := range collectors {
+		c(ctx, e)
+	}
+	for _, f := range e.families {
+		fams = append(fams, f)
+	}
+	sort.Slice(fams, func(i, j int) bool { return fams[i].name() < fams[j].name() })
+
+	bw := &errWriter{w: w}
+	for _, f := range fams {
+		f.write(bw)
+	}
+	return bw.err
+}
+
+// errWriter remembers the first write error so rendering can ignore it.
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (e *errWriter) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return len(p), nil
+	}
+	n, err := e.w.Write(p)
+	e.err = err
+	return n, nil
+}
+
+// CounterVec is a monotonically increasing count partitioned by labels.
+type CounterVec struct {
+	fname  string
+	help   string
+	labels []string
+	mu     sync.Mutex
+	values map[string]*sample
+}
+
+// NewCounterVec registers a counter family in r.
+func (r *Registry) NewCounterVec(name, help string, labels ...string) *CounterVec {
+	c := &CounterVec{fname: name, help: help, labels: labels, values: map[string]*sample{}}
+	r.register(c)
+	return c
+}
+
+// Inc adds one to the counter identified by labelValues.
+func (c *CounterVec) Inc(labelValues ...string) { c.Add(1, labelValues...) }
+
+// Add adds v to the counter identified by labelValues.
+func (c *CounterVec) Add(v float64, labelValues ...string) {
+	key := strings.Join(labelValues, "\xff")
+	c.mu.Lock()
+	s, ok := c.values[key]
+	if !ok {
+		s = &sample{labels: pairLabels(c.labels, labelValues)}
+		c.values[key] = s
+	}
+	s.value += v
+	c.mu.Unlock()
+}
+
+// Value returns the current count for labelValues.
+func (c *CounterVec) Value(labelValues ...string) float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if s, ok := c.values[strings.Join(labelValues, "\xff")]; ok {
+		return s.value
+	}
+	return 0
+}
+
+func (c *CounterVec) name() string { return c.fname }
+
+func (c *CounterVec) write(w io.Writer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	writeHeader(w, c.fname, c.help, "counter")
+	for _, s := range sortedSamples(c.values) {
+		writeSample(w, c.fname, s.labels, s.value)
+	}
+}
+
+// HistogramVec counts observations into buckets partitioned by labels.
+type HistogramVec struct {
+	fname   string
+	help    string
+	labels  []string
+	buckets []float64
+	mu      sync.Mutex
+	values  map[string]*histogram
+}
+
+type histogram struct {
+	labels []Label
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// NewHistogramVec registers a histogram family in r using buckets as the
+// upper bounds.
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	h := &HistogramVec{fname: name, help: help, labels: labels, buckets: buckets, values: map[string]*histogram{}}
+	r.register(h)
+	return h
+}
+
+// Observe records v against the histogram identified by labelValues.
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	key := strings.Join(labelValues, "\xff")
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	s, ok := h.values[key]
+	if !ok {
+		s = &histogram{labels: pairLabels(h.labels, labelValues), counts: make([]uint64, len(h.buckets))}
+		h.values[key] = s
+	}
+	for i, b := range h.buckets {
+		if v <= b {
+			s.counts[i]++
+		}
+	}
+	s.sum += v
+	s.count++
+}
+
+// Count returns the number of observations for labelValues.
+func (h *HistogramVec) Count(labelValues ...string) uint64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s, ok := h.values[strings.Join(labelValues, "\xff")]; ok {
+		return s.count
+	}
+	return 0
+}
+
+func (h *HistogramVec) name() string { return h.fname }
+
+func (h *HistogramVec) write(w io.Writer) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	writeHeader(w, h.fname, h.help, "histogram")
+	keys := make([]string, 0, len(h.values))
+	for k := range h.values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := h.values[k]
+		for i, b := range h.buckets {
+			writeSample(w, h.fname+"_bucket", append(s.labels[:len(s.labels):len(s.labels)], Label{"le", formatFloat(b)}), float64(s.counts[i]))
+		}
+		writeSample(w, h.fname+"_bucket", append(s.labels[:len(s.labels):len(s.labels)], Label{"le", "+Inf"}), float64(s.count))
+		writeSample(w, h.fname+"_sum", s.labels, s.sum)
+		writeSample(w, h.fname+"_count", s.labels, float64(s.count))
+	}
+}
+
+// Emitter receives samples from collectors during a scrape.
+type Emitter struct {
+	families map[string]*emitted
+}
+
+type emitted struct {
+	fname   string
+	help    string
+	kind    string
+	samples []*sample
+}
+
+// Gauge adds a gauge sample.
+func (e *Emitter) Gauge(name, help string, value float64, labels ...Label) {
+	e.add(name, help, "gauge", value, labels)
+}
+
+// Counter adds a counter sample for a total maintained elsewhere.
+func (e *Emitter) Counter(name, help string, value float64, labels ...Label) {
+	e.add(name, help, "counter", value, labels)
+}
+
+func (e *Emitter) add(name, help, kind string, value float64, labels []Label) {
+	f, ok := e.families[name]
+	if !ok {
+		f = &emitted{fname: name, help: help, kind: kind}
+		e.families[name] = f
+	}
+	f.samples = append(f.samples, &sample{labels: labels, value: value})
+}
+
+func (f *emitted) name() string { return f.fname }
+
+func (f *emitted) write(w io.Writer) {
+	writeHeader(w, f.fname, f.help, f.kind)
+	for _, s := range f.samples {
+		writeSample(w, f.fname, s.labels, s.value)
+	}
+}
+
+type sample struct {
+	labels []Label
+	value  float64
+}
+
+func pairLabels(names, values []string) []Label {
+	ls := make([]Label, len(names))
+	for i, n := range names {
+		ls[i].Name = n
+		if i < len(values) {
+			ls[i].Value = values[i]
+		}
+	}
+	return ls
+}
+
+func sortedSamples(m map[string]*sample) []*sample {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make([]*sample, len(keys))
+	for i, k := range keys {
+		out[i] = m[k]
+	}
+	return out
+}
+
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func writeHeader(w io.Writer, name, help, kind string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, helpEscaper.Replace(help), name, kind)
+}
+
+func writeSample(w io.Writer, name string, labels []Label, v float64) {
+	var sb strings.Builder
+	sb.WriteString(name)
+	if len(labels) > 0 {
+		sb.WriteByte('{')
+		for i, l := range labels {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(l.Name)
+			sb.WriteString(`="`)
+			sb.WriteString(labelEscaper.Replace(l.Value))
+			sb.WriteByte('"')
+		}
+		sb.WriteByte('}')
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(formatFloat(v))
+	sb.WriteByte('\n')
+	io.WriteString(w, sb.String())
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,57 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestRegistryWritesExpositionFormat(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounterVec("test_requests_total", "Requests.", "module", "code")
+	c.Inc("forum", "200")
+	c.Add(2, "forum", "200")
+	c.Inc("blogs", "404")
+	h := r.NewHistogramVec("test_duration_seconds", "Latency.", []float64{0.1, 1}, "module")
+	h.Observe(0.05, "forum")
+	h.Observe(0.5, "forum")
+	r.RegisterCollector(func(_ context.Context, e *Emitter) {
+		e.Gauge("test_queue_depth", "Queue \"depth\".", 4, Label{"queue", `a"b`})
+	})
+
+	var buf bytes.Buffer
+	if err := r.Write(context.Background(), &buf); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	want := `# HELP test_duration_seconds Latency.
+# TYPE test_duration_seconds histogram
+test_duration_seconds_bucket{module="forum",le="0.1"} 1
+test_duration_seconds_bucket{module="forum",le="1"} 2
+test_duration_seconds_bucket{module="forum",le="+Inf"} 2
+test_duration_seconds_sum{module="forum"} 0.55
+test_duration_seconds_count{module="forum"} 2
+# HELP test_queue_depth Queue "depth".
+# TYPE test_queue_depth gauge
+test_queue_depth{queue="a\"b"} 4
+# HELP test_requests_total Requests.
+# TYPE test_requests_total counter
+test_requests_total{module="blogs",code="404"} 1
+test_requests_total{module="forum",code="200"} 3
+`
+	if got := buf.String(); got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDefaultIncludesRuntimeMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Default.Write(context.Background(), &buf, DBStatsCollector(nil)); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	for _, want := range []string{"# TYPE goa4web_goroutines gauge", "goa4web_memory_heap_alloc_bytes ", "# TYPE goa4web_http_requests_total counter"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("missing %q", want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+	"time"
+)
+
+// Metrics recorded by the server. Each is registered in Default.
+var (
+	// HTTPRequests counts requests by router module, method and status code.
+	HTTPRequests = Default.NewCounterVec("goa4web_http_requests_total", "HTTP requests served by router module.", "module", "method", "code")
+	// HTTPRequestDuration observes request latency by router module.
+	HTTPRequestDuration = Default.NewHistogramVec("goa4web_http_request_duration_seconds", "HTTP request latency by router module.", DefaultBuckets, "module")
+
+	// EventBusPublished counts messages published on the event bus by type.
+	EventBusPublished = Default.NewCounterVec("goa4web_eventbus_published_total", "Messages published on the event bus.", "type")
+	// EventBusDropped counts deliveries lost because a subscriber was full or closed.
+	EventBusDropped = Default.NewCounterVec("goa4web_eventbus_dropped_total", "Event bus deliveries dropped because a subscriber could not accept them.", "type")
+
+	// EmailSent counts queued emails handed to the provider.
+	EmailSent = Default.NewCounterVec("goa4web_email_sent_total", "Queued emails sent successfully.")
+	// EmailSendFailures counts queued emails the provider failed to send.
+	EmailSendFailures = Default.NewCounterVec("goa4web_email_send_failures_total", "Queued emails that failed to send.", "reason")
+
+	// DLQRecords counts messages written to the dead letter queue.
+	DLQRecords = Default.NewCounterVec("goa4web_dlq_records_total", "Messages recorded in the dead letter queue.", "result")
+
+	// SchedulerTaskDuration observes how long scheduled task runs take.
+	SchedulerTaskDuration = Default.NewHistogramVec("goa4web_scheduler_task_duration_seconds", "Scheduler task run duration.", DefaultBuckets, "task")
+	// SchedulerTaskFailures counts scheduled task runs that returned an error.
+	SchedulerTaskFailures = Default.NewCounterVec("goa4web_scheduler_task_failures_total", "Scheduler task runs that failed.", "task")
+)
+
+// startTime approximates when the process started.
+var startTime = time.Now()
+
+func init() {
+	Default.RegisterCollector(RuntimeCollector)
+}
+
+// RuntimeCollector reports goroutine and memory figures.
+func RuntimeCollector(_ context.Context, e *Emitter) {
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	e.Gauge("goa4web_goroutines", "Number of goroutines.", float64(runtime.NumGoroutine()))
+	e.Gauge("goa4web_memory_alloc_bytes", "Bytes of allocated heap objects.", float64(mem.Alloc))
+	e.Gauge("goa4web_memory_sys_bytes", "Bytes of memory obtained from the OS.", float64(mem.Sys))
+	e.Gauge("goa4web_memory_heap_alloc_bytes", "Bytes of allocated heap objects.", float64(mem.HeapAlloc))
+	e.Gauge("goa4web_memory_heap_sys_bytes", "Bytes of heap memory obtained from the OS.", float64(mem.HeapSys))
+	e.Counter("goa4web_memory_total_alloc_bytes", "Cumulative bytes allocated for heap objects.", float64(mem.TotalAlloc))
+	e.Counter("goa4web_gc_cycles_total", "Completed garbage collection cycles.", float64(mem.NumGC))
+	e.Gauge("goa4web_start_time_seconds", "Unix time the process started.", float64(startTime.Unix()))
+}
+
+// DBStatsCollector reports connection pool figures for pool.
+func DBStatsCollector(pool *sql.DB) Collector {
+	return func(_ context.Context, e *Emitter) {
+		if pool == nil {
+			return
+		}
+		s := pool.Stats()
+		e.Gauge("goa4web_db_max_open_connections", "Maximum number of open database connections.", float64(s.MaxOpenConnections))
+		e.Gauge("goa4web_db_open_connections", "Open database connections.", float64(s.OpenConnections))
+		e.Gauge("goa4web_db_in_use_connections", "Database connections in use.", float64(s.InUse))
+		e.Gauge("goa4web_db_idle_connections", "Idle database connections.", float64(s.Idle))
+		e.Counter("goa4web_db_wait_count_total", "Connections waited for.", float64(s.WaitCount))
+		e.Counter("goa4web_db_wait_duration_seconds_total", "Time spent waiting for connections.", s.WaitDuration.Seconds())
+		e.Counter("goa4web_db_max_idle_closed_total", "Connections closed due to the idle limit.", float64(s.MaxIdleClosed))
+		e.Counter("goa4web_db_max_lifetime_closed_total", "Connections closed due to the lifetime limit.", float64(s.MaxLifetimeClosed))
+	}
+}
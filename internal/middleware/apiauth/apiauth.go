@@ -7,9 +7,11 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/arran4/goa4web/core"
 	"github.com/arran4/goa4web/core/common"
 	"github.com/arran4/goa4web/core/consts"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/gorilla/sessions"
 )
 
 // APIKeyAuthMiddleware checks for a Bearer token, validates it, and sets the user identity.
@@ -49,11 +51,20 @@ func APIKeyAuthMiddleware(next http.Handler) http.Handler {
 			scopeMap[strings.TrimSpace(s)] = true
 		}
 
-		// Set UserID in CoreData to act as the user
-		cd.UserID = apiKey.UsersIdusers
+		// Act as the key owner. Any roles cached for the anonymous request are
+		// discarded so grant checks are evaluated for the owner.
+		cd.SetActingUser(apiKey.UsersIdusers)
+
+		// Tasks read the user from the session so provide a request scoped
+		// session that is never persisted as a cookie.
+		session := sessions.NewSession(core.Store, core.SessionName)
+		session.Options = &sessions.Options{Path: "/", MaxAge: -1}
+		session.Values["UID"] = apiKey.UsersIdusers
+		cd.SetSession(session)
 
 		// Store scopes in context for specific handlers to check
 		ctx := context.WithValue(r.Context(), consts.KeyAPIScopes, scopeMap)
+		ctx = context.WithValue(ctx, core.ContextValues("session"), session)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
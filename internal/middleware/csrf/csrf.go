@@ -11,6 +11,7 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"filippo.io/csrf/gorilla"
 
@@ -43,7 +44,7 @@ func NewCSRFMiddleware(secret string, hostname string, version string) func(http
 	protect := csrf.Protect(key[:], csrf.Secure(version != "dev"), csrf.TrustedOrigins(origins))
 	return func(next http.Handler) http.Handler {
 		validatedNext := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if requiresToken(r.Method) && !validateRequestToken(r) {
+			if requiresToken(r.Method) && !isAPIKeyRequest(r) && !isSignedInboxPost(r) && !isOneClickUnsubscribe(r) && !isEmailEventWebhook(r) && !validateRequestToken(r) {
 				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 				return
 			}
@@ -51,6 +52,14 @@ func NewCSRFMiddleware(secret string, hostname string, version string) func(http
 		})
 		protected := protect(validatedNext)
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isAPIKeyRequest(r) {
+				// The key is the only credential an API call may use; without
+				// the session cookie a bogus key leaves the caller anonymous.
+				r = r.Clone(r.Context())
+				r.Header.Del("Cookie")
+				protected.ServeHTTP(w, r)
+				return
+			}
 			withToken, ok := attachToken(w, r)
 			if !ok {
 				return
@@ -121,6 +130,36 @@ func validateRequestToken(r *http.Request) bool {
 	return subtleCompare(r.PostFormValue(formFieldName), token)
 }
 
+// isAPIKeyRequest reports whether r is a call to an API route carrying an API
+// key. Such requests have their cookies removed so they cannot ride on a
+// browser session, which leaves nothing for a cross-site request to forge.
+func isAPIKeyRequest(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	return len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") && strings.Contains(r.URL.Path, "/api/")
+}
+
+// isSignedInboxPost reports whether r is an ActivityPub inbox delivery signed
+// by a remote server. Browsers cannot add the header to a cross-site form post
+// and the inbox handler verifies the signature itself; other routes ignore the
+// header, so it exempts nothing there.
+func isSignedInboxPost(r *http.Request) bool {
+	return r.Header.Get("Signature") != "" && strings.HasPrefix(r.URL.Path, "/activitypub/users/") && strings.HasSuffix(r.URL.Path, "/inbox")
+}
+
+// isOneClickUnsubscribe reports whether r is an RFC 8058 unsubscribe post
+// from a mail client. The link is signed for a single subscription, which the
+// handler verifies, so a forged cross-site post gains nothing.
+func isOneClickUnsubscribe(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/usr/subscriptions/unsubscribe/") && r.URL.Query().Get("sig") != ""
+}
+
+// isEmailEventWebhook reports whether r is a bounce or complaint post from an
+// email provider. Providers hold no session; the path carries a token the
+// handler checks and the events only ever suppress mail.
+func isEmailEventWebhook(r *http.Request) bool {
+	return strings.HasPrefix(r.URL.Path, "/email/events/")
+}
+
 func subtleCompare(provided string, expected string) bool {
 	if provided == "" || expected == "" {
 		return false
@@ -196,3 +196,158 @@ func TestCSRFRotatesAfterAuthentication(t *testing.T) {
 		t.Fatalf("expected token rotation after authentication")
 	}
 }
+
+func TestCSRFBearerTokenExempt(t *testing.T) {
+	store = sessions.NewCookieStore([]byte("testsecret"))
+	core.Store = store
+	core.SessionName = sessionName
+
+	r := mux.NewRouter()
+	r.HandleFunc("/api/forum/topics", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+
+	handler := NewCSRFMiddleware("testsecret", "http://example.com", "dev")(r)
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/api/forum/topics", nil)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected forbidden without token got %d", rr.Code)
+	}
+
+	req2 := httptest.NewRequest(http.MethodPost, "http://example.com/api/forum/topics", nil)
+	req2.Header.Set("Authorization", "Bearer abc123")
+	rr2 := httptest.NewRecorder()
+	handler.ServeHTTP(rr2, req2)
+	if rr2.Code != http.StatusOK {
+		t.Fatalf("expected 200 with bearer token got %d", rr2.Code)
+	}
+}
+
+func TestCSRFBearerTokenScopedToAPI(t *testing.T) {
+	store = sessions.NewCookieStore([]byte("testsecret"))
+	core.Store = store
+	core.SessionName = sessionName
+
+	var cookie string
+	r := mux.NewRouter()
+	r.HandleFunc("/api/forum/quote-selection", func(w http.ResponseWriter, r *http.Request) {
+		cookie = r.Header.Get("Cookie")
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+	r.HandleFunc("/forum/topic/1/thread", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+
+	handler := NewCSRFMiddleware("testsecret", "http://example.com", "dev")(r)
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/forum/topic/1/thread", nil)
+	req.Header.Set("Authorization", "Bearer abc123")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected forbidden for bearer token on form route got %d", rr.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "http://example.com/api/forum/quote-selection", nil)
+	req.Header.Set("Authorization", "Bearer abc123")
+	req.Header.Set("Cookie", sessionName+"=victim")
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK || cookie != "" {
+		t.Fatalf("status %d cookie %q; want session cookie dropped", rr.Code, cookie)
+	}
+}
+
+func TestCSRFHTTPSignatureExempt(t *testing.T) {
+	store = sessions.NewCookieStore([]byte("testsecret"))
+	core.Store = store
+	core.SessionName = sessionName
+
+	r := mux.NewRouter()
+	r.HandleFunc("/activitypub/users/bob/inbox", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	}).Methods(http.MethodPost)
+
+	handler := NewCSRFMiddleware("testsecret", "http://example.com", "dev")(r)
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/activitypub/users/bob/inbox", nil)
+	req.Header.Set("Signature", `keyId="https://remote.example/users/alice#main-key",signature="c2ln"`)
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusAccepted {
+		t.Fatalf("expected 202 with http signature got %d", rr.Code)
+	}
+
+	r.HandleFunc("/usr/email", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+	req = httptest.NewRequest(http.MethodPost, "http://example.com/usr/email", nil)
+	req.Header.Set("Signature", `keyId="https://remote.example/users/alice#main-key",signature="c2ln"`)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for signed post outside the inbox got %d", rr.Code)
+	}
+}
+
+func TestCSRFOneClickUnsubscribeExempt(t *testing.T) {
+	store = sessions.NewCookieStore([]byte("testsecret"))
+	core.Store = store
+	core.SessionName = sessionName
+
+	r := mux.NewRouter()
+	r.HandleFunc("/usr/subscriptions/unsubscribe/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+
+	handler := NewCSRFMiddleware("testsecret", "http://example.com", "dev")(r)
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/usr/subscriptions/unsubscribe/3?uid=2&sig=abc", strings.NewReader("List-Unsubscribe=One-Click"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected 200 for signed unsubscribe got %d", rr.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "http://example.com/usr/subscriptions/unsubscribe/3", strings.NewReader("List-Unsubscribe=One-Click"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 for unsigned unsubscribe got %d", rr.Code)
+	}
+}
+
+func TestCSRFEmailEventWebhookExempt(t *testing.T) {
+	store = sessions.NewCookieStore([]byte("testsecret"))
+	core.Store = store
+	core.SessionName = sessionName
+
+	r := mux.NewRouter()
+	r.HandleFunc("/email/events/{provider}/{token}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}).Methods(http.MethodPost)
+	r.HandleFunc("/email/other", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodPost)
+
+	handler := NewCSRFMiddleware("testsecret", "http://example.com", "dev")(r)
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/email/events/sendgrid/tok", strings.NewReader("[]"))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusNoContent {
+		t.Fatalf("expected 204 for webhook got %d", rr.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "http://example.com/email/other", strings.NewReader("[]"))
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	if rr.Code != http.StatusForbidden {
+		t.Fatalf("expected 403 outside webhook path got %d", rr.Code)
+	}
+}
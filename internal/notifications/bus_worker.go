@@ -182,6 +182,17 @@ func (n *Notifier) ProcessEvent(ctx context.Context, evt eventbus.TaskEvent, q d
 
 	}
 
+	if tp, ok := evt.Task.(MentionsNotificationProvider); ok {
+		if err := n.notifyMentioned(ctx, evt, tp); err != nil {
+			errW := fmt.Errorf("MentionsNotificationProvider: %w", err)
+			if dlqErr := n.dlqRecordAndNotify(ctx, q, fmt.Sprintf("notify mentioned users: %v", errW), &evt); dlqErr != nil {
+				return dlqErr
+			}
+			return errW
+		}
+
+	}
+
 	if tp, ok := evt.Task.(SubscribersNotificationTemplateProvider); ok {
 		if err := n.notifySubscribers(ctx, evt, tp); err != nil {
 			errW := fmt.Errorf("SubscribersNotificationTemplateProvider: %w", err)
@@ -358,6 +369,11 @@ func (n *Notifier) notifySubscribers(ctx context.Context, evt eventbus.TaskEvent
 	if tn, ok := evt.Task.(tasks.Name); ok {
 		name = tn.Name()
 	}
+	if st, ok := evt.Task.(SubscribersTaskProvider); ok {
+		if tn := st.SubscribersTask(evt); tn != nil {
+			name = tn.Name()
+		}
+	}
 	patterns := buildPatterns(tasks.TaskString(name), evt.Path)
 
 	emailSubs, err := collectSubscribers(ctx, n.Queries, patterns, "email")
@@ -413,8 +429,11 @@ func (n *Notifier) notifySubscribers(ctx context.Context, evt eventbus.TaskEvent
 	}
 
 	if et, send := tp.SubscribedEmailTemplate(evt); send {
+		threading := n.threadOptions(evt)
 		for id := range emailSubs {
-			if err := n.sendSubscriberEmail(ctx, id, evt, et); err != nil {
+			opts := append(n.emailReplyOptions(id, evt), threading...)
+			opts = append(opts, n.unsubscribeOptions(ctx, id, patterns)...)
+			if err := n.sendSubscriberEmail(ctx, id, evt, et, opts...); err != nil {
 				return fmt.Errorf("deliver email to %d: %w", id, err)
 			}
 		}
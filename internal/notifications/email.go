@@ -7,12 +7,15 @@ import (
 	"html"
 	htemplate "html/template"
 	"log"
+	"net/textproto"
 	"strings"
 	"time"
 
 	"github.com/arran4/goa4web/internal/db"
 	"github.com/arran4/goa4web/internal/email"
+	"github.com/arran4/goa4web/internal/emailreply"
 	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/tasks"
 )
 
 func (n *Notifier) CreateEmailTemplateAndQueue(ctx context.Context, userID *int32, emailAddr, page, action string, item any) error {
@@ -52,6 +55,15 @@ type EmailData struct {
 	SignOffHTML    htemplate.HTML
 	Item           any
 	Recipient      *db.SystemGetUserByIDRow
+	// ReplyTo is the address replies to the email are posted from, if any.
+	ReplyTo string
+	// ListUnsubscribe is the one-click unsubscribe link of the subscription
+	// the email was sent for.
+	ListUnsubscribe string
+	// MessageID identifies the email and References lists the messages of
+	// the conversation it belongs to, oldest first.
+	MessageID  string
+	References []string
 }
 
 // EmailOption configures EmailData prior to rendering.
@@ -67,6 +79,24 @@ func WithRecipient(u *db.SystemGetUserByIDRow) EmailOption {
 	return func(d *EmailData) { d.Recipient = u }
 }
 
+// WithReplyTo sets the Reply-To address of the email.
+func WithReplyTo(addr string) EmailOption {
+	return func(d *EmailData) { d.ReplyTo = addr }
+}
+
+// WithListUnsubscribe sets the RFC 8058 one-click unsubscribe link.
+func WithListUnsubscribe(link string) EmailOption {
+	return func(d *EmailData) { d.ListUnsubscribe = link }
+}
+
+// WithThreading sets the Message-ID of the email and the messages it follows.
+func WithThreading(messageID string, references ...string) EmailOption {
+	return func(d *EmailData) {
+		d.MessageID = messageID
+		d.References = references
+	}
+}
+
 // RenderEmailFromTemplates returns the rendered email message using the provided templates.
 // Options may adjust the email metadata prior to rendering.
 func (n *Notifier) RenderEmailFromTemplates(ctx context.Context, emailAddr string, et *EmailTemplates, item any, opts ...EmailOption) ([]byte, error) {
@@ -140,7 +170,22 @@ func (n *Notifier) RenderEmailFromTemplates(ctx context.Context, emailAddr strin
 		}
 		htmlBody = strings.TrimSpace(string(hb))
 	}
-	return email.BuildMessage(from, to, subject, textBody, htmlBody)
+	hdr := textproto.MIMEHeader{}
+	if data.ReplyTo != "" {
+		hdr.Set("Reply-To", data.ReplyTo)
+	}
+	if data.ListUnsubscribe != "" {
+		hdr.Set("List-Unsubscribe", "<"+data.ListUnsubscribe+">")
+		hdr.Set("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	if data.MessageID != "" {
+		hdr.Set("Message-ID", data.MessageID)
+	}
+	if len(data.References) > 0 {
+		hdr.Set("In-Reply-To", data.References[len(data.References)-1])
+		hdr.Set("References", strings.Join(data.References, " "))
+	}
+	return email.BuildMessageWithHeaders(from, to, subject, textBody, htmlBody, hdr)
 }
 
 func (n *Notifier) queueEmail(ctx context.Context, userID *int32, direct bool, msg []byte) error {
@@ -164,7 +209,7 @@ func (n *Notifier) queueEmail(ctx context.Context, userID *int32, direct bool, m
 }
 
 // sendSubscriberEmail queues an email notification for a subscriber.
-func (n *Notifier) sendSubscriberEmail(ctx context.Context, userID int32, evt eventbus.TaskEvent, et *EmailTemplates) error {
+func (n *Notifier) sendSubscriberEmail(ctx context.Context, userID int32, evt eventbus.TaskEvent, et *EmailTemplates, opts ...EmailOption) error {
 	user, err := n.Queries.SystemGetUserByID(ctx, userID)
 	if err != nil || !user.Email.Valid || user.Email.String == "" {
 		if nmErr := notifyMissingEmail(ctx, n.Queries, userID); nmErr != nil {
@@ -175,5 +220,33 @@ func (n *Notifier) sendSubscriberEmail(ctx context.Context, userID int32, evt ev
 	if et == nil {
 		return nil
 	}
-	return n.renderAndQueueEmailFromTemplates(ctx, &userID, user.Email.String, et, evt.Data, WithRecipient(user))
+	opts = append(opts, WithRecipient(user))
+	return n.renderAndQueueEmailFromTemplates(ctx, &userID, user.Email.String, et, evt.Data, opts...)
+}
+
+// emailReplyOptions returns the Reply-To option that lets userID answer evt
+// by email, when reply by email is configured and the task supports it.
+func (n *Notifier) emailReplyOptions(userID int32, evt eventbus.TaskEvent) []EmailOption {
+	if n.EmailReplyKey == "" || n.Config == nil || n.Config.EmailReplyDomain == "" {
+		return nil
+	}
+	rp, ok := evt.Task.(EmailReplyProvider)
+	if !ok {
+		return nil
+	}
+	tn, ok := evt.Task.(tasks.Name)
+	if !ok {
+		return nil
+	}
+	path, ok := rp.EmailReplyPath(evt)
+	if !ok {
+		return nil
+	}
+	addr := emailreply.Address(n.EmailReplyKey, n.Config.EmailReplyDomain, emailreply.Target{
+		UserID:  userID,
+		Task:    tn.Name(),
+		Path:    path,
+		Expires: time.Now().Add(emailreply.DefaultLifetime),
+	})
+	return []EmailOption{WithReplyTo(addr)}
 }
@@ -4,10 +4,13 @@ import (
 	"bytes"
 	"context"
 	"mime"
+	"net/http"
 	"net/mail"
 	"testing"
 
 	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/emailreply"
+	"github.com/arran4/goa4web/internal/eventbus"
 )
 
 func TestRenderEmailFromTemplates_AdminSubject(t *testing.T) {
@@ -32,3 +35,48 @@ func TestRenderEmailFromTemplates_AdminSubject(t *testing.T) {
 		t.Fatalf("subject=%q want %q", subj, want)
 	}
 }
+
+type emailReplyTestTask struct{ path string }
+
+func (emailReplyTestTask) Name() string { return "Reply" }
+
+func (emailReplyTestTask) Action(http.ResponseWriter, *http.Request) any { return nil }
+
+func (t emailReplyTestTask) EmailReplyPath(evt eventbus.TaskEvent) (string, bool) {
+	return t.path, t.path != ""
+}
+
+func TestRenderEmailFromTemplates_ReplyTo(t *testing.T) {
+	cfg := config.NewRuntimeConfig()
+	cfg.EmailFrom = "from@example.com"
+	cfg.EmailReplyDomain = "reply.example.com"
+	n := New(WithConfig(cfg), WithEmailReplyKey("k"))
+	evt := eventbus.TaskEvent{Task: emailReplyTestTask{path: "/forum/topic/1/thread/2/reply"}}
+	opts := n.emailReplyOptions(7, evt)
+	if len(opts) != 1 {
+		t.Fatalf("options=%d want 1", len(opts))
+	}
+	msg, err := n.RenderEmailFromTemplates(context.Background(), "to@example.com", &EmailTemplates{}, nil, opts...)
+	if err != nil {
+		t.Fatalf("RenderEmailFromTemplates: %v", err)
+	}
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	target, err := emailreply.ParseAddress("k", "reply.example.com", m.Header.Get("Reply-To"))
+	if err != nil {
+		t.Fatalf("ParseAddress(%q): %v", m.Header.Get("Reply-To"), err)
+	}
+	if target.UserID != 7 || target.Task != "Reply" || target.Path != "/forum/topic/1/thread/2/reply" {
+		t.Fatalf("target=%+v", target)
+	}
+
+	if opts := n.emailReplyOptions(7, eventbus.TaskEvent{Task: emailReplyTestTask{}}); len(opts) != 0 {
+		t.Fatalf("options without path=%d want 0", len(opts))
+	}
+	cfg.EmailReplyDomain = ""
+	if opts := n.emailReplyOptions(7, evt); len(opts) != 0 {
+		t.Fatalf("options without domain=%d want 0", len(opts))
+	}
+}
@@ -0,0 +1,115 @@
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+const (
+	// EmailTemplateMention is sent to users mentioned with @username.
+	EmailTemplateMention EmailTemplateName = "mentionEmail"
+	// NotificationTemplateMention is the internal notification for a mention.
+	NotificationTemplateMention NotificationTemplateName = "mention"
+)
+
+// notifyMentioned delivers mention notifications to each user recorded in the
+// event's MentionEventData. The author, unknown usernames, users who muted
+// mentions and users failing the provider's grants are skipped. Emails are
+// only sent to users who receive reply notifications by email. A failure for
+// one user is logged and the remaining users are still notified.
+func (n *Notifier) notifyMentioned(ctx context.Context, evt eventbus.TaskEvent, tp MentionsNotificationProvider) error {
+	data, ok := evt.Data[common.MentionsEventKey].(common.MentionEventData)
+	if !ok || len(data.Usernames) == 0 {
+		return nil
+	}
+	reqs, err := tp.MentionGrants(evt)
+	if err != nil {
+		return fmt.Errorf("mention grants: %w", err)
+	}
+
+	var msg []byte
+	if nt := tp.MentionInternalNotificationTemplate(evt); nt != nil {
+		msg, err = n.renderNotification(ctx, *nt, EmailData{Item: data, any: data})
+		if err != nil {
+			return fmt.Errorf("render mention notification: %w", err)
+		}
+	}
+	et, sendEmail := tp.MentionEmailTemplate(evt)
+
+	link := data.Path
+	if link == "" {
+		link = evt.Path
+	}
+	seen := map[int32]bool{}
+	for _, name := range data.Usernames {
+		user, err := n.Queries.SystemGetUserByUsername(ctx, sql.NullString{String: name, Valid: true})
+		if err != nil {
+			if !errors.Is(err, sql.ErrNoRows) {
+				log.Printf("mention lookup %q: %v", name, err)
+			}
+			continue
+		}
+		if user == nil || user.Idusers == evt.UserID || seen[user.Idusers] {
+			continue
+		}
+		seen[user.Idusers] = true
+		id := user.Idusers
+
+		pref, err := n.Queries.GetPreferenceForLister(ctx, id)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			log.Printf("mention preferences for %d: %v", id, err)
+			continue
+		}
+		if pref != nil && pref.MuteMentions {
+			continue
+		}
+		if !n.userHasGrants(ctx, id, reqs) {
+			continue
+		}
+
+		if len(msg) != 0 {
+			if err := n.sendInternalNotification(ctx, id, link, string(msg)); err != nil {
+				log.Printf("deliver mention to %d: %v", id, err)
+			}
+		}
+		if sendEmail && et != nil && pref != nil && pref.Emailforumupdates.Valid && pref.Emailforumupdates.Bool {
+			recipient, err := n.Queries.SystemGetUserByID(ctx, id)
+			if err != nil || !recipient.Email.Valid || strings.TrimSpace(recipient.Email.String) == "" {
+				if nmErr := notifyMissingEmail(ctx, n.Queries, id); nmErr != nil {
+					log.Printf("notify missing email: %v", nmErr)
+				}
+				continue
+			}
+			if err := n.renderAndQueueEmailFromTemplates(ctx, &id, recipient.Email.String, et, data, WithRecipient(recipient)); err != nil {
+				log.Printf("deliver mention email to %d: %v", id, err)
+			}
+		}
+	}
+	return nil
+}
+
+// userHasGrants reports whether userID satisfies every requirement.
+func (n *Notifier) userHasGrants(ctx context.Context, userID int32, reqs []GrantRequirement) bool {
+	for _, g := range reqs {
+		if _, err := n.Queries.SystemCheckGrant(ctx, db.SystemCheckGrantParams{
+			ViewerID:               userID,
+			Section:                g.Section.String(),
+			Item:                   sql.NullString{String: g.Item.String(), Valid: g.Item != ""},
+			Action:                 g.Action.String(),
+			ItemID:                 sql.NullInt32{Int32: g.ItemID, Valid: g.ItemID != 0},
+			IsSpecificPrivateForum: (g.Section.String() == "privateforum" || g.Section.String() == "privateforum_thread") && g.ItemID != 0,
+			UserID:                 sql.NullInt32{Int32: userID, Valid: userID != 0},
+		}); err != nil {
+			return false
+		}
+	}
+	return true
+}
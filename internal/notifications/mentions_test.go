@@ -0,0 +1,106 @@
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/internal/testhelpers"
+)
+
+type mentionTask struct{ tasks.TaskString }
+
+func (mentionTask) Action(http.ResponseWriter, *http.Request) any { return nil }
+
+func (mentionTask) MentionEmailTemplate(eventbus.TaskEvent) (*EmailTemplates, bool) {
+	return EmailTemplateMention.EmailTemplates(), true
+}
+
+func (mentionTask) MentionInternalNotificationTemplate(eventbus.TaskEvent) *string {
+	s := NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+func (mentionTask) MentionGrants(eventbus.TaskEvent) ([]GrantRequirement, error) { return nil, nil }
+
+func TestNotifyMentioned(t *testing.T) {
+	users := map[string]int32{"alice": 2, "bob": 3, "carol": 4}
+	q := testhelpers.NewQuerierStub()
+	q.SystemGetUserByUsernameFn = func(_ context.Context, name sql.NullString) (*db.SystemGetUserByUsernameRow, error) {
+		id, ok := users[name.String]
+		if !ok {
+			return nil, sql.ErrNoRows
+		}
+		return &db.SystemGetUserByUsernameRow{Idusers: id, Username: name}, nil
+	}
+	q.GetPreferenceForListerReturn = map[int32]*db.Preference{
+		3: {UsersIdusers: 3, MuteMentions: true},
+	}
+	cfg := config.NewRuntimeConfig()
+	n := New(WithQueries(q), WithConfig(cfg))
+
+	evt := eventbus.TaskEvent{
+		Path:   "/forum/topic/1/thread/1",
+		UserID: 4,
+		Data: map[string]any{common.MentionsEventKey: common.MentionEventData{
+			Usernames: []string{"alice", "bob", "carol", "nobody", "Alice"},
+			Author:    "carol",
+			Path:      "/forum/topic/1/thread/1",
+			Excerpt:   "hi @alice @bob",
+		}},
+	}
+	if err := n.notifyMentioned(context.Background(), evt, mentionTask{}); err != nil {
+		t.Fatalf("notifyMentioned: %v", err)
+	}
+	if len(q.SystemCreateNotificationCalls) != 1 {
+		t.Fatalf("expected 1 notification got %+v", q.SystemCreateNotificationCalls)
+	}
+	got := q.SystemCreateNotificationCalls[0]
+	if got.RecipientID != 2 || got.Link.String != "/forum/topic/1/thread/1" {
+		t.Fatalf("unexpected notification %+v", got)
+	}
+	if got.Message.String != "carol mentioned you: hi @alice @bob" {
+		t.Fatalf("message %q", got.Message.String)
+	}
+	if len(q.InsertPendingEmailCalls) != 0 {
+		t.Fatalf("expected no email without email preference, got %d", len(q.InsertPendingEmailCalls))
+	}
+}
+
+func TestNotifyMentionedContinuesAfterDeliveryError(t *testing.T) {
+	users := map[string]int32{"alice": 2, "bob": 3}
+	q := testhelpers.NewQuerierStub()
+	q.SystemGetUserByUsernameFn = func(_ context.Context, name sql.NullString) (*db.SystemGetUserByUsernameRow, error) {
+		id, ok := users[name.String]
+		if !ok {
+			return nil, sql.ErrNoRows
+		}
+		return &db.SystemGetUserByUsernameRow{Idusers: id, Username: name}, nil
+	}
+	q.SystemCreateNotificationErr = errors.New("insert failed")
+	n := New(WithQueries(q), WithConfig(config.NewRuntimeConfig()))
+
+	evt := eventbus.TaskEvent{
+		Path:   "/forum/topic/1/thread/1",
+		UserID: 4,
+		Data: map[string]any{common.MentionsEventKey: common.MentionEventData{
+			Usernames: []string{"alice", "bob"},
+			Author:    "carol",
+			Path:      "/forum/topic/1/thread/1",
+			Excerpt:   "hi @alice @bob",
+		}},
+	}
+	if err := n.notifyMentioned(context.Background(), evt, mentionTask{}); err != nil {
+		t.Fatalf("notifyMentioned: %v", err)
+	}
+	if len(q.SystemCreateNotificationCalls) != 2 {
+		t.Fatalf("expected a delivery attempt per user, got %+v", q.SystemCreateNotificationCalls)
+	}
+}
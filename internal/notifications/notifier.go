@@ -37,6 +37,10 @@ type Notifier struct {
 	emailHTMLTmpls *htemplate.Template
 	CustomQueries  db.CustomQueries
 	Silent         bool
+	// EmailReplyKey signs the Reply-To addresses of reply notifications.
+	EmailReplyKey string
+	// LinkSignKey signs the one-click unsubscribe links of subscriber emails.
+	LinkSignKey string
 }
 
 // Option configures a Notifier instance.
@@ -65,6 +69,12 @@ func WithCustomQueries(cq db.CustomQueries) Option {
 // WithEmailProvider sets the email provider dependency.
 func WithEmailProvider(p email.Provider) Option { return func(n *Notifier) { n.EmailProvider = p } }
 
+// WithEmailReplyKey sets the key used to sign email reply addresses.
+func WithEmailReplyKey(key string) Option { return func(n *Notifier) { n.EmailReplyKey = key } }
+
+// WithLinkSignKey sets the key used to sign unsubscribe links.
+func WithLinkSignKey(key string) Option { return func(n *Notifier) { n.LinkSignKey = key } }
+
 // WithBus sets the event bus dependency used to publish email queue events.
 func WithBus(b *eventbus.Bus) Option { return func(n *Notifier) { n.Bus = b } }
 
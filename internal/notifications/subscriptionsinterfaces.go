@@ -99,6 +99,14 @@ type SubscribersNotificationTemplateProvider interface {
 	SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent) *string
 }
 
+// SubscribersTaskProvider selects the task whose subscription patterns
+// receive subscriber notifications instead of the event's own task. Events
+// raised outside a request, such as a poll closing, use it to reach the
+// people following a thread's replies.
+type SubscribersTaskProvider interface {
+	SubscribersTask(evt eventbus.TaskEvent) tasks.Name
+}
+
 // AutoSubscribeProvider describes events that automatically create a
 // subscription when user preferences allow.
 type AutoSubscribeProvider interface {
@@ -119,9 +127,26 @@ type TargetUsersNotificationProvider interface {
 	TargetInternalNotificationTemplate(evt eventbus.TaskEvent) *string
 }
 
+// MentionsNotificationProvider indicates users mentioned with @username in the
+// event's content are notified. Tasks record the mentions with
+// CoreData.RecordMentions; only mentioned users meeting every MentionGrants
+// requirement who have not muted mentions receive the templates.
+type MentionsNotificationProvider interface {
+	MentionEmailTemplate(evt eventbus.TaskEvent) (templates *EmailTemplates, send bool)
+	MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string
+	MentionGrants(evt eventbus.TaskEvent) ([]GrantRequirement, error)
+}
+
 // GrantsRequiredProvider exposes the permission context for subscription
 // notifications. Implementations return one or more GrantRequirement values
 // checked with `SystemCheckGrant` before delivering a message.
 type GrantsRequiredProvider interface {
 	GrantsRequired(evt eventbus.TaskEvent) ([]GrantRequirement, error)
 }
+
+// EmailReplyProvider is implemented by tasks whose subscriber emails can be
+// answered by email. EmailReplyPath returns the path the reply form of the
+// same task is posted to.
+type EmailReplyProvider interface {
+	EmailReplyPath(evt eventbus.TaskEvent) (path string, ok bool)
+}
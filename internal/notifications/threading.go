@@ -0,0 +1,71 @@
+package notifications
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+// actionSuffix matches trailing path segments naming an action on an item
+// rather than the item itself.
+var actionSuffix = regexp.MustCompile(`/(reply|edit|comment/[0-9]+|comment/[0-9]+/edit)$`)
+
+// msgIDUnsafe matches characters not allowed in the local part of a
+// Message-ID.
+var msgIDUnsafe = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// itemPath returns the path of the thread or item an event belongs to, so
+// replies and edits share it with the item.
+func itemPath(p string) string {
+	if u, err := url.Parse(p); err == nil {
+		p = u.Path
+	}
+	p = strings.TrimRight(p, "/")
+	return actionSuffix.ReplaceAllString(p, "")
+}
+
+// messageIDHost returns the domain used on the right of generated
+// Message-IDs.
+func (n *Notifier) messageIDHost() string {
+	if n.Config != nil {
+		if u, err := url.Parse(n.Config.BaseURL); err == nil && u.Hostname() != "" {
+			return u.Hostname()
+		}
+		if a, err := mail.ParseAddress(n.Config.EmailFrom); err == nil {
+			if _, host, ok := strings.Cut(a.Address, "@"); ok {
+				return host
+			}
+		}
+	}
+	return "goa4web"
+}
+
+// threadMessageID returns the Message-ID every notification about the item
+// at path refers to, letting mail clients group them in one conversation.
+func (n *Notifier) threadMessageID(path string) string {
+	local := strings.Trim(msgIDUnsafe.ReplaceAllString(itemPath(path), "."), ".")
+	if local == "" {
+		return ""
+	}
+	return fmt.Sprintf("<%s@%s>", local, n.messageIDHost())
+}
+
+// threadOptions returns the Message-ID and References options for emails
+// about evt.
+func (n *Notifier) threadOptions(evt eventbus.TaskEvent) []EmailOption {
+	root := n.threadMessageID(evt.Path)
+	if root == "" {
+		return nil
+	}
+	t := evt.Time
+	if t.IsZero() {
+		t = time.Now()
+	}
+	id := fmt.Sprintf("<%d.%s", t.UnixNano(), strings.TrimPrefix(root, "<"))
+	return []EmailOption{WithThreading(id, root)}
+}
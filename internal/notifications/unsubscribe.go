@@ -0,0 +1,65 @@
+package notifications
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/sign"
+)
+
+// UnsubscribePath is the path prefix of one-click unsubscribe links.
+const UnsubscribePath = "/usr/subscriptions/unsubscribe/"
+
+func unsubscribeSignData(userID, subscriptionID int32) string {
+	return fmt.Sprintf("unsubscribe:%d:%d", userID, subscriptionID)
+}
+
+// UnsubscribeURL returns the signed link that removes subscription
+// subscriptionID of userID without requiring a login. The link does not
+// expire so it keeps working from old emails.
+func UnsubscribeURL(baseURL, key string, userID, subscriptionID int32) string {
+	sig := sign.Sign(unsubscribeSignData(userID, subscriptionID), key, sign.WithOutNonce())
+	q := url.Values{}
+	q.Set("uid", fmt.Sprint(userID))
+	q.Set("sig", sig)
+	return fmt.Sprintf("%s%s%d?%s", strings.TrimRight(baseURL, "/"), UnsubscribePath, subscriptionID, q.Encode())
+}
+
+// VerifyUnsubscribe checks sig was issued by UnsubscribeURL for the
+// subscription and user.
+func VerifyUnsubscribe(key string, userID, subscriptionID int32, sig string) error {
+	if key == "" {
+		return fmt.Errorf("unsubscribe signing key not configured")
+	}
+	return sign.Verify(unsubscribeSignData(userID, subscriptionID), sig, key, sign.WithOutNonce())
+}
+
+// unsubscribeOptions returns the List-Unsubscribe option for the email
+// subscription of userID that matched patterns.
+func (n *Notifier) unsubscribeOptions(ctx context.Context, userID int32, patterns []string) []EmailOption {
+	if n.LinkSignKey == "" || n.Config == nil || n.Config.BaseURL == "" {
+		return nil
+	}
+	subs, err := n.Queries.ListSubscriptionsByUser(ctx, userID)
+	if err != nil {
+		log.Printf("list subscriptions for %d: %v", userID, err)
+		return nil
+	}
+	ids := map[string]int32{}
+	for _, s := range subs {
+		if s.Method == "email" {
+			ids[s.Pattern] = s.ID
+		}
+	}
+	// Patterns run from the most specific to the most general so the row
+	// removed is the narrowest one covering the event.
+	for _, p := range expandPatternSeparators(patterns) {
+		if id, ok := ids[p]; ok {
+			return []EmailOption{WithListUnsubscribe(UnsubscribeURL(n.Config.BaseURL, n.LinkSignKey, userID, id))}
+		}
+	}
+	return nil
+}
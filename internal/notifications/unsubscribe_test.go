@@ -0,0 +1,112 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/testhelpers"
+)
+
+func TestUnsubscribeURLRoundTrip(t *testing.T) {
+	link := UnsubscribeURL("https://example.com/", "k", 7, 42)
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatalf("parse %q: %v", link, err)
+	}
+	if u.Path != UnsubscribePath+"42" || u.Query().Get("uid") != "7" {
+		t.Fatalf("link=%q", link)
+	}
+	sig := u.Query().Get("sig")
+	if err := VerifyUnsubscribe("k", 7, 42, sig); err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if err := VerifyUnsubscribe("k", 8, 42, sig); err == nil {
+		t.Fatal("signature accepted for another user")
+	}
+	if err := VerifyUnsubscribe("k", 7, 43, sig); err == nil {
+		t.Fatal("signature accepted for another subscription")
+	}
+}
+
+func TestRenderEmailFromTemplates_ListHeaders(t *testing.T) {
+	cfg := config.NewRuntimeConfig()
+	cfg.EmailFrom = "from@example.com"
+	cfg.BaseURL = "https://example.com"
+	q := testhelpers.NewQuerierStub(testhelpers.WithSubscriptions([]*db.ListSubscriptionsByUserRow{
+		{ID: 3, Pattern: "reply:/forum/topic/1/thread/2/*", Method: "internal"},
+		{ID: 4, Pattern: "reply:/forum/topic/*", Method: "email"},
+		{ID: 5, Pattern: "reply:/forum/topic/1/thread/2/*", Method: "email"},
+	}))
+	n := New(WithConfig(cfg), WithQueries(q), WithLinkSignKey("k"))
+	evt := eventbus.TaskEvent{Path: "/forum/topic/1/thread/2/reply", Time: time.Unix(100, 0)}
+
+	opts := n.threadOptions(evt)
+	opts = append(opts, n.unsubscribeOptions(context.Background(), 7, buildPatterns(emailReplyTestTask{}, evt.Path))...)
+	msg, err := n.RenderEmailFromTemplates(context.Background(), "to@example.com", &EmailTemplates{}, nil, opts...)
+	if err != nil {
+		t.Fatalf("RenderEmailFromTemplates: %v", err)
+	}
+	m, err := mail.ReadMessage(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+
+	lu := m.Header.Get("List-Unsubscribe")
+	if !strings.HasPrefix(lu, "<https://example.com"+UnsubscribePath+"5?") || !strings.HasSuffix(lu, ">") {
+		t.Fatalf("List-Unsubscribe=%q", lu)
+	}
+	if got := m.Header.Get("List-Unsubscribe-Post"); got != "List-Unsubscribe=One-Click" {
+		t.Fatalf("List-Unsubscribe-Post=%q", got)
+	}
+
+	root := "<forum.topic.1.thread.2@example.com>"
+	if got := m.Header.Get("References"); got != root {
+		t.Fatalf("References=%q", got)
+	}
+	if got := m.Header.Get("In-Reply-To"); got != root {
+		t.Fatalf("In-Reply-To=%q", got)
+	}
+	if got, want := m.Header.Get("Message-Id"), "<"+strconv.FormatInt(time.Unix(100, 0).UnixNano(), 10)+".forum.topic.1.thread.2@example.com>"; got != want {
+		t.Fatalf("Message-ID=%q want %q", got, want)
+	}
+}
+
+func TestUnsubscribeOptionsWithoutMatch(t *testing.T) {
+	cfg := config.NewRuntimeConfig()
+	cfg.BaseURL = "https://example.com"
+	q := testhelpers.NewQuerierStub(testhelpers.WithSubscriptions([]*db.ListSubscriptionsByUserRow{
+		{ID: 3, Pattern: "reply:/news/*", Method: "email"},
+	}))
+	n := New(WithConfig(cfg), WithQueries(q), WithLinkSignKey("k"))
+	if opts := n.unsubscribeOptions(context.Background(), 7, buildPatterns(emailReplyTestTask{}, "/forum/topic/1/thread/2/reply")); len(opts) != 0 {
+		t.Fatalf("options=%d want 0", len(opts))
+	}
+	n.LinkSignKey = ""
+	if opts := n.unsubscribeOptions(context.Background(), 7, []string{"reply:/news/*"}); len(opts) != 0 {
+		t.Fatalf("options without key=%d want 0", len(opts))
+	}
+}
+
+func TestItemPath(t *testing.T) {
+	tests := map[string]string{
+		"/forum/topic/1/thread/2/reply":      "/forum/topic/1/thread/2",
+		"/blogs/blog/4/comment/9":            "/blogs/blog/4",
+		"/news/news/3":                       "/news/news/3",
+		"/writings/article/5/comment/6/edit": "/writings/article/5",
+		"/imagebbs/board/1/thread/2?x=1":     "/imagebbs/board/1/thread/2",
+	}
+	for in, want := range tests {
+		if got := itemPath(in); got != want {
+			t.Errorf("itemPath(%q)=%q want %q", in, got, want)
+		}
+	}
+}
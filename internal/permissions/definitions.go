@@ -25,15 +25,18 @@ var (
 	BlogsEntryEdit    = &GrantDefinition{"blogs", "entry", "edit", "Allows editing own blog entries."}
 	BlogsEntryEditAny = &GrantDefinition{"blogs", "entry", "edit-any", "Allows editing any blog entry."}
 	BlogsEntrySee     = &GrantDefinition{"blogs", "entry", "see", "Allows seeing blog entries in lists."}
+	BlogsEntryReact   = &GrantDefinition{"blogs", "entry", "react", "Allows reacting to blog entries."}
 
 	// News
 	NewsPostPost    = &GrantDefinition{"news", "post", "post", "Allows posting new news articles."}
 	NewsPostEdit    = &GrantDefinition{"news", "post", "edit", "Allows editing news articles."}
+	NewsPostEditAny = &GrantDefinition{"news", "post", "edit-any", "Allows restoring earlier revisions of any news article."}
 	NewsPostReply   = &GrantDefinition{"news", "post", "reply", "Allows replying to news articles."}
 	NewsPostView    = &GrantDefinition{"news", "post", "view", "Allows viewing news articles."}
 	NewsPostSee     = &GrantDefinition{"news", "post", "see", "Allows seeing news articles in lists."}
 	NewsPostPromote = &GrantDefinition{"news", "post", "promote", "Allows promoting a news article to an announcement."}
 	NewsPostDemote  = &GrantDefinition{"news", "post", "demote", "Allows demoting an announcement to a regular news article."}
+	NewsPostReact   = &GrantDefinition{"news", "post", "react", "Allows reacting to news articles."}
 
 	// Linker
 	LinkerLinkPost    = &GrantDefinition{"linker", "link", "post", "Allows posting new links."}
@@ -48,11 +51,14 @@ var (
 	ForumTopicReply    = &GrantDefinition{"forum", "topic", "reply", "Allows replying to threads in a topic."}
 	ForumThreadEdit    = &GrantDefinition{"forum", "thread", "edit", "Allows editing own posts in a thread."}
 	ForumThreadEditAny = &GrantDefinition{"forum", "thread", "edit-any", "Allows editing any post in a thread."}
+	ForumTopicReact    = &GrantDefinition{"forum", "topic", "react", "Allows reacting to comments in a topic."}
+	ForumPollCreate    = &GrantDefinition{"forum", "poll", "create", "Allows attaching polls to new threads."}
 
 	// Private Forum
 	PrivateforumTopicSee   = &GrantDefinition{"privateforum", "topic", "see", "Allows seeing private topics."}
 	PrivateforumTopicPost  = &GrantDefinition{"privateforum", "topic", "post", "Allows posting new threads in a private topic."}
 	PrivateforumTopicReply = &GrantDefinition{"privateforum", "topic", "reply", "Allows replying to threads in a private topic."}
+	PrivateforumTopicReact = &GrantDefinition{"privateforum", "topic", "react", "Allows reacting to comments in private topics."}
 	PrivateforumThreadView = &GrantDefinition{
 		consts.PermissionSectionPrivateForumThread.String(),
 		consts.PermissionItemThread.String(),
@@ -71,6 +77,7 @@ var (
 	ImagebbsBoardPost    = &GrantDefinition{"imagebbs", "board", "post", "Allows posting new images to a board."}
 	ImagebbsBoardSee     = &GrantDefinition{"imagebbs", "board", "see", "Allows seeing image boards in lists."}
 	ImagebbsBoardApprove = &GrantDefinition{"imagebbs", "board", "approve", "Allows approving images on a board."}
+	ImagebbsBoardReact   = &GrantDefinition{"imagebbs", "board", "react", "Allows reacting to image posts on a board."}
 
 	// Images
 	ImagesUploadPost = &GrantDefinition{"images", "upload", "post", "Allows uploading images."}
@@ -79,12 +86,16 @@ var (
 	FaqQuestionPost = &GrantDefinition{"faq", "question", "post", "Allows posting new FAQ questions."}
 
 	// Writings
-	WritingArticleEdit  = &GrantDefinition{"writing", "article", "edit", "Allows editing own articles."}
-	WritingCategoryPost = &GrantDefinition{"writing", "category", "post", "Allows posting new articles in a category."}
-	WritingArticleView  = &GrantDefinition{"writing", "article", "view", "Allows viewing articles."}
-	WritingArticleReply = &GrantDefinition{"writing", "article", "reply", "Allows replying to articles."}
-	WritingArticleSee   = &GrantDefinition{"writing", "article", "see", "Allows seeing articles in lists."}
-	WritingPostEdit     = &GrantDefinition{"writing", "post", "edit", "Allows editing any article (admin)."}
+	WritingArticleEdit    = &GrantDefinition{"writing", "article", "edit", "Allows editing own articles."}
+	WritingArticleEditAny = &GrantDefinition{"writing", "article", "edit-any", "Allows restoring earlier revisions of any article."}
+	WritingCategoryPost   = &GrantDefinition{"writing", "category", "post", "Allows posting new articles in a category."}
+	WritingArticleView    = &GrantDefinition{"writing", "article", "view", "Allows viewing articles."}
+	WritingArticleReply   = &GrantDefinition{"writing", "article", "reply", "Allows replying to articles."}
+	WritingArticleSee     = &GrantDefinition{"writing", "article", "see", "Allows seeing articles in lists."}
+	WritingPostEdit       = &GrantDefinition{"writing", "post", "edit", "Allows editing any article (admin)."}
+
+	// Auth
+	AuthTwoFactorRequire = &GrantDefinition{"auth", "2fa", "require", "Requires members of the role to use two-factor authentication."}
 )
 
 // Definitions is a complete list of all grant permissions in the system.
@@ -96,15 +107,18 @@ var Definitions = []*GrantDefinition{
 	BlogsEntryEdit,
 	BlogsEntryEditAny,
 	BlogsEntrySee,
+	BlogsEntryReact,
 
 	// News
 	NewsPostPost,
 	NewsPostEdit,
+	NewsPostEditAny,
 	NewsPostReply,
 	NewsPostView,
 	NewsPostSee,
 	NewsPostPromote,
 	NewsPostDemote,
+	NewsPostReact,
 
 	// Linker
 	LinkerLinkPost,
@@ -119,11 +133,14 @@ var Definitions = []*GrantDefinition{
 	ForumTopicReply,
 	ForumThreadEdit,
 	ForumThreadEditAny,
+	ForumTopicReact,
+	ForumPollCreate,
 
 	// Private Forum
 	PrivateforumTopicSee,
 	PrivateforumTopicPost,
 	PrivateforumTopicReply,
+	PrivateforumTopicReact,
 	PrivateforumThreadView,
 	PrivateforumThreadReply,
 
@@ -132,6 +149,7 @@ var Definitions = []*GrantDefinition{
 	ImagebbsBoardPost,
 	ImagebbsBoardSee,
 	ImagebbsBoardApprove,
+	ImagebbsBoardReact,
 
 	// Images
 	ImagesUploadPost,
@@ -141,9 +159,13 @@ var Definitions = []*GrantDefinition{
 
 	// Writings
 	WritingArticleEdit,
+	WritingArticleEditAny,
 	WritingCategoryPost,
 	WritingArticleView,
 	WritingArticleReply,
 	WritingArticleSee,
 	WritingPostEdit,
+
+	// Auth
+	AuthTwoFactorRequire,
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/arran4/goa4web/config"
 	nav "github.com/arran4/goa4web/internal/navigation"
+	"github.com/arran4/goa4web/internal/sitemap"
 )
 
 // Module represents a router module and its setup function.
@@ -23,10 +24,16 @@ type Module struct {
 type Registry struct {
 	modules map[string]*Module
 	mu      sync.Mutex
+	// routes maps each route to the module that registered it.
+	routes map[*mux.Route]string
+	// sitemap collects the sitemap sources of the registered modules.
+	sitemap *sitemap.Sitemap
 }
 
 // NewRegistry creates an empty Registry.
-func NewRegistry() *Registry { return &Registry{modules: map[string]*Module{}} }
+func NewRegistry() *Registry {
+	return &Registry{modules: map[string]*Module{}, routes: map[*mux.Route]string{}, sitemap: sitemap.New()}
+}
 
 // RegisterModule registers a router module with optional dependencies. A module
 // is stored only on the first call.
@@ -39,6 +46,17 @@ func (reg *Registry) RegisterModule(name string, deps []string, setup func(*mux.
 	reg.modules[name] = &Module{Name: name, Deps: deps, Setup: setup}
 }
 
+// RegisterSitemap adds the sitemap source listing the public pages of the
+// named module.
+func (reg *Registry) RegisterSitemap(name string, src sitemap.Source) {
+	reg.sitemap.Register(name, src)
+}
+
+// Sitemap returns the sitemap built from the registered modules' sources.
+func (reg *Registry) Sitemap() *sitemap.Sitemap {
+	return reg.sitemap
+}
+
 // InitModules initialises all registered modules by resolving their
 // dependencies and invoking their Setup function once.
 func (reg *Registry) InitModules(r *mux.Router, cfg *config.RuntimeConfig, navReg *nav.Registry) {
@@ -77,7 +95,13 @@ func (reg *Registry) InitModules(r *mux.Router, cfg *config.RuntimeConfig, navRe
 		}
 		m.once.Do(func() {
 			log.Printf("Initializing router module: %s", m.Name)
+			before := collectRoutes(r)
 			opts := m.Setup(r, cfg)
+			for route := range collectRoutes(r) {
+				if _, ok := before[route]; !ok {
+					reg.routes[route] = m.Name
+				}
+			}
 			for _, opt := range opts {
 				opt.Apply(navReg)
 			}
@@ -97,3 +121,25 @@ func (reg *Registry) Names() []string {
 	sort.Strings(names)
 	return names
 }
+
+// collectRoutes returns every route reachable from r, including those on
+// subrouters.
+func collectRoutes(r *mux.Router) map[*mux.Route]struct{} {
+	routes := map[*mux.Route]struct{}{}
+	_ = r.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		routes[route] = struct{}{}
+		return nil
+	})
+	return routes
+}
+
+// ModuleForRoute returns the name of the module that registered route or an
+// empty string when the route was added outside a module.
+func (reg *Registry) ModuleForRoute(route *mux.Route) string {
+	if route == nil {
+		return ""
+	}
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	return reg.routes[route]
+}
@@ -1,12 +1,15 @@
 package router
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/gorilla/mux"
 
 	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/metrics"
 	"github.com/arran4/goa4web/internal/navigation"
 )
 
@@ -66,3 +69,26 @@ func TestRegistryNames(t *testing.T) {
 		t.Fatalf("names mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestMetricsMiddlewareLabelsModule(t *testing.T) {
+	reg := NewRegistry()
+	r := mux.NewRouter()
+	r.Use(reg.MetricsMiddleware)
+	reg.RegisterModule("widgets", nil, func(r *mux.Router, _ *config.RuntimeConfig) []navigation.RouterOptions {
+		sr := r.PathPrefix("/widgets").Subrouter()
+		sr.HandleFunc("/{id}", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+		return nil
+	})
+	reg.InitModules(r, &config.RuntimeConfig{}, navigation.NewRegistry())
+
+	before := metrics.HTTPRequests.Value("widgets", http.MethodGet, "418")
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/widgets/7", nil))
+	if got := metrics.HTTPRequests.Value("widgets", http.MethodGet, "418") - before; got != 1 {
+		t.Fatalf("requests counted=%v", got)
+	}
+	if metrics.HTTPRequestDuration.Count("widgets") == 0 {
+		t.Fatal("latency not observed")
+	}
+}
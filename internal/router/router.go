@@ -1,24 +1,34 @@
 package router
 
 import (
+	"bufio"
+	"fmt"
+	"net"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/arran4/goa4web/config"
 	"github.com/arran4/goa4web/core/common"
 	"github.com/arran4/goa4web/handlers"
+	"github.com/arran4/goa4web/internal/metrics"
 	nav "github.com/arran4/goa4web/internal/navigation"
 )
 
 // RegisterRoutes sets up all application routes on r.
 func RegisterRoutes(r *mux.Router, reg *Registry, cfg *config.RuntimeConfig, navReg *nav.Registry) {
+	r.Use(reg.MetricsMiddleware)
 	r.HandleFunc("/robots.txt", handlers.RobotsTXT(cfg)).Methods("GET")
+	r.HandleFunc("/sitemap.xml", handlers.SitemapIndex(cfg, reg.Sitemap())).Methods("GET")
+	r.HandleFunc("/sitemap/{section}/{page:[0-9]+}.xml", handlers.SitemapPage(cfg, reg.Sitemap())).Methods("GET")
 	r.HandleFunc("/main.css", handlers.MainCSS(cfg)).Methods("GET")
 	r.HandleFunc("/favicon.svg", handlers.Favicon(cfg)).Methods("GET")
 	r.HandleFunc("/static/site.js", handlers.SiteJS(cfg)).Methods("GET")
 	r.HandleFunc("/static/passkeys.js", handlers.PasskeysJS(cfg)).Methods("GET")
 	r.HandleFunc("/static/a4code.js", handlers.A4CodeJS(cfg)).Methods("GET")
+	r.HandleFunc("/a4code/markdown", handlers.MarkdownToA4CodePage).Methods("POST").MatcherFunc(handlers.RequiresAnAccount())
 
 	reg.InitModules(r, cfg, navReg)
 
@@ -42,3 +52,51 @@ func RoleCheckerMiddleware(roles ...string) func(http.Handler) http.Handler {
 func AdminCheckerMiddleware(next http.Handler) http.Handler {
 	return RoleCheckerMiddleware("administrator")(next)
 }
+
+// metricsRecorder captures the response status for request metrics.
+type metricsRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (m *metricsRecorder) WriteHeader(code int) {
+	if !m.wroteHeader {
+		m.status = code
+		m.wroteHeader = true
+	}
+	m.ResponseWriter.WriteHeader(code)
+}
+
+// Hijack delegates to the underlying ResponseWriter so websockets still work.
+func (m *metricsRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if hj, ok := m.ResponseWriter.(http.Hijacker); ok {
+		return hj.Hijack()
+	}
+	return nil, nil, fmt.Errorf("underlying ResponseWriter does not implement http.Hijacker")
+}
+
+// Flush delegates to the underlying ResponseWriter when it supports flushing.
+func (m *metricsRecorder) Flush() {
+	if f, ok := m.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (m *metricsRecorder) Unwrap() http.ResponseWriter { return m.ResponseWriter }
+
+// MetricsMiddleware records request counts and latencies labelled with the
+// module that registered the matched route.
+func (reg *Registry) MetricsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &metricsRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		module := reg.ModuleForRoute(mux.CurrentRoute(r))
+		if module == "" {
+			module = "core"
+		}
+		metrics.HTTPRequests.Inc(module, r.Method, strconv.Itoa(rec.status))
+		metrics.HTTPRequestDuration.Observe(time.Since(start).Seconds(), module)
+	})
+}
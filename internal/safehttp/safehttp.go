@@ -0,0 +1,53 @@
+// Package safehttp provides HTTP clients for requests to URLs supplied by
+// users or remote servers. The clients refuse to connect to loopback, private
+// and link-local addresses so such URLs cannot reach internal services.
+package safehttp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned when a connection to a disallowed address is
+// attempted.
+var ErrBlockedAddress = errors.New("destination address not allowed")
+
+// Blocked reports whether ip is an address outbound requests may not reach.
+func Blocked(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast()
+}
+
+// control runs after name resolution, so it sees the address actually dialled
+// and also covers redirects and DNS rebinding.
+func control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || Blocked(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+	}
+	return nil
+}
+
+// NewDialer returns a dialer that refuses blocked addresses.
+func NewDialer(timeout time.Duration) *net.Dialer {
+	return &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second, Control: control}
+}
+
+// NewClient returns a client whose connections go through NewDialer. Proxies
+// from the environment are ignored because the proxy address would be
+// checked instead of the destination.
+func NewClient(timeout time.Duration) *http.Client {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = nil
+	t.DialContext = NewDialer(timeout).DialContext
+	return &http.Client{Timeout: timeout, Transport: t}
+}
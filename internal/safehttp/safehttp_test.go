@@ -0,0 +1,34 @@
+package safehttp
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBlocked(t *testing.T) {
+	for _, s := range []string{"127.0.0.1", "::1", "10.1.2.3", "192.168.0.1", "172.16.0.1", "169.254.169.254", "fe80::1", "0.0.0.0", "::ffff:127.0.0.1", "fd00::1"} {
+		if !Blocked(net.ParseIP(s)) {
+			t.Errorf("%s not blocked", s)
+		}
+	}
+	for _, s := range []string{"93.184.216.34", "2606:2800:220:1::1"} {
+		if Blocked(net.ParseIP(s)) {
+			t.Errorf("%s blocked", s)
+		}
+	}
+}
+
+func TestClientRefusesLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request reached the server")
+	}))
+	defer srv.Close()
+	_, err := NewClient(time.Second).Get(srv.URL)
+	if !errors.Is(err, ErrBlockedAddress) {
+		t.Fatalf("err %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/metrics"
 )
 
 type Handler func(ctx context.Context, t time.Time) error
@@ -32,6 +33,17 @@ type runtimeTask struct {
 	NextRun time.Time
 }
 
+// run invokes the task handler and records its duration and outcome.
+func (rt *runtimeTask) run(ctx context.Context, t time.Time) error {
+	start := time.Now()
+	err := rt.Handler(ctx, t)
+	metrics.SchedulerTaskDuration.Observe(time.Since(start).Seconds(), rt.Name)
+	if err != nil {
+		metrics.SchedulerTaskFailures.Inc(rt.Name)
+	}
+	return err
+}
+
 type Scheduler struct {
 	Queries db.Querier
 	tasks   []*runtimeTask
@@ -121,7 +133,7 @@ func (s *Scheduler) processBackfillTask(ctx context.Context, rt *runtimeTask, no
 
 	// Loop from lastRun + 1 hour to currentHour
 	for t := lastRunTrunc.Add(time.Hour); !t.After(currentHour); t = t.Add(time.Hour) {
-		if err := rt.Handler(ctx, t); err != nil {
+		if err := rt.run(ctx, t); err != nil {
 			log.Printf("Scheduler task %s failed for %v: %v", rt.Name, t, err)
 			return
 		}
@@ -154,7 +166,7 @@ func (s *Scheduler) processPeriodicTask(ctx context.Context, rt *runtimeTask, no
 		}
 	}
 
-	if err := rt.Handler(ctx, now); err != nil {
+	if err := rt.run(ctx, now); err != nil {
 		log.Printf("Scheduler periodic task %s failed: %v", rt.Name, err)
 		// We still update LastRun/NextRun so we don't retry immediately?
 		// Or do we retry?
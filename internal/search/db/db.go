@@ -0,0 +1,239 @@
+// Package db implements a search backend on top of the searchwordlist and
+// *_search database tables.
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
+)
+
+// Backend stores lowercase word counts in the database. It matches whole
+// words only: phrases are treated as their individual words and prefix
+// queries match the prefix as a word. Hits are ranked by the total number of
+// occurrences of the query words and carry no snippets.
+type Backend struct {
+	Queries db.Querier
+}
+
+var _ search.Backend = (*Backend)(nil)
+
+// New returns a Backend using q.
+func New(q db.Querier) *Backend {
+	return &Backend{Queries: q}
+}
+
+// Register registers the database backend.
+func Register(r *search.Registry) {
+	r.RegisterBackend("db", func(_ *config.RuntimeConfig, q db.Querier) (search.Backend, error) {
+		return New(q), nil
+	})
+}
+
+// wordID returns the searchwordlist id for word, creating it when needed.
+func (b *Backend) wordID(ctx context.Context, word string) (int64, error) {
+	id, err := b.Queries.SystemCreateSearchWord(ctx, word)
+	if err != nil {
+		return 0, err
+	}
+	if id == 0 {
+		sw, err := b.Queries.SystemGetSearchWordByWordLowercased(ctx, word)
+		if err != nil {
+			return 0, err
+		}
+		id = int64(sw.Idsearchwordlist)
+	}
+	return id, nil
+}
+
+// Index implements search.Backend.
+func (b *Backend) Index(ctx context.Context, doc search.Document) error {
+	if b.Queries == nil {
+		return fmt.Errorf("no db")
+	}
+	if err := b.Delete(ctx, doc.Type, doc.ID); err != nil {
+		return err
+	}
+	counts := map[string]int32{}
+	for _, w := range search.BreakupTextToWords(doc.Text) {
+		counts[strings.ToLower(w)]++
+	}
+	for word, count := range counts {
+		wid, err := b.wordID(ctx, word)
+		if err != nil {
+			return err
+		}
+		if err := b.add(ctx, doc.Type, doc.ID, int32(wid), count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add inserts a single word count for a document.
+func (b *Backend) add(ctx context.Context, typ string, id, wid, count int32) error {
+	q := b.Queries
+	switch typ {
+	case search.TypeComment:
+		return q.SystemAddToForumCommentSearch(ctx, db.SystemAddToForumCommentSearchParams{CommentID: id, SearchwordlistIdsearchwordlist: wid, WordCount: count})
+	case search.TypeNews:
+		return q.SystemAddToSiteNewsSearch(ctx, db.SystemAddToSiteNewsSearchParams{SiteNewsID: id, SearchwordlistIdsearchwordlist: wid, WordCount: count})
+	case search.TypeBlog:
+		return q.SystemAddToBlogsSearch(ctx, db.SystemAddToBlogsSearchParams{BlogID: id, SearchwordlistIdsearchwordlist: wid, WordCount: count})
+	case search.TypeWriting:
+		return q.SystemAddToForumWritingSearch(ctx, db.SystemAddToForumWritingSearchParams{WritingID: id, SearchwordlistIdsearchwordlist: wid, WordCount: count})
+	case search.TypeLinker:
+		return q.SystemAddToLinkerSearch(ctx, db.SystemAddToLinkerSearchParams{LinkerID: id, SearchwordlistIdsearchwordlist: wid, WordCount: count})
+	case search.TypeImage:
+		return q.SystemAddToImagePostSearch(ctx, db.SystemAddToImagePostSearchParams{ImagePostID: id, SearchwordlistIdsearchwordlist: wid, WordCount: count})
+	}
+	return fmt.Errorf("unknown document type %q", typ)
+}
+
+// Delete implements search.Backend.
+func (b *Backend) Delete(ctx context.Context, typ string, id int32) error {
+	if b.Queries == nil {
+		return fmt.Errorf("no db")
+	}
+	q := b.Queries
+	switch typ {
+	case search.TypeComment:
+		return q.SystemDeleteCommentsSearchByCommentID(ctx, id)
+	case search.TypeNews:
+		return q.SystemDeleteSiteNewsSearchBySiteNewsID(ctx, id)
+	case search.TypeBlog:
+		return q.SystemDeleteBlogsSearchByBlogID(ctx, id)
+	case search.TypeWriting:
+		return q.SystemDeleteWritingSearchByWritingID(ctx, id)
+	case search.TypeLinker:
+		return q.SystemDeleteLinkerSearchByLinkerID(ctx, id)
+	case search.TypeImage:
+		return q.SystemDeleteImagePostSearchByImagePostID(ctx, id)
+	}
+	return fmt.Errorf("unknown document type %q", typ)
+}
+
+// Reset implements search.Backend.
+func (b *Backend) Reset(ctx context.Context, typ string) error {
+	if b.Queries == nil {
+		return fmt.Errorf("no db")
+	}
+	q := b.Queries
+	switch typ {
+	case search.TypeComment:
+		return q.SystemDeleteCommentsSearch(ctx)
+	case search.TypeNews:
+		return q.SystemDeleteSiteNewsSearch(ctx)
+	case search.TypeBlog:
+		return q.SystemDeleteBlogsSearch(ctx)
+	case search.TypeWriting:
+		return q.SystemDeleteWritingSearch(ctx)
+	case search.TypeLinker:
+		return q.SystemDeleteLinkerSearch(ctx)
+	case search.TypeImage:
+		return q.SystemDeleteImagePostSearch(ctx)
+	}
+	return fmt.Errorf("unknown document type %q", typ)
+}
+
+// matches returns the word count of word for every document of typ.
+func (b *Backend) matches(ctx context.Context, typ, word string) (map[int32]int32, error) {
+	q := b.Queries
+	w := sql.NullString{String: word, Valid: true}
+	out := map[int32]int32{}
+	var err error
+	switch typ {
+	case search.TypeComment:
+		var rows []*db.SystemListCommentsSearchMatchesByWordRow
+		rows, err = q.SystemListCommentsSearchMatchesByWord(ctx, w)
+		for _, r := range rows {
+			out[r.ID] = r.WordCount
+		}
+	case search.TypeNews:
+		var rows []*db.SystemListSiteNewsSearchMatchesByWordRow
+		rows, err = q.SystemListSiteNewsSearchMatchesByWord(ctx, w)
+		for _, r := range rows {
+			out[r.ID] = r.WordCount
+		}
+	case search.TypeBlog:
+		var rows []*db.SystemListBlogsSearchMatchesByWordRow
+		rows, err = q.SystemListBlogsSearchMatchesByWord(ctx, w)
+		for _, r := range rows {
+			out[r.ID] = r.WordCount
+		}
+	case search.TypeWriting:
+		var rows []*db.SystemListWritingSearchMatchesByWordRow
+		rows, err = q.SystemListWritingSearchMatchesByWord(ctx, w)
+		for _, r := range rows {
+			out[r.ID] = r.WordCount
+		}
+	case search.TypeLinker:
+		var rows []*db.SystemListLinkerSearchMatchesByWordRow
+		rows, err = q.SystemListLinkerSearchMatchesByWord(ctx, w)
+		for _, r := range rows {
+			out[r.ID] = r.WordCount
+		}
+	case search.TypeImage:
+		var rows []*db.SystemListImagePostSearchMatchesByWordRow
+		rows, err = q.SystemListImagePostSearchMatchesByWord(ctx, w)
+		for _, r := range rows {
+			out[r.ID] = r.WordCount
+		}
+	default:
+		return nil, fmt.Errorf("unknown document type %q", typ)
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Search implements search.Backend.
+func (b *Backend) Search(ctx context.Context, q search.Query) ([]search.Hit, error) {
+	if b.Queries == nil {
+		return nil, fmt.Errorf("no db")
+	}
+	words := search.QueryWords(search.ParseQuery(q.Text))
+	if len(words) == 0 {
+		return nil, nil
+	}
+	var scores map[int32]int32
+	for _, word := range words {
+		m, err := b.matches(ctx, q.Type, word)
+		if err != nil {
+			return nil, err
+		}
+		if scores == nil {
+			scores = m
+		} else {
+			for id := range scores {
+				if c, ok := m[id]; ok {
+					scores[id] += c
+				} else {
+					delete(scores, id)
+				}
+			}
+		}
+		if len(scores) == 0 {
+			return nil, nil
+		}
+	}
+	hits := make([]search.Hit, 0, len(scores))
+	for id, c := range scores {
+		hits = append(hits, search.Hit{ID: id, Score: float64(c)})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID > hits[j].ID
+	})
+	return search.Page(hits, q.Offset, q.Limit), nil
+}
@@ -0,0 +1,312 @@
+// Package index implements an embedded on-disk full-text search engine.
+//
+// Each document type has its own inverted index held in memory. The index is
+// persisted as a snapshot file plus an append-only journal of the changes
+// made since the snapshot was written. The journal is folded into a new
+// snapshot once it grows large, when a type is reset and when the engine is
+// closed.
+package index
+
+import (
+	"bufio"
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/search"
+)
+
+// compactThreshold is the number of journal entries that triggers writing a
+// new snapshot on Flush.
+const compactThreshold = 1000
+
+// Journal operations.
+const (
+	opAdd    = "add"
+	opDelete = "delete"
+)
+
+// journalEntry is a single line of a type's journal.
+type journalEntry struct {
+	Op   string `json:"op"`
+	ID   int32  `json:"id"`
+	Text string `json:"text,omitempty"`
+}
+
+// Engine is a search.Backend storing its index under a directory.
+type Engine struct {
+	dir    string
+	mu     sync.Mutex
+	stores map[string]*store
+}
+
+var _ search.Backend = (*Engine)(nil)
+var _ search.Flusher = (*Engine)(nil)
+
+// store couples the in-memory index of a type with its files on disk.
+type store struct {
+	mu       sync.Mutex
+	base     string
+	seg      *segment
+	journal  *os.File
+	w        *bufio.Writer
+	pending  int
+	modified bool
+}
+
+// Open returns an Engine storing its files in dir, creating it if needed.
+func Open(dir string) (*Engine, error) {
+	if dir == "" {
+		return nil, errors.New("search index directory not set")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create search index dir: %w", err)
+	}
+	return &Engine{dir: dir, stores: map[string]*store{}}, nil
+}
+
+// Register registers the on-disk index backend.
+func Register(r *search.Registry) {
+	r.RegisterBackend("index", func(cfg *config.RuntimeConfig, _ db.Querier) (search.Backend, error) {
+		return Open(cfg.SearchIndexDir)
+	})
+}
+
+// store returns the loaded store for typ.
+func (e *Engine) store(typ string) (*store, error) {
+	if !slices.Contains(search.Types, typ) {
+		return nil, fmt.Errorf("unknown document type %q", typ)
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if st, ok := e.stores[typ]; ok {
+		return st, nil
+	}
+	st, err := openStore(filepath.Join(e.dir, typ))
+	if err != nil {
+		return nil, fmt.Errorf("open %s index: %w", typ, err)
+	}
+	e.stores[typ] = st
+	return st, nil
+}
+
+// Index implements search.Backend.
+func (e *Engine) Index(_ context.Context, doc search.Document) error {
+	st, err := e.store(doc.Type)
+	if err != nil {
+		return err
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.seg.add(doc.ID, doc.Text)
+	return st.append(journalEntry{Op: opAdd, ID: doc.ID, Text: doc.Text})
+}
+
+// Delete implements search.Backend.
+func (e *Engine) Delete(_ context.Context, typ string, id int32) error {
+	st, err := e.store(typ)
+	if err != nil {
+		return err
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.seg.remove(id)
+	return st.append(journalEntry{Op: opDelete, ID: id})
+}
+
+// Reset implements search.Backend.
+func (e *Engine) Reset(_ context.Context, typ string) error {
+	st, err := e.store(typ)
+	if err != nil {
+		return err
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.seg = newSegment()
+	return st.compact()
+}
+
+// Search implements search.Backend.
+func (e *Engine) Search(_ context.Context, q search.Query) ([]search.Hit, error) {
+	clauses := search.ParseQuery(q.Text)
+	if len(clauses) == 0 {
+		return nil, nil
+	}
+	st, err := e.store(q.Type)
+	if err != nil {
+		return nil, err
+	}
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	return st.seg.search(clauses, q.Offset, q.Limit), nil
+}
+
+// Flush writes buffered journal entries to disk and compacts journals that
+// have grown past the threshold.
+func (e *Engine) Flush(context.Context) error {
+	e.mu.Lock()
+	stores := make([]*store, 0, len(e.stores))
+	for _, st := range e.stores {
+		stores = append(stores, st)
+	}
+	e.mu.Unlock()
+	var errs []error
+	for _, st := range stores {
+		st.mu.Lock()
+		if st.pending >= compactThreshold {
+			errs = append(errs, st.compact())
+		} else {
+			errs = append(errs, st.w.Flush())
+		}
+		st.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Close compacts every modified index and releases the journal files.
+func (e *Engine) Close() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var errs []error
+	for typ, st := range e.stores {
+		st.mu.Lock()
+		if st.modified {
+			errs = append(errs, st.compact())
+		}
+		errs = append(errs, st.journal.Close())
+		st.mu.Unlock()
+		delete(e.stores, typ)
+	}
+	return errors.Join(errs...)
+}
+
+// openStore loads the snapshot and replays the journal found at base.
+func openStore(base string) (*store, error) {
+	st := &store{base: base, seg: newSegment()}
+	if f, err := os.Open(base + ".snapshot"); err == nil {
+		err = gob.NewDecoder(bufio.NewReader(f)).Decode(st.seg)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("decode snapshot: %w", err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	journal, err := os.OpenFile(base+".journal", os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(journal)
+	var offset int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if len(line) > 0 && err == nil {
+			offset += int64(len(line))
+			var je journalEntry
+			if uerr := json.Unmarshal(line, &je); uerr != nil {
+				log.Printf("search index %s: skipping corrupt journal entry: %v", base, uerr)
+			} else {
+				st.apply(je)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			if len(line) > 0 {
+				// Drop the partial write so later entries start on a new line.
+				log.Printf("search index %s: discarding incomplete journal entry", base)
+				if terr := journal.Truncate(offset); terr != nil {
+					_ = journal.Close()
+					return nil, terr
+				}
+			}
+			break
+		}
+		if err != nil {
+			_ = journal.Close()
+			return nil, err
+		}
+	}
+	st.journal = journal
+	st.w = bufio.NewWriter(journal)
+	return st, nil
+}
+
+// apply replays a journal entry against the in-memory index.
+func (st *store) apply(je journalEntry) {
+	switch je.Op {
+	case opAdd:
+		st.seg.add(je.ID, je.Text)
+	case opDelete:
+		st.seg.remove(je.ID)
+	default:
+		return
+	}
+	st.pending++
+	st.modified = true
+}
+
+// append records je in the journal buffer.
+func (st *store) append(je journalEntry) error {
+	b, err := json.Marshal(je)
+	if err != nil {
+		return err
+	}
+	if _, err := st.w.Write(append(b, '\n')); err != nil {
+		return err
+	}
+	st.pending++
+	st.modified = true
+	return nil
+}
+
+// compact writes the current index to a new snapshot and empties the journal.
+func (st *store) compact() error {
+	if err := st.w.Flush(); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(st.base), filepath.Base(st.base)+".snapshot-*")
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(tmp)
+	if err := gob.NewEncoder(bw).Encode(st.seg); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("encode snapshot: %w", err)
+	}
+	if err := bw.Flush(); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), st.base+".snapshot"); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := st.journal.Truncate(0); err != nil {
+		return err
+	}
+	st.w.Reset(st.journal)
+	st.pending = 0
+	st.modified = false
+	return nil
+}
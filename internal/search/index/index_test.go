@@ -0,0 +1,171 @@
+package index
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/arran4/goa4web/internal/search"
+)
+
+func openTest(t *testing.T, dir string) *Engine {
+	t.Helper()
+	e, err := Open(dir)
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return e
+}
+
+func index(t *testing.T, e *Engine, typ string, docs map[int32]string) {
+	t.Helper()
+	ctx := context.Background()
+	for id, text := range docs {
+		if err := e.Index(ctx, search.Document{Type: typ, ID: id, Text: text}); err != nil {
+			t.Fatalf("Index %d: %v", id, err)
+		}
+	}
+}
+
+func ids(t *testing.T, e *Engine, typ, q string) []int32 {
+	t.Helper()
+	hits, err := e.Search(context.Background(), search.Query{Type: typ, Text: q})
+	if err != nil {
+		t.Fatalf("Search %q: %v", q, err)
+	}
+	return search.IDs(hits)
+}
+
+func equal(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestEngineRanking(t *testing.T) {
+	e := openTest(t, t.TempDir())
+	defer e.Close()
+	index(t, e, search.TypeBlog, map[int32]string{
+		1: "gardening notes about tomatoes and a long list of other vegetables grown this year",
+		2: "tomatoes tomatoes tomatoes",
+		3: "nothing relevant",
+	})
+
+	if got := ids(t, e, search.TypeBlog, "tomato"); !equal(got, []int32{2, 1}) {
+		t.Fatalf("ranking = %v", got)
+	}
+	if got := ids(t, e, search.TypeBlog, "tomatoes vegetable"); !equal(got, []int32{1}) {
+		t.Fatalf("all terms required = %v", got)
+	}
+	if got := ids(t, e, search.TypeNews, "tomato"); len(got) != 0 {
+		t.Fatalf("types must be separate: %v", got)
+	}
+	if _, err := e.Search(context.Background(), search.Query{Type: "unknown", Text: "x"}); err == nil {
+		t.Fatalf("expected error for unknown type")
+	}
+}
+
+func TestEnginePhraseAndPrefix(t *testing.T) {
+	e := openTest(t, t.TempDir())
+	defer e.Close()
+	index(t, e, search.TypeWriting, map[int32]string{
+		1: "open source licensing explained",
+		2: "source code that is open to all",
+		3: "the licence terms",
+	})
+
+	if got := ids(t, e, search.TypeWriting, `"open source"`); !equal(got, []int32{1}) {
+		t.Fatalf("phrase = %v", got)
+	}
+	got := ids(t, e, search.TypeWriting, "licen*")
+	if !equal(got, []int32{3, 1}) && !equal(got, []int32{1, 3}) {
+		t.Fatalf("prefix = %v", got)
+	}
+
+	// Index terms are stemmed: "happy" is stored as "happi".
+	index(t, e, search.TypeComment, map[int32]string{1: "a happy day", 2: "happiness"})
+	if got := ids(t, e, search.TypeComment, "happy*"); !equal(got, []int32{1, 2}) && !equal(got, []int32{2, 1}) {
+		t.Fatalf("whole word prefix = %v", got)
+	}
+
+	hits, err := e.Search(context.Background(), search.Query{Type: search.TypeWriting, Text: `"open source"`})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if want := "<mark>open</mark> <mark>source</mark>"; !strings.Contains(hits[0].Snippet, want) {
+		t.Fatalf("snippet %q missing %q", hits[0].Snippet, want)
+	}
+}
+
+func TestEnginePersistence(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	e := openTest(t, dir)
+	index(t, e, search.TypeComment, map[int32]string{1: "first comment", 2: "second comment"})
+	if err := e.Flush(ctx); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	// Reopen from the journal alone.
+	reopened := openTest(t, dir)
+	if got := ids(t, reopened, search.TypeComment, "comment"); len(got) != 2 {
+		t.Fatalf("journal replay = %v", got)
+	}
+
+	if err := e.Delete(ctx, search.TypeComment, 1); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	index(t, e, search.TypeComment, map[int32]string{2: "edited reply"})
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if fi, err := os.Stat(filepath.Join(dir, search.TypeComment+".journal")); err != nil || fi.Size() != 0 {
+		t.Fatalf("journal not compacted: %v %v", fi, err)
+	}
+
+	e = openTest(t, dir)
+	defer e.Close()
+	if got := ids(t, e, search.TypeComment, "comment"); len(got) != 0 {
+		t.Fatalf("stale documents after reopen: %v", got)
+	}
+	if got := ids(t, e, search.TypeComment, "edit"); !equal(got, []int32{2}) {
+		t.Fatalf("snapshot reload = %v", got)
+	}
+
+	if err := e.Reset(ctx, search.TypeComment); err != nil {
+		t.Fatalf("Reset: %v", err)
+	}
+	if got := ids(t, e, search.TypeComment, "edit"); len(got) != 0 {
+		t.Fatalf("reset left %v", got)
+	}
+}
+
+func TestEngineSkipsTruncatedJournal(t *testing.T) {
+	dir := t.TempDir()
+	journal := `{"op":"add","id":1,"text":"kept entry"}` + "\n" + `{"op":"add","id":2,"te`
+	if err := os.WriteFile(filepath.Join(dir, search.TypeNews+".journal"), []byte(journal), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	e := openTest(t, dir)
+	defer e.Close()
+	if got := ids(t, e, search.TypeNews, "entry"); !equal(got, []int32{1}) {
+		t.Fatalf("replay = %v", got)
+	}
+	index(t, e, search.TypeNews, map[int32]string{3: "later entry"})
+	if err := e.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	reopened := openTest(t, dir)
+	if got := ids(t, reopened, search.TypeNews, "entry"); !equal(got, []int32{3, 1}) {
+		t.Fatalf("entries after truncated write = %v", got)
+	}
+}
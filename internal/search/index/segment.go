@@ -0,0 +1,233 @@
+package index
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/arran4/goa4web/internal/search"
+)
+
+// BM25 tuning parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// document is the stored form of an indexed document.
+type document struct {
+	Text   string
+	Length int
+}
+
+// segment is the inverted index for a single document type.
+type segment struct {
+	Docs map[int32]*document
+	// Postings maps a term to the positions it occupies in each document.
+	Postings map[string]map[int32][]int32
+	TotalLen int64
+
+	// terms is the sorted term dictionary used for prefix expansion. It is
+	// rebuilt lazily after the postings change.
+	terms []string
+}
+
+func newSegment() *segment {
+	return &segment{
+		Docs:     map[int32]*document{},
+		Postings: map[string]map[int32][]int32{},
+	}
+}
+
+// add indexes text under id, replacing any existing document.
+func (s *segment) add(id int32, text string) {
+	s.remove(id)
+	tokens := search.Tokenize(text)
+	if len(tokens) == 0 {
+		return
+	}
+	for _, t := range tokens {
+		p := s.Postings[t.Term]
+		if p == nil {
+			p = map[int32][]int32{}
+			s.Postings[t.Term] = p
+			s.terms = nil
+		}
+		p[id] = append(p[id], int32(t.Position))
+	}
+	s.Docs[id] = &document{Text: text, Length: len(tokens)}
+	s.TotalLen += int64(len(tokens))
+}
+
+// remove deletes id from the index.
+func (s *segment) remove(id int32) {
+	doc, ok := s.Docs[id]
+	if !ok {
+		return
+	}
+	for _, t := range search.Tokenize(doc.Text) {
+		p := s.Postings[t.Term]
+		if p == nil {
+			continue
+		}
+		delete(p, id)
+		if len(p) == 0 {
+			delete(s.Postings, t.Term)
+			s.terms = nil
+		}
+	}
+	s.TotalLen -= int64(doc.Length)
+	delete(s.Docs, id)
+}
+
+// dictionary returns the sorted list of indexed terms.
+func (s *segment) dictionary() []string {
+	if s.terms == nil {
+		s.terms = make([]string, 0, len(s.Postings))
+		for t := range s.Postings {
+			s.terms = append(s.terms, t)
+		}
+		sort.Strings(s.terms)
+	}
+	return s.terms
+}
+
+// expand returns every indexed term starting with prefix or with its stem.
+// Terms are stored stemmed, so a whole word typed as a prefix, such as
+// "happy*", would otherwise miss its own stem "happi".
+func (s *segment) expand(prefix string) []string {
+	dict := s.dictionary()
+	var out []string
+	seen := map[string]bool{}
+	for _, p := range []string{prefix, search.Stem(prefix)} {
+		for i := sort.SearchStrings(dict, p); i < len(dict) && strings.HasPrefix(dict[i], p); i++ {
+			if !seen[dict[i]] {
+				seen[dict[i]] = true
+				out = append(out, dict[i])
+			}
+		}
+	}
+	return out
+}
+
+// idf returns the BM25 inverse document frequency of a term found in df
+// documents.
+func (s *segment) idf(df int) float64 {
+	n := float64(len(s.Docs))
+	return math.Log(1 + (n-float64(df)+0.5)/(float64(df)+0.5))
+}
+
+// weight returns the BM25 term frequency component for tf occurrences in id.
+func (s *segment) weight(id int32, tf int) float64 {
+	avg := float64(s.TotalLen) / float64(len(s.Docs))
+	dl := float64(s.Docs[id].Length)
+	f := float64(tf)
+	return f * (bm25K1 + 1) / (f + bm25K1*(1-bm25B+bm25B*dl/avg))
+}
+
+// scoreClause returns the BM25 contribution of c for every matching document.
+func (s *segment) scoreClause(c search.Clause) map[int32]float64 {
+	scores := map[int32]float64{}
+	switch {
+	case c.Prefix:
+		for _, term := range s.expand(c.Terms[0]) {
+			p := s.Postings[term]
+			idf := s.idf(len(p))
+			for id, pos := range p {
+				scores[id] += idf * s.weight(id, len(pos))
+			}
+		}
+	case c.Phrase():
+		idf := 0.0
+		for _, term := range c.Terms {
+			p := s.Postings[term]
+			if len(p) == 0 {
+				return nil
+			}
+			idf += s.idf(len(p))
+		}
+		for id := range s.Postings[c.Terms[0]] {
+			if tf := s.phraseFrequency(id, c.Terms); tf > 0 {
+				scores[id] = idf * s.weight(id, tf)
+			}
+		}
+	default:
+		p := s.Postings[c.Terms[0]]
+		idf := s.idf(len(p))
+		for id, pos := range p {
+			scores[id] = idf * s.weight(id, len(pos))
+		}
+	}
+	return scores
+}
+
+// phraseFrequency counts the occurrences of terms as a consecutive sequence
+// in document id.
+func (s *segment) phraseFrequency(id int32, terms []string) int {
+	lists := make([][]int32, len(terms))
+	for i, term := range terms {
+		lists[i] = s.Postings[term][id]
+		if len(lists[i]) == 0 {
+			return 0
+		}
+	}
+	n := 0
+	for _, start := range lists[0] {
+		ok := true
+		for i := 1; i < len(lists) && ok; i++ {
+			want := start + int32(i)
+			j := sort.Search(len(lists[i]), func(k int) bool { return lists[i][k] >= want })
+			ok = j < len(lists[i]) && lists[i][j] == want
+		}
+		if ok {
+			n++
+		}
+	}
+	return n
+}
+
+// search evaluates clauses and returns the ranked hits.
+func (s *segment) search(clauses []search.Clause, offset, limit int) []search.Hit {
+	if len(clauses) == 0 || len(s.Docs) == 0 {
+		return nil
+	}
+	var total map[int32]float64
+	for _, c := range clauses {
+		scores := s.scoreClause(c)
+		if len(scores) == 0 {
+			return nil
+		}
+		if total == nil {
+			total = scores
+			continue
+		}
+		for id := range total {
+			if sc, ok := scores[id]; ok {
+				total[id] += sc
+			} else {
+				delete(total, id)
+			}
+		}
+		if len(total) == 0 {
+			return nil
+		}
+	}
+
+	hits := make([]search.Hit, 0, len(total))
+	for id, score := range total {
+		hits = append(hits, search.Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID > hits[j].ID
+	})
+	hits = search.Page(hits, offset, limit)
+
+	match := search.Matcher(clauses)
+	for i := range hits {
+		hits[i].Snippet = search.Snippet(s.Docs[hits[i].ID].Text, match, search.DefaultSnippetWords)
+	}
+	return hits
+}
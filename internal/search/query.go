@@ -0,0 +1,84 @@
+package search
+
+import "strings"
+
+// Clause is a single required element of a parsed query.
+type Clause struct {
+	// Words holds the normalised words exactly as typed.
+	Words []string
+	// Terms holds the analysed form of Words. A clause with more than one
+	// term is a phrase and matches only when the terms appear consecutively.
+	Terms []string
+	// Prefix marks a single word clause ending in '*'. Its term is left
+	// unstemmed; backends match index terms beginning with it or, where
+	// terms are stemmed, with its stem.
+	Prefix bool
+}
+
+// Phrase reports whether c matches a sequence of words.
+func (c Clause) Phrase() bool { return len(c.Terms) > 1 }
+
+// ParseQuery splits text into clauses. Every clause must match for a document
+// to be returned. Double quotes group words into a phrase and a trailing '*'
+// turns a word into a prefix match:
+//
+//	"open source" licen*
+func ParseQuery(text string) []Clause {
+	var clauses []Clause
+	seen := map[string]bool{}
+	add := func(c Clause) {
+		key := strings.Join(c.Terms, " ")
+		if c.Prefix {
+			key += "*"
+		}
+		if key == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		clauses = append(clauses, c)
+	}
+	for i, part := range strings.Split(text, "\"") {
+		if i%2 == 1 {
+			add(phraseClause(part))
+			continue
+		}
+		for _, chunk := range strings.Fields(part) {
+			spans := wordSpans(chunk)
+			for n, s := range spans {
+				word := Normalize(chunk[s[0]:s[1]])
+				if word == "" {
+					continue
+				}
+				if n == len(spans)-1 && strings.HasPrefix(chunk[s[1]:], "*") {
+					add(Clause{Words: []string{word}, Terms: []string{word}, Prefix: true})
+					continue
+				}
+				add(Clause{Words: []string{word}, Terms: []string{Stem(word)}})
+			}
+		}
+	}
+	return clauses
+}
+
+// phraseClause builds a clause from the words inside a quoted phrase.
+func phraseClause(text string) Clause {
+	var c Clause
+	for _, w := range BreakupTextToWords(text) {
+		word := Normalize(w)
+		if word == "" {
+			continue
+		}
+		c.Words = append(c.Words, word)
+		c.Terms = append(c.Terms, Stem(word))
+	}
+	return c
+}
+
+// QueryWords returns every normalised word in clauses.
+func QueryWords(clauses []Clause) []string {
+	var words []string
+	for _, c := range clauses {
+		words = append(words, c.Words...)
+	}
+	return words
+}
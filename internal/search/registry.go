@@ -0,0 +1,72 @@
+package search
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/db"
+)
+
+// DefaultBackend names the backend used when none is configured.
+const DefaultBackend = "db"
+
+// BackendFactory creates a search backend using runtime configuration.
+type BackendFactory func(*config.RuntimeConfig, db.Querier) (Backend, error)
+
+// Registry stores search backend factories.
+type Registry struct {
+	mu       sync.RWMutex
+	backends map[string]BackendFactory
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry { return &Registry{backends: make(map[string]BackendFactory)} }
+
+// RegisterBackend adds factory to the registry under name.
+func (r *Registry) RegisterBackend(name string, factory BackendFactory) {
+	n := strings.ToLower(name)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.backends[n]; ok {
+		log.Printf("search: backend %s already registered", n)
+	}
+	r.backends[n] = factory
+}
+
+// lookupBackend retrieves a factory by name.
+func (r *Registry) lookupBackend(name string) BackendFactory {
+	r.mu.RLock()
+	f := r.backends[strings.ToLower(name)]
+	r.mu.RUnlock()
+	return f
+}
+
+// BackendFromConfig returns the search backend selected by cfg.SearchBackend.
+// The database backend is used when no backend is configured.
+func (r *Registry) BackendFromConfig(cfg *config.RuntimeConfig, q db.Querier) (Backend, error) {
+	name := strings.ToLower(strings.TrimSpace(cfg.SearchBackend))
+	if name == "" {
+		name = DefaultBackend
+	}
+	f := r.lookupBackend(name)
+	if f == nil {
+		return nil, fmt.Errorf("unknown search backend %q", name)
+	}
+	return f(cfg, q)
+}
+
+// BackendNames returns the names of registered backends in sorted order.
+func (r *Registry) BackendNames() []string {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.backends))
+	for n := range r.backends {
+		names = append(names, n)
+	}
+	r.mu.RUnlock()
+	sort.Strings(names)
+	return names
+}
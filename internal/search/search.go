@@ -0,0 +1,95 @@
+package search
+
+import "context"
+
+// Document types understood by search backends.
+const (
+	TypeComment = "comment"
+	TypeNews    = "news"
+	TypeBlog    = "blog"
+	TypeWriting = "writing"
+	TypeLinker  = "linker"
+	TypeImage   = "image"
+)
+
+// Types lists every document type in a stable order.
+var Types = []string{TypeComment, TypeNews, TypeBlog, TypeWriting, TypeLinker, TypeImage}
+
+// Document is a piece of content submitted to a backend for indexing.
+type Document struct {
+	Type string
+	ID   int32
+	Text string
+}
+
+// Query describes a search request against a single document type.
+//
+// Text uses the query syntax understood by ParseQuery. Offset skips that many
+// of the best ranked hits, so callers can page through the matches. Limit
+// caps the number of hits returned; zero returns every match.
+type Query struct {
+	Type   string
+	Text   string
+	Offset int
+	Limit  int
+}
+
+// Hit is a single ranked search result.
+type Hit struct {
+	ID    int32
+	Score float64
+	// Snippet holds an HTML fragment of the matching text with the matched
+	// terms wrapped in <mark> elements. Backends that cannot produce
+	// snippets leave it empty.
+	Snippet string
+}
+
+// Backend stores and queries the full-text index.
+type Backend interface {
+	// Index adds doc to the index, replacing any existing entry with the
+	// same type and ID.
+	Index(ctx context.Context, doc Document) error
+	// Delete removes a single document from the index.
+	Delete(ctx context.Context, typ string, id int32) error
+	// Reset removes every document of typ from the index.
+	Reset(ctx context.Context, typ string) error
+	// Search returns the documents matching q ordered by descending score.
+	Search(ctx context.Context, q Query) ([]Hit, error)
+}
+
+// Flusher is implemented by backends that buffer writes.
+type Flusher interface {
+	Flush(ctx context.Context) error
+}
+
+// Flush persists buffered writes when b implements Flusher.
+func Flush(ctx context.Context, b Backend) error {
+	if f, ok := b.(Flusher); ok {
+		return f.Flush(ctx)
+	}
+	return nil
+}
+
+// IDs returns the document IDs of hits preserving their order.
+func IDs(hits []Hit) []int32 {
+	ids := make([]int32, 0, len(hits))
+	for _, h := range hits {
+		ids = append(ids, h.ID)
+	}
+	return ids
+}
+
+// Page returns the hits selected by offset and limit. A limit of zero keeps
+// every hit after offset.
+func Page(hits []Hit, offset, limit int) []Hit {
+	if offset >= len(hits) {
+		return nil
+	}
+	if offset > 0 {
+		hits = hits[offset:]
+	}
+	if limit > 0 && len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
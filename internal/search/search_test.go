@@ -0,0 +1,109 @@
+package search_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/search"
+	dbsearch "github.com/arran4/goa4web/internal/search/db"
+	"github.com/arran4/goa4web/internal/search/index"
+	"github.com/arran4/goa4web/internal/search/searchdefaults"
+)
+
+func TestBackendFromConfigRegistry(t *testing.T) {
+	reg := searchdefaults.NewRegistry()
+
+	cfg := config.RuntimeConfig{}
+	if _, err := reg.BackendFromConfig(&cfg, nil); err != nil {
+		t.Fatalf("default backend: %v", err)
+	}
+	b, _ := reg.BackendFromConfig(&cfg, nil)
+	if _, ok := b.(*dbsearch.Backend); !ok {
+		t.Fatalf("expected *db.Backend got %T", b)
+	}
+
+	cfg = config.RuntimeConfig{SearchBackend: "Index", SearchIndexDir: t.TempDir()}
+	b, err := reg.BackendFromConfig(&cfg, nil)
+	if err != nil {
+		t.Fatalf("index backend: %v", err)
+	}
+	e, ok := b.(*index.Engine)
+	if !ok {
+		t.Fatalf("expected *index.Engine got %T", b)
+	}
+	_ = e.Close()
+
+	cfg = config.RuntimeConfig{SearchBackend: "missing"}
+	if _, err := reg.BackendFromConfig(&cfg, nil); err == nil {
+		t.Fatalf("expected error for unknown backend")
+	}
+
+	if got, want := reg.BackendNames(), []string{"db", "index"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("BackendNames = %v want %v", got, want)
+	}
+}
+
+func TestStem(t *testing.T) {
+	for word, want := range map[string]string{
+		"caresses":    "caress",
+		"ponies":      "poni",
+		"cats":        "cat",
+		"running":     "run",
+		"agreed":      "agre",
+		"happy":       "happi",
+		"relational":  "relat",
+		"hopefulness": "hope",
+		"generalize":  "gener",
+		"is":          "is",
+		"go4web":      "go4web",
+	} {
+		if got := search.Stem(word); got != want {
+			t.Errorf("Stem(%q) = %q want %q", word, got, want)
+		}
+	}
+}
+
+func TestParseQuery(t *testing.T) {
+	clauses := search.ParseQuery(`Running "Open Source" licen* running`)
+	if len(clauses) != 3 {
+		t.Fatalf("expected 3 clauses got %+v", clauses)
+	}
+	if c := clauses[0]; c.Phrase() || c.Prefix || c.Terms[0] != "run" {
+		t.Errorf("unexpected term clause %+v", c)
+	}
+	if c := clauses[1]; !c.Phrase() || !reflect.DeepEqual(c.Words, []string{"open", "source"}) {
+		t.Errorf("unexpected phrase clause %+v", c)
+	}
+	if c := clauses[2]; !c.Prefix || c.Terms[0] != "licen" {
+		t.Errorf("unexpected prefix clause %+v", c)
+	}
+	if got := search.QueryWords(clauses); !reflect.DeepEqual(got, []string{"running", "open", "source", "licen"}) {
+		t.Errorf("QueryWords = %v", got)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	text := "A <b>bold</b> start. " + strings.Repeat("filler ", 40) + "the cats were running home"
+	match := search.Matcher(search.ParseQuery("cat run"))
+	got := search.Snippet(text, match, 10)
+	if !strings.HasPrefix(got, "&hellip;") {
+		t.Errorf("expected leading ellipsis: %q", got)
+	}
+	if !strings.Contains(got, "<mark>cats</mark> were <mark>running</mark>") {
+		t.Errorf("expected marked terms: %q", got)
+	}
+	if strings.HasSuffix(got, "&hellip;") {
+		t.Errorf("unexpected trailing ellipsis: %q", got)
+	}
+
+	got = search.Snippet("<b>bold</b> move", search.Matcher(search.ParseQuery("move")), 10)
+	if got != "&lt;b&gt;bold&lt;/b&gt; <mark>move</mark>" {
+		t.Errorf("expected escaped snippet: %q", got)
+	}
+
+	if got := search.Snippet("nothing here", match, 10); got != "" {
+		t.Errorf("expected empty snippet got %q", got)
+	}
+}
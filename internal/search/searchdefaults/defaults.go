@@ -0,0 +1,20 @@
+package searchdefaults
+
+import (
+	"github.com/arran4/goa4web/internal/search"
+	"github.com/arran4/goa4web/internal/search/db"
+	"github.com/arran4/goa4web/internal/search/index"
+)
+
+// RegisterDefaults registers all stable search backends.
+func RegisterDefaults(r *search.Registry) {
+	db.Register(r)
+	index.Register(r)
+}
+
+// NewRegistry returns a Registry with stable backends registered.
+func NewRegistry() *search.Registry {
+	r := search.NewRegistry()
+	RegisterDefaults(r)
+	return r
+}
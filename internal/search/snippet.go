@@ -0,0 +1,104 @@
+package search
+
+import (
+	"html"
+	"strings"
+)
+
+// DefaultSnippetWords is the number of words included in a snippet.
+const DefaultSnippetWords = 30
+
+// Matcher returns a function reporting whether a token satisfies any clause.
+func Matcher(clauses []Clause) func(Token) bool {
+	terms := map[string]bool{}
+	var prefixes []string
+	for _, c := range clauses {
+		if c.Prefix {
+			prefixes = append(prefixes, c.Terms[0])
+			continue
+		}
+		for _, t := range c.Terms {
+			terms[t] = true
+		}
+	}
+	return func(tok Token) bool {
+		if terms[tok.Term] {
+			return true
+		}
+		for _, p := range prefixes {
+			if strings.HasPrefix(tok.Term, p) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Snippet extracts the window of about width words from text containing the
+// most matches and returns it as escaped HTML with each matching word wrapped
+// in <mark>. An empty string is returned when nothing matches.
+func Snippet(text string, match func(Token) bool, width int) string {
+	if width <= 0 {
+		width = DefaultSnippetWords
+	}
+	tokens := Tokenize(text)
+	var hits []int
+	for i, t := range tokens {
+		if match(t) {
+			hits = append(hits, i)
+		}
+	}
+	if len(hits) == 0 {
+		return ""
+	}
+
+	bestStart, bestCount := 0, -1
+	for _, h := range hits {
+		start := min(h-width/4, len(tokens)-width)
+		if start < 0 {
+			start = 0
+		}
+		count := 0
+		for _, o := range hits {
+			if o >= start && o < start+width {
+				count++
+			}
+		}
+		if count > bestCount {
+			bestStart, bestCount = start, count
+		}
+	}
+	end := bestStart + width
+	if end > len(tokens) {
+		end = len(tokens)
+	}
+
+	from, to := tokens[bestStart].Start, tokens[end-1].End
+	if bestStart == 0 {
+		from = 0
+	}
+	if end == len(tokens) {
+		to = len(text)
+	}
+
+	var sb strings.Builder
+	if from > 0 {
+		sb.WriteString("&hellip;")
+	}
+	last := from
+	for _, t := range tokens[bestStart:end] {
+		if !match(t) {
+			continue
+		}
+		sb.WriteString(html.EscapeString(text[last:t.Start]))
+		sb.WriteString("<mark>")
+		sb.WriteString(html.EscapeString(text[t.Start:t.End]))
+		sb.WriteString("</mark>")
+		last = t.End
+	}
+	sb.WriteString(html.EscapeString(text[last:to]))
+	if to < len(text) {
+		sb.WriteString("&hellip;")
+	}
+	return sb.String()
+}
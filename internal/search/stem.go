@@ -0,0 +1,295 @@
+package search
+
+// Stem reduces an English word to its stem using the Porter stemming
+// algorithm. Words that are not made up entirely of lowercase ASCII letters
+// are returned unchanged.
+func Stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+	s := &stemmer{b: []byte(word), k: len(word) - 1}
+	s.step1ab()
+	if s.k > 0 {
+		s.step1c()
+		s.step2()
+		s.step3()
+		s.step4()
+		s.step5()
+	}
+	return string(s.b[:s.k+1])
+}
+
+// stemmer holds the working state of the Porter algorithm. b[0:k+1] is the
+// word being stemmed and j marks the end of the stem when a suffix matches.
+type stemmer struct {
+	b    []byte
+	k, j int
+}
+
+// cons reports whether b[i] is a consonant.
+func (s *stemmer) cons(i int) bool {
+	switch s.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		if i == 0 {
+			return true
+		}
+		return !s.cons(i - 1)
+	}
+	return true
+}
+
+// m measures the number of vowel-consonant sequences in b[0:j+1].
+func (s *stemmer) m() int {
+	n, i := 0, 0
+	for {
+		if i > s.j {
+			return n
+		}
+		if !s.cons(i) {
+			break
+		}
+		i++
+	}
+	i++
+	for {
+		for {
+			if i > s.j {
+				return n
+			}
+			if s.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+		n++
+		for {
+			if i > s.j {
+				return n
+			}
+			if !s.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+	}
+}
+
+// vowelInStem reports whether b[0:j+1] contains a vowel.
+func (s *stemmer) vowelInStem() bool {
+	for i := 0; i <= s.j; i++ {
+		if !s.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleC reports whether b[i-1:i+1] is a double consonant.
+func (s *stemmer) doubleC(i int) bool {
+	if i < 1 || s.b[i] != s.b[i-1] {
+		return false
+	}
+	return s.cons(i)
+}
+
+// cvc reports whether b[i-2:i+1] is consonant-vowel-consonant and the final
+// consonant is not w, x or y.
+func (s *stemmer) cvc(i int) bool {
+	if i < 2 || !s.cons(i) || s.cons(i-1) || !s.cons(i-2) {
+		return false
+	}
+	switch s.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	}
+	return true
+}
+
+// ends reports whether b[0:k+1] ends with suffix, setting j to the end of
+// the stem when it does.
+func (s *stemmer) ends(suffix string) bool {
+	l := len(suffix)
+	if l > s.k+1 || suffix[l-1] != s.b[s.k] {
+		return false
+	}
+	if string(s.b[s.k-l+1:s.k+1]) != suffix {
+		return false
+	}
+	s.j = s.k - l
+	return true
+}
+
+// setTo replaces b[j+1:k+1] with v.
+func (s *stemmer) setTo(v string) {
+	s.b = append(s.b[:s.j+1], v...)
+	s.k = s.j + len(v)
+}
+
+// r replaces the suffix with v when the stem has a measure above zero.
+func (s *stemmer) r(v string) {
+	if s.m() > 0 {
+		s.setTo(v)
+	}
+}
+
+// step1ab removes plurals and -ed or -ing suffixes.
+func (s *stemmer) step1ab() {
+	if s.b[s.k] == 's' {
+		switch {
+		case s.ends("sses"):
+			s.k -= 2
+		case s.ends("ies"):
+			s.setTo("i")
+		case s.b[s.k-1] != 's':
+			s.k--
+		}
+	}
+	if s.ends("eed") {
+		if s.m() > 0 {
+			s.k--
+		}
+		return
+	}
+	if (s.ends("ed") || s.ends("ing")) && s.vowelInStem() {
+		s.k = s.j
+		switch {
+		case s.ends("at"):
+			s.setTo("ate")
+		case s.ends("bl"):
+			s.setTo("ble")
+		case s.ends("iz"):
+			s.setTo("ize")
+		case s.doubleC(s.k):
+			s.k--
+			switch s.b[s.k] {
+			case 'l', 's', 'z':
+				s.k++
+			}
+		default:
+			s.j = s.k
+			if s.m() == 1 && s.cvc(s.k) {
+				s.setTo("e")
+			}
+		}
+	}
+}
+
+// step1c turns a terminal y into i when there is another vowel in the stem.
+func (s *stemmer) step1c() {
+	if s.ends("y") && s.vowelInStem() {
+		s.b[s.k] = 'i'
+	}
+}
+
+// replaceFirst applies the first matching suffix rule in pairs.
+func (s *stemmer) replaceFirst(pairs ...string) {
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if s.ends(pairs[i]) {
+			s.r(pairs[i+1])
+			return
+		}
+	}
+}
+
+// step2 maps double suffixes to single ones.
+func (s *stemmer) step2() {
+	if s.k < 1 {
+		return
+	}
+	switch s.b[s.k-1] {
+	case 'a':
+		s.replaceFirst("ational", "ate", "tional", "tion")
+	case 'c':
+		s.replaceFirst("enci", "ence", "anci", "ance")
+	case 'e':
+		s.replaceFirst("izer", "ize")
+	case 'l':
+		s.replaceFirst("bli", "ble", "alli", "al", "entli", "ent", "eli", "e", "ousli", "ous")
+	case 'o':
+		s.replaceFirst("ization", "ize", "ation", "ate", "ator", "ate")
+	case 's':
+		s.replaceFirst("alism", "al", "iveness", "ive", "fulness", "ful", "ousness", "ous")
+	case 't':
+		s.replaceFirst("aliti", "al", "iviti", "ive", "biliti", "ble")
+	case 'g':
+		s.replaceFirst("logi", "log")
+	}
+}
+
+// step3 handles -ic-, -full, -ness and similar suffixes.
+func (s *stemmer) step3() {
+	switch s.b[s.k] {
+	case 'e':
+		s.replaceFirst("icate", "ic", "ative", "", "alize", "al")
+	case 'i':
+		s.replaceFirst("iciti", "ic")
+	case 'l':
+		s.replaceFirst("ical", "ic", "ful", "")
+	case 's':
+		s.replaceFirst("ness", "")
+	}
+}
+
+// step4 removes -ant, -ence and similar suffixes when the measure allows.
+func (s *stemmer) step4() {
+	if s.k < 1 {
+		return
+	}
+	matched := false
+	switch s.b[s.k-1] {
+	case 'a':
+		matched = s.ends("al")
+	case 'c':
+		matched = s.ends("ance") || s.ends("ence")
+	case 'e':
+		matched = s.ends("er")
+	case 'i':
+		matched = s.ends("ic")
+	case 'l':
+		matched = s.ends("able") || s.ends("ible")
+	case 'n':
+		matched = s.ends("ant") || s.ends("ement") || s.ends("ment") || s.ends("ent")
+	case 'o':
+		if s.ends("ion") && s.j >= 0 && (s.b[s.j] == 's' || s.b[s.j] == 't') {
+			matched = true
+		} else {
+			matched = s.ends("ou")
+		}
+	case 's':
+		matched = s.ends("ism")
+	case 't':
+		matched = s.ends("ate") || s.ends("iti")
+	case 'u':
+		matched = s.ends("ous")
+	case 'v':
+		matched = s.ends("ive")
+	case 'z':
+		matched = s.ends("ize")
+	}
+	if matched && s.m() > 1 {
+		s.k = s.j
+	}
+}
+
+// step5 removes a final -e and reduces -ll when the measure allows.
+func (s *stemmer) step5() {
+	s.j = s.k
+	if s.b[s.k] == 'e' {
+		a := s.m()
+		if a > 1 || (a == 1 && !s.cvc(s.k-1)) {
+			s.k--
+		}
+	}
+	if s.b[s.k] == 'l' && s.doubleC(s.k) && s.m() > 1 {
+		s.k--
+	}
+}
@@ -0,0 +1,77 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Token is a single analysed word from a piece of text.
+type Token struct {
+	// Term is the normalised and stemmed form used for matching.
+	Term string
+	// Position is the ordinal of the word within the text.
+	Position int
+	// Start and End are the byte offsets of the word in the original text.
+	Start, End int
+}
+
+// IsWordRune reports whether r forms part of a word.
+func IsWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("'-", r)
+}
+
+// wordSpans returns the byte ranges of the words in input.
+func wordSpans(input string) [][2]int {
+	var spans [][2]int
+	start := -1
+	for i, r := range input {
+		if IsWordRune(r) {
+			if start == -1 {
+				start = i
+			}
+		} else if start != -1 {
+			spans = append(spans, [2]int{start, i})
+			start = -1
+		}
+	}
+	if start != -1 {
+		spans = append(spans, [2]int{start, len(input)})
+	}
+	return spans
+}
+
+// BreakupTextToWords splits input into tokens of alphanumeric or
+// punctuation characters used for search indexing.
+func BreakupTextToWords(input string) []string {
+	var words []string
+	for _, s := range wordSpans(input) {
+		words = append(words, input[s[0]:s[1]])
+	}
+	return words
+}
+
+// Normalize lowercases word and strips leading and trailing punctuation.
+func Normalize(word string) string {
+	return strings.Trim(strings.ToLower(word), "'-")
+}
+
+// Analyze returns the index term for a single word.
+func Analyze(word string) string {
+	return Stem(Normalize(word))
+}
+
+// Tokenize splits text into analysed tokens. Words consisting only of
+// punctuation are dropped but still advance the position counter so phrase
+// matching never bridges them.
+func Tokenize(text string) []Token {
+	spans := wordSpans(text)
+	tokens := make([]Token, 0, len(spans))
+	for pos, s := range spans {
+		term := Analyze(text[s[0]:s[1]])
+		if term == "" {
+			continue
+		}
+		tokens = append(tokens, Token{Term: term, Position: pos, Start: s[0], End: s[1]})
+	}
+	return tokens
+}
@@ -31,6 +31,14 @@ func DefaultPath(name, dockerEnv string) string {
 	return devName
 }
 
+// Load returns a secret using the same priority as LoadOrCreate. It returns
+// an empty string rather than generating a secret when none is found, for
+// keys such as DKIM private keys that must be provisioned deliberately.
+func Load(fs core.FileSystem, cliSecret, path, envSecret, envSecretFile string, defaultPath func() string) (string, error) {
+	secret, _, err := load(fs, cliSecret, path, envSecret, envSecretFile, defaultPath)
+	return secret, err
+}
+
 // LoadOrCreate returns a secret using the following priority:
 //  1. cliSecret if non-empty
 //  2. the environment variable named envSecret
@@ -39,11 +47,28 @@ func DefaultPath(name, dockerEnv string) string {
 //
 // If the file does not exist, a new random secret is generated and saved.
 func LoadOrCreate(fs core.FileSystem, cliSecret, path, envSecret, envSecretFile string, defaultPath func() string) (string, error) {
+	secret, path, err := load(fs, cliSecret, path, envSecret, envSecretFile, defaultPath)
+	if err != nil || secret != "" {
+		return secret, err
+	}
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	secret = hex.EncodeToString(buf)
+	if err := fs.WriteFile(path, []byte(secret), 0600); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+// load finds an existing secret and reports the file path it looked in.
+func load(fs core.FileSystem, cliSecret, path, envSecret, envSecretFile string, defaultPath func() string) (string, string, error) {
 	if cliSecret != "" {
-		return cliSecret, nil
+		return cliSecret, path, nil
 	}
 	if env := os.Getenv(envSecret); env != "" {
-		return env, nil
+		return env, path, nil
 	}
 	if path == "" {
 		path = os.Getenv(envSecretFile)
@@ -53,20 +78,9 @@ func LoadOrCreate(fs core.FileSystem, cliSecret, path, envSecret, envSecretFile
 	}
 	b, err := fs.ReadFile(path)
 	if err == nil {
-		secret := strings.TrimSpace(string(b))
-		if secret != "" {
-			return secret, nil
-		}
+		return strings.TrimSpace(string(b)), path, nil
 	} else if !errors.Is(err, os.ErrNotExist) {
-		return "", err
+		return "", path, err
 	}
-	buf := make([]byte, 32)
-	if _, err := rand.Read(buf); err != nil {
-		return "", err
-	}
-	secret := hex.EncodeToString(buf)
-	if err := fs.WriteFile(path, []byte(secret), 0600); err != nil {
-		return "", err
-	}
-	return secret, nil
+	return "", path, nil
 }
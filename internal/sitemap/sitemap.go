@@ -0,0 +1,245 @@
+// Package sitemap caches the pages section modules list for search engines
+// and renders them as a sitemap index with one or more pages per section.
+package sitemap
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+const (
+	// SchedulerTaskName identifies the periodic task regenerating the sitemap.
+	SchedulerTaskName = "sitemap_generate"
+
+	// Namespace is the XML namespace of the sitemap protocol.
+	Namespace = "http://www.sitemaps.org/schemas/sitemap/0.9"
+
+	// MaxURLs is the most URLs the protocol allows in a single sitemap.
+	MaxURLs = 50000
+)
+
+// Entry is a page listed in the sitemap.
+type Entry struct {
+	// Path is the site relative URL of the page.
+	Path string
+	// LastMod is when the page last changed. It is omitted when zero.
+	LastMod time.Time
+}
+
+// Source lists the pages of a section that anonymous visitors may open.
+type Source func(ctx context.Context, q db.Querier) ([]Entry, error)
+
+// Page identifies one page of a section's sitemap.
+type Page struct {
+	Section string
+	// Number counts from 1.
+	Number  int
+	LastMod time.Time
+}
+
+// Path returns the site relative URL serving the page.
+func (p Page) Path() string {
+	return fmt.Sprintf("/sitemap/%s/%d.xml", p.Section, p.Number)
+}
+
+// Sitemap holds the registered sources and the entries they last produced.
+// Requests are answered from the cache, which Regenerate rebuilds.
+type Sitemap struct {
+	// PageSize limits the URLs in each page.
+	PageSize int
+
+	mu        sync.RWMutex
+	sources   map[string]Source
+	entries   map[string][]Entry
+	generated time.Time
+}
+
+// New returns an empty Sitemap using the protocol's page size.
+func New() *Sitemap {
+	return &Sitemap{
+		PageSize: MaxURLs,
+		sources:  map[string]Source{},
+		entries:  map[string][]Entry{},
+	}
+}
+
+// Register adds the source for section, replacing any earlier one.
+func (s *Sitemap) Register(section string, src Source) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sources[section] = src
+}
+
+// Sections returns the registered section names in sorted order.
+func (s *Sitemap) Sections() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.sources))
+	for name := range s.sources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Regenerate asks every source for its entries and replaces the cache. A
+// section whose source fails keeps its previous entries and the first error
+// is returned once the others are done.
+func (s *Sitemap) Regenerate(ctx context.Context, q db.Querier) error {
+	s.mu.RLock()
+	sources := make(map[string]Source, len(s.sources))
+	for name, src := range s.sources {
+		sources[name] = src
+	}
+	s.mu.RUnlock()
+
+	var firstErr error
+	fresh := map[string][]Entry{}
+	for name, src := range sources {
+		entries, err := src(ctx, q)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("sitemap %s: %w", name, err)
+			}
+			continue
+		}
+		fresh[name] = entries
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for name := range s.entries {
+		if _, ok := sources[name]; !ok {
+			delete(s.entries, name)
+		}
+	}
+	for name, entries := range fresh {
+		s.entries[name] = entries
+	}
+	s.generated = time.Now()
+	return firstErr
+}
+
+// Generated reports when Regenerate last ran, or the zero time if it has not.
+func (s *Sitemap) Generated() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.generated
+}
+
+func (s *Sitemap) pageSize() int {
+	if s.PageSize <= 0 || s.PageSize > MaxURLs {
+		return MaxURLs
+	}
+	return s.PageSize
+}
+
+// Pages lists the cached pages ordered by section. Sections without entries
+// have no pages.
+func (s *Sitemap) Pages() []Page {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	names := make([]string, 0, len(s.entries))
+	for name := range s.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	size := s.pageSize()
+	var pages []Page
+	for _, name := range names {
+		entries := s.entries[name]
+		for start, n := 0, 1; start < len(entries); start, n = start+size, n+1 {
+			end := min(start+size, len(entries))
+			pages = append(pages, Page{Section: name, Number: n, LastMod: Latest(entries[start:end])})
+		}
+	}
+	return pages
+}
+
+// Entries returns the entries on page n of section. ok is false when there
+// is no such page.
+func (s *Sitemap) Entries(section string, n int) (entries []Entry, ok bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	all := s.entries[section]
+	size := s.pageSize()
+	start := (n - 1) * size
+	if n < 1 || start >= len(all) {
+		return nil, false
+	}
+	return all[start:min(start+size, len(all))], true
+}
+
+// Latest returns the newest LastMod of entries.
+func Latest(entries []Entry) time.Time {
+	var t time.Time
+	for _, e := range entries {
+		if e.LastMod.After(t) {
+			t = e.LastMod
+		}
+	}
+	return t
+}
+
+type xmlURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type xmlURLSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	URLs    []xmlURL `xml:"url"`
+}
+
+type xmlSitemapIndex struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Sitemaps []xmlURL `xml:"sitemap"`
+}
+
+func lastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func writeXML(w io.Writer, v any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// WriteIndex writes a sitemap index listing pages. abs turns a site relative
+// path into an absolute URL.
+func WriteIndex(w io.Writer, pages []Page, abs func(string) string) error {
+	idx := xmlSitemapIndex{Xmlns: Namespace}
+	for _, p := range pages {
+		idx.Sitemaps = append(idx.Sitemaps, xmlURL{Loc: abs(p.Path()), LastMod: lastMod(p.LastMod)})
+	}
+	return writeXML(w, idx)
+}
+
+// WriteURLSet writes a sitemap page listing entries. abs turns a site
+// relative path into an absolute URL.
+func WriteURLSet(w io.Writer, entries []Entry, abs func(string) string) error {
+	set := xmlURLSet{Xmlns: Namespace}
+	for _, e := range entries {
+		set.URLs = append(set.URLs, xmlURL{Loc: abs(e.Path), LastMod: lastMod(e.LastMod)})
+	}
+	return writeXML(w, set)
+}
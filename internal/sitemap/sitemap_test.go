@@ -0,0 +1,108 @@
+package sitemap
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+)
+
+func staticSource(entries ...Entry) Source {
+	return func(context.Context, db.Querier) ([]Entry, error) { return entries, nil }
+}
+
+func TestPagination(t *testing.T) {
+	s := New()
+	s.PageSize = 2
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var entries []Entry
+	for i := 0; i < 5; i++ {
+		entries = append(entries, Entry{Path: fmt.Sprintf("/news/news/%d", i), LastMod: base.AddDate(0, 0, i)})
+	}
+	s.Register("news", staticSource(entries...))
+	s.Register("blogs", staticSource(Entry{Path: "/blogs"}))
+	s.Register("empty", staticSource())
+	if err := s.Regenerate(context.Background(), nil); err != nil {
+		t.Fatalf("regenerate: %v", err)
+	}
+
+	pages := s.Pages()
+	var got []string
+	for _, p := range pages {
+		got = append(got, p.Path())
+	}
+	want := []string{"/sitemap/blogs/1.xml", "/sitemap/news/1.xml", "/sitemap/news/2.xml", "/sitemap/news/3.xml"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("pages %v want %v", got, want)
+	}
+	if !pages[2].LastMod.Equal(base.AddDate(0, 0, 3)) {
+		t.Errorf("page lastmod %v", pages[2].LastMod)
+	}
+	if e, ok := s.Entries("news", 3); !ok || len(e) != 1 || e[0].Path != "/news/news/4" {
+		t.Errorf("last page %v %v", e, ok)
+	}
+	if _, ok := s.Entries("news", 4); ok {
+		t.Errorf("page past the end reported")
+	}
+	if _, ok := s.Entries("news", 0); ok {
+		t.Errorf("page 0 reported")
+	}
+}
+
+func TestRegenerateKeepsEntriesOnError(t *testing.T) {
+	s := New()
+	fail := false
+	s.Register("forum", func(context.Context, db.Querier) ([]Entry, error) {
+		if fail {
+			return nil, errors.New("boom")
+		}
+		return []Entry{{Path: "/forum"}}, nil
+	})
+	if err := s.Regenerate(context.Background(), nil); err != nil {
+		t.Fatalf("regenerate: %v", err)
+	}
+	fail = true
+	if err := s.Regenerate(context.Background(), nil); err == nil {
+		t.Fatalf("expected error")
+	}
+	if e, ok := s.Entries("forum", 1); !ok || len(e) != 1 {
+		t.Errorf("entries dropped: %v", e)
+	}
+}
+
+func TestWriteXML(t *testing.T) {
+	abs := func(p string) string { return "https://example.com" + p }
+	mod := time.Date(2024, 2, 3, 4, 5, 6, 0, time.FixedZone("x", 3600))
+
+	var buf bytes.Buffer
+	if err := WriteURLSet(&buf, []Entry{{Path: "/news", LastMod: mod}, {Path: "/forum?a=1&b=2"}}, abs); err != nil {
+		t.Fatalf("write urlset: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`,
+		"<loc>https://example.com/news</loc>",
+		"<lastmod>2024-02-03T03:05:06Z</lastmod>",
+		"<loc>https://example.com/forum?a=1&amp;b=2</loc>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("urlset missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "<lastmod>") != 1 {
+		t.Errorf("zero lastmod written:\n%s", out)
+	}
+
+	buf.Reset()
+	if err := WriteIndex(&buf, []Page{{Section: "news", Number: 2, LastMod: mod}}, abs); err != nil {
+		t.Fatalf("write index: %v", err)
+	}
+	if !strings.Contains(buf.String(), "<sitemap>\n    <loc>https://example.com/sitemap/news/2.xml</loc>") {
+		t.Errorf("index:\n%s", buf.String())
+	}
+}
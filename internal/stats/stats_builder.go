@@ -100,6 +100,7 @@ func BuildServerStatsData(cfg *config.RuntimeConfig, configFile string, tasksReg
 	}
 	if emailReg != nil {
 		data.Registries.EmailProviders = emailReg.ProviderNames()
+		data.EmailProviderHealth = emailReg.ProviderHealth()
 	}
 	data.Registries.RouterModules = routerModules
 	data.Registries.UploadProviders = upload.ProviderNames()
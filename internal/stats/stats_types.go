@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/email"
 )
 
 // ServerStatsMetrics holds runtime and system usage metrics.
@@ -40,6 +41,9 @@ type ServerStatsData struct {
 	ConfigJSON   string
 	ConfigValues map[string]string
 	Registries   ServerStatsRegistries
+	// EmailProviderHealth lists the rate limit and circuit state of each
+	// email provider in use.
+	EmailProviderHealth []email.ProviderHealth
 }
 
 // UsageStatsData holds the usage statistics.
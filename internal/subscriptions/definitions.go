@@ -151,6 +151,13 @@ var Definitions = []Definition{
 		Pattern:     "reply:/linker/*",
 	},
 
+	// Reactions
+	{
+		Name:        "Mute Reactions",
+		Description: "Do not notify me when someone reacts to my posts",
+		Pattern:     "mute react:/reactions/*",
+	},
+
 	// Legacy
 	{
 		Name:        "Write Reply",
@@ -381,6 +388,13 @@ func MatchDefinition(pattern string) (*Definition, map[string]string) {
 	return nil, nil
 }
 
+// MatchPattern reports whether value, formatted as "task:/path", matches the
+// subscription style pattern. Parameters such as {topicid} match a single path
+// segment and * matches anything.
+func MatchPattern(pattern, value string) (map[string]string, bool) {
+	return matchWithRegex(compilePattern(pattern), value)
+}
+
 var paramRegex = regexp.MustCompile(`\{([a-zA-Z0-9]+)\}`)
 
 func compilePattern(template string) *regexp.Regexp {
@@ -75,3 +75,29 @@ func TestMatchDefinition_Repro(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		value   string
+		match   bool
+		params  map[string]string
+	}{
+		{"create thread:/forum/topic/{topicid}*", "create thread:/forum/topic/3/thread/9", true, map[string]string{"topicid": "3"}},
+		{"create thread:/forum/topic/5*", "create thread:/forum/topic/3/thread/9", false, nil},
+		{"reply:/forum/topic/*/thread/*", "reply:/forum/topic/1/thread/2", true, map[string]string{}},
+		{"reply:/forum/topic/*/thread/*", "create thread:/forum/topic/1/thread/2", false, nil},
+	}
+	for _, tt := range tests {
+		params, ok := MatchPattern(tt.pattern, tt.value)
+		if ok != tt.match {
+			t.Errorf("MatchPattern(%q, %q) = %v, want %v", tt.pattern, tt.value, ok, tt.match)
+			continue
+		}
+		for k, v := range tt.params {
+			if params[k] != v {
+				t.Errorf("MatchPattern(%q, %q) param %s = %q, want %q", tt.pattern, tt.value, k, params[k], v)
+			}
+		}
+	}
+}
@@ -36,6 +36,19 @@ type Name interface {
 	Name() string
 }
 
+// Confidential is implemented by tasks whose events carry credentials such as
+// passwords, reset links or verification codes. Events of tasks reporting
+// true are never sent to external endpoints.
+type Confidential interface {
+	Confidential() bool
+}
+
+// IsConfidential reports whether t declares its events confidential.
+func IsConfidential(t Task) bool {
+	c, ok := t.(Confidential)
+	return ok && c.Confidential()
+}
+
 type TaskString string
 
 func (t TaskString) Name() string {
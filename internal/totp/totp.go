@@ -0,0 +1,137 @@
+// Package totp implements RFC 6238 time based one-time passwords and the
+// recovery codes issued alongside them.
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"rsc.io/qr"
+)
+
+const (
+	// Digits is the length of generated codes.
+	Digits = 6
+	// Period is the number of seconds each code is valid for.
+	Period = 30
+	// Skew is the number of periods either side of now that are accepted.
+	Skew = 1
+	// SecretSize is the number of random bytes in a generated secret.
+	SecretSize = 20
+	// RecoveryCodeCount is the number of recovery codes issued at once.
+	RecoveryCodeCount = 10
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret returns a new random base32 encoded secret.
+func GenerateSecret() (string, error) {
+	b := make([]byte, SecretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	s := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""))
+	return encoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+// Step returns the time step containing t.
+func Step(t time.Time) int64 { return t.Unix() / Period }
+
+// CodeAt computes the code for the given time step.
+func CodeAt(secret string, step int64) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", fmt.Errorf("decode secret: %w", err)
+	}
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	off := sum[len(sum)-1] & 0x0f
+	v := binary.BigEndian.Uint32(sum[off:off+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < Digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", Digits, v%mod), nil
+}
+
+// Validate checks code against the secret at time t allowing for Skew. The
+// matching step is returned so callers can reject replays of the same code.
+func Validate(secret, code string, t time.Time) (int64, bool) {
+	code = strings.ReplaceAll(strings.TrimSpace(code), " ", "")
+	if len(code) != Digits {
+		return 0, false
+	}
+	now := Step(t)
+	for i := int64(-Skew); i <= Skew; i++ {
+		want, err := CodeAt(secret, now+i)
+		if err != nil {
+			return 0, false
+		}
+		if hmac.Equal([]byte(want), []byte(code)) {
+			return now + i, true
+		}
+	}
+	return 0, false
+}
+
+// ProvisioningURI returns the otpauth URI understood by authenticator apps.
+func ProvisioningURI(issuer, account, secret string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(Digits))
+	v.Set("period", fmt.Sprint(Period))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// QRDataURI renders uri as a PNG QR code data URI suitable for an img tag.
+func QRDataURI(uri string) (string, error) {
+	code, err := qr.Encode(uri, qr.M)
+	if err != nil {
+		return "", err
+	}
+	code.Scale = 4
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(code.PNG()), nil
+}
+
+// GenerateRecoveryCodes returns n random recovery codes formatted as
+// xxxxx-xxxxx.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b := make([]byte, 5)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		s := hex.EncodeToString(b)
+		codes = append(codes, s[:5]+"-"+s[5:])
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the stored form of a recovery code. Input is
+// normalised so spacing, dashes and case do not matter.
+func HashRecoveryCode(code string) string {
+	c := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(c))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,71 @@
+package totp
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA1 seed from RFC 6238 appendix B.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCodeAtRFC6238(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		got, err := CodeAt(rfcSecret, tt.unix/Period)
+		if err != nil {
+			t.Fatalf("CodeAt: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("CodeAt(%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	prev, _ := CodeAt(rfcSecret, Step(now)-1)
+	if step, ok := Validate(rfcSecret, prev, now); !ok || step != Step(now)-1 {
+		t.Fatalf("previous step code rejected")
+	}
+	old, _ := CodeAt(rfcSecret, Step(now)-3)
+	if _, ok := Validate(rfcSecret, old, now); ok {
+		t.Fatalf("stale code accepted")
+	}
+	if _, ok := Validate(rfcSecret, "12345", now); ok {
+		t.Fatalf("short code accepted")
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("goa4web", "bob", "ABC")
+	if !strings.HasPrefix(uri, "otpauth://totp/goa4web:bob?") || !strings.Contains(uri, "secret=ABC") {
+		t.Fatalf("unexpected uri %q", uri)
+	}
+	if _, err := QRDataURI(uri); err != nil {
+		t.Fatalf("QRDataURI: %v", err)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(RecoveryCodeCount)
+	if err != nil {
+		t.Fatalf("GenerateRecoveryCodes: %v", err)
+	}
+	if len(codes) != RecoveryCodeCount || len(codes[0]) != 11 {
+		t.Fatalf("unexpected codes %v", codes)
+	}
+	if HashRecoveryCode(codes[0]) != HashRecoveryCode(" "+strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))) {
+		t.Fatalf("hash should ignore formatting")
+	}
+}
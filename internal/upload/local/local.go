@@ -2,7 +2,9 @@ package local
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -20,6 +22,8 @@ type FileSystem interface {
 	ReadFile(path string) ([]byte, error)
 	Remove(path string) error
 	WalkDir(root string, fn fs.WalkDirFunc) error
+	Open(path string) (io.ReadCloser, error)
+	Create(path string) (io.WriteCloser, error)
 }
 
 type osFS struct{}
@@ -32,6 +36,8 @@ func (osFS) WriteFile(path string, data []byte, perm fs.FileMode) error {
 func (osFS) ReadFile(path string) ([]byte, error)         { return os.ReadFile(path) }
 func (osFS) Remove(path string) error                     { return os.Remove(path) }
 func (osFS) WalkDir(root string, fn fs.WalkDirFunc) error { return filepath.WalkDir(root, fn) }
+func (osFS) Open(path string) (io.ReadCloser, error)      { return os.Open(path) }
+func (osFS) Create(path string) (io.WriteCloser, error)   { return os.Create(path) }
 
 type Provider struct {
 	Dir string
@@ -103,6 +109,101 @@ func (p Provider) Read(ctx context.Context, name string) ([]byte, error) {
 	return p.fs().ReadFile(path)
 }
 
+func (p Provider) WriteStream(ctx context.Context, name string, r io.Reader) error {
+	fs := p.fs()
+	path, err := p.safePath(name)
+	if err != nil {
+		return err
+	}
+	if err := fs.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := fs.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		_ = fs.Remove(path)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		_ = fs.Remove(path)
+		return err
+	}
+	return nil
+}
+
+func (p Provider) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	path, err := p.safePath(name)
+	if err != nil {
+		return nil, err
+	}
+	return p.fs().Open(path)
+}
+
+func (p Provider) Stat(ctx context.Context, name string) (upload.ObjectInfo, error) {
+	path, err := p.safePath(name)
+	if err != nil {
+		return upload.ObjectInfo{}, err
+	}
+	info, err := p.fs().Stat(path)
+	if err != nil {
+		return upload.ObjectInfo{}, err
+	}
+	if info.IsDir() {
+		return upload.ObjectInfo{}, fmt.Errorf("%s: %w", name, upload.ErrNotExist)
+	}
+	return upload.ObjectInfo{Name: name, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+func (p Provider) Delete(ctx context.Context, name string) error {
+	path, err := p.safePath(name)
+	if err != nil {
+		return err
+	}
+	if err := p.fs().Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List walks the provider directory and reports regular files whose slash
+// separated path relative to Dir starts with prefix.
+func (p Provider) List(ctx context.Context, prefix string, fn func(upload.ObjectInfo) error) error {
+	err := p.fs().WalkDir(p.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == p.Dir {
+				return fs.SkipAll
+			}
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(p.Dir, path)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		if !strings.HasPrefix(rel, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		return fn(upload.ObjectInfo{Name: rel, Size: info.Size(), ModTime: info.ModTime()})
+	})
+	if errors.Is(err, fs.SkipAll) {
+		return nil
+	}
+	return err
+}
+
 func (p Provider) Cleanup(ctx context.Context, limit int64) error {
 	if limit <= 0 {
 		return nil
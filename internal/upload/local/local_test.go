@@ -1,12 +1,16 @@
 package local
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
 	"io/fs"
 	"path/filepath"
 	"testing"
 	"time"
+
+	"github.com/arran4/goa4web/internal/upload"
 )
 
 type memFile struct {
@@ -93,6 +97,26 @@ func (m *memFS) WalkDir(root string, fn fs.WalkDirFunc) error {
 	return nil
 }
 
+func (m *memFS) Open(path string) (io.ReadCloser, error) {
+	data, err := m.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+type memWriter struct {
+	bytes.Buffer
+	fs   *memFS
+	path string
+}
+
+func (w *memWriter) Close() error { return w.fs.WriteFile(w.path, w.Bytes(), 0o644) }
+
+func (m *memFS) Create(path string) (io.WriteCloser, error) {
+	return &memWriter{fs: m, path: path}, nil
+}
+
 func TestCleanup(t *testing.T) {
 	mfs := newMemFS()
 	p := Provider{Dir: "/cache", FS: mfs}
@@ -166,3 +190,89 @@ func TestReadRejectsCleanEscape(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestWriteStreamOpenStat(t *testing.T) {
+	mfs := newMemFS()
+	p := Provider{Dir: "/store", FS: mfs}
+	ctx := context.Background()
+	if err := p.WriteStream(ctx, "ab/cd/file.jpg", bytes.NewReader([]byte("image"))); err != nil {
+		t.Fatal(err)
+	}
+	info, err := p.Stat(ctx, "ab/cd/file.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != "ab/cd/file.jpg" || info.Size != 5 {
+		t.Fatalf("info = %+v", info)
+	}
+	rc, err := p.Open(ctx, "ab/cd/file.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	if data, _ := io.ReadAll(rc); string(data) != "image" {
+		t.Fatalf("data = %q", data)
+	}
+	if _, err := p.Stat(ctx, "ab/cd/missing.jpg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("stat missing: %v", err)
+	}
+	if _, err := p.Stat(ctx, "ab/cd"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("stat dir: %v", err)
+	}
+}
+
+func TestWriteStreamRejectsTraversal(t *testing.T) {
+	p := Provider{Dir: "/store", FS: newMemFS()}
+	if err := p.WriteStream(context.Background(), "../evil", bytes.NewReader(nil)); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	mfs := newMemFS()
+	p := Provider{Dir: "/store", FS: mfs}
+	ctx := context.Background()
+	_ = p.Write(ctx, "a.jpg", []byte("x"))
+	if err := p.Delete(ctx, "a.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mfs.Stat("/store/a.jpg"); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("not removed: %v", err)
+	}
+	if err := p.Delete(ctx, "a.jpg"); err != nil {
+		t.Fatalf("delete missing: %v", err)
+	}
+	if err := p.Delete(ctx, "../a.jpg"); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestList(t *testing.T) {
+	mfs := newMemFS()
+	p := Provider{Dir: "/store", FS: mfs}
+	ctx := context.Background()
+	_ = p.Write(ctx, "ab/cd/one.jpg", []byte("1"))
+	_ = p.Write(ctx, "ab/ef/two.jpg", []byte("22"))
+	_ = p.Write(ctx, "cd/ef/three.jpg", []byte("333"))
+	_ = mfs.WriteFile("/other/four.jpg", []byte("4"), 0o644)
+	got := map[string]int64{}
+	if err := p.List(ctx, "ab/", func(info upload.ObjectInfo) error {
+		got[info.Name] = info.Size
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got["ab/cd/one.jpg"] != 1 || got["ab/ef/two.jpg"] != 2 {
+		t.Fatalf("listing = %v", got)
+	}
+}
+
+func TestListMissingDir(t *testing.T) {
+	p := Provider{Dir: t.TempDir() + "/missing"}
+	if err := p.List(context.Background(), "", func(upload.ObjectInfo) error {
+		t.Fatal("unexpected object")
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
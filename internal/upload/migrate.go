@@ -0,0 +1,122 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+// MigrateResult records what Migrate did with an object.
+type MigrateResult string
+
+const (
+	// MigrateCopied means the object was copied and verified.
+	MigrateCopied MigrateResult = "copied"
+	// MigrateSkipped means the destination already held a matching object.
+	MigrateSkipped MigrateResult = "skipped"
+	// MigratePlanned means the object would be copied outside a dry run.
+	MigratePlanned MigrateResult = "planned"
+)
+
+// MigrateOptions controls Migrate.
+type MigrateOptions struct {
+	// Prefix limits the migration to objects whose names start with it.
+	Prefix string
+	// SkipExisting leaves an object alone when the destination already
+	// holds one of the same name and size.
+	SkipExisting bool
+	// DryRun reports the objects that would be copied without writing them.
+	DryRun bool
+	// Progress is called after each object is handled.
+	Progress func(info ObjectInfo, result MigrateResult)
+}
+
+// MigrateStats summarises a migration.
+type MigrateStats struct {
+	Copied  int
+	Skipped int
+	Planned int
+	Bytes   int64
+}
+
+// Migrate copies every object listed by from into to, verifying each copy
+// with CopyObject. It stops at the first object that fails.
+func Migrate(ctx context.Context, from, to Provider, opts MigrateOptions) (MigrateStats, error) {
+	var stats MigrateStats
+	err := from.List(ctx, opts.Prefix, func(info ObjectInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		result := MigrateCopied
+		switch {
+		case opts.SkipExisting && existsWithSize(ctx, to, info):
+			result = MigrateSkipped
+			stats.Skipped++
+		case opts.DryRun:
+			result = MigratePlanned
+			stats.Planned++
+		default:
+			n, err := CopyObject(ctx, from, to, info.Name)
+			if err != nil {
+				return err
+			}
+			stats.Copied++
+			stats.Bytes += n
+		}
+		if opts.Progress != nil {
+			opts.Progress(info, result)
+		}
+		return nil
+	})
+	return stats, err
+}
+
+func existsWithSize(ctx context.Context, p Provider, info ObjectInfo) bool {
+	got, err := p.Stat(ctx, info.Name)
+	return err == nil && got.Size == info.Size
+}
+
+// CopyObject streams name from one provider to another and then reads the
+// copy back to confirm its size and SHA-256 digest match the source. It
+// returns the number of bytes copied.
+func CopyObject(ctx context.Context, from, to Provider, name string) (int64, error) {
+	src, err := from.Open(ctx, name)
+	if err != nil {
+		return 0, fmt.Errorf("open %s: %w", name, err)
+	}
+	defer func() { _ = src.Close() }()
+	h := sha256.New()
+	counter := &countingReader{r: io.TeeReader(src, h)}
+	if err := to.WriteStream(ctx, name, counter); err != nil {
+		return 0, fmt.Errorf("write %s: %w", name, err)
+	}
+	want := h.Sum(nil)
+
+	dst, err := to.Open(ctx, name)
+	if err != nil {
+		return 0, fmt.Errorf("verify %s: %w", name, err)
+	}
+	defer func() { _ = dst.Close() }()
+	h.Reset()
+	n, err := io.Copy(h, dst)
+	if err != nil {
+		return 0, fmt.Errorf("verify %s: %w", name, err)
+	}
+	if n != counter.n || !bytes.Equal(h.Sum(nil), want) {
+		return 0, fmt.Errorf("verify %s: copy does not match source", name)
+	}
+	return n, nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
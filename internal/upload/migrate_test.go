@@ -0,0 +1,128 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+type memProvider struct {
+	files   map[string][]byte
+	corrupt bool
+}
+
+func newMemProvider() *memProvider { return &memProvider{files: map[string][]byte{}} }
+
+func (m *memProvider) Check(context.Context) error { return nil }
+
+func (m *memProvider) Write(_ context.Context, name string, data []byte) error {
+	m.files[name] = append([]byte(nil), data...)
+	return nil
+}
+
+func (m *memProvider) Read(_ context.Context, name string) ([]byte, error) {
+	data, ok := m.files[name]
+	if !ok {
+		return nil, ErrNotExist
+	}
+	return data, nil
+}
+
+func (m *memProvider) WriteStream(ctx context.Context, name string, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if m.corrupt {
+		data = append(data, '!')
+	}
+	return m.Write(ctx, name, data)
+}
+
+func (m *memProvider) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	data, err := m.Read(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memProvider) Stat(_ context.Context, name string) (ObjectInfo, error) {
+	data, ok := m.files[name]
+	if !ok {
+		return ObjectInfo{}, ErrNotExist
+	}
+	return ObjectInfo{Name: name, Size: int64(len(data)), ModTime: time.Unix(1, 0)}, nil
+}
+
+func (m *memProvider) Delete(_ context.Context, name string) error {
+	delete(m.files, name)
+	return nil
+}
+
+func (m *memProvider) List(_ context.Context, prefix string, fn func(ObjectInfo) error) error {
+	names := make([]string, 0, len(m.files))
+	for n := range m.files {
+		if strings.HasPrefix(n, prefix) {
+			names = append(names, n)
+		}
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		if err := fn(ObjectInfo{Name: n, Size: int64(len(m.files[n]))}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := context.Background()
+	from, to := newMemProvider(), newMemProvider()
+	from.files["ab/cd/one.jpg"] = []byte("one")
+	from.files["ab/ef/two.jpg"] = []byte("two!")
+	to.files["ab/ef/two.jpg"] = []byte("old!")
+
+	var seen []string
+	stats, err := Migrate(ctx, from, to, MigrateOptions{SkipExisting: true, Progress: func(info ObjectInfo, result MigrateResult) {
+		seen = append(seen, fmt.Sprintf("%s=%s", info.Name, result))
+	}})
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if stats.Copied != 1 || stats.Skipped != 1 || stats.Bytes != 3 {
+		t.Fatalf("stats %+v", stats)
+	}
+	if got := strings.Join(seen, " "); got != "ab/cd/one.jpg=copied ab/ef/two.jpg=skipped" {
+		t.Fatalf("progress %q", got)
+	}
+	if string(to.files["ab/cd/one.jpg"]) != "one" {
+		t.Fatalf("copy %q", to.files["ab/cd/one.jpg"])
+	}
+}
+
+func TestMigrateDryRun(t *testing.T) {
+	from, to := newMemProvider(), newMemProvider()
+	from.files["a.jpg"] = []byte("a")
+	stats, err := Migrate(context.Background(), from, to, MigrateOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	if stats.Planned != 1 || len(to.files) != 0 {
+		t.Fatalf("stats %+v files %v", stats, to.files)
+	}
+}
+
+func TestCopyObjectVerifies(t *testing.T) {
+	from, to := newMemProvider(), newMemProvider()
+	from.files["a.jpg"] = []byte("a")
+	to.corrupt = true
+	if _, err := CopyObject(context.Background(), from, to, "a.jpg"); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Fatalf("expected verification error, got %v", err)
+	}
+}
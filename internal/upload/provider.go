@@ -1,6 +1,22 @@
 package upload
 
-import "context"
+import (
+	"context"
+	"io"
+	"io/fs"
+	"time"
+)
+
+// ErrNotExist is returned, possibly wrapped, when a named object is missing.
+var ErrNotExist = fs.ErrNotExist
+
+// ObjectInfo describes a stored object.
+type ObjectInfo struct {
+	// Name is the slash separated object name relative to the provider root.
+	Name    string
+	Size    int64
+	ModTime time.Time
+}
 
 // Provider handles storing images using different backends.
 type Provider interface {
@@ -10,6 +26,20 @@ type Provider interface {
 	Write(ctx context.Context, name string, data []byte) error
 	// Read retrieves data stored under name.
 	Read(ctx context.Context, name string) ([]byte, error)
+	// WriteStream stores the contents of r under name without buffering the
+	// whole object in memory.
+	WriteStream(ctx context.Context, name string, r io.Reader) error
+	// Open returns a reader for the object stored under name. The caller must
+	// close it.
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+	// Stat describes the object stored under name.
+	Stat(ctx context.Context, name string) (ObjectInfo, error)
+	// Delete removes the object stored under name. Deleting a missing object
+	// is not an error.
+	Delete(ctx context.Context, name string) error
+	// List calls fn for every object whose name starts with prefix. Returning
+	// an error from fn stops the listing and is returned by List.
+	List(ctx context.Context, prefix string, fn func(ObjectInfo) error) error
 }
 
 // CacheProvider extends Provider with a Cleanup method used for thumbnail caches.
@@ -5,15 +5,19 @@ package s3
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"os"
 	"path"
 	"strings"
 
 	//nolint:staticcheck // SA1019: AWS SDK v1 is deprecated
 	"github.com/aws/aws-sdk-go/aws"
 	//nolint:staticcheck // SA1019: AWS SDK v1 is deprecated
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	//nolint:staticcheck // SA1019: AWS SDK v1 is deprecated
 	"github.com/aws/aws-sdk-go/aws/session"
 	//nolint:staticcheck // SA1019: AWS SDK v1 is deprecated
 	awsS3 "github.com/aws/aws-sdk-go/service/s3"
@@ -30,6 +34,8 @@ type api interface {
 	PutObject(*awsS3.PutObjectInput) (*awsS3.PutObjectOutput, error)
 	DeleteObject(*awsS3.DeleteObjectInput) (*awsS3.DeleteObjectOutput, error)
 	GetObject(*awsS3.GetObjectInput) (*awsS3.GetObjectOutput, error)
+	HeadObject(*awsS3.HeadObjectInput) (*awsS3.HeadObjectOutput, error)
+	ListObjectsV2Pages(*awsS3.ListObjectsV2Input, func(*awsS3.ListObjectsV2Output, bool) bool) error
 }
 
 type Provider struct {
@@ -110,8 +116,19 @@ func (p Provider) Check(ctx context.Context) error {
 	return nil
 }
 
+func (p Provider) key(name string) string { return path.Join(p.Prefix, name) }
+
+// notExist converts S3 missing object errors into upload.ErrNotExist.
+func notExist(err error) error {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && (aerr.Code() == awsS3.ErrCodeNoSuchKey || aerr.Code() == "NotFound") {
+		return fmt.Errorf("%w: %v", upload.ErrNotExist, err)
+	}
+	return err
+}
+
 func (p Provider) Write(ctx context.Context, name string, data []byte) error {
-	key := path.Join(p.Prefix, name)
+	key := p.key(name)
 	_, err := p.Client.PutObject(&awsS3.PutObjectInput{Bucket: aws.String(p.Bucket), Key: aws.String(key), Body: bytes.NewReader(data)})
 	if err != nil {
 		return fmt.Errorf("put object: %w", err)
@@ -120,11 +137,94 @@ func (p Provider) Write(ctx context.Context, name string, data []byte) error {
 }
 
 func (p Provider) Read(ctx context.Context, name string) ([]byte, error) {
-	key := path.Join(p.Prefix, name)
-	out, err := p.Client.GetObject(&awsS3.GetObjectInput{Bucket: aws.String(p.Bucket), Key: aws.String(key)})
+	body, err := p.Open(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = body.Close() }()
+	return io.ReadAll(body)
+}
+
+// WriteStream uploads r under name. PutObject needs a seekable body, so
+// readers that cannot seek are spooled to a temporary file rather than
+// held in memory.
+func (p Provider) WriteStream(ctx context.Context, name string, r io.Reader) error {
+	body, ok := r.(io.ReadSeeker)
+	if !ok {
+		f, err := os.CreateTemp("", "goa4web-s3-*")
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}()
+		if _, err := io.Copy(f, r); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		body = f
+	}
+	_, err := p.Client.PutObject(&awsS3.PutObjectInput{Bucket: aws.String(p.Bucket), Key: aws.String(p.key(name)), Body: body})
+	if err != nil {
+		return fmt.Errorf("put object: %w", err)
+	}
+	return nil
+}
+
+func (p Provider) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	out, err := p.Client.GetObject(&awsS3.GetObjectInput{Bucket: aws.String(p.Bucket), Key: aws.String(p.key(name))})
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", notExist(err))
+	}
+	return out.Body, nil
+}
+
+func (p Provider) Stat(ctx context.Context, name string) (upload.ObjectInfo, error) {
+	out, err := p.Client.HeadObject(&awsS3.HeadObjectInput{Bucket: aws.String(p.Bucket), Key: aws.String(p.key(name))})
+	if err != nil {
+		return upload.ObjectInfo{}, fmt.Errorf("head object: %w", notExist(err))
+	}
+	return upload.ObjectInfo{Name: name, Size: aws.Int64Value(out.ContentLength), ModTime: aws.TimeValue(out.LastModified)}, nil
+}
+
+func (p Provider) Delete(ctx context.Context, name string) error {
+	if _, err := p.Client.DeleteObject(&awsS3.DeleteObjectInput{Bucket: aws.String(p.Bucket), Key: aws.String(p.key(name))}); err != nil {
+		return fmt.Errorf("delete object: %w", err)
+	}
+	return nil
+}
+
+// List pages through the objects under the provider prefix. Names passed to
+// fn have the provider prefix removed.
+func (p Provider) List(ctx context.Context, prefix string, fn func(upload.ObjectInfo) error) error {
+	root := ""
+	if p.Prefix != "" {
+		root = strings.TrimSuffix(p.Prefix, "/") + "/"
+	}
+	var fnErr error
+	err := p.Client.ListObjectsV2Pages(&awsS3.ListObjectsV2Input{Bucket: aws.String(p.Bucket), Prefix: aws.String(root + prefix)}, func(out *awsS3.ListObjectsV2Output, last bool) bool {
+		for _, obj := range out.Contents {
+			if fnErr = ctx.Err(); fnErr != nil {
+				return false
+			}
+			name := strings.TrimPrefix(aws.StringValue(obj.Key), root)
+			if name == "" || strings.HasSuffix(name, "/") {
+				continue
+			}
+			if fnErr = fn(upload.ObjectInfo{Name: name, Size: aws.Int64Value(obj.Size), ModTime: aws.TimeValue(obj.LastModified)}); fnErr != nil {
+				return false
+			}
+		}
+		return true
+	})
+	if fnErr != nil {
+		return fnErr
+	}
 	if err != nil {
-		return nil, fmt.Errorf("get object: %w", err)
+		return fmt.Errorf("list objects: %w", err)
 	}
-	defer func() { _ = out.Body.Close() }()
-	return io.ReadAll(out.Body)
+	return nil
 }
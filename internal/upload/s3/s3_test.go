@@ -5,11 +5,19 @@ package s3
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/internal/upload"
+	//nolint:staticcheck // SA1019: AWS SDK v1 is deprecated
+	"github.com/aws/aws-sdk-go/aws"
+	//nolint:staticcheck // SA1019: AWS SDK v1 is deprecated
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	//nolint:staticcheck // SA1019: AWS SDK v1 is deprecated
 	awsS3 "github.com/aws/aws-sdk-go/service/s3"
 )
@@ -24,6 +32,12 @@ type mockClient struct {
 	delErr     error
 	getErr     error
 	getData    []byte
+	statErr    error
+	putKey     string
+	putData    []byte
+	delKey     string
+	listPrefix string
+	listKeys   []string
 }
 
 type mockFactory struct{ c *mockClient }
@@ -35,13 +49,16 @@ func (m *mockClient) HeadBucket(*awsS3.HeadBucketInput) (*awsS3.HeadBucketOutput
 	return &awsS3.HeadBucketOutput{}, m.headErr
 }
 
-func (m *mockClient) PutObject(*awsS3.PutObjectInput) (*awsS3.PutObjectOutput, error) {
+func (m *mockClient) PutObject(in *awsS3.PutObjectInput) (*awsS3.PutObjectOutput, error) {
 	m.putCalled = true
+	m.putKey = aws.StringValue(in.Key)
+	m.putData, _ = io.ReadAll(in.Body)
 	return &awsS3.PutObjectOutput{}, m.putErr
 }
 
-func (m *mockClient) DeleteObject(*awsS3.DeleteObjectInput) (*awsS3.DeleteObjectOutput, error) {
+func (m *mockClient) DeleteObject(in *awsS3.DeleteObjectInput) (*awsS3.DeleteObjectOutput, error) {
 	m.delCalled = true
+	m.delKey = aws.StringValue(in.Key)
 	return &awsS3.DeleteObjectOutput{}, m.delErr
 }
 
@@ -53,6 +70,24 @@ func (m *mockClient) GetObject(*awsS3.GetObjectInput) (*awsS3.GetObjectOutput, e
 	return &awsS3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(m.getData))}, nil
 }
 
+func (m *mockClient) HeadObject(*awsS3.HeadObjectInput) (*awsS3.HeadObjectOutput, error) {
+	if m.statErr != nil {
+		return nil, m.statErr
+	}
+	return &awsS3.HeadObjectOutput{ContentLength: aws.Int64(int64(len(m.getData))), LastModified: aws.Time(time.Unix(10, 0))}, nil
+}
+
+func (m *mockClient) ListObjectsV2Pages(in *awsS3.ListObjectsV2Input, fn func(*awsS3.ListObjectsV2Output, bool) bool) error {
+	m.listPrefix = aws.StringValue(in.Prefix)
+	for i, k := range m.listKeys {
+		out := &awsS3.ListObjectsV2Output{Contents: []*awsS3.Object{{Key: aws.String(k), Size: aws.Int64(int64(i))}}}
+		if !fn(out, i == len(m.listKeys)-1) {
+			break
+		}
+	}
+	return nil
+}
+
 func TestProviderCheckSuccess(t *testing.T) {
 	mock := &mockClient{}
 	p := providerFromConfigWithFactory(&config.RuntimeConfig{EmailAWSRegion: "us-east-1", ImageUploadS3URL: "s3://bucket/path"}, mockFactory{mock})
@@ -89,3 +124,60 @@ func TestProviderRead(t *testing.T) {
 		t.Fatalf("unexpected %q %v", data, mock.getCalled)
 	}
 }
+
+func TestProviderWriteStream(t *testing.T) {
+	mock := &mockClient{}
+	p := providerFromConfigWithFactory(&config.RuntimeConfig{ImageUploadS3URL: "s3://bucket/path"}, mockFactory{mock})
+	// strings.NewReader is seekable; wrap it to exercise the spooling path.
+	if err := p.WriteStream(context.Background(), "ab/cd/name.jpg", io.MultiReader(strings.NewReader("streamed"))); err != nil {
+		t.Fatalf("write stream: %v", err)
+	}
+	if mock.putKey != "path/ab/cd/name.jpg" || string(mock.putData) != "streamed" {
+		t.Fatalf("put %q %q", mock.putKey, mock.putData)
+	}
+}
+
+func TestProviderStat(t *testing.T) {
+	mock := &mockClient{getData: []byte("hello")}
+	p := providerFromConfigWithFactory(&config.RuntimeConfig{ImageUploadS3URL: "s3://bucket/path"}, mockFactory{mock})
+	info, err := p.Stat(context.Background(), "name")
+	if err != nil {
+		t.Fatalf("stat: %v", err)
+	}
+	if info.Name != "name" || info.Size != 5 {
+		t.Fatalf("info %+v", info)
+	}
+	mock.statErr = awserr.New("NotFound", "missing", nil)
+	if _, err := p.Stat(context.Background(), "name"); !errors.Is(err, upload.ErrNotExist) {
+		t.Fatalf("stat missing: %v", err)
+	}
+}
+
+func TestProviderDelete(t *testing.T) {
+	mock := &mockClient{}
+	p := providerFromConfigWithFactory(&config.RuntimeConfig{ImageUploadS3URL: "s3://bucket/path"}, mockFactory{mock})
+	if err := p.Delete(context.Background(), "name"); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if mock.delKey != "path/name" {
+		t.Fatalf("deleted %q", mock.delKey)
+	}
+}
+
+func TestProviderList(t *testing.T) {
+	mock := &mockClient{listKeys: []string{"path/ab/one.jpg", "path/ab/", "path/ab/two.jpg"}}
+	p := providerFromConfigWithFactory(&config.RuntimeConfig{ImageUploadS3URL: "s3://bucket/path"}, mockFactory{mock})
+	var names []string
+	if err := p.List(context.Background(), "ab/", func(info upload.ObjectInfo) error {
+		names = append(names, info.Name)
+		return nil
+	}); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if mock.listPrefix != "path/ab/" {
+		t.Fatalf("prefix %q", mock.listPrefix)
+	}
+	if strings.Join(names, ",") != "ab/one.jpg,ab/two.jpg" {
+		t.Fatalf("names %v", names)
+	}
+}
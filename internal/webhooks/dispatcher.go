@@ -0,0 +1,359 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/dlq"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/safehttp"
+)
+
+const (
+	// DefaultMaxAttempts is the number of times a delivery is tried before
+	// it is sent to the DLQ.
+	DefaultMaxAttempts = 5
+	// DefaultBackoff is the wait before the first retry. Each further retry
+	// doubles it.
+	DefaultBackoff = 2 * time.Second
+	// DefaultTimeout bounds a single delivery attempt.
+	DefaultTimeout = 10 * time.Second
+	// DefaultWorkers is the number of deliveries that may run at once.
+	DefaultWorkers = 4
+	// DefaultRetryInterval is how often deliveries left pending are
+	// started again.
+	DefaultRetryInterval = 30 * time.Second
+
+	// retryBatch limits how many pending deliveries one RetryPending call
+	// looks at.
+	retryBatch   = 50
+	maxErrorBody = 512
+)
+
+// Dispatcher records and performs webhook deliveries.
+type Dispatcher struct {
+	queries     db.Querier
+	client      *http.Client
+	dlq         dlq.DLQ
+	maxAttempts int
+	backoff     time.Duration
+	now         func() time.Time
+	workers     int
+	slots       chan struct{}
+	wg          sync.WaitGroup
+
+	mu       sync.Mutex
+	inflight map[int32]bool
+}
+
+// Option configures a Dispatcher.
+type Option func(*Dispatcher)
+
+// WithHTTPClient sets the client used for deliveries. The default client
+// refuses loopback, private and link-local destinations.
+func WithHTTPClient(c *http.Client) Option {
+	return func(d *Dispatcher) {
+		if c != nil {
+			d.client = c
+		}
+	}
+}
+
+// WithDLQ sets where permanently failed deliveries are recorded.
+func WithDLQ(q dlq.DLQ) Option {
+	return func(d *Dispatcher) { d.dlq = q }
+}
+
+// WithMaxAttempts overrides DefaultMaxAttempts.
+func WithMaxAttempts(n int) Option {
+	return func(d *Dispatcher) {
+		if n > 0 {
+			d.maxAttempts = n
+		}
+	}
+}
+
+// WithBackoff overrides DefaultBackoff.
+func WithBackoff(b time.Duration) Option {
+	return func(d *Dispatcher) { d.backoff = b }
+}
+
+// WithWorkers overrides DefaultWorkers.
+func WithWorkers(n int) Option {
+	return func(d *Dispatcher) {
+		if n > 0 {
+			d.workers = n
+		}
+	}
+}
+
+// New returns a Dispatcher using q to read webhooks and record deliveries.
+func New(q db.Querier, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		queries:     q,
+		client:      safehttp.NewClient(DefaultTimeout),
+		maxAttempts: DefaultMaxAttempts,
+		backoff:     DefaultBackoff,
+		now:         time.Now,
+		workers:     DefaultWorkers,
+		inflight:    map[int32]bool{},
+	}
+	for _, o := range opts {
+		if o != nil {
+			o(d)
+		}
+	}
+	d.slots = make(chan struct{}, d.workers)
+	return d
+}
+
+// Wait blocks until all deliveries started by Dispatch and Redeliver finish.
+func (d *Dispatcher) Wait() { d.wg.Wait() }
+
+// Dispatch queues a delivery of evt to every active webhook whose patterns
+// match it. Deliveries run in the background. Dispatch never waits for a
+// worker: when every worker is busy the delivery stays pending until
+// RetryPending starts it.
+func (d *Dispatcher) Dispatch(ctx context.Context, evt eventbus.TaskEvent) error {
+	if !Deliverable(evt) {
+		return nil
+	}
+	name := EventName(evt)
+	hooks, err := d.queries.SystemListActiveWebhooks(ctx)
+	if err != nil {
+		return fmt.Errorf("list webhooks: %w", err)
+	}
+	var body []byte
+	for _, hook := range hooks {
+		if !Matches(ParsePatterns(hook.Patterns), name, evt.Path) {
+			continue
+		}
+		if body == nil {
+			if body, err = NewPayload(evt); err != nil {
+				return fmt.Errorf("encode payload: %w", err)
+			}
+		}
+		id, err := d.queries.SystemInsertWebhookDelivery(ctx, db.SystemInsertWebhookDeliveryParams{
+			WebhookID: hook.ID,
+			Event:     name,
+			Path:      evt.Path,
+			Payload:   string(body),
+		})
+		if err != nil {
+			log.Printf("webhook %d: record delivery: %v", hook.ID, err)
+			continue
+		}
+		if !d.start(ctx, hook, int32(id), name, body, 0, false) {
+			log.Printf("webhook %d delivery %d: workers busy, left pending", hook.ID, id)
+		}
+	}
+	return nil
+}
+
+// Redeliver sends the payload of an earlier delivery again as a new delivery
+// and returns its ID.
+func (d *Dispatcher) Redeliver(ctx context.Context, deliveryID int32) (int32, error) {
+	prev, err := d.queries.SystemGetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return 0, fmt.Errorf("get delivery: %w", err)
+	}
+	hook, err := d.queries.SystemGetWebhook(ctx, prev.WebhookID)
+	if err != nil {
+		return 0, fmt.Errorf("get webhook: %w", err)
+	}
+	id, err := d.queries.SystemInsertWebhookDelivery(ctx, db.SystemInsertWebhookDeliveryParams{
+		WebhookID: hook.ID,
+		Event:     prev.Event,
+		Path:      prev.Path,
+		Payload:   prev.Payload,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("record delivery: %w", err)
+	}
+	if !d.start(ctx, hook, int32(id), prev.Event, []byte(prev.Payload), 0, false) {
+		log.Printf("webhook %d delivery %d: workers busy, left pending", hook.ID, id)
+	}
+	return int32(id), nil
+}
+
+// RetryPending starts deliveries that are still pending, such as those
+// queued while every worker was busy or cut short by a restart, until no
+// worker is free. Deliveries of deleted or disabled webhooks are marked
+// failed.
+func (d *Dispatcher) RetryPending(ctx context.Context) error {
+	rows, err := d.queries.SystemListPendingWebhookDeliveries(ctx, retryBatch)
+	if err != nil {
+		return fmt.Errorf("list pending deliveries: %w", err)
+	}
+	hooks := map[int32]*db.Webhook{}
+	for _, row := range rows {
+		hook, ok := hooks[row.WebhookID]
+		if !ok {
+			hook, err = d.queries.SystemGetWebhook(ctx, row.WebhookID)
+			switch {
+			case errors.Is(err, sql.ErrNoRows):
+				hook = nil
+			case err != nil:
+				return fmt.Errorf("get webhook %d: %w", row.WebhookID, err)
+			}
+			hooks[row.WebhookID] = hook
+		}
+		if hook == nil || !hook.Active {
+			d.record(ctx, row.ID, StatusFailed, int(row.Attempts), 0, errors.New("webhook deleted or disabled"))
+			continue
+		}
+		if !d.start(ctx, hook, row.ID, row.Event, []byte(row.Payload), int(row.Attempts), true) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// start runs a delivery in the background and reports whether a worker slot
+// was free. A delivery already running is left alone. When recheck is set the
+// delivery is only sent if it is still pending once claimed, so one that
+// finished after it was listed is not sent twice.
+func (d *Dispatcher) start(ctx context.Context, hook *db.Webhook, id int32, event string, body []byte, attempts int, recheck bool) bool {
+	d.mu.Lock()
+	if d.inflight[id] {
+		d.mu.Unlock()
+		return true
+	}
+	select {
+	case d.slots <- struct{}{}:
+	default:
+		d.mu.Unlock()
+		return false
+	}
+	d.inflight[id] = true
+	d.mu.Unlock()
+	d.wg.Add(1)
+	go func() {
+		defer func() {
+			d.mu.Lock()
+			delete(d.inflight, id)
+			d.mu.Unlock()
+			<-d.slots
+			d.wg.Done()
+		}()
+		if recheck {
+			cur, err := d.queries.SystemGetWebhookDelivery(ctx, id)
+			if err != nil || cur.Status != StatusPending {
+				return
+			}
+		}
+		if err := d.deliver(ctx, hook, id, event, body, attempts); err != nil {
+			log.Printf("webhook %d delivery %d: %v", hook.ID, id, err)
+		}
+	}()
+	return true
+}
+
+// Deliver posts body to hook, retrying with exponential backoff. Each attempt
+// is recorded against the delivery. When all attempts fail the delivery is
+// marked failed and written to the DLQ.
+func (d *Dispatcher) Deliver(ctx context.Context, hook *db.Webhook, id int32, event string, body []byte) error {
+	return d.deliver(ctx, hook, id, event, body, 0)
+}
+
+// deliver is Deliver for a delivery that has already been attempted
+// attempts times.
+func (d *Dispatcher) deliver(ctx context.Context, hook *db.Webhook, id int32, event string, body []byte, attempts int) error {
+	wait := d.backoff
+	for attempt := attempts + 1; ; attempt++ {
+		code, err := d.post(ctx, hook, id, event, body)
+		if err == nil {
+			d.record(ctx, id, StatusDelivered, attempt, code, nil)
+			return nil
+		}
+		if attempt >= d.maxAttempts {
+			d.record(ctx, id, StatusFailed, attempt, code, err)
+			d.deadLetter(ctx, hook, id, event, err)
+			return err
+		}
+		d.record(ctx, id, StatusPending, attempt, code, err)
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			d.record(ctx, id, StatusFailed, attempt, code, err)
+			d.deadLetter(ctx, hook, id, event, err)
+			return ctx.Err()
+		}
+		wait *= 2
+	}
+}
+
+func (d *Dispatcher) post(ctx context.Context, hook *db.Webhook, id int32, event string, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, DefaultTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("new request: %w", err)
+	}
+	ts := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "goa4web-webhooks")
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(int(id)))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Signature(hook.Secret, ts, body))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return resp.StatusCode, fmt.Errorf("unexpected status %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+	return resp.StatusCode, nil
+}
+
+func (d *Dispatcher) record(ctx context.Context, id int32, status string, attempts, code int, err error) {
+	arg := db.SystemUpdateWebhookDeliveryParams{
+		Status:         status,
+		Attempts:       int32(attempts),
+		ResponseStatus: sql.NullInt32{Int32: int32(code), Valid: code != 0},
+		ID:             id,
+	}
+	if err != nil {
+		arg.Error = sql.NullString{String: err.Error(), Valid: true}
+	}
+	// The delivery log is updated even when ctx has been cancelled so that
+	// shutdowns do not leave deliveries pending forever.
+	if uerr := d.queries.SystemUpdateWebhookDelivery(context.WithoutCancel(ctx), arg); uerr != nil {
+		log.Printf("webhook delivery %d: update: %v", id, uerr)
+	}
+}
+
+// deadLetter records a failed delivery. The event itself is left out so
+// re-enlisting the entry cannot re-trigger other workers; use Redeliver
+// instead.
+func (d *Dispatcher) deadLetter(ctx context.Context, hook *db.Webhook, id int32, event string, err error) {
+	if d.dlq == nil {
+		return
+	}
+	msg := dlq.Message{
+		Error:    fmt.Sprintf("webhook %d (%s) delivery %d: %v", hook.ID, hook.Name, id, err),
+		TaskName: event,
+	}
+	b, merr := json.Marshal(msg)
+	if merr != nil {
+		b = []byte(msg.Error)
+	}
+	if derr := d.dlq.Record(context.WithoutCancel(ctx), string(b)); derr != nil {
+		log.Printf("webhook delivery %d: dlq record: %v", id, derr)
+	}
+}
@@ -0,0 +1,123 @@
+// Package webhooks delivers task events to administrator configured HTTP
+// endpoints.
+package webhooks
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/sign"
+	"github.com/arran4/goa4web/internal/subscriptions"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderEvent     = "X-Goa4web-Event"
+	HeaderDelivery  = "X-Goa4web-Delivery"
+	HeaderTimestamp = "X-Goa4web-Timestamp"
+	HeaderSignature = "X-Goa4web-Signature"
+)
+
+// Delivery states stored in webhook_deliveries.status.
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Payload is the JSON body posted to a webhook. It only names what happened;
+// event data is never forwarded because it can hold credentials or content
+// from private sections. Receivers fetch details through the API.
+type Payload struct {
+	Event    string    `json:"event"`
+	Path     string    `json:"path"`
+	ItemType string    `json:"item_type,omitempty"`
+	ItemID   int32     `json:"item_id,omitempty"`
+	ActorID  int32     `json:"actor_id,omitempty"`
+	Time     time.Time `json:"time"`
+}
+
+// EventName returns the task name of evt or an empty string when the task is
+// unnamed.
+func EventName(evt eventbus.TaskEvent) string {
+	if n, ok := evt.Task.(tasks.Name); ok {
+		return n.Name()
+	}
+	return ""
+}
+
+// Deliverable reports whether evt may be sent to webhooks. Events of unnamed
+// and confidential tasks are not, nor are events for content still waiting
+// for its publish time.
+func Deliverable(evt eventbus.TaskEvent) bool {
+	return EventName(evt) != "" && !tasks.IsConfidential(evt.Task) && !common.EventScheduled(evt)
+}
+
+// target is implemented by notification targets stored in evt.Data["target"].
+type target interface {
+	SubscriptionTarget() (string, int32)
+}
+
+// eventItem returns the item evt acted on, if the task recorded one.
+func eventItem(evt eventbus.TaskEvent) (string, int32) {
+	if t, ok := evt.Data["target"].(target); ok {
+		return t.SubscriptionTarget()
+	}
+	typ, _ := evt.Data["ItemType"].(string)
+	id, _ := evt.Data["ItemID"].(int32)
+	if typ == "" || id == 0 {
+		return "", 0
+	}
+	return typ, id
+}
+
+// NewPayload encodes evt as a webhook body.
+func NewPayload(evt eventbus.TaskEvent) ([]byte, error) {
+	p := Payload{
+		Event:   EventName(evt),
+		Path:    evt.Path,
+		ActorID: evt.UserID,
+		Time:    evt.Time.UTC(),
+	}
+	p.ItemType, p.ItemID = eventItem(evt)
+	return json.Marshal(p)
+}
+
+// ParsePatterns splits the stored pattern list into individual patterns.
+// Patterns are separated by new lines and blank lines are ignored.
+func ParsePatterns(s string) []string {
+	var out []string
+	for _, line := range strings.Split(s, "\n") {
+		if p := strings.TrimSpace(line); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// Matches reports whether the task and path satisfy any of patterns. Patterns
+// use the subscription syntax, for example
+// "create thread:/forum/topic/{topicid}*".
+func Matches(patterns []string, task, path string) bool {
+	value := strings.ToLower(task) + ":" + path
+	for _, p := range patterns {
+		if i := strings.Index(p, ":"); i >= 0 {
+			p = strings.ToLower(p[:i]) + p[i:]
+		}
+		if _, ok := subscriptions.MatchPattern(p, value); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Signature returns the value of HeaderSignature for body sent at ts. It is
+// an HMAC-SHA256 of "<ts>.<body>" keyed with the webhook secret.
+func Signature(secret string, ts int64, body []byte) string {
+	return "sha256=" + sign.Sign(strconv.FormatInt(ts, 10)+"."+string(body), secret)
+}
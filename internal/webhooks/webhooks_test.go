@@ -0,0 +1,315 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/internal/db"
+	dlqmock "github.com/arran4/goa4web/internal/dlq/mock"
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/safehttp"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+type fakeQueries struct {
+	db.Querier
+	mu         sync.Mutex
+	hooks      []*db.Webhook
+	deliveries map[int32]*db.WebhookDelivery
+}
+
+func newFakeQueries(hooks ...*db.Webhook) *fakeQueries {
+	return &fakeQueries{hooks: hooks, deliveries: map[int32]*db.WebhookDelivery{}}
+}
+
+func (f *fakeQueries) SystemListActiveWebhooks(context.Context) ([]*db.Webhook, error) {
+	return f.hooks, nil
+}
+
+func (f *fakeQueries) SystemGetWebhook(_ context.Context, id int32) (*db.Webhook, error) {
+	for _, h := range f.hooks {
+		if h.ID == id {
+			return h, nil
+		}
+	}
+	return nil, io.EOF
+}
+
+func (f *fakeQueries) SystemInsertWebhookDelivery(_ context.Context, arg db.SystemInsertWebhookDeliveryParams) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	id := int32(len(f.deliveries) + 1)
+	f.deliveries[id] = &db.WebhookDelivery{ID: id, WebhookID: arg.WebhookID, Event: arg.Event, Path: arg.Path, Payload: arg.Payload, Status: StatusPending}
+	return int64(id), nil
+}
+
+func (f *fakeQueries) SystemGetWebhookDelivery(_ context.Context, id int32) (*db.WebhookDelivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	d := *f.deliveries[id]
+	return &d, nil
+}
+
+func (f *fakeQueries) SystemListPendingWebhookDeliveries(_ context.Context, limit int32) ([]*db.WebhookDelivery, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var out []*db.WebhookDelivery
+	for id := int32(1); id <= int32(len(f.deliveries)) && len(out) < int(limit); id++ {
+		if d := f.deliveries[id]; d.Status == StatusPending {
+			c := *d
+			out = append(out, &c)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeQueries) status(id int32) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.deliveries[id].Status
+}
+
+func (f *fakeQueries) SystemUpdateWebhookDelivery(_ context.Context, arg db.SystemUpdateWebhookDeliveryParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	d := f.deliveries[arg.ID]
+	d.Status = arg.Status
+	d.Attempts = arg.Attempts
+	d.ResponseStatus = arg.ResponseStatus
+	d.Error = arg.Error
+	return nil
+}
+
+func TestMatches(t *testing.T) {
+	patterns := ParsePatterns("create thread:/forum/topic/{topicid}*\n\n  reply:/news/*  \n")
+	if len(patterns) != 2 {
+		t.Fatalf("patterns=%q", patterns)
+	}
+	tests := []struct {
+		task, path string
+		want       bool
+	}{
+		{"Create Thread", "/forum/topic/4/thread/9", true},
+		{"Reply", "/news/news/3", true},
+		{"Reply", "/forum/topic/4/thread/9", false},
+		{"Delete", "/news/news/3", false},
+	}
+	for _, tt := range tests {
+		if got := Matches(patterns, tt.task, tt.path); got != tt.want {
+			t.Errorf("Matches(%q, %q)=%v want %v", tt.task, tt.path, got, tt.want)
+		}
+	}
+}
+
+type fakeTarget struct {
+	typ string
+	id  int32
+}
+
+func (t fakeTarget) SubscriptionTarget() (string, int32) { return t.typ, t.id }
+
+type confidentialTask struct{ tasks.TaskString }
+
+func (confidentialTask) Confidential() bool { return true }
+
+func TestDispatchSkipsConfidentialTasks(t *testing.T) {
+	q := newFakeQueries(&db.Webhook{ID: 1, Url: "http://example.invalid/", Patterns: "*", Active: true})
+	d := New(q)
+	evt := eventbus.TaskEvent{Path: "/login", Task: confidentialTask{"Login"}, Data: map[string]any{"Password": "x"}}
+	if err := d.Dispatch(context.Background(), evt); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	d.Wait()
+	if len(q.deliveries) != 0 {
+		t.Fatalf("deliveries=%+v", q.deliveries)
+	}
+}
+
+func TestDispatchSkipsScheduledContent(t *testing.T) {
+	q := newFakeQueries(&db.Webhook{ID: 1, Url: "http://example.invalid/", Patterns: "*", Active: true})
+	d := New(q)
+	evt := eventbus.TaskEvent{Path: "/blogs/add", Task: tasks.TaskString("Add"), Data: map[string]any{common.ScheduledEventKey: true}}
+	if err := d.Dispatch(context.Background(), evt); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	d.Wait()
+	if len(q.deliveries) != 0 {
+		t.Fatalf("deliveries=%+v", q.deliveries)
+	}
+}
+
+func TestDefaultClientRefusesLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("delivery reached a loopback address")
+	}))
+	defer srv.Close()
+
+	hook := &db.Webhook{ID: 1, Url: srv.URL, Patterns: "*", Active: true}
+	q := newFakeQueries(hook)
+	d := New(q, WithMaxAttempts(1))
+	id, _ := q.SystemInsertWebhookDelivery(context.Background(), db.SystemInsertWebhookDeliveryParams{WebhookID: 1, Event: "Reply", Path: "/", Payload: "{}"})
+	if err := d.Deliver(context.Background(), hook, int32(id), "Reply", []byte("{}")); !errors.Is(err, safehttp.ErrBlockedAddress) {
+		t.Fatalf("err=%v", err)
+	}
+}
+
+func TestDispatchBoundsConcurrentDeliveries(t *testing.T) {
+	var mu sync.Mutex
+	running, peak := 0, 0
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+		<-release
+		mu.Lock()
+		running--
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	var hooks []*db.Webhook
+	for i := int32(1); i <= 5; i++ {
+		hooks = append(hooks, &db.Webhook{ID: i, Url: srv.URL, Patterns: "*", Active: true})
+	}
+	q := newFakeQueries(hooks...)
+	d := New(q, WithHTTPClient(srv.Client()), WithWorkers(2))
+	// Dispatch returns while both workers are stuck on the endpoint and
+	// leaves the other deliveries pending.
+	if err := d.Dispatch(context.Background(), eventbus.TaskEvent{Path: "/", Task: tasks.TaskString("Reply")}); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	if err := d.RetryPending(context.Background()); err != nil {
+		t.Fatalf("RetryPending while busy: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	for id := int32(3); id <= 5; id++ {
+		if st := q.status(id); st != StatusPending {
+			t.Fatalf("delivery %d status %s", id, st)
+		}
+	}
+	close(release)
+	d.Wait()
+	// Each sweep starts at most as many deliveries as there are workers.
+	for i := 0; i < 2; i++ {
+		if err := d.RetryPending(context.Background()); err != nil {
+			t.Fatalf("RetryPending: %v", err)
+		}
+		d.Wait()
+	}
+	for id := int32(1); id <= 5; id++ {
+		if st := q.status(id); st != StatusDelivered {
+			t.Fatalf("delivery %d status %s", id, st)
+		}
+	}
+	if peak != 2 {
+		t.Fatalf("peak concurrent deliveries %d", peak)
+	}
+}
+
+func TestDispatchSignsPayload(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	got := make(chan received, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		got <- received{header: r.Header.Clone(), body: b}
+	}))
+	defer srv.Close()
+
+	q := newFakeQueries(&db.Webhook{ID: 1, Name: "hook", Url: srv.URL, Secret: "s3cret", Patterns: "create thread:/forum/*", Active: true})
+	d := New(q, WithHTTPClient(srv.Client()))
+	evt := eventbus.TaskEvent{
+		Path:    "/forum/topic/1/thread/2",
+		Task:    tasks.TaskString("Create Thread"),
+		UserID:  7,
+		Time:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Outcome: eventbus.TaskOutcomeSuccess,
+		Data:    map[string]any{"target": fakeTarget{"thread", 2}, "Password": "hunter2", "Body": "private text"},
+	}
+	if err := d.Dispatch(context.Background(), evt); err != nil {
+		t.Fatalf("Dispatch: %v", err)
+	}
+	d.Wait()
+
+	r := <-got
+	ts, err := strconv.ParseInt(r.header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("timestamp: %v", err)
+	}
+	if sig := r.header.Get(HeaderSignature); sig != Signature("s3cret", ts, r.body) {
+		t.Fatalf("signature %q does not verify", sig)
+	}
+	if r.header.Get(HeaderEvent) != "Create Thread" || r.header.Get(HeaderDelivery) != "1" {
+		t.Fatalf("headers=%v", r.header)
+	}
+	var p Payload
+	if err := json.Unmarshal(r.body, &p); err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	if p.Event != "Create Thread" || p.ActorID != 7 || p.Path != evt.Path || p.ItemType != "thread" || p.ItemID != 2 {
+		t.Fatalf("payload=%+v", p)
+	}
+	if strings.Contains(string(r.body), "hunter2") || strings.Contains(string(r.body), "private text") {
+		t.Fatalf("event data leaked: %s", r.body)
+	}
+	if st := q.deliveries[1].Status; st != StatusDelivered {
+		t.Fatalf("status=%s", st)
+	}
+}
+
+func TestDeliverRetriesThenDeadLetters(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	hook := &db.Webhook{ID: 1, Name: "hook", Url: srv.URL, Secret: "k", Patterns: "*", Active: true}
+	q := newFakeQueries(hook)
+	dl := &dlqmock.Provider{}
+	d := New(q, WithHTTPClient(srv.Client()), WithDLQ(dl), WithMaxAttempts(3), WithBackoff(time.Millisecond))
+
+	id, _ := q.SystemInsertWebhookDelivery(context.Background(), db.SystemInsertWebhookDeliveryParams{WebhookID: 1, Event: "Reply", Path: "/", Payload: "{}"})
+	if err := d.Deliver(context.Background(), hook, int32(id), "Reply", []byte("{}")); err == nil {
+		t.Fatalf("expected error")
+	}
+	if calls != 3 {
+		t.Fatalf("calls=%d", calls)
+	}
+	del := q.deliveries[int32(id)]
+	if del.Status != StatusFailed || del.Attempts != 3 || del.ResponseStatus.Int32 != http.StatusInternalServerError {
+		t.Fatalf("delivery=%+v", del)
+	}
+	if len(dl.Records) != 1 || !strings.Contains(dl.Records[0].Message, "delivery 1") {
+		t.Fatalf("dlq=%+v", dl.Records)
+	}
+
+	newID, err := d.Redeliver(context.Background(), int32(id))
+	if err != nil {
+		t.Fatalf("Redeliver: %v", err)
+	}
+	d.Wait()
+	if newID == int32(id) || q.deliveries[newID].Payload != "{}" {
+		t.Fatalf("redelivery=%+v", q.deliveries[newID])
+	}
+}
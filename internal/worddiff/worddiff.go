@@ -0,0 +1,194 @@
+// Package worddiff computes word level differences between two texts.
+package worddiff
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Kind identifies how a span of text changed.
+type Kind int
+
+const (
+	// Equal text appears in both inputs.
+	Equal Kind = iota
+	// Insert text only appears in the new input.
+	Insert
+	// Delete text only appears in the old input.
+	Delete
+)
+
+// Op is a run of text sharing the same Kind.
+type Op struct {
+	Kind Kind
+	Text string
+}
+
+// Inserted reports whether the op was added.
+func (o Op) Inserted() bool { return o.Kind == Insert }
+
+// Deleted reports whether the op was removed.
+func (o Op) Deleted() bool { return o.Kind == Delete }
+
+// MaxEdits bounds the edit distance searched. Beyond it the texts are
+// reported as a single deletion followed by a single insertion.
+const MaxEdits = 1000
+
+// Tokenize splits s into words, whitespace runs and single punctuation runes.
+// Joining the tokens reproduces s.
+func Tokenize(s string) []string {
+	var tokens []string
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		n := size
+		switch {
+		case isWord(r):
+			n = runEnd(s, isWord)
+		case unicode.IsSpace(r):
+			n = runEnd(s, unicode.IsSpace)
+		}
+		tokens = append(tokens, s[:n])
+		s = s[n:]
+	}
+	return tokens
+}
+
+func isWord(r rune) bool { return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' }
+
+func runEnd(s string, f func(rune) bool) int {
+	for i, r := range s {
+		if !f(r) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// Diff returns the operations transforming a into b.
+func Diff(a, b string) []Op {
+	x, y := Tokenize(a), Tokenize(b)
+
+	pre := 0
+	for pre < len(x) && pre < len(y) && x[pre] == y[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(x)-pre && suf < len(y)-pre && x[len(x)-1-suf] == y[len(y)-1-suf] {
+		suf++
+	}
+
+	var ops []Op
+	add := func(k Kind, toks []string) {
+		if len(toks) == 0 {
+			return
+		}
+		text := strings.Join(toks, "")
+		if n := len(ops); n > 0 && ops[n-1].Kind == k {
+			ops[n-1].Text += text
+			return
+		}
+		ops = append(ops, Op{Kind: k, Text: text})
+	}
+
+	add(Equal, x[:pre])
+	mx, my := x[pre:len(x)-suf], y[pre:len(y)-suf]
+	if script := myers(mx, my); script != nil {
+		for _, e := range script {
+			switch e.kind {
+			case Equal:
+				add(Equal, mx[e.i:e.i+1])
+			case Delete:
+				add(Delete, mx[e.i:e.i+1])
+			case Insert:
+				add(Insert, my[e.j:e.j+1])
+			}
+		}
+	} else {
+		add(Delete, mx)
+		add(Insert, my)
+	}
+	add(Equal, x[len(x)-suf:])
+	return ops
+}
+
+type edit struct {
+	kind Kind
+	i, j int
+}
+
+// myers returns the shortest edit script between x and y or nil when it
+// exceeds MaxEdits.
+func myers(x, y []string) []edit {
+	n, m := len(x), len(y)
+	if n == 0 && m == 0 {
+		return []edit{}
+	}
+	maxD := n + m
+	if maxD > MaxEdits {
+		maxD = MaxEdits
+	}
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	var trace [][]int
+	for d := 0; d <= maxD; d++ {
+		// Only diagonals -d-1..d+1 are consulted when backtracking.
+		snapshot := make([]int, 2*d+3)
+		copy(snapshot, v[offset-d-1:offset+d+2])
+		trace = append(trace, snapshot)
+		for k := -d; k <= d; k += 2 {
+			var px int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				px = v[offset+k+1]
+			} else {
+				px = v[offset+k-1] + 1
+			}
+			py := px - k
+			for px < n && py < m && x[px] == y[py] {
+				px++
+				py++
+			}
+			v[offset+k] = px
+			if px >= n && py >= m {
+				return backtrack(trace, n, m)
+			}
+		}
+	}
+	return nil
+}
+
+func backtrack(trace [][]int, n, m int) []edit {
+	var script []edit
+	px, py := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		at := func(k int) int { return v[k+d+1] }
+		k := px - py
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for px > prevX && py > prevY {
+			px--
+			py--
+			script = append(script, edit{kind: Equal, i: px, j: py})
+		}
+		if d > 0 {
+			if px == prevX {
+				py--
+				script = append(script, edit{kind: Insert, i: px, j: py})
+			} else {
+				px--
+				script = append(script, edit{kind: Delete, i: px, j: py})
+			}
+		}
+	}
+	for i, j := 0, len(script)-1; i < j; i, j = i+1, j-1 {
+		script[i], script[j] = script[j], script[i]
+	}
+	return script
+}
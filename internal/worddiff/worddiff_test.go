@@ -0,0 +1,70 @@
+package worddiff
+
+import (
+	"strings"
+	"testing"
+)
+
+func render(ops []Op) string {
+	var sb strings.Builder
+	for _, o := range ops {
+		switch o.Kind {
+		case Insert:
+			sb.WriteString("{+" + o.Text + "+}")
+		case Delete:
+			sb.WriteString("[-" + o.Text + "-]")
+		default:
+			sb.WriteString(o.Text)
+		}
+	}
+	return sb.String()
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{"", "", ""},
+		{"same text", "same text", "same text"},
+		{"", "new", "{+new+}"},
+		{"old", "", "[-old-]"},
+		{"the quick fox", "the slow fox", "the [-quick-]{+slow+} fox"},
+		{"hello world", "hello big world", "hello {+big +}world"},
+		{"[b bold] text", "[i bold] text", "[[-b-]{+i+} bold] text"},
+		{"a b c d", "a c d e", "a [-b -]c d{+ e+}"},
+	}
+	for _, tt := range tests {
+		if got := render(Diff(tt.a, tt.b)); got != tt.want {
+			t.Errorf("Diff(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestDiffReconstructs(t *testing.T) {
+	a := "one two three four five six seven"
+	b := "zero one three four 4.5 five seven eight"
+	var oldText, newText strings.Builder
+	for _, o := range Diff(a, b) {
+		if !o.Inserted() {
+			oldText.WriteString(o.Text)
+		}
+		if !o.Deleted() {
+			newText.WriteString(o.Text)
+		}
+	}
+	if oldText.String() != a || newText.String() != b {
+		t.Fatalf("reconstructed %q / %q", oldText.String(), newText.String())
+	}
+}
+
+func TestDiffFallback(t *testing.T) {
+	var a, b strings.Builder
+	for i := 0; i < MaxEdits; i++ {
+		a.WriteString("x ")
+		b.WriteString("y ")
+	}
+	ops := Diff(a.String(), b.String())
+	if len(ops) != 3 || !ops[0].Deleted() || !ops[1].Inserted() {
+		t.Fatalf("unexpected ops %d", len(ops))
+	}
+}
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Store the edit history of comments, blogs, news posts and writings.
+CREATE TABLE IF NOT EXISTS `content_revisions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `users_idusers` int NOT NULL,
+  `title` mediumtext DEFAULT NULL,
+  `abstract` mediumtext DEFAULT NULL,
+  `body` mediumtext DEFAULT NULL,
+  `restored_from_id` int DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `timezone` tinytext DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `content_revisions_item_idx` (`item_type`, `item_id`)
+);
+
+UPDATE schema_version SET version = 97;
+
+-- +goose Down
+DROP TABLE IF EXISTS `content_revisions`;
+UPDATE schema_version SET version = 96;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Store the edit history of comments, blogs, news posts and writings.
+CREATE TABLE IF NOT EXISTS content_revisions (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+users_idusers INT NOT NULL,
+title TEXT DEFAULT NULL,
+abstract TEXT DEFAULT NULL,
+body TEXT DEFAULT NULL,
+restored_from_id INT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+timezone TEXT DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS content_revisions_item_idx ON content_revisions (item_type, item_id);
+
+UPDATE schema_version SET version = 97;
+
+-- +goose Down
+DROP TABLE IF EXISTS content_revisions;
+UPDATE schema_version SET version = 96;
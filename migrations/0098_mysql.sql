@@ -0,0 +1,27 @@
+-- +goose Up
+-- TOTP second factor secrets and one-time recovery codes.
+CREATE TABLE IF NOT EXISTS `user_totp` (
+  `users_idusers` int NOT NULL,
+  `secret` varchar(64) NOT NULL,
+  `enabled_at` datetime DEFAULT NULL,
+  `last_used_step` bigint NOT NULL DEFAULT 0,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`users_idusers`)
+);
+
+CREATE TABLE IF NOT EXISTS `user_recovery_codes` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `users_idusers` int NOT NULL,
+  `code_hash` varchar(64) NOT NULL,
+  `used_at` datetime DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `user_recovery_codes_user_idx` (`users_idusers`)
+);
+
+UPDATE schema_version SET version = 98;
+
+-- +goose Down
+DROP TABLE IF EXISTS `user_recovery_codes`;
+DROP TABLE IF EXISTS `user_totp`;
+UPDATE schema_version SET version = 97;
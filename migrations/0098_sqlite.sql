@@ -0,0 +1,25 @@
+-- +goose Up
+-- TOTP second factor secrets and one-time recovery codes.
+CREATE TABLE IF NOT EXISTS user_totp (
+users_idusers INTEGER PRIMARY KEY,
+secret TEXT NOT NULL,
+enabled_at DATETIME DEFAULT NULL,
+last_used_step BIGINT NOT NULL DEFAULT 0,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+users_idusers INT NOT NULL,
+code_hash TEXT NOT NULL,
+used_at DATETIME DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS user_recovery_codes_user_idx ON user_recovery_codes (users_idusers);
+
+UPDATE schema_version SET version = 98;
+
+-- +goose Down
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
+UPDATE schema_version SET version = 97;
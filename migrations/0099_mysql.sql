@@ -0,0 +1,35 @@
+-- +goose Up
+-- Outbound webhooks and their delivery log.
+CREATE TABLE IF NOT EXISTS `webhooks` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `name` varchar(128) NOT NULL,
+  `url` text NOT NULL,
+  `secret` varchar(128) NOT NULL,
+  `patterns` text NOT NULL,
+  `active` tinyint(1) NOT NULL DEFAULT 1,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`)
+);
+
+CREATE TABLE IF NOT EXISTS `webhook_deliveries` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `webhook_id` int NOT NULL,
+  `event` varchar(128) NOT NULL,
+  `path` text NOT NULL,
+  `payload` mediumtext NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT 'pending',
+  `attempts` int NOT NULL DEFAULT 0,
+  `response_status` int DEFAULT NULL,
+  `error` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `webhook_deliveries_webhook_idx` (`webhook_id`)
+);
+
+UPDATE schema_version SET version = 99;
+
+-- +goose Down
+DROP TABLE IF EXISTS `webhook_deliveries`;
+DROP TABLE IF EXISTS `webhooks`;
+UPDATE schema_version SET version = 98;
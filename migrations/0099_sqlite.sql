@@ -0,0 +1,33 @@
+-- +goose Up
+-- Outbound webhooks and their delivery log.
+CREATE TABLE IF NOT EXISTS webhooks (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+name TEXT NOT NULL,
+url TEXT NOT NULL,
+secret TEXT NOT NULL,
+patterns TEXT NOT NULL,
+active INTEGER NOT NULL DEFAULT 1,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+webhook_id INT NOT NULL,
+event TEXT NOT NULL,
+path TEXT NOT NULL,
+payload TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+attempts INT NOT NULL DEFAULT 0,
+response_status INT DEFAULT NULL,
+error TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at DATETIME DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id);
+
+UPDATE schema_version SET version = 99;
+
+-- +goose Down
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhooks;
+UPDATE schema_version SET version = 98;
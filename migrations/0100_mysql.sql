@@ -0,0 +1,31 @@
+-- +goose Up
+-- Reactions on comments, blog posts, news and image posts.
+CREATE TABLE IF NOT EXISTS `reactions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `users_idusers` int NOT NULL,
+  `reaction` varchar(32) NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `reactions_item_user_reaction_idx` (`item_type`, `item_id`, `users_idusers`, `reaction`),
+  KEY `reactions_item_idx` (`item_type`, `item_id`)
+);
+
+-- Grant react rights to logged-in roles that can view the section.
+INSERT INTO grants (created_at, role_id, section, item, rule_type, action, active)
+SELECT DISTINCT NOW(), g.role_id, g.section, g.item, 'allow', 'react', 1
+FROM grants g
+         JOIN roles r ON r.id = g.role_id
+WHERE g.action IN ('see', 'view')
+  AND g.section IN ('forum', 'privateforum', 'blogs', 'news', 'imagebbs')
+  AND g.item_id IS NULL
+  AND g.user_id IS NULL
+  AND r.can_login = 1;
+
+UPDATE schema_version SET version = 100;
+
+-- +goose Down
+DROP TABLE IF EXISTS `reactions`;
+DELETE FROM grants WHERE action = 'react';
+UPDATE schema_version SET version = 99;
@@ -0,0 +1,58 @@
+-- +goose Up
+-- Polls attached to forum threads.
+CREATE TABLE IF NOT EXISTS `forum_polls` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `forumthread_id` int NOT NULL,
+  `question` text NOT NULL,
+  `multiple_choice` tinyint(1) NOT NULL DEFAULT 0,
+  `anonymous` tinyint(1) NOT NULL DEFAULT 1,
+  `results_visibility` varchar(16) NOT NULL DEFAULT 'always',
+  `closes_at` datetime DEFAULT NULL,
+  `closed_at` datetime DEFAULT NULL,
+  `created_by` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `forum_polls_thread_idx` (`forumthread_id`),
+  KEY `forum_polls_closing_idx` (`closed_at`, `closes_at`)
+);
+
+CREATE TABLE IF NOT EXISTS `forum_poll_options` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `poll_id` int NOT NULL,
+  `position` int NOT NULL DEFAULT 0,
+  `label` varchar(255) NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `forum_poll_options_poll_idx` (`poll_id`, `position`)
+);
+
+CREATE TABLE IF NOT EXISTS `forum_poll_votes` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `poll_id` int NOT NULL,
+  `option_id` int NOT NULL,
+  `users_idusers` int NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `forum_poll_votes_option_user_idx` (`option_id`, `users_idusers`),
+  KEY `forum_poll_votes_poll_user_idx` (`poll_id`, `users_idusers`)
+);
+
+-- Allow logged-in roles that can start forum threads to attach polls.
+INSERT INTO grants (created_at, role_id, section, item, rule_type, action, active)
+SELECT DISTINCT NOW(), g.role_id, 'forum', 'poll', 'allow', 'create', 1
+FROM grants g
+         JOIN roles r ON r.id = g.role_id
+WHERE g.section = 'forum'
+  AND g.item = 'topic'
+  AND g.action = 'post'
+  AND g.item_id IS NULL
+  AND g.user_id IS NULL
+  AND r.can_login = 1;
+
+UPDATE schema_version SET version = 101;
+
+-- +goose Down
+DROP TABLE IF EXISTS `forum_poll_votes`;
+DROP TABLE IF EXISTS `forum_poll_options`;
+DROP TABLE IF EXISTS `forum_polls`;
+DELETE FROM grants WHERE section = 'forum' AND item = 'poll';
+UPDATE schema_version SET version = 100;
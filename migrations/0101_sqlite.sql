@@ -0,0 +1,54 @@
+-- +goose Up
+-- Polls attached to forum threads.
+CREATE TABLE IF NOT EXISTS forum_polls (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+forumthread_id INT NOT NULL UNIQUE,
+question TEXT NOT NULL,
+multiple_choice BOOLEAN NOT NULL DEFAULT 0,
+anonymous BOOLEAN NOT NULL DEFAULT 1,
+results_visibility TEXT NOT NULL DEFAULT 'always',
+closes_at DATETIME DEFAULT NULL,
+closed_at DATETIME DEFAULT NULL,
+created_by INT NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS forum_polls_closing_idx ON forum_polls (closed_at, closes_at);
+
+CREATE TABLE IF NOT EXISTS forum_poll_options (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+poll_id INT NOT NULL,
+position INT NOT NULL DEFAULT 0,
+label TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS forum_poll_options_poll_idx ON forum_poll_options (poll_id, position);
+
+CREATE TABLE IF NOT EXISTS forum_poll_votes (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+poll_id INT NOT NULL,
+option_id INT NOT NULL,
+users_idusers INT NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+UNIQUE (option_id, users_idusers)
+);
+CREATE INDEX IF NOT EXISTS forum_poll_votes_poll_user_idx ON forum_poll_votes (poll_id, users_idusers);
+
+-- Allow logged-in roles that can start forum threads to attach polls.
+INSERT INTO grants (created_at, role_id, section, item, rule_type, action, active)
+SELECT DISTINCT CURRENT_TIMESTAMP, g.role_id, 'forum', 'poll', 'allow', 'create', 1
+FROM grants g
+         JOIN roles r ON r.id = g.role_id
+WHERE g.section = 'forum'
+  AND g.item = 'topic'
+  AND g.action = 'post'
+  AND g.item_id IS NULL
+  AND g.user_id IS NULL
+  AND r.can_login = 1;
+
+UPDATE schema_version SET version = 101;
+
+-- +goose Down
+DROP TABLE IF EXISTS forum_poll_votes;
+DROP TABLE IF EXISTS forum_poll_options;
+DROP TABLE IF EXISTS forum_polls;
+DELETE FROM grants WHERE section = 'forum' AND item = 'poll';
+UPDATE schema_version SET version = 100;
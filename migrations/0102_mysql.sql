@@ -0,0 +1,25 @@
+-- +goose Up
+-- User reports of abusive content awaiting moderation.
+CREATE TABLE IF NOT EXISTS `content_reports` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `reporter_id` int NOT NULL,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `author_id` int NOT NULL,
+  `reason` text NOT NULL,
+  `status` varchar(20) NOT NULL DEFAULT 'pending',
+  `resolution_note` text,
+  `resolved_by` int DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `resolved_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `content_reports_status_idx` (`status`, `created_at`),
+  KEY `content_reports_item_idx` (`item_type`, `item_id`),
+  KEY `content_reports_reporter_idx` (`reporter_id`)
+);
+
+UPDATE schema_version SET version = 102;
+
+-- +goose Down
+DROP TABLE IF EXISTS `content_reports`;
+UPDATE schema_version SET version = 101;
@@ -0,0 +1,886 @@
+-- +goose Up
+-- PostgreSQL baseline matching schema version 102. Later migrations apply
+-- on top of this for every dialect.
+CREATE TABLE blogs (
+idblogs SERIAL PRIMARY KEY,
+forumthread_id INTEGER DEFAULT NULL,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+language_id INTEGER DEFAULT NULL,
+blog TEXT DEFAULT NULL,
+written TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+timezone TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+last_index TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE blogs_search (
+blog_id INTEGER NOT NULL DEFAULT 0,
+searchwordlist_idsearchwordlist INTEGER NOT NULL DEFAULT 0,
+word_count INTEGER NOT NULL DEFAULT 1,
+PRIMARY KEY (blog_id,searchwordlist_idsearchwordlist)
+);
+
+CREATE TABLE bookmarks (
+idbookmarks SERIAL PRIMARY KEY,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+list BYTEA DEFAULT NULL
+);
+
+CREATE TABLE comments (
+idcomments SERIAL PRIMARY KEY,
+forumthread_id INTEGER NOT NULL DEFAULT 0,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+language_id INTEGER DEFAULT NULL,
+written TIMESTAMP DEFAULT NULL,
+TEXT TEXT DEFAULT NULL,
+timezone TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+last_index TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE comments_search (
+searchwordlist_idsearchwordlist INTEGER NOT NULL DEFAULT 0,
+comment_id INTEGER NOT NULL DEFAULT 0,
+word_count INTEGER NOT NULL DEFAULT 1,
+PRIMARY KEY (searchwordlist_idsearchwordlist,comment_id)
+);
+
+CREATE TABLE faq (
+id SERIAL PRIMARY KEY,
+category_id INTEGER DEFAULT NULL,
+language_id INTEGER DEFAULT NULL,
+author_id INTEGER NOT NULL DEFAULT 0,
+answer TEXT DEFAULT NULL,
+question TEXT DEFAULT NULL,
+description TEXT DEFAULT '',
+priority INT NOT NULL DEFAULT 0,
+deleted_at TIMESTAMP DEFAULT NULL,
+updated_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE faq_categories (
+id SERIAL PRIMARY KEY,
+parent_category_id INTEGER DEFAULT NULL,
+language_id INTEGER DEFAULT NULL,
+name TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+priority INT NOT NULL DEFAULT 0,
+updated_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS content_revisions (
+id SERIAL PRIMARY KEY,
+item_type TEXT NOT NULL,
+item_id int NOT NULL,
+users_idusers int NOT NULL,
+title TEXT DEFAULT NULL,
+abstract TEXT DEFAULT NULL,
+body TEXT DEFAULT NULL,
+restored_from_id int DEFAULT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+timezone TEXT DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS content_revisions_item_idx ON content_revisions (item_type, item_id);
+
+CREATE TABLE IF NOT EXISTS faq_revisions (
+id SERIAL PRIMARY KEY,
+faq_id int NOT NULL,
+users_idusers int NOT NULL,
+question TEXT,
+answer TEXT,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+timezone TEXT DEFAULT NULL
+);
+
+CREATE TABLE forumcategory (
+idforumcategory SERIAL PRIMARY KEY,
+forumcategory_idforumcategory INTEGER NOT NULL DEFAULT 0,
+language_id INTEGER DEFAULT NULL,
+title TEXT DEFAULT NULL,
+description TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE forumthread (
+idforumthread SERIAL PRIMARY KEY,
+firstpost INTEGER NOT NULL DEFAULT 0,
+lastposter INTEGER NOT NULL DEFAULT 0,
+forumtopic_idforumtopic INTEGER NOT NULL DEFAULT 0,
+comments INTEGER DEFAULT NULL,
+lastaddition TIMESTAMP DEFAULT NULL,
+locked INTEGER DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+reply_to_comment_id INTEGER DEFAULT NULL,
+reply_to_thread_id INTEGER DEFAULT NULL
+);
+
+CREATE TABLE forumtopic (
+idforumtopic SERIAL PRIMARY KEY,
+lastposter INTEGER NOT NULL DEFAULT 0,
+forumcategory_idforumcategory INTEGER NOT NULL DEFAULT 0,
+language_id INTEGER DEFAULT NULL,
+title TEXT DEFAULT NULL,
+description TEXT DEFAULT NULL,
+threads INTEGER DEFAULT NULL,
+comments INTEGER DEFAULT NULL,
+lastaddition TIMESTAMP DEFAULT NULL,
+handler TEXT NOT NULL DEFAULT '',
+deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE imageboard (
+idimageboard SERIAL PRIMARY KEY,
+imageboard_idimageboard INTEGER DEFAULT NULL,
+title TEXT DEFAULT NULL,
+description TEXT DEFAULT NULL,
+approval_required INTEGER NOT NULL DEFAULT 0,
+deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE imagepost (
+idimagepost SERIAL PRIMARY KEY,
+forumthread_id INTEGER NOT NULL DEFAULT 0,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+imageboard_idimageboard INTEGER DEFAULT NULL,
+posted TIMESTAMP DEFAULT NULL,
+timezone TEXT DEFAULT NULL,
+description TEXT DEFAULT NULL,
+thumbnail TEXT DEFAULT NULL,
+fullimage TEXT DEFAULT NULL,
+file_size INTEGER NOT NULL DEFAULT 0,
+approved INTEGER NOT NULL DEFAULT 0,
+deleted_at TIMESTAMP DEFAULT NULL,
+last_index TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE imagepost_search (
+image_post_id INTEGER NOT NULL DEFAULT 0,
+searchwordlist_idsearchwordlist INTEGER NOT NULL DEFAULT 0,
+word_count INTEGER NOT NULL DEFAULT 1,
+PRIMARY KEY (image_post_id,searchwordlist_idsearchwordlist)
+);
+
+CREATE TABLE language (
+id SERIAL PRIMARY KEY,
+nameof TEXT DEFAULT NULL
+);
+
+CREATE TABLE linker (
+id SERIAL PRIMARY KEY,
+language_id INTEGER DEFAULT NULL,
+author_id INTEGER NOT NULL DEFAULT 0,
+category_id INTEGER DEFAULT NULL,
+thread_id INTEGER NOT NULL DEFAULT 0,
+title TEXT DEFAULT NULL,
+url TEXT DEFAULT NULL,
+description TEXT DEFAULT NULL,
+listed TIMESTAMP DEFAULT NULL,
+timezone TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+last_index TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE linker_category (
+id SERIAL PRIMARY KEY,
+position INTEGER NOT NULL DEFAULT 0,
+title TEXT DEFAULT NULL,
+sortorder INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE linker_queue (
+id SERIAL PRIMARY KEY,
+language_id INTEGER DEFAULT NULL,
+submitter_id INTEGER NOT NULL DEFAULT 0,
+category_id INTEGER DEFAULT NULL,
+title TEXT DEFAULT NULL,
+url TEXT DEFAULT NULL,
+description TEXT DEFAULT NULL,
+timezone TEXT DEFAULT NULL
+);
+
+CREATE TABLE linker_search (
+searchwordlist_idsearchwordlist INTEGER NOT NULL DEFAULT 0,
+linker_id INTEGER NOT NULL DEFAULT 0,
+word_count INTEGER NOT NULL DEFAULT 1,
+PRIMARY KEY (searchwordlist_idsearchwordlist,linker_id)
+);
+
+CREATE TABLE roles (
+id SERIAL PRIMARY KEY,
+name TEXT NOT NULL,
+can_login INTEGER NOT NULL DEFAULT 0,
+is_admin INTEGER NOT NULL DEFAULT 0,
+private_labels INTEGER NOT NULL DEFAULT 1,
+public_profile_allowed_at TIMESTAMP DEFAULT NULL,
+UNIQUE (name)
+);
+
+CREATE TABLE user_roles (
+iduser_roles SERIAL PRIMARY KEY,
+users_idusers INTEGER NOT NULL,
+role_id INTEGER NOT NULL
+);
+
+CREATE TABLE grants (
+id SERIAL PRIMARY KEY,
+created_at TIMESTAMP DEFAULT NULL,
+updated_at TIMESTAMP DEFAULT NULL,
+user_id INTEGER DEFAULT NULL,
+role_id INTEGER DEFAULT NULL,
+section TEXT NOT NULL,
+item TEXT DEFAULT NULL,
+rule_type TEXT NOT NULL,
+item_id INTEGER DEFAULT NULL,
+item_rule TEXT DEFAULT NULL,
+action TEXT NOT NULL,
+extra TEXT DEFAULT NULL,
+active INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE TABLE preferences (
+idpreferences SERIAL PRIMARY KEY,
+language_id INTEGER DEFAULT NULL,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+emailforumupdates INTEGER DEFAULT 0,
+page_size INTEGER NOT NULL DEFAULT 15,
+auto_subscribe_replies INTEGER NOT NULL DEFAULT 1,
+timezone TEXT DEFAULT NULL,
+custom_css TEXT DEFAULT NULL,
+daily_digest_hour int DEFAULT NULL,
+daily_digest_mark_read INTEGER NOT NULL DEFAULT 0,
+last_digest_sent_at TIMESTAMP DEFAULT NULL,
+weekly_digest_day INT DEFAULT NULL,
+weekly_digest_hour INT DEFAULT NULL,
+last_weekly_digest_sent_at TIMESTAMP DEFAULT NULL,
+monthly_digest_day INT DEFAULT NULL,
+monthly_digest_hour INT DEFAULT NULL,
+last_monthly_digest_sent_at TIMESTAMP DEFAULT NULL,
+image_safe_dimension TEXT DEFAULT NULL
+);
+
+CREATE TABLE searchwordlist (
+idsearchwordlist SERIAL PRIMARY KEY,
+word TEXT DEFAULT NULL,
+UNIQUE (word)
+);
+
+CREATE TABLE searchwordlist_has_linker (
+searchwordlist_idsearchwordlist INTEGER NOT NULL DEFAULT 0,
+linker_id INTEGER NOT NULL DEFAULT 0,
+PRIMARY KEY (searchwordlist_idsearchwordlist,linker_id)
+);
+
+CREATE TABLE site_news (
+idsiteNews SERIAL PRIMARY KEY,
+forumthread_id INTEGER NOT NULL DEFAULT 0,
+language_id INTEGER DEFAULT NULL,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+news TEXT DEFAULT NULL,
+occurred TIMESTAMP DEFAULT NULL,
+timezone TEXT DEFAULT NULL,
+last_index TIMESTAMP DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE site_news_search (
+site_news_id INTEGER NOT NULL DEFAULT 0,
+searchwordlist_idsearchwordlist INTEGER NOT NULL DEFAULT 0,
+word_count INTEGER NOT NULL DEFAULT 1,
+PRIMARY KEY (site_news_id,searchwordlist_idsearchwordlist)
+);
+
+CREATE TABLE user_language (
+iduserlang SERIAL PRIMARY KEY,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+language_id INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE users (
+idusers SERIAL PRIMARY KEY,
+username TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+public_profile_enabled_at TIMESTAMP DEFAULT NULL,
+UNIQUE (username)
+);
+
+CREATE TABLE passwords (
+id SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+passwd TEXT NOT NULL,
+passwd_algorithm TEXT,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE user_emails (
+id SERIAL PRIMARY KEY,
+user_id int NOT NULL,
+email TEXT NOT NULL DEFAULT '',
+verified_at TIMESTAMP DEFAULT NULL,
+last_verification_code TEXT DEFAULT NULL,
+verification_expires_at TIMESTAMP DEFAULT NULL,
+notification_priority int NOT NULL DEFAULT 0,
+UNIQUE (email,last_verification_code)
+);
+
+CREATE TABLE writing (
+idwriting SERIAL PRIMARY KEY,
+users_idusers INTEGER NOT NULL DEFAULT 0,
+forumthread_id INTEGER NOT NULL DEFAULT 0,
+language_id INTEGER DEFAULT NULL,
+writing_category_id INTEGER NOT NULL DEFAULT 0,
+title TEXT DEFAULT NULL,
+published TIMESTAMP DEFAULT NULL,
+timezone TEXT DEFAULT NULL,
+writing TEXT DEFAULT NULL,
+abstract TEXT DEFAULT NULL,
+private INTEGER DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+last_index TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE writing_category (
+idwritingCategory SERIAL PRIMARY KEY,
+writing_category_id INTEGER DEFAULT NULL,
+title TEXT DEFAULT NULL,
+description TEXT DEFAULT NULL
+);
+
+CREATE TABLE writing_search (
+searchwordlist_idsearchwordlist INTEGER NOT NULL DEFAULT 0,
+writing_id INTEGER NOT NULL DEFAULT 0,
+word_count INTEGER NOT NULL DEFAULT 1,
+PRIMARY KEY (searchwordlist_idsearchwordlist,writing_id)
+);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+id SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+api_key TEXT NOT NULL,
+name TEXT NOT NULL,
+scopes TEXT NOT NULL,
+expires_at TIMESTAMP DEFAULT NULL,
+last_used_at TIMESTAMP DEFAULT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+revoked_at TIMESTAMP DEFAULT NULL,
+UNIQUE (api_key)
+);
+
+CREATE TABLE IF NOT EXISTS subscriptions (
+id SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+method TEXT NOT NULL DEFAULT 'internal',
+pattern TEXT NOT NULL DEFAULT '',
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS pending_emails (
+id SERIAL PRIMARY KEY,
+to_user_id int DEFAULT NULL,
+direct_email INTEGER NOT NULL DEFAULT 0,
+body TEXT NOT NULL,
+error_count int NOT NULL DEFAULT 0,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+sent_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS pending_passwords (
+id SERIAL PRIMARY KEY,
+user_id int NOT NULL,
+passwd TEXT NOT NULL,
+passwd_algorithm TEXT NOT NULL,
+verification_code TEXT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+verified_at TIMESTAMP DEFAULT NULL,
+UNIQUE (verification_code)
+);
+
+CREATE TABLE IF NOT EXISTS notifications (
+id SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+link TEXT,
+message TEXT,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+read_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS dead_letters (
+id SERIAL PRIMARY KEY,
+message TEXT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS sessions (
+session_id TEXT NOT NULL,
+users_idusers int NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+PRIMARY KEY (session_id)
+);
+
+CREATE TABLE IF NOT EXISTS site_announcements (
+id SERIAL PRIMARY KEY,
+site_news_id int NOT NULL,
+active INTEGER NOT NULL DEFAULT 1,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS login_attempts (
+id SERIAL PRIMARY KEY,
+username TEXT NOT NULL,
+ip_address TEXT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS banned_ips (
+id SERIAL PRIMARY KEY,
+ip_net TEXT NOT NULL,
+reason TEXT,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+expires_at TIMESTAMP DEFAULT NULL,
+canceled_at TIMESTAMP DEFAULT NULL,
+UNIQUE (ip_net)
+);
+
+CREATE TABLE IF NOT EXISTS template_overrides (
+name TEXT NOT NULL,
+body TEXT NOT NULL,
+updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+PRIMARY KEY (name)
+);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+id SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+action TEXT NOT NULL,
+path TEXT NOT NULL,
+details TEXT,
+data TEXT,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS deactivated_users (
+idusers int NOT NULL,
+email TEXT,
+passwd TEXT,
+passwd_algorithm TEXT,
+username TEXT,
+deleted_at TIMESTAMP DEFAULT NULL,
+restored_at TIMESTAMP DEFAULT NULL,
+PRIMARY KEY (idusers)
+);
+
+CREATE TABLE IF NOT EXISTS deactivated_comments (
+idcomments int NOT NULL,
+forumthread_id int NOT NULL,
+users_idusers int NOT NULL,
+language_id int DEFAULT NULL,
+written TIMESTAMP,
+TEXT TEXT,
+timezone TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+restored_at TIMESTAMP DEFAULT NULL,
+PRIMARY KEY (idcomments)
+);
+
+CREATE TABLE IF NOT EXISTS deactivated_writings (
+idwriting int NOT NULL,
+users_idusers int NOT NULL,
+forumthread_id int NOT NULL,
+language_id int DEFAULT NULL,
+writing_category_id int NOT NULL,
+title TEXT,
+published TIMESTAMP,
+timezone TEXT DEFAULT NULL,
+writing TEXT,
+abstract TEXT,
+private INTEGER DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+restored_at TIMESTAMP DEFAULT NULL,
+PRIMARY KEY (idwriting)
+);
+
+CREATE TABLE IF NOT EXISTS deactivated_blogs (
+idblogs int NOT NULL,
+forumthread_id int NOT NULL,
+users_idusers int NOT NULL,
+language_id int DEFAULT NULL,
+blog TEXT,
+written TIMESTAMP,
+timezone TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+restored_at TIMESTAMP DEFAULT NULL,
+PRIMARY KEY (idblogs)
+);
+
+CREATE TABLE IF NOT EXISTS deactivated_imageposts (
+idimagepost int NOT NULL,
+forumthread_id int NOT NULL,
+users_idusers int NOT NULL,
+imageboard_idimageboard int DEFAULT NULL,
+posted TIMESTAMP,
+timezone TEXT DEFAULT NULL,
+description TEXT,
+thumbnail TEXT,
+fullimage TEXT,
+file_size int NOT NULL,
+approved INTEGER DEFAULT 0,
+deleted_at TIMESTAMP DEFAULT NULL,
+restored_at TIMESTAMP DEFAULT NULL,
+PRIMARY KEY (idimagepost)
+);
+
+CREATE TABLE IF NOT EXISTS deactivated_linker (
+id int NOT NULL,
+language_id int DEFAULT NULL,
+author_id int NOT NULL,
+category_id int DEFAULT NULL,
+thread_id int NOT NULL,
+title TEXT,
+url TEXT,
+description TEXT,
+listed TIMESTAMP,
+timezone TEXT DEFAULT NULL,
+deleted_at TIMESTAMP DEFAULT NULL,
+restored_at TIMESTAMP DEFAULT NULL,
+PRIMARY KEY (id)
+);
+
+CREATE TABLE IF NOT EXISTS uploaded_images (
+iduploadedimage SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+path TEXT,
+width int DEFAULT NULL,
+height int DEFAULT NULL,
+file_size int NOT NULL,
+uploaded TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS thread_images (
+idthread_image SERIAL PRIMARY KEY,
+forumthread_id int NOT NULL,
+path TEXT,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS admin_user_comments (
+id SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+comment TEXT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS admin_request_queue (
+id SERIAL PRIMARY KEY,
+users_idusers int NOT NULL,
+change_table TEXT NOT NULL,
+change_field TEXT NOT NULL,
+change_row_id int NOT NULL,
+change_value TEXT,
+contact_options TEXT,
+status TEXT NOT NULL DEFAULT 'pending',
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+acted_at TIMESTAMP DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS admin_request_comments (
+id SERIAL PRIMARY KEY,
+request_id int NOT NULL,
+comment TEXT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS external_links (
+id SERIAL PRIMARY KEY,
+url TEXT NOT NULL,
+url_hash BYTEA GENERATED ALWAYS AS (sha256(convert_to(url, 'UTF8'))) STORED NOT NULL,
+clicks int NOT NULL DEFAULT 0,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_by int DEFAULT NULL,
+card_title TEXT,
+card_description TEXT,
+card_image TEXT,
+card_image_cache TEXT,
+favicon_cache TEXT,
+card_duration TEXT,
+card_upload_date TEXT,
+card_author TEXT,
+UNIQUE (url_hash)
+);
+
+CREATE TABLE content_public_labels (
+id SERIAL PRIMARY KEY,
+item TEXT NOT NULL,
+item_id int NOT NULL,
+label TEXT NOT NULL,
+UNIQUE (item,item_id,label)
+);
+
+CREATE TABLE content_private_labels (
+id SERIAL PRIMARY KEY,
+item TEXT NOT NULL,
+item_id int NOT NULL,
+user_id int NOT NULL,
+label TEXT NOT NULL,
+invert BOOLEAN NOT NULL DEFAULT FALSE,
+UNIQUE (item,item_id,user_id,label)
+);
+
+CREATE TABLE content_label_status (
+id SERIAL PRIMARY KEY,
+item TEXT NOT NULL,
+item_id int NOT NULL,
+label TEXT NOT NULL,
+UNIQUE (item,item_id,label)
+);
+
+CREATE TABLE content_read_markers (
+id SERIAL PRIMARY KEY,
+item TEXT NOT NULL,
+item_id int NOT NULL,
+user_id int NOT NULL,
+last_comment_id int NOT NULL,
+UNIQUE (item,item_id,user_id)
+);
+
+CREATE TABLE role_subscription_archetypes (
+id SERIAL PRIMARY KEY,
+role_id int NOT NULL,
+archetype_name TEXT NOT NULL,
+pattern TEXT NOT NULL,
+method TEXT NOT NULL DEFAULT 'internal',
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE scheduler_state (
+task_name TEXT NOT NULL PRIMARY KEY,
+last_run_at TIMESTAMP DEFAULT NULL,
+metadata TEXT DEFAULT NULL
+);
+
+CREATE TABLE IF NOT EXISTS image_cache_entries (
+id TEXT NOT NULL,
+source_url TEXT DEFAULT NULL,
+source_kind TEXT NOT NULL DEFAULT 'unknown',
+status TEXT NOT NULL DEFAULT 'ready',
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+last_used_at TIMESTAMP DEFAULT NULL,
+fetched_at TIMESTAMP DEFAULT NULL,
+expires_at TIMESTAMP DEFAULT NULL,
+content_expires_at TIMESTAMP DEFAULT NULL,
+content_type TEXT DEFAULT NULL,
+size_bytes bigint DEFAULT NULL,
+width int DEFAULT NULL,
+height int DEFAULT NULL,
+checksum TEXT DEFAULT NULL,
+thumbnail_id TEXT DEFAULT NULL,
+uploaded_image_id int DEFAULT NULL,
+error_message TEXT DEFAULT NULL,
+retry_count int NOT NULL DEFAULT 0,
+last_attempt_at TIMESTAMP DEFAULT NULL,
+next_attempt_at TIMESTAMP DEFAULT NULL,
+PRIMARY KEY (id)
+);
+
+CREATE TABLE user_passkeys (
+id SERIAL PRIMARY KEY,
+user_id INT NOT NULL,
+name TEXT NOT NULL,
+backup_eligible BOOLEAN DEFAULT NULL,
+backup_state BOOLEAN DEFAULT NULL,
+credential_id BYTEA NOT NULL,
+public_key BYTEA NOT NULL,
+attestation_type TEXT NOT NULL,
+aaguid BYTEA NOT NULL,
+sign_count INT NOT NULL DEFAULT 0,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+last_used_at TIMESTAMP DEFAULT NULL,
+expires_at TIMESTAMP DEFAULT NULL,
+UNIQUE (credential_id)
+);
+
+CREATE TABLE user_totp (
+users_idusers INTEGER PRIMARY KEY,
+secret TEXT NOT NULL,
+enabled_at TIMESTAMP DEFAULT NULL,
+last_used_step BIGINT NOT NULL DEFAULT 0,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE user_recovery_codes (
+id SERIAL PRIMARY KEY,
+users_idusers INT NOT NULL,
+code_hash TEXT NOT NULL,
+used_at TIMESTAMP DEFAULT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS user_recovery_codes_user_idx ON user_recovery_codes (users_idusers);
+
+CREATE TABLE webhooks (
+id SERIAL PRIMARY KEY,
+name TEXT NOT NULL,
+url TEXT NOT NULL,
+secret TEXT NOT NULL,
+patterns TEXT NOT NULL,
+active INTEGER NOT NULL DEFAULT 1,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE webhook_deliveries (
+id SERIAL PRIMARY KEY,
+webhook_id INT NOT NULL,
+event TEXT NOT NULL,
+path TEXT NOT NULL,
+payload TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+attempts INT NOT NULL DEFAULT 0,
+response_status INT DEFAULT NULL,
+error TEXT DEFAULT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at TIMESTAMP DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id);
+
+CREATE TABLE reactions (
+id SERIAL PRIMARY KEY,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+users_idusers INT NOT NULL,
+reaction TEXT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+UNIQUE (item_type, item_id, users_idusers, reaction)
+);
+CREATE INDEX IF NOT EXISTS reactions_item_idx ON reactions (item_type, item_id);
+
+CREATE TABLE forum_polls (
+id SERIAL PRIMARY KEY,
+forumthread_id INT NOT NULL UNIQUE,
+question TEXT NOT NULL,
+multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+anonymous BOOLEAN NOT NULL DEFAULT TRUE,
+results_visibility TEXT NOT NULL DEFAULT 'always',
+closes_at TIMESTAMP DEFAULT NULL,
+closed_at TIMESTAMP DEFAULT NULL,
+created_by INT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS forum_polls_closing_idx ON forum_polls (closed_at, closes_at);
+
+CREATE TABLE forum_poll_options (
+id SERIAL PRIMARY KEY,
+poll_id INT NOT NULL,
+position INT NOT NULL DEFAULT 0,
+label TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS forum_poll_options_poll_idx ON forum_poll_options (poll_id, position);
+
+CREATE TABLE forum_poll_votes (
+id SERIAL PRIMARY KEY,
+poll_id INT NOT NULL,
+option_id INT NOT NULL,
+users_idusers INT NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+UNIQUE (option_id, users_idusers)
+);
+CREATE INDEX IF NOT EXISTS forum_poll_votes_poll_user_idx ON forum_poll_votes (poll_id, users_idusers);
+
+CREATE TABLE content_reports (
+id SERIAL PRIMARY KEY,
+reporter_id INT NOT NULL,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+author_id INT NOT NULL,
+reason TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+resolution_note TEXT,
+resolved_by INT DEFAULT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+resolved_at TIMESTAMP DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS content_reports_status_idx ON content_reports (status, created_at);
+CREATE INDEX IF NOT EXISTS content_reports_item_idx ON content_reports (item_type, item_id);
+CREATE INDEX IF NOT EXISTS content_reports_reporter_idx ON content_reports (reporter_id);
+
+CREATE TABLE IF NOT EXISTS schema_version (
+version INTEGER NOT NULL
+);
+INSERT INTO schema_version (version) VALUES (102);
+
+-- +goose Down
+DROP TABLE IF EXISTS schema_version CASCADE;
+DROP TABLE IF EXISTS content_reports CASCADE;
+DROP TABLE IF EXISTS forum_poll_votes CASCADE;
+DROP TABLE IF EXISTS forum_poll_options CASCADE;
+DROP TABLE IF EXISTS forum_polls CASCADE;
+DROP TABLE IF EXISTS reactions CASCADE;
+DROP TABLE IF EXISTS webhook_deliveries CASCADE;
+DROP TABLE IF EXISTS webhooks CASCADE;
+DROP TABLE IF EXISTS user_recovery_codes CASCADE;
+DROP TABLE IF EXISTS user_totp CASCADE;
+DROP TABLE IF EXISTS user_passkeys CASCADE;
+DROP TABLE IF EXISTS image_cache_entries CASCADE;
+DROP TABLE IF EXISTS scheduler_state CASCADE;
+DROP TABLE IF EXISTS role_subscription_archetypes CASCADE;
+DROP TABLE IF EXISTS content_read_markers CASCADE;
+DROP TABLE IF EXISTS content_label_status CASCADE;
+DROP TABLE IF EXISTS content_private_labels CASCADE;
+DROP TABLE IF EXISTS content_public_labels CASCADE;
+DROP TABLE IF EXISTS external_links CASCADE;
+DROP TABLE IF EXISTS admin_request_comments CASCADE;
+DROP TABLE IF EXISTS admin_request_queue CASCADE;
+DROP TABLE IF EXISTS admin_user_comments CASCADE;
+DROP TABLE IF EXISTS thread_images CASCADE;
+DROP TABLE IF EXISTS uploaded_images CASCADE;
+DROP TABLE IF EXISTS deactivated_linker CASCADE;
+DROP TABLE IF EXISTS deactivated_imageposts CASCADE;
+DROP TABLE IF EXISTS deactivated_blogs CASCADE;
+DROP TABLE IF EXISTS deactivated_writings CASCADE;
+DROP TABLE IF EXISTS deactivated_comments CASCADE;
+DROP TABLE IF EXISTS deactivated_users CASCADE;
+DROP TABLE IF EXISTS audit_log CASCADE;
+DROP TABLE IF EXISTS template_overrides CASCADE;
+DROP TABLE IF EXISTS banned_ips CASCADE;
+DROP TABLE IF EXISTS login_attempts CASCADE;
+DROP TABLE IF EXISTS site_announcements CASCADE;
+DROP TABLE IF EXISTS sessions CASCADE;
+DROP TABLE IF EXISTS dead_letters CASCADE;
+DROP TABLE IF EXISTS notifications CASCADE;
+DROP TABLE IF EXISTS pending_passwords CASCADE;
+DROP TABLE IF EXISTS pending_emails CASCADE;
+DROP TABLE IF EXISTS subscriptions CASCADE;
+DROP TABLE IF EXISTS api_keys CASCADE;
+DROP TABLE IF EXISTS writing_search CASCADE;
+DROP TABLE IF EXISTS writing_category CASCADE;
+DROP TABLE IF EXISTS writing CASCADE;
+DROP TABLE IF EXISTS user_emails CASCADE;
+DROP TABLE IF EXISTS passwords CASCADE;
+DROP TABLE IF EXISTS users CASCADE;
+DROP TABLE IF EXISTS user_language CASCADE;
+DROP TABLE IF EXISTS site_news_search CASCADE;
+DROP TABLE IF EXISTS site_news CASCADE;
+DROP TABLE IF EXISTS searchwordlist_has_linker CASCADE;
+DROP TABLE IF EXISTS searchwordlist CASCADE;
+DROP TABLE IF EXISTS preferences CASCADE;
+DROP TABLE IF EXISTS grants CASCADE;
+DROP TABLE IF EXISTS user_roles CASCADE;
+DROP TABLE IF EXISTS roles CASCADE;
+DROP TABLE IF EXISTS linker_search CASCADE;
+DROP TABLE IF EXISTS linker_queue CASCADE;
+DROP TABLE IF EXISTS linker_category CASCADE;
+DROP TABLE IF EXISTS linker CASCADE;
+DROP TABLE IF EXISTS language CASCADE;
+DROP TABLE IF EXISTS imagepost_search CASCADE;
+DROP TABLE IF EXISTS imagepost CASCADE;
+DROP TABLE IF EXISTS imageboard CASCADE;
+DROP TABLE IF EXISTS forumtopic CASCADE;
+DROP TABLE IF EXISTS forumthread CASCADE;
+DROP TABLE IF EXISTS forumcategory CASCADE;
+DROP TABLE IF EXISTS faq_revisions CASCADE;
+DROP TABLE IF EXISTS content_revisions CASCADE;
+DROP TABLE IF EXISTS faq_categories CASCADE;
+DROP TABLE IF EXISTS faq CASCADE;
+DROP TABLE IF EXISTS comments_search CASCADE;
+DROP TABLE IF EXISTS comments CASCADE;
+DROP TABLE IF EXISTS bookmarks CASCADE;
+DROP TABLE IF EXISTS blogs_search CASCADE;
+DROP TABLE IF EXISTS blogs CASCADE;
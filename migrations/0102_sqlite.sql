@@ -0,0 +1,24 @@
+-- +goose Up
+-- User reports of abusive content awaiting moderation.
+CREATE TABLE IF NOT EXISTS content_reports (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+reporter_id INT NOT NULL,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+author_id INT NOT NULL,
+reason TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+resolution_note TEXT,
+resolved_by INT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+resolved_at DATETIME DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS content_reports_status_idx ON content_reports (status, created_at);
+CREATE INDEX IF NOT EXISTS content_reports_item_idx ON content_reports (item_type, item_id);
+CREATE INDEX IF NOT EXISTS content_reports_reporter_idx ON content_reports (reporter_id);
+
+UPDATE schema_version SET version = 102;
+
+-- +goose Down
+DROP TABLE IF EXISTS content_reports;
+UPDATE schema_version SET version = 101;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- News posts, blog entries and writings waiting to go live at a set time.
+CREATE TABLE IF NOT EXISTS `scheduled_publications` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `item_type` varchar(32) NOT NULL,
+  `item_id` int NOT NULL,
+  `author_id` int NOT NULL,
+  `publish_at` datetime NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `scheduled_publications_item_idx` (`item_type`, `item_id`),
+  KEY `scheduled_publications_publish_at_idx` (`publish_at`)
+);
+
+UPDATE schema_version SET version = 103;
+
+-- +goose Down
+DROP TABLE IF EXISTS `scheduled_publications`;
+UPDATE schema_version SET version = 102;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- News posts, blog entries and writings waiting to go live at a set time.
+CREATE TABLE IF NOT EXISTS scheduled_publications (
+id SERIAL PRIMARY KEY,
+item_type TEXT NOT NULL,
+item_id INT NOT NULL,
+author_id INT NOT NULL,
+publish_at TIMESTAMP NOT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS scheduled_publications_item_idx ON scheduled_publications (item_type, item_id);
+CREATE INDEX IF NOT EXISTS scheduled_publications_publish_at_idx ON scheduled_publications (publish_at);
+
+UPDATE schema_version SET version = 103;
+
+-- +goose Down
+DROP TABLE IF EXISTS scheduled_publications;
+UPDATE schema_version SET version = 102;
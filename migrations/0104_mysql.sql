@@ -0,0 +1,19 @@
+-- +goose Up
+-- Autosaved editor contents, one per user and form.
+CREATE TABLE IF NOT EXISTS `drafts` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `target` varchar(128) NOT NULL,
+  `url` varchar(255) NOT NULL,
+  `content` mediumtext NOT NULL,
+  `updated_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `drafts_user_target_idx` (`user_id`, `target`),
+  KEY `drafts_updated_at_idx` (`updated_at`)
+);
+
+UPDATE schema_version SET version = 104;
+
+-- +goose Down
+DROP TABLE IF EXISTS `drafts`;
+UPDATE schema_version SET version = 103;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Autosaved editor contents, one per user and form.
+CREATE TABLE IF NOT EXISTS drafts (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+target TEXT NOT NULL,
+url TEXT NOT NULL,
+content TEXT NOT NULL,
+updated_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS drafts_user_target_idx ON drafts (user_id, target);
+CREATE INDEX IF NOT EXISTS drafts_updated_at_idx ON drafts (updated_at);
+
+UPDATE schema_version SET version = 104;
+
+-- +goose Down
+DROP TABLE IF EXISTS drafts;
+UPDATE schema_version SET version = 103;
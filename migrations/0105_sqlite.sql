@@ -0,0 +1,9 @@
+-- +goose Up
+-- Lets users stop @username mentions from notifying them.
+ALTER TABLE preferences ADD COLUMN mute_mentions INTEGER NOT NULL DEFAULT 0;
+
+UPDATE schema_version SET version = 105;
+
+-- +goose Down
+ALTER TABLE preferences DROP COLUMN mute_mentions;
+UPDATE schema_version SET version = 104;
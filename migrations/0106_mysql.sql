@@ -0,0 +1,56 @@
+-- +goose Up
+-- ActivityPub actor keys, remote followers, the outbox and queued deliveries.
+CREATE TABLE IF NOT EXISTS `activitypub_actor_keys` (
+  `user_id` int NOT NULL,
+  `public_key_pem` text NOT NULL,
+  `private_key_pem` text NOT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`user_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `activitypub_followers` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `actor_url` varchar(512) NOT NULL,
+  `inbox_url` varchar(512) NOT NULL,
+  `shared_inbox_url` varchar(512) DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `activitypub_followers_user_actor_idx` (`user_id`, `actor_url`)
+);
+
+CREATE TABLE IF NOT EXISTS `activitypub_activities` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `activity_type` varchar(32) NOT NULL,
+  `object_url` varchar(512) NOT NULL,
+  `object_json` mediumtext NOT NULL,
+  `published` datetime NOT NULL,
+  PRIMARY KEY (`id`),
+  KEY `activitypub_activities_user_idx` (`user_id`, `id`),
+  KEY `activitypub_activities_object_idx` (`object_url`)
+);
+
+CREATE TABLE IF NOT EXISTS `activitypub_deliveries` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_id` int NOT NULL,
+  `inbox_url` varchar(512) NOT NULL,
+  `payload` mediumtext NOT NULL,
+  `status` varchar(16) NOT NULL DEFAULT 'pending',
+  `attempts` int NOT NULL DEFAULT 0,
+  `next_attempt_at` datetime NOT NULL,
+  `error` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_at` datetime DEFAULT NULL,
+  PRIMARY KEY (`id`),
+  KEY `activitypub_deliveries_due_idx` (`status`, `next_attempt_at`)
+);
+
+UPDATE schema_version SET version = 106;
+
+-- +goose Down
+DROP TABLE IF EXISTS `activitypub_deliveries`;
+DROP TABLE IF EXISTS `activitypub_activities`;
+DROP TABLE IF EXISTS `activitypub_followers`;
+DROP TABLE IF EXISTS `activitypub_actor_keys`;
+UPDATE schema_version SET version = 105;
@@ -0,0 +1,52 @@
+-- +goose Up
+-- ActivityPub actor keys, remote followers, the outbox and queued deliveries.
+CREATE TABLE IF NOT EXISTS activitypub_actor_keys (
+user_id INT PRIMARY KEY,
+public_key_pem TEXT NOT NULL,
+private_key_pem TEXT NOT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS activitypub_followers (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+actor_url TEXT NOT NULL,
+inbox_url TEXT NOT NULL,
+shared_inbox_url TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS activitypub_followers_user_actor_idx ON activitypub_followers (user_id, actor_url);
+
+CREATE TABLE IF NOT EXISTS activitypub_activities (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+activity_type TEXT NOT NULL,
+object_url TEXT NOT NULL,
+object_json TEXT NOT NULL,
+published DATETIME NOT NULL
+);
+CREATE INDEX IF NOT EXISTS activitypub_activities_user_idx ON activitypub_activities (user_id, id);
+CREATE INDEX IF NOT EXISTS activitypub_activities_object_idx ON activitypub_activities (object_url);
+
+CREATE TABLE IF NOT EXISTS activitypub_deliveries (
+id INTEGER PRIMARY KEY AUTOINCREMENT,
+user_id INT NOT NULL,
+inbox_url TEXT NOT NULL,
+payload TEXT NOT NULL,
+status TEXT NOT NULL DEFAULT 'pending',
+attempts INT NOT NULL DEFAULT 0,
+next_attempt_at DATETIME NOT NULL,
+error TEXT DEFAULT NULL,
+created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+updated_at DATETIME DEFAULT NULL
+);
+CREATE INDEX IF NOT EXISTS activitypub_deliveries_due_idx ON activitypub_deliveries (status, next_attempt_at);
+
+UPDATE schema_version SET version = 106;
+
+-- +goose Down
+DROP TABLE IF EXISTS activitypub_deliveries;
+DROP TABLE IF EXISTS activitypub_activities;
+DROP TABLE IF EXISTS activitypub_followers;
+DROP TABLE IF EXISTS activitypub_actor_keys;
+UPDATE schema_version SET version = 105;
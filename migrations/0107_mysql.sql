@@ -0,0 +1,31 @@
+-- +goose Up
+-- Delivery problems reported for user email addresses and the addresses no
+-- longer mailed because of them.
+CREATE TABLE IF NOT EXISTS `email_bounces` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `user_email_id` int NOT NULL,
+  `kind` varchar(16) NOT NULL,
+  `source` varchar(16) NOT NULL,
+  `detail` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  KEY `email_bounces_user_email_idx` (`user_email_id`)
+);
+
+CREATE TABLE IF NOT EXISTS `email_suppressions` (
+  `id` int NOT NULL AUTO_INCREMENT,
+  `email` varchar(255) NOT NULL,
+  `user_id` int DEFAULT NULL,
+  `reason` varchar(16) NOT NULL,
+  `detail` text DEFAULT NULL,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `email_suppressions_email_idx` (`email`)
+);
+
+UPDATE schema_version SET version = 107;
+
+-- +goose Down
+DROP TABLE IF EXISTS `email_suppressions`;
+DROP TABLE IF EXISTS `email_bounces`;
+UPDATE schema_version SET version = 106;
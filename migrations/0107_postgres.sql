@@ -0,0 +1,29 @@
+-- +goose Up
+-- Delivery problems reported for user email addresses and the addresses no
+-- longer mailed because of them.
+CREATE TABLE IF NOT EXISTS email_bounces (
+id SERIAL PRIMARY KEY,
+user_email_id INT NOT NULL,
+kind TEXT NOT NULL,
+source TEXT NOT NULL,
+detail TEXT DEFAULT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS email_bounces_user_email_idx ON email_bounces (user_email_id);
+
+CREATE TABLE IF NOT EXISTS email_suppressions (
+id SERIAL PRIMARY KEY,
+email TEXT NOT NULL,
+user_id INT DEFAULT NULL,
+reason TEXT NOT NULL,
+detail TEXT DEFAULT NULL,
+created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS email_suppressions_email_idx ON email_suppressions (email);
+
+UPDATE schema_version SET version = 107;
+
+-- +goose Down
+DROP TABLE IF EXISTS email_suppressions;
+DROP TABLE IF EXISTS email_bounces;
+UPDATE schema_version SET version = 106;
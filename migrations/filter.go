@@ -8,8 +8,11 @@ import (
 
 // FilterFS returns an fs.FS that only exposes migration files matching the specified driver.
 func FilterFS(base fs.FS, driver string) fs.FS {
-	if driver == "sqlite3" {
+	switch driver {
+	case "sqlite3":
 		driver = "sqlite"
+	case "pgx":
+		driver = "postgres"
 	}
 	return &driverFilteredFS{
 		base:   base,
@@ -27,16 +30,22 @@ type driverFilteredFS struct {
 	driver string
 }
 
+// migrationDialects lists the driver suffixes used in migration file names.
+var migrationDialects = []string{"mysql", "sqlite", "postgres"}
+
 func (d *driverFilteredFS) isApplicable(name string) bool {
+	return applicable(name, d.driver)
+}
+
+func applicable(name, driver string) bool {
 	if !strings.HasSuffix(name, ".sql") {
 		return true
 	}
 	base := strings.TrimSuffix(path.Base(name), ".sql")
-	if strings.HasSuffix(base, "_mysql") {
-		return d.driver == "mysql"
-	}
-	if strings.HasSuffix(base, "_sqlite") {
-		return d.driver == "sqlite"
+	for _, dialect := range migrationDialects {
+		if strings.HasSuffix(base, "_"+dialect) {
+			return driver == dialect
+		}
 	}
 	return true
 }
@@ -96,21 +105,7 @@ func (f *filteredFile) ReadDir(n int) ([]fs.DirEntry, error) {
 	}
 	var filtered []fs.DirEntry
 	for _, entry := range entries {
-		name := entry.Name()
-		if !strings.HasSuffix(name, ".sql") {
-			filtered = append(filtered, entry)
-			continue
-		}
-		base := strings.TrimSuffix(path.Base(name), ".sql")
-		if strings.HasSuffix(base, "_mysql") {
-			if f.driver == "mysql" {
-				filtered = append(filtered, entry)
-			}
-		} else if strings.HasSuffix(base, "_sqlite") {
-			if f.driver == "sqlite" {
-				filtered = append(filtered, entry)
-			}
-		} else {
+		if applicable(entry.Name(), f.driver) {
 			filtered = append(filtered, entry)
 		}
 	}
@@ -0,0 +1,58 @@
+package migrations
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFilterFSPostgres(t *testing.T) {
+	base := fstest.MapFS{
+		"0101_mysql.sql":    {Data: []byte("-- mysql")},
+		"0101_sqlite.sql":   {Data: []byte("-- sqlite")},
+		"0102_mysql.sql":    {Data: []byte("-- mysql")},
+		"0102_sqlite.sql":   {Data: []byte("-- sqlite")},
+		"0102_postgres.sql": {Data: []byte("-- postgres")},
+	}
+	for _, driver := range []string{"postgres", "pgx"} {
+		entries, err := fs.ReadDir(FilterFS(base, driver), ".")
+		if err != nil {
+			t.Fatalf("%s: ReadDir: %v", driver, err)
+		}
+		if len(entries) != 1 || entries[0].Name() != "0102_postgres.sql" {
+			t.Fatalf("%s: unexpected entries %v", driver, entries)
+		}
+	}
+	entries, err := fs.ReadDir(FilterFS(base, "mysql"), ".")
+	if err != nil {
+		t.Fatalf("mysql: ReadDir: %v", err)
+	}
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), "_mysql.sql") {
+			t.Errorf("mysql: unexpected entry %s", e.Name())
+		}
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	base := fstest.MapFS{
+		"0101_mysql.sql":    {Data: []byte("-- mysql")},
+		"0102_mysql.sql":    {Data: []byte("-- mysql")},
+		"0103_mysql.sql":    {Data: []byte("-- mysql")},
+		"0102_postgres.sql": {Data: []byte("-- postgres")},
+		"embed.go":          {Data: []byte("package migrations")},
+	}
+	for driver, want := range map[string]int64{"mysql": 103, "postgres": 102} {
+		got, err := LatestVersion(FilterFS(base, driver))
+		if err != nil {
+			t.Fatalf("%s: %v", driver, err)
+		}
+		if got != want {
+			t.Errorf("%s: got %d want %d", driver, got, want)
+		}
+	}
+	if _, err := LatestVersion(FilterFS(base, "sqlite")); err == nil {
+		t.Error("expected error without sqlite migrations")
+	}
+}
@@ -0,0 +1,74 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+)
+
+func TestPostgresMigrationsApply(t *testing.T) {
+	dsn := os.Getenv("GOA4WEB_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("set GOA4WEB_TEST_POSTGRES_DSN to run PostgreSQL migration tests")
+	}
+	db := openTemporaryPostgresSchema(t, dsn)
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, FilterFS(FS, "postgres"))
+	if err != nil {
+		t.Fatalf("create goose provider: %v", err)
+	}
+	if _, err := provider.Up(t.Context()); err != nil {
+		t.Fatalf("apply migrations: %v", err)
+	}
+	want, err := provider.GetDBVersion(t.Context())
+	if err != nil {
+		t.Fatalf("read goose version: %v", err)
+	}
+	var version int64
+	if err := db.QueryRow(`SELECT version FROM schema_version LIMIT 1`).Scan(&version); err != nil {
+		t.Fatalf("read schema version: %v", err)
+	}
+	if version != want {
+		t.Fatalf("schema_version = %d, goose version = %d", version, want)
+	}
+
+	if _, err := provider.DownTo(t.Context(), 0); err != nil {
+		t.Fatalf("roll back migrations: %v", err)
+	}
+	var tables int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM pg_catalog.pg_tables WHERE schemaname = current_schema() AND tablename <> 'goose_db_version'`).Scan(&tables); err != nil {
+		t.Fatalf("count tables: %v", err)
+	}
+	if tables != 0 {
+		t.Fatalf("%d tables left after rolling back", tables)
+	}
+}
+
+func openTemporaryPostgresSchema(t *testing.T, dsn string) *sql.DB {
+	t.Helper()
+	cfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("parse PostgreSQL DSN: %v", err)
+	}
+	schemaName := fmt.Sprintf("goa4web_migration_%d", time.Now().UnixNano())
+	adminDB := stdlib.OpenDB(*cfg.Copy())
+	if _, err := adminDB.Exec(`CREATE SCHEMA "` + schemaName + `"`); err != nil {
+		_ = adminDB.Close()
+		t.Fatalf("create temporary schema: %v", err)
+	}
+	t.Cleanup(func() {
+		_, _ = adminDB.Exec(`DROP SCHEMA "` + schemaName + `" CASCADE`)
+		_ = adminDB.Close()
+	})
+	testConfig := cfg.Copy()
+	testConfig.RuntimeParams["search_path"] = schemaName
+	db := stdlib.OpenDB(*testConfig)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
@@ -23,14 +23,15 @@ func TestMigrationFileNaming(t *testing.T) {
 
 	// Regex to match NNNN_<driver>.sql
 	// NNNN is 4 digits
-	validNameStrict := regexp.MustCompile(`^\d{4}_(?:mysql|sqlite)\.sql$`)
+	validNameStrict := regexp.MustCompile(`^\d{4}_(?:mysql|sqlite|postgres)\.sql$`)
 
 	// Regex for files with descriptions (temporarily disallowed)
 	validNameDesc := regexp.MustCompile(`^\d{4}_[a-zA-Z0-9_]+\.sql$`)
 
 	mysqlVersions := make(map[int]string)
 	sqliteVersions := make(map[int]string)
-	var maxMySQL, maxSQLite int
+	postgresVersions := make(map[int]string)
+	var maxMySQL, maxSQLite, maxPostgres int
 
 	for _, entry := range entries {
 		if entry.IsDir() {
@@ -43,12 +44,12 @@ func TestMigrationFileNaming(t *testing.T) {
 
 		// Check for description usage (disallowed for now)
 		if validNameDesc.MatchString(name) && !validNameStrict.MatchString(name) {
-			t.Errorf("Migration file %s uses a description which is currently disabled. Use format NNNN_mysql.sql or NNNN_sqlite.sql", name)
+			t.Errorf("Migration file %s uses a description which is currently disabled. Use format NNNN_mysql.sql, NNNN_sqlite.sql or NNNN_postgres.sql", name)
 		}
 
 		// Validate naming convention
 		if !validNameStrict.MatchString(name) {
-			t.Errorf("Migration file %s does not match naming convention NNNN_mysql.sql or NNNN_sqlite.sql", name)
+			t.Errorf("Migration file %s does not match naming convention NNNN_mysql.sql, NNNN_sqlite.sql or NNNN_postgres.sql", name)
 		}
 
 		// Extract version to track independently
@@ -72,6 +73,14 @@ func TestMigrationFileNaming(t *testing.T) {
 					if version > maxSQLite {
 						maxSQLite = version
 					}
+				} else if strings.HasSuffix(name, "_postgres.sql") {
+					if existingFile, exists := postgresVersions[version]; exists {
+						t.Errorf("Duplicate PostgreSQL migration version %d found: %s and %s", version, existingFile, name)
+					}
+					postgresVersions[version] = name
+					if version > maxPostgres {
+						maxPostgres = version
+					}
 				}
 			}
 		}
@@ -90,8 +99,27 @@ func TestMigrationFileNaming(t *testing.T) {
 			t.Errorf("Missing SQLite migration for version %04d", v)
 		}
 	}
+
+	// PostgreSQL starts from a baseline at postgresBaselineVersion.
+	if maxPostgres != maxMySQL {
+		t.Errorf("Migration version mismatch: max MySQL version is %d, max PostgreSQL version is %d", maxMySQL, maxPostgres)
+	}
+	for v := postgresBaselineVersion; v <= maxMySQL; v++ {
+		if _, ok := postgresVersions[v]; !ok {
+			t.Errorf("Missing PostgreSQL migration for version %04d", v)
+		}
+	}
+	for v := range postgresVersions {
+		if v < postgresBaselineVersion {
+			t.Errorf("PostgreSQL migration %04d predates the %04d baseline", v, postgresBaselineVersion)
+		}
+	}
 }
 
+// postgresBaselineVersion is the first migration shipped for PostgreSQL. It
+// creates the whole schema as of that version.
+const postgresBaselineVersion = 102
+
 func TestSchemaVersionUpdated(t *testing.T) {
 	entries, err := FS.ReadDir(".")
 	if err != nil {
@@ -114,7 +142,7 @@ func TestSchemaVersionUpdated(t *testing.T) {
 		t.Skip("No migrations found")
 	}
 
-	for _, schemaFile := range []string{"schema.mysql.sql", "schema.sqlite.sql"} {
+	for schemaFile, applied := range map[string]string{"schema.mysql.sql": "1", "schema.sqlite.sql": "1", "schema.postgres.sql": "true"} {
 		schemaPath := filepath.Join("..", "database", schemaFile)
 		content, err := os.ReadFile(schemaPath)
 		if err != nil {
@@ -122,7 +150,7 @@ func TestSchemaVersionUpdated(t *testing.T) {
 		}
 
 		schemaStr := string(content)
-		expected := fmt.Sprintf("(%d, 1)", maxVersion)
+		expected := fmt.Sprintf("(%d, %s)", maxVersion, applied)
 		if !strings.Contains(schemaStr, expected) || !strings.Contains(schemaStr, "goose_db_version") {
 			t.Errorf("Schema file %s does not contain expected version update for %d", schemaPath, maxVersion)
 		}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// LatestVersion returns the highest migration version in f. Goose takes the
+// version from the digits before the first underscore of each file name.
+func LatestVersion(f fs.FS) (int64, error) {
+	entries, err := fs.ReadDir(f, ".")
+	if err != nil {
+		return 0, err
+	}
+	var latest int64
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".sql" {
+			continue
+		}
+		digits, _, ok := strings.Cut(e.Name(), "_")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseInt(digits, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("migration %s: %w", e.Name(), err)
+		}
+		latest = max(latest, v)
+	}
+	if latest == 0 {
+		return 0, fmt.Errorf("no migrations found")
+	}
+	return latest, nil
+}
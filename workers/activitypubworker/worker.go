@@ -0,0 +1,92 @@
+package activitypubworker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/internal/activitypub"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+// SchedulerTaskName identifies the delivery retry job in the scheduler.
+const SchedulerTaskName = "activitypub_delivery"
+
+// PurgeTaskName identifies the job removing old deliveries.
+const PurgeTaskName = "activitypub_delivery_purge"
+
+// DeliveryRetention is how long finished deliveries are kept.
+const DeliveryRetention = 7 * 24 * time.Hour
+
+// Worker records outbox activities for blog entries and writings changed by
+// successful tasks and queues them for followers. Sending is left to the
+// background task worker.
+func Worker(ctx context.Context, bus *eventbus.Bus, q db.Querier, cfg *config.RuntimeConfig, opts ...common.CoreOption) {
+	if bus == nil || q == nil {
+		return
+	}
+	ch := bus.Subscribe(eventbus.TaskMessageType)
+	for {
+		select {
+		case env, ok := <-ch:
+			if !ok {
+				return
+			}
+			if evt, ok := env.Msg.(eventbus.TaskEvent); ok && evt.Outcome == eventbus.TaskOutcomeSuccess {
+				if data, ok := evt.Data[activitypub.EventKey].(activitypub.EventData); ok {
+					cd := common.NewCoreData(ctx, q, cfg, opts...)
+					if err := activitypub.ForCoreData(cd).Sync(ctx, data.ItemType, data.ItemID); err != nil {
+						log.Printf("activitypub sync %s %d: %v", data.ItemType, data.ItemID, err)
+					} else {
+						publishDeliver(bus)
+					}
+				}
+			}
+			env.Ack()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// DeliverDue asks the background task worker to send deliveries waiting for
+// a retry.
+func DeliverDue(ctx context.Context, q db.Querier, bus *eventbus.Bus, now time.Time) error {
+	rows, err := q.SystemListDueActivityPubDeliveries(ctx, db.SystemListDueActivityPubDeliveriesParams{
+		Now:   now.UTC(),
+		Limit: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("list due deliveries: %w", err)
+	}
+	if len(rows) > 0 {
+		publishDeliver(bus)
+	}
+	return nil
+}
+
+// Purge removes deliveries that finished more than DeliveryRetention ago.
+func Purge(ctx context.Context, q db.Querier, now time.Time) error {
+	n, err := q.SystemPurgeActivityPubDeliveriesBefore(ctx, now.Add(-DeliveryRetention).UTC())
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		log.Printf("purged %d activitypub deliveries", n)
+	}
+	return nil
+}
+
+func publishDeliver(bus *eventbus.Bus) {
+	if bus == nil {
+		return
+	}
+	evt := eventbus.TaskEvent{Task: activitypub.NewDeliverTask(), Time: time.Now()}
+	if err := bus.Publish(evt); err != nil && err != eventbus.ErrBusClosed {
+		log.Printf("activitypub deliver publish: %v", err)
+	}
+}
@@ -2,6 +2,8 @@ package emailqueue
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"net/mail"
@@ -13,6 +15,7 @@ import (
 	"github.com/arran4/goa4web/internal/dlq"
 	"github.com/arran4/goa4web/internal/email"
 	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/metrics"
 )
 
 // StartEventListener listens for new messages queued by listening for EmailQueueEvent messages.
@@ -70,6 +73,7 @@ func isAdminEmail(ctx context.Context, q db.Querier, cfg *config.RuntimeConfig,
 
 // canSendToAddress checks if the email address is valid for sending.
 // It returns true if the user is verified OR if the user is unverified but has a valid verification code (verification email).
+// Addresses suppressed after a hard bounce or complaint are never sent to.
 func canSendToAddress(ctx context.Context, q db.Querier, addr string) bool {
 	if q == nil {
 		return false
@@ -78,6 +82,9 @@ func canSendToAddress(ctx context.Context, q db.Querier, addr string) bool {
 	if err != nil {
 		return false
 	}
+	if _, err := q.GetEmailSuppressionByEmail(ctx, addr); !errors.Is(err, sql.ErrNoRows) {
+		return false
+	}
 	if ue.VerifiedAt.Valid {
 		return true
 	}
@@ -148,6 +155,7 @@ func ProcessPendingEmail(ctx context.Context, q db.Querier, provider email.Provi
 	addr, err := ResolveQueuedEmailAddress(ctx, q, cfg, e)
 	if err != nil {
 		log.Printf("ResolveQueuedEmailAddress: %v", err)
+		metrics.EmailSendFailures.Inc("address")
 		if err := q.SystemIncrementPendingEmailError(ctx, e.ID); err != nil {
 			log.Printf("increment email error: %v", err)
 		}
@@ -156,6 +164,7 @@ func ProcessPendingEmail(ctx context.Context, q db.Querier, provider email.Provi
 	const dlqThreshold = 4 * 24 * time.Hour
 	if provider == nil {
 		log.Printf("email provider not configured: cannot send email %d to %s", e.ID, addr.Address)
+		metrics.EmailSendFailures.Inc("no_provider")
 		if err := q.SystemIncrementPendingEmailError(ctx, e.ID); err != nil {
 			log.Printf("increment email error: %v", err)
 		}
@@ -172,6 +181,7 @@ func ProcessPendingEmail(ctx context.Context, q db.Querier, provider email.Provi
 	}
 	if err := provider.Send(ctx, addr, []byte(e.Body)); err != nil {
 		log.Printf("send queued mail: %v", err)
+		metrics.EmailSendFailures.Inc("provider_error")
 		if err := q.SystemIncrementPendingEmailError(ctx, e.ID); err != nil {
 			log.Printf("increment email error: %v", err)
 			return true
@@ -187,6 +197,7 @@ func ProcessPendingEmail(ctx context.Context, q db.Querier, provider email.Provi
 		}
 		return true
 	}
+	metrics.EmailSent.Inc()
 	if err := q.SystemMarkPendingEmailSent(ctx, e.ID); err != nil {
 		log.Printf("mark sent: %v", err)
 	}
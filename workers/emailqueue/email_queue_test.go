@@ -169,3 +169,32 @@ func TestResolveQueuedEmailAddress_DirectEmail_UnverifiedUser_Success(t *testing
 		t.Errorf("Expected address %s, got %s", emailAddr, addr.Address)
 	}
 }
+
+func TestResolveQueuedEmailAddress_DirectEmail_SuppressedUser_Fails(t *testing.T) {
+	emailAddr := "bounced@example.com"
+	q := &db.QuerierStub{
+		GetEmailSuppressionByEmailReturns: &db.EmailSuppression{Email: emailAddr, Reason: "hard_bounce"},
+	}
+	cfg := &config.RuntimeConfig{}
+
+	q.GetUserEmailByEmailFn = func(ctx context.Context, email string) (*db.UserEmail, error) {
+		return &db.UserEmail{
+			Email:      email,
+			VerifiedAt: sql.NullTime{Time: time.Now(), Valid: true},
+		}, nil
+	}
+
+	e := &db.SystemListPendingEmailsRow{
+		ID:          1,
+		ToUserID:    sql.NullInt32{Valid: false},
+		Body:        fmt.Sprintf("To: %s\r\nSubject: Test\r\n\r\nBody", emailAddr),
+		DirectEmail: true,
+	}
+
+	if _, err := ResolveQueuedEmailAddress(context.Background(), q, cfg, e); err == nil {
+		t.Fatal("Expected error for suppressed address, got nil")
+	}
+	if len(q.GetEmailSuppressionByEmailCalls) != 1 || q.GetEmailSuppressionByEmailCalls[0] != emailAddr {
+		t.Fatalf("Expected suppression lookup for %s, got %v", emailAddr, q.GetEmailSuppressionByEmailCalls)
+	}
+}
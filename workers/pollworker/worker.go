@@ -0,0 +1,144 @@
+package pollworker
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+)
+
+// SchedulerTaskName identifies the poll closing job in the scheduler.
+const SchedulerTaskName = "forum_poll_close"
+
+// batchSize limits how many polls are closed per scheduler run.
+const batchSize = 50
+
+const (
+	// TaskPollClosed is raised when a forum poll reaches its close time.
+	TaskPollClosed tasks.TaskString = "Poll Closed"
+
+	// replyTask matches the forum's reply task so thread subscribers hear
+	// about closed polls without a separate subscription.
+	replyTask tasks.TaskString = "Reply"
+
+	EmailTemplatePollClosed        notif.EmailTemplateName        = "forumPollClosedEmail"
+	NotificationTemplatePollClosed notif.NotificationTemplateName = "forumPollClosed"
+)
+
+// PollResult is a poll choice and its final tally.
+type PollResult struct {
+	Label string
+	Votes int64
+}
+
+// PollClosedTask notifies thread subscribers that a poll has closed.
+type PollClosedTask struct{ tasks.TaskString }
+
+var pollClosedTask = &PollClosedTask{TaskString: TaskPollClosed}
+
+var _ tasks.Task = (*PollClosedTask)(nil)
+var _ tasks.TemplatesRequired = (*PollClosedTask)(nil)
+var _ notif.SubscribersNotificationTemplateProvider = (*PollClosedTask)(nil)
+var _ notif.SubscribersTaskProvider = (*PollClosedTask)(nil)
+var _ notif.GrantsRequiredProvider = (*PollClosedTask)(nil)
+
+// Action is unused; the event is raised by the scheduler rather than a form.
+func (PollClosedTask) Action(http.ResponseWriter, *http.Request) any { return nil }
+
+func (PollClosedTask) SubscribersTask(eventbus.TaskEvent) tasks.Name { return replyTask }
+
+func (PollClosedTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return EmailTemplatePollClosed.EmailTemplates(), true
+}
+
+func (PollClosedTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := NotificationTemplatePollClosed.NotificationTemplate()
+	return &s
+}
+
+// GrantsRequired limits private thread notifications to participants.
+func (PollClosedTask) GrantsRequired(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	if handler, _ := evt.Data["Handler"].(string); handler != "private" {
+		return nil, nil
+	}
+	topicID, _ := evt.Data["TopicID"].(int32)
+	threadID, _ := evt.Data["ThreadID"].(int32)
+	return []notif.GrantRequirement{
+		{Section: consts.PermissionSectionPrivateForum, Item: consts.PermissionItemTopic, ItemID: topicID, Action: consts.PermissionActionView},
+		{Section: consts.PermissionSectionPrivateForumThread, Item: consts.PermissionItemThread, ItemID: threadID, Action: consts.PermissionActionView},
+	}, nil
+}
+
+func (PollClosedTask) RequiredTemplates() []tasks.Template {
+	return EmailTemplatePollClosed.RequiredTemplates()
+}
+
+// CloseExpired closes polls whose close time has passed and publishes a
+// TaskPollClosed event for each so thread subscribers are told the result.
+func CloseExpired(ctx context.Context, q db.Querier, cfg *config.RuntimeConfig, bus *eventbus.Bus, now time.Time) error {
+	rows, err := q.SystemListExpiredForumPolls(ctx, db.SystemListExpiredForumPollsParams{
+		Now:   sql.NullTime{Time: now, Valid: true},
+		Limit: batchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("list expired polls: %w", err)
+	}
+	cd := common.NewCoreData(ctx, q, cfg)
+	for _, p := range rows {
+		n, err := q.SystemCloseForumPoll(ctx, db.SystemCloseForumPollParams{
+			ClosedAt: sql.NullTime{Time: now, Valid: true},
+			ID:       p.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("close poll %d: %w", p.ID, err)
+		}
+		if n == 0 {
+			// Closed elsewhere since it was listed.
+			continue
+		}
+		if bus == nil {
+			continue
+		}
+		var results []PollResult
+		options, err := q.ListForumPollOptionsWithVoteCounts(ctx, p.ID)
+		if err != nil {
+			log.Printf("poll %d results: %v", p.ID, err)
+		}
+		for _, o := range options {
+			results = append(results, PollResult{Label: o.Label, Votes: o.Votes})
+		}
+		base := "/forum"
+		if p.Handler == "private" {
+			base = "/private"
+		}
+		path := fmt.Sprintf("%s/topic/%d/thread/%d", base, p.TopicID, p.ForumthreadID)
+		if err := bus.Publish(eventbus.TaskEvent{
+			Path: path,
+			Task: pollClosedTask,
+			Time: now,
+			Data: map[string]any{
+				"PollID":   p.ID,
+				"Question": p.Question,
+				"Results":  results,
+				"TopicID":  p.TopicID,
+				"ThreadID": p.ForumthreadID,
+				"Handler":  p.Handler,
+				"URL":      cd.AbsoluteURL(path),
+			},
+			Outcome: eventbus.TaskOutcomeSuccess,
+		}); err != nil {
+			log.Printf("publish poll %d closed: %v", p.ID, err)
+		}
+	}
+	return nil
+}
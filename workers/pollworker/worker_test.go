@@ -0,0 +1,71 @@
+package pollworker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/templates"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+)
+
+type pollQueries struct {
+	db.Querier
+	expired []*db.SystemListExpiredForumPollsRow
+	closed  []int32
+}
+
+func (q *pollQueries) SystemListExpiredForumPolls(context.Context, db.SystemListExpiredForumPollsParams) ([]*db.SystemListExpiredForumPollsRow, error) {
+	return q.expired, nil
+}
+
+func (q *pollQueries) SystemCloseForumPoll(_ context.Context, arg db.SystemCloseForumPollParams) (int64, error) {
+	q.closed = append(q.closed, arg.ID)
+	return 1, nil
+}
+
+func (q *pollQueries) ListForumPollOptionsWithVoteCounts(context.Context, int32) ([]*db.ListForumPollOptionsWithVoteCountsRow, error) {
+	return []*db.ListForumPollOptionsWithVoteCountsRow{{Label: "Yes", Votes: 3}, {Label: "No", Votes: 1}}, nil
+}
+
+func TestPollClosedTemplatesExist(t *testing.T) {
+	for _, name := range pollClosedTask.RequiredTemplates() {
+		if !name.Exists(templates.WithSilence(true)) {
+			t.Fatalf("missing template: %s", name)
+		}
+	}
+}
+
+func TestCloseExpiredPublishesEvent(t *testing.T) {
+	q := &pollQueries{expired: []*db.SystemListExpiredForumPollsRow{{ID: 7, ForumthreadID: 5, TopicID: 2, Question: "Lunch?", Handler: "private"}}}
+	bus := eventbus.NewBus()
+	ch := bus.Subscribe(eventbus.TaskMessageType)
+
+	if err := CloseExpired(context.Background(), q, config.NewRuntimeConfig(), bus, time.Now()); err != nil {
+		t.Fatalf("CloseExpired: %v", err)
+	}
+	if len(q.closed) != 1 || q.closed[0] != 7 {
+		t.Fatalf("closed=%v", q.closed)
+	}
+	select {
+	case env := <-ch:
+		env.Ack()
+		evt := env.Msg.(eventbus.TaskEvent)
+		if evt.Path != "/private/topic/2/thread/5" {
+			t.Fatalf("path=%q", evt.Path)
+		}
+		if got := pollClosedTask.SubscribersTask(evt); got != replyTask {
+			t.Fatalf("subscribers task=%v", got)
+		}
+		if res, _ := evt.Data["Results"].([]PollResult); len(res) != 2 || res[0].Votes != 3 {
+			t.Fatalf("results=%+v", evt.Data["Results"])
+		}
+		if reqs, _ := pollClosedTask.GrantsRequired(evt); len(reqs) != 2 {
+			t.Fatalf("grants=%+v", reqs)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event published")
+	}
+}
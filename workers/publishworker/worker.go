@@ -0,0 +1,274 @@
+package publishworker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/common"
+	"github.com/arran4/goa4web/core/consts"
+	"github.com/arran4/goa4web/internal/activitypub"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/internal/tasks"
+	"github.com/arran4/goa4web/workers/searchworker"
+)
+
+// SchedulerTaskName identifies the scheduled publishing job in the scheduler.
+const SchedulerTaskName = "scheduled_publish"
+
+// batchSize limits how many items are published per scheduler run.
+const batchSize = 50
+
+const (
+	// TaskScheduledPublish is raised when a scheduled item goes live.
+	TaskScheduledPublish tasks.TaskString = "Scheduled Publish"
+
+	// The creation tasks of each section. Subscribers are matched against
+	// these so a scheduled item reaches the same people as one posted
+	// straight away.
+	newsPostTask      tasks.TaskString = "New Post"
+	blogAddTask       tasks.TaskString = "Add"
+	writingSubmitTask tasks.TaskString = "Submit writing"
+
+	EmailTemplateNewsAdd        notif.EmailTemplateName        = "newsAddEmail"
+	NotificationTemplateNewsAdd notif.NotificationTemplateName = "news_add"
+	EmailTemplateBlogAdd        notif.EmailTemplateName        = "blogAddEmail"
+	NotificationTemplateBlogAdd notif.NotificationTemplateName = "blog_add"
+	EmailTemplateWriting        notif.EmailTemplateName        = "writingEmail"
+	NotificationTemplateWriting notif.NotificationTemplateName = "writing"
+)
+
+// eventItemTypeKey records which kind of item an event announces.
+const eventItemTypeKey = "ItemType"
+
+// PublishTask announces a scheduled item once it goes live.
+type PublishTask struct{ tasks.TaskString }
+
+var publishTask = &PublishTask{TaskString: TaskScheduledPublish}
+
+var _ tasks.Task = (*PublishTask)(nil)
+var _ tasks.TemplatesRequired = (*PublishTask)(nil)
+var _ notif.SubscribersNotificationTemplateProvider = (*PublishTask)(nil)
+var _ notif.SubscribersTaskProvider = (*PublishTask)(nil)
+var _ notif.GrantsRequiredProvider = (*PublishTask)(nil)
+var _ notif.MentionsNotificationProvider = (*PublishTask)(nil)
+
+// Action is unused; the event is raised by the scheduler rather than a form.
+func (PublishTask) Action(http.ResponseWriter, *http.Request) any { return nil }
+
+func itemType(evt eventbus.TaskEvent) string {
+	t, _ := evt.Data[eventItemTypeKey].(string)
+	return t
+}
+
+func (PublishTask) SubscribersTask(evt eventbus.TaskEvent) tasks.Name {
+	switch itemType(evt) {
+	case common.ScheduledTypeNews:
+		return newsPostTask
+	case common.ScheduledTypeBlog:
+		return blogAddTask
+	case common.ScheduledTypeWriting:
+		return writingSubmitTask
+	}
+	return nil
+}
+
+func (PublishTask) SubscribedEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	switch itemType(evt) {
+	case common.ScheduledTypeNews:
+		return EmailTemplateNewsAdd.EmailTemplates(), true
+	case common.ScheduledTypeBlog:
+		return EmailTemplateBlogAdd.EmailTemplates(), true
+	case common.ScheduledTypeWriting:
+		return EmailTemplateWriting.EmailTemplates(), true
+	}
+	return nil, false
+}
+
+func (PublishTask) SubscribedInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	var s string
+	switch itemType(evt) {
+	case common.ScheduledTypeNews:
+		s = NotificationTemplateNewsAdd.NotificationTemplate()
+	case common.ScheduledTypeBlog:
+		s = NotificationTemplateBlogAdd.NotificationTemplate()
+	case common.ScheduledTypeWriting:
+		s = NotificationTemplateWriting.NotificationTemplate()
+	default:
+		return nil
+	}
+	return &s
+}
+
+// GrantsRequired limits notifications to subscribers who may view the item.
+func (PublishTask) GrantsRequired(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	t, ok := evt.Data["target"].(notif.Target)
+	if !ok {
+		return nil, fmt.Errorf("target not provided")
+	}
+	switch t.Type {
+	case common.ScheduledTypeNews:
+		return []notif.GrantRequirement{{Section: consts.PermissionSectionNews, Item: consts.PermissionItemPost, ItemID: t.ID, Action: consts.PermissionActionView}}, nil
+	case common.ScheduledTypeBlog:
+		return []notif.GrantRequirement{{Section: consts.PermissionSectionBlogs, Item: consts.PermissionItemEntry, ItemID: t.ID, Action: consts.PermissionActionView}}, nil
+	case common.ScheduledTypeWriting:
+		return []notif.GrantRequirement{{Section: consts.PermissionSectionWriting, Item: consts.PermissionItemArticle, ItemID: t.ID, Action: consts.PermissionActionView}}, nil
+	}
+	return nil, fmt.Errorf("unknown target type %q", t.Type)
+}
+
+// MentionEmailTemplate emails users mentioned in the item now it is live.
+func (PublishTask) MentionEmailTemplate(evt eventbus.TaskEvent) (templates *notif.EmailTemplates, send bool) {
+	return notif.EmailTemplateMention.EmailTemplates(), true
+}
+
+func (PublishTask) MentionInternalNotificationTemplate(evt eventbus.TaskEvent) *string {
+	s := notif.NotificationTemplateMention.NotificationTemplate()
+	return &s
+}
+
+// MentionGrants only notifies mentioned users who may view the item.
+func (t PublishTask) MentionGrants(evt eventbus.TaskEvent) ([]notif.GrantRequirement, error) {
+	return t.GrantsRequired(evt)
+}
+
+func (PublishTask) RequiredTemplates() []tasks.Template {
+	var r []tasks.Template
+	r = append(r, EmailTemplateNewsAdd.RequiredTemplates()...)
+	r = append(r, NotificationTemplateNewsAdd.RequiredTemplates()...)
+	r = append(r, EmailTemplateBlogAdd.RequiredTemplates()...)
+	r = append(r, NotificationTemplateBlogAdd.RequiredTemplates()...)
+	r = append(r, EmailTemplateWriting.RequiredTemplates()...)
+	r = append(r, NotificationTemplateWriting.RequiredTemplates()...)
+	r = append(r, notif.EmailTemplateMention.RequiredTemplates()...)
+	r = append(r, notif.NotificationTemplateMention.RequiredTemplates()...)
+	return r
+}
+
+// PublishDue makes items whose publish time has passed visible, then
+// publishes a TaskScheduledPublish event for each so the search worker
+// indexes them and subscribers are notified.
+func PublishDue(ctx context.Context, q db.Querier, cfg *config.RuntimeConfig, bus *eventbus.Bus, now time.Time) error {
+	rows, err := q.SystemListDueScheduledPublications(ctx, db.SystemListDueScheduledPublicationsParams{
+		Now:   now,
+		Limit: batchSize,
+	})
+	if err != nil {
+		return fmt.Errorf("list due publications: %w", err)
+	}
+	cd := common.NewCoreData(ctx, q, cfg)
+	for _, sp := range rows {
+		n, err := q.SystemDeleteScheduledPublication(ctx, sp.ID)
+		if err != nil {
+			return fmt.Errorf("claim publication %d: %w", sp.ID, err)
+		}
+		if n == 0 {
+			// Published elsewhere since it was listed.
+			continue
+		}
+		evt, err := publishItem(ctx, q, cd, sp)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Deleted while it was waiting.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("publish %s %d: %w", sp.ItemType, sp.ItemID, err)
+		}
+		if bus == nil || evt == nil {
+			continue
+		}
+		if u, err := q.SystemGetUserByID(ctx, sp.AuthorID); err == nil {
+			evt.Data["Username"] = u.Username.String
+			evt.Data["Author"] = u.Username.String
+			if m, ok := evt.Data[common.MentionsEventKey].(common.MentionEventData); ok {
+				m.Author = u.Username.String
+				evt.Data[common.MentionsEventKey] = m
+			}
+		} else {
+			log.Printf("publication %d author: %v", sp.ID, err)
+		}
+		evt.Task = publishTask
+		evt.UserID = sp.AuthorID
+		evt.Time = now
+		evt.Outcome = eventbus.TaskOutcomeSuccess
+		if err := bus.Publish(*evt); err != nil {
+			log.Printf("publish %s %d live: %v", sp.ItemType, sp.ItemID, err)
+		}
+	}
+	return nil
+}
+
+// publishItem stamps the item with its publish time and describes the event
+// announcing it.
+func publishItem(ctx context.Context, q db.Querier, cd *common.CoreData, sp *db.ScheduledPublication) (*eventbus.TaskEvent, error) {
+	var path, text string
+	data := map[string]any{
+		eventItemTypeKey: sp.ItemType,
+		"target":         notif.Target{Type: sp.ItemType, ID: sp.ItemID},
+	}
+	switch sp.ItemType {
+	case common.ScheduledTypeNews:
+		post, err := q.SystemGetNewsPostForPublishing(ctx, sp.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		if err := q.SystemSetNewsPostOccurred(ctx, db.SystemSetNewsPostOccurredParams{
+			Occurred: sql.NullTime{Time: sp.PublishAt, Valid: true},
+			ID:       sp.ItemID,
+		}); err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("/news/news/%d", sp.ItemID)
+		text = post.News.String
+		data[searchworker.EventKey] = searchworker.IndexEventData{Type: searchworker.TypeNews, ID: sp.ItemID, Text: post.News.String}
+	case common.ScheduledTypeBlog:
+		entry, err := q.SystemGetBlogEntryForPublishing(ctx, sp.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		if err := q.SystemSetBlogEntryWritten(ctx, db.SystemSetBlogEntryWrittenParams{
+			Written: sp.PublishAt,
+			ID:      sp.ItemID,
+		}); err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("/blogs/blog/%d", sp.ItemID)
+		text = entry.Blog.String
+		data[searchworker.EventKey] = searchworker.IndexEventData{Type: searchworker.TypeBlog, ID: sp.ItemID, Text: entry.Blog.String}
+		data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeBlog, ItemID: sp.ItemID}
+	case common.ScheduledTypeWriting:
+		writing, err := q.SystemGetWritingForPublishing(ctx, sp.ItemID)
+		if err != nil {
+			return nil, err
+		}
+		if err := q.SystemSetWritingPublished(ctx, db.SystemSetWritingPublishedParams{
+			Published: sql.NullTime{Time: sp.PublishAt, Valid: true},
+			ID:        sp.ItemID,
+		}); err != nil {
+			return nil, err
+		}
+		path = fmt.Sprintf("/writings/article/%d", sp.ItemID)
+		data["Title"] = writing.Title.String
+		text = writing.Abstract.String + "\n" + writing.Writing.String
+		fullText := strings.Join([]string{writing.Abstract.String, writing.Title.String, writing.Writing.String}, " ")
+		data[searchworker.EventKey] = searchworker.IndexEventData{Type: searchworker.TypeWriting, ID: sp.ItemID, Text: fullText}
+		data[activitypub.EventKey] = activitypub.EventData{ItemType: activitypub.ItemTypeWriting, ItemID: sp.ItemID}
+	default:
+		log.Printf("scheduled publication %d: unknown item type %q", sp.ID, sp.ItemType)
+		return nil, nil
+	}
+	data["PostURL"] = cd.AbsoluteURL(path)
+	data["URL"] = cd.AbsoluteURL(path)
+	if m, ok := cd.NewMentionEventData(text, "", path); ok {
+		data[common.MentionsEventKey] = m
+	}
+	return &eventbus.TaskEvent{Path: path, Data: data}, nil
+}
@@ -0,0 +1,95 @@
+package publishworker
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/arran4/goa4web/config"
+	"github.com/arran4/goa4web/core/templates"
+	"github.com/arran4/goa4web/internal/db"
+	"github.com/arran4/goa4web/internal/eventbus"
+	notif "github.com/arran4/goa4web/internal/notifications"
+	"github.com/arran4/goa4web/workers/searchworker"
+)
+
+type publishQueries struct {
+	db.Querier
+	due       []*db.ScheduledPublication
+	deleted   []int32
+	published []db.SystemSetWritingPublishedParams
+}
+
+func (q *publishQueries) SystemListDueScheduledPublications(context.Context, db.SystemListDueScheduledPublicationsParams) ([]*db.ScheduledPublication, error) {
+	return q.due, nil
+}
+
+func (q *publishQueries) SystemDeleteScheduledPublication(_ context.Context, id int32) (int64, error) {
+	q.deleted = append(q.deleted, id)
+	return 1, nil
+}
+
+func (q *publishQueries) SystemGetWritingForPublishing(_ context.Context, id int32) (*db.SystemGetWritingForPublishingRow, error) {
+	return &db.SystemGetWritingForPublishingRow{
+		Idwriting: id,
+		Title:     sql.NullString{String: "Launch", Valid: true},
+		Writing:   sql.NullString{String: "body text", Valid: true},
+	}, nil
+}
+
+func (q *publishQueries) SystemSetWritingPublished(_ context.Context, arg db.SystemSetWritingPublishedParams) error {
+	q.published = append(q.published, arg)
+	return nil
+}
+
+func (q *publishQueries) SystemGetUserByID(context.Context, int32) (*db.SystemGetUserByIDRow, error) {
+	return &db.SystemGetUserByIDRow{Username: sql.NullString{String: "alice", Valid: true}}, nil
+}
+
+func TestPublishTemplatesExist(t *testing.T) {
+	for _, name := range publishTask.RequiredTemplates() {
+		if !name.Exists(templates.WithSilence(true)) {
+			t.Fatalf("missing template: %s", name)
+		}
+	}
+}
+
+func TestPublishDuePublishesWriting(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	q := &publishQueries{due: []*db.ScheduledPublication{{ID: 4, ItemType: "writing", ItemID: 9, AuthorID: 2, PublishAt: at}}}
+	bus := eventbus.NewBus()
+	ch := bus.Subscribe(eventbus.TaskMessageType)
+
+	if err := PublishDue(context.Background(), q, config.NewRuntimeConfig(), bus, at.Add(time.Minute)); err != nil {
+		t.Fatalf("PublishDue: %v", err)
+	}
+	if len(q.deleted) != 1 || q.deleted[0] != 4 {
+		t.Fatalf("deleted=%v", q.deleted)
+	}
+	if len(q.published) != 1 || !q.published[0].Published.Time.Equal(at) {
+		t.Fatalf("published=%+v", q.published)
+	}
+	select {
+	case env := <-ch:
+		env.Ack()
+		evt := env.Msg.(eventbus.TaskEvent)
+		if evt.Path != "/writings/article/9" || evt.UserID != 2 {
+			t.Fatalf("path=%q user=%d", evt.Path, evt.UserID)
+		}
+		if got := publishTask.SubscribersTask(evt); got != writingSubmitTask {
+			t.Fatalf("subscribers task=%v", got)
+		}
+		if idx, _ := evt.Data[searchworker.EventKey].(searchworker.IndexEventData); idx.Type != searchworker.TypeWriting || idx.ID != 9 {
+			t.Fatalf("index=%+v", evt.Data[searchworker.EventKey])
+		}
+		if tgt, _ := evt.Data["target"].(notif.Target); tgt.ID != 9 {
+			t.Fatalf("target=%+v", evt.Data["target"])
+		}
+		if evt.Data["Author"] != "alice" || evt.Data["Title"] != "Launch" {
+			t.Fatalf("data=%+v", evt.Data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event published")
+	}
+}
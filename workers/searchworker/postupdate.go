@@ -6,6 +6,7 @@ import (
 
 	"github.com/arran4/goa4web/internal/db"
 	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/search"
 )
 
 // IndexedTask describes a task that can be indexed by the search worker.
@@ -20,7 +21,7 @@ type IndexedTask interface {
 }
 
 // processEvent indexes text for tasks implementing IndexableTask.
-func processEvent(ctx context.Context, evt eventbus.TaskEvent, q db.Querier) {
+func processEvent(ctx context.Context, evt eventbus.TaskEvent, q db.Querier, backend search.Backend) {
 	task, ok := evt.Task.(IndexedTask)
 	if !ok || evt.Data == nil {
 		return
@@ -38,7 +39,7 @@ func processEvent(ctx context.Context, evt eventbus.TaskEvent, q db.Querier) {
 		if d.ID == 0 || d.Text == "" {
 			continue
 		}
-		if err := index(ctx, q, d); err != nil {
+		if err := index(ctx, q, backend, d); err != nil {
 			log.Printf("index error: %v", err)
 		}
 	}
@@ -3,10 +3,11 @@ package searchworker
 import (
 	"context"
 	"log"
-	"strings"
 
 	"github.com/arran4/goa4web/internal/db"
 	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/search"
+	searchdb "github.com/arran4/goa4web/internal/search/db"
 )
 
 // EventKey is the map key used for search index events.
@@ -14,10 +15,12 @@ const EventKey = "search_index"
 
 // Index types handled by the worker.
 const (
-	TypeComment = "comment"
-	TypeWriting = "writing"
-	TypeLinker  = "linker"
-	TypeImage   = "image"
+	TypeComment = search.TypeComment
+	TypeNews    = search.TypeNews
+	TypeBlog    = search.TypeBlog
+	TypeWriting = search.TypeWriting
+	TypeLinker  = search.TypeLinker
+	TypeImage   = search.TypeImage
 )
 
 // IndexEventData describes content to index.
@@ -27,11 +30,15 @@ type IndexEventData struct {
 	Text string
 }
 
-// Worker listens for index events and updates the search tables.
-func Worker(ctx context.Context, bus *eventbus.Bus, q db.Querier) {
+// Worker listens for index events and updates the search backend. A nil
+// backend stores the index in the database search tables.
+func Worker(ctx context.Context, bus *eventbus.Bus, q db.Querier, backend search.Backend) {
 	if bus == nil || q == nil {
 		return
 	}
+	if backend == nil {
+		backend = searchdb.New(q)
+	}
 	ch := bus.Subscribe(eventbus.TaskMessageType)
 	for {
 		select {
@@ -50,11 +57,11 @@ func Worker(ctx context.Context, bus *eventbus.Bus, q db.Querier) {
 			// cancelled.
 			evtCtx := context.WithoutCancel(ctx)
 			if data, ok := evt.Data[EventKey].(IndexEventData); ok {
-				if err := index(evtCtx, q, data); err != nil {
+				if err := index(evtCtx, q, backend, data); err != nil {
 					log.Printf("index error: %v", err)
 				}
 			} else {
-				processEvent(evtCtx, evt, q)
+				processEvent(evtCtx, evt, q, backend)
 			}
 			env.Ack()
 		case <-ctx.Done():
@@ -63,38 +70,20 @@ func Worker(ctx context.Context, bus *eventbus.Bus, q db.Querier) {
 	}
 }
 
-func index(ctx context.Context, q db.Querier, data IndexEventData) error {
-	counts := map[string]int32{}
-	for _, w := range BreakupTextToWords(data.Text) {
-		counts[strings.ToLower(w)]++
+func index(ctx context.Context, q db.Querier, backend search.Backend, data IndexEventData) error {
+	if err := backend.Index(ctx, search.Document{Type: data.Type, ID: data.ID, Text: data.Text}); err != nil {
+		return err
 	}
-	for word, count := range counts {
-		id, err := q.SystemCreateSearchWord(ctx, strings.ToLower(word))
-		if err != nil {
-			return err
-		}
-		switch data.Type {
-		case TypeComment:
-			if err := q.SystemAddToForumCommentSearch(ctx, db.SystemAddToForumCommentSearchParams{CommentID: data.ID, SearchwordlistIdsearchwordlist: int32(id), WordCount: count}); err != nil {
-				return err
-			}
-		case TypeWriting:
-			if err := q.SystemAddToForumWritingSearch(ctx, db.SystemAddToForumWritingSearchParams{WritingID: data.ID, SearchwordlistIdsearchwordlist: int32(id), WordCount: count}); err != nil {
-				return err
-			}
-		case TypeLinker:
-			if err := q.SystemAddToLinkerSearch(ctx, db.SystemAddToLinkerSearchParams{LinkerID: data.ID, SearchwordlistIdsearchwordlist: int32(id), WordCount: count}); err != nil {
-				return err
-			}
-		case TypeImage:
-			if err := q.SystemAddToImagePostSearch(ctx, db.SystemAddToImagePostSearchParams{ImagePostID: data.ID, SearchwordlistIdsearchwordlist: int32(id), WordCount: count}); err != nil {
-				return err
-			}
-		}
+	if err := search.Flush(ctx, backend); err != nil {
+		return err
 	}
 	switch data.Type {
 	case TypeComment:
 		return q.SystemSetCommentLastIndex(ctx, data.ID)
+	case TypeNews:
+		return q.SystemSetSiteNewsLastIndex(ctx, data.ID)
+	case TypeBlog:
+		return q.SystemSetBlogLastIndex(ctx, data.ID)
 	case TypeWriting:
 		return q.SystemSetWritingLastIndex(ctx, data.ID)
 	case TypeLinker:
@@ -0,0 +1,50 @@
+package webhookworker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/arran4/goa4web/internal/eventbus"
+	"github.com/arran4/goa4web/internal/webhooks"
+)
+
+// Worker forwards successful task events to matching webhooks and handles
+// redelivery requests from the admin interface. Deliveries left pending while
+// every webhook worker was busy are started again periodically.
+func Worker(ctx context.Context, bus *eventbus.Bus, d *webhooks.Dispatcher) {
+	if bus == nil || d == nil {
+		return
+	}
+	ch := bus.Subscribe(eventbus.TaskMessageType, eventbus.WebhookRedeliverMessageType)
+	defer d.Wait()
+	retry := time.NewTicker(webhooks.DefaultRetryInterval)
+	defer retry.Stop()
+	for {
+		select {
+		case <-retry.C:
+			if err := d.RetryPending(ctx); err != nil {
+				log.Printf("webhook retry: %v", err)
+			}
+		case env, ok := <-ch:
+			if !ok {
+				return
+			}
+			switch msg := env.Msg.(type) {
+			case eventbus.TaskEvent:
+				if msg.Outcome == eventbus.TaskOutcomeSuccess {
+					if err := d.Dispatch(ctx, msg); err != nil {
+						log.Printf("webhook dispatch: %v", err)
+					}
+				}
+			case eventbus.WebhookRedeliverEvent:
+				if _, err := d.Redeliver(ctx, msg.DeliveryID); err != nil {
+					log.Printf("webhook redeliver %d: %v", msg.DeliveryID, err)
+				}
+			}
+			env.Ack()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -12,17 +12,26 @@ import (
 	"github.com/arran4/goa4web/internal/db"
 	"github.com/arran4/goa4web/internal/dlq"
 	"github.com/arran4/goa4web/internal/email"
+	"github.com/arran4/goa4web/internal/emailbounce"
+	"github.com/arran4/goa4web/internal/emailreply"
 	"github.com/arran4/goa4web/internal/eventbus"
 	"github.com/arran4/goa4web/internal/notifications"
 	"github.com/arran4/goa4web/internal/scheduler"
+	"github.com/arran4/goa4web/internal/search"
+	"github.com/arran4/goa4web/internal/sitemap"
+	"github.com/arran4/goa4web/internal/webhooks"
 
+	"github.com/arran4/goa4web/workers/activitypubworker"
 	"github.com/arran4/goa4web/workers/auditworker"
 	"github.com/arran4/goa4web/workers/backgroundtaskworker"
 	"github.com/arran4/goa4web/workers/emailqueue"
 	"github.com/arran4/goa4web/workers/externallinkworker"
 	"github.com/arran4/goa4web/workers/logworker"
+	"github.com/arran4/goa4web/workers/pollworker"
 	"github.com/arran4/goa4web/workers/postcountworker"
+	"github.com/arran4/goa4web/workers/publishworker"
 	"github.com/arran4/goa4web/workers/searchworker"
+	"github.com/arran4/goa4web/workers/webhookworker"
 )
 
 // Option configures background workers started by Start.
@@ -30,8 +39,16 @@ type Option func(*WorkersConfig)
 
 // WorkersConfig holds worker configuration.
 type WorkersConfig struct {
-	HTTPClient  *http.Client
-	CoreOptions []common.CoreOption
+	HTTPClient    *http.Client
+	CoreOptions   []common.CoreOption
+	SearchBackend search.Backend
+	Sitemap       *sitemap.Sitemap
+	// EmailReplyKey signs reply addresses and EmailReplyHandler receives the
+	// replies posted from them.
+	EmailReplyKey     string
+	EmailReplyHandler http.Handler
+	// LinkSignKey signs the unsubscribe links of notification emails.
+	LinkSignKey string
 }
 
 // WithHTTPClient sets the HTTP client to supply to workers making external requests.
@@ -41,6 +58,36 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
+// WithSearchBackend sets the backend updated by the search index worker.
+func WithSearchBackend(b search.Backend) Option {
+	return func(c *WorkersConfig) {
+		c.SearchBackend = b
+	}
+}
+
+// WithSitemap sets the sitemap regenerated by the scheduler.
+func WithSitemap(sm *sitemap.Sitemap) Option {
+	return func(c *WorkersConfig) {
+		c.Sitemap = sm
+	}
+}
+
+// WithEmailReply enables reply by email. Reply addresses are signed with key
+// and replies are posted to h.
+func WithEmailReply(key string, h http.Handler) Option {
+	return func(c *WorkersConfig) {
+		c.EmailReplyKey = key
+		c.EmailReplyHandler = h
+	}
+}
+
+// WithLinkSignKey sets the key used to sign unsubscribe links in emails.
+func WithLinkSignKey(key string) Option {
+	return func(c *WorkersConfig) {
+		c.LinkSignKey = key
+	}
+}
+
 // WithCoreOptions supplies additional CoreData options for background workers.
 func WithCoreOptions(opts ...common.CoreOption) Option {
 	return func(c *WorkersConfig) {
@@ -70,6 +117,9 @@ func Start(ctx context.Context, q db.Querier, provider email.Provider, dlqProvid
 		}
 	}
 
+	if provider != nil && cfg.EmailBounceDomain != "" && wc.LinkSignKey != "" {
+		provider = &emailbounce.ReturnPathProvider{Provider: provider, Key: wc.LinkSignKey, Domain: cfg.EmailBounceDomain}
+	}
 	log.Printf("Starting email worker")
 	safeGo(func() {
 		emailqueue.StartEventListener(ctx, q, provider, dlqProvider, bus, cfg)
@@ -99,6 +149,25 @@ func Start(ctx context.Context, q db.Querier, provider email.Provider, dlqProvid
 			Type:     scheduler.TaskTypePeriodic,
 			Interval: time.Hour,
 		})
+		s.Register(scheduler.Task{
+			Name: "draft_purge",
+			Handler: func(ctx context.Context, t time.Time) error {
+				if cfg.DraftRetentionDays <= 0 {
+					return nil
+				}
+				cutoff := t.AddDate(0, 0, -cfg.DraftRetentionDays)
+				n, err := q.SystemPurgeDraftsBefore(ctx, cutoff.UTC())
+				if err != nil {
+					return err
+				}
+				if n > 0 {
+					log.Printf("purged %d stale drafts", n)
+				}
+				return nil
+			},
+			Type:     scheduler.TaskTypePeriodic,
+			Interval: time.Hour,
+		})
 		s.Register(scheduler.Task{
 			Name: "email_queue_poll",
 			Handler: func(ctx context.Context, t time.Time) error {
@@ -119,6 +188,53 @@ func Start(ctx context.Context, q db.Querier, provider email.Provider, dlqProvid
 			Interval:  time.Minute,
 			Ephemeral: true,
 		})
+		s.Register(scheduler.Task{
+			Name: pollworker.SchedulerTaskName,
+			Handler: func(ctx context.Context, t time.Time) error {
+				return pollworker.CloseExpired(ctx, q, cfg, bus, t)
+			},
+			Type:     scheduler.TaskTypePeriodic,
+			Interval: time.Minute,
+		})
+		s.Register(scheduler.Task{
+			Name: publishworker.SchedulerTaskName,
+			Handler: func(ctx context.Context, t time.Time) error {
+				return publishworker.PublishDue(ctx, q, cfg, bus, t)
+			},
+			Type:     scheduler.TaskTypePeriodic,
+			Interval: time.Minute,
+		})
+		if cfg.ActivityPubEnabled {
+			s.Register(scheduler.Task{
+				Name: activitypubworker.SchedulerTaskName,
+				Handler: func(ctx context.Context, t time.Time) error {
+					return activitypubworker.DeliverDue(ctx, q, bus, t)
+				},
+				Type:      scheduler.TaskTypePeriodic,
+				Interval:  time.Minute,
+				Ephemeral: true,
+			})
+			s.Register(scheduler.Task{
+				Name: activitypubworker.PurgeTaskName,
+				Handler: func(ctx context.Context, t time.Time) error {
+					return activitypubworker.Purge(ctx, q, t)
+				},
+				Type:     scheduler.TaskTypePeriodic,
+				Interval: time.Hour,
+			})
+		}
+		if wc.Sitemap != nil {
+			s.Register(scheduler.Task{
+				Name: sitemap.SchedulerTaskName,
+				Handler: func(ctx context.Context, t time.Time) error {
+					return wc.Sitemap.Regenerate(ctx, q)
+				},
+				Type:     scheduler.TaskTypePeriodic,
+				Interval: time.Hour,
+				// The sitemap is cached in memory so it is rebuilt on start.
+				Ephemeral: true,
+			})
+		}
 		s.Run(ctx, 1*time.Second)
 	})
 	log.Printf("Starting event bus logger worker")
@@ -132,11 +248,51 @@ func Start(ctx context.Context, q db.Querier, provider email.Provider, dlqProvid
 			notifications.WithEmailProvider(provider),
 			notifications.WithBus(bus),
 			notifications.WithConfig(cfg),
+			notifications.WithEmailReplyKey(wc.EmailReplyKey),
+			notifications.WithLinkSignKey(wc.LinkSignKey),
 		)
 		n.BusWorker(ctx, bus, dlqProvider)
 	})
+	if cfg.EmailReplyDomain != "" && wc.EmailReplyKey != "" && wc.EmailReplyHandler != nil {
+		in := &emailreply.Ingester{Key: wc.EmailReplyKey, Domain: cfg.EmailReplyDomain, Handler: wc.EmailReplyHandler, Queries: q}
+		if cfg.EmailReplyListen != "" {
+			log.Printf("Starting email reply listener")
+			safeGo(func() {
+				srv := &emailreply.Server{Mailbox: in, Domain: cfg.EmailReplyDomain}
+				if err := srv.ListenAndServe(ctx, cfg.EmailReplyListen); err != nil {
+					log.Printf("email reply listener: %v", err)
+				}
+			})
+		}
+		if cfg.EmailReplyMaildir != "" {
+			log.Printf("Starting email reply maildir poller")
+			safeGo(func() {
+				in.PollMaildir(ctx, cfg.EmailReplyMaildir, time.Duration(cfg.EmailReplyPollInterval)*time.Second)
+			})
+		}
+	}
+	if (cfg.EmailBounceListen != "" || cfg.EmailBounceMaildir != "") && (cfg.EmailBounceDomain == "" || wc.LinkSignKey == "") {
+		log.Printf("email bounce processing disabled: %s and a link signing key are required", config.EnvEmailBounceDomain)
+	} else if q != nil && (cfg.EmailBounceListen != "" || cfg.EmailBounceMaildir != "") {
+		bp := &emailbounce.Processor{Queries: q, Key: wc.LinkSignKey, Domain: cfg.EmailBounceDomain}
+		if cfg.EmailBounceListen != "" {
+			log.Printf("Starting email bounce listener")
+			safeGo(func() {
+				srv := &emailreply.Server{Mailbox: bp}
+				if err := srv.ListenAndServe(ctx, cfg.EmailBounceListen); err != nil {
+					log.Printf("email bounce listener: %v", err)
+				}
+			})
+		}
+		if cfg.EmailBounceMaildir != "" {
+			log.Printf("Starting email bounce maildir poller")
+			safeGo(func() {
+				emailreply.PollMaildir(ctx, cfg.EmailBounceMaildir, time.Duration(cfg.EmailBouncePollInterval)*time.Second, bp.DeliverMessage)
+			})
+		}
+	}
 	log.Printf("Starting search index worker")
-	safeGo(func() { searchworker.Worker(ctx, bus, q) })
+	safeGo(func() { searchworker.Worker(ctx, bus, q, wc.SearchBackend) })
 	log.Printf("Starting background task worker")
 	safeGo(func() {
 		var bopts []backgroundtaskworker.Option
@@ -148,6 +304,15 @@ func Start(ctx context.Context, q db.Querier, provider email.Provider, dlqProvid
 		}
 		backgroundtaskworker.Worker(ctx, bus, q, cfg, bopts...)
 	})
+	log.Printf("Starting webhook worker")
+	safeGo(func() {
+		d := webhooks.New(q, webhooks.WithDLQ(dlqProvider))
+		webhookworker.Worker(ctx, bus, d)
+	})
+	if cfg.ActivityPubEnabled {
+		log.Printf("Starting ActivityPub worker")
+		safeGo(func() { activitypubworker.Worker(ctx, bus, q, cfg, wc.CoreOptions...) })
+	}
 	log.Printf("Starting post count worker")
 	safeGo(func() { postcountworker.Worker(ctx, bus, q) })
 	log.Printf("Starting external link worker")